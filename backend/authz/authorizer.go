@@ -3,6 +3,7 @@ package authz
 import (
 	"context"
 	"errors"
+	"slices"
 
 	"blog-server/pkg/errx"
 	"blog-server/repository"
@@ -19,11 +20,13 @@ type Authorizer struct {
 func NewAuthorizer(
 	postRepo repository.PostRepo,
 	linkRepo repository.LinkRepo,
+	commentRepo repository.CommentRepo,
 ) *Authorizer {
 	return &Authorizer{
 		ownerCheckers: map[Resource]OwnerChecker{
-			ResourcePost: postRepo,
-			ResourceLink: linkRepo,
+			ResourcePost:    postRepo,
+			ResourceLink:    linkRepo,
+			ResourceComment: commentRepo,
 		},
 	}
 }
@@ -59,9 +62,14 @@ func (a *Authorizer) Authorize(
 		return ErrForbidden()
 	}
 
+	// Token scopes
+	if !inScope(ctx, resource, action) {
+		return ErrForbidden()
+	}
+
 	if action == ActionUpdate || action == ActionDelete {
 
-		if role == RoleAdmin {
+		if overridesOwnership(role, resource) {
 			return nil
 		}
 
@@ -87,6 +95,37 @@ func (a *Authorizer) Authorize(
 	return nil
 }
 
+// OwnerScope returns whose resources the user may list for the action: nil
+// for everyone's, or the ID of the user for their own only.
+func (a *Authorizer) OwnerScope(
+	ctx context.Context,
+	userID uint,
+	role Role,
+	resource Resource,
+	action Action,
+) (*uint, error) {
+	if !can(role, resource, action) || !inScope(ctx, resource, action) {
+		return nil, ErrForbidden()
+	}
+
+	if overridesOwnership(role, resource) {
+		return nil, nil
+	}
+	if _, ok := a.ownerCheckers[resource]; !ok {
+		return nil, ErrForbidden()
+	}
+	return &userID, nil
+}
+
+// overridesOwnership reports whether the role acts on resources of the kind
+// regardless of who owns them.
+func overridesOwnership(role Role, resource Resource) bool {
+	if role == RoleAdmin {
+		return true
+	}
+	return slices.Contains(roleOverridesOwnership[role], resource)
+}
+
 func (a *Authorizer) CanCreatePost(ctx context.Context, userID uint, role Role) error {
 	return a.Authorize(ctx, userID, role, ResourcePost, ActionCreate, nil)
 }
@@ -3,18 +3,13 @@ package authz
 import "blog-server/entity"
 
 func FromEntityRole(role entity.UserRole) Role {
-	switch role {
-	case entity.UserRoleAdmin:
-		return RoleAdmin
-	default:
-		return RoleReader
-	}
+	return FromString(string(role))
 }
 
 func FromString(role string) Role {
-	switch role {
-	case "admin":
-		return RoleAdmin
+	switch Role(role) {
+	case RoleAdmin, RoleEditor, RoleAuthor:
+		return Role(role)
 	default:
 		return RoleReader
 	}
@@ -6,13 +6,54 @@ var rolePermissions = map[Role][]Permission{
 		{ResourcePost, ActionRead},
 		{ResourcePost, ActionUpdate},
 		{ResourcePost, ActionDelete},
+		{ResourcePost, ActionPurge},
 
 		{ResourceLink, ActionCreate},
 		{ResourceLink, ActionUpdate},
 		{ResourceLink, ActionDelete},
+
+		{ResourceComment, ActionRead},
+		{ResourceComment, ActionUpdate},
+		{ResourceComment, ActionDelete},
+
+		{ResourceMail, ActionRead},
+		{ResourceMail, ActionUpdate},
+		{ResourceMail, ActionDelete},
+
+		{ResourceUser, ActionRead},
+		{ResourceUser, ActionUpdate},
+		{ResourceUser, ActionDelete},
+	},
+
+	// Editors run the content: they edit anyone's posts and moderate
+	// comments, but cannot manage users, links or mail.
+	RoleEditor: {
+		{ResourcePost, ActionCreate},
+		{ResourcePost, ActionRead},
+		{ResourcePost, ActionUpdate},
+		{ResourcePost, ActionDelete},
+
+		{ResourceComment, ActionRead},
+		{ResourceComment, ActionUpdate},
+		{ResourceComment, ActionDelete},
+	},
+
+	// Authors write posts and may only edit their own.
+	RoleAuthor: {
+		{ResourcePost, ActionCreate},
+		{ResourcePost, ActionRead},
+		{ResourcePost, ActionUpdate},
+		{ResourcePost, ActionDelete},
 	},
 
 	RoleReader: {
 		{ResourcePost, ActionRead},
 	},
 }
+
+// roleOverridesOwnership lists the resources a role acts on regardless of
+// who owns them. Admins act on all resources; other roles are limited to
+// their own.
+var roleOverridesOwnership = map[Role][]Resource{
+	RoleEditor: {ResourcePost, ResourceComment},
+}
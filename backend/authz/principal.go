@@ -0,0 +1,14 @@
+package authz
+
+import "blog-server/entity"
+
+// AccessTokenPrincipal is the user and permissions a personal access token acts with.
+type AccessTokenPrincipal struct {
+	TokenID uint
+	UserID  uint
+	Role    entity.UserRole
+	Scopes  []Permission
+	// TwoFactor reports whether the token was created from a session that
+	// passed a second factor, which it then counts as having passed.
+	TwoFactor bool
+}
@@ -0,0 +1,52 @@
+package authz
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"strings"
+)
+
+type scopesKey struct{}
+
+// Scope returns the "resource:action" form of the permission, as used for
+// personal access token scopes.
+func (p Permission) Scope() string {
+	return string(p.Resource) + ":" + string(p.Action)
+}
+
+// ParseScope parses a "resource:action" scope.
+func ParseScope(scope string) (Permission, error) {
+	resource, action, ok := strings.Cut(scope, ":")
+	if !ok {
+		return Permission{}, fmt.Errorf("invalid scope %q", scope)
+	}
+	p := Permission{Resource: Resource(resource), Action: Action(action)}
+	for _, perms := range rolePermissions {
+		if slices.Contains(perms, p) {
+			return p, nil
+		}
+	}
+	return Permission{}, fmt.Errorf("unknown scope %q", scope)
+}
+
+// RoleAllows reports whether the role grants the permission.
+func RoleAllows(role Role, p Permission) bool {
+	return can(role, p.Resource, p.Action)
+}
+
+// WithScopes restricts the request to the given permissions on top of the
+// role of the user, as for requests made with a personal access token.
+func WithScopes(ctx context.Context, scopes []Permission) context.Context {
+	return context.WithValue(ctx, scopesKey{}, scopes)
+}
+
+// inScope reports whether the request may use the permission. Requests
+// without scopes are limited by the role alone.
+func inScope(ctx context.Context, resource Resource, action Action) bool {
+	scopes, ok := ctx.Value(scopesKey{}).([]Permission)
+	if !ok {
+		return true
+	}
+	return slices.Contains(scopes, Permission{Resource: resource, Action: action})
+}
@@ -4,12 +4,16 @@ type Role string
 
 const (
 	RoleAdmin  Role = "admin"
+	RoleEditor Role = "editor"
+	RoleAuthor Role = "author"
 	RoleReader Role = "reader"
 )
 
 func (Role) Values() []string {
 	return []string{
 		string(RoleReader),
+		string(RoleAuthor),
+		string(RoleEditor),
 		string(RoleAdmin),
 	}
 }
@@ -17,8 +21,11 @@ func (Role) Values() []string {
 type Resource string
 
 const (
-	ResourcePost Resource = "post"
-	ResourceLink Resource = "link"
+	ResourcePost    Resource = "post"
+	ResourceLink    Resource = "link"
+	ResourceComment Resource = "comment"
+	ResourceMail    Resource = "mail"
+	ResourceUser    Resource = "user"
 )
 
 type Action string
@@ -28,6 +35,8 @@ const (
 	ActionRead   Action = "read"
 	ActionUpdate Action = "update"
 	ActionDelete Action = "delete"
+	// ActionPurge permanently deletes a resource in the trash.
+	ActionPurge Action = "purge"
 )
 
 type Permission struct {
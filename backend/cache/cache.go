@@ -14,7 +14,51 @@ type Store interface {
 
 type AtomicStore interface {
 	Incr(ctx context.Context, key string) (int64, error)
+	// SetNX sets key only when it does not exist and reports whether it was set.
+	SetNX(ctx context.Context, key string, value string, ttl time.Duration) (bool, error)
+	// IncrWithTTL increments key and sets ttl when the key has no expiry yet,
+	// so the counter lives for a fixed window starting at its first hit.
+	IncrWithTTL(ctx context.Context, key string, ttl time.Duration) (int64, error)
+	// SlidingWindowIncr records a hit now and returns the number of hits within
+	// the window ending now, including this one.
+	SlidingWindowIncr(ctx context.Context, key string, window time.Duration) (int64, error)
 	PopBatch(ctx context.Context, keys []string) (map[string]string, error)
+	// DeleteIfEqual deletes key only when it holds value and reports whether
+	// it did, so that a lock is only released by the holder of its token.
+	DeleteIfEqual(ctx context.Context, key, value string) (bool, error)
+	// SetIfEqual replaces the value of key only when it holds old and reports
+	// whether it did, so that concurrent read-modify-write cycles cannot both win.
+	SetIfEqual(ctx context.Context, key, old, value string, ttl time.Duration) (bool, error)
+}
+
+// SortedSetStore exposes sorted set operations, used for time-ordered queues.
+type SortedSetStore interface {
+	ZAdd(ctx context.Context, key string, score float64, member string) error
+	// ZRangeByScore returns at most count members with min <= score <= max, lowest score first.
+	ZRangeByScore(ctx context.Context, key string, min, max float64, count int64) ([]string, error)
+	ZRem(ctx context.Context, key string, members ...string) error
+}
+
+// HashStore exposes hash operations, used to keep records addressable by field.
+type HashStore interface {
+	HSet(ctx context.Context, key, field, value string) error
+	HGet(ctx context.Context, key, field string) (string, error)
+	HGetAll(ctx context.Context, key string) (map[string]string, error)
+	HDel(ctx context.Context, key string, fields ...string) error
+}
+
+// SetStore exposes unordered set operations, used to index keys by owner.
+type SetStore interface {
+	SAdd(ctx context.Context, key string, members ...string) error
+	SRem(ctx context.Context, key string, members ...string) error
+	SMembers(ctx context.Context, key string) ([]string, error)
+}
+
+// RateLimitStore exposes rate limiting with the generic cell rate algorithm.
+type RateLimitStore interface {
+	// AllowGCRA admits one request to key at a sustained rate of one per
+	// interval with bursts of up to burst requests.
+	AllowGCRA(ctx context.Context, key string, interval time.Duration, burst int) (*RateLimitResult, error)
 }
 
 type PatternScanner interface {
@@ -24,6 +68,10 @@ type PatternScanner interface {
 type CacheClient interface {
 	Store
 	AtomicStore
+	SortedSetStore
+	HashStore
+	SetStore
+	RateLimitStore
 	PatternScanner
 
 	Close() error
@@ -0,0 +1,80 @@
+package cache
+
+import (
+	"context"
+	"time"
+
+	"github.com/redis/go-redis/v9"
+)
+
+// RateLimitResult is the outcome of a rate limited request.
+type RateLimitResult struct {
+	Allowed bool
+	// Remaining is how many more requests would be allowed right now.
+	Remaining int
+	// RetryAfter is how long until the next request is allowed, zero when allowed.
+	RetryAfter time.Duration
+	// ResetAfter is how long until the full burst is available again.
+	ResetAfter time.Duration
+}
+
+// GCRA applies the generic cell rate algorithm to one request at now.
+//
+// tat is the theoretical arrival time kept for the key, zero for a new key.
+// The returned tat replaces it when the request is allowed. Memory-backed
+// limiters use it directly; the Redis script below mirrors it.
+func GCRA(now, tat time.Time, interval time.Duration, burst int) (*RateLimitResult, time.Time) {
+	if tat.Before(now) {
+		tat = now
+	}
+	newTAT := tat.Add(interval)
+	allowAt := newTAT.Add(-interval * time.Duration(burst))
+
+	if now.Before(allowAt) {
+		return &RateLimitResult{
+			RetryAfter: allowAt.Sub(now),
+			ResetAfter: tat.Sub(now),
+		}, tat
+	}
+	return &RateLimitResult{
+		Allowed:    true,
+		Remaining:  int(now.Sub(allowAt) / interval),
+		ResetAfter: newTAT.Sub(now),
+	}, newTAT
+}
+
+// gcraScript is GCRA on the Redis clock, in microseconds. The key holds the
+// theoretical arrival time and expires once the burst is full again.
+var gcraScript = redis.NewScript(`
+local interval = tonumber(ARGV[1])
+local burst = tonumber(ARGV[2])
+local t = redis.call('TIME')
+local now = tonumber(t[1]) * 1000000 + tonumber(t[2])
+
+local tat = tonumber(redis.call('GET', KEYS[1]) or now)
+if tat < now then
+	tat = now
+end
+local new_tat = tat + interval
+local allow_at = new_tat - interval * burst
+
+if now < allow_at then
+	return {0, 0, allow_at - now, tat - now}
+end
+redis.call('SET', KEYS[1], string.format('%d', new_tat), 'PX', math.ceil((new_tat - now) / 1000))
+return {1, math.floor((now - allow_at) / interval), 0, new_tat - now}
+`)
+
+// AllowGCRA implements [CacheClient].
+func (c *client) AllowGCRA(ctx context.Context, key string, interval time.Duration, burst int) (*RateLimitResult, error) {
+	res, err := gcraScript.Run(ctx, c.rdb, []string{key}, interval.Microseconds(), burst).Int64Slice()
+	if err != nil {
+		return nil, err
+	}
+	return &RateLimitResult{
+		Allowed:    res[0] == 1,
+		Remaining:  int(res[1]),
+		RetryAfter: time.Duration(res[2]) * time.Microsecond,
+		ResetAfter: time.Duration(res[3]) * time.Microsecond,
+	}, nil
+}
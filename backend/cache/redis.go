@@ -5,6 +5,8 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"math/rand/v2"
+	"strconv"
 	"time"
 
 	"blog-server/config"
@@ -53,6 +55,75 @@ func (c *client) Incr(ctx context.Context, key string) (int64, error) {
 	return c.rdb.Incr(ctx, key).Result()
 }
 
+// IncrWithTTL implements [CacheClient].
+func (c *client) IncrWithTTL(ctx context.Context, key string, ttl time.Duration) (int64, error) {
+	pipe := c.rdb.TxPipeline()
+	incr := pipe.Incr(ctx, key)
+	if ttl > 0 {
+		pipe.ExpireNX(ctx, key, ttl)
+	}
+	if _, err := pipe.Exec(ctx); err != nil {
+		return 0, err
+	}
+	return incr.Val(), nil
+}
+
+// SlidingWindowIncr implements [CacheClient].
+//
+// Hits are kept in a sorted set scored by time, from which hits older than
+// the window are dropped on every call.
+func (c *client) SlidingWindowIncr(ctx context.Context, key string, window time.Duration) (int64, error) {
+	now := time.Now()
+	pipe := c.rdb.TxPipeline()
+	pipe.ZRemRangeByScore(ctx, key, "-inf", strconv.FormatInt(now.Add(-window).UnixNano(), 10))
+	pipe.ZAdd(ctx, key, redis.Z{
+		Score:  float64(now.UnixNano()),
+		Member: strconv.FormatInt(now.UnixNano(), 36) + "-" + strconv.FormatUint(rand.Uint64(), 36),
+	})
+	card := pipe.ZCard(ctx, key)
+	pipe.PExpire(ctx, key, window)
+	if _, err := pipe.Exec(ctx); err != nil {
+		return 0, err
+	}
+	return card.Val(), nil
+}
+
+// deleteIfEqualScript deletes KEYS[1] when it holds ARGV[1].
+var deleteIfEqualScript = redis.NewScript(`
+if redis.call('GET', KEYS[1]) == ARGV[1] then
+	return redis.call('DEL', KEYS[1])
+end
+return 0
+`)
+
+// DeleteIfEqual implements [CacheClient].
+func (c *client) DeleteIfEqual(ctx context.Context, key, value string) (bool, error) {
+	n, err := deleteIfEqualScript.Run(ctx, c.rdb, []string{key}, value).Int()
+	if err != nil {
+		return false, err
+	}
+	return n > 0, nil
+}
+
+// setIfEqualScript sets KEYS[1] to ARGV[2] with a TTL of ARGV[3] milliseconds
+// when it holds ARGV[1].
+var setIfEqualScript = redis.NewScript(`
+if redis.call('GET', KEYS[1]) == ARGV[1] then
+	redis.call('SET', KEYS[1], ARGV[2], 'PX', ARGV[3])
+	return 1
+end
+return 0
+`)
+
+// SetIfEqual implements [CacheClient].
+func (c *client) SetIfEqual(ctx context.Context, key, old, value string, ttl time.Duration) (bool, error) {
+	n, err := setIfEqualScript.Run(ctx, c.rdb, []string{key}, old, value, ttl.Milliseconds()).Int()
+	if err != nil {
+		return false, err
+	}
+	return n > 0, nil
+}
+
 func (c *client) Delete(ctx context.Context, key string) error {
 	return c.rdb.Del(ctx, key).Err()
 }
@@ -89,10 +160,97 @@ func (c *client) Set(ctx context.Context, key string, value string, ttl time.Dur
 	return c.rdb.Set(ctx, key, value, ttl).Err()
 }
 
+// SetNX implements [CacheClient].
+func (c *client) SetNX(ctx context.Context, key string, value string, ttl time.Duration) (bool, error) {
+	return c.rdb.SetNX(ctx, key, value, ttl).Result()
+}
+
+// ZAdd implements [CacheClient].
+func (c *client) ZAdd(ctx context.Context, key string, score float64, member string) error {
+	return c.rdb.ZAdd(ctx, key, redis.Z{Score: score, Member: member}).Err()
+}
+
+// ZRangeByScore implements [CacheClient].
+func (c *client) ZRangeByScore(ctx context.Context, key string, min, max float64, count int64) ([]string, error) {
+	return c.rdb.ZRangeByScore(ctx, key, &redis.ZRangeBy{
+		Min:   strconv.FormatFloat(min, 'f', -1, 64),
+		Max:   strconv.FormatFloat(max, 'f', -1, 64),
+		Count: count,
+	}).Result()
+}
+
+// ZRem implements [CacheClient].
+func (c *client) ZRem(ctx context.Context, key string, members ...string) error {
+	if len(members) == 0 {
+		return nil
+	}
+	return c.rdb.ZRem(ctx, key, toArgs(members)...).Err()
+}
+
+// HSet implements [CacheClient].
+func (c *client) HSet(ctx context.Context, key, field, value string) error {
+	return c.rdb.HSet(ctx, key, field, value).Err()
+}
+
+// HGet implements [CacheClient].
+func (c *client) HGet(ctx context.Context, key, field string) (string, error) {
+	result, err := c.rdb.HGet(ctx, key, field).Result()
+	if err == nil {
+		return result, nil
+	}
+	if errors.Is(err, redis.Nil) {
+		return "", errx.New(errx.CodeNotFound, err)
+	}
+	return "", err
+}
+
+// HGetAll implements [CacheClient].
+func (c *client) HGetAll(ctx context.Context, key string) (map[string]string, error) {
+	return c.rdb.HGetAll(ctx, key).Result()
+}
+
+// HDel implements [CacheClient].
+func (c *client) HDel(ctx context.Context, key string, fields ...string) error {
+	if len(fields) == 0 {
+		return nil
+	}
+	return c.rdb.HDel(ctx, key, fields...).Err()
+}
+
+// SAdd implements [CacheClient].
+func (c *client) SAdd(ctx context.Context, key string, members ...string) error {
+	if len(members) == 0 {
+		return nil
+	}
+	return c.rdb.SAdd(ctx, key, toArgs(members)...).Err()
+}
+
+// SRem implements [CacheClient].
+func (c *client) SRem(ctx context.Context, key string, members ...string) error {
+	if len(members) == 0 {
+		return nil
+	}
+	return c.rdb.SRem(ctx, key, toArgs(members)...).Err()
+}
+
+// SMembers implements [CacheClient].
+func (c *client) SMembers(ctx context.Context, key string) ([]string, error) {
+	return c.rdb.SMembers(ctx, key).Result()
+}
+
 func (c *client) Close() error {
 	return c.rdb.Close()
 }
 
+// toArgs converts members to the variadic arguments expected by go-redis.
+func toArgs(members []string) []any {
+	args := make([]any, len(members))
+	for i, m := range members {
+		args[i] = m
+	}
+	return args
+}
+
 func NewCacheClient(cfg *config.Config) (CacheClient, error) {
 	rcfg := cfg.Redis
 	rdb := redis.NewClient(&redis.Options{
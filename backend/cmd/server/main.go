@@ -12,7 +12,9 @@ import (
 	"blog-server/datastore"
 	"blog-server/handler"
 	"blog-server/logger"
+	"blog-server/mailer"
 	"blog-server/middleware"
+	"blog-server/pkg/jwt"
 	"blog-server/pkg/validatorx"
 	"blog-server/repository"
 	"blog-server/scheduler"
@@ -33,6 +35,7 @@ func main() {
 			config.Module(),
 			logger.Module(),
 			cache.Module(),
+			mailer.Module(),
 			datastore.Module(),
 			repository.Module(),
 			authz.Module(),
@@ -42,7 +45,8 @@ func main() {
 		),
 		fx.Provide(
 			validatorx.NewValidator,
-			middleware.NewAuthMiddleware,
+			newAuthMiddleware,
+			middleware.NewRateLimiter,
 			providerEchoApp,
 		),
 		fx.Invoke(
@@ -52,6 +56,16 @@ func main() {
 	app.Run()
 }
 
+// newAuthMiddleware hands the services the auth middleware depends on to it.
+func newAuthMiddleware(
+	cfg *config.Config,
+	j jwt.Jwt,
+	denylist service.TokenDenylist,
+	accessTokens service.AccessTokenService,
+) *middleware.AuthMiddleware {
+	return middleware.NewAuthMiddleware(cfg, j, denylist, accessTokens)
+}
+
 func providerEchoApp(cfg *config.Config, log logger.Logger) *echo.Echo {
 	echoCfg := echo.Config{
 		HTTPErrorHandler: handler.ErrorHandler(cfg, log),
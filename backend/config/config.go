@@ -15,15 +15,19 @@ const (
 // Config represents the root configuration structure of the application.
 // It aggregates all subsystem configurations.
 type Config struct {
-	App      AppConfig      `mapstructure:"app" yaml:"app"`
-	Server   ServerConfig   `mapstructure:"server" yaml:"server"`
-	Database DatabaseConfig `mapstructure:"database" yaml:"database"`
-	Redis    RedisConfig    `mapstructure:"redis" yaml:"redis"`
-	JWT      JWTConfig      `mapstructure:"jwt" yaml:"jwt"`
-	Log      LogConfig      `mapstructure:"log" yaml:"log"`
-	Email    EmailConfig    `mapstructure:"email" yaml:"email"`
-	LLM      LLMConfig      `mapstructure:"llm" yaml:"llm"`
-	Rustfs   RustfsConfig   `mapstructure:"rustfs" yaml:"rustfs"`
+	App       AppConfig       `mapstructure:"app" yaml:"app"`
+	Server    ServerConfig    `mapstructure:"server" yaml:"server"`
+	Database  DatabaseConfig  `mapstructure:"database" yaml:"database"`
+	Redis     RedisConfig     `mapstructure:"redis" yaml:"redis"`
+	JWT       JWTConfig       `mapstructure:"jwt" yaml:"jwt"`
+	Auth      AuthConfig      `mapstructure:"auth" yaml:"auth"`
+	Log       LogConfig       `mapstructure:"log" yaml:"log"`
+	Email     EmailConfig     `mapstructure:"email" yaml:"email"`
+	LLM       LLMConfig       `mapstructure:"llm" yaml:"llm"`
+	Rustfs    RustfsConfig    `mapstructure:"rustfs" yaml:"rustfs"`
+	Post      PostConfig      `mapstructure:"post" yaml:"post"`
+	Comment   CommentConfig   `mapstructure:"comment" yaml:"comment"`
+	RateLimit RateLimitConfig `mapstructure:"rate_limit" yaml:"rate_limit"`
 }
 
 // AppConfig contains general application-level settings such as environment,
@@ -96,11 +100,126 @@ type RedisConfig struct {
 }
 
 // JWTConfig contains configuration for JWT authentication and token lifecycle.
+//
+// Algorithm selects how tokens are signed: "HS256" (default) uses the shared
+// Secret, while "EdDSA" and "RS256" sign with key pairs stored in the database
+// and replaced every KeyRotation. Public keys are published as a JWKS.
 type JWTConfig struct {
 	Secret            string        `mapstructure:"secret" yaml:"secret"`
+	Algorithm         string        `mapstructure:"algorithm" yaml:"algorithm"`
+	KeyRotation       time.Duration `mapstructure:"key_rotation" yaml:"key_rotation"`
 	AccessExpiration  time.Duration `mapstructure:"access_expiration" yaml:"access_expiration"`
 	RefreshExpiration time.Duration `mapstructure:"refresh_expiration" yaml:"refresh_expiration"`
 	Issuer            string        `mapstructure:"issuer" yaml:"issuer"`
+	// KeyEncryptionKey is a base64 encoded 32 byte AES key the private
+	// signing keys are encrypted with in the database.
+	KeyEncryptionKey string `mapstructure:"key_encryption_key" yaml:"key_encryption_key"`
+}
+
+// UsesSharedSecret reports whether tokens are signed with the HMAC secret
+// instead of a key pair.
+func (c JWTConfig) UsesSharedSecret() bool {
+	return c.Algorithm == "" || c.Algorithm == "HS256"
+}
+
+// AuthConfig contains settings for login and account security.
+type AuthConfig struct {
+	TwoFactor TwoFactorConfig `mapstructure:"two_factor" yaml:"two_factor"`
+	WebAuthn  WebAuthnConfig  `mapstructure:"webauthn" yaml:"webauthn"`
+	OAuth     OAuthConfig     `mapstructure:"oauth" yaml:"oauth"`
+	Throttle  ThrottleConfig  `mapstructure:"throttle" yaml:"throttle"`
+}
+
+// TwoFactorConfig controls TOTP two-factor authentication.
+//
+// Issuer is the account label shown in authenticator apps. Users whose role
+// is in RequiredRoles and who did not pass a second factor at login may only
+// manage their own account, which includes enrolling; anything else fails
+// with errx.CodeTwoFactorRequired until they log in again with it.
+type TwoFactorConfig struct {
+	Issuer        string   `mapstructure:"issuer" yaml:"issuer"`
+	RequiredRoles []string `mapstructure:"required_roles" yaml:"required_roles"`
+}
+
+// WebAuthnConfig identifies the relying party for passkeys.
+//
+// RPID defaults to the host of app.domain and Origins to app.domain itself.
+// Changing RPID invalidates every registered passkey.
+type WebAuthnConfig struct {
+	RPID    string   `mapstructure:"rp_id" yaml:"rp_id"`
+	RPName  string   `mapstructure:"rp_name" yaml:"rp_name"`
+	Origins []string `mapstructure:"origins" yaml:"origins"`
+}
+
+// OAuthConfig lists the external providers users can log in with.
+type OAuthConfig struct {
+	Providers []OAuthProviderConfig `mapstructure:"providers" yaml:"providers"`
+}
+
+// OAuthProviderConfig registers this application at an external login provider.
+//
+// Name appears in the login URLs and links identities to the provider, so it
+// must not change once users have logged in. Type is "oidc" (default), which
+// needs the Issuer for discovery, or "github". Providers without a ClientID
+// are disabled. The redirect URL to register at the provider is
+// {app.domain}/api/v1/auth/oauth/{name}/callback.
+type OAuthProviderConfig struct {
+	Name         string   `mapstructure:"name" yaml:"name"`
+	Type         string   `mapstructure:"type" yaml:"type"`
+	Issuer       string   `mapstructure:"issuer" yaml:"issuer"`
+	ClientID     string   `mapstructure:"client_id" yaml:"client_id"`
+	ClientSecret string   `mapstructure:"client_secret" yaml:"client_secret"`
+	Scopes       []string `mapstructure:"scopes" yaml:"scopes"`
+}
+
+// Enabled reports whether the provider is configured.
+func (p OAuthProviderConfig) Enabled() bool {
+	return p.ClientID != ""
+}
+
+// ThrottleConfig limits password logins, captcha emails and wrong captchas.
+type ThrottleConfig struct {
+	Login         AttemptPolicy `mapstructure:"login" yaml:"login"`
+	Captcha       AttemptPolicy `mapstructure:"captcha" yaml:"captcha"`
+	CaptchaVerify AttemptPolicy `mapstructure:"captcha_verify" yaml:"captcha_verify"`
+}
+
+// AttemptPolicy limits attempts counted per IP, per email and per IP and
+// email together within a sliding Window.
+//
+// After FreeAttempts by the same IP and email, each further attempt must wait
+// BaseDelay, doubling every time up to MaxDelay. A counter reaching its limit
+// locks that IP, email or pair out for Lockout. A zero limit is not enforced.
+type AttemptPolicy struct {
+	Window       time.Duration `mapstructure:"window" yaml:"window"`
+	IPLimit      int           `mapstructure:"ip_limit" yaml:"ip_limit"`
+	EmailLimit   int           `mapstructure:"email_limit" yaml:"email_limit"`
+	IPEmailLimit int           `mapstructure:"ip_email_limit" yaml:"ip_email_limit"`
+	FreeAttempts int           `mapstructure:"free_attempts" yaml:"free_attempts"`
+	BaseDelay    time.Duration `mapstructure:"base_delay" yaml:"base_delay"`
+	MaxDelay     time.Duration `mapstructure:"max_delay" yaml:"max_delay"`
+	Lockout      time.Duration `mapstructure:"lockout" yaml:"lockout"`
+}
+
+// RateLimitConfig holds the named rate limit policies routes refer to.
+//
+// Routes whose policy is missing, or all routes when Enabled is false, are
+// not limited.
+type RateLimitConfig struct {
+	Enabled  bool                       `mapstructure:"enabled" yaml:"enabled"`
+	Policies map[string]RateLimitPolicy `mapstructure:"policies" yaml:"policies"`
+}
+
+// RateLimitPolicy allows Limit requests per Period, of which up to Burst
+// (default Limit) may come at once.
+//
+// Key selects whom requests are counted for: "ip" (default), "user", which
+// falls back to the IP for anonymous requests, or "route" for everyone together.
+type RateLimitPolicy struct {
+	Key    string        `mapstructure:"key" yaml:"key"`
+	Limit  int           `mapstructure:"limit" yaml:"limit"`
+	Period time.Duration `mapstructure:"period" yaml:"period"`
+	Burst  int           `mapstructure:"burst" yaml:"burst"`
 }
 
 // LogConfig defines logging configuration including output format and rotation policy.
@@ -114,13 +233,35 @@ type LogConfig struct {
 	Compress   bool   `mapstructure:"compress" yaml:"compress"`
 }
 
-// EmailConfig contains SMTP configuration for sending emails.
+// EmailConfig contains configuration for sending emails.
+//
+// Transport selects how emails are delivered: "smtp" (default) sends through
+// the SMTP server, "file" and "maildir" write messages into FileDir for local
+// development, and "memory" only records them for tests.
 type EmailConfig struct {
+	Transport string `mapstructure:"transport" yaml:"transport"`
+	FileDir   string `mapstructure:"file_dir" yaml:"file_dir"`
+
 	Host     string `mapstructure:"host" yaml:"host"`
 	Port     int    `mapstructure:"port" yaml:"port"`
 	Username string `mapstructure:"username" yaml:"username"`
 	Password string `mapstructure:"password" yaml:"password"`
 	From     string `mapstructure:"from" yaml:"from"`
+
+	Queue MailQueueConfig `mapstructure:"queue" yaml:"queue"`
+}
+
+// MailQueueConfig controls retries of the outbound mail queue.
+//
+// A failed message is retried after BaseBackoff, doubling on every attempt up
+// to MaxBackoff, and moved to the dead-letter list after MaxAttempts.
+type MailQueueConfig struct {
+	MaxAttempts int           `mapstructure:"max_attempts" yaml:"max_attempts"`
+	BaseBackoff time.Duration `mapstructure:"base_backoff" yaml:"base_backoff"`
+	MaxBackoff  time.Duration `mapstructure:"max_backoff" yaml:"max_backoff"`
+	// DeadLetterRetention is how long emails that ran out of attempts are
+	// kept for inspection and retry.
+	DeadLetterRetention time.Duration `mapstructure:"dead_letter_retention" yaml:"dead_letter_retention"`
 }
 
 // LLMConfig contains configuration for large language model integration.
@@ -135,3 +276,61 @@ type RustfsConfig struct {
 	SecretAccessKey string `mapstructure:"secret_access_key" yaml:"secret_access_key"`
 	Endpoint        string `mapstructure:"endpoint" yaml:"endpoint"`
 }
+
+// PostConfig contains settings for posts.
+type PostConfig struct {
+	Revisions RevisionConfig `mapstructure:"revisions" yaml:"revisions"`
+	Trash     TrashConfig    `mapstructure:"trash" yaml:"trash"`
+}
+
+// TrashConfig is the retention policy for deleted posts. Posts deleted more
+// than Retention ago are purged daily. Zero keeps them until purged by hand.
+type TrashConfig struct {
+	Retention time.Duration `mapstructure:"retention" yaml:"retention"`
+}
+
+// RevisionConfig is the retention policy for post revisions.
+//
+// A post keeps at most MaxPerPost revisions, and revisions older than MaxAge
+// are pruned daily. The latest revision of a post is always kept. Zero
+// disables a limit.
+type RevisionConfig struct {
+	MaxPerPost int           `mapstructure:"max_per_post" yaml:"max_per_post"`
+	MaxAge     time.Duration `mapstructure:"max_age" yaml:"max_age"`
+}
+
+// CommentConfig contains settings for reader comments.
+//
+// GuestSecret signs the tokens that let returning guests skip moderation and
+// must stay stable across restarts. Without it every guest comment is
+// moderated.
+type CommentConfig struct {
+	GuestSecret string              `mapstructure:"guest_secret" yaml:"guest_secret"`
+	Spam        SpamConfig          `mapstructure:"spam" yaml:"spam"`
+	Notify      CommentNotifyConfig `mapstructure:"notify" yaml:"notify"`
+}
+
+// CommentNotifyConfig controls email notifications about comments.
+//
+// UnsubscribeSecret signs the one-click unsubscribe links sent with reply
+// notifications and the links guests confirm them with, and must stay stable
+// across restarts.
+type CommentNotifyConfig struct {
+	Enabled           bool   `mapstructure:"enabled" yaml:"enabled"`
+	UnsubscribeSecret string `mapstructure:"unsubscribe_secret" yaml:"unsubscribe_secret"`
+}
+
+// SpamConfig controls the local spam scoring pipeline for new comments.
+//
+// A filter whose setting is zero or empty is left out of the chain. A comment
+// whose total score reaches Threshold is stored as spam.
+type SpamConfig struct {
+	Enabled         bool          `mapstructure:"enabled" yaml:"enabled"`
+	Threshold       int           `mapstructure:"threshold" yaml:"threshold"`
+	MaxLinks        int           `mapstructure:"max_links" yaml:"max_links"`
+	BannedWords     []string      `mapstructure:"banned_words" yaml:"banned_words"`
+	MinSubmitDelay  time.Duration `mapstructure:"min_submit_delay" yaml:"min_submit_delay"`
+	DuplicateWindow time.Duration `mapstructure:"duplicate_window" yaml:"duplicate_window"`
+	ReputationLimit int           `mapstructure:"reputation_limit" yaml:"reputation_limit"`
+	ReputationTTL   time.Duration `mapstructure:"reputation_ttl" yaml:"reputation_ttl"`
+}
@@ -1,6 +1,7 @@
 package config
 
 import (
+	"encoding/base64"
 	"fmt"
 	"strings"
 )
@@ -19,9 +20,67 @@ func validate(cfg *Config) error {
 	if cfg.Server.Port <= 0 || cfg.Server.Port > 65535 {
 		errs = append(errs, "server.port must be between 1 and 65535")
 	}
-	if cfg.App.IsProd() && cfg.JWT.Secret == "" {
+	switch cfg.JWT.Algorithm {
+	case "", "HS256", "EdDSA", "RS256":
+	default:
+		errs = append(errs, "jwt.algorithm must be one of HS256, EdDSA, RS256")
+	}
+	if cfg.App.IsProd() && cfg.JWT.UsesSharedSecret() && cfg.JWT.Secret == "" {
 		errs = append(errs, "jwt.secret is required in production (set JWT_SECRET env var)")
 	}
+	if cfg.App.IsProd() && !cfg.JWT.UsesSharedSecret() && cfg.JWT.KeyEncryptionKey == "" {
+		errs = append(errs, "jwt.key_encryption_key is required in production (set JWT_KEY_ENCRYPTION_KEY env var)")
+	}
+	if kek := cfg.JWT.KeyEncryptionKey; kek != "" {
+		if key, err := base64.StdEncoding.DecodeString(kek); err != nil || len(key) != 32 {
+			errs = append(errs, "jwt.key_encryption_key must be 32 bytes, base64 encoded")
+		}
+	}
+	for _, role := range cfg.Auth.TwoFactor.RequiredRoles {
+		switch role {
+		case "reader", "author", "editor", "admin":
+		default:
+			errs = append(errs, fmt.Sprintf("auth.two_factor.required_roles: unknown role %q", role))
+		}
+	}
+	names := make(map[string]bool)
+	for _, p := range cfg.Auth.OAuth.Providers {
+		if !p.Enabled() {
+			continue
+		}
+		switch {
+		case p.Name == "":
+			errs = append(errs, "auth.oauth.providers[].name is required")
+		case names[p.Name]:
+			errs = append(errs, fmt.Sprintf("auth.oauth provider %q is configured twice", p.Name))
+		}
+		names[p.Name] = true
+		switch p.Type {
+		case "", "oidc":
+			if p.Issuer == "" {
+				errs = append(errs, fmt.Sprintf("auth.oauth provider %q needs an issuer", p.Name))
+			}
+		case "github":
+		default:
+			errs = append(errs, fmt.Sprintf("auth.oauth provider %q: type must be oidc or github", p.Name))
+		}
+	}
+	for name, p := range cfg.RateLimit.Policies {
+		switch p.Key {
+		case "", "ip", "user", "route":
+		default:
+			errs = append(errs, fmt.Sprintf("rate_limit policy %q: key must be ip, user or route", name))
+		}
+		if p.Limit <= 0 || p.Period <= 0 || p.Burst < 0 {
+			errs = append(errs, fmt.Sprintf("rate_limit policy %q needs a positive limit and period", name))
+		}
+	}
+	if cfg.Post.Revisions.MaxPerPost < 0 || cfg.Post.Revisions.MaxAge < 0 {
+		errs = append(errs, "post.revisions limits must not be negative")
+	}
+	if cfg.Post.Trash.Retention < 0 {
+		errs = append(errs, "post.trash.retention must not be negative")
+	}
 	if cfg.App.IsProd() && cfg.Database.Password == "" {
 		errs = append(errs, "database.password is required in production (set DATABASE_PASSWORD env var)")
 	}
@@ -2,13 +2,25 @@ package contextx
 
 import (
 	"context"
+	"time"
 
 	"blog-server/authz"
 )
 
 type User struct {
-	ID   uint
-	Role authz.Role
+	ID        uint
+	Role      authz.Role
+	SessionID string
+	// TwoFactor reports whether the session passed a second factor at login.
+	TwoFactor bool
+
+	// TokenID and TokenExpiresAt identify the access token of the request.
+	TokenID        string
+	TokenExpiresAt time.Time
+
+	// AccessTokenID is set instead of the above when the request
+	// authenticated with a personal access token.
+	AccessTokenID uint
 }
 
 type contextKey string
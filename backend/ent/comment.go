@@ -4,6 +4,9 @@ package ent
 
 import (
 	"blog-server/ent/comment"
+	"blog-server/ent/post"
+	"blog-server/ent/user"
+	"blog-server/entity"
 	"fmt"
 	"strings"
 	"time"
@@ -22,17 +25,107 @@ type Comment struct {
 	// UpdatedAt holds the value of the "updated_at" field.
 	UpdatedAt time.Time `json:"updated_at,omitempty"`
 	// DeletedAt holds the value of the "deleted_at" field.
-	DeletedAt    *time.Time `json:"deleted_at,omitempty"`
+	DeletedAt *time.Time `json:"deleted_at,omitempty"`
+	// PostID holds the value of the "post_id" field.
+	PostID uint `json:"post_id,omitempty"`
+	// ParentID holds the value of the "parent_id" field.
+	ParentID *uint `json:"parent_id,omitempty"`
+	// RootID holds the value of the "root_id" field.
+	RootID *uint `json:"root_id,omitempty"`
+	// UserID holds the value of the "user_id" field.
+	UserID *uint `json:"user_id,omitempty"`
+	// AuthorName holds the value of the "author_name" field.
+	AuthorName string `json:"author_name,omitempty"`
+	// AuthorEmail holds the value of the "author_email" field.
+	AuthorEmail *string `json:"author_email,omitempty"`
+	// AuthorWebsite holds the value of the "author_website" field.
+	AuthorWebsite *string `json:"author_website,omitempty"`
+	// Content holds the value of the "content" field.
+	Content string `json:"content,omitempty"`
+	// Status holds the value of the "status" field.
+	Status entity.CommentStatus `json:"status,omitempty"`
+	// NotifyOnReply holds the value of the "notify_on_reply" field.
+	NotifyOnReply bool `json:"notify_on_reply,omitempty"`
+	// SpamReason holds the value of the "spam_reason" field.
+	SpamReason *string `json:"spam_reason,omitempty"`
+	// IP holds the value of the "ip" field.
+	IP string `json:"ip,omitempty"`
+	// UserAgent holds the value of the "user_agent" field.
+	UserAgent string `json:"user_agent,omitempty"`
+	// Edges holds the relations/edges for other nodes in the graph.
+	// The values are being populated by the CommentQuery when eager-loading is set.
+	Edges        CommentEdges `json:"edges"`
 	selectValues sql.SelectValues
 }
 
+// CommentEdges holds the relations/edges for other nodes in the graph.
+type CommentEdges struct {
+	// Post holds the value of the post edge.
+	Post *Post `json:"post,omitempty"`
+	// Author holds the value of the author edge.
+	Author *User `json:"author,omitempty"`
+	// Parent holds the value of the parent edge.
+	Parent *Comment `json:"parent,omitempty"`
+	// Replies holds the value of the replies edge.
+	Replies []*Comment `json:"replies,omitempty"`
+	// loadedTypes holds the information for reporting if a
+	// type was loaded (or requested) in eager-loading or not.
+	loadedTypes [4]bool
+}
+
+// PostOrErr returns the Post value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e CommentEdges) PostOrErr() (*Post, error) {
+	if e.Post != nil {
+		return e.Post, nil
+	} else if e.loadedTypes[0] {
+		return nil, &NotFoundError{label: post.Label}
+	}
+	return nil, &NotLoadedError{edge: "post"}
+}
+
+// AuthorOrErr returns the Author value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e CommentEdges) AuthorOrErr() (*User, error) {
+	if e.Author != nil {
+		return e.Author, nil
+	} else if e.loadedTypes[1] {
+		return nil, &NotFoundError{label: user.Label}
+	}
+	return nil, &NotLoadedError{edge: "author"}
+}
+
+// ParentOrErr returns the Parent value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e CommentEdges) ParentOrErr() (*Comment, error) {
+	if e.Parent != nil {
+		return e.Parent, nil
+	} else if e.loadedTypes[2] {
+		return nil, &NotFoundError{label: comment.Label}
+	}
+	return nil, &NotLoadedError{edge: "parent"}
+}
+
+// RepliesOrErr returns the Replies value or an error if the edge
+// was not loaded in eager-loading.
+func (e CommentEdges) RepliesOrErr() ([]*Comment, error) {
+	if e.loadedTypes[3] {
+		return e.Replies, nil
+	}
+	return nil, &NotLoadedError{edge: "replies"}
+}
+
 // scanValues returns the types for scanning values from sql.Rows.
 func (*Comment) scanValues(columns []string) ([]any, error) {
 	values := make([]any, len(columns))
 	for i := range columns {
 		switch columns[i] {
-		case comment.FieldID:
+		case comment.FieldNotifyOnReply:
+			values[i] = new(sql.NullBool)
+		case comment.FieldID, comment.FieldPostID, comment.FieldParentID, comment.FieldRootID, comment.FieldUserID:
 			values[i] = new(sql.NullInt64)
+		case comment.FieldAuthorName, comment.FieldAuthorEmail, comment.FieldAuthorWebsite, comment.FieldContent, comment.FieldStatus, comment.FieldSpamReason, comment.FieldIP, comment.FieldUserAgent:
+			values[i] = new(sql.NullString)
 		case comment.FieldCreatedAt, comment.FieldUpdatedAt, comment.FieldDeletedAt:
 			values[i] = new(sql.NullTime)
 		default:
@@ -75,6 +168,90 @@ func (_m *Comment) assignValues(columns []string, values []any) error {
 				_m.DeletedAt = new(time.Time)
 				*_m.DeletedAt = value.Time
 			}
+		case comment.FieldPostID:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field post_id", values[i])
+			} else if value.Valid {
+				_m.PostID = uint(value.Int64)
+			}
+		case comment.FieldParentID:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field parent_id", values[i])
+			} else if value.Valid {
+				_m.ParentID = new(uint)
+				*_m.ParentID = uint(value.Int64)
+			}
+		case comment.FieldRootID:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field root_id", values[i])
+			} else if value.Valid {
+				_m.RootID = new(uint)
+				*_m.RootID = uint(value.Int64)
+			}
+		case comment.FieldUserID:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field user_id", values[i])
+			} else if value.Valid {
+				_m.UserID = new(uint)
+				*_m.UserID = uint(value.Int64)
+			}
+		case comment.FieldAuthorName:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field author_name", values[i])
+			} else if value.Valid {
+				_m.AuthorName = value.String
+			}
+		case comment.FieldAuthorEmail:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field author_email", values[i])
+			} else if value.Valid {
+				_m.AuthorEmail = new(string)
+				*_m.AuthorEmail = value.String
+			}
+		case comment.FieldAuthorWebsite:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field author_website", values[i])
+			} else if value.Valid {
+				_m.AuthorWebsite = new(string)
+				*_m.AuthorWebsite = value.String
+			}
+		case comment.FieldContent:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field content", values[i])
+			} else if value.Valid {
+				_m.Content = value.String
+			}
+		case comment.FieldStatus:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field status", values[i])
+			} else if value.Valid {
+				_m.Status = entity.CommentStatus(value.String)
+			}
+		case comment.FieldNotifyOnReply:
+			if value, ok := values[i].(*sql.NullBool); !ok {
+				return fmt.Errorf("unexpected type %T for field notify_on_reply", values[i])
+			} else if value.Valid {
+				_m.NotifyOnReply = value.Bool
+			}
+		case comment.FieldSpamReason:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field spam_reason", values[i])
+			} else if value.Valid {
+				_m.SpamReason = new(string)
+				*_m.SpamReason = value.String
+			}
+		case comment.FieldIP:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field ip", values[i])
+			} else if value.Valid {
+				_m.IP = value.String
+			}
+		case comment.FieldUserAgent:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field user_agent", values[i])
+			} else if value.Valid {
+				_m.UserAgent = value.String
+			}
 		default:
 			_m.selectValues.Set(columns[i], values[i])
 		}
@@ -88,6 +265,26 @@ func (_m *Comment) Value(name string) (ent.Value, error) {
 	return _m.selectValues.Get(name)
 }
 
+// QueryPost queries the "post" edge of the Comment entity.
+func (_m *Comment) QueryPost() *PostQuery {
+	return NewCommentClient(_m.config).QueryPost(_m)
+}
+
+// QueryAuthor queries the "author" edge of the Comment entity.
+func (_m *Comment) QueryAuthor() *UserQuery {
+	return NewCommentClient(_m.config).QueryAuthor(_m)
+}
+
+// QueryParent queries the "parent" edge of the Comment entity.
+func (_m *Comment) QueryParent() *CommentQuery {
+	return NewCommentClient(_m.config).QueryParent(_m)
+}
+
+// QueryReplies queries the "replies" edge of the Comment entity.
+func (_m *Comment) QueryReplies() *CommentQuery {
+	return NewCommentClient(_m.config).QueryReplies(_m)
+}
+
 // Update returns a builder for updating this Comment.
 // Note that you need to call Comment.Unwrap() before calling this method if this Comment
 // was returned from a transaction, and the transaction was committed or rolled back.
@@ -121,6 +318,57 @@ func (_m *Comment) String() string {
 		builder.WriteString("deleted_at=")
 		builder.WriteString(v.Format(time.ANSIC))
 	}
+	builder.WriteString(", ")
+	builder.WriteString("post_id=")
+	builder.WriteString(fmt.Sprintf("%v", _m.PostID))
+	builder.WriteString(", ")
+	if v := _m.ParentID; v != nil {
+		builder.WriteString("parent_id=")
+		builder.WriteString(fmt.Sprintf("%v", *v))
+	}
+	builder.WriteString(", ")
+	if v := _m.RootID; v != nil {
+		builder.WriteString("root_id=")
+		builder.WriteString(fmt.Sprintf("%v", *v))
+	}
+	builder.WriteString(", ")
+	if v := _m.UserID; v != nil {
+		builder.WriteString("user_id=")
+		builder.WriteString(fmt.Sprintf("%v", *v))
+	}
+	builder.WriteString(", ")
+	builder.WriteString("author_name=")
+	builder.WriteString(_m.AuthorName)
+	builder.WriteString(", ")
+	if v := _m.AuthorEmail; v != nil {
+		builder.WriteString("author_email=")
+		builder.WriteString(*v)
+	}
+	builder.WriteString(", ")
+	if v := _m.AuthorWebsite; v != nil {
+		builder.WriteString("author_website=")
+		builder.WriteString(*v)
+	}
+	builder.WriteString(", ")
+	builder.WriteString("content=")
+	builder.WriteString(_m.Content)
+	builder.WriteString(", ")
+	builder.WriteString("status=")
+	builder.WriteString(fmt.Sprintf("%v", _m.Status))
+	builder.WriteString(", ")
+	builder.WriteString("notify_on_reply=")
+	builder.WriteString(fmt.Sprintf("%v", _m.NotifyOnReply))
+	builder.WriteString(", ")
+	if v := _m.SpamReason; v != nil {
+		builder.WriteString("spam_reason=")
+		builder.WriteString(*v)
+	}
+	builder.WriteString(", ")
+	builder.WriteString("ip=")
+	builder.WriteString(_m.IP)
+	builder.WriteString(", ")
+	builder.WriteString("user_agent=")
+	builder.WriteString(_m.UserAgent)
 	builder.WriteByte(')')
 	return builder.String()
 }
@@ -4,9 +4,11 @@ package comment
 
 import (
 	"blog-server/ent/predicate"
+	"blog-server/entity"
 	"time"
 
 	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
 )
 
 // ID filters vertices based on their ID field.
@@ -69,6 +71,66 @@ func DeletedAt(v time.Time) predicate.Comment {
 	return predicate.Comment(sql.FieldEQ(FieldDeletedAt, v))
 }
 
+// PostID applies equality check predicate on the "post_id" field. It's identical to PostIDEQ.
+func PostID(v uint) predicate.Comment {
+	return predicate.Comment(sql.FieldEQ(FieldPostID, v))
+}
+
+// ParentID applies equality check predicate on the "parent_id" field. It's identical to ParentIDEQ.
+func ParentID(v uint) predicate.Comment {
+	return predicate.Comment(sql.FieldEQ(FieldParentID, v))
+}
+
+// RootID applies equality check predicate on the "root_id" field. It's identical to RootIDEQ.
+func RootID(v uint) predicate.Comment {
+	return predicate.Comment(sql.FieldEQ(FieldRootID, v))
+}
+
+// UserID applies equality check predicate on the "user_id" field. It's identical to UserIDEQ.
+func UserID(v uint) predicate.Comment {
+	return predicate.Comment(sql.FieldEQ(FieldUserID, v))
+}
+
+// AuthorName applies equality check predicate on the "author_name" field. It's identical to AuthorNameEQ.
+func AuthorName(v string) predicate.Comment {
+	return predicate.Comment(sql.FieldEQ(FieldAuthorName, v))
+}
+
+// AuthorEmail applies equality check predicate on the "author_email" field. It's identical to AuthorEmailEQ.
+func AuthorEmail(v string) predicate.Comment {
+	return predicate.Comment(sql.FieldEQ(FieldAuthorEmail, v))
+}
+
+// AuthorWebsite applies equality check predicate on the "author_website" field. It's identical to AuthorWebsiteEQ.
+func AuthorWebsite(v string) predicate.Comment {
+	return predicate.Comment(sql.FieldEQ(FieldAuthorWebsite, v))
+}
+
+// Content applies equality check predicate on the "content" field. It's identical to ContentEQ.
+func Content(v string) predicate.Comment {
+	return predicate.Comment(sql.FieldEQ(FieldContent, v))
+}
+
+// NotifyOnReply applies equality check predicate on the "notify_on_reply" field. It's identical to NotifyOnReplyEQ.
+func NotifyOnReply(v bool) predicate.Comment {
+	return predicate.Comment(sql.FieldEQ(FieldNotifyOnReply, v))
+}
+
+// SpamReason applies equality check predicate on the "spam_reason" field. It's identical to SpamReasonEQ.
+func SpamReason(v string) predicate.Comment {
+	return predicate.Comment(sql.FieldEQ(FieldSpamReason, v))
+}
+
+// IP applies equality check predicate on the "ip" field. It's identical to IPEQ.
+func IP(v string) predicate.Comment {
+	return predicate.Comment(sql.FieldEQ(FieldIP, v))
+}
+
+// UserAgent applies equality check predicate on the "user_agent" field. It's identical to UserAgentEQ.
+func UserAgent(v string) predicate.Comment {
+	return predicate.Comment(sql.FieldEQ(FieldUserAgent, v))
+}
+
 // CreatedAtEQ applies the EQ predicate on the "created_at" field.
 func CreatedAtEQ(v time.Time) predicate.Comment {
 	return predicate.Comment(sql.FieldEQ(FieldCreatedAt, v))
@@ -199,6 +261,753 @@ func DeletedAtNotNil() predicate.Comment {
 	return predicate.Comment(sql.FieldNotNull(FieldDeletedAt))
 }
 
+// PostIDEQ applies the EQ predicate on the "post_id" field.
+func PostIDEQ(v uint) predicate.Comment {
+	return predicate.Comment(sql.FieldEQ(FieldPostID, v))
+}
+
+// PostIDNEQ applies the NEQ predicate on the "post_id" field.
+func PostIDNEQ(v uint) predicate.Comment {
+	return predicate.Comment(sql.FieldNEQ(FieldPostID, v))
+}
+
+// PostIDIn applies the In predicate on the "post_id" field.
+func PostIDIn(vs ...uint) predicate.Comment {
+	return predicate.Comment(sql.FieldIn(FieldPostID, vs...))
+}
+
+// PostIDNotIn applies the NotIn predicate on the "post_id" field.
+func PostIDNotIn(vs ...uint) predicate.Comment {
+	return predicate.Comment(sql.FieldNotIn(FieldPostID, vs...))
+}
+
+// ParentIDEQ applies the EQ predicate on the "parent_id" field.
+func ParentIDEQ(v uint) predicate.Comment {
+	return predicate.Comment(sql.FieldEQ(FieldParentID, v))
+}
+
+// ParentIDNEQ applies the NEQ predicate on the "parent_id" field.
+func ParentIDNEQ(v uint) predicate.Comment {
+	return predicate.Comment(sql.FieldNEQ(FieldParentID, v))
+}
+
+// ParentIDIn applies the In predicate on the "parent_id" field.
+func ParentIDIn(vs ...uint) predicate.Comment {
+	return predicate.Comment(sql.FieldIn(FieldParentID, vs...))
+}
+
+// ParentIDNotIn applies the NotIn predicate on the "parent_id" field.
+func ParentIDNotIn(vs ...uint) predicate.Comment {
+	return predicate.Comment(sql.FieldNotIn(FieldParentID, vs...))
+}
+
+// ParentIDIsNil applies the IsNil predicate on the "parent_id" field.
+func ParentIDIsNil() predicate.Comment {
+	return predicate.Comment(sql.FieldIsNull(FieldParentID))
+}
+
+// ParentIDNotNil applies the NotNil predicate on the "parent_id" field.
+func ParentIDNotNil() predicate.Comment {
+	return predicate.Comment(sql.FieldNotNull(FieldParentID))
+}
+
+// RootIDEQ applies the EQ predicate on the "root_id" field.
+func RootIDEQ(v uint) predicate.Comment {
+	return predicate.Comment(sql.FieldEQ(FieldRootID, v))
+}
+
+// RootIDNEQ applies the NEQ predicate on the "root_id" field.
+func RootIDNEQ(v uint) predicate.Comment {
+	return predicate.Comment(sql.FieldNEQ(FieldRootID, v))
+}
+
+// RootIDIn applies the In predicate on the "root_id" field.
+func RootIDIn(vs ...uint) predicate.Comment {
+	return predicate.Comment(sql.FieldIn(FieldRootID, vs...))
+}
+
+// RootIDNotIn applies the NotIn predicate on the "root_id" field.
+func RootIDNotIn(vs ...uint) predicate.Comment {
+	return predicate.Comment(sql.FieldNotIn(FieldRootID, vs...))
+}
+
+// RootIDGT applies the GT predicate on the "root_id" field.
+func RootIDGT(v uint) predicate.Comment {
+	return predicate.Comment(sql.FieldGT(FieldRootID, v))
+}
+
+// RootIDGTE applies the GTE predicate on the "root_id" field.
+func RootIDGTE(v uint) predicate.Comment {
+	return predicate.Comment(sql.FieldGTE(FieldRootID, v))
+}
+
+// RootIDLT applies the LT predicate on the "root_id" field.
+func RootIDLT(v uint) predicate.Comment {
+	return predicate.Comment(sql.FieldLT(FieldRootID, v))
+}
+
+// RootIDLTE applies the LTE predicate on the "root_id" field.
+func RootIDLTE(v uint) predicate.Comment {
+	return predicate.Comment(sql.FieldLTE(FieldRootID, v))
+}
+
+// RootIDIsNil applies the IsNil predicate on the "root_id" field.
+func RootIDIsNil() predicate.Comment {
+	return predicate.Comment(sql.FieldIsNull(FieldRootID))
+}
+
+// RootIDNotNil applies the NotNil predicate on the "root_id" field.
+func RootIDNotNil() predicate.Comment {
+	return predicate.Comment(sql.FieldNotNull(FieldRootID))
+}
+
+// UserIDEQ applies the EQ predicate on the "user_id" field.
+func UserIDEQ(v uint) predicate.Comment {
+	return predicate.Comment(sql.FieldEQ(FieldUserID, v))
+}
+
+// UserIDNEQ applies the NEQ predicate on the "user_id" field.
+func UserIDNEQ(v uint) predicate.Comment {
+	return predicate.Comment(sql.FieldNEQ(FieldUserID, v))
+}
+
+// UserIDIn applies the In predicate on the "user_id" field.
+func UserIDIn(vs ...uint) predicate.Comment {
+	return predicate.Comment(sql.FieldIn(FieldUserID, vs...))
+}
+
+// UserIDNotIn applies the NotIn predicate on the "user_id" field.
+func UserIDNotIn(vs ...uint) predicate.Comment {
+	return predicate.Comment(sql.FieldNotIn(FieldUserID, vs...))
+}
+
+// UserIDIsNil applies the IsNil predicate on the "user_id" field.
+func UserIDIsNil() predicate.Comment {
+	return predicate.Comment(sql.FieldIsNull(FieldUserID))
+}
+
+// UserIDNotNil applies the NotNil predicate on the "user_id" field.
+func UserIDNotNil() predicate.Comment {
+	return predicate.Comment(sql.FieldNotNull(FieldUserID))
+}
+
+// AuthorNameEQ applies the EQ predicate on the "author_name" field.
+func AuthorNameEQ(v string) predicate.Comment {
+	return predicate.Comment(sql.FieldEQ(FieldAuthorName, v))
+}
+
+// AuthorNameNEQ applies the NEQ predicate on the "author_name" field.
+func AuthorNameNEQ(v string) predicate.Comment {
+	return predicate.Comment(sql.FieldNEQ(FieldAuthorName, v))
+}
+
+// AuthorNameIn applies the In predicate on the "author_name" field.
+func AuthorNameIn(vs ...string) predicate.Comment {
+	return predicate.Comment(sql.FieldIn(FieldAuthorName, vs...))
+}
+
+// AuthorNameNotIn applies the NotIn predicate on the "author_name" field.
+func AuthorNameNotIn(vs ...string) predicate.Comment {
+	return predicate.Comment(sql.FieldNotIn(FieldAuthorName, vs...))
+}
+
+// AuthorNameGT applies the GT predicate on the "author_name" field.
+func AuthorNameGT(v string) predicate.Comment {
+	return predicate.Comment(sql.FieldGT(FieldAuthorName, v))
+}
+
+// AuthorNameGTE applies the GTE predicate on the "author_name" field.
+func AuthorNameGTE(v string) predicate.Comment {
+	return predicate.Comment(sql.FieldGTE(FieldAuthorName, v))
+}
+
+// AuthorNameLT applies the LT predicate on the "author_name" field.
+func AuthorNameLT(v string) predicate.Comment {
+	return predicate.Comment(sql.FieldLT(FieldAuthorName, v))
+}
+
+// AuthorNameLTE applies the LTE predicate on the "author_name" field.
+func AuthorNameLTE(v string) predicate.Comment {
+	return predicate.Comment(sql.FieldLTE(FieldAuthorName, v))
+}
+
+// AuthorNameContains applies the Contains predicate on the "author_name" field.
+func AuthorNameContains(v string) predicate.Comment {
+	return predicate.Comment(sql.FieldContains(FieldAuthorName, v))
+}
+
+// AuthorNameHasPrefix applies the HasPrefix predicate on the "author_name" field.
+func AuthorNameHasPrefix(v string) predicate.Comment {
+	return predicate.Comment(sql.FieldHasPrefix(FieldAuthorName, v))
+}
+
+// AuthorNameHasSuffix applies the HasSuffix predicate on the "author_name" field.
+func AuthorNameHasSuffix(v string) predicate.Comment {
+	return predicate.Comment(sql.FieldHasSuffix(FieldAuthorName, v))
+}
+
+// AuthorNameEqualFold applies the EqualFold predicate on the "author_name" field.
+func AuthorNameEqualFold(v string) predicate.Comment {
+	return predicate.Comment(sql.FieldEqualFold(FieldAuthorName, v))
+}
+
+// AuthorNameContainsFold applies the ContainsFold predicate on the "author_name" field.
+func AuthorNameContainsFold(v string) predicate.Comment {
+	return predicate.Comment(sql.FieldContainsFold(FieldAuthorName, v))
+}
+
+// AuthorEmailEQ applies the EQ predicate on the "author_email" field.
+func AuthorEmailEQ(v string) predicate.Comment {
+	return predicate.Comment(sql.FieldEQ(FieldAuthorEmail, v))
+}
+
+// AuthorEmailNEQ applies the NEQ predicate on the "author_email" field.
+func AuthorEmailNEQ(v string) predicate.Comment {
+	return predicate.Comment(sql.FieldNEQ(FieldAuthorEmail, v))
+}
+
+// AuthorEmailIn applies the In predicate on the "author_email" field.
+func AuthorEmailIn(vs ...string) predicate.Comment {
+	return predicate.Comment(sql.FieldIn(FieldAuthorEmail, vs...))
+}
+
+// AuthorEmailNotIn applies the NotIn predicate on the "author_email" field.
+func AuthorEmailNotIn(vs ...string) predicate.Comment {
+	return predicate.Comment(sql.FieldNotIn(FieldAuthorEmail, vs...))
+}
+
+// AuthorEmailGT applies the GT predicate on the "author_email" field.
+func AuthorEmailGT(v string) predicate.Comment {
+	return predicate.Comment(sql.FieldGT(FieldAuthorEmail, v))
+}
+
+// AuthorEmailGTE applies the GTE predicate on the "author_email" field.
+func AuthorEmailGTE(v string) predicate.Comment {
+	return predicate.Comment(sql.FieldGTE(FieldAuthorEmail, v))
+}
+
+// AuthorEmailLT applies the LT predicate on the "author_email" field.
+func AuthorEmailLT(v string) predicate.Comment {
+	return predicate.Comment(sql.FieldLT(FieldAuthorEmail, v))
+}
+
+// AuthorEmailLTE applies the LTE predicate on the "author_email" field.
+func AuthorEmailLTE(v string) predicate.Comment {
+	return predicate.Comment(sql.FieldLTE(FieldAuthorEmail, v))
+}
+
+// AuthorEmailContains applies the Contains predicate on the "author_email" field.
+func AuthorEmailContains(v string) predicate.Comment {
+	return predicate.Comment(sql.FieldContains(FieldAuthorEmail, v))
+}
+
+// AuthorEmailHasPrefix applies the HasPrefix predicate on the "author_email" field.
+func AuthorEmailHasPrefix(v string) predicate.Comment {
+	return predicate.Comment(sql.FieldHasPrefix(FieldAuthorEmail, v))
+}
+
+// AuthorEmailHasSuffix applies the HasSuffix predicate on the "author_email" field.
+func AuthorEmailHasSuffix(v string) predicate.Comment {
+	return predicate.Comment(sql.FieldHasSuffix(FieldAuthorEmail, v))
+}
+
+// AuthorEmailIsNil applies the IsNil predicate on the "author_email" field.
+func AuthorEmailIsNil() predicate.Comment {
+	return predicate.Comment(sql.FieldIsNull(FieldAuthorEmail))
+}
+
+// AuthorEmailNotNil applies the NotNil predicate on the "author_email" field.
+func AuthorEmailNotNil() predicate.Comment {
+	return predicate.Comment(sql.FieldNotNull(FieldAuthorEmail))
+}
+
+// AuthorEmailEqualFold applies the EqualFold predicate on the "author_email" field.
+func AuthorEmailEqualFold(v string) predicate.Comment {
+	return predicate.Comment(sql.FieldEqualFold(FieldAuthorEmail, v))
+}
+
+// AuthorEmailContainsFold applies the ContainsFold predicate on the "author_email" field.
+func AuthorEmailContainsFold(v string) predicate.Comment {
+	return predicate.Comment(sql.FieldContainsFold(FieldAuthorEmail, v))
+}
+
+// AuthorWebsiteEQ applies the EQ predicate on the "author_website" field.
+func AuthorWebsiteEQ(v string) predicate.Comment {
+	return predicate.Comment(sql.FieldEQ(FieldAuthorWebsite, v))
+}
+
+// AuthorWebsiteNEQ applies the NEQ predicate on the "author_website" field.
+func AuthorWebsiteNEQ(v string) predicate.Comment {
+	return predicate.Comment(sql.FieldNEQ(FieldAuthorWebsite, v))
+}
+
+// AuthorWebsiteIn applies the In predicate on the "author_website" field.
+func AuthorWebsiteIn(vs ...string) predicate.Comment {
+	return predicate.Comment(sql.FieldIn(FieldAuthorWebsite, vs...))
+}
+
+// AuthorWebsiteNotIn applies the NotIn predicate on the "author_website" field.
+func AuthorWebsiteNotIn(vs ...string) predicate.Comment {
+	return predicate.Comment(sql.FieldNotIn(FieldAuthorWebsite, vs...))
+}
+
+// AuthorWebsiteGT applies the GT predicate on the "author_website" field.
+func AuthorWebsiteGT(v string) predicate.Comment {
+	return predicate.Comment(sql.FieldGT(FieldAuthorWebsite, v))
+}
+
+// AuthorWebsiteGTE applies the GTE predicate on the "author_website" field.
+func AuthorWebsiteGTE(v string) predicate.Comment {
+	return predicate.Comment(sql.FieldGTE(FieldAuthorWebsite, v))
+}
+
+// AuthorWebsiteLT applies the LT predicate on the "author_website" field.
+func AuthorWebsiteLT(v string) predicate.Comment {
+	return predicate.Comment(sql.FieldLT(FieldAuthorWebsite, v))
+}
+
+// AuthorWebsiteLTE applies the LTE predicate on the "author_website" field.
+func AuthorWebsiteLTE(v string) predicate.Comment {
+	return predicate.Comment(sql.FieldLTE(FieldAuthorWebsite, v))
+}
+
+// AuthorWebsiteContains applies the Contains predicate on the "author_website" field.
+func AuthorWebsiteContains(v string) predicate.Comment {
+	return predicate.Comment(sql.FieldContains(FieldAuthorWebsite, v))
+}
+
+// AuthorWebsiteHasPrefix applies the HasPrefix predicate on the "author_website" field.
+func AuthorWebsiteHasPrefix(v string) predicate.Comment {
+	return predicate.Comment(sql.FieldHasPrefix(FieldAuthorWebsite, v))
+}
+
+// AuthorWebsiteHasSuffix applies the HasSuffix predicate on the "author_website" field.
+func AuthorWebsiteHasSuffix(v string) predicate.Comment {
+	return predicate.Comment(sql.FieldHasSuffix(FieldAuthorWebsite, v))
+}
+
+// AuthorWebsiteIsNil applies the IsNil predicate on the "author_website" field.
+func AuthorWebsiteIsNil() predicate.Comment {
+	return predicate.Comment(sql.FieldIsNull(FieldAuthorWebsite))
+}
+
+// AuthorWebsiteNotNil applies the NotNil predicate on the "author_website" field.
+func AuthorWebsiteNotNil() predicate.Comment {
+	return predicate.Comment(sql.FieldNotNull(FieldAuthorWebsite))
+}
+
+// AuthorWebsiteEqualFold applies the EqualFold predicate on the "author_website" field.
+func AuthorWebsiteEqualFold(v string) predicate.Comment {
+	return predicate.Comment(sql.FieldEqualFold(FieldAuthorWebsite, v))
+}
+
+// AuthorWebsiteContainsFold applies the ContainsFold predicate on the "author_website" field.
+func AuthorWebsiteContainsFold(v string) predicate.Comment {
+	return predicate.Comment(sql.FieldContainsFold(FieldAuthorWebsite, v))
+}
+
+// ContentEQ applies the EQ predicate on the "content" field.
+func ContentEQ(v string) predicate.Comment {
+	return predicate.Comment(sql.FieldEQ(FieldContent, v))
+}
+
+// ContentNEQ applies the NEQ predicate on the "content" field.
+func ContentNEQ(v string) predicate.Comment {
+	return predicate.Comment(sql.FieldNEQ(FieldContent, v))
+}
+
+// ContentIn applies the In predicate on the "content" field.
+func ContentIn(vs ...string) predicate.Comment {
+	return predicate.Comment(sql.FieldIn(FieldContent, vs...))
+}
+
+// ContentNotIn applies the NotIn predicate on the "content" field.
+func ContentNotIn(vs ...string) predicate.Comment {
+	return predicate.Comment(sql.FieldNotIn(FieldContent, vs...))
+}
+
+// ContentGT applies the GT predicate on the "content" field.
+func ContentGT(v string) predicate.Comment {
+	return predicate.Comment(sql.FieldGT(FieldContent, v))
+}
+
+// ContentGTE applies the GTE predicate on the "content" field.
+func ContentGTE(v string) predicate.Comment {
+	return predicate.Comment(sql.FieldGTE(FieldContent, v))
+}
+
+// ContentLT applies the LT predicate on the "content" field.
+func ContentLT(v string) predicate.Comment {
+	return predicate.Comment(sql.FieldLT(FieldContent, v))
+}
+
+// ContentLTE applies the LTE predicate on the "content" field.
+func ContentLTE(v string) predicate.Comment {
+	return predicate.Comment(sql.FieldLTE(FieldContent, v))
+}
+
+// ContentContains applies the Contains predicate on the "content" field.
+func ContentContains(v string) predicate.Comment {
+	return predicate.Comment(sql.FieldContains(FieldContent, v))
+}
+
+// ContentHasPrefix applies the HasPrefix predicate on the "content" field.
+func ContentHasPrefix(v string) predicate.Comment {
+	return predicate.Comment(sql.FieldHasPrefix(FieldContent, v))
+}
+
+// ContentHasSuffix applies the HasSuffix predicate on the "content" field.
+func ContentHasSuffix(v string) predicate.Comment {
+	return predicate.Comment(sql.FieldHasSuffix(FieldContent, v))
+}
+
+// ContentEqualFold applies the EqualFold predicate on the "content" field.
+func ContentEqualFold(v string) predicate.Comment {
+	return predicate.Comment(sql.FieldEqualFold(FieldContent, v))
+}
+
+// ContentContainsFold applies the ContainsFold predicate on the "content" field.
+func ContentContainsFold(v string) predicate.Comment {
+	return predicate.Comment(sql.FieldContainsFold(FieldContent, v))
+}
+
+// StatusEQ applies the EQ predicate on the "status" field.
+func StatusEQ(v entity.CommentStatus) predicate.Comment {
+	vc := v
+	return predicate.Comment(sql.FieldEQ(FieldStatus, vc))
+}
+
+// StatusNEQ applies the NEQ predicate on the "status" field.
+func StatusNEQ(v entity.CommentStatus) predicate.Comment {
+	vc := v
+	return predicate.Comment(sql.FieldNEQ(FieldStatus, vc))
+}
+
+// StatusIn applies the In predicate on the "status" field.
+func StatusIn(vs ...entity.CommentStatus) predicate.Comment {
+	v := make([]any, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.Comment(sql.FieldIn(FieldStatus, v...))
+}
+
+// StatusNotIn applies the NotIn predicate on the "status" field.
+func StatusNotIn(vs ...entity.CommentStatus) predicate.Comment {
+	v := make([]any, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.Comment(sql.FieldNotIn(FieldStatus, v...))
+}
+
+// NotifyOnReplyEQ applies the EQ predicate on the "notify_on_reply" field.
+func NotifyOnReplyEQ(v bool) predicate.Comment {
+	return predicate.Comment(sql.FieldEQ(FieldNotifyOnReply, v))
+}
+
+// NotifyOnReplyNEQ applies the NEQ predicate on the "notify_on_reply" field.
+func NotifyOnReplyNEQ(v bool) predicate.Comment {
+	return predicate.Comment(sql.FieldNEQ(FieldNotifyOnReply, v))
+}
+
+// SpamReasonEQ applies the EQ predicate on the "spam_reason" field.
+func SpamReasonEQ(v string) predicate.Comment {
+	return predicate.Comment(sql.FieldEQ(FieldSpamReason, v))
+}
+
+// SpamReasonNEQ applies the NEQ predicate on the "spam_reason" field.
+func SpamReasonNEQ(v string) predicate.Comment {
+	return predicate.Comment(sql.FieldNEQ(FieldSpamReason, v))
+}
+
+// SpamReasonIn applies the In predicate on the "spam_reason" field.
+func SpamReasonIn(vs ...string) predicate.Comment {
+	return predicate.Comment(sql.FieldIn(FieldSpamReason, vs...))
+}
+
+// SpamReasonNotIn applies the NotIn predicate on the "spam_reason" field.
+func SpamReasonNotIn(vs ...string) predicate.Comment {
+	return predicate.Comment(sql.FieldNotIn(FieldSpamReason, vs...))
+}
+
+// SpamReasonGT applies the GT predicate on the "spam_reason" field.
+func SpamReasonGT(v string) predicate.Comment {
+	return predicate.Comment(sql.FieldGT(FieldSpamReason, v))
+}
+
+// SpamReasonGTE applies the GTE predicate on the "spam_reason" field.
+func SpamReasonGTE(v string) predicate.Comment {
+	return predicate.Comment(sql.FieldGTE(FieldSpamReason, v))
+}
+
+// SpamReasonLT applies the LT predicate on the "spam_reason" field.
+func SpamReasonLT(v string) predicate.Comment {
+	return predicate.Comment(sql.FieldLT(FieldSpamReason, v))
+}
+
+// SpamReasonLTE applies the LTE predicate on the "spam_reason" field.
+func SpamReasonLTE(v string) predicate.Comment {
+	return predicate.Comment(sql.FieldLTE(FieldSpamReason, v))
+}
+
+// SpamReasonContains applies the Contains predicate on the "spam_reason" field.
+func SpamReasonContains(v string) predicate.Comment {
+	return predicate.Comment(sql.FieldContains(FieldSpamReason, v))
+}
+
+// SpamReasonHasPrefix applies the HasPrefix predicate on the "spam_reason" field.
+func SpamReasonHasPrefix(v string) predicate.Comment {
+	return predicate.Comment(sql.FieldHasPrefix(FieldSpamReason, v))
+}
+
+// SpamReasonHasSuffix applies the HasSuffix predicate on the "spam_reason" field.
+func SpamReasonHasSuffix(v string) predicate.Comment {
+	return predicate.Comment(sql.FieldHasSuffix(FieldSpamReason, v))
+}
+
+// SpamReasonIsNil applies the IsNil predicate on the "spam_reason" field.
+func SpamReasonIsNil() predicate.Comment {
+	return predicate.Comment(sql.FieldIsNull(FieldSpamReason))
+}
+
+// SpamReasonNotNil applies the NotNil predicate on the "spam_reason" field.
+func SpamReasonNotNil() predicate.Comment {
+	return predicate.Comment(sql.FieldNotNull(FieldSpamReason))
+}
+
+// SpamReasonEqualFold applies the EqualFold predicate on the "spam_reason" field.
+func SpamReasonEqualFold(v string) predicate.Comment {
+	return predicate.Comment(sql.FieldEqualFold(FieldSpamReason, v))
+}
+
+// SpamReasonContainsFold applies the ContainsFold predicate on the "spam_reason" field.
+func SpamReasonContainsFold(v string) predicate.Comment {
+	return predicate.Comment(sql.FieldContainsFold(FieldSpamReason, v))
+}
+
+// IPEQ applies the EQ predicate on the "ip" field.
+func IPEQ(v string) predicate.Comment {
+	return predicate.Comment(sql.FieldEQ(FieldIP, v))
+}
+
+// IPNEQ applies the NEQ predicate on the "ip" field.
+func IPNEQ(v string) predicate.Comment {
+	return predicate.Comment(sql.FieldNEQ(FieldIP, v))
+}
+
+// IPIn applies the In predicate on the "ip" field.
+func IPIn(vs ...string) predicate.Comment {
+	return predicate.Comment(sql.FieldIn(FieldIP, vs...))
+}
+
+// IPNotIn applies the NotIn predicate on the "ip" field.
+func IPNotIn(vs ...string) predicate.Comment {
+	return predicate.Comment(sql.FieldNotIn(FieldIP, vs...))
+}
+
+// IPGT applies the GT predicate on the "ip" field.
+func IPGT(v string) predicate.Comment {
+	return predicate.Comment(sql.FieldGT(FieldIP, v))
+}
+
+// IPGTE applies the GTE predicate on the "ip" field.
+func IPGTE(v string) predicate.Comment {
+	return predicate.Comment(sql.FieldGTE(FieldIP, v))
+}
+
+// IPLT applies the LT predicate on the "ip" field.
+func IPLT(v string) predicate.Comment {
+	return predicate.Comment(sql.FieldLT(FieldIP, v))
+}
+
+// IPLTE applies the LTE predicate on the "ip" field.
+func IPLTE(v string) predicate.Comment {
+	return predicate.Comment(sql.FieldLTE(FieldIP, v))
+}
+
+// IPContains applies the Contains predicate on the "ip" field.
+func IPContains(v string) predicate.Comment {
+	return predicate.Comment(sql.FieldContains(FieldIP, v))
+}
+
+// IPHasPrefix applies the HasPrefix predicate on the "ip" field.
+func IPHasPrefix(v string) predicate.Comment {
+	return predicate.Comment(sql.FieldHasPrefix(FieldIP, v))
+}
+
+// IPHasSuffix applies the HasSuffix predicate on the "ip" field.
+func IPHasSuffix(v string) predicate.Comment {
+	return predicate.Comment(sql.FieldHasSuffix(FieldIP, v))
+}
+
+// IPEqualFold applies the EqualFold predicate on the "ip" field.
+func IPEqualFold(v string) predicate.Comment {
+	return predicate.Comment(sql.FieldEqualFold(FieldIP, v))
+}
+
+// IPContainsFold applies the ContainsFold predicate on the "ip" field.
+func IPContainsFold(v string) predicate.Comment {
+	return predicate.Comment(sql.FieldContainsFold(FieldIP, v))
+}
+
+// UserAgentEQ applies the EQ predicate on the "user_agent" field.
+func UserAgentEQ(v string) predicate.Comment {
+	return predicate.Comment(sql.FieldEQ(FieldUserAgent, v))
+}
+
+// UserAgentNEQ applies the NEQ predicate on the "user_agent" field.
+func UserAgentNEQ(v string) predicate.Comment {
+	return predicate.Comment(sql.FieldNEQ(FieldUserAgent, v))
+}
+
+// UserAgentIn applies the In predicate on the "user_agent" field.
+func UserAgentIn(vs ...string) predicate.Comment {
+	return predicate.Comment(sql.FieldIn(FieldUserAgent, vs...))
+}
+
+// UserAgentNotIn applies the NotIn predicate on the "user_agent" field.
+func UserAgentNotIn(vs ...string) predicate.Comment {
+	return predicate.Comment(sql.FieldNotIn(FieldUserAgent, vs...))
+}
+
+// UserAgentGT applies the GT predicate on the "user_agent" field.
+func UserAgentGT(v string) predicate.Comment {
+	return predicate.Comment(sql.FieldGT(FieldUserAgent, v))
+}
+
+// UserAgentGTE applies the GTE predicate on the "user_agent" field.
+func UserAgentGTE(v string) predicate.Comment {
+	return predicate.Comment(sql.FieldGTE(FieldUserAgent, v))
+}
+
+// UserAgentLT applies the LT predicate on the "user_agent" field.
+func UserAgentLT(v string) predicate.Comment {
+	return predicate.Comment(sql.FieldLT(FieldUserAgent, v))
+}
+
+// UserAgentLTE applies the LTE predicate on the "user_agent" field.
+func UserAgentLTE(v string) predicate.Comment {
+	return predicate.Comment(sql.FieldLTE(FieldUserAgent, v))
+}
+
+// UserAgentContains applies the Contains predicate on the "user_agent" field.
+func UserAgentContains(v string) predicate.Comment {
+	return predicate.Comment(sql.FieldContains(FieldUserAgent, v))
+}
+
+// UserAgentHasPrefix applies the HasPrefix predicate on the "user_agent" field.
+func UserAgentHasPrefix(v string) predicate.Comment {
+	return predicate.Comment(sql.FieldHasPrefix(FieldUserAgent, v))
+}
+
+// UserAgentHasSuffix applies the HasSuffix predicate on the "user_agent" field.
+func UserAgentHasSuffix(v string) predicate.Comment {
+	return predicate.Comment(sql.FieldHasSuffix(FieldUserAgent, v))
+}
+
+// UserAgentEqualFold applies the EqualFold predicate on the "user_agent" field.
+func UserAgentEqualFold(v string) predicate.Comment {
+	return predicate.Comment(sql.FieldEqualFold(FieldUserAgent, v))
+}
+
+// UserAgentContainsFold applies the ContainsFold predicate on the "user_agent" field.
+func UserAgentContainsFold(v string) predicate.Comment {
+	return predicate.Comment(sql.FieldContainsFold(FieldUserAgent, v))
+}
+
+// HasPost applies the HasEdge predicate on the "post" edge.
+func HasPost() predicate.Comment {
+	return predicate.Comment(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, PostTable, PostColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasPostWith applies the HasEdge predicate on the "post" edge with a given conditions (other predicates).
+func HasPostWith(preds ...predicate.Post) predicate.Comment {
+	return predicate.Comment(func(s *sql.Selector) {
+		step := newPostStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// HasAuthor applies the HasEdge predicate on the "author" edge.
+func HasAuthor() predicate.Comment {
+	return predicate.Comment(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, AuthorTable, AuthorColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasAuthorWith applies the HasEdge predicate on the "author" edge with a given conditions (other predicates).
+func HasAuthorWith(preds ...predicate.User) predicate.Comment {
+	return predicate.Comment(func(s *sql.Selector) {
+		step := newAuthorStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// HasParent applies the HasEdge predicate on the "parent" edge.
+func HasParent() predicate.Comment {
+	return predicate.Comment(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, ParentTable, ParentColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasParentWith applies the HasEdge predicate on the "parent" edge with a given conditions (other predicates).
+func HasParentWith(preds ...predicate.Comment) predicate.Comment {
+	return predicate.Comment(func(s *sql.Selector) {
+		step := newParentStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// HasReplies applies the HasEdge predicate on the "replies" edge.
+func HasReplies() predicate.Comment {
+	return predicate.Comment(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, RepliesTable, RepliesColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasRepliesWith applies the HasEdge predicate on the "replies" edge with a given conditions (other predicates).
+func HasRepliesWith(preds ...predicate.Comment) predicate.Comment {
+	return predicate.Comment(func(s *sql.Selector) {
+		step := newRepliesStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
 // And groups predicates with the AND operator between them.
 func And(predicates ...predicate.Comment) predicate.Comment {
 	return predicate.Comment(sql.AndPredicates(predicates...))
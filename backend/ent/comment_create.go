@@ -4,6 +4,9 @@ package ent
 
 import (
 	"blog-server/ent/comment"
+	"blog-server/ent/post"
+	"blog-server/ent/user"
+	"blog-server/entity"
 	"context"
 	"errors"
 	"fmt"
@@ -64,12 +67,214 @@ func (_c *CommentCreate) SetNillableDeletedAt(v *time.Time) *CommentCreate {
 	return _c
 }
 
+// SetPostID sets the "post_id" field.
+func (_c *CommentCreate) SetPostID(v uint) *CommentCreate {
+	_c.mutation.SetPostID(v)
+	return _c
+}
+
+// SetParentID sets the "parent_id" field.
+func (_c *CommentCreate) SetParentID(v uint) *CommentCreate {
+	_c.mutation.SetParentID(v)
+	return _c
+}
+
+// SetNillableParentID sets the "parent_id" field if the given value is not nil.
+func (_c *CommentCreate) SetNillableParentID(v *uint) *CommentCreate {
+	if v != nil {
+		_c.SetParentID(*v)
+	}
+	return _c
+}
+
+// SetRootID sets the "root_id" field.
+func (_c *CommentCreate) SetRootID(v uint) *CommentCreate {
+	_c.mutation.SetRootID(v)
+	return _c
+}
+
+// SetNillableRootID sets the "root_id" field if the given value is not nil.
+func (_c *CommentCreate) SetNillableRootID(v *uint) *CommentCreate {
+	if v != nil {
+		_c.SetRootID(*v)
+	}
+	return _c
+}
+
+// SetUserID sets the "user_id" field.
+func (_c *CommentCreate) SetUserID(v uint) *CommentCreate {
+	_c.mutation.SetUserID(v)
+	return _c
+}
+
+// SetNillableUserID sets the "user_id" field if the given value is not nil.
+func (_c *CommentCreate) SetNillableUserID(v *uint) *CommentCreate {
+	if v != nil {
+		_c.SetUserID(*v)
+	}
+	return _c
+}
+
+// SetAuthorName sets the "author_name" field.
+func (_c *CommentCreate) SetAuthorName(v string) *CommentCreate {
+	_c.mutation.SetAuthorName(v)
+	return _c
+}
+
+// SetAuthorEmail sets the "author_email" field.
+func (_c *CommentCreate) SetAuthorEmail(v string) *CommentCreate {
+	_c.mutation.SetAuthorEmail(v)
+	return _c
+}
+
+// SetNillableAuthorEmail sets the "author_email" field if the given value is not nil.
+func (_c *CommentCreate) SetNillableAuthorEmail(v *string) *CommentCreate {
+	if v != nil {
+		_c.SetAuthorEmail(*v)
+	}
+	return _c
+}
+
+// SetAuthorWebsite sets the "author_website" field.
+func (_c *CommentCreate) SetAuthorWebsite(v string) *CommentCreate {
+	_c.mutation.SetAuthorWebsite(v)
+	return _c
+}
+
+// SetNillableAuthorWebsite sets the "author_website" field if the given value is not nil.
+func (_c *CommentCreate) SetNillableAuthorWebsite(v *string) *CommentCreate {
+	if v != nil {
+		_c.SetAuthorWebsite(*v)
+	}
+	return _c
+}
+
+// SetContent sets the "content" field.
+func (_c *CommentCreate) SetContent(v string) *CommentCreate {
+	_c.mutation.SetContent(v)
+	return _c
+}
+
+// SetStatus sets the "status" field.
+func (_c *CommentCreate) SetStatus(v entity.CommentStatus) *CommentCreate {
+	_c.mutation.SetStatus(v)
+	return _c
+}
+
+// SetNillableStatus sets the "status" field if the given value is not nil.
+func (_c *CommentCreate) SetNillableStatus(v *entity.CommentStatus) *CommentCreate {
+	if v != nil {
+		_c.SetStatus(*v)
+	}
+	return _c
+}
+
+// SetNotifyOnReply sets the "notify_on_reply" field.
+func (_c *CommentCreate) SetNotifyOnReply(v bool) *CommentCreate {
+	_c.mutation.SetNotifyOnReply(v)
+	return _c
+}
+
+// SetNillableNotifyOnReply sets the "notify_on_reply" field if the given value is not nil.
+func (_c *CommentCreate) SetNillableNotifyOnReply(v *bool) *CommentCreate {
+	if v != nil {
+		_c.SetNotifyOnReply(*v)
+	}
+	return _c
+}
+
+// SetSpamReason sets the "spam_reason" field.
+func (_c *CommentCreate) SetSpamReason(v string) *CommentCreate {
+	_c.mutation.SetSpamReason(v)
+	return _c
+}
+
+// SetNillableSpamReason sets the "spam_reason" field if the given value is not nil.
+func (_c *CommentCreate) SetNillableSpamReason(v *string) *CommentCreate {
+	if v != nil {
+		_c.SetSpamReason(*v)
+	}
+	return _c
+}
+
+// SetIP sets the "ip" field.
+func (_c *CommentCreate) SetIP(v string) *CommentCreate {
+	_c.mutation.SetIP(v)
+	return _c
+}
+
+// SetNillableIP sets the "ip" field if the given value is not nil.
+func (_c *CommentCreate) SetNillableIP(v *string) *CommentCreate {
+	if v != nil {
+		_c.SetIP(*v)
+	}
+	return _c
+}
+
+// SetUserAgent sets the "user_agent" field.
+func (_c *CommentCreate) SetUserAgent(v string) *CommentCreate {
+	_c.mutation.SetUserAgent(v)
+	return _c
+}
+
+// SetNillableUserAgent sets the "user_agent" field if the given value is not nil.
+func (_c *CommentCreate) SetNillableUserAgent(v *string) *CommentCreate {
+	if v != nil {
+		_c.SetUserAgent(*v)
+	}
+	return _c
+}
+
 // SetID sets the "id" field.
 func (_c *CommentCreate) SetID(v uint) *CommentCreate {
 	_c.mutation.SetID(v)
 	return _c
 }
 
+// SetPost sets the "post" edge to the Post entity.
+func (_c *CommentCreate) SetPost(v *Post) *CommentCreate {
+	return _c.SetPostID(v.ID)
+}
+
+// SetAuthorID sets the "author" edge to the User entity by ID.
+func (_c *CommentCreate) SetAuthorID(id uint) *CommentCreate {
+	_c.mutation.SetAuthorID(id)
+	return _c
+}
+
+// SetNillableAuthorID sets the "author" edge to the User entity by ID if the given value is not nil.
+func (_c *CommentCreate) SetNillableAuthorID(id *uint) *CommentCreate {
+	if id != nil {
+		_c = _c.SetAuthorID(*id)
+	}
+	return _c
+}
+
+// SetAuthor sets the "author" edge to the User entity.
+func (_c *CommentCreate) SetAuthor(v *User) *CommentCreate {
+	return _c.SetAuthorID(v.ID)
+}
+
+// SetParent sets the "parent" edge to the Comment entity.
+func (_c *CommentCreate) SetParent(v *Comment) *CommentCreate {
+	return _c.SetParentID(v.ID)
+}
+
+// AddReplyIDs adds the "replies" edge to the Comment entity by IDs.
+func (_c *CommentCreate) AddReplyIDs(ids ...uint) *CommentCreate {
+	_c.mutation.AddReplyIDs(ids...)
+	return _c
+}
+
+// AddReplies adds the "replies" edges to the Comment entity.
+func (_c *CommentCreate) AddReplies(v ...*Comment) *CommentCreate {
+	ids := make([]uint, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _c.AddReplyIDs(ids...)
+}
+
 // Mutation returns the CommentMutation object of the builder.
 func (_c *CommentCreate) Mutation() *CommentMutation {
 	return _c.mutation
@@ -113,6 +318,22 @@ func (_c *CommentCreate) defaults() {
 		v := comment.DefaultUpdatedAt()
 		_c.mutation.SetUpdatedAt(v)
 	}
+	if _, ok := _c.mutation.Status(); !ok {
+		v := comment.DefaultStatus
+		_c.mutation.SetStatus(v)
+	}
+	if _, ok := _c.mutation.NotifyOnReply(); !ok {
+		v := comment.DefaultNotifyOnReply
+		_c.mutation.SetNotifyOnReply(v)
+	}
+	if _, ok := _c.mutation.IP(); !ok {
+		v := comment.DefaultIP
+		_c.mutation.SetIP(v)
+	}
+	if _, ok := _c.mutation.UserAgent(); !ok {
+		v := comment.DefaultUserAgent
+		_c.mutation.SetUserAgent(v)
+	}
 }
 
 // check runs all checks and user-defined validators on the builder.
@@ -123,6 +344,65 @@ func (_c *CommentCreate) check() error {
 	if _, ok := _c.mutation.UpdatedAt(); !ok {
 		return &ValidationError{Name: "updated_at", err: errors.New(`ent: missing required field "Comment.updated_at"`)}
 	}
+	if _, ok := _c.mutation.PostID(); !ok {
+		return &ValidationError{Name: "post_id", err: errors.New(`ent: missing required field "Comment.post_id"`)}
+	}
+	if _, ok := _c.mutation.AuthorName(); !ok {
+		return &ValidationError{Name: "author_name", err: errors.New(`ent: missing required field "Comment.author_name"`)}
+	}
+	if v, ok := _c.mutation.AuthorName(); ok {
+		if err := comment.AuthorNameValidator(v); err != nil {
+			return &ValidationError{Name: "author_name", err: fmt.Errorf(`ent: validator failed for field "Comment.author_name": %w`, err)}
+		}
+	}
+	if v, ok := _c.mutation.AuthorEmail(); ok {
+		if err := comment.AuthorEmailValidator(v); err != nil {
+			return &ValidationError{Name: "author_email", err: fmt.Errorf(`ent: validator failed for field "Comment.author_email": %w`, err)}
+		}
+	}
+	if v, ok := _c.mutation.AuthorWebsite(); ok {
+		if err := comment.AuthorWebsiteValidator(v); err != nil {
+			return &ValidationError{Name: "author_website", err: fmt.Errorf(`ent: validator failed for field "Comment.author_website": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.Content(); !ok {
+		return &ValidationError{Name: "content", err: errors.New(`ent: missing required field "Comment.content"`)}
+	}
+	if _, ok := _c.mutation.Status(); !ok {
+		return &ValidationError{Name: "status", err: errors.New(`ent: missing required field "Comment.status"`)}
+	}
+	if v, ok := _c.mutation.Status(); ok {
+		if err := comment.StatusValidator(v); err != nil {
+			return &ValidationError{Name: "status", err: fmt.Errorf(`ent: validator failed for field "Comment.status": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.NotifyOnReply(); !ok {
+		return &ValidationError{Name: "notify_on_reply", err: errors.New(`ent: missing required field "Comment.notify_on_reply"`)}
+	}
+	if v, ok := _c.mutation.SpamReason(); ok {
+		if err := comment.SpamReasonValidator(v); err != nil {
+			return &ValidationError{Name: "spam_reason", err: fmt.Errorf(`ent: validator failed for field "Comment.spam_reason": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.IP(); !ok {
+		return &ValidationError{Name: "ip", err: errors.New(`ent: missing required field "Comment.ip"`)}
+	}
+	if v, ok := _c.mutation.IP(); ok {
+		if err := comment.IPValidator(v); err != nil {
+			return &ValidationError{Name: "ip", err: fmt.Errorf(`ent: validator failed for field "Comment.ip": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.UserAgent(); !ok {
+		return &ValidationError{Name: "user_agent", err: errors.New(`ent: missing required field "Comment.user_agent"`)}
+	}
+	if v, ok := _c.mutation.UserAgent(); ok {
+		if err := comment.UserAgentValidator(v); err != nil {
+			return &ValidationError{Name: "user_agent", err: fmt.Errorf(`ent: validator failed for field "Comment.user_agent": %w`, err)}
+		}
+	}
+	if len(_c.mutation.PostIDs()) == 0 {
+		return &ValidationError{Name: "post", err: errors.New(`ent: missing required edge "Comment.post"`)}
+	}
 	return nil
 }
 
@@ -168,6 +448,113 @@ func (_c *CommentCreate) createSpec() (*Comment, *sqlgraph.CreateSpec) {
 		_spec.SetField(comment.FieldDeletedAt, field.TypeTime, value)
 		_node.DeletedAt = &value
 	}
+	if value, ok := _c.mutation.RootID(); ok {
+		_spec.SetField(comment.FieldRootID, field.TypeUint, value)
+		_node.RootID = &value
+	}
+	if value, ok := _c.mutation.AuthorName(); ok {
+		_spec.SetField(comment.FieldAuthorName, field.TypeString, value)
+		_node.AuthorName = value
+	}
+	if value, ok := _c.mutation.AuthorEmail(); ok {
+		_spec.SetField(comment.FieldAuthorEmail, field.TypeString, value)
+		_node.AuthorEmail = &value
+	}
+	if value, ok := _c.mutation.AuthorWebsite(); ok {
+		_spec.SetField(comment.FieldAuthorWebsite, field.TypeString, value)
+		_node.AuthorWebsite = &value
+	}
+	if value, ok := _c.mutation.Content(); ok {
+		_spec.SetField(comment.FieldContent, field.TypeString, value)
+		_node.Content = value
+	}
+	if value, ok := _c.mutation.Status(); ok {
+		_spec.SetField(comment.FieldStatus, field.TypeEnum, value)
+		_node.Status = value
+	}
+	if value, ok := _c.mutation.NotifyOnReply(); ok {
+		_spec.SetField(comment.FieldNotifyOnReply, field.TypeBool, value)
+		_node.NotifyOnReply = value
+	}
+	if value, ok := _c.mutation.SpamReason(); ok {
+		_spec.SetField(comment.FieldSpamReason, field.TypeString, value)
+		_node.SpamReason = &value
+	}
+	if value, ok := _c.mutation.IP(); ok {
+		_spec.SetField(comment.FieldIP, field.TypeString, value)
+		_node.IP = value
+	}
+	if value, ok := _c.mutation.UserAgent(); ok {
+		_spec.SetField(comment.FieldUserAgent, field.TypeString, value)
+		_node.UserAgent = value
+	}
+	if nodes := _c.mutation.PostIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   comment.PostTable,
+			Columns: []string{comment.PostColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(post.FieldID, field.TypeUint),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.PostID = nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := _c.mutation.AuthorIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   comment.AuthorTable,
+			Columns: []string{comment.AuthorColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeUint),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.UserID = &nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := _c.mutation.ParentIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   comment.ParentTable,
+			Columns: []string{comment.ParentColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(comment.FieldID, field.TypeUint),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.ParentID = &nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := _c.mutation.RepliesIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   comment.RepliesTable,
+			Columns: []string{comment.RepliesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(comment.FieldID, field.TypeUint),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
 	return _node, _spec
 }
 
@@ -214,148 +601,577 @@ type (
 		create *CommentCreate
 	}
 
-	// CommentUpsert is the "OnConflict" setter.
-	CommentUpsert struct {
-		*sql.UpdateSet
-	}
-)
+	// CommentUpsert is the "OnConflict" setter.
+	CommentUpsert struct {
+		*sql.UpdateSet
+	}
+)
+
+// SetCreatedAt sets the "created_at" field.
+func (u *CommentUpsert) SetCreatedAt(v time.Time) *CommentUpsert {
+	u.Set(comment.FieldCreatedAt, v)
+	return u
+}
+
+// UpdateCreatedAt sets the "created_at" field to the value that was provided on create.
+func (u *CommentUpsert) UpdateCreatedAt() *CommentUpsert {
+	u.SetExcluded(comment.FieldCreatedAt)
+	return u
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (u *CommentUpsert) SetUpdatedAt(v time.Time) *CommentUpsert {
+	u.Set(comment.FieldUpdatedAt, v)
+	return u
+}
+
+// UpdateUpdatedAt sets the "updated_at" field to the value that was provided on create.
+func (u *CommentUpsert) UpdateUpdatedAt() *CommentUpsert {
+	u.SetExcluded(comment.FieldUpdatedAt)
+	return u
+}
+
+// SetDeletedAt sets the "deleted_at" field.
+func (u *CommentUpsert) SetDeletedAt(v time.Time) *CommentUpsert {
+	u.Set(comment.FieldDeletedAt, v)
+	return u
+}
+
+// UpdateDeletedAt sets the "deleted_at" field to the value that was provided on create.
+func (u *CommentUpsert) UpdateDeletedAt() *CommentUpsert {
+	u.SetExcluded(comment.FieldDeletedAt)
+	return u
+}
+
+// ClearDeletedAt clears the value of the "deleted_at" field.
+func (u *CommentUpsert) ClearDeletedAt() *CommentUpsert {
+	u.SetNull(comment.FieldDeletedAt)
+	return u
+}
+
+// SetPostID sets the "post_id" field.
+func (u *CommentUpsert) SetPostID(v uint) *CommentUpsert {
+	u.Set(comment.FieldPostID, v)
+	return u
+}
+
+// UpdatePostID sets the "post_id" field to the value that was provided on create.
+func (u *CommentUpsert) UpdatePostID() *CommentUpsert {
+	u.SetExcluded(comment.FieldPostID)
+	return u
+}
+
+// SetParentID sets the "parent_id" field.
+func (u *CommentUpsert) SetParentID(v uint) *CommentUpsert {
+	u.Set(comment.FieldParentID, v)
+	return u
+}
+
+// UpdateParentID sets the "parent_id" field to the value that was provided on create.
+func (u *CommentUpsert) UpdateParentID() *CommentUpsert {
+	u.SetExcluded(comment.FieldParentID)
+	return u
+}
+
+// ClearParentID clears the value of the "parent_id" field.
+func (u *CommentUpsert) ClearParentID() *CommentUpsert {
+	u.SetNull(comment.FieldParentID)
+	return u
+}
+
+// SetRootID sets the "root_id" field.
+func (u *CommentUpsert) SetRootID(v uint) *CommentUpsert {
+	u.Set(comment.FieldRootID, v)
+	return u
+}
+
+// UpdateRootID sets the "root_id" field to the value that was provided on create.
+func (u *CommentUpsert) UpdateRootID() *CommentUpsert {
+	u.SetExcluded(comment.FieldRootID)
+	return u
+}
+
+// AddRootID adds v to the "root_id" field.
+func (u *CommentUpsert) AddRootID(v uint) *CommentUpsert {
+	u.Add(comment.FieldRootID, v)
+	return u
+}
+
+// ClearRootID clears the value of the "root_id" field.
+func (u *CommentUpsert) ClearRootID() *CommentUpsert {
+	u.SetNull(comment.FieldRootID)
+	return u
+}
+
+// SetUserID sets the "user_id" field.
+func (u *CommentUpsert) SetUserID(v uint) *CommentUpsert {
+	u.Set(comment.FieldUserID, v)
+	return u
+}
+
+// UpdateUserID sets the "user_id" field to the value that was provided on create.
+func (u *CommentUpsert) UpdateUserID() *CommentUpsert {
+	u.SetExcluded(comment.FieldUserID)
+	return u
+}
+
+// ClearUserID clears the value of the "user_id" field.
+func (u *CommentUpsert) ClearUserID() *CommentUpsert {
+	u.SetNull(comment.FieldUserID)
+	return u
+}
+
+// SetAuthorName sets the "author_name" field.
+func (u *CommentUpsert) SetAuthorName(v string) *CommentUpsert {
+	u.Set(comment.FieldAuthorName, v)
+	return u
+}
+
+// UpdateAuthorName sets the "author_name" field to the value that was provided on create.
+func (u *CommentUpsert) UpdateAuthorName() *CommentUpsert {
+	u.SetExcluded(comment.FieldAuthorName)
+	return u
+}
+
+// SetAuthorEmail sets the "author_email" field.
+func (u *CommentUpsert) SetAuthorEmail(v string) *CommentUpsert {
+	u.Set(comment.FieldAuthorEmail, v)
+	return u
+}
+
+// UpdateAuthorEmail sets the "author_email" field to the value that was provided on create.
+func (u *CommentUpsert) UpdateAuthorEmail() *CommentUpsert {
+	u.SetExcluded(comment.FieldAuthorEmail)
+	return u
+}
+
+// ClearAuthorEmail clears the value of the "author_email" field.
+func (u *CommentUpsert) ClearAuthorEmail() *CommentUpsert {
+	u.SetNull(comment.FieldAuthorEmail)
+	return u
+}
+
+// SetAuthorWebsite sets the "author_website" field.
+func (u *CommentUpsert) SetAuthorWebsite(v string) *CommentUpsert {
+	u.Set(comment.FieldAuthorWebsite, v)
+	return u
+}
+
+// UpdateAuthorWebsite sets the "author_website" field to the value that was provided on create.
+func (u *CommentUpsert) UpdateAuthorWebsite() *CommentUpsert {
+	u.SetExcluded(comment.FieldAuthorWebsite)
+	return u
+}
+
+// ClearAuthorWebsite clears the value of the "author_website" field.
+func (u *CommentUpsert) ClearAuthorWebsite() *CommentUpsert {
+	u.SetNull(comment.FieldAuthorWebsite)
+	return u
+}
+
+// SetContent sets the "content" field.
+func (u *CommentUpsert) SetContent(v string) *CommentUpsert {
+	u.Set(comment.FieldContent, v)
+	return u
+}
+
+// UpdateContent sets the "content" field to the value that was provided on create.
+func (u *CommentUpsert) UpdateContent() *CommentUpsert {
+	u.SetExcluded(comment.FieldContent)
+	return u
+}
+
+// SetStatus sets the "status" field.
+func (u *CommentUpsert) SetStatus(v entity.CommentStatus) *CommentUpsert {
+	u.Set(comment.FieldStatus, v)
+	return u
+}
+
+// UpdateStatus sets the "status" field to the value that was provided on create.
+func (u *CommentUpsert) UpdateStatus() *CommentUpsert {
+	u.SetExcluded(comment.FieldStatus)
+	return u
+}
+
+// SetNotifyOnReply sets the "notify_on_reply" field.
+func (u *CommentUpsert) SetNotifyOnReply(v bool) *CommentUpsert {
+	u.Set(comment.FieldNotifyOnReply, v)
+	return u
+}
+
+// UpdateNotifyOnReply sets the "notify_on_reply" field to the value that was provided on create.
+func (u *CommentUpsert) UpdateNotifyOnReply() *CommentUpsert {
+	u.SetExcluded(comment.FieldNotifyOnReply)
+	return u
+}
+
+// SetSpamReason sets the "spam_reason" field.
+func (u *CommentUpsert) SetSpamReason(v string) *CommentUpsert {
+	u.Set(comment.FieldSpamReason, v)
+	return u
+}
+
+// UpdateSpamReason sets the "spam_reason" field to the value that was provided on create.
+func (u *CommentUpsert) UpdateSpamReason() *CommentUpsert {
+	u.SetExcluded(comment.FieldSpamReason)
+	return u
+}
+
+// ClearSpamReason clears the value of the "spam_reason" field.
+func (u *CommentUpsert) ClearSpamReason() *CommentUpsert {
+	u.SetNull(comment.FieldSpamReason)
+	return u
+}
+
+// SetIP sets the "ip" field.
+func (u *CommentUpsert) SetIP(v string) *CommentUpsert {
+	u.Set(comment.FieldIP, v)
+	return u
+}
+
+// UpdateIP sets the "ip" field to the value that was provided on create.
+func (u *CommentUpsert) UpdateIP() *CommentUpsert {
+	u.SetExcluded(comment.FieldIP)
+	return u
+}
+
+// SetUserAgent sets the "user_agent" field.
+func (u *CommentUpsert) SetUserAgent(v string) *CommentUpsert {
+	u.Set(comment.FieldUserAgent, v)
+	return u
+}
+
+// UpdateUserAgent sets the "user_agent" field to the value that was provided on create.
+func (u *CommentUpsert) UpdateUserAgent() *CommentUpsert {
+	u.SetExcluded(comment.FieldUserAgent)
+	return u
+}
+
+// UpdateNewValues updates the mutable fields using the new values that were set on create except the ID field.
+// Using this option is equivalent to using:
+//
+//	client.Comment.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//			sql.ResolveWith(func(u *sql.UpdateSet) {
+//				u.SetIgnore(comment.FieldID)
+//			}),
+//		).
+//		Exec(ctx)
+func (u *CommentUpsertOne) UpdateNewValues() *CommentUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		if _, exists := u.create.mutation.ID(); exists {
+			s.SetIgnore(comment.FieldID)
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.Comment.Create().
+//	    OnConflict(sql.ResolveWithIgnore()).
+//	    Exec(ctx)
+func (u *CommentUpsertOne) Ignore() *CommentUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *CommentUpsertOne) DoNothing() *CommentUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the CommentCreate.OnConflict
+// documentation for more info.
+func (u *CommentUpsertOne) Update(set func(*CommentUpsert)) *CommentUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&CommentUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (u *CommentUpsertOne) SetCreatedAt(v time.Time) *CommentUpsertOne {
+	return u.Update(func(s *CommentUpsert) {
+		s.SetCreatedAt(v)
+	})
+}
+
+// UpdateCreatedAt sets the "created_at" field to the value that was provided on create.
+func (u *CommentUpsertOne) UpdateCreatedAt() *CommentUpsertOne {
+	return u.Update(func(s *CommentUpsert) {
+		s.UpdateCreatedAt()
+	})
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (u *CommentUpsertOne) SetUpdatedAt(v time.Time) *CommentUpsertOne {
+	return u.Update(func(s *CommentUpsert) {
+		s.SetUpdatedAt(v)
+	})
+}
+
+// UpdateUpdatedAt sets the "updated_at" field to the value that was provided on create.
+func (u *CommentUpsertOne) UpdateUpdatedAt() *CommentUpsertOne {
+	return u.Update(func(s *CommentUpsert) {
+		s.UpdateUpdatedAt()
+	})
+}
+
+// SetDeletedAt sets the "deleted_at" field.
+func (u *CommentUpsertOne) SetDeletedAt(v time.Time) *CommentUpsertOne {
+	return u.Update(func(s *CommentUpsert) {
+		s.SetDeletedAt(v)
+	})
+}
+
+// UpdateDeletedAt sets the "deleted_at" field to the value that was provided on create.
+func (u *CommentUpsertOne) UpdateDeletedAt() *CommentUpsertOne {
+	return u.Update(func(s *CommentUpsert) {
+		s.UpdateDeletedAt()
+	})
+}
+
+// ClearDeletedAt clears the value of the "deleted_at" field.
+func (u *CommentUpsertOne) ClearDeletedAt() *CommentUpsertOne {
+	return u.Update(func(s *CommentUpsert) {
+		s.ClearDeletedAt()
+	})
+}
+
+// SetPostID sets the "post_id" field.
+func (u *CommentUpsertOne) SetPostID(v uint) *CommentUpsertOne {
+	return u.Update(func(s *CommentUpsert) {
+		s.SetPostID(v)
+	})
+}
+
+// UpdatePostID sets the "post_id" field to the value that was provided on create.
+func (u *CommentUpsertOne) UpdatePostID() *CommentUpsertOne {
+	return u.Update(func(s *CommentUpsert) {
+		s.UpdatePostID()
+	})
+}
+
+// SetParentID sets the "parent_id" field.
+func (u *CommentUpsertOne) SetParentID(v uint) *CommentUpsertOne {
+	return u.Update(func(s *CommentUpsert) {
+		s.SetParentID(v)
+	})
+}
+
+// UpdateParentID sets the "parent_id" field to the value that was provided on create.
+func (u *CommentUpsertOne) UpdateParentID() *CommentUpsertOne {
+	return u.Update(func(s *CommentUpsert) {
+		s.UpdateParentID()
+	})
+}
+
+// ClearParentID clears the value of the "parent_id" field.
+func (u *CommentUpsertOne) ClearParentID() *CommentUpsertOne {
+	return u.Update(func(s *CommentUpsert) {
+		s.ClearParentID()
+	})
+}
+
+// SetRootID sets the "root_id" field.
+func (u *CommentUpsertOne) SetRootID(v uint) *CommentUpsertOne {
+	return u.Update(func(s *CommentUpsert) {
+		s.SetRootID(v)
+	})
+}
+
+// AddRootID adds v to the "root_id" field.
+func (u *CommentUpsertOne) AddRootID(v uint) *CommentUpsertOne {
+	return u.Update(func(s *CommentUpsert) {
+		s.AddRootID(v)
+	})
+}
+
+// UpdateRootID sets the "root_id" field to the value that was provided on create.
+func (u *CommentUpsertOne) UpdateRootID() *CommentUpsertOne {
+	return u.Update(func(s *CommentUpsert) {
+		s.UpdateRootID()
+	})
+}
+
+// ClearRootID clears the value of the "root_id" field.
+func (u *CommentUpsertOne) ClearRootID() *CommentUpsertOne {
+	return u.Update(func(s *CommentUpsert) {
+		s.ClearRootID()
+	})
+}
+
+// SetUserID sets the "user_id" field.
+func (u *CommentUpsertOne) SetUserID(v uint) *CommentUpsertOne {
+	return u.Update(func(s *CommentUpsert) {
+		s.SetUserID(v)
+	})
+}
+
+// UpdateUserID sets the "user_id" field to the value that was provided on create.
+func (u *CommentUpsertOne) UpdateUserID() *CommentUpsertOne {
+	return u.Update(func(s *CommentUpsert) {
+		s.UpdateUserID()
+	})
+}
+
+// ClearUserID clears the value of the "user_id" field.
+func (u *CommentUpsertOne) ClearUserID() *CommentUpsertOne {
+	return u.Update(func(s *CommentUpsert) {
+		s.ClearUserID()
+	})
+}
+
+// SetAuthorName sets the "author_name" field.
+func (u *CommentUpsertOne) SetAuthorName(v string) *CommentUpsertOne {
+	return u.Update(func(s *CommentUpsert) {
+		s.SetAuthorName(v)
+	})
+}
+
+// UpdateAuthorName sets the "author_name" field to the value that was provided on create.
+func (u *CommentUpsertOne) UpdateAuthorName() *CommentUpsertOne {
+	return u.Update(func(s *CommentUpsert) {
+		s.UpdateAuthorName()
+	})
+}
 
-// SetCreatedAt sets the "created_at" field.
-func (u *CommentUpsert) SetCreatedAt(v time.Time) *CommentUpsert {
-	u.Set(comment.FieldCreatedAt, v)
-	return u
+// SetAuthorEmail sets the "author_email" field.
+func (u *CommentUpsertOne) SetAuthorEmail(v string) *CommentUpsertOne {
+	return u.Update(func(s *CommentUpsert) {
+		s.SetAuthorEmail(v)
+	})
 }
 
-// UpdateCreatedAt sets the "created_at" field to the value that was provided on create.
-func (u *CommentUpsert) UpdateCreatedAt() *CommentUpsert {
-	u.SetExcluded(comment.FieldCreatedAt)
-	return u
+// UpdateAuthorEmail sets the "author_email" field to the value that was provided on create.
+func (u *CommentUpsertOne) UpdateAuthorEmail() *CommentUpsertOne {
+	return u.Update(func(s *CommentUpsert) {
+		s.UpdateAuthorEmail()
+	})
 }
 
-// SetUpdatedAt sets the "updated_at" field.
-func (u *CommentUpsert) SetUpdatedAt(v time.Time) *CommentUpsert {
-	u.Set(comment.FieldUpdatedAt, v)
-	return u
+// ClearAuthorEmail clears the value of the "author_email" field.
+func (u *CommentUpsertOne) ClearAuthorEmail() *CommentUpsertOne {
+	return u.Update(func(s *CommentUpsert) {
+		s.ClearAuthorEmail()
+	})
 }
 
-// UpdateUpdatedAt sets the "updated_at" field to the value that was provided on create.
-func (u *CommentUpsert) UpdateUpdatedAt() *CommentUpsert {
-	u.SetExcluded(comment.FieldUpdatedAt)
-	return u
+// SetAuthorWebsite sets the "author_website" field.
+func (u *CommentUpsertOne) SetAuthorWebsite(v string) *CommentUpsertOne {
+	return u.Update(func(s *CommentUpsert) {
+		s.SetAuthorWebsite(v)
+	})
 }
 
-// SetDeletedAt sets the "deleted_at" field.
-func (u *CommentUpsert) SetDeletedAt(v time.Time) *CommentUpsert {
-	u.Set(comment.FieldDeletedAt, v)
-	return u
+// UpdateAuthorWebsite sets the "author_website" field to the value that was provided on create.
+func (u *CommentUpsertOne) UpdateAuthorWebsite() *CommentUpsertOne {
+	return u.Update(func(s *CommentUpsert) {
+		s.UpdateAuthorWebsite()
+	})
 }
 
-// UpdateDeletedAt sets the "deleted_at" field to the value that was provided on create.
-func (u *CommentUpsert) UpdateDeletedAt() *CommentUpsert {
-	u.SetExcluded(comment.FieldDeletedAt)
-	return u
+// ClearAuthorWebsite clears the value of the "author_website" field.
+func (u *CommentUpsertOne) ClearAuthorWebsite() *CommentUpsertOne {
+	return u.Update(func(s *CommentUpsert) {
+		s.ClearAuthorWebsite()
+	})
 }
 
-// ClearDeletedAt clears the value of the "deleted_at" field.
-func (u *CommentUpsert) ClearDeletedAt() *CommentUpsert {
-	u.SetNull(comment.FieldDeletedAt)
-	return u
+// SetContent sets the "content" field.
+func (u *CommentUpsertOne) SetContent(v string) *CommentUpsertOne {
+	return u.Update(func(s *CommentUpsert) {
+		s.SetContent(v)
+	})
 }
 
-// UpdateNewValues updates the mutable fields using the new values that were set on create except the ID field.
-// Using this option is equivalent to using:
-//
-//	client.Comment.Create().
-//		OnConflict(
-//			sql.ResolveWithNewValues(),
-//			sql.ResolveWith(func(u *sql.UpdateSet) {
-//				u.SetIgnore(comment.FieldID)
-//			}),
-//		).
-//		Exec(ctx)
-func (u *CommentUpsertOne) UpdateNewValues() *CommentUpsertOne {
-	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
-	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
-		if _, exists := u.create.mutation.ID(); exists {
-			s.SetIgnore(comment.FieldID)
-		}
-	}))
-	return u
+// UpdateContent sets the "content" field to the value that was provided on create.
+func (u *CommentUpsertOne) UpdateContent() *CommentUpsertOne {
+	return u.Update(func(s *CommentUpsert) {
+		s.UpdateContent()
+	})
 }
 
-// Ignore sets each column to itself in case of conflict.
-// Using this option is equivalent to using:
-//
-//	client.Comment.Create().
-//	    OnConflict(sql.ResolveWithIgnore()).
-//	    Exec(ctx)
-func (u *CommentUpsertOne) Ignore() *CommentUpsertOne {
-	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
-	return u
+// SetStatus sets the "status" field.
+func (u *CommentUpsertOne) SetStatus(v entity.CommentStatus) *CommentUpsertOne {
+	return u.Update(func(s *CommentUpsert) {
+		s.SetStatus(v)
+	})
 }
 
-// DoNothing configures the conflict_action to `DO NOTHING`.
-// Supported only by SQLite and PostgreSQL.
-func (u *CommentUpsertOne) DoNothing() *CommentUpsertOne {
-	u.create.conflict = append(u.create.conflict, sql.DoNothing())
-	return u
+// UpdateStatus sets the "status" field to the value that was provided on create.
+func (u *CommentUpsertOne) UpdateStatus() *CommentUpsertOne {
+	return u.Update(func(s *CommentUpsert) {
+		s.UpdateStatus()
+	})
 }
 
-// Update allows overriding fields `UPDATE` values. See the CommentCreate.OnConflict
-// documentation for more info.
-func (u *CommentUpsertOne) Update(set func(*CommentUpsert)) *CommentUpsertOne {
-	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
-		set(&CommentUpsert{UpdateSet: update})
-	}))
-	return u
+// SetNotifyOnReply sets the "notify_on_reply" field.
+func (u *CommentUpsertOne) SetNotifyOnReply(v bool) *CommentUpsertOne {
+	return u.Update(func(s *CommentUpsert) {
+		s.SetNotifyOnReply(v)
+	})
 }
 
-// SetCreatedAt sets the "created_at" field.
-func (u *CommentUpsertOne) SetCreatedAt(v time.Time) *CommentUpsertOne {
+// UpdateNotifyOnReply sets the "notify_on_reply" field to the value that was provided on create.
+func (u *CommentUpsertOne) UpdateNotifyOnReply() *CommentUpsertOne {
 	return u.Update(func(s *CommentUpsert) {
-		s.SetCreatedAt(v)
+		s.UpdateNotifyOnReply()
 	})
 }
 
-// UpdateCreatedAt sets the "created_at" field to the value that was provided on create.
-func (u *CommentUpsertOne) UpdateCreatedAt() *CommentUpsertOne {
+// SetSpamReason sets the "spam_reason" field.
+func (u *CommentUpsertOne) SetSpamReason(v string) *CommentUpsertOne {
 	return u.Update(func(s *CommentUpsert) {
-		s.UpdateCreatedAt()
+		s.SetSpamReason(v)
 	})
 }
 
-// SetUpdatedAt sets the "updated_at" field.
-func (u *CommentUpsertOne) SetUpdatedAt(v time.Time) *CommentUpsertOne {
+// UpdateSpamReason sets the "spam_reason" field to the value that was provided on create.
+func (u *CommentUpsertOne) UpdateSpamReason() *CommentUpsertOne {
 	return u.Update(func(s *CommentUpsert) {
-		s.SetUpdatedAt(v)
+		s.UpdateSpamReason()
 	})
 }
 
-// UpdateUpdatedAt sets the "updated_at" field to the value that was provided on create.
-func (u *CommentUpsertOne) UpdateUpdatedAt() *CommentUpsertOne {
+// ClearSpamReason clears the value of the "spam_reason" field.
+func (u *CommentUpsertOne) ClearSpamReason() *CommentUpsertOne {
 	return u.Update(func(s *CommentUpsert) {
-		s.UpdateUpdatedAt()
+		s.ClearSpamReason()
 	})
 }
 
-// SetDeletedAt sets the "deleted_at" field.
-func (u *CommentUpsertOne) SetDeletedAt(v time.Time) *CommentUpsertOne {
+// SetIP sets the "ip" field.
+func (u *CommentUpsertOne) SetIP(v string) *CommentUpsertOne {
 	return u.Update(func(s *CommentUpsert) {
-		s.SetDeletedAt(v)
+		s.SetIP(v)
 	})
 }
 
-// UpdateDeletedAt sets the "deleted_at" field to the value that was provided on create.
-func (u *CommentUpsertOne) UpdateDeletedAt() *CommentUpsertOne {
+// UpdateIP sets the "ip" field to the value that was provided on create.
+func (u *CommentUpsertOne) UpdateIP() *CommentUpsertOne {
 	return u.Update(func(s *CommentUpsert) {
-		s.UpdateDeletedAt()
+		s.UpdateIP()
 	})
 }
 
-// ClearDeletedAt clears the value of the "deleted_at" field.
-func (u *CommentUpsertOne) ClearDeletedAt() *CommentUpsertOne {
+// SetUserAgent sets the "user_agent" field.
+func (u *CommentUpsertOne) SetUserAgent(v string) *CommentUpsertOne {
 	return u.Update(func(s *CommentUpsert) {
-		s.ClearDeletedAt()
+		s.SetUserAgent(v)
+	})
+}
+
+// UpdateUserAgent sets the "user_agent" field to the value that was provided on create.
+func (u *CommentUpsertOne) UpdateUserAgent() *CommentUpsertOne {
+	return u.Update(func(s *CommentUpsert) {
+		s.UpdateUserAgent()
 	})
 }
 
@@ -622,6 +1438,237 @@ func (u *CommentUpsertBulk) ClearDeletedAt() *CommentUpsertBulk {
 	})
 }
 
+// SetPostID sets the "post_id" field.
+func (u *CommentUpsertBulk) SetPostID(v uint) *CommentUpsertBulk {
+	return u.Update(func(s *CommentUpsert) {
+		s.SetPostID(v)
+	})
+}
+
+// UpdatePostID sets the "post_id" field to the value that was provided on create.
+func (u *CommentUpsertBulk) UpdatePostID() *CommentUpsertBulk {
+	return u.Update(func(s *CommentUpsert) {
+		s.UpdatePostID()
+	})
+}
+
+// SetParentID sets the "parent_id" field.
+func (u *CommentUpsertBulk) SetParentID(v uint) *CommentUpsertBulk {
+	return u.Update(func(s *CommentUpsert) {
+		s.SetParentID(v)
+	})
+}
+
+// UpdateParentID sets the "parent_id" field to the value that was provided on create.
+func (u *CommentUpsertBulk) UpdateParentID() *CommentUpsertBulk {
+	return u.Update(func(s *CommentUpsert) {
+		s.UpdateParentID()
+	})
+}
+
+// ClearParentID clears the value of the "parent_id" field.
+func (u *CommentUpsertBulk) ClearParentID() *CommentUpsertBulk {
+	return u.Update(func(s *CommentUpsert) {
+		s.ClearParentID()
+	})
+}
+
+// SetRootID sets the "root_id" field.
+func (u *CommentUpsertBulk) SetRootID(v uint) *CommentUpsertBulk {
+	return u.Update(func(s *CommentUpsert) {
+		s.SetRootID(v)
+	})
+}
+
+// AddRootID adds v to the "root_id" field.
+func (u *CommentUpsertBulk) AddRootID(v uint) *CommentUpsertBulk {
+	return u.Update(func(s *CommentUpsert) {
+		s.AddRootID(v)
+	})
+}
+
+// UpdateRootID sets the "root_id" field to the value that was provided on create.
+func (u *CommentUpsertBulk) UpdateRootID() *CommentUpsertBulk {
+	return u.Update(func(s *CommentUpsert) {
+		s.UpdateRootID()
+	})
+}
+
+// ClearRootID clears the value of the "root_id" field.
+func (u *CommentUpsertBulk) ClearRootID() *CommentUpsertBulk {
+	return u.Update(func(s *CommentUpsert) {
+		s.ClearRootID()
+	})
+}
+
+// SetUserID sets the "user_id" field.
+func (u *CommentUpsertBulk) SetUserID(v uint) *CommentUpsertBulk {
+	return u.Update(func(s *CommentUpsert) {
+		s.SetUserID(v)
+	})
+}
+
+// UpdateUserID sets the "user_id" field to the value that was provided on create.
+func (u *CommentUpsertBulk) UpdateUserID() *CommentUpsertBulk {
+	return u.Update(func(s *CommentUpsert) {
+		s.UpdateUserID()
+	})
+}
+
+// ClearUserID clears the value of the "user_id" field.
+func (u *CommentUpsertBulk) ClearUserID() *CommentUpsertBulk {
+	return u.Update(func(s *CommentUpsert) {
+		s.ClearUserID()
+	})
+}
+
+// SetAuthorName sets the "author_name" field.
+func (u *CommentUpsertBulk) SetAuthorName(v string) *CommentUpsertBulk {
+	return u.Update(func(s *CommentUpsert) {
+		s.SetAuthorName(v)
+	})
+}
+
+// UpdateAuthorName sets the "author_name" field to the value that was provided on create.
+func (u *CommentUpsertBulk) UpdateAuthorName() *CommentUpsertBulk {
+	return u.Update(func(s *CommentUpsert) {
+		s.UpdateAuthorName()
+	})
+}
+
+// SetAuthorEmail sets the "author_email" field.
+func (u *CommentUpsertBulk) SetAuthorEmail(v string) *CommentUpsertBulk {
+	return u.Update(func(s *CommentUpsert) {
+		s.SetAuthorEmail(v)
+	})
+}
+
+// UpdateAuthorEmail sets the "author_email" field to the value that was provided on create.
+func (u *CommentUpsertBulk) UpdateAuthorEmail() *CommentUpsertBulk {
+	return u.Update(func(s *CommentUpsert) {
+		s.UpdateAuthorEmail()
+	})
+}
+
+// ClearAuthorEmail clears the value of the "author_email" field.
+func (u *CommentUpsertBulk) ClearAuthorEmail() *CommentUpsertBulk {
+	return u.Update(func(s *CommentUpsert) {
+		s.ClearAuthorEmail()
+	})
+}
+
+// SetAuthorWebsite sets the "author_website" field.
+func (u *CommentUpsertBulk) SetAuthorWebsite(v string) *CommentUpsertBulk {
+	return u.Update(func(s *CommentUpsert) {
+		s.SetAuthorWebsite(v)
+	})
+}
+
+// UpdateAuthorWebsite sets the "author_website" field to the value that was provided on create.
+func (u *CommentUpsertBulk) UpdateAuthorWebsite() *CommentUpsertBulk {
+	return u.Update(func(s *CommentUpsert) {
+		s.UpdateAuthorWebsite()
+	})
+}
+
+// ClearAuthorWebsite clears the value of the "author_website" field.
+func (u *CommentUpsertBulk) ClearAuthorWebsite() *CommentUpsertBulk {
+	return u.Update(func(s *CommentUpsert) {
+		s.ClearAuthorWebsite()
+	})
+}
+
+// SetContent sets the "content" field.
+func (u *CommentUpsertBulk) SetContent(v string) *CommentUpsertBulk {
+	return u.Update(func(s *CommentUpsert) {
+		s.SetContent(v)
+	})
+}
+
+// UpdateContent sets the "content" field to the value that was provided on create.
+func (u *CommentUpsertBulk) UpdateContent() *CommentUpsertBulk {
+	return u.Update(func(s *CommentUpsert) {
+		s.UpdateContent()
+	})
+}
+
+// SetStatus sets the "status" field.
+func (u *CommentUpsertBulk) SetStatus(v entity.CommentStatus) *CommentUpsertBulk {
+	return u.Update(func(s *CommentUpsert) {
+		s.SetStatus(v)
+	})
+}
+
+// UpdateStatus sets the "status" field to the value that was provided on create.
+func (u *CommentUpsertBulk) UpdateStatus() *CommentUpsertBulk {
+	return u.Update(func(s *CommentUpsert) {
+		s.UpdateStatus()
+	})
+}
+
+// SetNotifyOnReply sets the "notify_on_reply" field.
+func (u *CommentUpsertBulk) SetNotifyOnReply(v bool) *CommentUpsertBulk {
+	return u.Update(func(s *CommentUpsert) {
+		s.SetNotifyOnReply(v)
+	})
+}
+
+// UpdateNotifyOnReply sets the "notify_on_reply" field to the value that was provided on create.
+func (u *CommentUpsertBulk) UpdateNotifyOnReply() *CommentUpsertBulk {
+	return u.Update(func(s *CommentUpsert) {
+		s.UpdateNotifyOnReply()
+	})
+}
+
+// SetSpamReason sets the "spam_reason" field.
+func (u *CommentUpsertBulk) SetSpamReason(v string) *CommentUpsertBulk {
+	return u.Update(func(s *CommentUpsert) {
+		s.SetSpamReason(v)
+	})
+}
+
+// UpdateSpamReason sets the "spam_reason" field to the value that was provided on create.
+func (u *CommentUpsertBulk) UpdateSpamReason() *CommentUpsertBulk {
+	return u.Update(func(s *CommentUpsert) {
+		s.UpdateSpamReason()
+	})
+}
+
+// ClearSpamReason clears the value of the "spam_reason" field.
+func (u *CommentUpsertBulk) ClearSpamReason() *CommentUpsertBulk {
+	return u.Update(func(s *CommentUpsert) {
+		s.ClearSpamReason()
+	})
+}
+
+// SetIP sets the "ip" field.
+func (u *CommentUpsertBulk) SetIP(v string) *CommentUpsertBulk {
+	return u.Update(func(s *CommentUpsert) {
+		s.SetIP(v)
+	})
+}
+
+// UpdateIP sets the "ip" field to the value that was provided on create.
+func (u *CommentUpsertBulk) UpdateIP() *CommentUpsertBulk {
+	return u.Update(func(s *CommentUpsert) {
+		s.UpdateIP()
+	})
+}
+
+// SetUserAgent sets the "user_agent" field.
+func (u *CommentUpsertBulk) SetUserAgent(v string) *CommentUpsertBulk {
+	return u.Update(func(s *CommentUpsert) {
+		s.SetUserAgent(v)
+	})
+}
+
+// UpdateUserAgent sets the "user_agent" field to the value that was provided on create.
+func (u *CommentUpsertBulk) UpdateUserAgent() *CommentUpsertBulk {
+	return u.Update(func(s *CommentUpsert) {
+		s.UpdateUserAgent()
+	})
+}
+
 // Exec executes the query.
 func (u *CommentUpsertBulk) Exec(ctx context.Context) error {
 	if u.create.err != nil {
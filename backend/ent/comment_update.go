@@ -4,7 +4,10 @@ package ent
 
 import (
 	"blog-server/ent/comment"
+	"blog-server/ent/post"
 	"blog-server/ent/predicate"
+	"blog-server/ent/user"
+	"blog-server/entity"
 	"context"
 	"errors"
 	"fmt"
@@ -77,11 +80,319 @@ func (_u *CommentUpdate) ClearDeletedAt() *CommentUpdate {
 	return _u
 }
 
+// SetPostID sets the "post_id" field.
+func (_u *CommentUpdate) SetPostID(v uint) *CommentUpdate {
+	_u.mutation.SetPostID(v)
+	return _u
+}
+
+// SetNillablePostID sets the "post_id" field if the given value is not nil.
+func (_u *CommentUpdate) SetNillablePostID(v *uint) *CommentUpdate {
+	if v != nil {
+		_u.SetPostID(*v)
+	}
+	return _u
+}
+
+// SetParentID sets the "parent_id" field.
+func (_u *CommentUpdate) SetParentID(v uint) *CommentUpdate {
+	_u.mutation.SetParentID(v)
+	return _u
+}
+
+// SetNillableParentID sets the "parent_id" field if the given value is not nil.
+func (_u *CommentUpdate) SetNillableParentID(v *uint) *CommentUpdate {
+	if v != nil {
+		_u.SetParentID(*v)
+	}
+	return _u
+}
+
+// ClearParentID clears the value of the "parent_id" field.
+func (_u *CommentUpdate) ClearParentID() *CommentUpdate {
+	_u.mutation.ClearParentID()
+	return _u
+}
+
+// SetRootID sets the "root_id" field.
+func (_u *CommentUpdate) SetRootID(v uint) *CommentUpdate {
+	_u.mutation.ResetRootID()
+	_u.mutation.SetRootID(v)
+	return _u
+}
+
+// SetNillableRootID sets the "root_id" field if the given value is not nil.
+func (_u *CommentUpdate) SetNillableRootID(v *uint) *CommentUpdate {
+	if v != nil {
+		_u.SetRootID(*v)
+	}
+	return _u
+}
+
+// AddRootID adds value to the "root_id" field.
+func (_u *CommentUpdate) AddRootID(v int) *CommentUpdate {
+	_u.mutation.AddRootID(v)
+	return _u
+}
+
+// ClearRootID clears the value of the "root_id" field.
+func (_u *CommentUpdate) ClearRootID() *CommentUpdate {
+	_u.mutation.ClearRootID()
+	return _u
+}
+
+// SetUserID sets the "user_id" field.
+func (_u *CommentUpdate) SetUserID(v uint) *CommentUpdate {
+	_u.mutation.SetUserID(v)
+	return _u
+}
+
+// SetNillableUserID sets the "user_id" field if the given value is not nil.
+func (_u *CommentUpdate) SetNillableUserID(v *uint) *CommentUpdate {
+	if v != nil {
+		_u.SetUserID(*v)
+	}
+	return _u
+}
+
+// ClearUserID clears the value of the "user_id" field.
+func (_u *CommentUpdate) ClearUserID() *CommentUpdate {
+	_u.mutation.ClearUserID()
+	return _u
+}
+
+// SetAuthorName sets the "author_name" field.
+func (_u *CommentUpdate) SetAuthorName(v string) *CommentUpdate {
+	_u.mutation.SetAuthorName(v)
+	return _u
+}
+
+// SetNillableAuthorName sets the "author_name" field if the given value is not nil.
+func (_u *CommentUpdate) SetNillableAuthorName(v *string) *CommentUpdate {
+	if v != nil {
+		_u.SetAuthorName(*v)
+	}
+	return _u
+}
+
+// SetAuthorEmail sets the "author_email" field.
+func (_u *CommentUpdate) SetAuthorEmail(v string) *CommentUpdate {
+	_u.mutation.SetAuthorEmail(v)
+	return _u
+}
+
+// SetNillableAuthorEmail sets the "author_email" field if the given value is not nil.
+func (_u *CommentUpdate) SetNillableAuthorEmail(v *string) *CommentUpdate {
+	if v != nil {
+		_u.SetAuthorEmail(*v)
+	}
+	return _u
+}
+
+// ClearAuthorEmail clears the value of the "author_email" field.
+func (_u *CommentUpdate) ClearAuthorEmail() *CommentUpdate {
+	_u.mutation.ClearAuthorEmail()
+	return _u
+}
+
+// SetAuthorWebsite sets the "author_website" field.
+func (_u *CommentUpdate) SetAuthorWebsite(v string) *CommentUpdate {
+	_u.mutation.SetAuthorWebsite(v)
+	return _u
+}
+
+// SetNillableAuthorWebsite sets the "author_website" field if the given value is not nil.
+func (_u *CommentUpdate) SetNillableAuthorWebsite(v *string) *CommentUpdate {
+	if v != nil {
+		_u.SetAuthorWebsite(*v)
+	}
+	return _u
+}
+
+// ClearAuthorWebsite clears the value of the "author_website" field.
+func (_u *CommentUpdate) ClearAuthorWebsite() *CommentUpdate {
+	_u.mutation.ClearAuthorWebsite()
+	return _u
+}
+
+// SetContent sets the "content" field.
+func (_u *CommentUpdate) SetContent(v string) *CommentUpdate {
+	_u.mutation.SetContent(v)
+	return _u
+}
+
+// SetNillableContent sets the "content" field if the given value is not nil.
+func (_u *CommentUpdate) SetNillableContent(v *string) *CommentUpdate {
+	if v != nil {
+		_u.SetContent(*v)
+	}
+	return _u
+}
+
+// SetStatus sets the "status" field.
+func (_u *CommentUpdate) SetStatus(v entity.CommentStatus) *CommentUpdate {
+	_u.mutation.SetStatus(v)
+	return _u
+}
+
+// SetNillableStatus sets the "status" field if the given value is not nil.
+func (_u *CommentUpdate) SetNillableStatus(v *entity.CommentStatus) *CommentUpdate {
+	if v != nil {
+		_u.SetStatus(*v)
+	}
+	return _u
+}
+
+// SetNotifyOnReply sets the "notify_on_reply" field.
+func (_u *CommentUpdate) SetNotifyOnReply(v bool) *CommentUpdate {
+	_u.mutation.SetNotifyOnReply(v)
+	return _u
+}
+
+// SetNillableNotifyOnReply sets the "notify_on_reply" field if the given value is not nil.
+func (_u *CommentUpdate) SetNillableNotifyOnReply(v *bool) *CommentUpdate {
+	if v != nil {
+		_u.SetNotifyOnReply(*v)
+	}
+	return _u
+}
+
+// SetSpamReason sets the "spam_reason" field.
+func (_u *CommentUpdate) SetSpamReason(v string) *CommentUpdate {
+	_u.mutation.SetSpamReason(v)
+	return _u
+}
+
+// SetNillableSpamReason sets the "spam_reason" field if the given value is not nil.
+func (_u *CommentUpdate) SetNillableSpamReason(v *string) *CommentUpdate {
+	if v != nil {
+		_u.SetSpamReason(*v)
+	}
+	return _u
+}
+
+// ClearSpamReason clears the value of the "spam_reason" field.
+func (_u *CommentUpdate) ClearSpamReason() *CommentUpdate {
+	_u.mutation.ClearSpamReason()
+	return _u
+}
+
+// SetIP sets the "ip" field.
+func (_u *CommentUpdate) SetIP(v string) *CommentUpdate {
+	_u.mutation.SetIP(v)
+	return _u
+}
+
+// SetNillableIP sets the "ip" field if the given value is not nil.
+func (_u *CommentUpdate) SetNillableIP(v *string) *CommentUpdate {
+	if v != nil {
+		_u.SetIP(*v)
+	}
+	return _u
+}
+
+// SetUserAgent sets the "user_agent" field.
+func (_u *CommentUpdate) SetUserAgent(v string) *CommentUpdate {
+	_u.mutation.SetUserAgent(v)
+	return _u
+}
+
+// SetNillableUserAgent sets the "user_agent" field if the given value is not nil.
+func (_u *CommentUpdate) SetNillableUserAgent(v *string) *CommentUpdate {
+	if v != nil {
+		_u.SetUserAgent(*v)
+	}
+	return _u
+}
+
+// SetPost sets the "post" edge to the Post entity.
+func (_u *CommentUpdate) SetPost(v *Post) *CommentUpdate {
+	return _u.SetPostID(v.ID)
+}
+
+// SetAuthorID sets the "author" edge to the User entity by ID.
+func (_u *CommentUpdate) SetAuthorID(id uint) *CommentUpdate {
+	_u.mutation.SetAuthorID(id)
+	return _u
+}
+
+// SetNillableAuthorID sets the "author" edge to the User entity by ID if the given value is not nil.
+func (_u *CommentUpdate) SetNillableAuthorID(id *uint) *CommentUpdate {
+	if id != nil {
+		_u = _u.SetAuthorID(*id)
+	}
+	return _u
+}
+
+// SetAuthor sets the "author" edge to the User entity.
+func (_u *CommentUpdate) SetAuthor(v *User) *CommentUpdate {
+	return _u.SetAuthorID(v.ID)
+}
+
+// SetParent sets the "parent" edge to the Comment entity.
+func (_u *CommentUpdate) SetParent(v *Comment) *CommentUpdate {
+	return _u.SetParentID(v.ID)
+}
+
+// AddReplyIDs adds the "replies" edge to the Comment entity by IDs.
+func (_u *CommentUpdate) AddReplyIDs(ids ...uint) *CommentUpdate {
+	_u.mutation.AddReplyIDs(ids...)
+	return _u
+}
+
+// AddReplies adds the "replies" edges to the Comment entity.
+func (_u *CommentUpdate) AddReplies(v ...*Comment) *CommentUpdate {
+	ids := make([]uint, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddReplyIDs(ids...)
+}
+
 // Mutation returns the CommentMutation object of the builder.
 func (_u *CommentUpdate) Mutation() *CommentMutation {
 	return _u.mutation
 }
 
+// ClearPost clears the "post" edge to the Post entity.
+func (_u *CommentUpdate) ClearPost() *CommentUpdate {
+	_u.mutation.ClearPost()
+	return _u
+}
+
+// ClearAuthor clears the "author" edge to the User entity.
+func (_u *CommentUpdate) ClearAuthor() *CommentUpdate {
+	_u.mutation.ClearAuthor()
+	return _u
+}
+
+// ClearParent clears the "parent" edge to the Comment entity.
+func (_u *CommentUpdate) ClearParent() *CommentUpdate {
+	_u.mutation.ClearParent()
+	return _u
+}
+
+// ClearReplies clears all "replies" edges to the Comment entity.
+func (_u *CommentUpdate) ClearReplies() *CommentUpdate {
+	_u.mutation.ClearReplies()
+	return _u
+}
+
+// RemoveReplyIDs removes the "replies" edge to Comment entities by IDs.
+func (_u *CommentUpdate) RemoveReplyIDs(ids ...uint) *CommentUpdate {
+	_u.mutation.RemoveReplyIDs(ids...)
+	return _u
+}
+
+// RemoveReplies removes "replies" edges to Comment entities.
+func (_u *CommentUpdate) RemoveReplies(v ...*Comment) *CommentUpdate {
+	ids := make([]uint, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveReplyIDs(ids...)
+}
+
 // Save executes the query and returns the number of nodes affected by the update operation.
 func (_u *CommentUpdate) Save(ctx context.Context) (int, error) {
 	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
@@ -109,6 +420,49 @@ func (_u *CommentUpdate) ExecX(ctx context.Context) {
 	}
 }
 
+// check runs all checks and user-defined validators on the builder.
+func (_u *CommentUpdate) check() error {
+	if v, ok := _u.mutation.AuthorName(); ok {
+		if err := comment.AuthorNameValidator(v); err != nil {
+			return &ValidationError{Name: "author_name", err: fmt.Errorf(`ent: validator failed for field "Comment.author_name": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.AuthorEmail(); ok {
+		if err := comment.AuthorEmailValidator(v); err != nil {
+			return &ValidationError{Name: "author_email", err: fmt.Errorf(`ent: validator failed for field "Comment.author_email": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.AuthorWebsite(); ok {
+		if err := comment.AuthorWebsiteValidator(v); err != nil {
+			return &ValidationError{Name: "author_website", err: fmt.Errorf(`ent: validator failed for field "Comment.author_website": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.Status(); ok {
+		if err := comment.StatusValidator(v); err != nil {
+			return &ValidationError{Name: "status", err: fmt.Errorf(`ent: validator failed for field "Comment.status": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.SpamReason(); ok {
+		if err := comment.SpamReasonValidator(v); err != nil {
+			return &ValidationError{Name: "spam_reason", err: fmt.Errorf(`ent: validator failed for field "Comment.spam_reason": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.IP(); ok {
+		if err := comment.IPValidator(v); err != nil {
+			return &ValidationError{Name: "ip", err: fmt.Errorf(`ent: validator failed for field "Comment.ip": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.UserAgent(); ok {
+		if err := comment.UserAgentValidator(v); err != nil {
+			return &ValidationError{Name: "user_agent", err: fmt.Errorf(`ent: validator failed for field "Comment.user_agent": %w`, err)}
+		}
+	}
+	if _u.mutation.PostCleared() && len(_u.mutation.PostIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "Comment.post"`)
+	}
+	return nil
+}
+
 // Modify adds a statement modifier for attaching custom logic to the UPDATE statement.
 func (_u *CommentUpdate) Modify(modifiers ...func(u *sql.UpdateBuilder)) *CommentUpdate {
 	_u.modifiers = append(_u.modifiers, modifiers...)
@@ -116,6 +470,9 @@ func (_u *CommentUpdate) Modify(modifiers ...func(u *sql.UpdateBuilder)) *Commen
 }
 
 func (_u *CommentUpdate) sqlSave(ctx context.Context) (_node int, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
 	_spec := sqlgraph.NewUpdateSpec(comment.Table, comment.Columns, sqlgraph.NewFieldSpec(comment.FieldID, field.TypeUint))
 	if ps := _u.mutation.predicates; len(ps) > 0 {
 		_spec.Predicate = func(selector *sql.Selector) {
@@ -136,6 +493,183 @@ func (_u *CommentUpdate) sqlSave(ctx context.Context) (_node int, err error) {
 	if _u.mutation.DeletedAtCleared() {
 		_spec.ClearField(comment.FieldDeletedAt, field.TypeTime)
 	}
+	if value, ok := _u.mutation.RootID(); ok {
+		_spec.SetField(comment.FieldRootID, field.TypeUint, value)
+	}
+	if value, ok := _u.mutation.AddedRootID(); ok {
+		_spec.AddField(comment.FieldRootID, field.TypeUint, value)
+	}
+	if _u.mutation.RootIDCleared() {
+		_spec.ClearField(comment.FieldRootID, field.TypeUint)
+	}
+	if value, ok := _u.mutation.AuthorName(); ok {
+		_spec.SetField(comment.FieldAuthorName, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.AuthorEmail(); ok {
+		_spec.SetField(comment.FieldAuthorEmail, field.TypeString, value)
+	}
+	if _u.mutation.AuthorEmailCleared() {
+		_spec.ClearField(comment.FieldAuthorEmail, field.TypeString)
+	}
+	if value, ok := _u.mutation.AuthorWebsite(); ok {
+		_spec.SetField(comment.FieldAuthorWebsite, field.TypeString, value)
+	}
+	if _u.mutation.AuthorWebsiteCleared() {
+		_spec.ClearField(comment.FieldAuthorWebsite, field.TypeString)
+	}
+	if value, ok := _u.mutation.Content(); ok {
+		_spec.SetField(comment.FieldContent, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Status(); ok {
+		_spec.SetField(comment.FieldStatus, field.TypeEnum, value)
+	}
+	if value, ok := _u.mutation.NotifyOnReply(); ok {
+		_spec.SetField(comment.FieldNotifyOnReply, field.TypeBool, value)
+	}
+	if value, ok := _u.mutation.SpamReason(); ok {
+		_spec.SetField(comment.FieldSpamReason, field.TypeString, value)
+	}
+	if _u.mutation.SpamReasonCleared() {
+		_spec.ClearField(comment.FieldSpamReason, field.TypeString)
+	}
+	if value, ok := _u.mutation.IP(); ok {
+		_spec.SetField(comment.FieldIP, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.UserAgent(); ok {
+		_spec.SetField(comment.FieldUserAgent, field.TypeString, value)
+	}
+	if _u.mutation.PostCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   comment.PostTable,
+			Columns: []string{comment.PostColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(post.FieldID, field.TypeUint),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.PostIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   comment.PostTable,
+			Columns: []string{comment.PostColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(post.FieldID, field.TypeUint),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.AuthorCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   comment.AuthorTable,
+			Columns: []string{comment.AuthorColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeUint),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.AuthorIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   comment.AuthorTable,
+			Columns: []string{comment.AuthorColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeUint),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.ParentCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   comment.ParentTable,
+			Columns: []string{comment.ParentColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(comment.FieldID, field.TypeUint),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.ParentIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   comment.ParentTable,
+			Columns: []string{comment.ParentColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(comment.FieldID, field.TypeUint),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.RepliesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   comment.RepliesTable,
+			Columns: []string{comment.RepliesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(comment.FieldID, field.TypeUint),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RemovedRepliesIDs(); len(nodes) > 0 && !_u.mutation.RepliesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   comment.RepliesTable,
+			Columns: []string{comment.RepliesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(comment.FieldID, field.TypeUint),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RepliesIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   comment.RepliesTable,
+			Columns: []string{comment.RepliesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(comment.FieldID, field.TypeUint),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
 	_spec.AddModifiers(_u.modifiers...)
 	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
 		if _, ok := err.(*sqlgraph.NotFoundError); ok {
@@ -206,11 +740,319 @@ func (_u *CommentUpdateOne) ClearDeletedAt() *CommentUpdateOne {
 	return _u
 }
 
+// SetPostID sets the "post_id" field.
+func (_u *CommentUpdateOne) SetPostID(v uint) *CommentUpdateOne {
+	_u.mutation.SetPostID(v)
+	return _u
+}
+
+// SetNillablePostID sets the "post_id" field if the given value is not nil.
+func (_u *CommentUpdateOne) SetNillablePostID(v *uint) *CommentUpdateOne {
+	if v != nil {
+		_u.SetPostID(*v)
+	}
+	return _u
+}
+
+// SetParentID sets the "parent_id" field.
+func (_u *CommentUpdateOne) SetParentID(v uint) *CommentUpdateOne {
+	_u.mutation.SetParentID(v)
+	return _u
+}
+
+// SetNillableParentID sets the "parent_id" field if the given value is not nil.
+func (_u *CommentUpdateOne) SetNillableParentID(v *uint) *CommentUpdateOne {
+	if v != nil {
+		_u.SetParentID(*v)
+	}
+	return _u
+}
+
+// ClearParentID clears the value of the "parent_id" field.
+func (_u *CommentUpdateOne) ClearParentID() *CommentUpdateOne {
+	_u.mutation.ClearParentID()
+	return _u
+}
+
+// SetRootID sets the "root_id" field.
+func (_u *CommentUpdateOne) SetRootID(v uint) *CommentUpdateOne {
+	_u.mutation.ResetRootID()
+	_u.mutation.SetRootID(v)
+	return _u
+}
+
+// SetNillableRootID sets the "root_id" field if the given value is not nil.
+func (_u *CommentUpdateOne) SetNillableRootID(v *uint) *CommentUpdateOne {
+	if v != nil {
+		_u.SetRootID(*v)
+	}
+	return _u
+}
+
+// AddRootID adds value to the "root_id" field.
+func (_u *CommentUpdateOne) AddRootID(v int) *CommentUpdateOne {
+	_u.mutation.AddRootID(v)
+	return _u
+}
+
+// ClearRootID clears the value of the "root_id" field.
+func (_u *CommentUpdateOne) ClearRootID() *CommentUpdateOne {
+	_u.mutation.ClearRootID()
+	return _u
+}
+
+// SetUserID sets the "user_id" field.
+func (_u *CommentUpdateOne) SetUserID(v uint) *CommentUpdateOne {
+	_u.mutation.SetUserID(v)
+	return _u
+}
+
+// SetNillableUserID sets the "user_id" field if the given value is not nil.
+func (_u *CommentUpdateOne) SetNillableUserID(v *uint) *CommentUpdateOne {
+	if v != nil {
+		_u.SetUserID(*v)
+	}
+	return _u
+}
+
+// ClearUserID clears the value of the "user_id" field.
+func (_u *CommentUpdateOne) ClearUserID() *CommentUpdateOne {
+	_u.mutation.ClearUserID()
+	return _u
+}
+
+// SetAuthorName sets the "author_name" field.
+func (_u *CommentUpdateOne) SetAuthorName(v string) *CommentUpdateOne {
+	_u.mutation.SetAuthorName(v)
+	return _u
+}
+
+// SetNillableAuthorName sets the "author_name" field if the given value is not nil.
+func (_u *CommentUpdateOne) SetNillableAuthorName(v *string) *CommentUpdateOne {
+	if v != nil {
+		_u.SetAuthorName(*v)
+	}
+	return _u
+}
+
+// SetAuthorEmail sets the "author_email" field.
+func (_u *CommentUpdateOne) SetAuthorEmail(v string) *CommentUpdateOne {
+	_u.mutation.SetAuthorEmail(v)
+	return _u
+}
+
+// SetNillableAuthorEmail sets the "author_email" field if the given value is not nil.
+func (_u *CommentUpdateOne) SetNillableAuthorEmail(v *string) *CommentUpdateOne {
+	if v != nil {
+		_u.SetAuthorEmail(*v)
+	}
+	return _u
+}
+
+// ClearAuthorEmail clears the value of the "author_email" field.
+func (_u *CommentUpdateOne) ClearAuthorEmail() *CommentUpdateOne {
+	_u.mutation.ClearAuthorEmail()
+	return _u
+}
+
+// SetAuthorWebsite sets the "author_website" field.
+func (_u *CommentUpdateOne) SetAuthorWebsite(v string) *CommentUpdateOne {
+	_u.mutation.SetAuthorWebsite(v)
+	return _u
+}
+
+// SetNillableAuthorWebsite sets the "author_website" field if the given value is not nil.
+func (_u *CommentUpdateOne) SetNillableAuthorWebsite(v *string) *CommentUpdateOne {
+	if v != nil {
+		_u.SetAuthorWebsite(*v)
+	}
+	return _u
+}
+
+// ClearAuthorWebsite clears the value of the "author_website" field.
+func (_u *CommentUpdateOne) ClearAuthorWebsite() *CommentUpdateOne {
+	_u.mutation.ClearAuthorWebsite()
+	return _u
+}
+
+// SetContent sets the "content" field.
+func (_u *CommentUpdateOne) SetContent(v string) *CommentUpdateOne {
+	_u.mutation.SetContent(v)
+	return _u
+}
+
+// SetNillableContent sets the "content" field if the given value is not nil.
+func (_u *CommentUpdateOne) SetNillableContent(v *string) *CommentUpdateOne {
+	if v != nil {
+		_u.SetContent(*v)
+	}
+	return _u
+}
+
+// SetStatus sets the "status" field.
+func (_u *CommentUpdateOne) SetStatus(v entity.CommentStatus) *CommentUpdateOne {
+	_u.mutation.SetStatus(v)
+	return _u
+}
+
+// SetNillableStatus sets the "status" field if the given value is not nil.
+func (_u *CommentUpdateOne) SetNillableStatus(v *entity.CommentStatus) *CommentUpdateOne {
+	if v != nil {
+		_u.SetStatus(*v)
+	}
+	return _u
+}
+
+// SetNotifyOnReply sets the "notify_on_reply" field.
+func (_u *CommentUpdateOne) SetNotifyOnReply(v bool) *CommentUpdateOne {
+	_u.mutation.SetNotifyOnReply(v)
+	return _u
+}
+
+// SetNillableNotifyOnReply sets the "notify_on_reply" field if the given value is not nil.
+func (_u *CommentUpdateOne) SetNillableNotifyOnReply(v *bool) *CommentUpdateOne {
+	if v != nil {
+		_u.SetNotifyOnReply(*v)
+	}
+	return _u
+}
+
+// SetSpamReason sets the "spam_reason" field.
+func (_u *CommentUpdateOne) SetSpamReason(v string) *CommentUpdateOne {
+	_u.mutation.SetSpamReason(v)
+	return _u
+}
+
+// SetNillableSpamReason sets the "spam_reason" field if the given value is not nil.
+func (_u *CommentUpdateOne) SetNillableSpamReason(v *string) *CommentUpdateOne {
+	if v != nil {
+		_u.SetSpamReason(*v)
+	}
+	return _u
+}
+
+// ClearSpamReason clears the value of the "spam_reason" field.
+func (_u *CommentUpdateOne) ClearSpamReason() *CommentUpdateOne {
+	_u.mutation.ClearSpamReason()
+	return _u
+}
+
+// SetIP sets the "ip" field.
+func (_u *CommentUpdateOne) SetIP(v string) *CommentUpdateOne {
+	_u.mutation.SetIP(v)
+	return _u
+}
+
+// SetNillableIP sets the "ip" field if the given value is not nil.
+func (_u *CommentUpdateOne) SetNillableIP(v *string) *CommentUpdateOne {
+	if v != nil {
+		_u.SetIP(*v)
+	}
+	return _u
+}
+
+// SetUserAgent sets the "user_agent" field.
+func (_u *CommentUpdateOne) SetUserAgent(v string) *CommentUpdateOne {
+	_u.mutation.SetUserAgent(v)
+	return _u
+}
+
+// SetNillableUserAgent sets the "user_agent" field if the given value is not nil.
+func (_u *CommentUpdateOne) SetNillableUserAgent(v *string) *CommentUpdateOne {
+	if v != nil {
+		_u.SetUserAgent(*v)
+	}
+	return _u
+}
+
+// SetPost sets the "post" edge to the Post entity.
+func (_u *CommentUpdateOne) SetPost(v *Post) *CommentUpdateOne {
+	return _u.SetPostID(v.ID)
+}
+
+// SetAuthorID sets the "author" edge to the User entity by ID.
+func (_u *CommentUpdateOne) SetAuthorID(id uint) *CommentUpdateOne {
+	_u.mutation.SetAuthorID(id)
+	return _u
+}
+
+// SetNillableAuthorID sets the "author" edge to the User entity by ID if the given value is not nil.
+func (_u *CommentUpdateOne) SetNillableAuthorID(id *uint) *CommentUpdateOne {
+	if id != nil {
+		_u = _u.SetAuthorID(*id)
+	}
+	return _u
+}
+
+// SetAuthor sets the "author" edge to the User entity.
+func (_u *CommentUpdateOne) SetAuthor(v *User) *CommentUpdateOne {
+	return _u.SetAuthorID(v.ID)
+}
+
+// SetParent sets the "parent" edge to the Comment entity.
+func (_u *CommentUpdateOne) SetParent(v *Comment) *CommentUpdateOne {
+	return _u.SetParentID(v.ID)
+}
+
+// AddReplyIDs adds the "replies" edge to the Comment entity by IDs.
+func (_u *CommentUpdateOne) AddReplyIDs(ids ...uint) *CommentUpdateOne {
+	_u.mutation.AddReplyIDs(ids...)
+	return _u
+}
+
+// AddReplies adds the "replies" edges to the Comment entity.
+func (_u *CommentUpdateOne) AddReplies(v ...*Comment) *CommentUpdateOne {
+	ids := make([]uint, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddReplyIDs(ids...)
+}
+
 // Mutation returns the CommentMutation object of the builder.
 func (_u *CommentUpdateOne) Mutation() *CommentMutation {
 	return _u.mutation
 }
 
+// ClearPost clears the "post" edge to the Post entity.
+func (_u *CommentUpdateOne) ClearPost() *CommentUpdateOne {
+	_u.mutation.ClearPost()
+	return _u
+}
+
+// ClearAuthor clears the "author" edge to the User entity.
+func (_u *CommentUpdateOne) ClearAuthor() *CommentUpdateOne {
+	_u.mutation.ClearAuthor()
+	return _u
+}
+
+// ClearParent clears the "parent" edge to the Comment entity.
+func (_u *CommentUpdateOne) ClearParent() *CommentUpdateOne {
+	_u.mutation.ClearParent()
+	return _u
+}
+
+// ClearReplies clears all "replies" edges to the Comment entity.
+func (_u *CommentUpdateOne) ClearReplies() *CommentUpdateOne {
+	_u.mutation.ClearReplies()
+	return _u
+}
+
+// RemoveReplyIDs removes the "replies" edge to Comment entities by IDs.
+func (_u *CommentUpdateOne) RemoveReplyIDs(ids ...uint) *CommentUpdateOne {
+	_u.mutation.RemoveReplyIDs(ids...)
+	return _u
+}
+
+// RemoveReplies removes "replies" edges to Comment entities.
+func (_u *CommentUpdateOne) RemoveReplies(v ...*Comment) *CommentUpdateOne {
+	ids := make([]uint, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveReplyIDs(ids...)
+}
+
 // Where appends a list predicates to the CommentUpdate builder.
 func (_u *CommentUpdateOne) Where(ps ...predicate.Comment) *CommentUpdateOne {
 	_u.mutation.Where(ps...)
@@ -251,6 +1093,49 @@ func (_u *CommentUpdateOne) ExecX(ctx context.Context) {
 	}
 }
 
+// check runs all checks and user-defined validators on the builder.
+func (_u *CommentUpdateOne) check() error {
+	if v, ok := _u.mutation.AuthorName(); ok {
+		if err := comment.AuthorNameValidator(v); err != nil {
+			return &ValidationError{Name: "author_name", err: fmt.Errorf(`ent: validator failed for field "Comment.author_name": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.AuthorEmail(); ok {
+		if err := comment.AuthorEmailValidator(v); err != nil {
+			return &ValidationError{Name: "author_email", err: fmt.Errorf(`ent: validator failed for field "Comment.author_email": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.AuthorWebsite(); ok {
+		if err := comment.AuthorWebsiteValidator(v); err != nil {
+			return &ValidationError{Name: "author_website", err: fmt.Errorf(`ent: validator failed for field "Comment.author_website": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.Status(); ok {
+		if err := comment.StatusValidator(v); err != nil {
+			return &ValidationError{Name: "status", err: fmt.Errorf(`ent: validator failed for field "Comment.status": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.SpamReason(); ok {
+		if err := comment.SpamReasonValidator(v); err != nil {
+			return &ValidationError{Name: "spam_reason", err: fmt.Errorf(`ent: validator failed for field "Comment.spam_reason": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.IP(); ok {
+		if err := comment.IPValidator(v); err != nil {
+			return &ValidationError{Name: "ip", err: fmt.Errorf(`ent: validator failed for field "Comment.ip": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.UserAgent(); ok {
+		if err := comment.UserAgentValidator(v); err != nil {
+			return &ValidationError{Name: "user_agent", err: fmt.Errorf(`ent: validator failed for field "Comment.user_agent": %w`, err)}
+		}
+	}
+	if _u.mutation.PostCleared() && len(_u.mutation.PostIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "Comment.post"`)
+	}
+	return nil
+}
+
 // Modify adds a statement modifier for attaching custom logic to the UPDATE statement.
 func (_u *CommentUpdateOne) Modify(modifiers ...func(u *sql.UpdateBuilder)) *CommentUpdateOne {
 	_u.modifiers = append(_u.modifiers, modifiers...)
@@ -258,6 +1143,9 @@ func (_u *CommentUpdateOne) Modify(modifiers ...func(u *sql.UpdateBuilder)) *Com
 }
 
 func (_u *CommentUpdateOne) sqlSave(ctx context.Context) (_node *Comment, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
 	_spec := sqlgraph.NewUpdateSpec(comment.Table, comment.Columns, sqlgraph.NewFieldSpec(comment.FieldID, field.TypeUint))
 	id, ok := _u.mutation.ID()
 	if !ok {
@@ -295,6 +1183,183 @@ func (_u *CommentUpdateOne) sqlSave(ctx context.Context) (_node *Comment, err er
 	if _u.mutation.DeletedAtCleared() {
 		_spec.ClearField(comment.FieldDeletedAt, field.TypeTime)
 	}
+	if value, ok := _u.mutation.RootID(); ok {
+		_spec.SetField(comment.FieldRootID, field.TypeUint, value)
+	}
+	if value, ok := _u.mutation.AddedRootID(); ok {
+		_spec.AddField(comment.FieldRootID, field.TypeUint, value)
+	}
+	if _u.mutation.RootIDCleared() {
+		_spec.ClearField(comment.FieldRootID, field.TypeUint)
+	}
+	if value, ok := _u.mutation.AuthorName(); ok {
+		_spec.SetField(comment.FieldAuthorName, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.AuthorEmail(); ok {
+		_spec.SetField(comment.FieldAuthorEmail, field.TypeString, value)
+	}
+	if _u.mutation.AuthorEmailCleared() {
+		_spec.ClearField(comment.FieldAuthorEmail, field.TypeString)
+	}
+	if value, ok := _u.mutation.AuthorWebsite(); ok {
+		_spec.SetField(comment.FieldAuthorWebsite, field.TypeString, value)
+	}
+	if _u.mutation.AuthorWebsiteCleared() {
+		_spec.ClearField(comment.FieldAuthorWebsite, field.TypeString)
+	}
+	if value, ok := _u.mutation.Content(); ok {
+		_spec.SetField(comment.FieldContent, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Status(); ok {
+		_spec.SetField(comment.FieldStatus, field.TypeEnum, value)
+	}
+	if value, ok := _u.mutation.NotifyOnReply(); ok {
+		_spec.SetField(comment.FieldNotifyOnReply, field.TypeBool, value)
+	}
+	if value, ok := _u.mutation.SpamReason(); ok {
+		_spec.SetField(comment.FieldSpamReason, field.TypeString, value)
+	}
+	if _u.mutation.SpamReasonCleared() {
+		_spec.ClearField(comment.FieldSpamReason, field.TypeString)
+	}
+	if value, ok := _u.mutation.IP(); ok {
+		_spec.SetField(comment.FieldIP, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.UserAgent(); ok {
+		_spec.SetField(comment.FieldUserAgent, field.TypeString, value)
+	}
+	if _u.mutation.PostCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   comment.PostTable,
+			Columns: []string{comment.PostColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(post.FieldID, field.TypeUint),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.PostIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   comment.PostTable,
+			Columns: []string{comment.PostColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(post.FieldID, field.TypeUint),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.AuthorCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   comment.AuthorTable,
+			Columns: []string{comment.AuthorColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeUint),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.AuthorIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   comment.AuthorTable,
+			Columns: []string{comment.AuthorColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeUint),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.ParentCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   comment.ParentTable,
+			Columns: []string{comment.ParentColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(comment.FieldID, field.TypeUint),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.ParentIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   comment.ParentTable,
+			Columns: []string{comment.ParentColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(comment.FieldID, field.TypeUint),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.RepliesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   comment.RepliesTable,
+			Columns: []string{comment.RepliesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(comment.FieldID, field.TypeUint),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RemovedRepliesIDs(); len(nodes) > 0 && !_u.mutation.RepliesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   comment.RepliesTable,
+			Columns: []string{comment.RepliesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(comment.FieldID, field.TypeUint),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RepliesIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   comment.RepliesTable,
+			Columns: []string{comment.RepliesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(comment.FieldID, field.TypeUint),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
 	_spec.AddModifiers(_u.modifiers...)
 	_node = &Comment{config: _u.config}
 	_spec.Assign = _node.assignValues
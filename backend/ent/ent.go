@@ -6,12 +6,18 @@ import (
 	"blog-server/ent/comment"
 	"blog-server/ent/link"
 	"blog-server/ent/linkcategory"
+	"blog-server/ent/passkey"
+	"blog-server/ent/personalaccesstoken"
 	"blog-server/ent/post"
 	"blog-server/ent/postcategory"
 	"blog-server/ent/postcategoryrelation"
+	"blog-server/ent/postrevision"
+	"blog-server/ent/postslug"
 	"blog-server/ent/posttag"
 	"blog-server/ent/posttagrelation"
+	"blog-server/ent/signingkey"
 	"blog-server/ent/user"
+	"blog-server/ent/useridentity"
 	"context"
 	"errors"
 	"fmt"
@@ -84,12 +90,18 @@ func checkColumn(t, c string) error {
 			comment.Table:              comment.ValidColumn,
 			link.Table:                 link.ValidColumn,
 			linkcategory.Table:         linkcategory.ValidColumn,
+			passkey.Table:              passkey.ValidColumn,
+			personalaccesstoken.Table:  personalaccesstoken.ValidColumn,
 			post.Table:                 post.ValidColumn,
 			postcategory.Table:         postcategory.ValidColumn,
 			postcategoryrelation.Table: postcategoryrelation.ValidColumn,
+			postrevision.Table:         postrevision.ValidColumn,
+			postslug.Table:             postslug.ValidColumn,
 			posttag.Table:              posttag.ValidColumn,
 			posttagrelation.Table:      posttagrelation.ValidColumn,
+			signingkey.Table:           signingkey.ValidColumn,
 			user.Table:                 user.ValidColumn,
+			useridentity.Table:         useridentity.ValidColumn,
 		})
 	})
 	return columnCheck(t, c)
@@ -15,12 +15,57 @@ var (
 		{Name: "created_at", Type: field.TypeTime},
 		{Name: "updated_at", Type: field.TypeTime},
 		{Name: "deleted_at", Type: field.TypeTime, Nullable: true},
+		{Name: "root_id", Type: field.TypeUint, Nullable: true},
+		{Name: "author_name", Type: field.TypeString, Size: 50},
+		{Name: "author_email", Type: field.TypeString, Nullable: true, Size: 100},
+		{Name: "author_website", Type: field.TypeString, Nullable: true, Size: 255},
+		{Name: "content", Type: field.TypeString, Size: 2147483647},
+		{Name: "status", Type: field.TypeEnum, Enums: []string{"pending", "approved", "rejected", "spam", "deleted"}, Default: "pending"},
+		{Name: "notify_on_reply", Type: field.TypeBool, Default: false},
+		{Name: "spam_reason", Type: field.TypeString, Nullable: true, Size: 512},
+		{Name: "ip", Type: field.TypeString, Size: 64, Default: ""},
+		{Name: "user_agent", Type: field.TypeString, Size: 512, Default: ""},
+		{Name: "parent_id", Type: field.TypeUint, Nullable: true},
+		{Name: "post_id", Type: field.TypeUint},
+		{Name: "user_id", Type: field.TypeUint, Nullable: true},
 	}
 	// CommentsTable holds the schema information for the "comments" table.
 	CommentsTable = &schema.Table{
 		Name:       "comments",
 		Columns:    CommentsColumns,
 		PrimaryKey: []*schema.Column{CommentsColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "comments_comments_replies",
+				Columns:    []*schema.Column{CommentsColumns[14]},
+				RefColumns: []*schema.Column{CommentsColumns[0]},
+				OnDelete:   schema.SetNull,
+			},
+			{
+				Symbol:     "comments_posts_comments",
+				Columns:    []*schema.Column{CommentsColumns[15]},
+				RefColumns: []*schema.Column{PostsColumns[0]},
+				OnDelete:   schema.NoAction,
+			},
+			{
+				Symbol:     "comments_users_comments",
+				Columns:    []*schema.Column{CommentsColumns[16]},
+				RefColumns: []*schema.Column{UsersColumns[0]},
+				OnDelete:   schema.SetNull,
+			},
+		},
+		Indexes: []*schema.Index{
+			{
+				Name:    "comment_post_id_status",
+				Unique:  false,
+				Columns: []*schema.Column{CommentsColumns[15], CommentsColumns[9]},
+			},
+			{
+				Name:    "comment_root_id",
+				Unique:  false,
+				Columns: []*schema.Column{CommentsColumns[4]},
+			},
+		},
 	}
 	// LinksColumns holds the columns for the "links" table.
 	LinksColumns = []*schema.Column{
@@ -66,6 +111,73 @@ var (
 		Columns:    LinkCategoriesColumns,
 		PrimaryKey: []*schema.Column{LinkCategoriesColumns[0]},
 	}
+	// PasskeysColumns holds the columns for the "passkeys" table.
+	PasskeysColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeUint, Increment: true},
+		{Name: "created_at", Type: field.TypeTime},
+		{Name: "updated_at", Type: field.TypeTime},
+		{Name: "deleted_at", Type: field.TypeTime, Nullable: true},
+		{Name: "credential_id", Type: field.TypeString, Unique: true, Size: 1366},
+		{Name: "public_key", Type: field.TypeBytes},
+		{Name: "algorithm", Type: field.TypeInt},
+		{Name: "sign_count", Type: field.TypeUint32, Default: 0},
+		{Name: "name", Type: field.TypeString, Size: 64},
+		{Name: "aaguid", Type: field.TypeString, Nullable: true, Size: 36},
+		{Name: "transports", Type: field.TypeJSON, Nullable: true},
+		{Name: "last_used_at", Type: field.TypeTime, Nullable: true},
+		{Name: "user_id", Type: field.TypeUint},
+	}
+	// PasskeysTable holds the schema information for the "passkeys" table.
+	PasskeysTable = &schema.Table{
+		Name:       "passkeys",
+		Columns:    PasskeysColumns,
+		PrimaryKey: []*schema.Column{PasskeysColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "passkeys_users_passkeys",
+				Columns:    []*schema.Column{PasskeysColumns[12]},
+				RefColumns: []*schema.Column{UsersColumns[0]},
+				OnDelete:   schema.NoAction,
+			},
+		},
+	}
+	// PersonalAccessTokensColumns holds the columns for the "personal_access_tokens" table.
+	PersonalAccessTokensColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeUint, Increment: true},
+		{Name: "created_at", Type: field.TypeTime},
+		{Name: "updated_at", Type: field.TypeTime},
+		{Name: "deleted_at", Type: field.TypeTime, Nullable: true},
+		{Name: "name", Type: field.TypeString, Size: 64},
+		{Name: "token_hash", Type: field.TypeString, Unique: true, Size: 64},
+		{Name: "token_prefix", Type: field.TypeString, Size: 16},
+		{Name: "scopes", Type: field.TypeJSON},
+		{Name: "expires_at", Type: field.TypeTime},
+		{Name: "two_factor", Type: field.TypeBool, Default: false},
+		{Name: "last_used_at", Type: field.TypeTime, Nullable: true},
+		{Name: "revoked_at", Type: field.TypeTime, Nullable: true},
+		{Name: "user_id", Type: field.TypeUint},
+	}
+	// PersonalAccessTokensTable holds the schema information for the "personal_access_tokens" table.
+	PersonalAccessTokensTable = &schema.Table{
+		Name:       "personal_access_tokens",
+		Columns:    PersonalAccessTokensColumns,
+		PrimaryKey: []*schema.Column{PersonalAccessTokensColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "personal_access_tokens_users_access_tokens",
+				Columns:    []*schema.Column{PersonalAccessTokensColumns[12]},
+				RefColumns: []*schema.Column{UsersColumns[0]},
+				OnDelete:   schema.NoAction,
+			},
+		},
+		Indexes: []*schema.Index{
+			{
+				Name:    "personalaccesstoken_user_id",
+				Unique:  false,
+				Columns: []*schema.Column{PersonalAccessTokensColumns[12]},
+			},
+		},
+	}
 	// PostsColumns holds the columns for the "posts" table.
 	PostsColumns = []*schema.Column{
 		{Name: "id", Type: field.TypeUint, Increment: true},
@@ -73,13 +185,15 @@ var (
 		{Name: "updated_at", Type: field.TypeTime},
 		{Name: "deleted_at", Type: field.TypeTime, Nullable: true},
 		{Name: "title", Type: field.TypeString, Size: 255},
+		{Name: "slug", Type: field.TypeString, Unique: true, Nullable: true, Size: 255},
 		{Name: "summary", Type: field.TypeString, Nullable: true, Size: 255},
 		{Name: "content", Type: field.TypeString, Size: 2147483647},
 		{Name: "cover", Type: field.TypeString, Nullable: true, Size: 255},
 		{Name: "read_time_minutes", Type: field.TypeUint},
 		{Name: "view_count", Type: field.TypeUint, Default: 0},
-		{Name: "status", Type: field.TypeEnum, Enums: []string{"draft", "published", "archived"}, Default: "draft"},
+		{Name: "status", Type: field.TypeEnum, Enums: []string{"draft", "published", "archived", "scheduled"}, Default: "draft"},
 		{Name: "published_at", Type: field.TypeTime, Nullable: true},
+		{Name: "search_vector", Type: field.TypeString, Nullable: true, SchemaType: map[string]string{"postgres": "tsvector"}},
 		{Name: "user_id", Type: field.TypeUint},
 	}
 	// PostsTable holds the schema information for the "posts" table.
@@ -90,11 +204,21 @@ var (
 		ForeignKeys: []*schema.ForeignKey{
 			{
 				Symbol:     "posts_users_posts",
-				Columns:    []*schema.Column{PostsColumns[12]},
+				Columns:    []*schema.Column{PostsColumns[14]},
 				RefColumns: []*schema.Column{UsersColumns[0]},
 				OnDelete:   schema.NoAction,
 			},
 		},
+		Indexes: []*schema.Index{
+			{
+				Name:    "post_search_vector",
+				Unique:  false,
+				Columns: []*schema.Column{PostsColumns[13]},
+				Annotation: &entsql.IndexAnnotation{
+					Type: "GIN",
+				},
+			},
+		},
 	}
 	// PostCategoriesColumns holds the columns for the "post_categories" table.
 	PostCategoriesColumns = []*schema.Column{
@@ -136,6 +260,77 @@ var (
 			},
 		},
 	}
+	// PostRevisionsColumns holds the columns for the "post_revisions" table.
+	PostRevisionsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeUint, Increment: true},
+		{Name: "created_at", Type: field.TypeTime},
+		{Name: "updated_at", Type: field.TypeTime},
+		{Name: "deleted_at", Type: field.TypeTime, Nullable: true},
+		{Name: "version", Type: field.TypeUint},
+		{Name: "title", Type: field.TypeString, Size: 255},
+		{Name: "summary", Type: field.TypeString, Nullable: true, Size: 255},
+		{Name: "content", Type: field.TypeString, Size: 2147483647},
+		{Name: "restored_from", Type: field.TypeUint, Nullable: true},
+		{Name: "post_id", Type: field.TypeUint},
+		{Name: "user_id", Type: field.TypeUint, Nullable: true},
+	}
+	// PostRevisionsTable holds the schema information for the "post_revisions" table.
+	PostRevisionsTable = &schema.Table{
+		Name:       "post_revisions",
+		Columns:    PostRevisionsColumns,
+		PrimaryKey: []*schema.Column{PostRevisionsColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "post_revisions_posts_revisions",
+				Columns:    []*schema.Column{PostRevisionsColumns[9]},
+				RefColumns: []*schema.Column{PostsColumns[0]},
+				OnDelete:   schema.NoAction,
+			},
+			{
+				Symbol:     "post_revisions_users_post_revisions",
+				Columns:    []*schema.Column{PostRevisionsColumns[10]},
+				RefColumns: []*schema.Column{UsersColumns[0]},
+				OnDelete:   schema.SetNull,
+			},
+		},
+		Indexes: []*schema.Index{
+			{
+				Name:    "postrevision_post_id_version",
+				Unique:  true,
+				Columns: []*schema.Column{PostRevisionsColumns[9], PostRevisionsColumns[4]},
+			},
+		},
+	}
+	// PostSlugsColumns holds the columns for the "post_slugs" table.
+	PostSlugsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeUint, Increment: true},
+		{Name: "created_at", Type: field.TypeTime},
+		{Name: "updated_at", Type: field.TypeTime},
+		{Name: "deleted_at", Type: field.TypeTime, Nullable: true},
+		{Name: "slug", Type: field.TypeString, Unique: true, Size: 255},
+		{Name: "post_id", Type: field.TypeUint},
+	}
+	// PostSlugsTable holds the schema information for the "post_slugs" table.
+	PostSlugsTable = &schema.Table{
+		Name:       "post_slugs",
+		Columns:    PostSlugsColumns,
+		PrimaryKey: []*schema.Column{PostSlugsColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "post_slugs_posts_slug_history",
+				Columns:    []*schema.Column{PostSlugsColumns[5]},
+				RefColumns: []*schema.Column{PostsColumns[0]},
+				OnDelete:   schema.NoAction,
+			},
+		},
+		Indexes: []*schema.Index{
+			{
+				Name:    "postslug_post_id",
+				Unique:  false,
+				Columns: []*schema.Column{PostSlugsColumns[5]},
+			},
+		},
+	}
 	// PostTagsColumns holds the columns for the "post_tags" table.
 	PostTagsColumns = []*schema.Column{
 		{Name: "id", Type: field.TypeUint, Increment: true},
@@ -176,6 +371,25 @@ var (
 			},
 		},
 	}
+	// SigningKeysColumns holds the columns for the "signing_keys" table.
+	SigningKeysColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeUint, Increment: true},
+		{Name: "created_at", Type: field.TypeTime},
+		{Name: "updated_at", Type: field.TypeTime},
+		{Name: "deleted_at", Type: field.TypeTime, Nullable: true},
+		{Name: "kid", Type: field.TypeString, Unique: true, Size: 64},
+		{Name: "algorithm", Type: field.TypeString, Size: 16},
+		{Name: "private_key", Type: field.TypeString, Size: 2147483647},
+		{Name: "public_key", Type: field.TypeString, Size: 2147483647},
+		{Name: "activates_at", Type: field.TypeTime, Nullable: true},
+		{Name: "retired_at", Type: field.TypeTime, Nullable: true},
+	}
+	// SigningKeysTable holds the schema information for the "signing_keys" table.
+	SigningKeysTable = &schema.Table{
+		Name:       "signing_keys",
+		Columns:    SigningKeysColumns,
+		PrimaryKey: []*schema.Column{SigningKeysColumns[0]},
+	}
 	// UsersColumns holds the columns for the "users" table.
 	UsersColumns = []*schema.Column{
 		{Name: "id", Type: field.TypeUint, Increment: true},
@@ -186,8 +400,13 @@ var (
 		{Name: "avatar", Type: field.TypeString, Nullable: true, Size: 255},
 		{Name: "email", Type: field.TypeString, Unique: true, Size: 100},
 		{Name: "password", Type: field.TypeString, Size: 255},
-		{Name: "role", Type: field.TypeEnum, Enums: []string{"reader", "admin"}, Default: "reader"},
-		{Name: "username", Type: field.TypeString, Size: 50},
+		{Name: "role", Type: field.TypeEnum, Enums: []string{"reader", "author", "editor", "admin"}, Default: "reader"},
+		{Name: "status", Type: field.TypeEnum, Enums: []string{"active", "disabled", "banned"}, Default: "active"},
+		{Name: "username", Type: field.TypeString, Unique: true, Size: 50},
+		{Name: "bio", Type: field.TypeString, Nullable: true, Size: 500},
+		{Name: "totp_secret", Type: field.TypeString, Nullable: true, Size: 64},
+		{Name: "totp_enabled", Type: field.TypeBool, Default: false},
+		{Name: "totp_recovery_codes", Type: field.TypeJSON, Nullable: true},
 	}
 	// UsersTable holds the schema information for the "users" table.
 	UsersTable = &schema.Table{
@@ -195,31 +414,84 @@ var (
 		Columns:    UsersColumns,
 		PrimaryKey: []*schema.Column{UsersColumns[0]},
 	}
+	// UserIdentitiesColumns holds the columns for the "user_identities" table.
+	UserIdentitiesColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeUint, Increment: true},
+		{Name: "created_at", Type: field.TypeTime},
+		{Name: "updated_at", Type: field.TypeTime},
+		{Name: "deleted_at", Type: field.TypeTime, Nullable: true},
+		{Name: "provider", Type: field.TypeString, Size: 32},
+		{Name: "subject", Type: field.TypeString, Size: 255},
+		{Name: "email", Type: field.TypeString, Nullable: true, Size: 100},
+		{Name: "last_login_at", Type: field.TypeTime, Nullable: true},
+		{Name: "user_id", Type: field.TypeUint},
+	}
+	// UserIdentitiesTable holds the schema information for the "user_identities" table.
+	UserIdentitiesTable = &schema.Table{
+		Name:       "user_identities",
+		Columns:    UserIdentitiesColumns,
+		PrimaryKey: []*schema.Column{UserIdentitiesColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "user_identities_users_identities",
+				Columns:    []*schema.Column{UserIdentitiesColumns[8]},
+				RefColumns: []*schema.Column{UsersColumns[0]},
+				OnDelete:   schema.NoAction,
+			},
+		},
+		Indexes: []*schema.Index{
+			{
+				Name:    "useridentity_provider_subject",
+				Unique:  true,
+				Columns: []*schema.Column{UserIdentitiesColumns[4], UserIdentitiesColumns[5]},
+			},
+			{
+				Name:    "useridentity_user_id",
+				Unique:  false,
+				Columns: []*schema.Column{UserIdentitiesColumns[8]},
+			},
+		},
+	}
 	// Tables holds all the tables in the schema.
 	Tables = []*schema.Table{
 		CommentsTable,
 		LinksTable,
 		LinkCategoriesTable,
+		PasskeysTable,
+		PersonalAccessTokensTable,
 		PostsTable,
 		PostCategoriesTable,
 		PostCategoryRelationsTable,
+		PostRevisionsTable,
+		PostSlugsTable,
 		PostTagsTable,
 		PostTagRelationsTable,
+		SigningKeysTable,
 		UsersTable,
+		UserIdentitiesTable,
 	}
 )
 
 func init() {
+	CommentsTable.ForeignKeys[0].RefTable = CommentsTable
+	CommentsTable.ForeignKeys[1].RefTable = PostsTable
+	CommentsTable.ForeignKeys[2].RefTable = UsersTable
 	LinksTable.ForeignKeys[0].RefTable = LinkCategoriesTable
+	PasskeysTable.ForeignKeys[0].RefTable = UsersTable
+	PersonalAccessTokensTable.ForeignKeys[0].RefTable = UsersTable
 	PostsTable.ForeignKeys[0].RefTable = UsersTable
 	PostCategoryRelationsTable.ForeignKeys[0].RefTable = PostsTable
 	PostCategoryRelationsTable.ForeignKeys[1].RefTable = PostCategoriesTable
 	PostCategoryRelationsTable.Annotation = &entsql.Annotation{
 		Table: "post_category_relations",
 	}
+	PostRevisionsTable.ForeignKeys[0].RefTable = PostsTable
+	PostRevisionsTable.ForeignKeys[1].RefTable = UsersTable
+	PostSlugsTable.ForeignKeys[0].RefTable = PostsTable
 	PostTagRelationsTable.ForeignKeys[0].RefTable = PostsTable
 	PostTagRelationsTable.ForeignKeys[1].RefTable = PostTagsTable
 	PostTagRelationsTable.Annotation = &entsql.Annotation{
 		Table: "post_tag_relations",
 	}
+	UserIdentitiesTable.ForeignKeys[0].RefTable = UsersTable
 }
@@ -6,13 +6,19 @@ import (
 	"blog-server/ent/comment"
 	"blog-server/ent/link"
 	"blog-server/ent/linkcategory"
+	"blog-server/ent/passkey"
+	"blog-server/ent/personalaccesstoken"
 	"blog-server/ent/post"
 	"blog-server/ent/postcategory"
 	"blog-server/ent/postcategoryrelation"
+	"blog-server/ent/postrevision"
+	"blog-server/ent/postslug"
 	"blog-server/ent/posttag"
 	"blog-server/ent/posttagrelation"
 	"blog-server/ent/predicate"
+	"blog-server/ent/signingkey"
 	"blog-server/ent/user"
+	"blog-server/ent/useridentity"
 	"blog-server/entity"
 	"context"
 	"errors"
@@ -37,27 +43,53 @@ const (
 	TypeComment              = "Comment"
 	TypeLink                 = "Link"
 	TypeLinkCategory         = "LinkCategory"
+	TypePasskey              = "Passkey"
+	TypePersonalAccessToken  = "PersonalAccessToken"
 	TypePost                 = "Post"
 	TypePostCategory         = "PostCategory"
 	TypePostCategoryRelation = "PostCategoryRelation"
+	TypePostRevision         = "PostRevision"
+	TypePostSlug             = "PostSlug"
 	TypePostTag              = "PostTag"
 	TypePostTagRelation      = "PostTagRelation"
+	TypeSigningKey           = "SigningKey"
 	TypeUser                 = "User"
+	TypeUserIdentity         = "UserIdentity"
 )
 
 // CommentMutation represents an operation that mutates the Comment nodes in the graph.
 type CommentMutation struct {
 	config
-	op            Op
-	typ           string
-	id            *uint
-	created_at    *time.Time
-	updated_at    *time.Time
-	deleted_at    *time.Time
-	clearedFields map[string]struct{}
-	done          bool
-	oldValue      func(context.Context) (*Comment, error)
-	predicates    []predicate.Comment
+	op              Op
+	typ             string
+	id              *uint
+	created_at      *time.Time
+	updated_at      *time.Time
+	deleted_at      *time.Time
+	root_id         *uint
+	addroot_id      *int
+	author_name     *string
+	author_email    *string
+	author_website  *string
+	content         *string
+	status          *entity.CommentStatus
+	notify_on_reply *bool
+	spam_reason     *string
+	ip              *string
+	user_agent      *string
+	clearedFields   map[string]struct{}
+	post            *uint
+	clearedpost     bool
+	author          *uint
+	clearedauthor   bool
+	parent          *uint
+	clearedparent   bool
+	replies         map[uint]struct{}
+	removedreplies  map[uint]struct{}
+	clearedreplies  bool
+	done            bool
+	oldValue        func(context.Context) (*Comment, error)
+	predicates      []predicate.Comment
 }
 
 var _ ent.Mutation = (*CommentMutation)(nil)
@@ -285,919 +317,803 @@ func (m *CommentMutation) ResetDeletedAt() {
 	delete(m.clearedFields, comment.FieldDeletedAt)
 }
 
-// Where appends a list predicates to the CommentMutation builder.
-func (m *CommentMutation) Where(ps ...predicate.Comment) {
-	m.predicates = append(m.predicates, ps...)
+// SetPostID sets the "post_id" field.
+func (m *CommentMutation) SetPostID(u uint) {
+	m.post = &u
 }
 
-// WhereP appends storage-level predicates to the CommentMutation builder. Using this method,
-// users can use type-assertion to append predicates that do not depend on any generated package.
-func (m *CommentMutation) WhereP(ps ...func(*sql.Selector)) {
-	p := make([]predicate.Comment, len(ps))
-	for i := range ps {
-		p[i] = ps[i]
+// PostID returns the value of the "post_id" field in the mutation.
+func (m *CommentMutation) PostID() (r uint, exists bool) {
+	v := m.post
+	if v == nil {
+		return
 	}
-	m.Where(p...)
+	return *v, true
 }
 
-// Op returns the operation name.
-func (m *CommentMutation) Op() Op {
-	return m.op
+// OldPostID returns the old "post_id" field's value of the Comment entity.
+// If the Comment object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *CommentMutation) OldPostID(ctx context.Context) (v uint, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldPostID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldPostID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldPostID: %w", err)
+	}
+	return oldValue.PostID, nil
 }
 
-// SetOp allows setting the mutation operation.
-func (m *CommentMutation) SetOp(op Op) {
-	m.op = op
+// ResetPostID resets all changes to the "post_id" field.
+func (m *CommentMutation) ResetPostID() {
+	m.post = nil
 }
 
-// Type returns the node type of this mutation (Comment).
-func (m *CommentMutation) Type() string {
-	return m.typ
+// SetParentID sets the "parent_id" field.
+func (m *CommentMutation) SetParentID(u uint) {
+	m.parent = &u
 }
 
-// Fields returns all fields that were changed during this mutation. Note that in
-// order to get all numeric fields that were incremented/decremented, call
-// AddedFields().
-func (m *CommentMutation) Fields() []string {
-	fields := make([]string, 0, 3)
-	if m.created_at != nil {
-		fields = append(fields, comment.FieldCreatedAt)
-	}
-	if m.updated_at != nil {
-		fields = append(fields, comment.FieldUpdatedAt)
-	}
-	if m.deleted_at != nil {
-		fields = append(fields, comment.FieldDeletedAt)
+// ParentID returns the value of the "parent_id" field in the mutation.
+func (m *CommentMutation) ParentID() (r uint, exists bool) {
+	v := m.parent
+	if v == nil {
+		return
 	}
-	return fields
+	return *v, true
 }
 
-// Field returns the value of a field with the given name. The second boolean
-// return value indicates that this field was not set, or was not defined in the
-// schema.
-func (m *CommentMutation) Field(name string) (ent.Value, bool) {
-	switch name {
-	case comment.FieldCreatedAt:
-		return m.CreatedAt()
-	case comment.FieldUpdatedAt:
-		return m.UpdatedAt()
-	case comment.FieldDeletedAt:
-		return m.DeletedAt()
+// OldParentID returns the old "parent_id" field's value of the Comment entity.
+// If the Comment object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *CommentMutation) OldParentID(ctx context.Context) (v *uint, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldParentID is only allowed on UpdateOne operations")
 	}
-	return nil, false
-}
-
-// OldField returns the old value of the field from the database. An error is
-// returned if the mutation operation is not UpdateOne, or the query to the
-// database failed.
-func (m *CommentMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
-	switch name {
-	case comment.FieldCreatedAt:
-		return m.OldCreatedAt(ctx)
-	case comment.FieldUpdatedAt:
-		return m.OldUpdatedAt(ctx)
-	case comment.FieldDeletedAt:
-		return m.OldDeletedAt(ctx)
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldParentID requires an ID field in the mutation")
 	}
-	return nil, fmt.Errorf("unknown Comment field %s", name)
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldParentID: %w", err)
+	}
+	return oldValue.ParentID, nil
 }
 
-// SetField sets the value of a field with the given name. It returns an error if
-// the field is not defined in the schema, or if the type mismatched the field
-// type.
-func (m *CommentMutation) SetField(name string, value ent.Value) error {
-	switch name {
-	case comment.FieldCreatedAt:
-		v, ok := value.(time.Time)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetCreatedAt(v)
-		return nil
-	case comment.FieldUpdatedAt:
-		v, ok := value.(time.Time)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetUpdatedAt(v)
-		return nil
-	case comment.FieldDeletedAt:
-		v, ok := value.(time.Time)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetDeletedAt(v)
-		return nil
-	}
-	return fmt.Errorf("unknown Comment field %s", name)
+// ClearParentID clears the value of the "parent_id" field.
+func (m *CommentMutation) ClearParentID() {
+	m.parent = nil
+	m.clearedFields[comment.FieldParentID] = struct{}{}
 }
 
-// AddedFields returns all numeric fields that were incremented/decremented during
-// this mutation.
-func (m *CommentMutation) AddedFields() []string {
-	return nil
+// ParentIDCleared returns if the "parent_id" field was cleared in this mutation.
+func (m *CommentMutation) ParentIDCleared() bool {
+	_, ok := m.clearedFields[comment.FieldParentID]
+	return ok
 }
 
-// AddedField returns the numeric value that was incremented/decremented on a field
-// with the given name. The second boolean return value indicates that this field
-// was not set, or was not defined in the schema.
-func (m *CommentMutation) AddedField(name string) (ent.Value, bool) {
-	return nil, false
+// ResetParentID resets all changes to the "parent_id" field.
+func (m *CommentMutation) ResetParentID() {
+	m.parent = nil
+	delete(m.clearedFields, comment.FieldParentID)
 }
 
-// AddField adds the value to the field with the given name. It returns an error if
-// the field is not defined in the schema, or if the type mismatched the field
-// type.
-func (m *CommentMutation) AddField(name string, value ent.Value) error {
-	switch name {
-	}
-	return fmt.Errorf("unknown Comment numeric field %s", name)
+// SetRootID sets the "root_id" field.
+func (m *CommentMutation) SetRootID(u uint) {
+	m.root_id = &u
+	m.addroot_id = nil
 }
 
-// ClearedFields returns all nullable fields that were cleared during this
-// mutation.
-func (m *CommentMutation) ClearedFields() []string {
-	var fields []string
-	if m.FieldCleared(comment.FieldDeletedAt) {
-		fields = append(fields, comment.FieldDeletedAt)
+// RootID returns the value of the "root_id" field in the mutation.
+func (m *CommentMutation) RootID() (r uint, exists bool) {
+	v := m.root_id
+	if v == nil {
+		return
 	}
-	return fields
+	return *v, true
 }
 
-// FieldCleared returns a boolean indicating if a field with the given name was
-// cleared in this mutation.
-func (m *CommentMutation) FieldCleared(name string) bool {
-	_, ok := m.clearedFields[name]
-	return ok
+// OldRootID returns the old "root_id" field's value of the Comment entity.
+// If the Comment object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *CommentMutation) OldRootID(ctx context.Context) (v *uint, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldRootID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldRootID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldRootID: %w", err)
+	}
+	return oldValue.RootID, nil
 }
 
-// ClearField clears the value of the field with the given name. It returns an
-// error if the field is not defined in the schema.
-func (m *CommentMutation) ClearField(name string) error {
-	switch name {
-	case comment.FieldDeletedAt:
-		m.ClearDeletedAt()
-		return nil
+// AddRootID adds u to the "root_id" field.
+func (m *CommentMutation) AddRootID(u int) {
+	if m.addroot_id != nil {
+		*m.addroot_id += u
+	} else {
+		m.addroot_id = &u
 	}
-	return fmt.Errorf("unknown Comment nullable field %s", name)
 }
 
-// ResetField resets all changes in the mutation for the field with the given name.
-// It returns an error if the field is not defined in the schema.
-func (m *CommentMutation) ResetField(name string) error {
-	switch name {
-	case comment.FieldCreatedAt:
-		m.ResetCreatedAt()
-		return nil
-	case comment.FieldUpdatedAt:
-		m.ResetUpdatedAt()
-		return nil
-	case comment.FieldDeletedAt:
-		m.ResetDeletedAt()
-		return nil
+// AddedRootID returns the value that was added to the "root_id" field in this mutation.
+func (m *CommentMutation) AddedRootID() (r int, exists bool) {
+	v := m.addroot_id
+	if v == nil {
+		return
 	}
-	return fmt.Errorf("unknown Comment field %s", name)
+	return *v, true
 }
 
-// AddedEdges returns all edge names that were set/added in this mutation.
-func (m *CommentMutation) AddedEdges() []string {
-	edges := make([]string, 0, 0)
-	return edges
+// ClearRootID clears the value of the "root_id" field.
+func (m *CommentMutation) ClearRootID() {
+	m.root_id = nil
+	m.addroot_id = nil
+	m.clearedFields[comment.FieldRootID] = struct{}{}
 }
 
-// AddedIDs returns all IDs (to other nodes) that were added for the given edge
-// name in this mutation.
-func (m *CommentMutation) AddedIDs(name string) []ent.Value {
-	return nil
+// RootIDCleared returns if the "root_id" field was cleared in this mutation.
+func (m *CommentMutation) RootIDCleared() bool {
+	_, ok := m.clearedFields[comment.FieldRootID]
+	return ok
 }
 
-// RemovedEdges returns all edge names that were removed in this mutation.
-func (m *CommentMutation) RemovedEdges() []string {
-	edges := make([]string, 0, 0)
-	return edges
+// ResetRootID resets all changes to the "root_id" field.
+func (m *CommentMutation) ResetRootID() {
+	m.root_id = nil
+	m.addroot_id = nil
+	delete(m.clearedFields, comment.FieldRootID)
 }
 
-// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
-// the given name in this mutation.
-func (m *CommentMutation) RemovedIDs(name string) []ent.Value {
-	return nil
+// SetUserID sets the "user_id" field.
+func (m *CommentMutation) SetUserID(u uint) {
+	m.author = &u
 }
 
-// ClearedEdges returns all edge names that were cleared in this mutation.
-func (m *CommentMutation) ClearedEdges() []string {
-	edges := make([]string, 0, 0)
-	return edges
+// UserID returns the value of the "user_id" field in the mutation.
+func (m *CommentMutation) UserID() (r uint, exists bool) {
+	v := m.author
+	if v == nil {
+		return
+	}
+	return *v, true
 }
 
-// EdgeCleared returns a boolean which indicates if the edge with the given name
-// was cleared in this mutation.
-func (m *CommentMutation) EdgeCleared(name string) bool {
-	return false
+// OldUserID returns the old "user_id" field's value of the Comment entity.
+// If the Comment object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *CommentMutation) OldUserID(ctx context.Context) (v *uint, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldUserID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldUserID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldUserID: %w", err)
+	}
+	return oldValue.UserID, nil
 }
 
-// ClearEdge clears the value of the edge with the given name. It returns an error
-// if that edge is not defined in the schema.
-func (m *CommentMutation) ClearEdge(name string) error {
-	return fmt.Errorf("unknown Comment unique edge %s", name)
+// ClearUserID clears the value of the "user_id" field.
+func (m *CommentMutation) ClearUserID() {
+	m.author = nil
+	m.clearedFields[comment.FieldUserID] = struct{}{}
 }
 
-// ResetEdge resets all changes to the edge with the given name in this mutation.
-// It returns an error if the edge is not defined in the schema.
-func (m *CommentMutation) ResetEdge(name string) error {
-	return fmt.Errorf("unknown Comment edge %s", name)
+// UserIDCleared returns if the "user_id" field was cleared in this mutation.
+func (m *CommentMutation) UserIDCleared() bool {
+	_, ok := m.clearedFields[comment.FieldUserID]
+	return ok
 }
 
-// LinkMutation represents an operation that mutates the Link nodes in the graph.
-type LinkMutation struct {
-	config
-	op              Op
-	typ             string
-	id              *uint
-	created_at      *time.Time
-	updated_at      *time.Time
-	deleted_at      *time.Time
-	description     *string
-	enabled         *bool
-	name            *string
-	sort_order      *int
-	addsort_order   *int
-	url             *string
-	avatar          *string
-	status          *entity.LinkStatus
-	clearedFields   map[string]struct{}
-	category        *uint
-	clearedcategory bool
-	done            bool
-	oldValue        func(context.Context) (*Link, error)
-	predicates      []predicate.Link
+// ResetUserID resets all changes to the "user_id" field.
+func (m *CommentMutation) ResetUserID() {
+	m.author = nil
+	delete(m.clearedFields, comment.FieldUserID)
 }
 
-var _ ent.Mutation = (*LinkMutation)(nil)
+// SetAuthorName sets the "author_name" field.
+func (m *CommentMutation) SetAuthorName(s string) {
+	m.author_name = &s
+}
 
-// linkOption allows management of the mutation configuration using functional options.
-type linkOption func(*LinkMutation)
+// AuthorName returns the value of the "author_name" field in the mutation.
+func (m *CommentMutation) AuthorName() (r string, exists bool) {
+	v := m.author_name
+	if v == nil {
+		return
+	}
+	return *v, true
+}
 
-// newLinkMutation creates new mutation for the Link entity.
-func newLinkMutation(c config, op Op, opts ...linkOption) *LinkMutation {
-	m := &LinkMutation{
-		config:        c,
-		op:            op,
-		typ:           TypeLink,
-		clearedFields: make(map[string]struct{}),
+// OldAuthorName returns the old "author_name" field's value of the Comment entity.
+// If the Comment object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *CommentMutation) OldAuthorName(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldAuthorName is only allowed on UpdateOne operations")
 	}
-	for _, opt := range opts {
-		opt(m)
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldAuthorName requires an ID field in the mutation")
 	}
-	return m
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldAuthorName: %w", err)
+	}
+	return oldValue.AuthorName, nil
 }
 
-// withLinkID sets the ID field of the mutation.
-func withLinkID(id uint) linkOption {
-	return func(m *LinkMutation) {
-		var (
-			err   error
-			once  sync.Once
-			value *Link
-		)
-		m.oldValue = func(ctx context.Context) (*Link, error) {
-			once.Do(func() {
-				if m.done {
-					err = errors.New("querying old values post mutation is not allowed")
-				} else {
-					value, err = m.Client().Link.Get(ctx, id)
-				}
-			})
-			return value, err
-		}
-		m.id = &id
-	}
+// ResetAuthorName resets all changes to the "author_name" field.
+func (m *CommentMutation) ResetAuthorName() {
+	m.author_name = nil
 }
 
-// withLink sets the old Link of the mutation.
-func withLink(node *Link) linkOption {
-	return func(m *LinkMutation) {
-		m.oldValue = func(context.Context) (*Link, error) {
-			return node, nil
-		}
-		m.id = &node.ID
-	}
+// SetAuthorEmail sets the "author_email" field.
+func (m *CommentMutation) SetAuthorEmail(s string) {
+	m.author_email = &s
 }
 
-// Client returns a new `ent.Client` from the mutation. If the mutation was
-// executed in a transaction (ent.Tx), a transactional client is returned.
-func (m LinkMutation) Client() *Client {
-	client := &Client{config: m.config}
-	client.init()
-	return client
+// AuthorEmail returns the value of the "author_email" field in the mutation.
+func (m *CommentMutation) AuthorEmail() (r string, exists bool) {
+	v := m.author_email
+	if v == nil {
+		return
+	}
+	return *v, true
 }
 
-// Tx returns an `ent.Tx` for mutations that were executed in transactions;
-// it returns an error otherwise.
-func (m LinkMutation) Tx() (*Tx, error) {
-	if _, ok := m.driver.(*txDriver); !ok {
-		return nil, errors.New("ent: mutation is not running in a transaction")
+// OldAuthorEmail returns the old "author_email" field's value of the Comment entity.
+// If the Comment object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *CommentMutation) OldAuthorEmail(ctx context.Context) (v *string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldAuthorEmail is only allowed on UpdateOne operations")
 	}
-	tx := &Tx{config: m.config}
-	tx.init()
-	return tx, nil
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldAuthorEmail requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldAuthorEmail: %w", err)
+	}
+	return oldValue.AuthorEmail, nil
 }
 
-// SetID sets the value of the id field. Note that this
-// operation is only accepted on creation of Link entities.
-func (m *LinkMutation) SetID(id uint) {
-	m.id = &id
+// ClearAuthorEmail clears the value of the "author_email" field.
+func (m *CommentMutation) ClearAuthorEmail() {
+	m.author_email = nil
+	m.clearedFields[comment.FieldAuthorEmail] = struct{}{}
 }
 
-// ID returns the ID value in the mutation. Note that the ID is only available
-// if it was provided to the builder or after it was returned from the database.
-func (m *LinkMutation) ID() (id uint, exists bool) {
-	if m.id == nil {
-		return
-	}
-	return *m.id, true
+// AuthorEmailCleared returns if the "author_email" field was cleared in this mutation.
+func (m *CommentMutation) AuthorEmailCleared() bool {
+	_, ok := m.clearedFields[comment.FieldAuthorEmail]
+	return ok
 }
 
-// IDs queries the database and returns the entity ids that match the mutation's predicate.
-// That means, if the mutation is applied within a transaction with an isolation level such
-// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
-// or updated by the mutation.
-func (m *LinkMutation) IDs(ctx context.Context) ([]uint, error) {
-	switch {
-	case m.op.Is(OpUpdateOne | OpDeleteOne):
-		id, exists := m.ID()
-		if exists {
-			return []uint{id}, nil
-		}
-		fallthrough
-	case m.op.Is(OpUpdate | OpDelete):
-		return m.Client().Link.Query().Where(m.predicates...).IDs(ctx)
-	default:
-		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
-	}
+// ResetAuthorEmail resets all changes to the "author_email" field.
+func (m *CommentMutation) ResetAuthorEmail() {
+	m.author_email = nil
+	delete(m.clearedFields, comment.FieldAuthorEmail)
 }
 
-// SetCreatedAt sets the "created_at" field.
-func (m *LinkMutation) SetCreatedAt(t time.Time) {
-	m.created_at = &t
+// SetAuthorWebsite sets the "author_website" field.
+func (m *CommentMutation) SetAuthorWebsite(s string) {
+	m.author_website = &s
 }
 
-// CreatedAt returns the value of the "created_at" field in the mutation.
-func (m *LinkMutation) CreatedAt() (r time.Time, exists bool) {
-	v := m.created_at
+// AuthorWebsite returns the value of the "author_website" field in the mutation.
+func (m *CommentMutation) AuthorWebsite() (r string, exists bool) {
+	v := m.author_website
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldCreatedAt returns the old "created_at" field's value of the Link entity.
-// If the Link object wasn't provided to the builder, the object is fetched from the database.
+// OldAuthorWebsite returns the old "author_website" field's value of the Comment entity.
+// If the Comment object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *LinkMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
+func (m *CommentMutation) OldAuthorWebsite(ctx context.Context) (v *string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
+		return v, errors.New("OldAuthorWebsite is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldCreatedAt requires an ID field in the mutation")
+		return v, errors.New("OldAuthorWebsite requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldCreatedAt: %w", err)
+		return v, fmt.Errorf("querying old value for OldAuthorWebsite: %w", err)
 	}
-	return oldValue.CreatedAt, nil
+	return oldValue.AuthorWebsite, nil
 }
 
-// ResetCreatedAt resets all changes to the "created_at" field.
-func (m *LinkMutation) ResetCreatedAt() {
-	m.created_at = nil
+// ClearAuthorWebsite clears the value of the "author_website" field.
+func (m *CommentMutation) ClearAuthorWebsite() {
+	m.author_website = nil
+	m.clearedFields[comment.FieldAuthorWebsite] = struct{}{}
 }
 
-// SetUpdatedAt sets the "updated_at" field.
-func (m *LinkMutation) SetUpdatedAt(t time.Time) {
-	m.updated_at = &t
+// AuthorWebsiteCleared returns if the "author_website" field was cleared in this mutation.
+func (m *CommentMutation) AuthorWebsiteCleared() bool {
+	_, ok := m.clearedFields[comment.FieldAuthorWebsite]
+	return ok
 }
 
-// UpdatedAt returns the value of the "updated_at" field in the mutation.
-func (m *LinkMutation) UpdatedAt() (r time.Time, exists bool) {
-	v := m.updated_at
+// ResetAuthorWebsite resets all changes to the "author_website" field.
+func (m *CommentMutation) ResetAuthorWebsite() {
+	m.author_website = nil
+	delete(m.clearedFields, comment.FieldAuthorWebsite)
+}
+
+// SetContent sets the "content" field.
+func (m *CommentMutation) SetContent(s string) {
+	m.content = &s
+}
+
+// Content returns the value of the "content" field in the mutation.
+func (m *CommentMutation) Content() (r string, exists bool) {
+	v := m.content
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldUpdatedAt returns the old "updated_at" field's value of the Link entity.
-// If the Link object wasn't provided to the builder, the object is fetched from the database.
+// OldContent returns the old "content" field's value of the Comment entity.
+// If the Comment object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *LinkMutation) OldUpdatedAt(ctx context.Context) (v time.Time, err error) {
+func (m *CommentMutation) OldContent(ctx context.Context) (v string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldUpdatedAt is only allowed on UpdateOne operations")
+		return v, errors.New("OldContent is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldUpdatedAt requires an ID field in the mutation")
+		return v, errors.New("OldContent requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldUpdatedAt: %w", err)
+		return v, fmt.Errorf("querying old value for OldContent: %w", err)
 	}
-	return oldValue.UpdatedAt, nil
+	return oldValue.Content, nil
 }
 
-// ResetUpdatedAt resets all changes to the "updated_at" field.
-func (m *LinkMutation) ResetUpdatedAt() {
-	m.updated_at = nil
+// ResetContent resets all changes to the "content" field.
+func (m *CommentMutation) ResetContent() {
+	m.content = nil
 }
 
-// SetDeletedAt sets the "deleted_at" field.
-func (m *LinkMutation) SetDeletedAt(t time.Time) {
-	m.deleted_at = &t
+// SetStatus sets the "status" field.
+func (m *CommentMutation) SetStatus(es entity.CommentStatus) {
+	m.status = &es
 }
 
-// DeletedAt returns the value of the "deleted_at" field in the mutation.
-func (m *LinkMutation) DeletedAt() (r time.Time, exists bool) {
-	v := m.deleted_at
+// Status returns the value of the "status" field in the mutation.
+func (m *CommentMutation) Status() (r entity.CommentStatus, exists bool) {
+	v := m.status
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldDeletedAt returns the old "deleted_at" field's value of the Link entity.
-// If the Link object wasn't provided to the builder, the object is fetched from the database.
+// OldStatus returns the old "status" field's value of the Comment entity.
+// If the Comment object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *LinkMutation) OldDeletedAt(ctx context.Context) (v *time.Time, err error) {
+func (m *CommentMutation) OldStatus(ctx context.Context) (v entity.CommentStatus, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldDeletedAt is only allowed on UpdateOne operations")
+		return v, errors.New("OldStatus is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldDeletedAt requires an ID field in the mutation")
+		return v, errors.New("OldStatus requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldDeletedAt: %w", err)
+		return v, fmt.Errorf("querying old value for OldStatus: %w", err)
 	}
-	return oldValue.DeletedAt, nil
+	return oldValue.Status, nil
 }
 
-// ClearDeletedAt clears the value of the "deleted_at" field.
-func (m *LinkMutation) ClearDeletedAt() {
-	m.deleted_at = nil
-	m.clearedFields[link.FieldDeletedAt] = struct{}{}
+// ResetStatus resets all changes to the "status" field.
+func (m *CommentMutation) ResetStatus() {
+	m.status = nil
 }
 
-// DeletedAtCleared returns if the "deleted_at" field was cleared in this mutation.
-func (m *LinkMutation) DeletedAtCleared() bool {
-	_, ok := m.clearedFields[link.FieldDeletedAt]
-	return ok
+// SetNotifyOnReply sets the "notify_on_reply" field.
+func (m *CommentMutation) SetNotifyOnReply(b bool) {
+	m.notify_on_reply = &b
 }
 
-// ResetDeletedAt resets all changes to the "deleted_at" field.
-func (m *LinkMutation) ResetDeletedAt() {
-	m.deleted_at = nil
-	delete(m.clearedFields, link.FieldDeletedAt)
+// NotifyOnReply returns the value of the "notify_on_reply" field in the mutation.
+func (m *CommentMutation) NotifyOnReply() (r bool, exists bool) {
+	v := m.notify_on_reply
+	if v == nil {
+		return
+	}
+	return *v, true
 }
 
-// SetDescription sets the "description" field.
-func (m *LinkMutation) SetDescription(s string) {
-	m.description = &s
+// OldNotifyOnReply returns the old "notify_on_reply" field's value of the Comment entity.
+// If the Comment object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *CommentMutation) OldNotifyOnReply(ctx context.Context) (v bool, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldNotifyOnReply is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldNotifyOnReply requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldNotifyOnReply: %w", err)
+	}
+	return oldValue.NotifyOnReply, nil
 }
 
-// Description returns the value of the "description" field in the mutation.
-func (m *LinkMutation) Description() (r string, exists bool) {
-	v := m.description
+// ResetNotifyOnReply resets all changes to the "notify_on_reply" field.
+func (m *CommentMutation) ResetNotifyOnReply() {
+	m.notify_on_reply = nil
+}
+
+// SetSpamReason sets the "spam_reason" field.
+func (m *CommentMutation) SetSpamReason(s string) {
+	m.spam_reason = &s
+}
+
+// SpamReason returns the value of the "spam_reason" field in the mutation.
+func (m *CommentMutation) SpamReason() (r string, exists bool) {
+	v := m.spam_reason
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldDescription returns the old "description" field's value of the Link entity.
-// If the Link object wasn't provided to the builder, the object is fetched from the database.
+// OldSpamReason returns the old "spam_reason" field's value of the Comment entity.
+// If the Comment object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *LinkMutation) OldDescription(ctx context.Context) (v string, err error) {
+func (m *CommentMutation) OldSpamReason(ctx context.Context) (v *string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldDescription is only allowed on UpdateOne operations")
+		return v, errors.New("OldSpamReason is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldDescription requires an ID field in the mutation")
+		return v, errors.New("OldSpamReason requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldDescription: %w", err)
+		return v, fmt.Errorf("querying old value for OldSpamReason: %w", err)
 	}
-	return oldValue.Description, nil
+	return oldValue.SpamReason, nil
 }
 
-// ClearDescription clears the value of the "description" field.
-func (m *LinkMutation) ClearDescription() {
-	m.description = nil
-	m.clearedFields[link.FieldDescription] = struct{}{}
+// ClearSpamReason clears the value of the "spam_reason" field.
+func (m *CommentMutation) ClearSpamReason() {
+	m.spam_reason = nil
+	m.clearedFields[comment.FieldSpamReason] = struct{}{}
 }
 
-// DescriptionCleared returns if the "description" field was cleared in this mutation.
-func (m *LinkMutation) DescriptionCleared() bool {
-	_, ok := m.clearedFields[link.FieldDescription]
+// SpamReasonCleared returns if the "spam_reason" field was cleared in this mutation.
+func (m *CommentMutation) SpamReasonCleared() bool {
+	_, ok := m.clearedFields[comment.FieldSpamReason]
 	return ok
 }
 
-// ResetDescription resets all changes to the "description" field.
-func (m *LinkMutation) ResetDescription() {
-	m.description = nil
-	delete(m.clearedFields, link.FieldDescription)
+// ResetSpamReason resets all changes to the "spam_reason" field.
+func (m *CommentMutation) ResetSpamReason() {
+	m.spam_reason = nil
+	delete(m.clearedFields, comment.FieldSpamReason)
 }
 
-// SetEnabled sets the "enabled" field.
-func (m *LinkMutation) SetEnabled(b bool) {
-	m.enabled = &b
+// SetIP sets the "ip" field.
+func (m *CommentMutation) SetIP(s string) {
+	m.ip = &s
 }
 
-// Enabled returns the value of the "enabled" field in the mutation.
-func (m *LinkMutation) Enabled() (r bool, exists bool) {
-	v := m.enabled
+// IP returns the value of the "ip" field in the mutation.
+func (m *CommentMutation) IP() (r string, exists bool) {
+	v := m.ip
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldEnabled returns the old "enabled" field's value of the Link entity.
-// If the Link object wasn't provided to the builder, the object is fetched from the database.
+// OldIP returns the old "ip" field's value of the Comment entity.
+// If the Comment object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *LinkMutation) OldEnabled(ctx context.Context) (v bool, err error) {
+func (m *CommentMutation) OldIP(ctx context.Context) (v string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldEnabled is only allowed on UpdateOne operations")
+		return v, errors.New("OldIP is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldEnabled requires an ID field in the mutation")
+		return v, errors.New("OldIP requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldEnabled: %w", err)
+		return v, fmt.Errorf("querying old value for OldIP: %w", err)
 	}
-	return oldValue.Enabled, nil
+	return oldValue.IP, nil
 }
 
-// ResetEnabled resets all changes to the "enabled" field.
-func (m *LinkMutation) ResetEnabled() {
-	m.enabled = nil
+// ResetIP resets all changes to the "ip" field.
+func (m *CommentMutation) ResetIP() {
+	m.ip = nil
 }
 
-// SetName sets the "name" field.
-func (m *LinkMutation) SetName(s string) {
-	m.name = &s
+// SetUserAgent sets the "user_agent" field.
+func (m *CommentMutation) SetUserAgent(s string) {
+	m.user_agent = &s
 }
 
-// Name returns the value of the "name" field in the mutation.
-func (m *LinkMutation) Name() (r string, exists bool) {
-	v := m.name
+// UserAgent returns the value of the "user_agent" field in the mutation.
+func (m *CommentMutation) UserAgent() (r string, exists bool) {
+	v := m.user_agent
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldName returns the old "name" field's value of the Link entity.
-// If the Link object wasn't provided to the builder, the object is fetched from the database.
+// OldUserAgent returns the old "user_agent" field's value of the Comment entity.
+// If the Comment object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *LinkMutation) OldName(ctx context.Context) (v string, err error) {
+func (m *CommentMutation) OldUserAgent(ctx context.Context) (v string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldName is only allowed on UpdateOne operations")
+		return v, errors.New("OldUserAgent is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldName requires an ID field in the mutation")
+		return v, errors.New("OldUserAgent requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldName: %w", err)
+		return v, fmt.Errorf("querying old value for OldUserAgent: %w", err)
 	}
-	return oldValue.Name, nil
+	return oldValue.UserAgent, nil
 }
 
-// ResetName resets all changes to the "name" field.
-func (m *LinkMutation) ResetName() {
-	m.name = nil
+// ResetUserAgent resets all changes to the "user_agent" field.
+func (m *CommentMutation) ResetUserAgent() {
+	m.user_agent = nil
 }
 
-// SetSortOrder sets the "sort_order" field.
-func (m *LinkMutation) SetSortOrder(i int) {
-	m.sort_order = &i
-	m.addsort_order = nil
+// ClearPost clears the "post" edge to the Post entity.
+func (m *CommentMutation) ClearPost() {
+	m.clearedpost = true
+	m.clearedFields[comment.FieldPostID] = struct{}{}
 }
 
-// SortOrder returns the value of the "sort_order" field in the mutation.
-func (m *LinkMutation) SortOrder() (r int, exists bool) {
-	v := m.sort_order
-	if v == nil {
-		return
-	}
-	return *v, true
+// PostCleared reports if the "post" edge to the Post entity was cleared.
+func (m *CommentMutation) PostCleared() bool {
+	return m.clearedpost
 }
 
-// OldSortOrder returns the old "sort_order" field's value of the Link entity.
-// If the Link object wasn't provided to the builder, the object is fetched from the database.
-// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *LinkMutation) OldSortOrder(ctx context.Context) (v int, err error) {
-	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldSortOrder is only allowed on UpdateOne operations")
-	}
-	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldSortOrder requires an ID field in the mutation")
-	}
-	oldValue, err := m.oldValue(ctx)
-	if err != nil {
-		return v, fmt.Errorf("querying old value for OldSortOrder: %w", err)
+// PostIDs returns the "post" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// PostID instead. It exists only for internal usage by the builders.
+func (m *CommentMutation) PostIDs() (ids []uint) {
+	if id := m.post; id != nil {
+		ids = append(ids, *id)
 	}
-	return oldValue.SortOrder, nil
+	return
 }
 
-// AddSortOrder adds i to the "sort_order" field.
-func (m *LinkMutation) AddSortOrder(i int) {
-	if m.addsort_order != nil {
-		*m.addsort_order += i
-	} else {
-		m.addsort_order = &i
-	}
+// ResetPost resets all changes to the "post" edge.
+func (m *CommentMutation) ResetPost() {
+	m.post = nil
+	m.clearedpost = false
 }
 
-// AddedSortOrder returns the value that was added to the "sort_order" field in this mutation.
-func (m *LinkMutation) AddedSortOrder() (r int, exists bool) {
-	v := m.addsort_order
-	if v == nil {
-		return
-	}
-	return *v, true
+// SetAuthorID sets the "author" edge to the User entity by id.
+func (m *CommentMutation) SetAuthorID(id uint) {
+	m.author = &id
 }
 
-// ResetSortOrder resets all changes to the "sort_order" field.
-func (m *LinkMutation) ResetSortOrder() {
-	m.sort_order = nil
-	m.addsort_order = nil
+// ClearAuthor clears the "author" edge to the User entity.
+func (m *CommentMutation) ClearAuthor() {
+	m.clearedauthor = true
+	m.clearedFields[comment.FieldUserID] = struct{}{}
 }
 
-// SetURL sets the "url" field.
-func (m *LinkMutation) SetURL(s string) {
-	m.url = &s
+// AuthorCleared reports if the "author" edge to the User entity was cleared.
+func (m *CommentMutation) AuthorCleared() bool {
+	return m.UserIDCleared() || m.clearedauthor
 }
 
-// URL returns the value of the "url" field in the mutation.
-func (m *LinkMutation) URL() (r string, exists bool) {
-	v := m.url
-	if v == nil {
-		return
+// AuthorID returns the "author" edge ID in the mutation.
+func (m *CommentMutation) AuthorID() (id uint, exists bool) {
+	if m.author != nil {
+		return *m.author, true
 	}
-	return *v, true
+	return
 }
 
-// OldURL returns the old "url" field's value of the Link entity.
-// If the Link object wasn't provided to the builder, the object is fetched from the database.
-// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *LinkMutation) OldURL(ctx context.Context) (v string, err error) {
-	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldURL is only allowed on UpdateOne operations")
-	}
-	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldURL requires an ID field in the mutation")
-	}
-	oldValue, err := m.oldValue(ctx)
-	if err != nil {
-		return v, fmt.Errorf("querying old value for OldURL: %w", err)
+// AuthorIDs returns the "author" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// AuthorID instead. It exists only for internal usage by the builders.
+func (m *CommentMutation) AuthorIDs() (ids []uint) {
+	if id := m.author; id != nil {
+		ids = append(ids, *id)
 	}
-	return oldValue.URL, nil
+	return
 }
 
-// ResetURL resets all changes to the "url" field.
-func (m *LinkMutation) ResetURL() {
-	m.url = nil
+// ResetAuthor resets all changes to the "author" edge.
+func (m *CommentMutation) ResetAuthor() {
+	m.author = nil
+	m.clearedauthor = false
 }
 
-// SetAvatar sets the "avatar" field.
-func (m *LinkMutation) SetAvatar(s string) {
-	m.avatar = &s
+// ClearParent clears the "parent" edge to the Comment entity.
+func (m *CommentMutation) ClearParent() {
+	m.clearedparent = true
+	m.clearedFields[comment.FieldParentID] = struct{}{}
 }
 
-// Avatar returns the value of the "avatar" field in the mutation.
-func (m *LinkMutation) Avatar() (r string, exists bool) {
-	v := m.avatar
-	if v == nil {
-		return
-	}
-	return *v, true
+// ParentCleared reports if the "parent" edge to the Comment entity was cleared.
+func (m *CommentMutation) ParentCleared() bool {
+	return m.ParentIDCleared() || m.clearedparent
 }
 
-// OldAvatar returns the old "avatar" field's value of the Link entity.
-// If the Link object wasn't provided to the builder, the object is fetched from the database.
-// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *LinkMutation) OldAvatar(ctx context.Context) (v string, err error) {
-	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldAvatar is only allowed on UpdateOne operations")
-	}
-	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldAvatar requires an ID field in the mutation")
-	}
-	oldValue, err := m.oldValue(ctx)
-	if err != nil {
-		return v, fmt.Errorf("querying old value for OldAvatar: %w", err)
+// ParentIDs returns the "parent" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// ParentID instead. It exists only for internal usage by the builders.
+func (m *CommentMutation) ParentIDs() (ids []uint) {
+	if id := m.parent; id != nil {
+		ids = append(ids, *id)
 	}
-	return oldValue.Avatar, nil
+	return
 }
 
-// ClearAvatar clears the value of the "avatar" field.
-func (m *LinkMutation) ClearAvatar() {
-	m.avatar = nil
-	m.clearedFields[link.FieldAvatar] = struct{}{}
+// ResetParent resets all changes to the "parent" edge.
+func (m *CommentMutation) ResetParent() {
+	m.parent = nil
+	m.clearedparent = false
 }
 
-// AvatarCleared returns if the "avatar" field was cleared in this mutation.
-func (m *LinkMutation) AvatarCleared() bool {
-	_, ok := m.clearedFields[link.FieldAvatar]
-	return ok
+// AddReplyIDs adds the "replies" edge to the Comment entity by ids.
+func (m *CommentMutation) AddReplyIDs(ids ...uint) {
+	if m.replies == nil {
+		m.replies = make(map[uint]struct{})
+	}
+	for i := range ids {
+		m.replies[ids[i]] = struct{}{}
+	}
 }
 
-// ResetAvatar resets all changes to the "avatar" field.
-func (m *LinkMutation) ResetAvatar() {
-	m.avatar = nil
-	delete(m.clearedFields, link.FieldAvatar)
+// ClearReplies clears the "replies" edge to the Comment entity.
+func (m *CommentMutation) ClearReplies() {
+	m.clearedreplies = true
 }
 
-// SetStatus sets the "status" field.
-func (m *LinkMutation) SetStatus(es entity.LinkStatus) {
-	m.status = &es
+// RepliesCleared reports if the "replies" edge to the Comment entity was cleared.
+func (m *CommentMutation) RepliesCleared() bool {
+	return m.clearedreplies
 }
 
-// Status returns the value of the "status" field in the mutation.
-func (m *LinkMutation) Status() (r entity.LinkStatus, exists bool) {
-	v := m.status
-	if v == nil {
-		return
+// RemoveReplyIDs removes the "replies" edge to the Comment entity by IDs.
+func (m *CommentMutation) RemoveReplyIDs(ids ...uint) {
+	if m.removedreplies == nil {
+		m.removedreplies = make(map[uint]struct{})
+	}
+	for i := range ids {
+		delete(m.replies, ids[i])
+		m.removedreplies[ids[i]] = struct{}{}
 	}
-	return *v, true
 }
 
-// OldStatus returns the old "status" field's value of the Link entity.
-// If the Link object wasn't provided to the builder, the object is fetched from the database.
-// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *LinkMutation) OldStatus(ctx context.Context) (v entity.LinkStatus, err error) {
-	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldStatus is only allowed on UpdateOne operations")
-	}
-	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldStatus requires an ID field in the mutation")
-	}
-	oldValue, err := m.oldValue(ctx)
-	if err != nil {
-		return v, fmt.Errorf("querying old value for OldStatus: %w", err)
+// RemovedReplies returns the removed IDs of the "replies" edge to the Comment entity.
+func (m *CommentMutation) RemovedRepliesIDs() (ids []uint) {
+	for id := range m.removedreplies {
+		ids = append(ids, id)
 	}
-	return oldValue.Status, nil
+	return
 }
 
-// ResetStatus resets all changes to the "status" field.
-func (m *LinkMutation) ResetStatus() {
-	m.status = nil
+// RepliesIDs returns the "replies" edge IDs in the mutation.
+func (m *CommentMutation) RepliesIDs() (ids []uint) {
+	for id := range m.replies {
+		ids = append(ids, id)
+	}
+	return
 }
 
-// SetCategoryID sets the "category_id" field.
-func (m *LinkMutation) SetCategoryID(u uint) {
-	m.category = &u
+// ResetReplies resets all changes to the "replies" edge.
+func (m *CommentMutation) ResetReplies() {
+	m.replies = nil
+	m.clearedreplies = false
+	m.removedreplies = nil
 }
 
-// CategoryID returns the value of the "category_id" field in the mutation.
-func (m *LinkMutation) CategoryID() (r uint, exists bool) {
-	v := m.category
-	if v == nil {
-		return
-	}
-	return *v, true
+// Where appends a list predicates to the CommentMutation builder.
+func (m *CommentMutation) Where(ps ...predicate.Comment) {
+	m.predicates = append(m.predicates, ps...)
 }
 
-// OldCategoryID returns the old "category_id" field's value of the Link entity.
-// If the Link object wasn't provided to the builder, the object is fetched from the database.
-// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *LinkMutation) OldCategoryID(ctx context.Context) (v uint, err error) {
-	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldCategoryID is only allowed on UpdateOne operations")
-	}
-	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldCategoryID requires an ID field in the mutation")
-	}
-	oldValue, err := m.oldValue(ctx)
-	if err != nil {
-		return v, fmt.Errorf("querying old value for OldCategoryID: %w", err)
+// WhereP appends storage-level predicates to the CommentMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *CommentMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.Comment, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
 	}
-	return oldValue.CategoryID, nil
+	m.Where(p...)
 }
 
-// ClearCategoryID clears the value of the "category_id" field.
-func (m *LinkMutation) ClearCategoryID() {
-	m.category = nil
-	m.clearedFields[link.FieldCategoryID] = struct{}{}
-}
-
-// CategoryIDCleared returns if the "category_id" field was cleared in this mutation.
-func (m *LinkMutation) CategoryIDCleared() bool {
-	_, ok := m.clearedFields[link.FieldCategoryID]
-	return ok
-}
-
-// ResetCategoryID resets all changes to the "category_id" field.
-func (m *LinkMutation) ResetCategoryID() {
-	m.category = nil
-	delete(m.clearedFields, link.FieldCategoryID)
-}
-
-// ClearCategory clears the "category" edge to the LinkCategory entity.
-func (m *LinkMutation) ClearCategory() {
-	m.clearedcategory = true
-	m.clearedFields[link.FieldCategoryID] = struct{}{}
-}
-
-// CategoryCleared reports if the "category" edge to the LinkCategory entity was cleared.
-func (m *LinkMutation) CategoryCleared() bool {
-	return m.CategoryIDCleared() || m.clearedcategory
-}
-
-// CategoryIDs returns the "category" edge IDs in the mutation.
-// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
-// CategoryID instead. It exists only for internal usage by the builders.
-func (m *LinkMutation) CategoryIDs() (ids []uint) {
-	if id := m.category; id != nil {
-		ids = append(ids, *id)
-	}
-	return
-}
-
-// ResetCategory resets all changes to the "category" edge.
-func (m *LinkMutation) ResetCategory() {
-	m.category = nil
-	m.clearedcategory = false
-}
-
-// Where appends a list predicates to the LinkMutation builder.
-func (m *LinkMutation) Where(ps ...predicate.Link) {
-	m.predicates = append(m.predicates, ps...)
-}
-
-// WhereP appends storage-level predicates to the LinkMutation builder. Using this method,
-// users can use type-assertion to append predicates that do not depend on any generated package.
-func (m *LinkMutation) WhereP(ps ...func(*sql.Selector)) {
-	p := make([]predicate.Link, len(ps))
-	for i := range ps {
-		p[i] = ps[i]
-	}
-	m.Where(p...)
-}
-
-// Op returns the operation name.
-func (m *LinkMutation) Op() Op {
-	return m.op
+// Op returns the operation name.
+func (m *CommentMutation) Op() Op {
+	return m.op
 }
 
 // SetOp allows setting the mutation operation.
-func (m *LinkMutation) SetOp(op Op) {
+func (m *CommentMutation) SetOp(op Op) {
 	m.op = op
 }
 
-// Type returns the node type of this mutation (Link).
-func (m *LinkMutation) Type() string {
+// Type returns the node type of this mutation (Comment).
+func (m *CommentMutation) Type() string {
 	return m.typ
 }
 
 // Fields returns all fields that were changed during this mutation. Note that in
 // order to get all numeric fields that were incremented/decremented, call
 // AddedFields().
-func (m *LinkMutation) Fields() []string {
-	fields := make([]string, 0, 11)
+func (m *CommentMutation) Fields() []string {
+	fields := make([]string, 0, 16)
 	if m.created_at != nil {
-		fields = append(fields, link.FieldCreatedAt)
+		fields = append(fields, comment.FieldCreatedAt)
 	}
 	if m.updated_at != nil {
-		fields = append(fields, link.FieldUpdatedAt)
+		fields = append(fields, comment.FieldUpdatedAt)
 	}
 	if m.deleted_at != nil {
-		fields = append(fields, link.FieldDeletedAt)
+		fields = append(fields, comment.FieldDeletedAt)
 	}
-	if m.description != nil {
-		fields = append(fields, link.FieldDescription)
+	if m.post != nil {
+		fields = append(fields, comment.FieldPostID)
 	}
-	if m.enabled != nil {
-		fields = append(fields, link.FieldEnabled)
+	if m.parent != nil {
+		fields = append(fields, comment.FieldParentID)
 	}
-	if m.name != nil {
-		fields = append(fields, link.FieldName)
+	if m.root_id != nil {
+		fields = append(fields, comment.FieldRootID)
 	}
-	if m.sort_order != nil {
-		fields = append(fields, link.FieldSortOrder)
+	if m.author != nil {
+		fields = append(fields, comment.FieldUserID)
 	}
-	if m.url != nil {
-		fields = append(fields, link.FieldURL)
+	if m.author_name != nil {
+		fields = append(fields, comment.FieldAuthorName)
 	}
-	if m.avatar != nil {
-		fields = append(fields, link.FieldAvatar)
+	if m.author_email != nil {
+		fields = append(fields, comment.FieldAuthorEmail)
+	}
+	if m.author_website != nil {
+		fields = append(fields, comment.FieldAuthorWebsite)
+	}
+	if m.content != nil {
+		fields = append(fields, comment.FieldContent)
 	}
 	if m.status != nil {
-		fields = append(fields, link.FieldStatus)
+		fields = append(fields, comment.FieldStatus)
 	}
-	if m.category != nil {
-		fields = append(fields, link.FieldCategoryID)
+	if m.notify_on_reply != nil {
+		fields = append(fields, comment.FieldNotifyOnReply)
+	}
+	if m.spam_reason != nil {
+		fields = append(fields, comment.FieldSpamReason)
+	}
+	if m.ip != nil {
+		fields = append(fields, comment.FieldIP)
+	}
+	if m.user_agent != nil {
+		fields = append(fields, comment.FieldUserAgent)
 	}
 	return fields
 }
@@ -1205,30 +1121,40 @@ func (m *LinkMutation) Fields() []string {
 // Field returns the value of a field with the given name. The second boolean
 // return value indicates that this field was not set, or was not defined in the
 // schema.
-func (m *LinkMutation) Field(name string) (ent.Value, bool) {
+func (m *CommentMutation) Field(name string) (ent.Value, bool) {
 	switch name {
-	case link.FieldCreatedAt:
+	case comment.FieldCreatedAt:
 		return m.CreatedAt()
-	case link.FieldUpdatedAt:
+	case comment.FieldUpdatedAt:
 		return m.UpdatedAt()
-	case link.FieldDeletedAt:
+	case comment.FieldDeletedAt:
 		return m.DeletedAt()
-	case link.FieldDescription:
-		return m.Description()
-	case link.FieldEnabled:
-		return m.Enabled()
-	case link.FieldName:
-		return m.Name()
-	case link.FieldSortOrder:
-		return m.SortOrder()
-	case link.FieldURL:
-		return m.URL()
-	case link.FieldAvatar:
-		return m.Avatar()
-	case link.FieldStatus:
+	case comment.FieldPostID:
+		return m.PostID()
+	case comment.FieldParentID:
+		return m.ParentID()
+	case comment.FieldRootID:
+		return m.RootID()
+	case comment.FieldUserID:
+		return m.UserID()
+	case comment.FieldAuthorName:
+		return m.AuthorName()
+	case comment.FieldAuthorEmail:
+		return m.AuthorEmail()
+	case comment.FieldAuthorWebsite:
+		return m.AuthorWebsite()
+	case comment.FieldContent:
+		return m.Content()
+	case comment.FieldStatus:
 		return m.Status()
-	case link.FieldCategoryID:
-		return m.CategoryID()
+	case comment.FieldNotifyOnReply:
+		return m.NotifyOnReply()
+	case comment.FieldSpamReason:
+		return m.SpamReason()
+	case comment.FieldIP:
+		return m.IP()
+	case comment.FieldUserAgent:
+		return m.UserAgent()
 	}
 	return nil, false
 }
@@ -1236,126 +1162,171 @@ func (m *LinkMutation) Field(name string) (ent.Value, bool) {
 // OldField returns the old value of the field from the database. An error is
 // returned if the mutation operation is not UpdateOne, or the query to the
 // database failed.
-func (m *LinkMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+func (m *CommentMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
 	switch name {
-	case link.FieldCreatedAt:
+	case comment.FieldCreatedAt:
 		return m.OldCreatedAt(ctx)
-	case link.FieldUpdatedAt:
+	case comment.FieldUpdatedAt:
 		return m.OldUpdatedAt(ctx)
-	case link.FieldDeletedAt:
+	case comment.FieldDeletedAt:
 		return m.OldDeletedAt(ctx)
-	case link.FieldDescription:
-		return m.OldDescription(ctx)
-	case link.FieldEnabled:
-		return m.OldEnabled(ctx)
-	case link.FieldName:
-		return m.OldName(ctx)
-	case link.FieldSortOrder:
-		return m.OldSortOrder(ctx)
-	case link.FieldURL:
-		return m.OldURL(ctx)
-	case link.FieldAvatar:
-		return m.OldAvatar(ctx)
-	case link.FieldStatus:
+	case comment.FieldPostID:
+		return m.OldPostID(ctx)
+	case comment.FieldParentID:
+		return m.OldParentID(ctx)
+	case comment.FieldRootID:
+		return m.OldRootID(ctx)
+	case comment.FieldUserID:
+		return m.OldUserID(ctx)
+	case comment.FieldAuthorName:
+		return m.OldAuthorName(ctx)
+	case comment.FieldAuthorEmail:
+		return m.OldAuthorEmail(ctx)
+	case comment.FieldAuthorWebsite:
+		return m.OldAuthorWebsite(ctx)
+	case comment.FieldContent:
+		return m.OldContent(ctx)
+	case comment.FieldStatus:
 		return m.OldStatus(ctx)
-	case link.FieldCategoryID:
-		return m.OldCategoryID(ctx)
+	case comment.FieldNotifyOnReply:
+		return m.OldNotifyOnReply(ctx)
+	case comment.FieldSpamReason:
+		return m.OldSpamReason(ctx)
+	case comment.FieldIP:
+		return m.OldIP(ctx)
+	case comment.FieldUserAgent:
+		return m.OldUserAgent(ctx)
 	}
-	return nil, fmt.Errorf("unknown Link field %s", name)
+	return nil, fmt.Errorf("unknown Comment field %s", name)
 }
 
 // SetField sets the value of a field with the given name. It returns an error if
 // the field is not defined in the schema, or if the type mismatched the field
 // type.
-func (m *LinkMutation) SetField(name string, value ent.Value) error {
+func (m *CommentMutation) SetField(name string, value ent.Value) error {
 	switch name {
-	case link.FieldCreatedAt:
+	case comment.FieldCreatedAt:
 		v, ok := value.(time.Time)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
 		m.SetCreatedAt(v)
 		return nil
-	case link.FieldUpdatedAt:
+	case comment.FieldUpdatedAt:
 		v, ok := value.(time.Time)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
 		m.SetUpdatedAt(v)
 		return nil
-	case link.FieldDeletedAt:
+	case comment.FieldDeletedAt:
 		v, ok := value.(time.Time)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
 		m.SetDeletedAt(v)
 		return nil
-	case link.FieldDescription:
-		v, ok := value.(string)
+	case comment.FieldPostID:
+		v, ok := value.(uint)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetDescription(v)
+		m.SetPostID(v)
 		return nil
-	case link.FieldEnabled:
-		v, ok := value.(bool)
+	case comment.FieldParentID:
+		v, ok := value.(uint)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetEnabled(v)
+		m.SetParentID(v)
 		return nil
-	case link.FieldName:
+	case comment.FieldRootID:
+		v, ok := value.(uint)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetRootID(v)
+		return nil
+	case comment.FieldUserID:
+		v, ok := value.(uint)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetUserID(v)
+		return nil
+	case comment.FieldAuthorName:
 		v, ok := value.(string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetName(v)
+		m.SetAuthorName(v)
 		return nil
-	case link.FieldSortOrder:
-		v, ok := value.(int)
+	case comment.FieldAuthorEmail:
+		v, ok := value.(string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetSortOrder(v)
+		m.SetAuthorEmail(v)
 		return nil
-	case link.FieldURL:
+	case comment.FieldAuthorWebsite:
 		v, ok := value.(string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetURL(v)
+		m.SetAuthorWebsite(v)
 		return nil
-	case link.FieldAvatar:
+	case comment.FieldContent:
 		v, ok := value.(string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetAvatar(v)
+		m.SetContent(v)
 		return nil
-	case link.FieldStatus:
-		v, ok := value.(entity.LinkStatus)
+	case comment.FieldStatus:
+		v, ok := value.(entity.CommentStatus)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
 		m.SetStatus(v)
 		return nil
-	case link.FieldCategoryID:
-		v, ok := value.(uint)
+	case comment.FieldNotifyOnReply:
+		v, ok := value.(bool)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetCategoryID(v)
+		m.SetNotifyOnReply(v)
+		return nil
+	case comment.FieldSpamReason:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetSpamReason(v)
+		return nil
+	case comment.FieldIP:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetIP(v)
+		return nil
+	case comment.FieldUserAgent:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetUserAgent(v)
 		return nil
 	}
-	return fmt.Errorf("unknown Link field %s", name)
+	return fmt.Errorf("unknown Comment field %s", name)
 }
 
 // AddedFields returns all numeric fields that were incremented/decremented during
 // this mutation.
-func (m *LinkMutation) AddedFields() []string {
+func (m *CommentMutation) AddedFields() []string {
 	var fields []string
-	if m.addsort_order != nil {
-		fields = append(fields, link.FieldSortOrder)
+	if m.addroot_id != nil {
+		fields = append(fields, comment.FieldRootID)
 	}
 	return fields
 }
@@ -1363,10 +1334,10 @@ func (m *LinkMutation) AddedFields() []string {
 // AddedField returns the numeric value that was incremented/decremented on a field
 // with the given name. The second boolean return value indicates that this field
 // was not set, or was not defined in the schema.
-func (m *LinkMutation) AddedField(name string) (ent.Value, bool) {
+func (m *CommentMutation) AddedField(name string) (ent.Value, bool) {
 	switch name {
-	case link.FieldSortOrder:
-		return m.AddedSortOrder()
+	case comment.FieldRootID:
+		return m.AddedRootID()
 	}
 	return nil, false
 }
@@ -1374,212 +1345,313 @@ func (m *LinkMutation) AddedField(name string) (ent.Value, bool) {
 // AddField adds the value to the field with the given name. It returns an error if
 // the field is not defined in the schema, or if the type mismatched the field
 // type.
-func (m *LinkMutation) AddField(name string, value ent.Value) error {
+func (m *CommentMutation) AddField(name string, value ent.Value) error {
 	switch name {
-	case link.FieldSortOrder:
+	case comment.FieldRootID:
 		v, ok := value.(int)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.AddSortOrder(v)
+		m.AddRootID(v)
 		return nil
 	}
-	return fmt.Errorf("unknown Link numeric field %s", name)
+	return fmt.Errorf("unknown Comment numeric field %s", name)
 }
 
 // ClearedFields returns all nullable fields that were cleared during this
 // mutation.
-func (m *LinkMutation) ClearedFields() []string {
+func (m *CommentMutation) ClearedFields() []string {
 	var fields []string
-	if m.FieldCleared(link.FieldDeletedAt) {
-		fields = append(fields, link.FieldDeletedAt)
+	if m.FieldCleared(comment.FieldDeletedAt) {
+		fields = append(fields, comment.FieldDeletedAt)
 	}
-	if m.FieldCleared(link.FieldDescription) {
-		fields = append(fields, link.FieldDescription)
+	if m.FieldCleared(comment.FieldParentID) {
+		fields = append(fields, comment.FieldParentID)
 	}
-	if m.FieldCleared(link.FieldAvatar) {
-		fields = append(fields, link.FieldAvatar)
+	if m.FieldCleared(comment.FieldRootID) {
+		fields = append(fields, comment.FieldRootID)
 	}
-	if m.FieldCleared(link.FieldCategoryID) {
-		fields = append(fields, link.FieldCategoryID)
+	if m.FieldCleared(comment.FieldUserID) {
+		fields = append(fields, comment.FieldUserID)
+	}
+	if m.FieldCleared(comment.FieldAuthorEmail) {
+		fields = append(fields, comment.FieldAuthorEmail)
+	}
+	if m.FieldCleared(comment.FieldAuthorWebsite) {
+		fields = append(fields, comment.FieldAuthorWebsite)
+	}
+	if m.FieldCleared(comment.FieldSpamReason) {
+		fields = append(fields, comment.FieldSpamReason)
 	}
 	return fields
 }
 
 // FieldCleared returns a boolean indicating if a field with the given name was
 // cleared in this mutation.
-func (m *LinkMutation) FieldCleared(name string) bool {
+func (m *CommentMutation) FieldCleared(name string) bool {
 	_, ok := m.clearedFields[name]
 	return ok
 }
 
 // ClearField clears the value of the field with the given name. It returns an
 // error if the field is not defined in the schema.
-func (m *LinkMutation) ClearField(name string) error {
+func (m *CommentMutation) ClearField(name string) error {
 	switch name {
-	case link.FieldDeletedAt:
+	case comment.FieldDeletedAt:
 		m.ClearDeletedAt()
 		return nil
-	case link.FieldDescription:
-		m.ClearDescription()
+	case comment.FieldParentID:
+		m.ClearParentID()
 		return nil
-	case link.FieldAvatar:
-		m.ClearAvatar()
+	case comment.FieldRootID:
+		m.ClearRootID()
 		return nil
-	case link.FieldCategoryID:
-		m.ClearCategoryID()
+	case comment.FieldUserID:
+		m.ClearUserID()
+		return nil
+	case comment.FieldAuthorEmail:
+		m.ClearAuthorEmail()
+		return nil
+	case comment.FieldAuthorWebsite:
+		m.ClearAuthorWebsite()
+		return nil
+	case comment.FieldSpamReason:
+		m.ClearSpamReason()
 		return nil
 	}
-	return fmt.Errorf("unknown Link nullable field %s", name)
+	return fmt.Errorf("unknown Comment nullable field %s", name)
 }
 
 // ResetField resets all changes in the mutation for the field with the given name.
 // It returns an error if the field is not defined in the schema.
-func (m *LinkMutation) ResetField(name string) error {
+func (m *CommentMutation) ResetField(name string) error {
 	switch name {
-	case link.FieldCreatedAt:
+	case comment.FieldCreatedAt:
 		m.ResetCreatedAt()
 		return nil
-	case link.FieldUpdatedAt:
+	case comment.FieldUpdatedAt:
 		m.ResetUpdatedAt()
 		return nil
-	case link.FieldDeletedAt:
+	case comment.FieldDeletedAt:
 		m.ResetDeletedAt()
 		return nil
-	case link.FieldDescription:
-		m.ResetDescription()
+	case comment.FieldPostID:
+		m.ResetPostID()
 		return nil
-	case link.FieldEnabled:
-		m.ResetEnabled()
+	case comment.FieldParentID:
+		m.ResetParentID()
 		return nil
-	case link.FieldName:
-		m.ResetName()
+	case comment.FieldRootID:
+		m.ResetRootID()
 		return nil
-	case link.FieldSortOrder:
-		m.ResetSortOrder()
+	case comment.FieldUserID:
+		m.ResetUserID()
 		return nil
-	case link.FieldURL:
-		m.ResetURL()
+	case comment.FieldAuthorName:
+		m.ResetAuthorName()
 		return nil
-	case link.FieldAvatar:
-		m.ResetAvatar()
+	case comment.FieldAuthorEmail:
+		m.ResetAuthorEmail()
 		return nil
-	case link.FieldStatus:
+	case comment.FieldAuthorWebsite:
+		m.ResetAuthorWebsite()
+		return nil
+	case comment.FieldContent:
+		m.ResetContent()
+		return nil
+	case comment.FieldStatus:
 		m.ResetStatus()
 		return nil
-	case link.FieldCategoryID:
-		m.ResetCategoryID()
+	case comment.FieldNotifyOnReply:
+		m.ResetNotifyOnReply()
+		return nil
+	case comment.FieldSpamReason:
+		m.ResetSpamReason()
+		return nil
+	case comment.FieldIP:
+		m.ResetIP()
+		return nil
+	case comment.FieldUserAgent:
+		m.ResetUserAgent()
 		return nil
 	}
-	return fmt.Errorf("unknown Link field %s", name)
+	return fmt.Errorf("unknown Comment field %s", name)
 }
 
 // AddedEdges returns all edge names that were set/added in this mutation.
-func (m *LinkMutation) AddedEdges() []string {
-	edges := make([]string, 0, 1)
-	if m.category != nil {
-		edges = append(edges, link.EdgeCategory)
+func (m *CommentMutation) AddedEdges() []string {
+	edges := make([]string, 0, 4)
+	if m.post != nil {
+		edges = append(edges, comment.EdgePost)
+	}
+	if m.author != nil {
+		edges = append(edges, comment.EdgeAuthor)
+	}
+	if m.parent != nil {
+		edges = append(edges, comment.EdgeParent)
+	}
+	if m.replies != nil {
+		edges = append(edges, comment.EdgeReplies)
 	}
 	return edges
 }
 
 // AddedIDs returns all IDs (to other nodes) that were added for the given edge
 // name in this mutation.
-func (m *LinkMutation) AddedIDs(name string) []ent.Value {
+func (m *CommentMutation) AddedIDs(name string) []ent.Value {
 	switch name {
-	case link.EdgeCategory:
-		if id := m.category; id != nil {
+	case comment.EdgePost:
+		if id := m.post; id != nil {
 			return []ent.Value{*id}
 		}
+	case comment.EdgeAuthor:
+		if id := m.author; id != nil {
+			return []ent.Value{*id}
+		}
+	case comment.EdgeParent:
+		if id := m.parent; id != nil {
+			return []ent.Value{*id}
+		}
+	case comment.EdgeReplies:
+		ids := make([]ent.Value, 0, len(m.replies))
+		for id := range m.replies {
+			ids = append(ids, id)
+		}
+		return ids
 	}
 	return nil
 }
 
 // RemovedEdges returns all edge names that were removed in this mutation.
-func (m *LinkMutation) RemovedEdges() []string {
-	edges := make([]string, 0, 1)
+func (m *CommentMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 4)
+	if m.removedreplies != nil {
+		edges = append(edges, comment.EdgeReplies)
+	}
 	return edges
 }
 
 // RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
 // the given name in this mutation.
-func (m *LinkMutation) RemovedIDs(name string) []ent.Value {
+func (m *CommentMutation) RemovedIDs(name string) []ent.Value {
+	switch name {
+	case comment.EdgeReplies:
+		ids := make([]ent.Value, 0, len(m.removedreplies))
+		for id := range m.removedreplies {
+			ids = append(ids, id)
+		}
+		return ids
+	}
 	return nil
 }
 
 // ClearedEdges returns all edge names that were cleared in this mutation.
-func (m *LinkMutation) ClearedEdges() []string {
-	edges := make([]string, 0, 1)
-	if m.clearedcategory {
-		edges = append(edges, link.EdgeCategory)
+func (m *CommentMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 4)
+	if m.clearedpost {
+		edges = append(edges, comment.EdgePost)
+	}
+	if m.clearedauthor {
+		edges = append(edges, comment.EdgeAuthor)
+	}
+	if m.clearedparent {
+		edges = append(edges, comment.EdgeParent)
+	}
+	if m.clearedreplies {
+		edges = append(edges, comment.EdgeReplies)
 	}
 	return edges
 }
 
 // EdgeCleared returns a boolean which indicates if the edge with the given name
 // was cleared in this mutation.
-func (m *LinkMutation) EdgeCleared(name string) bool {
+func (m *CommentMutation) EdgeCleared(name string) bool {
 	switch name {
-	case link.EdgeCategory:
-		return m.clearedcategory
+	case comment.EdgePost:
+		return m.clearedpost
+	case comment.EdgeAuthor:
+		return m.clearedauthor
+	case comment.EdgeParent:
+		return m.clearedparent
+	case comment.EdgeReplies:
+		return m.clearedreplies
 	}
 	return false
 }
 
 // ClearEdge clears the value of the edge with the given name. It returns an error
 // if that edge is not defined in the schema.
-func (m *LinkMutation) ClearEdge(name string) error {
+func (m *CommentMutation) ClearEdge(name string) error {
 	switch name {
-	case link.EdgeCategory:
-		m.ClearCategory()
+	case comment.EdgePost:
+		m.ClearPost()
+		return nil
+	case comment.EdgeAuthor:
+		m.ClearAuthor()
+		return nil
+	case comment.EdgeParent:
+		m.ClearParent()
 		return nil
 	}
-	return fmt.Errorf("unknown Link unique edge %s", name)
+	return fmt.Errorf("unknown Comment unique edge %s", name)
 }
 
 // ResetEdge resets all changes to the edge with the given name in this mutation.
 // It returns an error if the edge is not defined in the schema.
-func (m *LinkMutation) ResetEdge(name string) error {
+func (m *CommentMutation) ResetEdge(name string) error {
 	switch name {
-	case link.EdgeCategory:
-		m.ResetCategory()
+	case comment.EdgePost:
+		m.ResetPost()
+		return nil
+	case comment.EdgeAuthor:
+		m.ResetAuthor()
+		return nil
+	case comment.EdgeParent:
+		m.ResetParent()
+		return nil
+	case comment.EdgeReplies:
+		m.ResetReplies()
 		return nil
 	}
-	return fmt.Errorf("unknown Link edge %s", name)
+	return fmt.Errorf("unknown Comment edge %s", name)
 }
 
-// LinkCategoryMutation represents an operation that mutates the LinkCategory nodes in the graph.
-type LinkCategoryMutation struct {
+// LinkMutation represents an operation that mutates the Link nodes in the graph.
+type LinkMutation struct {
 	config
-	op            Op
-	typ           string
-	id            *uint
-	created_at    *time.Time
-	updated_at    *time.Time
-	deleted_at    *time.Time
-	name          *string
-	sort_order    *int
-	addsort_order *int
-	clearedFields map[string]struct{}
-	links         map[uint]struct{}
-	removedlinks  map[uint]struct{}
-	clearedlinks  bool
-	done          bool
-	oldValue      func(context.Context) (*LinkCategory, error)
-	predicates    []predicate.LinkCategory
+	op              Op
+	typ             string
+	id              *uint
+	created_at      *time.Time
+	updated_at      *time.Time
+	deleted_at      *time.Time
+	description     *string
+	enabled         *bool
+	name            *string
+	sort_order      *int
+	addsort_order   *int
+	url             *string
+	avatar          *string
+	status          *entity.LinkStatus
+	clearedFields   map[string]struct{}
+	category        *uint
+	clearedcategory bool
+	done            bool
+	oldValue        func(context.Context) (*Link, error)
+	predicates      []predicate.Link
 }
 
-var _ ent.Mutation = (*LinkCategoryMutation)(nil)
+var _ ent.Mutation = (*LinkMutation)(nil)
 
-// linkcategoryOption allows management of the mutation configuration using functional options.
-type linkcategoryOption func(*LinkCategoryMutation)
+// linkOption allows management of the mutation configuration using functional options.
+type linkOption func(*LinkMutation)
 
-// newLinkCategoryMutation creates new mutation for the LinkCategory entity.
-func newLinkCategoryMutation(c config, op Op, opts ...linkcategoryOption) *LinkCategoryMutation {
-	m := &LinkCategoryMutation{
+// newLinkMutation creates new mutation for the Link entity.
+func newLinkMutation(c config, op Op, opts ...linkOption) *LinkMutation {
+	m := &LinkMutation{
 		config:        c,
 		op:            op,
-		typ:           TypeLinkCategory,
+		typ:           TypeLink,
 		clearedFields: make(map[string]struct{}),
 	}
 	for _, opt := range opts {
@@ -1588,20 +1660,20 @@ func newLinkCategoryMutation(c config, op Op, opts ...linkcategoryOption) *LinkC
 	return m
 }
 
-// withLinkCategoryID sets the ID field of the mutation.
-func withLinkCategoryID(id uint) linkcategoryOption {
-	return func(m *LinkCategoryMutation) {
+// withLinkID sets the ID field of the mutation.
+func withLinkID(id uint) linkOption {
+	return func(m *LinkMutation) {
 		var (
 			err   error
 			once  sync.Once
-			value *LinkCategory
+			value *Link
 		)
-		m.oldValue = func(ctx context.Context) (*LinkCategory, error) {
+		m.oldValue = func(ctx context.Context) (*Link, error) {
 			once.Do(func() {
 				if m.done {
 					err = errors.New("querying old values post mutation is not allowed")
 				} else {
-					value, err = m.Client().LinkCategory.Get(ctx, id)
+					value, err = m.Client().Link.Get(ctx, id)
 				}
 			})
 			return value, err
@@ -1610,10 +1682,10 @@ func withLinkCategoryID(id uint) linkcategoryOption {
 	}
 }
 
-// withLinkCategory sets the old LinkCategory of the mutation.
-func withLinkCategory(node *LinkCategory) linkcategoryOption {
-	return func(m *LinkCategoryMutation) {
-		m.oldValue = func(context.Context) (*LinkCategory, error) {
+// withLink sets the old Link of the mutation.
+func withLink(node *Link) linkOption {
+	return func(m *LinkMutation) {
+		m.oldValue = func(context.Context) (*Link, error) {
 			return node, nil
 		}
 		m.id = &node.ID
@@ -1622,7 +1694,7 @@ func withLinkCategory(node *LinkCategory) linkcategoryOption {
 
 // Client returns a new `ent.Client` from the mutation. If the mutation was
 // executed in a transaction (ent.Tx), a transactional client is returned.
-func (m LinkCategoryMutation) Client() *Client {
+func (m LinkMutation) Client() *Client {
 	client := &Client{config: m.config}
 	client.init()
 	return client
@@ -1630,7 +1702,7 @@ func (m LinkCategoryMutation) Client() *Client {
 
 // Tx returns an `ent.Tx` for mutations that were executed in transactions;
 // it returns an error otherwise.
-func (m LinkCategoryMutation) Tx() (*Tx, error) {
+func (m LinkMutation) Tx() (*Tx, error) {
 	if _, ok := m.driver.(*txDriver); !ok {
 		return nil, errors.New("ent: mutation is not running in a transaction")
 	}
@@ -1640,14 +1712,14 @@ func (m LinkCategoryMutation) Tx() (*Tx, error) {
 }
 
 // SetID sets the value of the id field. Note that this
-// operation is only accepted on creation of LinkCategory entities.
-func (m *LinkCategoryMutation) SetID(id uint) {
+// operation is only accepted on creation of Link entities.
+func (m *LinkMutation) SetID(id uint) {
 	m.id = &id
 }
 
 // ID returns the ID value in the mutation. Note that the ID is only available
 // if it was provided to the builder or after it was returned from the database.
-func (m *LinkCategoryMutation) ID() (id uint, exists bool) {
+func (m *LinkMutation) ID() (id uint, exists bool) {
 	if m.id == nil {
 		return
 	}
@@ -1658,7 +1730,7 @@ func (m *LinkCategoryMutation) ID() (id uint, exists bool) {
 // That means, if the mutation is applied within a transaction with an isolation level such
 // as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
 // or updated by the mutation.
-func (m *LinkCategoryMutation) IDs(ctx context.Context) ([]uint, error) {
+func (m *LinkMutation) IDs(ctx context.Context) ([]uint, error) {
 	switch {
 	case m.op.Is(OpUpdateOne | OpDeleteOne):
 		id, exists := m.ID()
@@ -1667,19 +1739,19 @@ func (m *LinkCategoryMutation) IDs(ctx context.Context) ([]uint, error) {
 		}
 		fallthrough
 	case m.op.Is(OpUpdate | OpDelete):
-		return m.Client().LinkCategory.Query().Where(m.predicates...).IDs(ctx)
+		return m.Client().Link.Query().Where(m.predicates...).IDs(ctx)
 	default:
 		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
 	}
 }
 
 // SetCreatedAt sets the "created_at" field.
-func (m *LinkCategoryMutation) SetCreatedAt(t time.Time) {
+func (m *LinkMutation) SetCreatedAt(t time.Time) {
 	m.created_at = &t
 }
 
 // CreatedAt returns the value of the "created_at" field in the mutation.
-func (m *LinkCategoryMutation) CreatedAt() (r time.Time, exists bool) {
+func (m *LinkMutation) CreatedAt() (r time.Time, exists bool) {
 	v := m.created_at
 	if v == nil {
 		return
@@ -1687,10 +1759,10 @@ func (m *LinkCategoryMutation) CreatedAt() (r time.Time, exists bool) {
 	return *v, true
 }
 
-// OldCreatedAt returns the old "created_at" field's value of the LinkCategory entity.
-// If the LinkCategory object wasn't provided to the builder, the object is fetched from the database.
+// OldCreatedAt returns the old "created_at" field's value of the Link entity.
+// If the Link object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *LinkCategoryMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
+func (m *LinkMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
 	if !m.op.Is(OpUpdateOne) {
 		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
 	}
@@ -1705,17 +1777,17 @@ func (m *LinkCategoryMutation) OldCreatedAt(ctx context.Context) (v time.Time, e
 }
 
 // ResetCreatedAt resets all changes to the "created_at" field.
-func (m *LinkCategoryMutation) ResetCreatedAt() {
+func (m *LinkMutation) ResetCreatedAt() {
 	m.created_at = nil
 }
 
 // SetUpdatedAt sets the "updated_at" field.
-func (m *LinkCategoryMutation) SetUpdatedAt(t time.Time) {
+func (m *LinkMutation) SetUpdatedAt(t time.Time) {
 	m.updated_at = &t
 }
 
 // UpdatedAt returns the value of the "updated_at" field in the mutation.
-func (m *LinkCategoryMutation) UpdatedAt() (r time.Time, exists bool) {
+func (m *LinkMutation) UpdatedAt() (r time.Time, exists bool) {
 	v := m.updated_at
 	if v == nil {
 		return
@@ -1723,10 +1795,10 @@ func (m *LinkCategoryMutation) UpdatedAt() (r time.Time, exists bool) {
 	return *v, true
 }
 
-// OldUpdatedAt returns the old "updated_at" field's value of the LinkCategory entity.
-// If the LinkCategory object wasn't provided to the builder, the object is fetched from the database.
+// OldUpdatedAt returns the old "updated_at" field's value of the Link entity.
+// If the Link object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *LinkCategoryMutation) OldUpdatedAt(ctx context.Context) (v time.Time, err error) {
+func (m *LinkMutation) OldUpdatedAt(ctx context.Context) (v time.Time, err error) {
 	if !m.op.Is(OpUpdateOne) {
 		return v, errors.New("OldUpdatedAt is only allowed on UpdateOne operations")
 	}
@@ -1741,17 +1813,17 @@ func (m *LinkCategoryMutation) OldUpdatedAt(ctx context.Context) (v time.Time, e
 }
 
 // ResetUpdatedAt resets all changes to the "updated_at" field.
-func (m *LinkCategoryMutation) ResetUpdatedAt() {
+func (m *LinkMutation) ResetUpdatedAt() {
 	m.updated_at = nil
 }
 
 // SetDeletedAt sets the "deleted_at" field.
-func (m *LinkCategoryMutation) SetDeletedAt(t time.Time) {
+func (m *LinkMutation) SetDeletedAt(t time.Time) {
 	m.deleted_at = &t
 }
 
 // DeletedAt returns the value of the "deleted_at" field in the mutation.
-func (m *LinkCategoryMutation) DeletedAt() (r time.Time, exists bool) {
+func (m *LinkMutation) DeletedAt() (r time.Time, exists bool) {
 	v := m.deleted_at
 	if v == nil {
 		return
@@ -1759,10 +1831,10 @@ func (m *LinkCategoryMutation) DeletedAt() (r time.Time, exists bool) {
 	return *v, true
 }
 
-// OldDeletedAt returns the old "deleted_at" field's value of the LinkCategory entity.
-// If the LinkCategory object wasn't provided to the builder, the object is fetched from the database.
+// OldDeletedAt returns the old "deleted_at" field's value of the Link entity.
+// If the Link object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *LinkCategoryMutation) OldDeletedAt(ctx context.Context) (v *time.Time, err error) {
+func (m *LinkMutation) OldDeletedAt(ctx context.Context) (v *time.Time, err error) {
 	if !m.op.Is(OpUpdateOne) {
 		return v, errors.New("OldDeletedAt is only allowed on UpdateOne operations")
 	}
@@ -1777,41 +1849,126 @@ func (m *LinkCategoryMutation) OldDeletedAt(ctx context.Context) (v *time.Time,
 }
 
 // ClearDeletedAt clears the value of the "deleted_at" field.
-func (m *LinkCategoryMutation) ClearDeletedAt() {
+func (m *LinkMutation) ClearDeletedAt() {
 	m.deleted_at = nil
-	m.clearedFields[linkcategory.FieldDeletedAt] = struct{}{}
+	m.clearedFields[link.FieldDeletedAt] = struct{}{}
 }
 
 // DeletedAtCleared returns if the "deleted_at" field was cleared in this mutation.
-func (m *LinkCategoryMutation) DeletedAtCleared() bool {
-	_, ok := m.clearedFields[linkcategory.FieldDeletedAt]
+func (m *LinkMutation) DeletedAtCleared() bool {
+	_, ok := m.clearedFields[link.FieldDeletedAt]
 	return ok
 }
 
 // ResetDeletedAt resets all changes to the "deleted_at" field.
-func (m *LinkCategoryMutation) ResetDeletedAt() {
+func (m *LinkMutation) ResetDeletedAt() {
 	m.deleted_at = nil
-	delete(m.clearedFields, linkcategory.FieldDeletedAt)
+	delete(m.clearedFields, link.FieldDeletedAt)
 }
 
-// SetName sets the "name" field.
-func (m *LinkCategoryMutation) SetName(s string) {
-	m.name = &s
+// SetDescription sets the "description" field.
+func (m *LinkMutation) SetDescription(s string) {
+	m.description = &s
 }
 
-// Name returns the value of the "name" field in the mutation.
-func (m *LinkCategoryMutation) Name() (r string, exists bool) {
-	v := m.name
+// Description returns the value of the "description" field in the mutation.
+func (m *LinkMutation) Description() (r string, exists bool) {
+	v := m.description
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldName returns the old "name" field's value of the LinkCategory entity.
-// If the LinkCategory object wasn't provided to the builder, the object is fetched from the database.
+// OldDescription returns the old "description" field's value of the Link entity.
+// If the Link object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *LinkCategoryMutation) OldName(ctx context.Context) (v string, err error) {
+func (m *LinkMutation) OldDescription(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldDescription is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldDescription requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldDescription: %w", err)
+	}
+	return oldValue.Description, nil
+}
+
+// ClearDescription clears the value of the "description" field.
+func (m *LinkMutation) ClearDescription() {
+	m.description = nil
+	m.clearedFields[link.FieldDescription] = struct{}{}
+}
+
+// DescriptionCleared returns if the "description" field was cleared in this mutation.
+func (m *LinkMutation) DescriptionCleared() bool {
+	_, ok := m.clearedFields[link.FieldDescription]
+	return ok
+}
+
+// ResetDescription resets all changes to the "description" field.
+func (m *LinkMutation) ResetDescription() {
+	m.description = nil
+	delete(m.clearedFields, link.FieldDescription)
+}
+
+// SetEnabled sets the "enabled" field.
+func (m *LinkMutation) SetEnabled(b bool) {
+	m.enabled = &b
+}
+
+// Enabled returns the value of the "enabled" field in the mutation.
+func (m *LinkMutation) Enabled() (r bool, exists bool) {
+	v := m.enabled
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldEnabled returns the old "enabled" field's value of the Link entity.
+// If the Link object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *LinkMutation) OldEnabled(ctx context.Context) (v bool, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldEnabled is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldEnabled requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldEnabled: %w", err)
+	}
+	return oldValue.Enabled, nil
+}
+
+// ResetEnabled resets all changes to the "enabled" field.
+func (m *LinkMutation) ResetEnabled() {
+	m.enabled = nil
+}
+
+// SetName sets the "name" field.
+func (m *LinkMutation) SetName(s string) {
+	m.name = &s
+}
+
+// Name returns the value of the "name" field in the mutation.
+func (m *LinkMutation) Name() (r string, exists bool) {
+	v := m.name
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldName returns the old "name" field's value of the Link entity.
+// If the Link object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *LinkMutation) OldName(ctx context.Context) (v string, err error) {
 	if !m.op.Is(OpUpdateOne) {
 		return v, errors.New("OldName is only allowed on UpdateOne operations")
 	}
@@ -1826,18 +1983,18 @@ func (m *LinkCategoryMutation) OldName(ctx context.Context) (v string, err error
 }
 
 // ResetName resets all changes to the "name" field.
-func (m *LinkCategoryMutation) ResetName() {
+func (m *LinkMutation) ResetName() {
 	m.name = nil
 }
 
 // SetSortOrder sets the "sort_order" field.
-func (m *LinkCategoryMutation) SetSortOrder(i int) {
+func (m *LinkMutation) SetSortOrder(i int) {
 	m.sort_order = &i
 	m.addsort_order = nil
 }
 
 // SortOrder returns the value of the "sort_order" field in the mutation.
-func (m *LinkCategoryMutation) SortOrder() (r int, exists bool) {
+func (m *LinkMutation) SortOrder() (r int, exists bool) {
 	v := m.sort_order
 	if v == nil {
 		return
@@ -1845,10 +2002,10 @@ func (m *LinkCategoryMutation) SortOrder() (r int, exists bool) {
 	return *v, true
 }
 
-// OldSortOrder returns the old "sort_order" field's value of the LinkCategory entity.
-// If the LinkCategory object wasn't provided to the builder, the object is fetched from the database.
+// OldSortOrder returns the old "sort_order" field's value of the Link entity.
+// If the Link object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *LinkCategoryMutation) OldSortOrder(ctx context.Context) (v int, err error) {
+func (m *LinkMutation) OldSortOrder(ctx context.Context) (v int, err error) {
 	if !m.op.Is(OpUpdateOne) {
 		return v, errors.New("OldSortOrder is only allowed on UpdateOne operations")
 	}
@@ -1863,7 +2020,7 @@ func (m *LinkCategoryMutation) OldSortOrder(ctx context.Context) (v int, err err
 }
 
 // AddSortOrder adds i to the "sort_order" field.
-func (m *LinkCategoryMutation) AddSortOrder(i int) {
+func (m *LinkMutation) AddSortOrder(i int) {
 	if m.addsort_order != nil {
 		*m.addsort_order += i
 	} else {
@@ -1872,7 +2029,7 @@ func (m *LinkCategoryMutation) AddSortOrder(i int) {
 }
 
 // AddedSortOrder returns the value that was added to the "sort_order" field in this mutation.
-func (m *LinkCategoryMutation) AddedSortOrder() (r int, exists bool) {
+func (m *LinkMutation) AddedSortOrder() (r int, exists bool) {
 	v := m.addsort_order
 	if v == nil {
 		return
@@ -1881,114 +2038,275 @@ func (m *LinkCategoryMutation) AddedSortOrder() (r int, exists bool) {
 }
 
 // ResetSortOrder resets all changes to the "sort_order" field.
-func (m *LinkCategoryMutation) ResetSortOrder() {
+func (m *LinkMutation) ResetSortOrder() {
 	m.sort_order = nil
 	m.addsort_order = nil
 }
 
-// AddLinkIDs adds the "links" edge to the Link entity by ids.
-func (m *LinkCategoryMutation) AddLinkIDs(ids ...uint) {
-	if m.links == nil {
-		m.links = make(map[uint]struct{})
+// SetURL sets the "url" field.
+func (m *LinkMutation) SetURL(s string) {
+	m.url = &s
+}
+
+// URL returns the value of the "url" field in the mutation.
+func (m *LinkMutation) URL() (r string, exists bool) {
+	v := m.url
+	if v == nil {
+		return
 	}
-	for i := range ids {
-		m.links[ids[i]] = struct{}{}
+	return *v, true
+}
+
+// OldURL returns the old "url" field's value of the Link entity.
+// If the Link object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *LinkMutation) OldURL(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldURL is only allowed on UpdateOne operations")
 	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldURL requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldURL: %w", err)
+	}
+	return oldValue.URL, nil
 }
 
-// ClearLinks clears the "links" edge to the Link entity.
-func (m *LinkCategoryMutation) ClearLinks() {
-	m.clearedlinks = true
+// ResetURL resets all changes to the "url" field.
+func (m *LinkMutation) ResetURL() {
+	m.url = nil
 }
 
-// LinksCleared reports if the "links" edge to the Link entity was cleared.
-func (m *LinkCategoryMutation) LinksCleared() bool {
-	return m.clearedlinks
+// SetAvatar sets the "avatar" field.
+func (m *LinkMutation) SetAvatar(s string) {
+	m.avatar = &s
 }
 
-// RemoveLinkIDs removes the "links" edge to the Link entity by IDs.
-func (m *LinkCategoryMutation) RemoveLinkIDs(ids ...uint) {
-	if m.removedlinks == nil {
-		m.removedlinks = make(map[uint]struct{})
-	}
-	for i := range ids {
-		delete(m.links, ids[i])
-		m.removedlinks[ids[i]] = struct{}{}
+// Avatar returns the value of the "avatar" field in the mutation.
+func (m *LinkMutation) Avatar() (r string, exists bool) {
+	v := m.avatar
+	if v == nil {
+		return
 	}
+	return *v, true
 }
 
-// RemovedLinks returns the removed IDs of the "links" edge to the Link entity.
-func (m *LinkCategoryMutation) RemovedLinksIDs() (ids []uint) {
-	for id := range m.removedlinks {
-		ids = append(ids, id)
+// OldAvatar returns the old "avatar" field's value of the Link entity.
+// If the Link object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *LinkMutation) OldAvatar(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldAvatar is only allowed on UpdateOne operations")
 	}
-	return
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldAvatar requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldAvatar: %w", err)
+	}
+	return oldValue.Avatar, nil
 }
 
-// LinksIDs returns the "links" edge IDs in the mutation.
-func (m *LinkCategoryMutation) LinksIDs() (ids []uint) {
-	for id := range m.links {
-		ids = append(ids, id)
-	}
-	return
+// ClearAvatar clears the value of the "avatar" field.
+func (m *LinkMutation) ClearAvatar() {
+	m.avatar = nil
+	m.clearedFields[link.FieldAvatar] = struct{}{}
 }
 
-// ResetLinks resets all changes to the "links" edge.
-func (m *LinkCategoryMutation) ResetLinks() {
-	m.links = nil
-	m.clearedlinks = false
-	m.removedlinks = nil
+// AvatarCleared returns if the "avatar" field was cleared in this mutation.
+func (m *LinkMutation) AvatarCleared() bool {
+	_, ok := m.clearedFields[link.FieldAvatar]
+	return ok
 }
 
-// Where appends a list predicates to the LinkCategoryMutation builder.
-func (m *LinkCategoryMutation) Where(ps ...predicate.LinkCategory) {
-	m.predicates = append(m.predicates, ps...)
+// ResetAvatar resets all changes to the "avatar" field.
+func (m *LinkMutation) ResetAvatar() {
+	m.avatar = nil
+	delete(m.clearedFields, link.FieldAvatar)
 }
 
-// WhereP appends storage-level predicates to the LinkCategoryMutation builder. Using this method,
-// users can use type-assertion to append predicates that do not depend on any generated package.
-func (m *LinkCategoryMutation) WhereP(ps ...func(*sql.Selector)) {
-	p := make([]predicate.LinkCategory, len(ps))
-	for i := range ps {
-		p[i] = ps[i]
+// SetStatus sets the "status" field.
+func (m *LinkMutation) SetStatus(es entity.LinkStatus) {
+	m.status = &es
+}
+
+// Status returns the value of the "status" field in the mutation.
+func (m *LinkMutation) Status() (r entity.LinkStatus, exists bool) {
+	v := m.status
+	if v == nil {
+		return
 	}
-	m.Where(p...)
+	return *v, true
 }
 
-// Op returns the operation name.
-func (m *LinkCategoryMutation) Op() Op {
-	return m.op
+// OldStatus returns the old "status" field's value of the Link entity.
+// If the Link object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *LinkMutation) OldStatus(ctx context.Context) (v entity.LinkStatus, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldStatus is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldStatus requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldStatus: %w", err)
+	}
+	return oldValue.Status, nil
 }
 
-// SetOp allows setting the mutation operation.
-func (m *LinkCategoryMutation) SetOp(op Op) {
-	m.op = op
+// ResetStatus resets all changes to the "status" field.
+func (m *LinkMutation) ResetStatus() {
+	m.status = nil
 }
 
-// Type returns the node type of this mutation (LinkCategory).
-func (m *LinkCategoryMutation) Type() string {
-	return m.typ
+// SetCategoryID sets the "category_id" field.
+func (m *LinkMutation) SetCategoryID(u uint) {
+	m.category = &u
 }
 
-// Fields returns all fields that were changed during this mutation. Note that in
+// CategoryID returns the value of the "category_id" field in the mutation.
+func (m *LinkMutation) CategoryID() (r uint, exists bool) {
+	v := m.category
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCategoryID returns the old "category_id" field's value of the Link entity.
+// If the Link object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *LinkMutation) OldCategoryID(ctx context.Context) (v uint, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCategoryID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCategoryID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCategoryID: %w", err)
+	}
+	return oldValue.CategoryID, nil
+}
+
+// ClearCategoryID clears the value of the "category_id" field.
+func (m *LinkMutation) ClearCategoryID() {
+	m.category = nil
+	m.clearedFields[link.FieldCategoryID] = struct{}{}
+}
+
+// CategoryIDCleared returns if the "category_id" field was cleared in this mutation.
+func (m *LinkMutation) CategoryIDCleared() bool {
+	_, ok := m.clearedFields[link.FieldCategoryID]
+	return ok
+}
+
+// ResetCategoryID resets all changes to the "category_id" field.
+func (m *LinkMutation) ResetCategoryID() {
+	m.category = nil
+	delete(m.clearedFields, link.FieldCategoryID)
+}
+
+// ClearCategory clears the "category" edge to the LinkCategory entity.
+func (m *LinkMutation) ClearCategory() {
+	m.clearedcategory = true
+	m.clearedFields[link.FieldCategoryID] = struct{}{}
+}
+
+// CategoryCleared reports if the "category" edge to the LinkCategory entity was cleared.
+func (m *LinkMutation) CategoryCleared() bool {
+	return m.CategoryIDCleared() || m.clearedcategory
+}
+
+// CategoryIDs returns the "category" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// CategoryID instead. It exists only for internal usage by the builders.
+func (m *LinkMutation) CategoryIDs() (ids []uint) {
+	if id := m.category; id != nil {
+		ids = append(ids, *id)
+	}
+	return
+}
+
+// ResetCategory resets all changes to the "category" edge.
+func (m *LinkMutation) ResetCategory() {
+	m.category = nil
+	m.clearedcategory = false
+}
+
+// Where appends a list predicates to the LinkMutation builder.
+func (m *LinkMutation) Where(ps ...predicate.Link) {
+	m.predicates = append(m.predicates, ps...)
+}
+
+// WhereP appends storage-level predicates to the LinkMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *LinkMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.Link, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
+	}
+	m.Where(p...)
+}
+
+// Op returns the operation name.
+func (m *LinkMutation) Op() Op {
+	return m.op
+}
+
+// SetOp allows setting the mutation operation.
+func (m *LinkMutation) SetOp(op Op) {
+	m.op = op
+}
+
+// Type returns the node type of this mutation (Link).
+func (m *LinkMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
 // order to get all numeric fields that were incremented/decremented, call
 // AddedFields().
-func (m *LinkCategoryMutation) Fields() []string {
-	fields := make([]string, 0, 5)
+func (m *LinkMutation) Fields() []string {
+	fields := make([]string, 0, 11)
 	if m.created_at != nil {
-		fields = append(fields, linkcategory.FieldCreatedAt)
+		fields = append(fields, link.FieldCreatedAt)
 	}
 	if m.updated_at != nil {
-		fields = append(fields, linkcategory.FieldUpdatedAt)
+		fields = append(fields, link.FieldUpdatedAt)
 	}
 	if m.deleted_at != nil {
-		fields = append(fields, linkcategory.FieldDeletedAt)
+		fields = append(fields, link.FieldDeletedAt)
+	}
+	if m.description != nil {
+		fields = append(fields, link.FieldDescription)
+	}
+	if m.enabled != nil {
+		fields = append(fields, link.FieldEnabled)
 	}
 	if m.name != nil {
-		fields = append(fields, linkcategory.FieldName)
+		fields = append(fields, link.FieldName)
 	}
 	if m.sort_order != nil {
-		fields = append(fields, linkcategory.FieldSortOrder)
+		fields = append(fields, link.FieldSortOrder)
+	}
+	if m.url != nil {
+		fields = append(fields, link.FieldURL)
+	}
+	if m.avatar != nil {
+		fields = append(fields, link.FieldAvatar)
+	}
+	if m.status != nil {
+		fields = append(fields, link.FieldStatus)
+	}
+	if m.category != nil {
+		fields = append(fields, link.FieldCategoryID)
 	}
 	return fields
 }
@@ -1996,18 +2314,30 @@ func (m *LinkCategoryMutation) Fields() []string {
 // Field returns the value of a field with the given name. The second boolean
 // return value indicates that this field was not set, or was not defined in the
 // schema.
-func (m *LinkCategoryMutation) Field(name string) (ent.Value, bool) {
+func (m *LinkMutation) Field(name string) (ent.Value, bool) {
 	switch name {
-	case linkcategory.FieldCreatedAt:
+	case link.FieldCreatedAt:
 		return m.CreatedAt()
-	case linkcategory.FieldUpdatedAt:
+	case link.FieldUpdatedAt:
 		return m.UpdatedAt()
-	case linkcategory.FieldDeletedAt:
+	case link.FieldDeletedAt:
 		return m.DeletedAt()
-	case linkcategory.FieldName:
+	case link.FieldDescription:
+		return m.Description()
+	case link.FieldEnabled:
+		return m.Enabled()
+	case link.FieldName:
 		return m.Name()
-	case linkcategory.FieldSortOrder:
+	case link.FieldSortOrder:
 		return m.SortOrder()
+	case link.FieldURL:
+		return m.URL()
+	case link.FieldAvatar:
+		return m.Avatar()
+	case link.FieldStatus:
+		return m.Status()
+	case link.FieldCategoryID:
+		return m.CategoryID()
 	}
 	return nil, false
 }
@@ -2015,72 +2345,126 @@ func (m *LinkCategoryMutation) Field(name string) (ent.Value, bool) {
 // OldField returns the old value of the field from the database. An error is
 // returned if the mutation operation is not UpdateOne, or the query to the
 // database failed.
-func (m *LinkCategoryMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+func (m *LinkMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
 	switch name {
-	case linkcategory.FieldCreatedAt:
+	case link.FieldCreatedAt:
 		return m.OldCreatedAt(ctx)
-	case linkcategory.FieldUpdatedAt:
+	case link.FieldUpdatedAt:
 		return m.OldUpdatedAt(ctx)
-	case linkcategory.FieldDeletedAt:
+	case link.FieldDeletedAt:
 		return m.OldDeletedAt(ctx)
-	case linkcategory.FieldName:
+	case link.FieldDescription:
+		return m.OldDescription(ctx)
+	case link.FieldEnabled:
+		return m.OldEnabled(ctx)
+	case link.FieldName:
 		return m.OldName(ctx)
-	case linkcategory.FieldSortOrder:
+	case link.FieldSortOrder:
 		return m.OldSortOrder(ctx)
+	case link.FieldURL:
+		return m.OldURL(ctx)
+	case link.FieldAvatar:
+		return m.OldAvatar(ctx)
+	case link.FieldStatus:
+		return m.OldStatus(ctx)
+	case link.FieldCategoryID:
+		return m.OldCategoryID(ctx)
 	}
-	return nil, fmt.Errorf("unknown LinkCategory field %s", name)
+	return nil, fmt.Errorf("unknown Link field %s", name)
 }
 
 // SetField sets the value of a field with the given name. It returns an error if
 // the field is not defined in the schema, or if the type mismatched the field
 // type.
-func (m *LinkCategoryMutation) SetField(name string, value ent.Value) error {
+func (m *LinkMutation) SetField(name string, value ent.Value) error {
 	switch name {
-	case linkcategory.FieldCreatedAt:
+	case link.FieldCreatedAt:
 		v, ok := value.(time.Time)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
 		m.SetCreatedAt(v)
 		return nil
-	case linkcategory.FieldUpdatedAt:
+	case link.FieldUpdatedAt:
 		v, ok := value.(time.Time)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
 		m.SetUpdatedAt(v)
 		return nil
-	case linkcategory.FieldDeletedAt:
+	case link.FieldDeletedAt:
 		v, ok := value.(time.Time)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
 		m.SetDeletedAt(v)
 		return nil
-	case linkcategory.FieldName:
+	case link.FieldDescription:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetDescription(v)
+		return nil
+	case link.FieldEnabled:
+		v, ok := value.(bool)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetEnabled(v)
+		return nil
+	case link.FieldName:
 		v, ok := value.(string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
 		m.SetName(v)
 		return nil
-	case linkcategory.FieldSortOrder:
+	case link.FieldSortOrder:
 		v, ok := value.(int)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
 		m.SetSortOrder(v)
 		return nil
+	case link.FieldURL:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetURL(v)
+		return nil
+	case link.FieldAvatar:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetAvatar(v)
+		return nil
+	case link.FieldStatus:
+		v, ok := value.(entity.LinkStatus)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetStatus(v)
+		return nil
+	case link.FieldCategoryID:
+		v, ok := value.(uint)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCategoryID(v)
+		return nil
 	}
-	return fmt.Errorf("unknown LinkCategory field %s", name)
+	return fmt.Errorf("unknown Link field %s", name)
 }
 
 // AddedFields returns all numeric fields that were incremented/decremented during
 // this mutation.
-func (m *LinkCategoryMutation) AddedFields() []string {
+func (m *LinkMutation) AddedFields() []string {
 	var fields []string
 	if m.addsort_order != nil {
-		fields = append(fields, linkcategory.FieldSortOrder)
+		fields = append(fields, link.FieldSortOrder)
 	}
 	return fields
 }
@@ -2088,9 +2472,9 @@ func (m *LinkCategoryMutation) AddedFields() []string {
 // AddedField returns the numeric value that was incremented/decremented on a field
 // with the given name. The second boolean return value indicates that this field
 // was not set, or was not defined in the schema.
-func (m *LinkCategoryMutation) AddedField(name string) (ent.Value, bool) {
+func (m *LinkMutation) AddedField(name string) (ent.Value, bool) {
 	switch name {
-	case linkcategory.FieldSortOrder:
+	case link.FieldSortOrder:
 		return m.AddedSortOrder()
 	}
 	return nil, false
@@ -2099,9 +2483,9 @@ func (m *LinkCategoryMutation) AddedField(name string) (ent.Value, bool) {
 // AddField adds the value to the field with the given name. It returns an error if
 // the field is not defined in the schema, or if the type mismatched the field
 // type.
-func (m *LinkCategoryMutation) AddField(name string, value ent.Value) error {
+func (m *LinkMutation) AddField(name string, value ent.Value) error {
 	switch name {
-	case linkcategory.FieldSortOrder:
+	case link.FieldSortOrder:
 		v, ok := value.(int)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
@@ -2109,188 +2493,202 @@ func (m *LinkCategoryMutation) AddField(name string, value ent.Value) error {
 		m.AddSortOrder(v)
 		return nil
 	}
-	return fmt.Errorf("unknown LinkCategory numeric field %s", name)
+	return fmt.Errorf("unknown Link numeric field %s", name)
 }
 
 // ClearedFields returns all nullable fields that were cleared during this
 // mutation.
-func (m *LinkCategoryMutation) ClearedFields() []string {
+func (m *LinkMutation) ClearedFields() []string {
 	var fields []string
-	if m.FieldCleared(linkcategory.FieldDeletedAt) {
-		fields = append(fields, linkcategory.FieldDeletedAt)
+	if m.FieldCleared(link.FieldDeletedAt) {
+		fields = append(fields, link.FieldDeletedAt)
+	}
+	if m.FieldCleared(link.FieldDescription) {
+		fields = append(fields, link.FieldDescription)
+	}
+	if m.FieldCleared(link.FieldAvatar) {
+		fields = append(fields, link.FieldAvatar)
+	}
+	if m.FieldCleared(link.FieldCategoryID) {
+		fields = append(fields, link.FieldCategoryID)
 	}
 	return fields
 }
 
 // FieldCleared returns a boolean indicating if a field with the given name was
 // cleared in this mutation.
-func (m *LinkCategoryMutation) FieldCleared(name string) bool {
+func (m *LinkMutation) FieldCleared(name string) bool {
 	_, ok := m.clearedFields[name]
 	return ok
 }
 
 // ClearField clears the value of the field with the given name. It returns an
 // error if the field is not defined in the schema.
-func (m *LinkCategoryMutation) ClearField(name string) error {
+func (m *LinkMutation) ClearField(name string) error {
 	switch name {
-	case linkcategory.FieldDeletedAt:
+	case link.FieldDeletedAt:
 		m.ClearDeletedAt()
 		return nil
+	case link.FieldDescription:
+		m.ClearDescription()
+		return nil
+	case link.FieldAvatar:
+		m.ClearAvatar()
+		return nil
+	case link.FieldCategoryID:
+		m.ClearCategoryID()
+		return nil
 	}
-	return fmt.Errorf("unknown LinkCategory nullable field %s", name)
+	return fmt.Errorf("unknown Link nullable field %s", name)
 }
 
 // ResetField resets all changes in the mutation for the field with the given name.
 // It returns an error if the field is not defined in the schema.
-func (m *LinkCategoryMutation) ResetField(name string) error {
+func (m *LinkMutation) ResetField(name string) error {
 	switch name {
-	case linkcategory.FieldCreatedAt:
+	case link.FieldCreatedAt:
 		m.ResetCreatedAt()
 		return nil
-	case linkcategory.FieldUpdatedAt:
+	case link.FieldUpdatedAt:
 		m.ResetUpdatedAt()
 		return nil
-	case linkcategory.FieldDeletedAt:
+	case link.FieldDeletedAt:
 		m.ResetDeletedAt()
 		return nil
-	case linkcategory.FieldName:
+	case link.FieldDescription:
+		m.ResetDescription()
+		return nil
+	case link.FieldEnabled:
+		m.ResetEnabled()
+		return nil
+	case link.FieldName:
 		m.ResetName()
 		return nil
-	case linkcategory.FieldSortOrder:
+	case link.FieldSortOrder:
 		m.ResetSortOrder()
 		return nil
+	case link.FieldURL:
+		m.ResetURL()
+		return nil
+	case link.FieldAvatar:
+		m.ResetAvatar()
+		return nil
+	case link.FieldStatus:
+		m.ResetStatus()
+		return nil
+	case link.FieldCategoryID:
+		m.ResetCategoryID()
+		return nil
 	}
-	return fmt.Errorf("unknown LinkCategory field %s", name)
+	return fmt.Errorf("unknown Link field %s", name)
 }
 
 // AddedEdges returns all edge names that were set/added in this mutation.
-func (m *LinkCategoryMutation) AddedEdges() []string {
+func (m *LinkMutation) AddedEdges() []string {
 	edges := make([]string, 0, 1)
-	if m.links != nil {
-		edges = append(edges, linkcategory.EdgeLinks)
+	if m.category != nil {
+		edges = append(edges, link.EdgeCategory)
 	}
 	return edges
 }
 
 // AddedIDs returns all IDs (to other nodes) that were added for the given edge
 // name in this mutation.
-func (m *LinkCategoryMutation) AddedIDs(name string) []ent.Value {
+func (m *LinkMutation) AddedIDs(name string) []ent.Value {
 	switch name {
-	case linkcategory.EdgeLinks:
-		ids := make([]ent.Value, 0, len(m.links))
-		for id := range m.links {
-			ids = append(ids, id)
+	case link.EdgeCategory:
+		if id := m.category; id != nil {
+			return []ent.Value{*id}
 		}
-		return ids
 	}
 	return nil
 }
 
 // RemovedEdges returns all edge names that were removed in this mutation.
-func (m *LinkCategoryMutation) RemovedEdges() []string {
+func (m *LinkMutation) RemovedEdges() []string {
 	edges := make([]string, 0, 1)
-	if m.removedlinks != nil {
-		edges = append(edges, linkcategory.EdgeLinks)
-	}
 	return edges
 }
 
 // RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
 // the given name in this mutation.
-func (m *LinkCategoryMutation) RemovedIDs(name string) []ent.Value {
-	switch name {
-	case linkcategory.EdgeLinks:
-		ids := make([]ent.Value, 0, len(m.removedlinks))
-		for id := range m.removedlinks {
-			ids = append(ids, id)
-		}
-		return ids
-	}
+func (m *LinkMutation) RemovedIDs(name string) []ent.Value {
 	return nil
 }
 
 // ClearedEdges returns all edge names that were cleared in this mutation.
-func (m *LinkCategoryMutation) ClearedEdges() []string {
+func (m *LinkMutation) ClearedEdges() []string {
 	edges := make([]string, 0, 1)
-	if m.clearedlinks {
-		edges = append(edges, linkcategory.EdgeLinks)
+	if m.clearedcategory {
+		edges = append(edges, link.EdgeCategory)
 	}
 	return edges
 }
 
 // EdgeCleared returns a boolean which indicates if the edge with the given name
 // was cleared in this mutation.
-func (m *LinkCategoryMutation) EdgeCleared(name string) bool {
+func (m *LinkMutation) EdgeCleared(name string) bool {
 	switch name {
-	case linkcategory.EdgeLinks:
-		return m.clearedlinks
+	case link.EdgeCategory:
+		return m.clearedcategory
 	}
 	return false
 }
 
 // ClearEdge clears the value of the edge with the given name. It returns an error
 // if that edge is not defined in the schema.
-func (m *LinkCategoryMutation) ClearEdge(name string) error {
+func (m *LinkMutation) ClearEdge(name string) error {
 	switch name {
+	case link.EdgeCategory:
+		m.ClearCategory()
+		return nil
 	}
-	return fmt.Errorf("unknown LinkCategory unique edge %s", name)
+	return fmt.Errorf("unknown Link unique edge %s", name)
 }
 
 // ResetEdge resets all changes to the edge with the given name in this mutation.
 // It returns an error if the edge is not defined in the schema.
-func (m *LinkCategoryMutation) ResetEdge(name string) error {
+func (m *LinkMutation) ResetEdge(name string) error {
 	switch name {
-	case linkcategory.EdgeLinks:
-		m.ResetLinks()
+	case link.EdgeCategory:
+		m.ResetCategory()
 		return nil
 	}
-	return fmt.Errorf("unknown LinkCategory edge %s", name)
+	return fmt.Errorf("unknown Link edge %s", name)
 }
 
-// PostMutation represents an operation that mutates the Post nodes in the graph.
-type PostMutation struct {
+// LinkCategoryMutation represents an operation that mutates the LinkCategory nodes in the graph.
+type LinkCategoryMutation struct {
 	config
-	op                   Op
-	typ                  string
-	id                   *uint
-	created_at           *time.Time
-	updated_at           *time.Time
-	deleted_at           *time.Time
-	title                *string
-	summary              *string
-	content              *string
-	cover                *string
-	read_time_minutes    *uint
-	addread_time_minutes *int
-	view_count           *uint
-	addview_count        *int
-	status               *entity.PostStatus
-	published_at         *time.Time
-	clearedFields        map[string]struct{}
-	author               *uint
-	clearedauthor        bool
-	categories           map[uint]struct{}
-	removedcategories    map[uint]struct{}
-	clearedcategories    bool
-	tags                 map[uint]struct{}
-	removedtags          map[uint]struct{}
-	clearedtags          bool
-	done                 bool
-	oldValue             func(context.Context) (*Post, error)
-	predicates           []predicate.Post
+	op            Op
+	typ           string
+	id            *uint
+	created_at    *time.Time
+	updated_at    *time.Time
+	deleted_at    *time.Time
+	name          *string
+	sort_order    *int
+	addsort_order *int
+	clearedFields map[string]struct{}
+	links         map[uint]struct{}
+	removedlinks  map[uint]struct{}
+	clearedlinks  bool
+	done          bool
+	oldValue      func(context.Context) (*LinkCategory, error)
+	predicates    []predicate.LinkCategory
 }
 
-var _ ent.Mutation = (*PostMutation)(nil)
+var _ ent.Mutation = (*LinkCategoryMutation)(nil)
 
-// postOption allows management of the mutation configuration using functional options.
-type postOption func(*PostMutation)
+// linkcategoryOption allows management of the mutation configuration using functional options.
+type linkcategoryOption func(*LinkCategoryMutation)
 
-// newPostMutation creates new mutation for the Post entity.
-func newPostMutation(c config, op Op, opts ...postOption) *PostMutation {
-	m := &PostMutation{
+// newLinkCategoryMutation creates new mutation for the LinkCategory entity.
+func newLinkCategoryMutation(c config, op Op, opts ...linkcategoryOption) *LinkCategoryMutation {
+	m := &LinkCategoryMutation{
 		config:        c,
 		op:            op,
-		typ:           TypePost,
+		typ:           TypeLinkCategory,
 		clearedFields: make(map[string]struct{}),
 	}
 	for _, opt := range opts {
@@ -2299,20 +2697,20 @@ func newPostMutation(c config, op Op, opts ...postOption) *PostMutation {
 	return m
 }
 
-// withPostID sets the ID field of the mutation.
-func withPostID(id uint) postOption {
-	return func(m *PostMutation) {
+// withLinkCategoryID sets the ID field of the mutation.
+func withLinkCategoryID(id uint) linkcategoryOption {
+	return func(m *LinkCategoryMutation) {
 		var (
 			err   error
 			once  sync.Once
-			value *Post
+			value *LinkCategory
 		)
-		m.oldValue = func(ctx context.Context) (*Post, error) {
+		m.oldValue = func(ctx context.Context) (*LinkCategory, error) {
 			once.Do(func() {
 				if m.done {
 					err = errors.New("querying old values post mutation is not allowed")
 				} else {
-					value, err = m.Client().Post.Get(ctx, id)
+					value, err = m.Client().LinkCategory.Get(ctx, id)
 				}
 			})
 			return value, err
@@ -2321,10 +2719,10 @@ func withPostID(id uint) postOption {
 	}
 }
 
-// withPost sets the old Post of the mutation.
-func withPost(node *Post) postOption {
-	return func(m *PostMutation) {
-		m.oldValue = func(context.Context) (*Post, error) {
+// withLinkCategory sets the old LinkCategory of the mutation.
+func withLinkCategory(node *LinkCategory) linkcategoryOption {
+	return func(m *LinkCategoryMutation) {
+		m.oldValue = func(context.Context) (*LinkCategory, error) {
 			return node, nil
 		}
 		m.id = &node.ID
@@ -2333,7 +2731,7 @@ func withPost(node *Post) postOption {
 
 // Client returns a new `ent.Client` from the mutation. If the mutation was
 // executed in a transaction (ent.Tx), a transactional client is returned.
-func (m PostMutation) Client() *Client {
+func (m LinkCategoryMutation) Client() *Client {
 	client := &Client{config: m.config}
 	client.init()
 	return client
@@ -2341,7 +2739,7 @@ func (m PostMutation) Client() *Client {
 
 // Tx returns an `ent.Tx` for mutations that were executed in transactions;
 // it returns an error otherwise.
-func (m PostMutation) Tx() (*Tx, error) {
+func (m LinkCategoryMutation) Tx() (*Tx, error) {
 	if _, ok := m.driver.(*txDriver); !ok {
 		return nil, errors.New("ent: mutation is not running in a transaction")
 	}
@@ -2351,14 +2749,14 @@ func (m PostMutation) Tx() (*Tx, error) {
 }
 
 // SetID sets the value of the id field. Note that this
-// operation is only accepted on creation of Post entities.
-func (m *PostMutation) SetID(id uint) {
+// operation is only accepted on creation of LinkCategory entities.
+func (m *LinkCategoryMutation) SetID(id uint) {
 	m.id = &id
 }
 
 // ID returns the ID value in the mutation. Note that the ID is only available
 // if it was provided to the builder or after it was returned from the database.
-func (m *PostMutation) ID() (id uint, exists bool) {
+func (m *LinkCategoryMutation) ID() (id uint, exists bool) {
 	if m.id == nil {
 		return
 	}
@@ -2369,7 +2767,7 @@ func (m *PostMutation) ID() (id uint, exists bool) {
 // That means, if the mutation is applied within a transaction with an isolation level such
 // as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
 // or updated by the mutation.
-func (m *PostMutation) IDs(ctx context.Context) ([]uint, error) {
+func (m *LinkCategoryMutation) IDs(ctx context.Context) ([]uint, error) {
 	switch {
 	case m.op.Is(OpUpdateOne | OpDeleteOne):
 		id, exists := m.ID()
@@ -2378,19 +2776,19 @@ func (m *PostMutation) IDs(ctx context.Context) ([]uint, error) {
 		}
 		fallthrough
 	case m.op.Is(OpUpdate | OpDelete):
-		return m.Client().Post.Query().Where(m.predicates...).IDs(ctx)
+		return m.Client().LinkCategory.Query().Where(m.predicates...).IDs(ctx)
 	default:
 		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
 	}
 }
 
 // SetCreatedAt sets the "created_at" field.
-func (m *PostMutation) SetCreatedAt(t time.Time) {
+func (m *LinkCategoryMutation) SetCreatedAt(t time.Time) {
 	m.created_at = &t
 }
 
 // CreatedAt returns the value of the "created_at" field in the mutation.
-func (m *PostMutation) CreatedAt() (r time.Time, exists bool) {
+func (m *LinkCategoryMutation) CreatedAt() (r time.Time, exists bool) {
 	v := m.created_at
 	if v == nil {
 		return
@@ -2398,10 +2796,10 @@ func (m *PostMutation) CreatedAt() (r time.Time, exists bool) {
 	return *v, true
 }
 
-// OldCreatedAt returns the old "created_at" field's value of the Post entity.
-// If the Post object wasn't provided to the builder, the object is fetched from the database.
+// OldCreatedAt returns the old "created_at" field's value of the LinkCategory entity.
+// If the LinkCategory object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *PostMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
+func (m *LinkCategoryMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
 	if !m.op.Is(OpUpdateOne) {
 		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
 	}
@@ -2416,17 +2814,17 @@ func (m *PostMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error
 }
 
 // ResetCreatedAt resets all changes to the "created_at" field.
-func (m *PostMutation) ResetCreatedAt() {
+func (m *LinkCategoryMutation) ResetCreatedAt() {
 	m.created_at = nil
 }
 
 // SetUpdatedAt sets the "updated_at" field.
-func (m *PostMutation) SetUpdatedAt(t time.Time) {
+func (m *LinkCategoryMutation) SetUpdatedAt(t time.Time) {
 	m.updated_at = &t
 }
 
 // UpdatedAt returns the value of the "updated_at" field in the mutation.
-func (m *PostMutation) UpdatedAt() (r time.Time, exists bool) {
+func (m *LinkCategoryMutation) UpdatedAt() (r time.Time, exists bool) {
 	v := m.updated_at
 	if v == nil {
 		return
@@ -2434,10 +2832,10 @@ func (m *PostMutation) UpdatedAt() (r time.Time, exists bool) {
 	return *v, true
 }
 
-// OldUpdatedAt returns the old "updated_at" field's value of the Post entity.
-// If the Post object wasn't provided to the builder, the object is fetched from the database.
+// OldUpdatedAt returns the old "updated_at" field's value of the LinkCategory entity.
+// If the LinkCategory object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *PostMutation) OldUpdatedAt(ctx context.Context) (v time.Time, err error) {
+func (m *LinkCategoryMutation) OldUpdatedAt(ctx context.Context) (v time.Time, err error) {
 	if !m.op.Is(OpUpdateOne) {
 		return v, errors.New("OldUpdatedAt is only allowed on UpdateOne operations")
 	}
@@ -2452,17 +2850,17 @@ func (m *PostMutation) OldUpdatedAt(ctx context.Context) (v time.Time, err error
 }
 
 // ResetUpdatedAt resets all changes to the "updated_at" field.
-func (m *PostMutation) ResetUpdatedAt() {
+func (m *LinkCategoryMutation) ResetUpdatedAt() {
 	m.updated_at = nil
 }
 
 // SetDeletedAt sets the "deleted_at" field.
-func (m *PostMutation) SetDeletedAt(t time.Time) {
+func (m *LinkCategoryMutation) SetDeletedAt(t time.Time) {
 	m.deleted_at = &t
 }
 
 // DeletedAt returns the value of the "deleted_at" field in the mutation.
-func (m *PostMutation) DeletedAt() (r time.Time, exists bool) {
+func (m *LinkCategoryMutation) DeletedAt() (r time.Time, exists bool) {
 	v := m.deleted_at
 	if v == nil {
 		return
@@ -2470,10 +2868,10 @@ func (m *PostMutation) DeletedAt() (r time.Time, exists bool) {
 	return *v, true
 }
 
-// OldDeletedAt returns the old "deleted_at" field's value of the Post entity.
-// If the Post object wasn't provided to the builder, the object is fetched from the database.
+// OldDeletedAt returns the old "deleted_at" field's value of the LinkCategory entity.
+// If the LinkCategory object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *PostMutation) OldDeletedAt(ctx context.Context) (v *time.Time, err error) {
+func (m *LinkCategoryMutation) OldDeletedAt(ctx context.Context) (v *time.Time, err error) {
 	if !m.op.Is(OpUpdateOne) {
 		return v, errors.New("OldDeletedAt is only allowed on UpdateOne operations")
 	}
@@ -2488,583 +2886,7966 @@ func (m *PostMutation) OldDeletedAt(ctx context.Context) (v *time.Time, err erro
 }
 
 // ClearDeletedAt clears the value of the "deleted_at" field.
-func (m *PostMutation) ClearDeletedAt() {
+func (m *LinkCategoryMutation) ClearDeletedAt() {
 	m.deleted_at = nil
-	m.clearedFields[post.FieldDeletedAt] = struct{}{}
+	m.clearedFields[linkcategory.FieldDeletedAt] = struct{}{}
 }
 
 // DeletedAtCleared returns if the "deleted_at" field was cleared in this mutation.
-func (m *PostMutation) DeletedAtCleared() bool {
-	_, ok := m.clearedFields[post.FieldDeletedAt]
+func (m *LinkCategoryMutation) DeletedAtCleared() bool {
+	_, ok := m.clearedFields[linkcategory.FieldDeletedAt]
 	return ok
 }
 
 // ResetDeletedAt resets all changes to the "deleted_at" field.
-func (m *PostMutation) ResetDeletedAt() {
+func (m *LinkCategoryMutation) ResetDeletedAt() {
 	m.deleted_at = nil
-	delete(m.clearedFields, post.FieldDeletedAt)
+	delete(m.clearedFields, linkcategory.FieldDeletedAt)
 }
 
-// SetUserID sets the "user_id" field.
-func (m *PostMutation) SetUserID(u uint) {
-	m.author = &u
+// SetName sets the "name" field.
+func (m *LinkCategoryMutation) SetName(s string) {
+	m.name = &s
 }
 
-// UserID returns the value of the "user_id" field in the mutation.
-func (m *PostMutation) UserID() (r uint, exists bool) {
-	v := m.author
+// Name returns the value of the "name" field in the mutation.
+func (m *LinkCategoryMutation) Name() (r string, exists bool) {
+	v := m.name
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldUserID returns the old "user_id" field's value of the Post entity.
-// If the Post object wasn't provided to the builder, the object is fetched from the database.
+// OldName returns the old "name" field's value of the LinkCategory entity.
+// If the LinkCategory object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *PostMutation) OldUserID(ctx context.Context) (v uint, err error) {
+func (m *LinkCategoryMutation) OldName(ctx context.Context) (v string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldUserID is only allowed on UpdateOne operations")
+		return v, errors.New("OldName is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldUserID requires an ID field in the mutation")
+		return v, errors.New("OldName requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldUserID: %w", err)
+		return v, fmt.Errorf("querying old value for OldName: %w", err)
 	}
-	return oldValue.UserID, nil
+	return oldValue.Name, nil
 }
 
-// ResetUserID resets all changes to the "user_id" field.
-func (m *PostMutation) ResetUserID() {
-	m.author = nil
+// ResetName resets all changes to the "name" field.
+func (m *LinkCategoryMutation) ResetName() {
+	m.name = nil
 }
 
-// SetTitle sets the "title" field.
-func (m *PostMutation) SetTitle(s string) {
-	m.title = &s
+// SetSortOrder sets the "sort_order" field.
+func (m *LinkCategoryMutation) SetSortOrder(i int) {
+	m.sort_order = &i
+	m.addsort_order = nil
 }
 
-// Title returns the value of the "title" field in the mutation.
-func (m *PostMutation) Title() (r string, exists bool) {
-	v := m.title
+// SortOrder returns the value of the "sort_order" field in the mutation.
+func (m *LinkCategoryMutation) SortOrder() (r int, exists bool) {
+	v := m.sort_order
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldTitle returns the old "title" field's value of the Post entity.
-// If the Post object wasn't provided to the builder, the object is fetched from the database.
+// OldSortOrder returns the old "sort_order" field's value of the LinkCategory entity.
+// If the LinkCategory object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *PostMutation) OldTitle(ctx context.Context) (v string, err error) {
+func (m *LinkCategoryMutation) OldSortOrder(ctx context.Context) (v int, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldTitle is only allowed on UpdateOne operations")
+		return v, errors.New("OldSortOrder is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldTitle requires an ID field in the mutation")
+		return v, errors.New("OldSortOrder requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldTitle: %w", err)
+		return v, fmt.Errorf("querying old value for OldSortOrder: %w", err)
 	}
-	return oldValue.Title, nil
-}
-
-// ResetTitle resets all changes to the "title" field.
-func (m *PostMutation) ResetTitle() {
-	m.title = nil
+	return oldValue.SortOrder, nil
 }
 
-// SetSummary sets the "summary" field.
-func (m *PostMutation) SetSummary(s string) {
-	m.summary = &s
+// AddSortOrder adds i to the "sort_order" field.
+func (m *LinkCategoryMutation) AddSortOrder(i int) {
+	if m.addsort_order != nil {
+		*m.addsort_order += i
+	} else {
+		m.addsort_order = &i
+	}
 }
 
-// Summary returns the value of the "summary" field in the mutation.
-func (m *PostMutation) Summary() (r string, exists bool) {
-	v := m.summary
+// AddedSortOrder returns the value that was added to the "sort_order" field in this mutation.
+func (m *LinkCategoryMutation) AddedSortOrder() (r int, exists bool) {
+	v := m.addsort_order
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldSummary returns the old "summary" field's value of the Post entity.
-// If the Post object wasn't provided to the builder, the object is fetched from the database.
-// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *PostMutation) OldSummary(ctx context.Context) (v *string, err error) {
-	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldSummary is only allowed on UpdateOne operations")
-	}
-	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldSummary requires an ID field in the mutation")
-	}
-	oldValue, err := m.oldValue(ctx)
-	if err != nil {
-		return v, fmt.Errorf("querying old value for OldSummary: %w", err)
-	}
-	return oldValue.Summary, nil
-}
-
-// ClearSummary clears the value of the "summary" field.
-func (m *PostMutation) ClearSummary() {
-	m.summary = nil
-	m.clearedFields[post.FieldSummary] = struct{}{}
+// ResetSortOrder resets all changes to the "sort_order" field.
+func (m *LinkCategoryMutation) ResetSortOrder() {
+	m.sort_order = nil
+	m.addsort_order = nil
 }
 
-// SummaryCleared returns if the "summary" field was cleared in this mutation.
-func (m *PostMutation) SummaryCleared() bool {
-	_, ok := m.clearedFields[post.FieldSummary]
-	return ok
+// AddLinkIDs adds the "links" edge to the Link entity by ids.
+func (m *LinkCategoryMutation) AddLinkIDs(ids ...uint) {
+	if m.links == nil {
+		m.links = make(map[uint]struct{})
+	}
+	for i := range ids {
+		m.links[ids[i]] = struct{}{}
+	}
 }
 
-// ResetSummary resets all changes to the "summary" field.
-func (m *PostMutation) ResetSummary() {
-	m.summary = nil
-	delete(m.clearedFields, post.FieldSummary)
+// ClearLinks clears the "links" edge to the Link entity.
+func (m *LinkCategoryMutation) ClearLinks() {
+	m.clearedlinks = true
 }
 
-// SetContent sets the "content" field.
-func (m *PostMutation) SetContent(s string) {
-	m.content = &s
+// LinksCleared reports if the "links" edge to the Link entity was cleared.
+func (m *LinkCategoryMutation) LinksCleared() bool {
+	return m.clearedlinks
 }
 
-// Content returns the value of the "content" field in the mutation.
-func (m *PostMutation) Content() (r string, exists bool) {
-	v := m.content
-	if v == nil {
-		return
+// RemoveLinkIDs removes the "links" edge to the Link entity by IDs.
+func (m *LinkCategoryMutation) RemoveLinkIDs(ids ...uint) {
+	if m.removedlinks == nil {
+		m.removedlinks = make(map[uint]struct{})
+	}
+	for i := range ids {
+		delete(m.links, ids[i])
+		m.removedlinks[ids[i]] = struct{}{}
 	}
-	return *v, true
 }
 
-// OldContent returns the old "content" field's value of the Post entity.
-// If the Post object wasn't provided to the builder, the object is fetched from the database.
-// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *PostMutation) OldContent(ctx context.Context) (v string, err error) {
-	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldContent is only allowed on UpdateOne operations")
-	}
-	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldContent requires an ID field in the mutation")
-	}
-	oldValue, err := m.oldValue(ctx)
-	if err != nil {
-		return v, fmt.Errorf("querying old value for OldContent: %w", err)
+// RemovedLinks returns the removed IDs of the "links" edge to the Link entity.
+func (m *LinkCategoryMutation) RemovedLinksIDs() (ids []uint) {
+	for id := range m.removedlinks {
+		ids = append(ids, id)
 	}
-	return oldValue.Content, nil
+	return
 }
 
-// ResetContent resets all changes to the "content" field.
-func (m *PostMutation) ResetContent() {
-	m.content = nil
+// LinksIDs returns the "links" edge IDs in the mutation.
+func (m *LinkCategoryMutation) LinksIDs() (ids []uint) {
+	for id := range m.links {
+		ids = append(ids, id)
+	}
+	return
 }
 
-// SetCover sets the "cover" field.
-func (m *PostMutation) SetCover(s string) {
-	m.cover = &s
+// ResetLinks resets all changes to the "links" edge.
+func (m *LinkCategoryMutation) ResetLinks() {
+	m.links = nil
+	m.clearedlinks = false
+	m.removedlinks = nil
 }
 
-// Cover returns the value of the "cover" field in the mutation.
-func (m *PostMutation) Cover() (r string, exists bool) {
-	v := m.cover
-	if v == nil {
-		return
-	}
-	return *v, true
+// Where appends a list predicates to the LinkCategoryMutation builder.
+func (m *LinkCategoryMutation) Where(ps ...predicate.LinkCategory) {
+	m.predicates = append(m.predicates, ps...)
 }
 
-// OldCover returns the old "cover" field's value of the Post entity.
-// If the Post object wasn't provided to the builder, the object is fetched from the database.
-// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *PostMutation) OldCover(ctx context.Context) (v *string, err error) {
-	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldCover is only allowed on UpdateOne operations")
-	}
-	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldCover requires an ID field in the mutation")
-	}
-	oldValue, err := m.oldValue(ctx)
-	if err != nil {
-		return v, fmt.Errorf("querying old value for OldCover: %w", err)
+// WhereP appends storage-level predicates to the LinkCategoryMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *LinkCategoryMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.LinkCategory, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
 	}
-	return oldValue.Cover, nil
-}
-
-// ClearCover clears the value of the "cover" field.
-func (m *PostMutation) ClearCover() {
-	m.cover = nil
-	m.clearedFields[post.FieldCover] = struct{}{}
+	m.Where(p...)
 }
 
-// CoverCleared returns if the "cover" field was cleared in this mutation.
-func (m *PostMutation) CoverCleared() bool {
-	_, ok := m.clearedFields[post.FieldCover]
-	return ok
+// Op returns the operation name.
+func (m *LinkCategoryMutation) Op() Op {
+	return m.op
 }
 
-// ResetCover resets all changes to the "cover" field.
-func (m *PostMutation) ResetCover() {
-	m.cover = nil
-	delete(m.clearedFields, post.FieldCover)
+// SetOp allows setting the mutation operation.
+func (m *LinkCategoryMutation) SetOp(op Op) {
+	m.op = op
 }
 
-// SetReadTimeMinutes sets the "read_time_minutes" field.
-func (m *PostMutation) SetReadTimeMinutes(u uint) {
-	m.read_time_minutes = &u
-	m.addread_time_minutes = nil
+// Type returns the node type of this mutation (LinkCategory).
+func (m *LinkCategoryMutation) Type() string {
+	return m.typ
 }
 
-// ReadTimeMinutes returns the value of the "read_time_minutes" field in the mutation.
-func (m *PostMutation) ReadTimeMinutes() (r uint, exists bool) {
-	v := m.read_time_minutes
-	if v == nil {
-		return
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *LinkCategoryMutation) Fields() []string {
+	fields := make([]string, 0, 5)
+	if m.created_at != nil {
+		fields = append(fields, linkcategory.FieldCreatedAt)
+	}
+	if m.updated_at != nil {
+		fields = append(fields, linkcategory.FieldUpdatedAt)
+	}
+	if m.deleted_at != nil {
+		fields = append(fields, linkcategory.FieldDeletedAt)
+	}
+	if m.name != nil {
+		fields = append(fields, linkcategory.FieldName)
+	}
+	if m.sort_order != nil {
+		fields = append(fields, linkcategory.FieldSortOrder)
+	}
+	return fields
+}
+
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *LinkCategoryMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case linkcategory.FieldCreatedAt:
+		return m.CreatedAt()
+	case linkcategory.FieldUpdatedAt:
+		return m.UpdatedAt()
+	case linkcategory.FieldDeletedAt:
+		return m.DeletedAt()
+	case linkcategory.FieldName:
+		return m.Name()
+	case linkcategory.FieldSortOrder:
+		return m.SortOrder()
+	}
+	return nil, false
+}
+
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *LinkCategoryMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case linkcategory.FieldCreatedAt:
+		return m.OldCreatedAt(ctx)
+	case linkcategory.FieldUpdatedAt:
+		return m.OldUpdatedAt(ctx)
+	case linkcategory.FieldDeletedAt:
+		return m.OldDeletedAt(ctx)
+	case linkcategory.FieldName:
+		return m.OldName(ctx)
+	case linkcategory.FieldSortOrder:
+		return m.OldSortOrder(ctx)
+	}
+	return nil, fmt.Errorf("unknown LinkCategory field %s", name)
+}
+
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *LinkCategoryMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case linkcategory.FieldCreatedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCreatedAt(v)
+		return nil
+	case linkcategory.FieldUpdatedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetUpdatedAt(v)
+		return nil
+	case linkcategory.FieldDeletedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetDeletedAt(v)
+		return nil
+	case linkcategory.FieldName:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetName(v)
+		return nil
+	case linkcategory.FieldSortOrder:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetSortOrder(v)
+		return nil
+	}
+	return fmt.Errorf("unknown LinkCategory field %s", name)
+}
+
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *LinkCategoryMutation) AddedFields() []string {
+	var fields []string
+	if m.addsort_order != nil {
+		fields = append(fields, linkcategory.FieldSortOrder)
+	}
+	return fields
+}
+
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *LinkCategoryMutation) AddedField(name string) (ent.Value, bool) {
+	switch name {
+	case linkcategory.FieldSortOrder:
+		return m.AddedSortOrder()
+	}
+	return nil, false
+}
+
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *LinkCategoryMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	case linkcategory.FieldSortOrder:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddSortOrder(v)
+		return nil
+	}
+	return fmt.Errorf("unknown LinkCategory numeric field %s", name)
+}
+
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *LinkCategoryMutation) ClearedFields() []string {
+	var fields []string
+	if m.FieldCleared(linkcategory.FieldDeletedAt) {
+		fields = append(fields, linkcategory.FieldDeletedAt)
+	}
+	return fields
+}
+
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *LinkCategoryMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
+}
+
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *LinkCategoryMutation) ClearField(name string) error {
+	switch name {
+	case linkcategory.FieldDeletedAt:
+		m.ClearDeletedAt()
+		return nil
+	}
+	return fmt.Errorf("unknown LinkCategory nullable field %s", name)
+}
+
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *LinkCategoryMutation) ResetField(name string) error {
+	switch name {
+	case linkcategory.FieldCreatedAt:
+		m.ResetCreatedAt()
+		return nil
+	case linkcategory.FieldUpdatedAt:
+		m.ResetUpdatedAt()
+		return nil
+	case linkcategory.FieldDeletedAt:
+		m.ResetDeletedAt()
+		return nil
+	case linkcategory.FieldName:
+		m.ResetName()
+		return nil
+	case linkcategory.FieldSortOrder:
+		m.ResetSortOrder()
+		return nil
+	}
+	return fmt.Errorf("unknown LinkCategory field %s", name)
+}
+
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *LinkCategoryMutation) AddedEdges() []string {
+	edges := make([]string, 0, 1)
+	if m.links != nil {
+		edges = append(edges, linkcategory.EdgeLinks)
+	}
+	return edges
+}
+
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *LinkCategoryMutation) AddedIDs(name string) []ent.Value {
+	switch name {
+	case linkcategory.EdgeLinks:
+		ids := make([]ent.Value, 0, len(m.links))
+		for id := range m.links {
+			ids = append(ids, id)
+		}
+		return ids
+	}
+	return nil
+}
+
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *LinkCategoryMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 1)
+	if m.removedlinks != nil {
+		edges = append(edges, linkcategory.EdgeLinks)
+	}
+	return edges
+}
+
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *LinkCategoryMutation) RemovedIDs(name string) []ent.Value {
+	switch name {
+	case linkcategory.EdgeLinks:
+		ids := make([]ent.Value, 0, len(m.removedlinks))
+		for id := range m.removedlinks {
+			ids = append(ids, id)
+		}
+		return ids
+	}
+	return nil
+}
+
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *LinkCategoryMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 1)
+	if m.clearedlinks {
+		edges = append(edges, linkcategory.EdgeLinks)
+	}
+	return edges
+}
+
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *LinkCategoryMutation) EdgeCleared(name string) bool {
+	switch name {
+	case linkcategory.EdgeLinks:
+		return m.clearedlinks
+	}
+	return false
+}
+
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *LinkCategoryMutation) ClearEdge(name string) error {
+	switch name {
+	}
+	return fmt.Errorf("unknown LinkCategory unique edge %s", name)
+}
+
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *LinkCategoryMutation) ResetEdge(name string) error {
+	switch name {
+	case linkcategory.EdgeLinks:
+		m.ResetLinks()
+		return nil
+	}
+	return fmt.Errorf("unknown LinkCategory edge %s", name)
+}
+
+// PasskeyMutation represents an operation that mutates the Passkey nodes in the graph.
+type PasskeyMutation struct {
+	config
+	op               Op
+	typ              string
+	id               *uint
+	created_at       *time.Time
+	updated_at       *time.Time
+	deleted_at       *time.Time
+	credential_id    *string
+	public_key       *[]byte
+	algorithm        *int
+	addalgorithm     *int
+	sign_count       *uint32
+	addsign_count    *int32
+	name             *string
+	aaguid           *string
+	transports       *[]string
+	appendtransports []string
+	last_used_at     *time.Time
+	clearedFields    map[string]struct{}
+	user             *uint
+	cleareduser      bool
+	done             bool
+	oldValue         func(context.Context) (*Passkey, error)
+	predicates       []predicate.Passkey
+}
+
+var _ ent.Mutation = (*PasskeyMutation)(nil)
+
+// passkeyOption allows management of the mutation configuration using functional options.
+type passkeyOption func(*PasskeyMutation)
+
+// newPasskeyMutation creates new mutation for the Passkey entity.
+func newPasskeyMutation(c config, op Op, opts ...passkeyOption) *PasskeyMutation {
+	m := &PasskeyMutation{
+		config:        c,
+		op:            op,
+		typ:           TypePasskey,
+		clearedFields: make(map[string]struct{}),
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// withPasskeyID sets the ID field of the mutation.
+func withPasskeyID(id uint) passkeyOption {
+	return func(m *PasskeyMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *Passkey
+		)
+		m.oldValue = func(ctx context.Context) (*Passkey, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().Passkey.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
+	}
+}
+
+// withPasskey sets the old Passkey of the mutation.
+func withPasskey(node *Passkey) passkeyOption {
+	return func(m *PasskeyMutation) {
+		m.oldValue = func(context.Context) (*Passkey, error) {
+			return node, nil
+		}
+		m.id = &node.ID
+	}
+}
+
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m PasskeyMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
+}
+
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m PasskeyMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("ent: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
+}
+
+// SetID sets the value of the id field. Note that this
+// operation is only accepted on creation of Passkey entities.
+func (m *PasskeyMutation) SetID(id uint) {
+	m.id = &id
+}
+
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *PasskeyMutation) ID() (id uint, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
+}
+
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *PasskeyMutation) IDs(ctx context.Context) ([]uint, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []uint{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().Passkey.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (m *PasskeyMutation) SetCreatedAt(t time.Time) {
+	m.created_at = &t
+}
+
+// CreatedAt returns the value of the "created_at" field in the mutation.
+func (m *PasskeyMutation) CreatedAt() (r time.Time, exists bool) {
+	v := m.created_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCreatedAt returns the old "created_at" field's value of the Passkey entity.
+// If the Passkey object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *PasskeyMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCreatedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCreatedAt: %w", err)
+	}
+	return oldValue.CreatedAt, nil
+}
+
+// ResetCreatedAt resets all changes to the "created_at" field.
+func (m *PasskeyMutation) ResetCreatedAt() {
+	m.created_at = nil
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (m *PasskeyMutation) SetUpdatedAt(t time.Time) {
+	m.updated_at = &t
+}
+
+// UpdatedAt returns the value of the "updated_at" field in the mutation.
+func (m *PasskeyMutation) UpdatedAt() (r time.Time, exists bool) {
+	v := m.updated_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldUpdatedAt returns the old "updated_at" field's value of the Passkey entity.
+// If the Passkey object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *PasskeyMutation) OldUpdatedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldUpdatedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldUpdatedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldUpdatedAt: %w", err)
+	}
+	return oldValue.UpdatedAt, nil
+}
+
+// ResetUpdatedAt resets all changes to the "updated_at" field.
+func (m *PasskeyMutation) ResetUpdatedAt() {
+	m.updated_at = nil
+}
+
+// SetDeletedAt sets the "deleted_at" field.
+func (m *PasskeyMutation) SetDeletedAt(t time.Time) {
+	m.deleted_at = &t
+}
+
+// DeletedAt returns the value of the "deleted_at" field in the mutation.
+func (m *PasskeyMutation) DeletedAt() (r time.Time, exists bool) {
+	v := m.deleted_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldDeletedAt returns the old "deleted_at" field's value of the Passkey entity.
+// If the Passkey object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *PasskeyMutation) OldDeletedAt(ctx context.Context) (v *time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldDeletedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldDeletedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldDeletedAt: %w", err)
+	}
+	return oldValue.DeletedAt, nil
+}
+
+// ClearDeletedAt clears the value of the "deleted_at" field.
+func (m *PasskeyMutation) ClearDeletedAt() {
+	m.deleted_at = nil
+	m.clearedFields[passkey.FieldDeletedAt] = struct{}{}
+}
+
+// DeletedAtCleared returns if the "deleted_at" field was cleared in this mutation.
+func (m *PasskeyMutation) DeletedAtCleared() bool {
+	_, ok := m.clearedFields[passkey.FieldDeletedAt]
+	return ok
+}
+
+// ResetDeletedAt resets all changes to the "deleted_at" field.
+func (m *PasskeyMutation) ResetDeletedAt() {
+	m.deleted_at = nil
+	delete(m.clearedFields, passkey.FieldDeletedAt)
+}
+
+// SetUserID sets the "user_id" field.
+func (m *PasskeyMutation) SetUserID(u uint) {
+	m.user = &u
+}
+
+// UserID returns the value of the "user_id" field in the mutation.
+func (m *PasskeyMutation) UserID() (r uint, exists bool) {
+	v := m.user
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldUserID returns the old "user_id" field's value of the Passkey entity.
+// If the Passkey object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *PasskeyMutation) OldUserID(ctx context.Context) (v uint, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldUserID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldUserID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldUserID: %w", err)
+	}
+	return oldValue.UserID, nil
+}
+
+// ResetUserID resets all changes to the "user_id" field.
+func (m *PasskeyMutation) ResetUserID() {
+	m.user = nil
+}
+
+// SetCredentialID sets the "credential_id" field.
+func (m *PasskeyMutation) SetCredentialID(s string) {
+	m.credential_id = &s
+}
+
+// CredentialID returns the value of the "credential_id" field in the mutation.
+func (m *PasskeyMutation) CredentialID() (r string, exists bool) {
+	v := m.credential_id
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCredentialID returns the old "credential_id" field's value of the Passkey entity.
+// If the Passkey object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *PasskeyMutation) OldCredentialID(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCredentialID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCredentialID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCredentialID: %w", err)
+	}
+	return oldValue.CredentialID, nil
+}
+
+// ResetCredentialID resets all changes to the "credential_id" field.
+func (m *PasskeyMutation) ResetCredentialID() {
+	m.credential_id = nil
+}
+
+// SetPublicKey sets the "public_key" field.
+func (m *PasskeyMutation) SetPublicKey(b []byte) {
+	m.public_key = &b
+}
+
+// PublicKey returns the value of the "public_key" field in the mutation.
+func (m *PasskeyMutation) PublicKey() (r []byte, exists bool) {
+	v := m.public_key
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldPublicKey returns the old "public_key" field's value of the Passkey entity.
+// If the Passkey object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *PasskeyMutation) OldPublicKey(ctx context.Context) (v []byte, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldPublicKey is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldPublicKey requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldPublicKey: %w", err)
+	}
+	return oldValue.PublicKey, nil
+}
+
+// ResetPublicKey resets all changes to the "public_key" field.
+func (m *PasskeyMutation) ResetPublicKey() {
+	m.public_key = nil
+}
+
+// SetAlgorithm sets the "algorithm" field.
+func (m *PasskeyMutation) SetAlgorithm(i int) {
+	m.algorithm = &i
+	m.addalgorithm = nil
+}
+
+// Algorithm returns the value of the "algorithm" field in the mutation.
+func (m *PasskeyMutation) Algorithm() (r int, exists bool) {
+	v := m.algorithm
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldAlgorithm returns the old "algorithm" field's value of the Passkey entity.
+// If the Passkey object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *PasskeyMutation) OldAlgorithm(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldAlgorithm is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldAlgorithm requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldAlgorithm: %w", err)
+	}
+	return oldValue.Algorithm, nil
+}
+
+// AddAlgorithm adds i to the "algorithm" field.
+func (m *PasskeyMutation) AddAlgorithm(i int) {
+	if m.addalgorithm != nil {
+		*m.addalgorithm += i
+	} else {
+		m.addalgorithm = &i
+	}
+}
+
+// AddedAlgorithm returns the value that was added to the "algorithm" field in this mutation.
+func (m *PasskeyMutation) AddedAlgorithm() (r int, exists bool) {
+	v := m.addalgorithm
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetAlgorithm resets all changes to the "algorithm" field.
+func (m *PasskeyMutation) ResetAlgorithm() {
+	m.algorithm = nil
+	m.addalgorithm = nil
+}
+
+// SetSignCount sets the "sign_count" field.
+func (m *PasskeyMutation) SetSignCount(u uint32) {
+	m.sign_count = &u
+	m.addsign_count = nil
+}
+
+// SignCount returns the value of the "sign_count" field in the mutation.
+func (m *PasskeyMutation) SignCount() (r uint32, exists bool) {
+	v := m.sign_count
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldSignCount returns the old "sign_count" field's value of the Passkey entity.
+// If the Passkey object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *PasskeyMutation) OldSignCount(ctx context.Context) (v uint32, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldSignCount is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldSignCount requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldSignCount: %w", err)
+	}
+	return oldValue.SignCount, nil
+}
+
+// AddSignCount adds u to the "sign_count" field.
+func (m *PasskeyMutation) AddSignCount(u int32) {
+	if m.addsign_count != nil {
+		*m.addsign_count += u
+	} else {
+		m.addsign_count = &u
+	}
+}
+
+// AddedSignCount returns the value that was added to the "sign_count" field in this mutation.
+func (m *PasskeyMutation) AddedSignCount() (r int32, exists bool) {
+	v := m.addsign_count
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetSignCount resets all changes to the "sign_count" field.
+func (m *PasskeyMutation) ResetSignCount() {
+	m.sign_count = nil
+	m.addsign_count = nil
+}
+
+// SetName sets the "name" field.
+func (m *PasskeyMutation) SetName(s string) {
+	m.name = &s
+}
+
+// Name returns the value of the "name" field in the mutation.
+func (m *PasskeyMutation) Name() (r string, exists bool) {
+	v := m.name
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldName returns the old "name" field's value of the Passkey entity.
+// If the Passkey object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *PasskeyMutation) OldName(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldName is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldName requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldName: %w", err)
+	}
+	return oldValue.Name, nil
+}
+
+// ResetName resets all changes to the "name" field.
+func (m *PasskeyMutation) ResetName() {
+	m.name = nil
+}
+
+// SetAaguid sets the "aaguid" field.
+func (m *PasskeyMutation) SetAaguid(s string) {
+	m.aaguid = &s
+}
+
+// Aaguid returns the value of the "aaguid" field in the mutation.
+func (m *PasskeyMutation) Aaguid() (r string, exists bool) {
+	v := m.aaguid
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldAaguid returns the old "aaguid" field's value of the Passkey entity.
+// If the Passkey object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *PasskeyMutation) OldAaguid(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldAaguid is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldAaguid requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldAaguid: %w", err)
+	}
+	return oldValue.Aaguid, nil
+}
+
+// ClearAaguid clears the value of the "aaguid" field.
+func (m *PasskeyMutation) ClearAaguid() {
+	m.aaguid = nil
+	m.clearedFields[passkey.FieldAaguid] = struct{}{}
+}
+
+// AaguidCleared returns if the "aaguid" field was cleared in this mutation.
+func (m *PasskeyMutation) AaguidCleared() bool {
+	_, ok := m.clearedFields[passkey.FieldAaguid]
+	return ok
+}
+
+// ResetAaguid resets all changes to the "aaguid" field.
+func (m *PasskeyMutation) ResetAaguid() {
+	m.aaguid = nil
+	delete(m.clearedFields, passkey.FieldAaguid)
+}
+
+// SetTransports sets the "transports" field.
+func (m *PasskeyMutation) SetTransports(s []string) {
+	m.transports = &s
+	m.appendtransports = nil
+}
+
+// Transports returns the value of the "transports" field in the mutation.
+func (m *PasskeyMutation) Transports() (r []string, exists bool) {
+	v := m.transports
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldTransports returns the old "transports" field's value of the Passkey entity.
+// If the Passkey object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *PasskeyMutation) OldTransports(ctx context.Context) (v []string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldTransports is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldTransports requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldTransports: %w", err)
+	}
+	return oldValue.Transports, nil
+}
+
+// AppendTransports adds s to the "transports" field.
+func (m *PasskeyMutation) AppendTransports(s []string) {
+	m.appendtransports = append(m.appendtransports, s...)
+}
+
+// AppendedTransports returns the list of values that were appended to the "transports" field in this mutation.
+func (m *PasskeyMutation) AppendedTransports() ([]string, bool) {
+	if len(m.appendtransports) == 0 {
+		return nil, false
+	}
+	return m.appendtransports, true
+}
+
+// ClearTransports clears the value of the "transports" field.
+func (m *PasskeyMutation) ClearTransports() {
+	m.transports = nil
+	m.appendtransports = nil
+	m.clearedFields[passkey.FieldTransports] = struct{}{}
+}
+
+// TransportsCleared returns if the "transports" field was cleared in this mutation.
+func (m *PasskeyMutation) TransportsCleared() bool {
+	_, ok := m.clearedFields[passkey.FieldTransports]
+	return ok
+}
+
+// ResetTransports resets all changes to the "transports" field.
+func (m *PasskeyMutation) ResetTransports() {
+	m.transports = nil
+	m.appendtransports = nil
+	delete(m.clearedFields, passkey.FieldTransports)
+}
+
+// SetLastUsedAt sets the "last_used_at" field.
+func (m *PasskeyMutation) SetLastUsedAt(t time.Time) {
+	m.last_used_at = &t
+}
+
+// LastUsedAt returns the value of the "last_used_at" field in the mutation.
+func (m *PasskeyMutation) LastUsedAt() (r time.Time, exists bool) {
+	v := m.last_used_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldLastUsedAt returns the old "last_used_at" field's value of the Passkey entity.
+// If the Passkey object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *PasskeyMutation) OldLastUsedAt(ctx context.Context) (v *time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldLastUsedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldLastUsedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldLastUsedAt: %w", err)
+	}
+	return oldValue.LastUsedAt, nil
+}
+
+// ClearLastUsedAt clears the value of the "last_used_at" field.
+func (m *PasskeyMutation) ClearLastUsedAt() {
+	m.last_used_at = nil
+	m.clearedFields[passkey.FieldLastUsedAt] = struct{}{}
+}
+
+// LastUsedAtCleared returns if the "last_used_at" field was cleared in this mutation.
+func (m *PasskeyMutation) LastUsedAtCleared() bool {
+	_, ok := m.clearedFields[passkey.FieldLastUsedAt]
+	return ok
+}
+
+// ResetLastUsedAt resets all changes to the "last_used_at" field.
+func (m *PasskeyMutation) ResetLastUsedAt() {
+	m.last_used_at = nil
+	delete(m.clearedFields, passkey.FieldLastUsedAt)
+}
+
+// ClearUser clears the "user" edge to the User entity.
+func (m *PasskeyMutation) ClearUser() {
+	m.cleareduser = true
+	m.clearedFields[passkey.FieldUserID] = struct{}{}
+}
+
+// UserCleared reports if the "user" edge to the User entity was cleared.
+func (m *PasskeyMutation) UserCleared() bool {
+	return m.cleareduser
+}
+
+// UserIDs returns the "user" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// UserID instead. It exists only for internal usage by the builders.
+func (m *PasskeyMutation) UserIDs() (ids []uint) {
+	if id := m.user; id != nil {
+		ids = append(ids, *id)
+	}
+	return
+}
+
+// ResetUser resets all changes to the "user" edge.
+func (m *PasskeyMutation) ResetUser() {
+	m.user = nil
+	m.cleareduser = false
+}
+
+// Where appends a list predicates to the PasskeyMutation builder.
+func (m *PasskeyMutation) Where(ps ...predicate.Passkey) {
+	m.predicates = append(m.predicates, ps...)
+}
+
+// WhereP appends storage-level predicates to the PasskeyMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *PasskeyMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.Passkey, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
+	}
+	m.Where(p...)
+}
+
+// Op returns the operation name.
+func (m *PasskeyMutation) Op() Op {
+	return m.op
+}
+
+// SetOp allows setting the mutation operation.
+func (m *PasskeyMutation) SetOp(op Op) {
+	m.op = op
+}
+
+// Type returns the node type of this mutation (Passkey).
+func (m *PasskeyMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *PasskeyMutation) Fields() []string {
+	fields := make([]string, 0, 12)
+	if m.created_at != nil {
+		fields = append(fields, passkey.FieldCreatedAt)
+	}
+	if m.updated_at != nil {
+		fields = append(fields, passkey.FieldUpdatedAt)
+	}
+	if m.deleted_at != nil {
+		fields = append(fields, passkey.FieldDeletedAt)
+	}
+	if m.user != nil {
+		fields = append(fields, passkey.FieldUserID)
+	}
+	if m.credential_id != nil {
+		fields = append(fields, passkey.FieldCredentialID)
+	}
+	if m.public_key != nil {
+		fields = append(fields, passkey.FieldPublicKey)
+	}
+	if m.algorithm != nil {
+		fields = append(fields, passkey.FieldAlgorithm)
+	}
+	if m.sign_count != nil {
+		fields = append(fields, passkey.FieldSignCount)
+	}
+	if m.name != nil {
+		fields = append(fields, passkey.FieldName)
+	}
+	if m.aaguid != nil {
+		fields = append(fields, passkey.FieldAaguid)
+	}
+	if m.transports != nil {
+		fields = append(fields, passkey.FieldTransports)
+	}
+	if m.last_used_at != nil {
+		fields = append(fields, passkey.FieldLastUsedAt)
+	}
+	return fields
+}
+
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *PasskeyMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case passkey.FieldCreatedAt:
+		return m.CreatedAt()
+	case passkey.FieldUpdatedAt:
+		return m.UpdatedAt()
+	case passkey.FieldDeletedAt:
+		return m.DeletedAt()
+	case passkey.FieldUserID:
+		return m.UserID()
+	case passkey.FieldCredentialID:
+		return m.CredentialID()
+	case passkey.FieldPublicKey:
+		return m.PublicKey()
+	case passkey.FieldAlgorithm:
+		return m.Algorithm()
+	case passkey.FieldSignCount:
+		return m.SignCount()
+	case passkey.FieldName:
+		return m.Name()
+	case passkey.FieldAaguid:
+		return m.Aaguid()
+	case passkey.FieldTransports:
+		return m.Transports()
+	case passkey.FieldLastUsedAt:
+		return m.LastUsedAt()
+	}
+	return nil, false
+}
+
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *PasskeyMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case passkey.FieldCreatedAt:
+		return m.OldCreatedAt(ctx)
+	case passkey.FieldUpdatedAt:
+		return m.OldUpdatedAt(ctx)
+	case passkey.FieldDeletedAt:
+		return m.OldDeletedAt(ctx)
+	case passkey.FieldUserID:
+		return m.OldUserID(ctx)
+	case passkey.FieldCredentialID:
+		return m.OldCredentialID(ctx)
+	case passkey.FieldPublicKey:
+		return m.OldPublicKey(ctx)
+	case passkey.FieldAlgorithm:
+		return m.OldAlgorithm(ctx)
+	case passkey.FieldSignCount:
+		return m.OldSignCount(ctx)
+	case passkey.FieldName:
+		return m.OldName(ctx)
+	case passkey.FieldAaguid:
+		return m.OldAaguid(ctx)
+	case passkey.FieldTransports:
+		return m.OldTransports(ctx)
+	case passkey.FieldLastUsedAt:
+		return m.OldLastUsedAt(ctx)
+	}
+	return nil, fmt.Errorf("unknown Passkey field %s", name)
+}
+
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *PasskeyMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case passkey.FieldCreatedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCreatedAt(v)
+		return nil
+	case passkey.FieldUpdatedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetUpdatedAt(v)
+		return nil
+	case passkey.FieldDeletedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetDeletedAt(v)
+		return nil
+	case passkey.FieldUserID:
+		v, ok := value.(uint)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetUserID(v)
+		return nil
+	case passkey.FieldCredentialID:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCredentialID(v)
+		return nil
+	case passkey.FieldPublicKey:
+		v, ok := value.([]byte)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetPublicKey(v)
+		return nil
+	case passkey.FieldAlgorithm:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetAlgorithm(v)
+		return nil
+	case passkey.FieldSignCount:
+		v, ok := value.(uint32)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetSignCount(v)
+		return nil
+	case passkey.FieldName:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetName(v)
+		return nil
+	case passkey.FieldAaguid:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetAaguid(v)
+		return nil
+	case passkey.FieldTransports:
+		v, ok := value.([]string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetTransports(v)
+		return nil
+	case passkey.FieldLastUsedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetLastUsedAt(v)
+		return nil
+	}
+	return fmt.Errorf("unknown Passkey field %s", name)
+}
+
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *PasskeyMutation) AddedFields() []string {
+	var fields []string
+	if m.addalgorithm != nil {
+		fields = append(fields, passkey.FieldAlgorithm)
+	}
+	if m.addsign_count != nil {
+		fields = append(fields, passkey.FieldSignCount)
+	}
+	return fields
+}
+
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *PasskeyMutation) AddedField(name string) (ent.Value, bool) {
+	switch name {
+	case passkey.FieldAlgorithm:
+		return m.AddedAlgorithm()
+	case passkey.FieldSignCount:
+		return m.AddedSignCount()
+	}
+	return nil, false
+}
+
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *PasskeyMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	case passkey.FieldAlgorithm:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddAlgorithm(v)
+		return nil
+	case passkey.FieldSignCount:
+		v, ok := value.(int32)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddSignCount(v)
+		return nil
+	}
+	return fmt.Errorf("unknown Passkey numeric field %s", name)
+}
+
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *PasskeyMutation) ClearedFields() []string {
+	var fields []string
+	if m.FieldCleared(passkey.FieldDeletedAt) {
+		fields = append(fields, passkey.FieldDeletedAt)
+	}
+	if m.FieldCleared(passkey.FieldAaguid) {
+		fields = append(fields, passkey.FieldAaguid)
+	}
+	if m.FieldCleared(passkey.FieldTransports) {
+		fields = append(fields, passkey.FieldTransports)
+	}
+	if m.FieldCleared(passkey.FieldLastUsedAt) {
+		fields = append(fields, passkey.FieldLastUsedAt)
+	}
+	return fields
+}
+
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *PasskeyMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
+}
+
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *PasskeyMutation) ClearField(name string) error {
+	switch name {
+	case passkey.FieldDeletedAt:
+		m.ClearDeletedAt()
+		return nil
+	case passkey.FieldAaguid:
+		m.ClearAaguid()
+		return nil
+	case passkey.FieldTransports:
+		m.ClearTransports()
+		return nil
+	case passkey.FieldLastUsedAt:
+		m.ClearLastUsedAt()
+		return nil
+	}
+	return fmt.Errorf("unknown Passkey nullable field %s", name)
+}
+
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *PasskeyMutation) ResetField(name string) error {
+	switch name {
+	case passkey.FieldCreatedAt:
+		m.ResetCreatedAt()
+		return nil
+	case passkey.FieldUpdatedAt:
+		m.ResetUpdatedAt()
+		return nil
+	case passkey.FieldDeletedAt:
+		m.ResetDeletedAt()
+		return nil
+	case passkey.FieldUserID:
+		m.ResetUserID()
+		return nil
+	case passkey.FieldCredentialID:
+		m.ResetCredentialID()
+		return nil
+	case passkey.FieldPublicKey:
+		m.ResetPublicKey()
+		return nil
+	case passkey.FieldAlgorithm:
+		m.ResetAlgorithm()
+		return nil
+	case passkey.FieldSignCount:
+		m.ResetSignCount()
+		return nil
+	case passkey.FieldName:
+		m.ResetName()
+		return nil
+	case passkey.FieldAaguid:
+		m.ResetAaguid()
+		return nil
+	case passkey.FieldTransports:
+		m.ResetTransports()
+		return nil
+	case passkey.FieldLastUsedAt:
+		m.ResetLastUsedAt()
+		return nil
+	}
+	return fmt.Errorf("unknown Passkey field %s", name)
+}
+
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *PasskeyMutation) AddedEdges() []string {
+	edges := make([]string, 0, 1)
+	if m.user != nil {
+		edges = append(edges, passkey.EdgeUser)
+	}
+	return edges
+}
+
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *PasskeyMutation) AddedIDs(name string) []ent.Value {
+	switch name {
+	case passkey.EdgeUser:
+		if id := m.user; id != nil {
+			return []ent.Value{*id}
+		}
+	}
+	return nil
+}
+
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *PasskeyMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 1)
+	return edges
+}
+
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *PasskeyMutation) RemovedIDs(name string) []ent.Value {
+	return nil
+}
+
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *PasskeyMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 1)
+	if m.cleareduser {
+		edges = append(edges, passkey.EdgeUser)
+	}
+	return edges
+}
+
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *PasskeyMutation) EdgeCleared(name string) bool {
+	switch name {
+	case passkey.EdgeUser:
+		return m.cleareduser
+	}
+	return false
+}
+
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *PasskeyMutation) ClearEdge(name string) error {
+	switch name {
+	case passkey.EdgeUser:
+		m.ClearUser()
+		return nil
+	}
+	return fmt.Errorf("unknown Passkey unique edge %s", name)
+}
+
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *PasskeyMutation) ResetEdge(name string) error {
+	switch name {
+	case passkey.EdgeUser:
+		m.ResetUser()
+		return nil
+	}
+	return fmt.Errorf("unknown Passkey edge %s", name)
+}
+
+// PersonalAccessTokenMutation represents an operation that mutates the PersonalAccessToken nodes in the graph.
+type PersonalAccessTokenMutation struct {
+	config
+	op            Op
+	typ           string
+	id            *uint
+	created_at    *time.Time
+	updated_at    *time.Time
+	deleted_at    *time.Time
+	name          *string
+	token_hash    *string
+	token_prefix  *string
+	scopes        *[]string
+	appendscopes  []string
+	expires_at    *time.Time
+	two_factor    *bool
+	last_used_at  *time.Time
+	revoked_at    *time.Time
+	clearedFields map[string]struct{}
+	user          *uint
+	cleareduser   bool
+	done          bool
+	oldValue      func(context.Context) (*PersonalAccessToken, error)
+	predicates    []predicate.PersonalAccessToken
+}
+
+var _ ent.Mutation = (*PersonalAccessTokenMutation)(nil)
+
+// personalaccesstokenOption allows management of the mutation configuration using functional options.
+type personalaccesstokenOption func(*PersonalAccessTokenMutation)
+
+// newPersonalAccessTokenMutation creates new mutation for the PersonalAccessToken entity.
+func newPersonalAccessTokenMutation(c config, op Op, opts ...personalaccesstokenOption) *PersonalAccessTokenMutation {
+	m := &PersonalAccessTokenMutation{
+		config:        c,
+		op:            op,
+		typ:           TypePersonalAccessToken,
+		clearedFields: make(map[string]struct{}),
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// withPersonalAccessTokenID sets the ID field of the mutation.
+func withPersonalAccessTokenID(id uint) personalaccesstokenOption {
+	return func(m *PersonalAccessTokenMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *PersonalAccessToken
+		)
+		m.oldValue = func(ctx context.Context) (*PersonalAccessToken, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().PersonalAccessToken.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
+	}
+}
+
+// withPersonalAccessToken sets the old PersonalAccessToken of the mutation.
+func withPersonalAccessToken(node *PersonalAccessToken) personalaccesstokenOption {
+	return func(m *PersonalAccessTokenMutation) {
+		m.oldValue = func(context.Context) (*PersonalAccessToken, error) {
+			return node, nil
+		}
+		m.id = &node.ID
+	}
+}
+
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m PersonalAccessTokenMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
+}
+
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m PersonalAccessTokenMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("ent: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
+}
+
+// SetID sets the value of the id field. Note that this
+// operation is only accepted on creation of PersonalAccessToken entities.
+func (m *PersonalAccessTokenMutation) SetID(id uint) {
+	m.id = &id
+}
+
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *PersonalAccessTokenMutation) ID() (id uint, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
+}
+
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *PersonalAccessTokenMutation) IDs(ctx context.Context) ([]uint, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []uint{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().PersonalAccessToken.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (m *PersonalAccessTokenMutation) SetCreatedAt(t time.Time) {
+	m.created_at = &t
+}
+
+// CreatedAt returns the value of the "created_at" field in the mutation.
+func (m *PersonalAccessTokenMutation) CreatedAt() (r time.Time, exists bool) {
+	v := m.created_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCreatedAt returns the old "created_at" field's value of the PersonalAccessToken entity.
+// If the PersonalAccessToken object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *PersonalAccessTokenMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCreatedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCreatedAt: %w", err)
+	}
+	return oldValue.CreatedAt, nil
+}
+
+// ResetCreatedAt resets all changes to the "created_at" field.
+func (m *PersonalAccessTokenMutation) ResetCreatedAt() {
+	m.created_at = nil
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (m *PersonalAccessTokenMutation) SetUpdatedAt(t time.Time) {
+	m.updated_at = &t
+}
+
+// UpdatedAt returns the value of the "updated_at" field in the mutation.
+func (m *PersonalAccessTokenMutation) UpdatedAt() (r time.Time, exists bool) {
+	v := m.updated_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldUpdatedAt returns the old "updated_at" field's value of the PersonalAccessToken entity.
+// If the PersonalAccessToken object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *PersonalAccessTokenMutation) OldUpdatedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldUpdatedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldUpdatedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldUpdatedAt: %w", err)
+	}
+	return oldValue.UpdatedAt, nil
+}
+
+// ResetUpdatedAt resets all changes to the "updated_at" field.
+func (m *PersonalAccessTokenMutation) ResetUpdatedAt() {
+	m.updated_at = nil
+}
+
+// SetDeletedAt sets the "deleted_at" field.
+func (m *PersonalAccessTokenMutation) SetDeletedAt(t time.Time) {
+	m.deleted_at = &t
+}
+
+// DeletedAt returns the value of the "deleted_at" field in the mutation.
+func (m *PersonalAccessTokenMutation) DeletedAt() (r time.Time, exists bool) {
+	v := m.deleted_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldDeletedAt returns the old "deleted_at" field's value of the PersonalAccessToken entity.
+// If the PersonalAccessToken object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *PersonalAccessTokenMutation) OldDeletedAt(ctx context.Context) (v *time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldDeletedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldDeletedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldDeletedAt: %w", err)
+	}
+	return oldValue.DeletedAt, nil
+}
+
+// ClearDeletedAt clears the value of the "deleted_at" field.
+func (m *PersonalAccessTokenMutation) ClearDeletedAt() {
+	m.deleted_at = nil
+	m.clearedFields[personalaccesstoken.FieldDeletedAt] = struct{}{}
+}
+
+// DeletedAtCleared returns if the "deleted_at" field was cleared in this mutation.
+func (m *PersonalAccessTokenMutation) DeletedAtCleared() bool {
+	_, ok := m.clearedFields[personalaccesstoken.FieldDeletedAt]
+	return ok
+}
+
+// ResetDeletedAt resets all changes to the "deleted_at" field.
+func (m *PersonalAccessTokenMutation) ResetDeletedAt() {
+	m.deleted_at = nil
+	delete(m.clearedFields, personalaccesstoken.FieldDeletedAt)
+}
+
+// SetUserID sets the "user_id" field.
+func (m *PersonalAccessTokenMutation) SetUserID(u uint) {
+	m.user = &u
+}
+
+// UserID returns the value of the "user_id" field in the mutation.
+func (m *PersonalAccessTokenMutation) UserID() (r uint, exists bool) {
+	v := m.user
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldUserID returns the old "user_id" field's value of the PersonalAccessToken entity.
+// If the PersonalAccessToken object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *PersonalAccessTokenMutation) OldUserID(ctx context.Context) (v uint, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldUserID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldUserID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldUserID: %w", err)
+	}
+	return oldValue.UserID, nil
+}
+
+// ResetUserID resets all changes to the "user_id" field.
+func (m *PersonalAccessTokenMutation) ResetUserID() {
+	m.user = nil
+}
+
+// SetName sets the "name" field.
+func (m *PersonalAccessTokenMutation) SetName(s string) {
+	m.name = &s
+}
+
+// Name returns the value of the "name" field in the mutation.
+func (m *PersonalAccessTokenMutation) Name() (r string, exists bool) {
+	v := m.name
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldName returns the old "name" field's value of the PersonalAccessToken entity.
+// If the PersonalAccessToken object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *PersonalAccessTokenMutation) OldName(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldName is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldName requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldName: %w", err)
+	}
+	return oldValue.Name, nil
+}
+
+// ResetName resets all changes to the "name" field.
+func (m *PersonalAccessTokenMutation) ResetName() {
+	m.name = nil
+}
+
+// SetTokenHash sets the "token_hash" field.
+func (m *PersonalAccessTokenMutation) SetTokenHash(s string) {
+	m.token_hash = &s
+}
+
+// TokenHash returns the value of the "token_hash" field in the mutation.
+func (m *PersonalAccessTokenMutation) TokenHash() (r string, exists bool) {
+	v := m.token_hash
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldTokenHash returns the old "token_hash" field's value of the PersonalAccessToken entity.
+// If the PersonalAccessToken object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *PersonalAccessTokenMutation) OldTokenHash(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldTokenHash is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldTokenHash requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldTokenHash: %w", err)
+	}
+	return oldValue.TokenHash, nil
+}
+
+// ResetTokenHash resets all changes to the "token_hash" field.
+func (m *PersonalAccessTokenMutation) ResetTokenHash() {
+	m.token_hash = nil
+}
+
+// SetTokenPrefix sets the "token_prefix" field.
+func (m *PersonalAccessTokenMutation) SetTokenPrefix(s string) {
+	m.token_prefix = &s
+}
+
+// TokenPrefix returns the value of the "token_prefix" field in the mutation.
+func (m *PersonalAccessTokenMutation) TokenPrefix() (r string, exists bool) {
+	v := m.token_prefix
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldTokenPrefix returns the old "token_prefix" field's value of the PersonalAccessToken entity.
+// If the PersonalAccessToken object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *PersonalAccessTokenMutation) OldTokenPrefix(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldTokenPrefix is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldTokenPrefix requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldTokenPrefix: %w", err)
+	}
+	return oldValue.TokenPrefix, nil
+}
+
+// ResetTokenPrefix resets all changes to the "token_prefix" field.
+func (m *PersonalAccessTokenMutation) ResetTokenPrefix() {
+	m.token_prefix = nil
+}
+
+// SetScopes sets the "scopes" field.
+func (m *PersonalAccessTokenMutation) SetScopes(s []string) {
+	m.scopes = &s
+	m.appendscopes = nil
+}
+
+// Scopes returns the value of the "scopes" field in the mutation.
+func (m *PersonalAccessTokenMutation) Scopes() (r []string, exists bool) {
+	v := m.scopes
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldScopes returns the old "scopes" field's value of the PersonalAccessToken entity.
+// If the PersonalAccessToken object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *PersonalAccessTokenMutation) OldScopes(ctx context.Context) (v []string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldScopes is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldScopes requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldScopes: %w", err)
+	}
+	return oldValue.Scopes, nil
+}
+
+// AppendScopes adds s to the "scopes" field.
+func (m *PersonalAccessTokenMutation) AppendScopes(s []string) {
+	m.appendscopes = append(m.appendscopes, s...)
+}
+
+// AppendedScopes returns the list of values that were appended to the "scopes" field in this mutation.
+func (m *PersonalAccessTokenMutation) AppendedScopes() ([]string, bool) {
+	if len(m.appendscopes) == 0 {
+		return nil, false
+	}
+	return m.appendscopes, true
+}
+
+// ResetScopes resets all changes to the "scopes" field.
+func (m *PersonalAccessTokenMutation) ResetScopes() {
+	m.scopes = nil
+	m.appendscopes = nil
+}
+
+// SetExpiresAt sets the "expires_at" field.
+func (m *PersonalAccessTokenMutation) SetExpiresAt(t time.Time) {
+	m.expires_at = &t
+}
+
+// ExpiresAt returns the value of the "expires_at" field in the mutation.
+func (m *PersonalAccessTokenMutation) ExpiresAt() (r time.Time, exists bool) {
+	v := m.expires_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldExpiresAt returns the old "expires_at" field's value of the PersonalAccessToken entity.
+// If the PersonalAccessToken object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *PersonalAccessTokenMutation) OldExpiresAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldExpiresAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldExpiresAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldExpiresAt: %w", err)
+	}
+	return oldValue.ExpiresAt, nil
+}
+
+// ResetExpiresAt resets all changes to the "expires_at" field.
+func (m *PersonalAccessTokenMutation) ResetExpiresAt() {
+	m.expires_at = nil
+}
+
+// SetTwoFactor sets the "two_factor" field.
+func (m *PersonalAccessTokenMutation) SetTwoFactor(b bool) {
+	m.two_factor = &b
+}
+
+// TwoFactor returns the value of the "two_factor" field in the mutation.
+func (m *PersonalAccessTokenMutation) TwoFactor() (r bool, exists bool) {
+	v := m.two_factor
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldTwoFactor returns the old "two_factor" field's value of the PersonalAccessToken entity.
+// If the PersonalAccessToken object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *PersonalAccessTokenMutation) OldTwoFactor(ctx context.Context) (v bool, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldTwoFactor is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldTwoFactor requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldTwoFactor: %w", err)
+	}
+	return oldValue.TwoFactor, nil
+}
+
+// ResetTwoFactor resets all changes to the "two_factor" field.
+func (m *PersonalAccessTokenMutation) ResetTwoFactor() {
+	m.two_factor = nil
+}
+
+// SetLastUsedAt sets the "last_used_at" field.
+func (m *PersonalAccessTokenMutation) SetLastUsedAt(t time.Time) {
+	m.last_used_at = &t
+}
+
+// LastUsedAt returns the value of the "last_used_at" field in the mutation.
+func (m *PersonalAccessTokenMutation) LastUsedAt() (r time.Time, exists bool) {
+	v := m.last_used_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldLastUsedAt returns the old "last_used_at" field's value of the PersonalAccessToken entity.
+// If the PersonalAccessToken object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *PersonalAccessTokenMutation) OldLastUsedAt(ctx context.Context) (v *time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldLastUsedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldLastUsedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldLastUsedAt: %w", err)
+	}
+	return oldValue.LastUsedAt, nil
+}
+
+// ClearLastUsedAt clears the value of the "last_used_at" field.
+func (m *PersonalAccessTokenMutation) ClearLastUsedAt() {
+	m.last_used_at = nil
+	m.clearedFields[personalaccesstoken.FieldLastUsedAt] = struct{}{}
+}
+
+// LastUsedAtCleared returns if the "last_used_at" field was cleared in this mutation.
+func (m *PersonalAccessTokenMutation) LastUsedAtCleared() bool {
+	_, ok := m.clearedFields[personalaccesstoken.FieldLastUsedAt]
+	return ok
+}
+
+// ResetLastUsedAt resets all changes to the "last_used_at" field.
+func (m *PersonalAccessTokenMutation) ResetLastUsedAt() {
+	m.last_used_at = nil
+	delete(m.clearedFields, personalaccesstoken.FieldLastUsedAt)
+}
+
+// SetRevokedAt sets the "revoked_at" field.
+func (m *PersonalAccessTokenMutation) SetRevokedAt(t time.Time) {
+	m.revoked_at = &t
+}
+
+// RevokedAt returns the value of the "revoked_at" field in the mutation.
+func (m *PersonalAccessTokenMutation) RevokedAt() (r time.Time, exists bool) {
+	v := m.revoked_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldRevokedAt returns the old "revoked_at" field's value of the PersonalAccessToken entity.
+// If the PersonalAccessToken object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *PersonalAccessTokenMutation) OldRevokedAt(ctx context.Context) (v *time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldRevokedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldRevokedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldRevokedAt: %w", err)
+	}
+	return oldValue.RevokedAt, nil
+}
+
+// ClearRevokedAt clears the value of the "revoked_at" field.
+func (m *PersonalAccessTokenMutation) ClearRevokedAt() {
+	m.revoked_at = nil
+	m.clearedFields[personalaccesstoken.FieldRevokedAt] = struct{}{}
+}
+
+// RevokedAtCleared returns if the "revoked_at" field was cleared in this mutation.
+func (m *PersonalAccessTokenMutation) RevokedAtCleared() bool {
+	_, ok := m.clearedFields[personalaccesstoken.FieldRevokedAt]
+	return ok
+}
+
+// ResetRevokedAt resets all changes to the "revoked_at" field.
+func (m *PersonalAccessTokenMutation) ResetRevokedAt() {
+	m.revoked_at = nil
+	delete(m.clearedFields, personalaccesstoken.FieldRevokedAt)
+}
+
+// ClearUser clears the "user" edge to the User entity.
+func (m *PersonalAccessTokenMutation) ClearUser() {
+	m.cleareduser = true
+	m.clearedFields[personalaccesstoken.FieldUserID] = struct{}{}
+}
+
+// UserCleared reports if the "user" edge to the User entity was cleared.
+func (m *PersonalAccessTokenMutation) UserCleared() bool {
+	return m.cleareduser
+}
+
+// UserIDs returns the "user" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// UserID instead. It exists only for internal usage by the builders.
+func (m *PersonalAccessTokenMutation) UserIDs() (ids []uint) {
+	if id := m.user; id != nil {
+		ids = append(ids, *id)
+	}
+	return
+}
+
+// ResetUser resets all changes to the "user" edge.
+func (m *PersonalAccessTokenMutation) ResetUser() {
+	m.user = nil
+	m.cleareduser = false
+}
+
+// Where appends a list predicates to the PersonalAccessTokenMutation builder.
+func (m *PersonalAccessTokenMutation) Where(ps ...predicate.PersonalAccessToken) {
+	m.predicates = append(m.predicates, ps...)
+}
+
+// WhereP appends storage-level predicates to the PersonalAccessTokenMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *PersonalAccessTokenMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.PersonalAccessToken, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
+	}
+	m.Where(p...)
+}
+
+// Op returns the operation name.
+func (m *PersonalAccessTokenMutation) Op() Op {
+	return m.op
+}
+
+// SetOp allows setting the mutation operation.
+func (m *PersonalAccessTokenMutation) SetOp(op Op) {
+	m.op = op
+}
+
+// Type returns the node type of this mutation (PersonalAccessToken).
+func (m *PersonalAccessTokenMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *PersonalAccessTokenMutation) Fields() []string {
+	fields := make([]string, 0, 12)
+	if m.created_at != nil {
+		fields = append(fields, personalaccesstoken.FieldCreatedAt)
+	}
+	if m.updated_at != nil {
+		fields = append(fields, personalaccesstoken.FieldUpdatedAt)
+	}
+	if m.deleted_at != nil {
+		fields = append(fields, personalaccesstoken.FieldDeletedAt)
+	}
+	if m.user != nil {
+		fields = append(fields, personalaccesstoken.FieldUserID)
+	}
+	if m.name != nil {
+		fields = append(fields, personalaccesstoken.FieldName)
+	}
+	if m.token_hash != nil {
+		fields = append(fields, personalaccesstoken.FieldTokenHash)
+	}
+	if m.token_prefix != nil {
+		fields = append(fields, personalaccesstoken.FieldTokenPrefix)
+	}
+	if m.scopes != nil {
+		fields = append(fields, personalaccesstoken.FieldScopes)
+	}
+	if m.expires_at != nil {
+		fields = append(fields, personalaccesstoken.FieldExpiresAt)
+	}
+	if m.two_factor != nil {
+		fields = append(fields, personalaccesstoken.FieldTwoFactor)
+	}
+	if m.last_used_at != nil {
+		fields = append(fields, personalaccesstoken.FieldLastUsedAt)
+	}
+	if m.revoked_at != nil {
+		fields = append(fields, personalaccesstoken.FieldRevokedAt)
+	}
+	return fields
+}
+
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *PersonalAccessTokenMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case personalaccesstoken.FieldCreatedAt:
+		return m.CreatedAt()
+	case personalaccesstoken.FieldUpdatedAt:
+		return m.UpdatedAt()
+	case personalaccesstoken.FieldDeletedAt:
+		return m.DeletedAt()
+	case personalaccesstoken.FieldUserID:
+		return m.UserID()
+	case personalaccesstoken.FieldName:
+		return m.Name()
+	case personalaccesstoken.FieldTokenHash:
+		return m.TokenHash()
+	case personalaccesstoken.FieldTokenPrefix:
+		return m.TokenPrefix()
+	case personalaccesstoken.FieldScopes:
+		return m.Scopes()
+	case personalaccesstoken.FieldExpiresAt:
+		return m.ExpiresAt()
+	case personalaccesstoken.FieldTwoFactor:
+		return m.TwoFactor()
+	case personalaccesstoken.FieldLastUsedAt:
+		return m.LastUsedAt()
+	case personalaccesstoken.FieldRevokedAt:
+		return m.RevokedAt()
+	}
+	return nil, false
+}
+
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *PersonalAccessTokenMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case personalaccesstoken.FieldCreatedAt:
+		return m.OldCreatedAt(ctx)
+	case personalaccesstoken.FieldUpdatedAt:
+		return m.OldUpdatedAt(ctx)
+	case personalaccesstoken.FieldDeletedAt:
+		return m.OldDeletedAt(ctx)
+	case personalaccesstoken.FieldUserID:
+		return m.OldUserID(ctx)
+	case personalaccesstoken.FieldName:
+		return m.OldName(ctx)
+	case personalaccesstoken.FieldTokenHash:
+		return m.OldTokenHash(ctx)
+	case personalaccesstoken.FieldTokenPrefix:
+		return m.OldTokenPrefix(ctx)
+	case personalaccesstoken.FieldScopes:
+		return m.OldScopes(ctx)
+	case personalaccesstoken.FieldExpiresAt:
+		return m.OldExpiresAt(ctx)
+	case personalaccesstoken.FieldTwoFactor:
+		return m.OldTwoFactor(ctx)
+	case personalaccesstoken.FieldLastUsedAt:
+		return m.OldLastUsedAt(ctx)
+	case personalaccesstoken.FieldRevokedAt:
+		return m.OldRevokedAt(ctx)
+	}
+	return nil, fmt.Errorf("unknown PersonalAccessToken field %s", name)
+}
+
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *PersonalAccessTokenMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case personalaccesstoken.FieldCreatedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCreatedAt(v)
+		return nil
+	case personalaccesstoken.FieldUpdatedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetUpdatedAt(v)
+		return nil
+	case personalaccesstoken.FieldDeletedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetDeletedAt(v)
+		return nil
+	case personalaccesstoken.FieldUserID:
+		v, ok := value.(uint)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetUserID(v)
+		return nil
+	case personalaccesstoken.FieldName:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetName(v)
+		return nil
+	case personalaccesstoken.FieldTokenHash:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetTokenHash(v)
+		return nil
+	case personalaccesstoken.FieldTokenPrefix:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetTokenPrefix(v)
+		return nil
+	case personalaccesstoken.FieldScopes:
+		v, ok := value.([]string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetScopes(v)
+		return nil
+	case personalaccesstoken.FieldExpiresAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetExpiresAt(v)
+		return nil
+	case personalaccesstoken.FieldTwoFactor:
+		v, ok := value.(bool)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetTwoFactor(v)
+		return nil
+	case personalaccesstoken.FieldLastUsedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetLastUsedAt(v)
+		return nil
+	case personalaccesstoken.FieldRevokedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetRevokedAt(v)
+		return nil
+	}
+	return fmt.Errorf("unknown PersonalAccessToken field %s", name)
+}
+
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *PersonalAccessTokenMutation) AddedFields() []string {
+	var fields []string
+	return fields
+}
+
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *PersonalAccessTokenMutation) AddedField(name string) (ent.Value, bool) {
+	switch name {
+	}
+	return nil, false
+}
+
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *PersonalAccessTokenMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	}
+	return fmt.Errorf("unknown PersonalAccessToken numeric field %s", name)
+}
+
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *PersonalAccessTokenMutation) ClearedFields() []string {
+	var fields []string
+	if m.FieldCleared(personalaccesstoken.FieldDeletedAt) {
+		fields = append(fields, personalaccesstoken.FieldDeletedAt)
+	}
+	if m.FieldCleared(personalaccesstoken.FieldLastUsedAt) {
+		fields = append(fields, personalaccesstoken.FieldLastUsedAt)
+	}
+	if m.FieldCleared(personalaccesstoken.FieldRevokedAt) {
+		fields = append(fields, personalaccesstoken.FieldRevokedAt)
+	}
+	return fields
+}
+
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *PersonalAccessTokenMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
+}
+
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *PersonalAccessTokenMutation) ClearField(name string) error {
+	switch name {
+	case personalaccesstoken.FieldDeletedAt:
+		m.ClearDeletedAt()
+		return nil
+	case personalaccesstoken.FieldLastUsedAt:
+		m.ClearLastUsedAt()
+		return nil
+	case personalaccesstoken.FieldRevokedAt:
+		m.ClearRevokedAt()
+		return nil
+	}
+	return fmt.Errorf("unknown PersonalAccessToken nullable field %s", name)
+}
+
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *PersonalAccessTokenMutation) ResetField(name string) error {
+	switch name {
+	case personalaccesstoken.FieldCreatedAt:
+		m.ResetCreatedAt()
+		return nil
+	case personalaccesstoken.FieldUpdatedAt:
+		m.ResetUpdatedAt()
+		return nil
+	case personalaccesstoken.FieldDeletedAt:
+		m.ResetDeletedAt()
+		return nil
+	case personalaccesstoken.FieldUserID:
+		m.ResetUserID()
+		return nil
+	case personalaccesstoken.FieldName:
+		m.ResetName()
+		return nil
+	case personalaccesstoken.FieldTokenHash:
+		m.ResetTokenHash()
+		return nil
+	case personalaccesstoken.FieldTokenPrefix:
+		m.ResetTokenPrefix()
+		return nil
+	case personalaccesstoken.FieldScopes:
+		m.ResetScopes()
+		return nil
+	case personalaccesstoken.FieldExpiresAt:
+		m.ResetExpiresAt()
+		return nil
+	case personalaccesstoken.FieldTwoFactor:
+		m.ResetTwoFactor()
+		return nil
+	case personalaccesstoken.FieldLastUsedAt:
+		m.ResetLastUsedAt()
+		return nil
+	case personalaccesstoken.FieldRevokedAt:
+		m.ResetRevokedAt()
+		return nil
+	}
+	return fmt.Errorf("unknown PersonalAccessToken field %s", name)
+}
+
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *PersonalAccessTokenMutation) AddedEdges() []string {
+	edges := make([]string, 0, 1)
+	if m.user != nil {
+		edges = append(edges, personalaccesstoken.EdgeUser)
+	}
+	return edges
+}
+
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *PersonalAccessTokenMutation) AddedIDs(name string) []ent.Value {
+	switch name {
+	case personalaccesstoken.EdgeUser:
+		if id := m.user; id != nil {
+			return []ent.Value{*id}
+		}
+	}
+	return nil
+}
+
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *PersonalAccessTokenMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 1)
+	return edges
+}
+
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *PersonalAccessTokenMutation) RemovedIDs(name string) []ent.Value {
+	return nil
+}
+
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *PersonalAccessTokenMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 1)
+	if m.cleareduser {
+		edges = append(edges, personalaccesstoken.EdgeUser)
+	}
+	return edges
+}
+
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *PersonalAccessTokenMutation) EdgeCleared(name string) bool {
+	switch name {
+	case personalaccesstoken.EdgeUser:
+		return m.cleareduser
+	}
+	return false
+}
+
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *PersonalAccessTokenMutation) ClearEdge(name string) error {
+	switch name {
+	case personalaccesstoken.EdgeUser:
+		m.ClearUser()
+		return nil
+	}
+	return fmt.Errorf("unknown PersonalAccessToken unique edge %s", name)
+}
+
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *PersonalAccessTokenMutation) ResetEdge(name string) error {
+	switch name {
+	case personalaccesstoken.EdgeUser:
+		m.ResetUser()
+		return nil
+	}
+	return fmt.Errorf("unknown PersonalAccessToken edge %s", name)
+}
+
+// PostMutation represents an operation that mutates the Post nodes in the graph.
+type PostMutation struct {
+	config
+	op                   Op
+	typ                  string
+	id                   *uint
+	created_at           *time.Time
+	updated_at           *time.Time
+	deleted_at           *time.Time
+	title                *string
+	slug                 *string
+	summary              *string
+	content              *string
+	cover                *string
+	read_time_minutes    *uint
+	addread_time_minutes *int
+	view_count           *uint
+	addview_count        *int
+	status               *entity.PostStatus
+	published_at         *time.Time
+	search_vector        *string
+	clearedFields        map[string]struct{}
+	author               *uint
+	clearedauthor        bool
+	categories           map[uint]struct{}
+	removedcategories    map[uint]struct{}
+	clearedcategories    bool
+	tags                 map[uint]struct{}
+	removedtags          map[uint]struct{}
+	clearedtags          bool
+	comments             map[uint]struct{}
+	removedcomments      map[uint]struct{}
+	clearedcomments      bool
+	slug_history         map[uint]struct{}
+	removedslug_history  map[uint]struct{}
+	clearedslug_history  bool
+	revisions            map[uint]struct{}
+	removedrevisions     map[uint]struct{}
+	clearedrevisions     bool
+	done                 bool
+	oldValue             func(context.Context) (*Post, error)
+	predicates           []predicate.Post
+}
+
+var _ ent.Mutation = (*PostMutation)(nil)
+
+// postOption allows management of the mutation configuration using functional options.
+type postOption func(*PostMutation)
+
+// newPostMutation creates new mutation for the Post entity.
+func newPostMutation(c config, op Op, opts ...postOption) *PostMutation {
+	m := &PostMutation{
+		config:        c,
+		op:            op,
+		typ:           TypePost,
+		clearedFields: make(map[string]struct{}),
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// withPostID sets the ID field of the mutation.
+func withPostID(id uint) postOption {
+	return func(m *PostMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *Post
+		)
+		m.oldValue = func(ctx context.Context) (*Post, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().Post.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
+	}
+}
+
+// withPost sets the old Post of the mutation.
+func withPost(node *Post) postOption {
+	return func(m *PostMutation) {
+		m.oldValue = func(context.Context) (*Post, error) {
+			return node, nil
+		}
+		m.id = &node.ID
+	}
+}
+
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m PostMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
+}
+
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m PostMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("ent: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
+}
+
+// SetID sets the value of the id field. Note that this
+// operation is only accepted on creation of Post entities.
+func (m *PostMutation) SetID(id uint) {
+	m.id = &id
+}
+
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *PostMutation) ID() (id uint, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
+}
+
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *PostMutation) IDs(ctx context.Context) ([]uint, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []uint{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().Post.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (m *PostMutation) SetCreatedAt(t time.Time) {
+	m.created_at = &t
+}
+
+// CreatedAt returns the value of the "created_at" field in the mutation.
+func (m *PostMutation) CreatedAt() (r time.Time, exists bool) {
+	v := m.created_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCreatedAt returns the old "created_at" field's value of the Post entity.
+// If the Post object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *PostMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCreatedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCreatedAt: %w", err)
+	}
+	return oldValue.CreatedAt, nil
+}
+
+// ResetCreatedAt resets all changes to the "created_at" field.
+func (m *PostMutation) ResetCreatedAt() {
+	m.created_at = nil
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (m *PostMutation) SetUpdatedAt(t time.Time) {
+	m.updated_at = &t
+}
+
+// UpdatedAt returns the value of the "updated_at" field in the mutation.
+func (m *PostMutation) UpdatedAt() (r time.Time, exists bool) {
+	v := m.updated_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldUpdatedAt returns the old "updated_at" field's value of the Post entity.
+// If the Post object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *PostMutation) OldUpdatedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldUpdatedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldUpdatedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldUpdatedAt: %w", err)
+	}
+	return oldValue.UpdatedAt, nil
+}
+
+// ResetUpdatedAt resets all changes to the "updated_at" field.
+func (m *PostMutation) ResetUpdatedAt() {
+	m.updated_at = nil
+}
+
+// SetDeletedAt sets the "deleted_at" field.
+func (m *PostMutation) SetDeletedAt(t time.Time) {
+	m.deleted_at = &t
+}
+
+// DeletedAt returns the value of the "deleted_at" field in the mutation.
+func (m *PostMutation) DeletedAt() (r time.Time, exists bool) {
+	v := m.deleted_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldDeletedAt returns the old "deleted_at" field's value of the Post entity.
+// If the Post object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *PostMutation) OldDeletedAt(ctx context.Context) (v *time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldDeletedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldDeletedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldDeletedAt: %w", err)
+	}
+	return oldValue.DeletedAt, nil
+}
+
+// ClearDeletedAt clears the value of the "deleted_at" field.
+func (m *PostMutation) ClearDeletedAt() {
+	m.deleted_at = nil
+	m.clearedFields[post.FieldDeletedAt] = struct{}{}
+}
+
+// DeletedAtCleared returns if the "deleted_at" field was cleared in this mutation.
+func (m *PostMutation) DeletedAtCleared() bool {
+	_, ok := m.clearedFields[post.FieldDeletedAt]
+	return ok
+}
+
+// ResetDeletedAt resets all changes to the "deleted_at" field.
+func (m *PostMutation) ResetDeletedAt() {
+	m.deleted_at = nil
+	delete(m.clearedFields, post.FieldDeletedAt)
+}
+
+// SetUserID sets the "user_id" field.
+func (m *PostMutation) SetUserID(u uint) {
+	m.author = &u
+}
+
+// UserID returns the value of the "user_id" field in the mutation.
+func (m *PostMutation) UserID() (r uint, exists bool) {
+	v := m.author
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldUserID returns the old "user_id" field's value of the Post entity.
+// If the Post object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *PostMutation) OldUserID(ctx context.Context) (v uint, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldUserID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldUserID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldUserID: %w", err)
+	}
+	return oldValue.UserID, nil
+}
+
+// ResetUserID resets all changes to the "user_id" field.
+func (m *PostMutation) ResetUserID() {
+	m.author = nil
+}
+
+// SetTitle sets the "title" field.
+func (m *PostMutation) SetTitle(s string) {
+	m.title = &s
+}
+
+// Title returns the value of the "title" field in the mutation.
+func (m *PostMutation) Title() (r string, exists bool) {
+	v := m.title
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldTitle returns the old "title" field's value of the Post entity.
+// If the Post object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *PostMutation) OldTitle(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldTitle is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldTitle requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldTitle: %w", err)
+	}
+	return oldValue.Title, nil
+}
+
+// ResetTitle resets all changes to the "title" field.
+func (m *PostMutation) ResetTitle() {
+	m.title = nil
+}
+
+// SetSlug sets the "slug" field.
+func (m *PostMutation) SetSlug(s string) {
+	m.slug = &s
+}
+
+// Slug returns the value of the "slug" field in the mutation.
+func (m *PostMutation) Slug() (r string, exists bool) {
+	v := m.slug
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldSlug returns the old "slug" field's value of the Post entity.
+// If the Post object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *PostMutation) OldSlug(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldSlug is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldSlug requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldSlug: %w", err)
+	}
+	return oldValue.Slug, nil
+}
+
+// ClearSlug clears the value of the "slug" field.
+func (m *PostMutation) ClearSlug() {
+	m.slug = nil
+	m.clearedFields[post.FieldSlug] = struct{}{}
+}
+
+// SlugCleared returns if the "slug" field was cleared in this mutation.
+func (m *PostMutation) SlugCleared() bool {
+	_, ok := m.clearedFields[post.FieldSlug]
+	return ok
+}
+
+// ResetSlug resets all changes to the "slug" field.
+func (m *PostMutation) ResetSlug() {
+	m.slug = nil
+	delete(m.clearedFields, post.FieldSlug)
+}
+
+// SetSummary sets the "summary" field.
+func (m *PostMutation) SetSummary(s string) {
+	m.summary = &s
+}
+
+// Summary returns the value of the "summary" field in the mutation.
+func (m *PostMutation) Summary() (r string, exists bool) {
+	v := m.summary
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldSummary returns the old "summary" field's value of the Post entity.
+// If the Post object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *PostMutation) OldSummary(ctx context.Context) (v *string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldSummary is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldSummary requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldSummary: %w", err)
+	}
+	return oldValue.Summary, nil
+}
+
+// ClearSummary clears the value of the "summary" field.
+func (m *PostMutation) ClearSummary() {
+	m.summary = nil
+	m.clearedFields[post.FieldSummary] = struct{}{}
+}
+
+// SummaryCleared returns if the "summary" field was cleared in this mutation.
+func (m *PostMutation) SummaryCleared() bool {
+	_, ok := m.clearedFields[post.FieldSummary]
+	return ok
+}
+
+// ResetSummary resets all changes to the "summary" field.
+func (m *PostMutation) ResetSummary() {
+	m.summary = nil
+	delete(m.clearedFields, post.FieldSummary)
+}
+
+// SetContent sets the "content" field.
+func (m *PostMutation) SetContent(s string) {
+	m.content = &s
+}
+
+// Content returns the value of the "content" field in the mutation.
+func (m *PostMutation) Content() (r string, exists bool) {
+	v := m.content
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldContent returns the old "content" field's value of the Post entity.
+// If the Post object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *PostMutation) OldContent(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldContent is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldContent requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldContent: %w", err)
+	}
+	return oldValue.Content, nil
+}
+
+// ResetContent resets all changes to the "content" field.
+func (m *PostMutation) ResetContent() {
+	m.content = nil
+}
+
+// SetCover sets the "cover" field.
+func (m *PostMutation) SetCover(s string) {
+	m.cover = &s
+}
+
+// Cover returns the value of the "cover" field in the mutation.
+func (m *PostMutation) Cover() (r string, exists bool) {
+	v := m.cover
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCover returns the old "cover" field's value of the Post entity.
+// If the Post object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *PostMutation) OldCover(ctx context.Context) (v *string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCover is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCover requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCover: %w", err)
+	}
+	return oldValue.Cover, nil
+}
+
+// ClearCover clears the value of the "cover" field.
+func (m *PostMutation) ClearCover() {
+	m.cover = nil
+	m.clearedFields[post.FieldCover] = struct{}{}
+}
+
+// CoverCleared returns if the "cover" field was cleared in this mutation.
+func (m *PostMutation) CoverCleared() bool {
+	_, ok := m.clearedFields[post.FieldCover]
+	return ok
+}
+
+// ResetCover resets all changes to the "cover" field.
+func (m *PostMutation) ResetCover() {
+	m.cover = nil
+	delete(m.clearedFields, post.FieldCover)
+}
+
+// SetReadTimeMinutes sets the "read_time_minutes" field.
+func (m *PostMutation) SetReadTimeMinutes(u uint) {
+	m.read_time_minutes = &u
+	m.addread_time_minutes = nil
+}
+
+// ReadTimeMinutes returns the value of the "read_time_minutes" field in the mutation.
+func (m *PostMutation) ReadTimeMinutes() (r uint, exists bool) {
+	v := m.read_time_minutes
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldReadTimeMinutes returns the old "read_time_minutes" field's value of the Post entity.
+// If the Post object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *PostMutation) OldReadTimeMinutes(ctx context.Context) (v uint, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldReadTimeMinutes is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldReadTimeMinutes requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldReadTimeMinutes: %w", err)
+	}
+	return oldValue.ReadTimeMinutes, nil
+}
+
+// AddReadTimeMinutes adds u to the "read_time_minutes" field.
+func (m *PostMutation) AddReadTimeMinutes(u int) {
+	if m.addread_time_minutes != nil {
+		*m.addread_time_minutes += u
+	} else {
+		m.addread_time_minutes = &u
+	}
+}
+
+// AddedReadTimeMinutes returns the value that was added to the "read_time_minutes" field in this mutation.
+func (m *PostMutation) AddedReadTimeMinutes() (r int, exists bool) {
+	v := m.addread_time_minutes
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetReadTimeMinutes resets all changes to the "read_time_minutes" field.
+func (m *PostMutation) ResetReadTimeMinutes() {
+	m.read_time_minutes = nil
+	m.addread_time_minutes = nil
+}
+
+// SetViewCount sets the "view_count" field.
+func (m *PostMutation) SetViewCount(u uint) {
+	m.view_count = &u
+	m.addview_count = nil
+}
+
+// ViewCount returns the value of the "view_count" field in the mutation.
+func (m *PostMutation) ViewCount() (r uint, exists bool) {
+	v := m.view_count
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldViewCount returns the old "view_count" field's value of the Post entity.
+// If the Post object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *PostMutation) OldViewCount(ctx context.Context) (v uint, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldViewCount is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldViewCount requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldViewCount: %w", err)
+	}
+	return oldValue.ViewCount, nil
+}
+
+// AddViewCount adds u to the "view_count" field.
+func (m *PostMutation) AddViewCount(u int) {
+	if m.addview_count != nil {
+		*m.addview_count += u
+	} else {
+		m.addview_count = &u
+	}
+}
+
+// AddedViewCount returns the value that was added to the "view_count" field in this mutation.
+func (m *PostMutation) AddedViewCount() (r int, exists bool) {
+	v := m.addview_count
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetViewCount resets all changes to the "view_count" field.
+func (m *PostMutation) ResetViewCount() {
+	m.view_count = nil
+	m.addview_count = nil
+}
+
+// SetStatus sets the "status" field.
+func (m *PostMutation) SetStatus(es entity.PostStatus) {
+	m.status = &es
+}
+
+// Status returns the value of the "status" field in the mutation.
+func (m *PostMutation) Status() (r entity.PostStatus, exists bool) {
+	v := m.status
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldStatus returns the old "status" field's value of the Post entity.
+// If the Post object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *PostMutation) OldStatus(ctx context.Context) (v entity.PostStatus, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldStatus is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldStatus requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldStatus: %w", err)
+	}
+	return oldValue.Status, nil
+}
+
+// ResetStatus resets all changes to the "status" field.
+func (m *PostMutation) ResetStatus() {
+	m.status = nil
+}
+
+// SetPublishedAt sets the "published_at" field.
+func (m *PostMutation) SetPublishedAt(t time.Time) {
+	m.published_at = &t
+}
+
+// PublishedAt returns the value of the "published_at" field in the mutation.
+func (m *PostMutation) PublishedAt() (r time.Time, exists bool) {
+	v := m.published_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldPublishedAt returns the old "published_at" field's value of the Post entity.
+// If the Post object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *PostMutation) OldPublishedAt(ctx context.Context) (v *time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldPublishedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldPublishedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldPublishedAt: %w", err)
+	}
+	return oldValue.PublishedAt, nil
+}
+
+// ClearPublishedAt clears the value of the "published_at" field.
+func (m *PostMutation) ClearPublishedAt() {
+	m.published_at = nil
+	m.clearedFields[post.FieldPublishedAt] = struct{}{}
+}
+
+// PublishedAtCleared returns if the "published_at" field was cleared in this mutation.
+func (m *PostMutation) PublishedAtCleared() bool {
+	_, ok := m.clearedFields[post.FieldPublishedAt]
+	return ok
+}
+
+// ResetPublishedAt resets all changes to the "published_at" field.
+func (m *PostMutation) ResetPublishedAt() {
+	m.published_at = nil
+	delete(m.clearedFields, post.FieldPublishedAt)
+}
+
+// SetSearchVector sets the "search_vector" field.
+func (m *PostMutation) SetSearchVector(s string) {
+	m.search_vector = &s
+}
+
+// SearchVector returns the value of the "search_vector" field in the mutation.
+func (m *PostMutation) SearchVector() (r string, exists bool) {
+	v := m.search_vector
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldSearchVector returns the old "search_vector" field's value of the Post entity.
+// If the Post object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *PostMutation) OldSearchVector(ctx context.Context) (v *string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldSearchVector is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldSearchVector requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldSearchVector: %w", err)
+	}
+	return oldValue.SearchVector, nil
+}
+
+// ClearSearchVector clears the value of the "search_vector" field.
+func (m *PostMutation) ClearSearchVector() {
+	m.search_vector = nil
+	m.clearedFields[post.FieldSearchVector] = struct{}{}
+}
+
+// SearchVectorCleared returns if the "search_vector" field was cleared in this mutation.
+func (m *PostMutation) SearchVectorCleared() bool {
+	_, ok := m.clearedFields[post.FieldSearchVector]
+	return ok
+}
+
+// ResetSearchVector resets all changes to the "search_vector" field.
+func (m *PostMutation) ResetSearchVector() {
+	m.search_vector = nil
+	delete(m.clearedFields, post.FieldSearchVector)
+}
+
+// SetAuthorID sets the "author" edge to the User entity by id.
+func (m *PostMutation) SetAuthorID(id uint) {
+	m.author = &id
+}
+
+// ClearAuthor clears the "author" edge to the User entity.
+func (m *PostMutation) ClearAuthor() {
+	m.clearedauthor = true
+	m.clearedFields[post.FieldUserID] = struct{}{}
+}
+
+// AuthorCleared reports if the "author" edge to the User entity was cleared.
+func (m *PostMutation) AuthorCleared() bool {
+	return m.clearedauthor
+}
+
+// AuthorID returns the "author" edge ID in the mutation.
+func (m *PostMutation) AuthorID() (id uint, exists bool) {
+	if m.author != nil {
+		return *m.author, true
+	}
+	return
+}
+
+// AuthorIDs returns the "author" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// AuthorID instead. It exists only for internal usage by the builders.
+func (m *PostMutation) AuthorIDs() (ids []uint) {
+	if id := m.author; id != nil {
+		ids = append(ids, *id)
+	}
+	return
+}
+
+// ResetAuthor resets all changes to the "author" edge.
+func (m *PostMutation) ResetAuthor() {
+	m.author = nil
+	m.clearedauthor = false
+}
+
+// AddCategoryIDs adds the "categories" edge to the PostCategory entity by ids.
+func (m *PostMutation) AddCategoryIDs(ids ...uint) {
+	if m.categories == nil {
+		m.categories = make(map[uint]struct{})
+	}
+	for i := range ids {
+		m.categories[ids[i]] = struct{}{}
+	}
+}
+
+// ClearCategories clears the "categories" edge to the PostCategory entity.
+func (m *PostMutation) ClearCategories() {
+	m.clearedcategories = true
+}
+
+// CategoriesCleared reports if the "categories" edge to the PostCategory entity was cleared.
+func (m *PostMutation) CategoriesCleared() bool {
+	return m.clearedcategories
+}
+
+// RemoveCategoryIDs removes the "categories" edge to the PostCategory entity by IDs.
+func (m *PostMutation) RemoveCategoryIDs(ids ...uint) {
+	if m.removedcategories == nil {
+		m.removedcategories = make(map[uint]struct{})
+	}
+	for i := range ids {
+		delete(m.categories, ids[i])
+		m.removedcategories[ids[i]] = struct{}{}
+	}
+}
+
+// RemovedCategories returns the removed IDs of the "categories" edge to the PostCategory entity.
+func (m *PostMutation) RemovedCategoriesIDs() (ids []uint) {
+	for id := range m.removedcategories {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// CategoriesIDs returns the "categories" edge IDs in the mutation.
+func (m *PostMutation) CategoriesIDs() (ids []uint) {
+	for id := range m.categories {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ResetCategories resets all changes to the "categories" edge.
+func (m *PostMutation) ResetCategories() {
+	m.categories = nil
+	m.clearedcategories = false
+	m.removedcategories = nil
+}
+
+// AddTagIDs adds the "tags" edge to the PostTag entity by ids.
+func (m *PostMutation) AddTagIDs(ids ...uint) {
+	if m.tags == nil {
+		m.tags = make(map[uint]struct{})
+	}
+	for i := range ids {
+		m.tags[ids[i]] = struct{}{}
+	}
+}
+
+// ClearTags clears the "tags" edge to the PostTag entity.
+func (m *PostMutation) ClearTags() {
+	m.clearedtags = true
+}
+
+// TagsCleared reports if the "tags" edge to the PostTag entity was cleared.
+func (m *PostMutation) TagsCleared() bool {
+	return m.clearedtags
+}
+
+// RemoveTagIDs removes the "tags" edge to the PostTag entity by IDs.
+func (m *PostMutation) RemoveTagIDs(ids ...uint) {
+	if m.removedtags == nil {
+		m.removedtags = make(map[uint]struct{})
+	}
+	for i := range ids {
+		delete(m.tags, ids[i])
+		m.removedtags[ids[i]] = struct{}{}
+	}
+}
+
+// RemovedTags returns the removed IDs of the "tags" edge to the PostTag entity.
+func (m *PostMutation) RemovedTagsIDs() (ids []uint) {
+	for id := range m.removedtags {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// TagsIDs returns the "tags" edge IDs in the mutation.
+func (m *PostMutation) TagsIDs() (ids []uint) {
+	for id := range m.tags {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ResetTags resets all changes to the "tags" edge.
+func (m *PostMutation) ResetTags() {
+	m.tags = nil
+	m.clearedtags = false
+	m.removedtags = nil
+}
+
+// AddCommentIDs adds the "comments" edge to the Comment entity by ids.
+func (m *PostMutation) AddCommentIDs(ids ...uint) {
+	if m.comments == nil {
+		m.comments = make(map[uint]struct{})
+	}
+	for i := range ids {
+		m.comments[ids[i]] = struct{}{}
+	}
+}
+
+// ClearComments clears the "comments" edge to the Comment entity.
+func (m *PostMutation) ClearComments() {
+	m.clearedcomments = true
+}
+
+// CommentsCleared reports if the "comments" edge to the Comment entity was cleared.
+func (m *PostMutation) CommentsCleared() bool {
+	return m.clearedcomments
+}
+
+// RemoveCommentIDs removes the "comments" edge to the Comment entity by IDs.
+func (m *PostMutation) RemoveCommentIDs(ids ...uint) {
+	if m.removedcomments == nil {
+		m.removedcomments = make(map[uint]struct{})
+	}
+	for i := range ids {
+		delete(m.comments, ids[i])
+		m.removedcomments[ids[i]] = struct{}{}
+	}
+}
+
+// RemovedComments returns the removed IDs of the "comments" edge to the Comment entity.
+func (m *PostMutation) RemovedCommentsIDs() (ids []uint) {
+	for id := range m.removedcomments {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// CommentsIDs returns the "comments" edge IDs in the mutation.
+func (m *PostMutation) CommentsIDs() (ids []uint) {
+	for id := range m.comments {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ResetComments resets all changes to the "comments" edge.
+func (m *PostMutation) ResetComments() {
+	m.comments = nil
+	m.clearedcomments = false
+	m.removedcomments = nil
+}
+
+// AddSlugHistoryIDs adds the "slug_history" edge to the PostSlug entity by ids.
+func (m *PostMutation) AddSlugHistoryIDs(ids ...uint) {
+	if m.slug_history == nil {
+		m.slug_history = make(map[uint]struct{})
+	}
+	for i := range ids {
+		m.slug_history[ids[i]] = struct{}{}
+	}
+}
+
+// ClearSlugHistory clears the "slug_history" edge to the PostSlug entity.
+func (m *PostMutation) ClearSlugHistory() {
+	m.clearedslug_history = true
+}
+
+// SlugHistoryCleared reports if the "slug_history" edge to the PostSlug entity was cleared.
+func (m *PostMutation) SlugHistoryCleared() bool {
+	return m.clearedslug_history
+}
+
+// RemoveSlugHistoryIDs removes the "slug_history" edge to the PostSlug entity by IDs.
+func (m *PostMutation) RemoveSlugHistoryIDs(ids ...uint) {
+	if m.removedslug_history == nil {
+		m.removedslug_history = make(map[uint]struct{})
+	}
+	for i := range ids {
+		delete(m.slug_history, ids[i])
+		m.removedslug_history[ids[i]] = struct{}{}
+	}
+}
+
+// RemovedSlugHistory returns the removed IDs of the "slug_history" edge to the PostSlug entity.
+func (m *PostMutation) RemovedSlugHistoryIDs() (ids []uint) {
+	for id := range m.removedslug_history {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// SlugHistoryIDs returns the "slug_history" edge IDs in the mutation.
+func (m *PostMutation) SlugHistoryIDs() (ids []uint) {
+	for id := range m.slug_history {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ResetSlugHistory resets all changes to the "slug_history" edge.
+func (m *PostMutation) ResetSlugHistory() {
+	m.slug_history = nil
+	m.clearedslug_history = false
+	m.removedslug_history = nil
+}
+
+// AddRevisionIDs adds the "revisions" edge to the PostRevision entity by ids.
+func (m *PostMutation) AddRevisionIDs(ids ...uint) {
+	if m.revisions == nil {
+		m.revisions = make(map[uint]struct{})
+	}
+	for i := range ids {
+		m.revisions[ids[i]] = struct{}{}
+	}
+}
+
+// ClearRevisions clears the "revisions" edge to the PostRevision entity.
+func (m *PostMutation) ClearRevisions() {
+	m.clearedrevisions = true
+}
+
+// RevisionsCleared reports if the "revisions" edge to the PostRevision entity was cleared.
+func (m *PostMutation) RevisionsCleared() bool {
+	return m.clearedrevisions
+}
+
+// RemoveRevisionIDs removes the "revisions" edge to the PostRevision entity by IDs.
+func (m *PostMutation) RemoveRevisionIDs(ids ...uint) {
+	if m.removedrevisions == nil {
+		m.removedrevisions = make(map[uint]struct{})
+	}
+	for i := range ids {
+		delete(m.revisions, ids[i])
+		m.removedrevisions[ids[i]] = struct{}{}
+	}
+}
+
+// RemovedRevisions returns the removed IDs of the "revisions" edge to the PostRevision entity.
+func (m *PostMutation) RemovedRevisionsIDs() (ids []uint) {
+	for id := range m.removedrevisions {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// RevisionsIDs returns the "revisions" edge IDs in the mutation.
+func (m *PostMutation) RevisionsIDs() (ids []uint) {
+	for id := range m.revisions {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ResetRevisions resets all changes to the "revisions" edge.
+func (m *PostMutation) ResetRevisions() {
+	m.revisions = nil
+	m.clearedrevisions = false
+	m.removedrevisions = nil
+}
+
+// Where appends a list predicates to the PostMutation builder.
+func (m *PostMutation) Where(ps ...predicate.Post) {
+	m.predicates = append(m.predicates, ps...)
+}
+
+// WhereP appends storage-level predicates to the PostMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *PostMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.Post, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
+	}
+	m.Where(p...)
+}
+
+// Op returns the operation name.
+func (m *PostMutation) Op() Op {
+	return m.op
+}
+
+// SetOp allows setting the mutation operation.
+func (m *PostMutation) SetOp(op Op) {
+	m.op = op
+}
+
+// Type returns the node type of this mutation (Post).
+func (m *PostMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *PostMutation) Fields() []string {
+	fields := make([]string, 0, 14)
+	if m.created_at != nil {
+		fields = append(fields, post.FieldCreatedAt)
+	}
+	if m.updated_at != nil {
+		fields = append(fields, post.FieldUpdatedAt)
+	}
+	if m.deleted_at != nil {
+		fields = append(fields, post.FieldDeletedAt)
+	}
+	if m.author != nil {
+		fields = append(fields, post.FieldUserID)
+	}
+	if m.title != nil {
+		fields = append(fields, post.FieldTitle)
+	}
+	if m.slug != nil {
+		fields = append(fields, post.FieldSlug)
+	}
+	if m.summary != nil {
+		fields = append(fields, post.FieldSummary)
+	}
+	if m.content != nil {
+		fields = append(fields, post.FieldContent)
+	}
+	if m.cover != nil {
+		fields = append(fields, post.FieldCover)
+	}
+	if m.read_time_minutes != nil {
+		fields = append(fields, post.FieldReadTimeMinutes)
+	}
+	if m.view_count != nil {
+		fields = append(fields, post.FieldViewCount)
+	}
+	if m.status != nil {
+		fields = append(fields, post.FieldStatus)
+	}
+	if m.published_at != nil {
+		fields = append(fields, post.FieldPublishedAt)
+	}
+	if m.search_vector != nil {
+		fields = append(fields, post.FieldSearchVector)
+	}
+	return fields
+}
+
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *PostMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case post.FieldCreatedAt:
+		return m.CreatedAt()
+	case post.FieldUpdatedAt:
+		return m.UpdatedAt()
+	case post.FieldDeletedAt:
+		return m.DeletedAt()
+	case post.FieldUserID:
+		return m.UserID()
+	case post.FieldTitle:
+		return m.Title()
+	case post.FieldSlug:
+		return m.Slug()
+	case post.FieldSummary:
+		return m.Summary()
+	case post.FieldContent:
+		return m.Content()
+	case post.FieldCover:
+		return m.Cover()
+	case post.FieldReadTimeMinutes:
+		return m.ReadTimeMinutes()
+	case post.FieldViewCount:
+		return m.ViewCount()
+	case post.FieldStatus:
+		return m.Status()
+	case post.FieldPublishedAt:
+		return m.PublishedAt()
+	case post.FieldSearchVector:
+		return m.SearchVector()
+	}
+	return nil, false
+}
+
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *PostMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case post.FieldCreatedAt:
+		return m.OldCreatedAt(ctx)
+	case post.FieldUpdatedAt:
+		return m.OldUpdatedAt(ctx)
+	case post.FieldDeletedAt:
+		return m.OldDeletedAt(ctx)
+	case post.FieldUserID:
+		return m.OldUserID(ctx)
+	case post.FieldTitle:
+		return m.OldTitle(ctx)
+	case post.FieldSlug:
+		return m.OldSlug(ctx)
+	case post.FieldSummary:
+		return m.OldSummary(ctx)
+	case post.FieldContent:
+		return m.OldContent(ctx)
+	case post.FieldCover:
+		return m.OldCover(ctx)
+	case post.FieldReadTimeMinutes:
+		return m.OldReadTimeMinutes(ctx)
+	case post.FieldViewCount:
+		return m.OldViewCount(ctx)
+	case post.FieldStatus:
+		return m.OldStatus(ctx)
+	case post.FieldPublishedAt:
+		return m.OldPublishedAt(ctx)
+	case post.FieldSearchVector:
+		return m.OldSearchVector(ctx)
+	}
+	return nil, fmt.Errorf("unknown Post field %s", name)
+}
+
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *PostMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case post.FieldCreatedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCreatedAt(v)
+		return nil
+	case post.FieldUpdatedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetUpdatedAt(v)
+		return nil
+	case post.FieldDeletedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetDeletedAt(v)
+		return nil
+	case post.FieldUserID:
+		v, ok := value.(uint)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetUserID(v)
+		return nil
+	case post.FieldTitle:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetTitle(v)
+		return nil
+	case post.FieldSlug:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetSlug(v)
+		return nil
+	case post.FieldSummary:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetSummary(v)
+		return nil
+	case post.FieldContent:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetContent(v)
+		return nil
+	case post.FieldCover:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCover(v)
+		return nil
+	case post.FieldReadTimeMinutes:
+		v, ok := value.(uint)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetReadTimeMinutes(v)
+		return nil
+	case post.FieldViewCount:
+		v, ok := value.(uint)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetViewCount(v)
+		return nil
+	case post.FieldStatus:
+		v, ok := value.(entity.PostStatus)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetStatus(v)
+		return nil
+	case post.FieldPublishedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetPublishedAt(v)
+		return nil
+	case post.FieldSearchVector:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetSearchVector(v)
+		return nil
+	}
+	return fmt.Errorf("unknown Post field %s", name)
+}
+
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *PostMutation) AddedFields() []string {
+	var fields []string
+	if m.addread_time_minutes != nil {
+		fields = append(fields, post.FieldReadTimeMinutes)
+	}
+	if m.addview_count != nil {
+		fields = append(fields, post.FieldViewCount)
+	}
+	return fields
+}
+
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *PostMutation) AddedField(name string) (ent.Value, bool) {
+	switch name {
+	case post.FieldReadTimeMinutes:
+		return m.AddedReadTimeMinutes()
+	case post.FieldViewCount:
+		return m.AddedViewCount()
+	}
+	return nil, false
+}
+
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *PostMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	case post.FieldReadTimeMinutes:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddReadTimeMinutes(v)
+		return nil
+	case post.FieldViewCount:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddViewCount(v)
+		return nil
+	}
+	return fmt.Errorf("unknown Post numeric field %s", name)
+}
+
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *PostMutation) ClearedFields() []string {
+	var fields []string
+	if m.FieldCleared(post.FieldDeletedAt) {
+		fields = append(fields, post.FieldDeletedAt)
+	}
+	if m.FieldCleared(post.FieldSlug) {
+		fields = append(fields, post.FieldSlug)
+	}
+	if m.FieldCleared(post.FieldSummary) {
+		fields = append(fields, post.FieldSummary)
+	}
+	if m.FieldCleared(post.FieldCover) {
+		fields = append(fields, post.FieldCover)
+	}
+	if m.FieldCleared(post.FieldPublishedAt) {
+		fields = append(fields, post.FieldPublishedAt)
+	}
+	if m.FieldCleared(post.FieldSearchVector) {
+		fields = append(fields, post.FieldSearchVector)
+	}
+	return fields
+}
+
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *PostMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
+}
+
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *PostMutation) ClearField(name string) error {
+	switch name {
+	case post.FieldDeletedAt:
+		m.ClearDeletedAt()
+		return nil
+	case post.FieldSlug:
+		m.ClearSlug()
+		return nil
+	case post.FieldSummary:
+		m.ClearSummary()
+		return nil
+	case post.FieldCover:
+		m.ClearCover()
+		return nil
+	case post.FieldPublishedAt:
+		m.ClearPublishedAt()
+		return nil
+	case post.FieldSearchVector:
+		m.ClearSearchVector()
+		return nil
+	}
+	return fmt.Errorf("unknown Post nullable field %s", name)
+}
+
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *PostMutation) ResetField(name string) error {
+	switch name {
+	case post.FieldCreatedAt:
+		m.ResetCreatedAt()
+		return nil
+	case post.FieldUpdatedAt:
+		m.ResetUpdatedAt()
+		return nil
+	case post.FieldDeletedAt:
+		m.ResetDeletedAt()
+		return nil
+	case post.FieldUserID:
+		m.ResetUserID()
+		return nil
+	case post.FieldTitle:
+		m.ResetTitle()
+		return nil
+	case post.FieldSlug:
+		m.ResetSlug()
+		return nil
+	case post.FieldSummary:
+		m.ResetSummary()
+		return nil
+	case post.FieldContent:
+		m.ResetContent()
+		return nil
+	case post.FieldCover:
+		m.ResetCover()
+		return nil
+	case post.FieldReadTimeMinutes:
+		m.ResetReadTimeMinutes()
+		return nil
+	case post.FieldViewCount:
+		m.ResetViewCount()
+		return nil
+	case post.FieldStatus:
+		m.ResetStatus()
+		return nil
+	case post.FieldPublishedAt:
+		m.ResetPublishedAt()
+		return nil
+	case post.FieldSearchVector:
+		m.ResetSearchVector()
+		return nil
+	}
+	return fmt.Errorf("unknown Post field %s", name)
+}
+
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *PostMutation) AddedEdges() []string {
+	edges := make([]string, 0, 6)
+	if m.author != nil {
+		edges = append(edges, post.EdgeAuthor)
+	}
+	if m.categories != nil {
+		edges = append(edges, post.EdgeCategories)
+	}
+	if m.tags != nil {
+		edges = append(edges, post.EdgeTags)
+	}
+	if m.comments != nil {
+		edges = append(edges, post.EdgeComments)
+	}
+	if m.slug_history != nil {
+		edges = append(edges, post.EdgeSlugHistory)
+	}
+	if m.revisions != nil {
+		edges = append(edges, post.EdgeRevisions)
+	}
+	return edges
+}
+
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *PostMutation) AddedIDs(name string) []ent.Value {
+	switch name {
+	case post.EdgeAuthor:
+		if id := m.author; id != nil {
+			return []ent.Value{*id}
+		}
+	case post.EdgeCategories:
+		ids := make([]ent.Value, 0, len(m.categories))
+		for id := range m.categories {
+			ids = append(ids, id)
+		}
+		return ids
+	case post.EdgeTags:
+		ids := make([]ent.Value, 0, len(m.tags))
+		for id := range m.tags {
+			ids = append(ids, id)
+		}
+		return ids
+	case post.EdgeComments:
+		ids := make([]ent.Value, 0, len(m.comments))
+		for id := range m.comments {
+			ids = append(ids, id)
+		}
+		return ids
+	case post.EdgeSlugHistory:
+		ids := make([]ent.Value, 0, len(m.slug_history))
+		for id := range m.slug_history {
+			ids = append(ids, id)
+		}
+		return ids
+	case post.EdgeRevisions:
+		ids := make([]ent.Value, 0, len(m.revisions))
+		for id := range m.revisions {
+			ids = append(ids, id)
+		}
+		return ids
+	}
+	return nil
+}
+
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *PostMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 6)
+	if m.removedcategories != nil {
+		edges = append(edges, post.EdgeCategories)
+	}
+	if m.removedtags != nil {
+		edges = append(edges, post.EdgeTags)
+	}
+	if m.removedcomments != nil {
+		edges = append(edges, post.EdgeComments)
+	}
+	if m.removedslug_history != nil {
+		edges = append(edges, post.EdgeSlugHistory)
+	}
+	if m.removedrevisions != nil {
+		edges = append(edges, post.EdgeRevisions)
+	}
+	return edges
+}
+
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *PostMutation) RemovedIDs(name string) []ent.Value {
+	switch name {
+	case post.EdgeCategories:
+		ids := make([]ent.Value, 0, len(m.removedcategories))
+		for id := range m.removedcategories {
+			ids = append(ids, id)
+		}
+		return ids
+	case post.EdgeTags:
+		ids := make([]ent.Value, 0, len(m.removedtags))
+		for id := range m.removedtags {
+			ids = append(ids, id)
+		}
+		return ids
+	case post.EdgeComments:
+		ids := make([]ent.Value, 0, len(m.removedcomments))
+		for id := range m.removedcomments {
+			ids = append(ids, id)
+		}
+		return ids
+	case post.EdgeSlugHistory:
+		ids := make([]ent.Value, 0, len(m.removedslug_history))
+		for id := range m.removedslug_history {
+			ids = append(ids, id)
+		}
+		return ids
+	case post.EdgeRevisions:
+		ids := make([]ent.Value, 0, len(m.removedrevisions))
+		for id := range m.removedrevisions {
+			ids = append(ids, id)
+		}
+		return ids
+	}
+	return nil
+}
+
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *PostMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 6)
+	if m.clearedauthor {
+		edges = append(edges, post.EdgeAuthor)
+	}
+	if m.clearedcategories {
+		edges = append(edges, post.EdgeCategories)
+	}
+	if m.clearedtags {
+		edges = append(edges, post.EdgeTags)
+	}
+	if m.clearedcomments {
+		edges = append(edges, post.EdgeComments)
+	}
+	if m.clearedslug_history {
+		edges = append(edges, post.EdgeSlugHistory)
+	}
+	if m.clearedrevisions {
+		edges = append(edges, post.EdgeRevisions)
+	}
+	return edges
+}
+
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *PostMutation) EdgeCleared(name string) bool {
+	switch name {
+	case post.EdgeAuthor:
+		return m.clearedauthor
+	case post.EdgeCategories:
+		return m.clearedcategories
+	case post.EdgeTags:
+		return m.clearedtags
+	case post.EdgeComments:
+		return m.clearedcomments
+	case post.EdgeSlugHistory:
+		return m.clearedslug_history
+	case post.EdgeRevisions:
+		return m.clearedrevisions
+	}
+	return false
+}
+
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *PostMutation) ClearEdge(name string) error {
+	switch name {
+	case post.EdgeAuthor:
+		m.ClearAuthor()
+		return nil
+	}
+	return fmt.Errorf("unknown Post unique edge %s", name)
+}
+
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *PostMutation) ResetEdge(name string) error {
+	switch name {
+	case post.EdgeAuthor:
+		m.ResetAuthor()
+		return nil
+	case post.EdgeCategories:
+		m.ResetCategories()
+		return nil
+	case post.EdgeTags:
+		m.ResetTags()
+		return nil
+	case post.EdgeComments:
+		m.ResetComments()
+		return nil
+	case post.EdgeSlugHistory:
+		m.ResetSlugHistory()
+		return nil
+	case post.EdgeRevisions:
+		m.ResetRevisions()
+		return nil
+	}
+	return fmt.Errorf("unknown Post edge %s", name)
+}
+
+// PostCategoryMutation represents an operation that mutates the PostCategory nodes in the graph.
+type PostCategoryMutation struct {
+	config
+	op            Op
+	typ           string
+	id            *uint
+	created_at    *time.Time
+	updated_at    *time.Time
+	deleted_at    *time.Time
+	name          *string
+	slug          *string
+	clearedFields map[string]struct{}
+	posts         map[uint]struct{}
+	removedposts  map[uint]struct{}
+	clearedposts  bool
+	done          bool
+	oldValue      func(context.Context) (*PostCategory, error)
+	predicates    []predicate.PostCategory
+}
+
+var _ ent.Mutation = (*PostCategoryMutation)(nil)
+
+// postcategoryOption allows management of the mutation configuration using functional options.
+type postcategoryOption func(*PostCategoryMutation)
+
+// newPostCategoryMutation creates new mutation for the PostCategory entity.
+func newPostCategoryMutation(c config, op Op, opts ...postcategoryOption) *PostCategoryMutation {
+	m := &PostCategoryMutation{
+		config:        c,
+		op:            op,
+		typ:           TypePostCategory,
+		clearedFields: make(map[string]struct{}),
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// withPostCategoryID sets the ID field of the mutation.
+func withPostCategoryID(id uint) postcategoryOption {
+	return func(m *PostCategoryMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *PostCategory
+		)
+		m.oldValue = func(ctx context.Context) (*PostCategory, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().PostCategory.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
+	}
+}
+
+// withPostCategory sets the old PostCategory of the mutation.
+func withPostCategory(node *PostCategory) postcategoryOption {
+	return func(m *PostCategoryMutation) {
+		m.oldValue = func(context.Context) (*PostCategory, error) {
+			return node, nil
+		}
+		m.id = &node.ID
+	}
+}
+
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m PostCategoryMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
+}
+
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m PostCategoryMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("ent: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
+}
+
+// SetID sets the value of the id field. Note that this
+// operation is only accepted on creation of PostCategory entities.
+func (m *PostCategoryMutation) SetID(id uint) {
+	m.id = &id
+}
+
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *PostCategoryMutation) ID() (id uint, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
+}
+
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *PostCategoryMutation) IDs(ctx context.Context) ([]uint, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []uint{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().PostCategory.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (m *PostCategoryMutation) SetCreatedAt(t time.Time) {
+	m.created_at = &t
+}
+
+// CreatedAt returns the value of the "created_at" field in the mutation.
+func (m *PostCategoryMutation) CreatedAt() (r time.Time, exists bool) {
+	v := m.created_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCreatedAt returns the old "created_at" field's value of the PostCategory entity.
+// If the PostCategory object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *PostCategoryMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCreatedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCreatedAt: %w", err)
+	}
+	return oldValue.CreatedAt, nil
+}
+
+// ResetCreatedAt resets all changes to the "created_at" field.
+func (m *PostCategoryMutation) ResetCreatedAt() {
+	m.created_at = nil
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (m *PostCategoryMutation) SetUpdatedAt(t time.Time) {
+	m.updated_at = &t
+}
+
+// UpdatedAt returns the value of the "updated_at" field in the mutation.
+func (m *PostCategoryMutation) UpdatedAt() (r time.Time, exists bool) {
+	v := m.updated_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldUpdatedAt returns the old "updated_at" field's value of the PostCategory entity.
+// If the PostCategory object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *PostCategoryMutation) OldUpdatedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldUpdatedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldUpdatedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldUpdatedAt: %w", err)
+	}
+	return oldValue.UpdatedAt, nil
+}
+
+// ResetUpdatedAt resets all changes to the "updated_at" field.
+func (m *PostCategoryMutation) ResetUpdatedAt() {
+	m.updated_at = nil
+}
+
+// SetDeletedAt sets the "deleted_at" field.
+func (m *PostCategoryMutation) SetDeletedAt(t time.Time) {
+	m.deleted_at = &t
+}
+
+// DeletedAt returns the value of the "deleted_at" field in the mutation.
+func (m *PostCategoryMutation) DeletedAt() (r time.Time, exists bool) {
+	v := m.deleted_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldDeletedAt returns the old "deleted_at" field's value of the PostCategory entity.
+// If the PostCategory object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *PostCategoryMutation) OldDeletedAt(ctx context.Context) (v *time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldDeletedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldDeletedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldDeletedAt: %w", err)
+	}
+	return oldValue.DeletedAt, nil
+}
+
+// ClearDeletedAt clears the value of the "deleted_at" field.
+func (m *PostCategoryMutation) ClearDeletedAt() {
+	m.deleted_at = nil
+	m.clearedFields[postcategory.FieldDeletedAt] = struct{}{}
+}
+
+// DeletedAtCleared returns if the "deleted_at" field was cleared in this mutation.
+func (m *PostCategoryMutation) DeletedAtCleared() bool {
+	_, ok := m.clearedFields[postcategory.FieldDeletedAt]
+	return ok
+}
+
+// ResetDeletedAt resets all changes to the "deleted_at" field.
+func (m *PostCategoryMutation) ResetDeletedAt() {
+	m.deleted_at = nil
+	delete(m.clearedFields, postcategory.FieldDeletedAt)
+}
+
+// SetName sets the "name" field.
+func (m *PostCategoryMutation) SetName(s string) {
+	m.name = &s
+}
+
+// Name returns the value of the "name" field in the mutation.
+func (m *PostCategoryMutation) Name() (r string, exists bool) {
+	v := m.name
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldName returns the old "name" field's value of the PostCategory entity.
+// If the PostCategory object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *PostCategoryMutation) OldName(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldName is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldName requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldName: %w", err)
+	}
+	return oldValue.Name, nil
+}
+
+// ResetName resets all changes to the "name" field.
+func (m *PostCategoryMutation) ResetName() {
+	m.name = nil
+}
+
+// SetSlug sets the "slug" field.
+func (m *PostCategoryMutation) SetSlug(s string) {
+	m.slug = &s
+}
+
+// Slug returns the value of the "slug" field in the mutation.
+func (m *PostCategoryMutation) Slug() (r string, exists bool) {
+	v := m.slug
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldSlug returns the old "slug" field's value of the PostCategory entity.
+// If the PostCategory object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *PostCategoryMutation) OldSlug(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldSlug is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldSlug requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldSlug: %w", err)
+	}
+	return oldValue.Slug, nil
+}
+
+// ResetSlug resets all changes to the "slug" field.
+func (m *PostCategoryMutation) ResetSlug() {
+	m.slug = nil
+}
+
+// AddPostIDs adds the "posts" edge to the Post entity by ids.
+func (m *PostCategoryMutation) AddPostIDs(ids ...uint) {
+	if m.posts == nil {
+		m.posts = make(map[uint]struct{})
+	}
+	for i := range ids {
+		m.posts[ids[i]] = struct{}{}
+	}
+}
+
+// ClearPosts clears the "posts" edge to the Post entity.
+func (m *PostCategoryMutation) ClearPosts() {
+	m.clearedposts = true
+}
+
+// PostsCleared reports if the "posts" edge to the Post entity was cleared.
+func (m *PostCategoryMutation) PostsCleared() bool {
+	return m.clearedposts
+}
+
+// RemovePostIDs removes the "posts" edge to the Post entity by IDs.
+func (m *PostCategoryMutation) RemovePostIDs(ids ...uint) {
+	if m.removedposts == nil {
+		m.removedposts = make(map[uint]struct{})
+	}
+	for i := range ids {
+		delete(m.posts, ids[i])
+		m.removedposts[ids[i]] = struct{}{}
+	}
+}
+
+// RemovedPosts returns the removed IDs of the "posts" edge to the Post entity.
+func (m *PostCategoryMutation) RemovedPostsIDs() (ids []uint) {
+	for id := range m.removedposts {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// PostsIDs returns the "posts" edge IDs in the mutation.
+func (m *PostCategoryMutation) PostsIDs() (ids []uint) {
+	for id := range m.posts {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ResetPosts resets all changes to the "posts" edge.
+func (m *PostCategoryMutation) ResetPosts() {
+	m.posts = nil
+	m.clearedposts = false
+	m.removedposts = nil
+}
+
+// Where appends a list predicates to the PostCategoryMutation builder.
+func (m *PostCategoryMutation) Where(ps ...predicate.PostCategory) {
+	m.predicates = append(m.predicates, ps...)
+}
+
+// WhereP appends storage-level predicates to the PostCategoryMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *PostCategoryMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.PostCategory, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
+	}
+	m.Where(p...)
+}
+
+// Op returns the operation name.
+func (m *PostCategoryMutation) Op() Op {
+	return m.op
+}
+
+// SetOp allows setting the mutation operation.
+func (m *PostCategoryMutation) SetOp(op Op) {
+	m.op = op
+}
+
+// Type returns the node type of this mutation (PostCategory).
+func (m *PostCategoryMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *PostCategoryMutation) Fields() []string {
+	fields := make([]string, 0, 5)
+	if m.created_at != nil {
+		fields = append(fields, postcategory.FieldCreatedAt)
+	}
+	if m.updated_at != nil {
+		fields = append(fields, postcategory.FieldUpdatedAt)
+	}
+	if m.deleted_at != nil {
+		fields = append(fields, postcategory.FieldDeletedAt)
+	}
+	if m.name != nil {
+		fields = append(fields, postcategory.FieldName)
+	}
+	if m.slug != nil {
+		fields = append(fields, postcategory.FieldSlug)
+	}
+	return fields
+}
+
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *PostCategoryMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case postcategory.FieldCreatedAt:
+		return m.CreatedAt()
+	case postcategory.FieldUpdatedAt:
+		return m.UpdatedAt()
+	case postcategory.FieldDeletedAt:
+		return m.DeletedAt()
+	case postcategory.FieldName:
+		return m.Name()
+	case postcategory.FieldSlug:
+		return m.Slug()
+	}
+	return nil, false
+}
+
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *PostCategoryMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case postcategory.FieldCreatedAt:
+		return m.OldCreatedAt(ctx)
+	case postcategory.FieldUpdatedAt:
+		return m.OldUpdatedAt(ctx)
+	case postcategory.FieldDeletedAt:
+		return m.OldDeletedAt(ctx)
+	case postcategory.FieldName:
+		return m.OldName(ctx)
+	case postcategory.FieldSlug:
+		return m.OldSlug(ctx)
+	}
+	return nil, fmt.Errorf("unknown PostCategory field %s", name)
+}
+
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *PostCategoryMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case postcategory.FieldCreatedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCreatedAt(v)
+		return nil
+	case postcategory.FieldUpdatedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetUpdatedAt(v)
+		return nil
+	case postcategory.FieldDeletedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetDeletedAt(v)
+		return nil
+	case postcategory.FieldName:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetName(v)
+		return nil
+	case postcategory.FieldSlug:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetSlug(v)
+		return nil
+	}
+	return fmt.Errorf("unknown PostCategory field %s", name)
+}
+
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *PostCategoryMutation) AddedFields() []string {
+	return nil
+}
+
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *PostCategoryMutation) AddedField(name string) (ent.Value, bool) {
+	return nil, false
+}
+
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *PostCategoryMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	}
+	return fmt.Errorf("unknown PostCategory numeric field %s", name)
+}
+
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *PostCategoryMutation) ClearedFields() []string {
+	var fields []string
+	if m.FieldCleared(postcategory.FieldDeletedAt) {
+		fields = append(fields, postcategory.FieldDeletedAt)
+	}
+	return fields
+}
+
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *PostCategoryMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
+}
+
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *PostCategoryMutation) ClearField(name string) error {
+	switch name {
+	case postcategory.FieldDeletedAt:
+		m.ClearDeletedAt()
+		return nil
+	}
+	return fmt.Errorf("unknown PostCategory nullable field %s", name)
+}
+
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *PostCategoryMutation) ResetField(name string) error {
+	switch name {
+	case postcategory.FieldCreatedAt:
+		m.ResetCreatedAt()
+		return nil
+	case postcategory.FieldUpdatedAt:
+		m.ResetUpdatedAt()
+		return nil
+	case postcategory.FieldDeletedAt:
+		m.ResetDeletedAt()
+		return nil
+	case postcategory.FieldName:
+		m.ResetName()
+		return nil
+	case postcategory.FieldSlug:
+		m.ResetSlug()
+		return nil
+	}
+	return fmt.Errorf("unknown PostCategory field %s", name)
+}
+
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *PostCategoryMutation) AddedEdges() []string {
+	edges := make([]string, 0, 1)
+	if m.posts != nil {
+		edges = append(edges, postcategory.EdgePosts)
+	}
+	return edges
+}
+
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *PostCategoryMutation) AddedIDs(name string) []ent.Value {
+	switch name {
+	case postcategory.EdgePosts:
+		ids := make([]ent.Value, 0, len(m.posts))
+		for id := range m.posts {
+			ids = append(ids, id)
+		}
+		return ids
+	}
+	return nil
+}
+
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *PostCategoryMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 1)
+	if m.removedposts != nil {
+		edges = append(edges, postcategory.EdgePosts)
+	}
+	return edges
+}
+
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *PostCategoryMutation) RemovedIDs(name string) []ent.Value {
+	switch name {
+	case postcategory.EdgePosts:
+		ids := make([]ent.Value, 0, len(m.removedposts))
+		for id := range m.removedposts {
+			ids = append(ids, id)
+		}
+		return ids
+	}
+	return nil
+}
+
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *PostCategoryMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 1)
+	if m.clearedposts {
+		edges = append(edges, postcategory.EdgePosts)
+	}
+	return edges
+}
+
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *PostCategoryMutation) EdgeCleared(name string) bool {
+	switch name {
+	case postcategory.EdgePosts:
+		return m.clearedposts
+	}
+	return false
+}
+
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *PostCategoryMutation) ClearEdge(name string) error {
+	switch name {
+	}
+	return fmt.Errorf("unknown PostCategory unique edge %s", name)
+}
+
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *PostCategoryMutation) ResetEdge(name string) error {
+	switch name {
+	case postcategory.EdgePosts:
+		m.ResetPosts()
+		return nil
+	}
+	return fmt.Errorf("unknown PostCategory edge %s", name)
+}
+
+// PostCategoryRelationMutation represents an operation that mutates the PostCategoryRelation nodes in the graph.
+type PostCategoryRelationMutation struct {
+	config
+	op              Op
+	typ             string
+	clearedFields   map[string]struct{}
+	post            *uint
+	clearedpost     bool
+	category        *uint
+	clearedcategory bool
+	done            bool
+	oldValue        func(context.Context) (*PostCategoryRelation, error)
+	predicates      []predicate.PostCategoryRelation
+}
+
+var _ ent.Mutation = (*PostCategoryRelationMutation)(nil)
+
+// postcategoryrelationOption allows management of the mutation configuration using functional options.
+type postcategoryrelationOption func(*PostCategoryRelationMutation)
+
+// newPostCategoryRelationMutation creates new mutation for the PostCategoryRelation entity.
+func newPostCategoryRelationMutation(c config, op Op, opts ...postcategoryrelationOption) *PostCategoryRelationMutation {
+	m := &PostCategoryRelationMutation{
+		config:        c,
+		op:            op,
+		typ:           TypePostCategoryRelation,
+		clearedFields: make(map[string]struct{}),
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m PostCategoryRelationMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
+}
+
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m PostCategoryRelationMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("ent: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
+}
+
+// SetPostID sets the "post_id" field.
+func (m *PostCategoryRelationMutation) SetPostID(u uint) {
+	m.post = &u
+}
+
+// PostID returns the value of the "post_id" field in the mutation.
+func (m *PostCategoryRelationMutation) PostID() (r uint, exists bool) {
+	v := m.post
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetPostID resets all changes to the "post_id" field.
+func (m *PostCategoryRelationMutation) ResetPostID() {
+	m.post = nil
+}
+
+// SetPostCategoryID sets the "post_category_id" field.
+func (m *PostCategoryRelationMutation) SetPostCategoryID(u uint) {
+	m.category = &u
+}
+
+// PostCategoryID returns the value of the "post_category_id" field in the mutation.
+func (m *PostCategoryRelationMutation) PostCategoryID() (r uint, exists bool) {
+	v := m.category
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetPostCategoryID resets all changes to the "post_category_id" field.
+func (m *PostCategoryRelationMutation) ResetPostCategoryID() {
+	m.category = nil
+}
+
+// ClearPost clears the "post" edge to the Post entity.
+func (m *PostCategoryRelationMutation) ClearPost() {
+	m.clearedpost = true
+	m.clearedFields[postcategoryrelation.FieldPostID] = struct{}{}
+}
+
+// PostCleared reports if the "post" edge to the Post entity was cleared.
+func (m *PostCategoryRelationMutation) PostCleared() bool {
+	return m.clearedpost
+}
+
+// PostIDs returns the "post" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// PostID instead. It exists only for internal usage by the builders.
+func (m *PostCategoryRelationMutation) PostIDs() (ids []uint) {
+	if id := m.post; id != nil {
+		ids = append(ids, *id)
+	}
+	return
+}
+
+// ResetPost resets all changes to the "post" edge.
+func (m *PostCategoryRelationMutation) ResetPost() {
+	m.post = nil
+	m.clearedpost = false
+}
+
+// SetCategoryID sets the "category" edge to the PostCategory entity by id.
+func (m *PostCategoryRelationMutation) SetCategoryID(id uint) {
+	m.category = &id
+}
+
+// ClearCategory clears the "category" edge to the PostCategory entity.
+func (m *PostCategoryRelationMutation) ClearCategory() {
+	m.clearedcategory = true
+	m.clearedFields[postcategoryrelation.FieldPostCategoryID] = struct{}{}
+}
+
+// CategoryCleared reports if the "category" edge to the PostCategory entity was cleared.
+func (m *PostCategoryRelationMutation) CategoryCleared() bool {
+	return m.clearedcategory
+}
+
+// CategoryID returns the "category" edge ID in the mutation.
+func (m *PostCategoryRelationMutation) CategoryID() (id uint, exists bool) {
+	if m.category != nil {
+		return *m.category, true
+	}
+	return
+}
+
+// CategoryIDs returns the "category" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// CategoryID instead. It exists only for internal usage by the builders.
+func (m *PostCategoryRelationMutation) CategoryIDs() (ids []uint) {
+	if id := m.category; id != nil {
+		ids = append(ids, *id)
+	}
+	return
+}
+
+// ResetCategory resets all changes to the "category" edge.
+func (m *PostCategoryRelationMutation) ResetCategory() {
+	m.category = nil
+	m.clearedcategory = false
+}
+
+// Where appends a list predicates to the PostCategoryRelationMutation builder.
+func (m *PostCategoryRelationMutation) Where(ps ...predicate.PostCategoryRelation) {
+	m.predicates = append(m.predicates, ps...)
+}
+
+// WhereP appends storage-level predicates to the PostCategoryRelationMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *PostCategoryRelationMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.PostCategoryRelation, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
+	}
+	m.Where(p...)
+}
+
+// Op returns the operation name.
+func (m *PostCategoryRelationMutation) Op() Op {
+	return m.op
+}
+
+// SetOp allows setting the mutation operation.
+func (m *PostCategoryRelationMutation) SetOp(op Op) {
+	m.op = op
+}
+
+// Type returns the node type of this mutation (PostCategoryRelation).
+func (m *PostCategoryRelationMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *PostCategoryRelationMutation) Fields() []string {
+	fields := make([]string, 0, 2)
+	if m.post != nil {
+		fields = append(fields, postcategoryrelation.FieldPostID)
+	}
+	if m.category != nil {
+		fields = append(fields, postcategoryrelation.FieldPostCategoryID)
+	}
+	return fields
+}
+
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *PostCategoryRelationMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case postcategoryrelation.FieldPostID:
+		return m.PostID()
+	case postcategoryrelation.FieldPostCategoryID:
+		return m.PostCategoryID()
+	}
+	return nil, false
+}
+
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *PostCategoryRelationMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	return nil, errors.New("edge schema PostCategoryRelation does not support getting old values")
+}
+
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *PostCategoryRelationMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case postcategoryrelation.FieldPostID:
+		v, ok := value.(uint)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetPostID(v)
+		return nil
+	case postcategoryrelation.FieldPostCategoryID:
+		v, ok := value.(uint)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetPostCategoryID(v)
+		return nil
+	}
+	return fmt.Errorf("unknown PostCategoryRelation field %s", name)
+}
+
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *PostCategoryRelationMutation) AddedFields() []string {
+	var fields []string
+	return fields
+}
+
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *PostCategoryRelationMutation) AddedField(name string) (ent.Value, bool) {
+	switch name {
+	}
+	return nil, false
+}
+
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *PostCategoryRelationMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	}
+	return fmt.Errorf("unknown PostCategoryRelation numeric field %s", name)
+}
+
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *PostCategoryRelationMutation) ClearedFields() []string {
+	return nil
+}
+
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *PostCategoryRelationMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
+}
+
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *PostCategoryRelationMutation) ClearField(name string) error {
+	return fmt.Errorf("unknown PostCategoryRelation nullable field %s", name)
+}
+
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *PostCategoryRelationMutation) ResetField(name string) error {
+	switch name {
+	case postcategoryrelation.FieldPostID:
+		m.ResetPostID()
+		return nil
+	case postcategoryrelation.FieldPostCategoryID:
+		m.ResetPostCategoryID()
+		return nil
+	}
+	return fmt.Errorf("unknown PostCategoryRelation field %s", name)
+}
+
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *PostCategoryRelationMutation) AddedEdges() []string {
+	edges := make([]string, 0, 2)
+	if m.post != nil {
+		edges = append(edges, postcategoryrelation.EdgePost)
+	}
+	if m.category != nil {
+		edges = append(edges, postcategoryrelation.EdgeCategory)
+	}
+	return edges
+}
+
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *PostCategoryRelationMutation) AddedIDs(name string) []ent.Value {
+	switch name {
+	case postcategoryrelation.EdgePost:
+		if id := m.post; id != nil {
+			return []ent.Value{*id}
+		}
+	case postcategoryrelation.EdgeCategory:
+		if id := m.category; id != nil {
+			return []ent.Value{*id}
+		}
+	}
+	return nil
+}
+
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *PostCategoryRelationMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 2)
+	return edges
+}
+
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *PostCategoryRelationMutation) RemovedIDs(name string) []ent.Value {
+	return nil
+}
+
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *PostCategoryRelationMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 2)
+	if m.clearedpost {
+		edges = append(edges, postcategoryrelation.EdgePost)
+	}
+	if m.clearedcategory {
+		edges = append(edges, postcategoryrelation.EdgeCategory)
+	}
+	return edges
+}
+
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *PostCategoryRelationMutation) EdgeCleared(name string) bool {
+	switch name {
+	case postcategoryrelation.EdgePost:
+		return m.clearedpost
+	case postcategoryrelation.EdgeCategory:
+		return m.clearedcategory
+	}
+	return false
+}
+
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *PostCategoryRelationMutation) ClearEdge(name string) error {
+	switch name {
+	case postcategoryrelation.EdgePost:
+		m.ClearPost()
+		return nil
+	case postcategoryrelation.EdgeCategory:
+		m.ClearCategory()
+		return nil
+	}
+	return fmt.Errorf("unknown PostCategoryRelation unique edge %s", name)
+}
+
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *PostCategoryRelationMutation) ResetEdge(name string) error {
+	switch name {
+	case postcategoryrelation.EdgePost:
+		m.ResetPost()
+		return nil
+	case postcategoryrelation.EdgeCategory:
+		m.ResetCategory()
+		return nil
+	}
+	return fmt.Errorf("unknown PostCategoryRelation edge %s", name)
+}
+
+// PostRevisionMutation represents an operation that mutates the PostRevision nodes in the graph.
+type PostRevisionMutation struct {
+	config
+	op               Op
+	typ              string
+	id               *uint
+	created_at       *time.Time
+	updated_at       *time.Time
+	deleted_at       *time.Time
+	version          *uint
+	addversion       *int
+	title            *string
+	summary          *string
+	content          *string
+	restored_from    *uint
+	addrestored_from *int
+	clearedFields    map[string]struct{}
+	post             *uint
+	clearedpost      bool
+	user             *uint
+	cleareduser      bool
+	done             bool
+	oldValue         func(context.Context) (*PostRevision, error)
+	predicates       []predicate.PostRevision
+}
+
+var _ ent.Mutation = (*PostRevisionMutation)(nil)
+
+// postrevisionOption allows management of the mutation configuration using functional options.
+type postrevisionOption func(*PostRevisionMutation)
+
+// newPostRevisionMutation creates new mutation for the PostRevision entity.
+func newPostRevisionMutation(c config, op Op, opts ...postrevisionOption) *PostRevisionMutation {
+	m := &PostRevisionMutation{
+		config:        c,
+		op:            op,
+		typ:           TypePostRevision,
+		clearedFields: make(map[string]struct{}),
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// withPostRevisionID sets the ID field of the mutation.
+func withPostRevisionID(id uint) postrevisionOption {
+	return func(m *PostRevisionMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *PostRevision
+		)
+		m.oldValue = func(ctx context.Context) (*PostRevision, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().PostRevision.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
+	}
+}
+
+// withPostRevision sets the old PostRevision of the mutation.
+func withPostRevision(node *PostRevision) postrevisionOption {
+	return func(m *PostRevisionMutation) {
+		m.oldValue = func(context.Context) (*PostRevision, error) {
+			return node, nil
+		}
+		m.id = &node.ID
+	}
+}
+
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m PostRevisionMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
+}
+
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m PostRevisionMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("ent: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
+}
+
+// SetID sets the value of the id field. Note that this
+// operation is only accepted on creation of PostRevision entities.
+func (m *PostRevisionMutation) SetID(id uint) {
+	m.id = &id
+}
+
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *PostRevisionMutation) ID() (id uint, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
+}
+
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *PostRevisionMutation) IDs(ctx context.Context) ([]uint, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []uint{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().PostRevision.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (m *PostRevisionMutation) SetCreatedAt(t time.Time) {
+	m.created_at = &t
+}
+
+// CreatedAt returns the value of the "created_at" field in the mutation.
+func (m *PostRevisionMutation) CreatedAt() (r time.Time, exists bool) {
+	v := m.created_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCreatedAt returns the old "created_at" field's value of the PostRevision entity.
+// If the PostRevision object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *PostRevisionMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCreatedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCreatedAt: %w", err)
+	}
+	return oldValue.CreatedAt, nil
+}
+
+// ResetCreatedAt resets all changes to the "created_at" field.
+func (m *PostRevisionMutation) ResetCreatedAt() {
+	m.created_at = nil
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (m *PostRevisionMutation) SetUpdatedAt(t time.Time) {
+	m.updated_at = &t
+}
+
+// UpdatedAt returns the value of the "updated_at" field in the mutation.
+func (m *PostRevisionMutation) UpdatedAt() (r time.Time, exists bool) {
+	v := m.updated_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldUpdatedAt returns the old "updated_at" field's value of the PostRevision entity.
+// If the PostRevision object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *PostRevisionMutation) OldUpdatedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldUpdatedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldUpdatedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldUpdatedAt: %w", err)
+	}
+	return oldValue.UpdatedAt, nil
+}
+
+// ResetUpdatedAt resets all changes to the "updated_at" field.
+func (m *PostRevisionMutation) ResetUpdatedAt() {
+	m.updated_at = nil
+}
+
+// SetDeletedAt sets the "deleted_at" field.
+func (m *PostRevisionMutation) SetDeletedAt(t time.Time) {
+	m.deleted_at = &t
+}
+
+// DeletedAt returns the value of the "deleted_at" field in the mutation.
+func (m *PostRevisionMutation) DeletedAt() (r time.Time, exists bool) {
+	v := m.deleted_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldDeletedAt returns the old "deleted_at" field's value of the PostRevision entity.
+// If the PostRevision object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *PostRevisionMutation) OldDeletedAt(ctx context.Context) (v *time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldDeletedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldDeletedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldDeletedAt: %w", err)
+	}
+	return oldValue.DeletedAt, nil
+}
+
+// ClearDeletedAt clears the value of the "deleted_at" field.
+func (m *PostRevisionMutation) ClearDeletedAt() {
+	m.deleted_at = nil
+	m.clearedFields[postrevision.FieldDeletedAt] = struct{}{}
+}
+
+// DeletedAtCleared returns if the "deleted_at" field was cleared in this mutation.
+func (m *PostRevisionMutation) DeletedAtCleared() bool {
+	_, ok := m.clearedFields[postrevision.FieldDeletedAt]
+	return ok
+}
+
+// ResetDeletedAt resets all changes to the "deleted_at" field.
+func (m *PostRevisionMutation) ResetDeletedAt() {
+	m.deleted_at = nil
+	delete(m.clearedFields, postrevision.FieldDeletedAt)
+}
+
+// SetPostID sets the "post_id" field.
+func (m *PostRevisionMutation) SetPostID(u uint) {
+	m.post = &u
+}
+
+// PostID returns the value of the "post_id" field in the mutation.
+func (m *PostRevisionMutation) PostID() (r uint, exists bool) {
+	v := m.post
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldPostID returns the old "post_id" field's value of the PostRevision entity.
+// If the PostRevision object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *PostRevisionMutation) OldPostID(ctx context.Context) (v uint, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldPostID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldPostID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldPostID: %w", err)
+	}
+	return oldValue.PostID, nil
+}
+
+// ResetPostID resets all changes to the "post_id" field.
+func (m *PostRevisionMutation) ResetPostID() {
+	m.post = nil
+}
+
+// SetVersion sets the "version" field.
+func (m *PostRevisionMutation) SetVersion(u uint) {
+	m.version = &u
+	m.addversion = nil
+}
+
+// Version returns the value of the "version" field in the mutation.
+func (m *PostRevisionMutation) Version() (r uint, exists bool) {
+	v := m.version
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldVersion returns the old "version" field's value of the PostRevision entity.
+// If the PostRevision object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *PostRevisionMutation) OldVersion(ctx context.Context) (v uint, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldVersion is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldVersion requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldVersion: %w", err)
+	}
+	return oldValue.Version, nil
+}
+
+// AddVersion adds u to the "version" field.
+func (m *PostRevisionMutation) AddVersion(u int) {
+	if m.addversion != nil {
+		*m.addversion += u
+	} else {
+		m.addversion = &u
+	}
+}
+
+// AddedVersion returns the value that was added to the "version" field in this mutation.
+func (m *PostRevisionMutation) AddedVersion() (r int, exists bool) {
+	v := m.addversion
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetVersion resets all changes to the "version" field.
+func (m *PostRevisionMutation) ResetVersion() {
+	m.version = nil
+	m.addversion = nil
+}
+
+// SetUserID sets the "user_id" field.
+func (m *PostRevisionMutation) SetUserID(u uint) {
+	m.user = &u
+}
+
+// UserID returns the value of the "user_id" field in the mutation.
+func (m *PostRevisionMutation) UserID() (r uint, exists bool) {
+	v := m.user
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldUserID returns the old "user_id" field's value of the PostRevision entity.
+// If the PostRevision object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *PostRevisionMutation) OldUserID(ctx context.Context) (v *uint, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldUserID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldUserID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldUserID: %w", err)
+	}
+	return oldValue.UserID, nil
+}
+
+// ClearUserID clears the value of the "user_id" field.
+func (m *PostRevisionMutation) ClearUserID() {
+	m.user = nil
+	m.clearedFields[postrevision.FieldUserID] = struct{}{}
+}
+
+// UserIDCleared returns if the "user_id" field was cleared in this mutation.
+func (m *PostRevisionMutation) UserIDCleared() bool {
+	_, ok := m.clearedFields[postrevision.FieldUserID]
+	return ok
+}
+
+// ResetUserID resets all changes to the "user_id" field.
+func (m *PostRevisionMutation) ResetUserID() {
+	m.user = nil
+	delete(m.clearedFields, postrevision.FieldUserID)
+}
+
+// SetTitle sets the "title" field.
+func (m *PostRevisionMutation) SetTitle(s string) {
+	m.title = &s
+}
+
+// Title returns the value of the "title" field in the mutation.
+func (m *PostRevisionMutation) Title() (r string, exists bool) {
+	v := m.title
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldTitle returns the old "title" field's value of the PostRevision entity.
+// If the PostRevision object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *PostRevisionMutation) OldTitle(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldTitle is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldTitle requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldTitle: %w", err)
+	}
+	return oldValue.Title, nil
+}
+
+// ResetTitle resets all changes to the "title" field.
+func (m *PostRevisionMutation) ResetTitle() {
+	m.title = nil
+}
+
+// SetSummary sets the "summary" field.
+func (m *PostRevisionMutation) SetSummary(s string) {
+	m.summary = &s
+}
+
+// Summary returns the value of the "summary" field in the mutation.
+func (m *PostRevisionMutation) Summary() (r string, exists bool) {
+	v := m.summary
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldSummary returns the old "summary" field's value of the PostRevision entity.
+// If the PostRevision object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *PostRevisionMutation) OldSummary(ctx context.Context) (v *string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldSummary is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldSummary requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldSummary: %w", err)
+	}
+	return oldValue.Summary, nil
+}
+
+// ClearSummary clears the value of the "summary" field.
+func (m *PostRevisionMutation) ClearSummary() {
+	m.summary = nil
+	m.clearedFields[postrevision.FieldSummary] = struct{}{}
+}
+
+// SummaryCleared returns if the "summary" field was cleared in this mutation.
+func (m *PostRevisionMutation) SummaryCleared() bool {
+	_, ok := m.clearedFields[postrevision.FieldSummary]
+	return ok
+}
+
+// ResetSummary resets all changes to the "summary" field.
+func (m *PostRevisionMutation) ResetSummary() {
+	m.summary = nil
+	delete(m.clearedFields, postrevision.FieldSummary)
+}
+
+// SetContent sets the "content" field.
+func (m *PostRevisionMutation) SetContent(s string) {
+	m.content = &s
+}
+
+// Content returns the value of the "content" field in the mutation.
+func (m *PostRevisionMutation) Content() (r string, exists bool) {
+	v := m.content
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldContent returns the old "content" field's value of the PostRevision entity.
+// If the PostRevision object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *PostRevisionMutation) OldContent(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldContent is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldContent requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldContent: %w", err)
+	}
+	return oldValue.Content, nil
+}
+
+// ResetContent resets all changes to the "content" field.
+func (m *PostRevisionMutation) ResetContent() {
+	m.content = nil
+}
+
+// SetRestoredFrom sets the "restored_from" field.
+func (m *PostRevisionMutation) SetRestoredFrom(u uint) {
+	m.restored_from = &u
+	m.addrestored_from = nil
+}
+
+// RestoredFrom returns the value of the "restored_from" field in the mutation.
+func (m *PostRevisionMutation) RestoredFrom() (r uint, exists bool) {
+	v := m.restored_from
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldRestoredFrom returns the old "restored_from" field's value of the PostRevision entity.
+// If the PostRevision object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *PostRevisionMutation) OldRestoredFrom(ctx context.Context) (v *uint, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldRestoredFrom is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldRestoredFrom requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldRestoredFrom: %w", err)
+	}
+	return oldValue.RestoredFrom, nil
+}
+
+// AddRestoredFrom adds u to the "restored_from" field.
+func (m *PostRevisionMutation) AddRestoredFrom(u int) {
+	if m.addrestored_from != nil {
+		*m.addrestored_from += u
+	} else {
+		m.addrestored_from = &u
+	}
+}
+
+// AddedRestoredFrom returns the value that was added to the "restored_from" field in this mutation.
+func (m *PostRevisionMutation) AddedRestoredFrom() (r int, exists bool) {
+	v := m.addrestored_from
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ClearRestoredFrom clears the value of the "restored_from" field.
+func (m *PostRevisionMutation) ClearRestoredFrom() {
+	m.restored_from = nil
+	m.addrestored_from = nil
+	m.clearedFields[postrevision.FieldRestoredFrom] = struct{}{}
+}
+
+// RestoredFromCleared returns if the "restored_from" field was cleared in this mutation.
+func (m *PostRevisionMutation) RestoredFromCleared() bool {
+	_, ok := m.clearedFields[postrevision.FieldRestoredFrom]
+	return ok
+}
+
+// ResetRestoredFrom resets all changes to the "restored_from" field.
+func (m *PostRevisionMutation) ResetRestoredFrom() {
+	m.restored_from = nil
+	m.addrestored_from = nil
+	delete(m.clearedFields, postrevision.FieldRestoredFrom)
+}
+
+// ClearPost clears the "post" edge to the Post entity.
+func (m *PostRevisionMutation) ClearPost() {
+	m.clearedpost = true
+	m.clearedFields[postrevision.FieldPostID] = struct{}{}
+}
+
+// PostCleared reports if the "post" edge to the Post entity was cleared.
+func (m *PostRevisionMutation) PostCleared() bool {
+	return m.clearedpost
+}
+
+// PostIDs returns the "post" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// PostID instead. It exists only for internal usage by the builders.
+func (m *PostRevisionMutation) PostIDs() (ids []uint) {
+	if id := m.post; id != nil {
+		ids = append(ids, *id)
+	}
+	return
+}
+
+// ResetPost resets all changes to the "post" edge.
+func (m *PostRevisionMutation) ResetPost() {
+	m.post = nil
+	m.clearedpost = false
+}
+
+// ClearUser clears the "user" edge to the User entity.
+func (m *PostRevisionMutation) ClearUser() {
+	m.cleareduser = true
+	m.clearedFields[postrevision.FieldUserID] = struct{}{}
+}
+
+// UserCleared reports if the "user" edge to the User entity was cleared.
+func (m *PostRevisionMutation) UserCleared() bool {
+	return m.UserIDCleared() || m.cleareduser
+}
+
+// UserIDs returns the "user" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// UserID instead. It exists only for internal usage by the builders.
+func (m *PostRevisionMutation) UserIDs() (ids []uint) {
+	if id := m.user; id != nil {
+		ids = append(ids, *id)
+	}
+	return
+}
+
+// ResetUser resets all changes to the "user" edge.
+func (m *PostRevisionMutation) ResetUser() {
+	m.user = nil
+	m.cleareduser = false
+}
+
+// Where appends a list predicates to the PostRevisionMutation builder.
+func (m *PostRevisionMutation) Where(ps ...predicate.PostRevision) {
+	m.predicates = append(m.predicates, ps...)
+}
+
+// WhereP appends storage-level predicates to the PostRevisionMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *PostRevisionMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.PostRevision, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
+	}
+	m.Where(p...)
+}
+
+// Op returns the operation name.
+func (m *PostRevisionMutation) Op() Op {
+	return m.op
+}
+
+// SetOp allows setting the mutation operation.
+func (m *PostRevisionMutation) SetOp(op Op) {
+	m.op = op
+}
+
+// Type returns the node type of this mutation (PostRevision).
+func (m *PostRevisionMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *PostRevisionMutation) Fields() []string {
+	fields := make([]string, 0, 10)
+	if m.created_at != nil {
+		fields = append(fields, postrevision.FieldCreatedAt)
+	}
+	if m.updated_at != nil {
+		fields = append(fields, postrevision.FieldUpdatedAt)
+	}
+	if m.deleted_at != nil {
+		fields = append(fields, postrevision.FieldDeletedAt)
+	}
+	if m.post != nil {
+		fields = append(fields, postrevision.FieldPostID)
+	}
+	if m.version != nil {
+		fields = append(fields, postrevision.FieldVersion)
+	}
+	if m.user != nil {
+		fields = append(fields, postrevision.FieldUserID)
+	}
+	if m.title != nil {
+		fields = append(fields, postrevision.FieldTitle)
+	}
+	if m.summary != nil {
+		fields = append(fields, postrevision.FieldSummary)
+	}
+	if m.content != nil {
+		fields = append(fields, postrevision.FieldContent)
+	}
+	if m.restored_from != nil {
+		fields = append(fields, postrevision.FieldRestoredFrom)
+	}
+	return fields
+}
+
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *PostRevisionMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case postrevision.FieldCreatedAt:
+		return m.CreatedAt()
+	case postrevision.FieldUpdatedAt:
+		return m.UpdatedAt()
+	case postrevision.FieldDeletedAt:
+		return m.DeletedAt()
+	case postrevision.FieldPostID:
+		return m.PostID()
+	case postrevision.FieldVersion:
+		return m.Version()
+	case postrevision.FieldUserID:
+		return m.UserID()
+	case postrevision.FieldTitle:
+		return m.Title()
+	case postrevision.FieldSummary:
+		return m.Summary()
+	case postrevision.FieldContent:
+		return m.Content()
+	case postrevision.FieldRestoredFrom:
+		return m.RestoredFrom()
+	}
+	return nil, false
+}
+
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *PostRevisionMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case postrevision.FieldCreatedAt:
+		return m.OldCreatedAt(ctx)
+	case postrevision.FieldUpdatedAt:
+		return m.OldUpdatedAt(ctx)
+	case postrevision.FieldDeletedAt:
+		return m.OldDeletedAt(ctx)
+	case postrevision.FieldPostID:
+		return m.OldPostID(ctx)
+	case postrevision.FieldVersion:
+		return m.OldVersion(ctx)
+	case postrevision.FieldUserID:
+		return m.OldUserID(ctx)
+	case postrevision.FieldTitle:
+		return m.OldTitle(ctx)
+	case postrevision.FieldSummary:
+		return m.OldSummary(ctx)
+	case postrevision.FieldContent:
+		return m.OldContent(ctx)
+	case postrevision.FieldRestoredFrom:
+		return m.OldRestoredFrom(ctx)
+	}
+	return nil, fmt.Errorf("unknown PostRevision field %s", name)
+}
+
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *PostRevisionMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case postrevision.FieldCreatedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCreatedAt(v)
+		return nil
+	case postrevision.FieldUpdatedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetUpdatedAt(v)
+		return nil
+	case postrevision.FieldDeletedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetDeletedAt(v)
+		return nil
+	case postrevision.FieldPostID:
+		v, ok := value.(uint)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetPostID(v)
+		return nil
+	case postrevision.FieldVersion:
+		v, ok := value.(uint)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetVersion(v)
+		return nil
+	case postrevision.FieldUserID:
+		v, ok := value.(uint)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetUserID(v)
+		return nil
+	case postrevision.FieldTitle:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetTitle(v)
+		return nil
+	case postrevision.FieldSummary:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetSummary(v)
+		return nil
+	case postrevision.FieldContent:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetContent(v)
+		return nil
+	case postrevision.FieldRestoredFrom:
+		v, ok := value.(uint)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetRestoredFrom(v)
+		return nil
+	}
+	return fmt.Errorf("unknown PostRevision field %s", name)
+}
+
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *PostRevisionMutation) AddedFields() []string {
+	var fields []string
+	if m.addversion != nil {
+		fields = append(fields, postrevision.FieldVersion)
+	}
+	if m.addrestored_from != nil {
+		fields = append(fields, postrevision.FieldRestoredFrom)
+	}
+	return fields
+}
+
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *PostRevisionMutation) AddedField(name string) (ent.Value, bool) {
+	switch name {
+	case postrevision.FieldVersion:
+		return m.AddedVersion()
+	case postrevision.FieldRestoredFrom:
+		return m.AddedRestoredFrom()
+	}
+	return nil, false
+}
+
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *PostRevisionMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	case postrevision.FieldVersion:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddVersion(v)
+		return nil
+	case postrevision.FieldRestoredFrom:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddRestoredFrom(v)
+		return nil
+	}
+	return fmt.Errorf("unknown PostRevision numeric field %s", name)
+}
+
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *PostRevisionMutation) ClearedFields() []string {
+	var fields []string
+	if m.FieldCleared(postrevision.FieldDeletedAt) {
+		fields = append(fields, postrevision.FieldDeletedAt)
+	}
+	if m.FieldCleared(postrevision.FieldUserID) {
+		fields = append(fields, postrevision.FieldUserID)
+	}
+	if m.FieldCleared(postrevision.FieldSummary) {
+		fields = append(fields, postrevision.FieldSummary)
+	}
+	if m.FieldCleared(postrevision.FieldRestoredFrom) {
+		fields = append(fields, postrevision.FieldRestoredFrom)
+	}
+	return fields
+}
+
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *PostRevisionMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
+}
+
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *PostRevisionMutation) ClearField(name string) error {
+	switch name {
+	case postrevision.FieldDeletedAt:
+		m.ClearDeletedAt()
+		return nil
+	case postrevision.FieldUserID:
+		m.ClearUserID()
+		return nil
+	case postrevision.FieldSummary:
+		m.ClearSummary()
+		return nil
+	case postrevision.FieldRestoredFrom:
+		m.ClearRestoredFrom()
+		return nil
+	}
+	return fmt.Errorf("unknown PostRevision nullable field %s", name)
+}
+
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *PostRevisionMutation) ResetField(name string) error {
+	switch name {
+	case postrevision.FieldCreatedAt:
+		m.ResetCreatedAt()
+		return nil
+	case postrevision.FieldUpdatedAt:
+		m.ResetUpdatedAt()
+		return nil
+	case postrevision.FieldDeletedAt:
+		m.ResetDeletedAt()
+		return nil
+	case postrevision.FieldPostID:
+		m.ResetPostID()
+		return nil
+	case postrevision.FieldVersion:
+		m.ResetVersion()
+		return nil
+	case postrevision.FieldUserID:
+		m.ResetUserID()
+		return nil
+	case postrevision.FieldTitle:
+		m.ResetTitle()
+		return nil
+	case postrevision.FieldSummary:
+		m.ResetSummary()
+		return nil
+	case postrevision.FieldContent:
+		m.ResetContent()
+		return nil
+	case postrevision.FieldRestoredFrom:
+		m.ResetRestoredFrom()
+		return nil
+	}
+	return fmt.Errorf("unknown PostRevision field %s", name)
+}
+
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *PostRevisionMutation) AddedEdges() []string {
+	edges := make([]string, 0, 2)
+	if m.post != nil {
+		edges = append(edges, postrevision.EdgePost)
+	}
+	if m.user != nil {
+		edges = append(edges, postrevision.EdgeUser)
+	}
+	return edges
+}
+
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *PostRevisionMutation) AddedIDs(name string) []ent.Value {
+	switch name {
+	case postrevision.EdgePost:
+		if id := m.post; id != nil {
+			return []ent.Value{*id}
+		}
+	case postrevision.EdgeUser:
+		if id := m.user; id != nil {
+			return []ent.Value{*id}
+		}
+	}
+	return nil
+}
+
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *PostRevisionMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 2)
+	return edges
+}
+
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *PostRevisionMutation) RemovedIDs(name string) []ent.Value {
+	return nil
+}
+
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *PostRevisionMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 2)
+	if m.clearedpost {
+		edges = append(edges, postrevision.EdgePost)
+	}
+	if m.cleareduser {
+		edges = append(edges, postrevision.EdgeUser)
+	}
+	return edges
+}
+
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *PostRevisionMutation) EdgeCleared(name string) bool {
+	switch name {
+	case postrevision.EdgePost:
+		return m.clearedpost
+	case postrevision.EdgeUser:
+		return m.cleareduser
+	}
+	return false
+}
+
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *PostRevisionMutation) ClearEdge(name string) error {
+	switch name {
+	case postrevision.EdgePost:
+		m.ClearPost()
+		return nil
+	case postrevision.EdgeUser:
+		m.ClearUser()
+		return nil
+	}
+	return fmt.Errorf("unknown PostRevision unique edge %s", name)
+}
+
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *PostRevisionMutation) ResetEdge(name string) error {
+	switch name {
+	case postrevision.EdgePost:
+		m.ResetPost()
+		return nil
+	case postrevision.EdgeUser:
+		m.ResetUser()
+		return nil
+	}
+	return fmt.Errorf("unknown PostRevision edge %s", name)
+}
+
+// PostSlugMutation represents an operation that mutates the PostSlug nodes in the graph.
+type PostSlugMutation struct {
+	config
+	op            Op
+	typ           string
+	id            *uint
+	created_at    *time.Time
+	updated_at    *time.Time
+	deleted_at    *time.Time
+	slug          *string
+	clearedFields map[string]struct{}
+	post          *uint
+	clearedpost   bool
+	done          bool
+	oldValue      func(context.Context) (*PostSlug, error)
+	predicates    []predicate.PostSlug
+}
+
+var _ ent.Mutation = (*PostSlugMutation)(nil)
+
+// postslugOption allows management of the mutation configuration using functional options.
+type postslugOption func(*PostSlugMutation)
+
+// newPostSlugMutation creates new mutation for the PostSlug entity.
+func newPostSlugMutation(c config, op Op, opts ...postslugOption) *PostSlugMutation {
+	m := &PostSlugMutation{
+		config:        c,
+		op:            op,
+		typ:           TypePostSlug,
+		clearedFields: make(map[string]struct{}),
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// withPostSlugID sets the ID field of the mutation.
+func withPostSlugID(id uint) postslugOption {
+	return func(m *PostSlugMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *PostSlug
+		)
+		m.oldValue = func(ctx context.Context) (*PostSlug, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().PostSlug.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
+	}
+}
+
+// withPostSlug sets the old PostSlug of the mutation.
+func withPostSlug(node *PostSlug) postslugOption {
+	return func(m *PostSlugMutation) {
+		m.oldValue = func(context.Context) (*PostSlug, error) {
+			return node, nil
+		}
+		m.id = &node.ID
+	}
+}
+
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m PostSlugMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
+}
+
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m PostSlugMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("ent: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
+}
+
+// SetID sets the value of the id field. Note that this
+// operation is only accepted on creation of PostSlug entities.
+func (m *PostSlugMutation) SetID(id uint) {
+	m.id = &id
+}
+
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *PostSlugMutation) ID() (id uint, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
+}
+
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *PostSlugMutation) IDs(ctx context.Context) ([]uint, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []uint{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().PostSlug.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (m *PostSlugMutation) SetCreatedAt(t time.Time) {
+	m.created_at = &t
+}
+
+// CreatedAt returns the value of the "created_at" field in the mutation.
+func (m *PostSlugMutation) CreatedAt() (r time.Time, exists bool) {
+	v := m.created_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCreatedAt returns the old "created_at" field's value of the PostSlug entity.
+// If the PostSlug object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *PostSlugMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCreatedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCreatedAt: %w", err)
+	}
+	return oldValue.CreatedAt, nil
+}
+
+// ResetCreatedAt resets all changes to the "created_at" field.
+func (m *PostSlugMutation) ResetCreatedAt() {
+	m.created_at = nil
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (m *PostSlugMutation) SetUpdatedAt(t time.Time) {
+	m.updated_at = &t
+}
+
+// UpdatedAt returns the value of the "updated_at" field in the mutation.
+func (m *PostSlugMutation) UpdatedAt() (r time.Time, exists bool) {
+	v := m.updated_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldUpdatedAt returns the old "updated_at" field's value of the PostSlug entity.
+// If the PostSlug object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *PostSlugMutation) OldUpdatedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldUpdatedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldUpdatedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldUpdatedAt: %w", err)
+	}
+	return oldValue.UpdatedAt, nil
+}
+
+// ResetUpdatedAt resets all changes to the "updated_at" field.
+func (m *PostSlugMutation) ResetUpdatedAt() {
+	m.updated_at = nil
+}
+
+// SetDeletedAt sets the "deleted_at" field.
+func (m *PostSlugMutation) SetDeletedAt(t time.Time) {
+	m.deleted_at = &t
+}
+
+// DeletedAt returns the value of the "deleted_at" field in the mutation.
+func (m *PostSlugMutation) DeletedAt() (r time.Time, exists bool) {
+	v := m.deleted_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldDeletedAt returns the old "deleted_at" field's value of the PostSlug entity.
+// If the PostSlug object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *PostSlugMutation) OldDeletedAt(ctx context.Context) (v *time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldDeletedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldDeletedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldDeletedAt: %w", err)
+	}
+	return oldValue.DeletedAt, nil
+}
+
+// ClearDeletedAt clears the value of the "deleted_at" field.
+func (m *PostSlugMutation) ClearDeletedAt() {
+	m.deleted_at = nil
+	m.clearedFields[postslug.FieldDeletedAt] = struct{}{}
+}
+
+// DeletedAtCleared returns if the "deleted_at" field was cleared in this mutation.
+func (m *PostSlugMutation) DeletedAtCleared() bool {
+	_, ok := m.clearedFields[postslug.FieldDeletedAt]
+	return ok
+}
+
+// ResetDeletedAt resets all changes to the "deleted_at" field.
+func (m *PostSlugMutation) ResetDeletedAt() {
+	m.deleted_at = nil
+	delete(m.clearedFields, postslug.FieldDeletedAt)
+}
+
+// SetPostID sets the "post_id" field.
+func (m *PostSlugMutation) SetPostID(u uint) {
+	m.post = &u
+}
+
+// PostID returns the value of the "post_id" field in the mutation.
+func (m *PostSlugMutation) PostID() (r uint, exists bool) {
+	v := m.post
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldPostID returns the old "post_id" field's value of the PostSlug entity.
+// If the PostSlug object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *PostSlugMutation) OldPostID(ctx context.Context) (v uint, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldPostID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldPostID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldPostID: %w", err)
+	}
+	return oldValue.PostID, nil
+}
+
+// ResetPostID resets all changes to the "post_id" field.
+func (m *PostSlugMutation) ResetPostID() {
+	m.post = nil
+}
+
+// SetSlug sets the "slug" field.
+func (m *PostSlugMutation) SetSlug(s string) {
+	m.slug = &s
+}
+
+// Slug returns the value of the "slug" field in the mutation.
+func (m *PostSlugMutation) Slug() (r string, exists bool) {
+	v := m.slug
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldSlug returns the old "slug" field's value of the PostSlug entity.
+// If the PostSlug object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *PostSlugMutation) OldSlug(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldSlug is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldSlug requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldSlug: %w", err)
+	}
+	return oldValue.Slug, nil
+}
+
+// ResetSlug resets all changes to the "slug" field.
+func (m *PostSlugMutation) ResetSlug() {
+	m.slug = nil
+}
+
+// ClearPost clears the "post" edge to the Post entity.
+func (m *PostSlugMutation) ClearPost() {
+	m.clearedpost = true
+	m.clearedFields[postslug.FieldPostID] = struct{}{}
+}
+
+// PostCleared reports if the "post" edge to the Post entity was cleared.
+func (m *PostSlugMutation) PostCleared() bool {
+	return m.clearedpost
+}
+
+// PostIDs returns the "post" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// PostID instead. It exists only for internal usage by the builders.
+func (m *PostSlugMutation) PostIDs() (ids []uint) {
+	if id := m.post; id != nil {
+		ids = append(ids, *id)
+	}
+	return
+}
+
+// ResetPost resets all changes to the "post" edge.
+func (m *PostSlugMutation) ResetPost() {
+	m.post = nil
+	m.clearedpost = false
+}
+
+// Where appends a list predicates to the PostSlugMutation builder.
+func (m *PostSlugMutation) Where(ps ...predicate.PostSlug) {
+	m.predicates = append(m.predicates, ps...)
+}
+
+// WhereP appends storage-level predicates to the PostSlugMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *PostSlugMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.PostSlug, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
+	}
+	m.Where(p...)
+}
+
+// Op returns the operation name.
+func (m *PostSlugMutation) Op() Op {
+	return m.op
+}
+
+// SetOp allows setting the mutation operation.
+func (m *PostSlugMutation) SetOp(op Op) {
+	m.op = op
+}
+
+// Type returns the node type of this mutation (PostSlug).
+func (m *PostSlugMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *PostSlugMutation) Fields() []string {
+	fields := make([]string, 0, 5)
+	if m.created_at != nil {
+		fields = append(fields, postslug.FieldCreatedAt)
+	}
+	if m.updated_at != nil {
+		fields = append(fields, postslug.FieldUpdatedAt)
+	}
+	if m.deleted_at != nil {
+		fields = append(fields, postslug.FieldDeletedAt)
+	}
+	if m.post != nil {
+		fields = append(fields, postslug.FieldPostID)
+	}
+	if m.slug != nil {
+		fields = append(fields, postslug.FieldSlug)
+	}
+	return fields
+}
+
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *PostSlugMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case postslug.FieldCreatedAt:
+		return m.CreatedAt()
+	case postslug.FieldUpdatedAt:
+		return m.UpdatedAt()
+	case postslug.FieldDeletedAt:
+		return m.DeletedAt()
+	case postslug.FieldPostID:
+		return m.PostID()
+	case postslug.FieldSlug:
+		return m.Slug()
+	}
+	return nil, false
+}
+
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *PostSlugMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case postslug.FieldCreatedAt:
+		return m.OldCreatedAt(ctx)
+	case postslug.FieldUpdatedAt:
+		return m.OldUpdatedAt(ctx)
+	case postslug.FieldDeletedAt:
+		return m.OldDeletedAt(ctx)
+	case postslug.FieldPostID:
+		return m.OldPostID(ctx)
+	case postslug.FieldSlug:
+		return m.OldSlug(ctx)
+	}
+	return nil, fmt.Errorf("unknown PostSlug field %s", name)
+}
+
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *PostSlugMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case postslug.FieldCreatedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCreatedAt(v)
+		return nil
+	case postslug.FieldUpdatedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetUpdatedAt(v)
+		return nil
+	case postslug.FieldDeletedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetDeletedAt(v)
+		return nil
+	case postslug.FieldPostID:
+		v, ok := value.(uint)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetPostID(v)
+		return nil
+	case postslug.FieldSlug:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetSlug(v)
+		return nil
+	}
+	return fmt.Errorf("unknown PostSlug field %s", name)
+}
+
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *PostSlugMutation) AddedFields() []string {
+	var fields []string
+	return fields
+}
+
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *PostSlugMutation) AddedField(name string) (ent.Value, bool) {
+	switch name {
+	}
+	return nil, false
+}
+
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *PostSlugMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	}
+	return fmt.Errorf("unknown PostSlug numeric field %s", name)
+}
+
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *PostSlugMutation) ClearedFields() []string {
+	var fields []string
+	if m.FieldCleared(postslug.FieldDeletedAt) {
+		fields = append(fields, postslug.FieldDeletedAt)
+	}
+	return fields
+}
+
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *PostSlugMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
+}
+
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *PostSlugMutation) ClearField(name string) error {
+	switch name {
+	case postslug.FieldDeletedAt:
+		m.ClearDeletedAt()
+		return nil
+	}
+	return fmt.Errorf("unknown PostSlug nullable field %s", name)
+}
+
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *PostSlugMutation) ResetField(name string) error {
+	switch name {
+	case postslug.FieldCreatedAt:
+		m.ResetCreatedAt()
+		return nil
+	case postslug.FieldUpdatedAt:
+		m.ResetUpdatedAt()
+		return nil
+	case postslug.FieldDeletedAt:
+		m.ResetDeletedAt()
+		return nil
+	case postslug.FieldPostID:
+		m.ResetPostID()
+		return nil
+	case postslug.FieldSlug:
+		m.ResetSlug()
+		return nil
+	}
+	return fmt.Errorf("unknown PostSlug field %s", name)
+}
+
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *PostSlugMutation) AddedEdges() []string {
+	edges := make([]string, 0, 1)
+	if m.post != nil {
+		edges = append(edges, postslug.EdgePost)
+	}
+	return edges
+}
+
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *PostSlugMutation) AddedIDs(name string) []ent.Value {
+	switch name {
+	case postslug.EdgePost:
+		if id := m.post; id != nil {
+			return []ent.Value{*id}
+		}
+	}
+	return nil
+}
+
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *PostSlugMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 1)
+	return edges
+}
+
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *PostSlugMutation) RemovedIDs(name string) []ent.Value {
+	return nil
+}
+
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *PostSlugMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 1)
+	if m.clearedpost {
+		edges = append(edges, postslug.EdgePost)
+	}
+	return edges
+}
+
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *PostSlugMutation) EdgeCleared(name string) bool {
+	switch name {
+	case postslug.EdgePost:
+		return m.clearedpost
+	}
+	return false
+}
+
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *PostSlugMutation) ClearEdge(name string) error {
+	switch name {
+	case postslug.EdgePost:
+		m.ClearPost()
+		return nil
+	}
+	return fmt.Errorf("unknown PostSlug unique edge %s", name)
+}
+
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *PostSlugMutation) ResetEdge(name string) error {
+	switch name {
+	case postslug.EdgePost:
+		m.ResetPost()
+		return nil
+	}
+	return fmt.Errorf("unknown PostSlug edge %s", name)
+}
+
+// PostTagMutation represents an operation that mutates the PostTag nodes in the graph.
+type PostTagMutation struct {
+	config
+	op            Op
+	typ           string
+	id            *uint
+	created_at    *time.Time
+	updated_at    *time.Time
+	deleted_at    *time.Time
+	name          *string
+	slug          *string
+	clearedFields map[string]struct{}
+	posts         map[uint]struct{}
+	removedposts  map[uint]struct{}
+	clearedposts  bool
+	done          bool
+	oldValue      func(context.Context) (*PostTag, error)
+	predicates    []predicate.PostTag
+}
+
+var _ ent.Mutation = (*PostTagMutation)(nil)
+
+// posttagOption allows management of the mutation configuration using functional options.
+type posttagOption func(*PostTagMutation)
+
+// newPostTagMutation creates new mutation for the PostTag entity.
+func newPostTagMutation(c config, op Op, opts ...posttagOption) *PostTagMutation {
+	m := &PostTagMutation{
+		config:        c,
+		op:            op,
+		typ:           TypePostTag,
+		clearedFields: make(map[string]struct{}),
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// withPostTagID sets the ID field of the mutation.
+func withPostTagID(id uint) posttagOption {
+	return func(m *PostTagMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *PostTag
+		)
+		m.oldValue = func(ctx context.Context) (*PostTag, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().PostTag.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
+	}
+}
+
+// withPostTag sets the old PostTag of the mutation.
+func withPostTag(node *PostTag) posttagOption {
+	return func(m *PostTagMutation) {
+		m.oldValue = func(context.Context) (*PostTag, error) {
+			return node, nil
+		}
+		m.id = &node.ID
+	}
+}
+
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m PostTagMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
+}
+
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m PostTagMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("ent: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
+}
+
+// SetID sets the value of the id field. Note that this
+// operation is only accepted on creation of PostTag entities.
+func (m *PostTagMutation) SetID(id uint) {
+	m.id = &id
+}
+
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *PostTagMutation) ID() (id uint, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
+}
+
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *PostTagMutation) IDs(ctx context.Context) ([]uint, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []uint{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().PostTag.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (m *PostTagMutation) SetCreatedAt(t time.Time) {
+	m.created_at = &t
+}
+
+// CreatedAt returns the value of the "created_at" field in the mutation.
+func (m *PostTagMutation) CreatedAt() (r time.Time, exists bool) {
+	v := m.created_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCreatedAt returns the old "created_at" field's value of the PostTag entity.
+// If the PostTag object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *PostTagMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCreatedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCreatedAt: %w", err)
+	}
+	return oldValue.CreatedAt, nil
+}
+
+// ResetCreatedAt resets all changes to the "created_at" field.
+func (m *PostTagMutation) ResetCreatedAt() {
+	m.created_at = nil
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (m *PostTagMutation) SetUpdatedAt(t time.Time) {
+	m.updated_at = &t
+}
+
+// UpdatedAt returns the value of the "updated_at" field in the mutation.
+func (m *PostTagMutation) UpdatedAt() (r time.Time, exists bool) {
+	v := m.updated_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldUpdatedAt returns the old "updated_at" field's value of the PostTag entity.
+// If the PostTag object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *PostTagMutation) OldUpdatedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldUpdatedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldUpdatedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldUpdatedAt: %w", err)
+	}
+	return oldValue.UpdatedAt, nil
+}
+
+// ResetUpdatedAt resets all changes to the "updated_at" field.
+func (m *PostTagMutation) ResetUpdatedAt() {
+	m.updated_at = nil
+}
+
+// SetDeletedAt sets the "deleted_at" field.
+func (m *PostTagMutation) SetDeletedAt(t time.Time) {
+	m.deleted_at = &t
+}
+
+// DeletedAt returns the value of the "deleted_at" field in the mutation.
+func (m *PostTagMutation) DeletedAt() (r time.Time, exists bool) {
+	v := m.deleted_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldDeletedAt returns the old "deleted_at" field's value of the PostTag entity.
+// If the PostTag object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *PostTagMutation) OldDeletedAt(ctx context.Context) (v *time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldDeletedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldDeletedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldDeletedAt: %w", err)
+	}
+	return oldValue.DeletedAt, nil
+}
+
+// ClearDeletedAt clears the value of the "deleted_at" field.
+func (m *PostTagMutation) ClearDeletedAt() {
+	m.deleted_at = nil
+	m.clearedFields[posttag.FieldDeletedAt] = struct{}{}
+}
+
+// DeletedAtCleared returns if the "deleted_at" field was cleared in this mutation.
+func (m *PostTagMutation) DeletedAtCleared() bool {
+	_, ok := m.clearedFields[posttag.FieldDeletedAt]
+	return ok
+}
+
+// ResetDeletedAt resets all changes to the "deleted_at" field.
+func (m *PostTagMutation) ResetDeletedAt() {
+	m.deleted_at = nil
+	delete(m.clearedFields, posttag.FieldDeletedAt)
+}
+
+// SetName sets the "name" field.
+func (m *PostTagMutation) SetName(s string) {
+	m.name = &s
+}
+
+// Name returns the value of the "name" field in the mutation.
+func (m *PostTagMutation) Name() (r string, exists bool) {
+	v := m.name
+	if v == nil {
+		return
 	}
 	return *v, true
 }
 
-// OldReadTimeMinutes returns the old "read_time_minutes" field's value of the Post entity.
-// If the Post object wasn't provided to the builder, the object is fetched from the database.
+// OldName returns the old "name" field's value of the PostTag entity.
+// If the PostTag object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *PostMutation) OldReadTimeMinutes(ctx context.Context) (v uint, err error) {
+func (m *PostTagMutation) OldName(ctx context.Context) (v string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldReadTimeMinutes is only allowed on UpdateOne operations")
+		return v, errors.New("OldName is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldReadTimeMinutes requires an ID field in the mutation")
+		return v, errors.New("OldName requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldReadTimeMinutes: %w", err)
-	}
-	return oldValue.ReadTimeMinutes, nil
-}
-
-// AddReadTimeMinutes adds u to the "read_time_minutes" field.
-func (m *PostMutation) AddReadTimeMinutes(u int) {
-	if m.addread_time_minutes != nil {
-		*m.addread_time_minutes += u
-	} else {
-		m.addread_time_minutes = &u
-	}
-}
-
-// AddedReadTimeMinutes returns the value that was added to the "read_time_minutes" field in this mutation.
-func (m *PostMutation) AddedReadTimeMinutes() (r int, exists bool) {
-	v := m.addread_time_minutes
-	if v == nil {
-		return
+		return v, fmt.Errorf("querying old value for OldName: %w", err)
 	}
-	return *v, true
+	return oldValue.Name, nil
 }
 
-// ResetReadTimeMinutes resets all changes to the "read_time_minutes" field.
-func (m *PostMutation) ResetReadTimeMinutes() {
-	m.read_time_minutes = nil
-	m.addread_time_minutes = nil
+// ResetName resets all changes to the "name" field.
+func (m *PostTagMutation) ResetName() {
+	m.name = nil
 }
 
-// SetViewCount sets the "view_count" field.
-func (m *PostMutation) SetViewCount(u uint) {
-	m.view_count = &u
-	m.addview_count = nil
+// SetSlug sets the "slug" field.
+func (m *PostTagMutation) SetSlug(s string) {
+	m.slug = &s
 }
 
-// ViewCount returns the value of the "view_count" field in the mutation.
-func (m *PostMutation) ViewCount() (r uint, exists bool) {
-	v := m.view_count
+// Slug returns the value of the "slug" field in the mutation.
+func (m *PostTagMutation) Slug() (r string, exists bool) {
+	v := m.slug
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldViewCount returns the old "view_count" field's value of the Post entity.
-// If the Post object wasn't provided to the builder, the object is fetched from the database.
+// OldSlug returns the old "slug" field's value of the PostTag entity.
+// If the PostTag object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *PostMutation) OldViewCount(ctx context.Context) (v uint, err error) {
+func (m *PostTagMutation) OldSlug(ctx context.Context) (v string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldViewCount is only allowed on UpdateOne operations")
+		return v, errors.New("OldSlug is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldViewCount requires an ID field in the mutation")
+		return v, errors.New("OldSlug requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldViewCount: %w", err)
+		return v, fmt.Errorf("querying old value for OldSlug: %w", err)
+	}
+	return oldValue.Slug, nil
+}
+
+// ResetSlug resets all changes to the "slug" field.
+func (m *PostTagMutation) ResetSlug() {
+	m.slug = nil
+}
+
+// AddPostIDs adds the "posts" edge to the Post entity by ids.
+func (m *PostTagMutation) AddPostIDs(ids ...uint) {
+	if m.posts == nil {
+		m.posts = make(map[uint]struct{})
+	}
+	for i := range ids {
+		m.posts[ids[i]] = struct{}{}
+	}
+}
+
+// ClearPosts clears the "posts" edge to the Post entity.
+func (m *PostTagMutation) ClearPosts() {
+	m.clearedposts = true
+}
+
+// PostsCleared reports if the "posts" edge to the Post entity was cleared.
+func (m *PostTagMutation) PostsCleared() bool {
+	return m.clearedposts
+}
+
+// RemovePostIDs removes the "posts" edge to the Post entity by IDs.
+func (m *PostTagMutation) RemovePostIDs(ids ...uint) {
+	if m.removedposts == nil {
+		m.removedposts = make(map[uint]struct{})
+	}
+	for i := range ids {
+		delete(m.posts, ids[i])
+		m.removedposts[ids[i]] = struct{}{}
+	}
+}
+
+// RemovedPosts returns the removed IDs of the "posts" edge to the Post entity.
+func (m *PostTagMutation) RemovedPostsIDs() (ids []uint) {
+	for id := range m.removedposts {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// PostsIDs returns the "posts" edge IDs in the mutation.
+func (m *PostTagMutation) PostsIDs() (ids []uint) {
+	for id := range m.posts {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ResetPosts resets all changes to the "posts" edge.
+func (m *PostTagMutation) ResetPosts() {
+	m.posts = nil
+	m.clearedposts = false
+	m.removedposts = nil
+}
+
+// Where appends a list predicates to the PostTagMutation builder.
+func (m *PostTagMutation) Where(ps ...predicate.PostTag) {
+	m.predicates = append(m.predicates, ps...)
+}
+
+// WhereP appends storage-level predicates to the PostTagMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *PostTagMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.PostTag, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
+	}
+	m.Where(p...)
+}
+
+// Op returns the operation name.
+func (m *PostTagMutation) Op() Op {
+	return m.op
+}
+
+// SetOp allows setting the mutation operation.
+func (m *PostTagMutation) SetOp(op Op) {
+	m.op = op
+}
+
+// Type returns the node type of this mutation (PostTag).
+func (m *PostTagMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *PostTagMutation) Fields() []string {
+	fields := make([]string, 0, 5)
+	if m.created_at != nil {
+		fields = append(fields, posttag.FieldCreatedAt)
+	}
+	if m.updated_at != nil {
+		fields = append(fields, posttag.FieldUpdatedAt)
+	}
+	if m.deleted_at != nil {
+		fields = append(fields, posttag.FieldDeletedAt)
+	}
+	if m.name != nil {
+		fields = append(fields, posttag.FieldName)
+	}
+	if m.slug != nil {
+		fields = append(fields, posttag.FieldSlug)
+	}
+	return fields
+}
+
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *PostTagMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case posttag.FieldCreatedAt:
+		return m.CreatedAt()
+	case posttag.FieldUpdatedAt:
+		return m.UpdatedAt()
+	case posttag.FieldDeletedAt:
+		return m.DeletedAt()
+	case posttag.FieldName:
+		return m.Name()
+	case posttag.FieldSlug:
+		return m.Slug()
+	}
+	return nil, false
+}
+
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *PostTagMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case posttag.FieldCreatedAt:
+		return m.OldCreatedAt(ctx)
+	case posttag.FieldUpdatedAt:
+		return m.OldUpdatedAt(ctx)
+	case posttag.FieldDeletedAt:
+		return m.OldDeletedAt(ctx)
+	case posttag.FieldName:
+		return m.OldName(ctx)
+	case posttag.FieldSlug:
+		return m.OldSlug(ctx)
+	}
+	return nil, fmt.Errorf("unknown PostTag field %s", name)
+}
+
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *PostTagMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case posttag.FieldCreatedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCreatedAt(v)
+		return nil
+	case posttag.FieldUpdatedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetUpdatedAt(v)
+		return nil
+	case posttag.FieldDeletedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetDeletedAt(v)
+		return nil
+	case posttag.FieldName:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetName(v)
+		return nil
+	case posttag.FieldSlug:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetSlug(v)
+		return nil
 	}
-	return oldValue.ViewCount, nil
+	return fmt.Errorf("unknown PostTag field %s", name)
 }
 
-// AddViewCount adds u to the "view_count" field.
-func (m *PostMutation) AddViewCount(u int) {
-	if m.addview_count != nil {
-		*m.addview_count += u
-	} else {
-		m.addview_count = &u
-	}
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *PostTagMutation) AddedFields() []string {
+	return nil
 }
 
-// AddedViewCount returns the value that was added to the "view_count" field in this mutation.
-func (m *PostMutation) AddedViewCount() (r int, exists bool) {
-	v := m.addview_count
-	if v == nil {
-		return
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *PostTagMutation) AddedField(name string) (ent.Value, bool) {
+	return nil, false
+}
+
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *PostTagMutation) AddField(name string, value ent.Value) error {
+	switch name {
 	}
-	return *v, true
+	return fmt.Errorf("unknown PostTag numeric field %s", name)
 }
 
-// ResetViewCount resets all changes to the "view_count" field.
-func (m *PostMutation) ResetViewCount() {
-	m.view_count = nil
-	m.addview_count = nil
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *PostTagMutation) ClearedFields() []string {
+	var fields []string
+	if m.FieldCleared(posttag.FieldDeletedAt) {
+		fields = append(fields, posttag.FieldDeletedAt)
+	}
+	return fields
 }
 
-// SetStatus sets the "status" field.
-func (m *PostMutation) SetStatus(es entity.PostStatus) {
-	m.status = &es
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *PostTagMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
 }
 
-// Status returns the value of the "status" field in the mutation.
-func (m *PostMutation) Status() (r entity.PostStatus, exists bool) {
-	v := m.status
-	if v == nil {
-		return
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *PostTagMutation) ClearField(name string) error {
+	switch name {
+	case posttag.FieldDeletedAt:
+		m.ClearDeletedAt()
+		return nil
 	}
-	return *v, true
+	return fmt.Errorf("unknown PostTag nullable field %s", name)
 }
 
-// OldStatus returns the old "status" field's value of the Post entity.
-// If the Post object wasn't provided to the builder, the object is fetched from the database.
-// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *PostMutation) OldStatus(ctx context.Context) (v entity.PostStatus, err error) {
-	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldStatus is only allowed on UpdateOne operations")
-	}
-	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldStatus requires an ID field in the mutation")
-	}
-	oldValue, err := m.oldValue(ctx)
-	if err != nil {
-		return v, fmt.Errorf("querying old value for OldStatus: %w", err)
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *PostTagMutation) ResetField(name string) error {
+	switch name {
+	case posttag.FieldCreatedAt:
+		m.ResetCreatedAt()
+		return nil
+	case posttag.FieldUpdatedAt:
+		m.ResetUpdatedAt()
+		return nil
+	case posttag.FieldDeletedAt:
+		m.ResetDeletedAt()
+		return nil
+	case posttag.FieldName:
+		m.ResetName()
+		return nil
+	case posttag.FieldSlug:
+		m.ResetSlug()
+		return nil
 	}
-	return oldValue.Status, nil
+	return fmt.Errorf("unknown PostTag field %s", name)
 }
 
-// ResetStatus resets all changes to the "status" field.
-func (m *PostMutation) ResetStatus() {
-	m.status = nil
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *PostTagMutation) AddedEdges() []string {
+	edges := make([]string, 0, 1)
+	if m.posts != nil {
+		edges = append(edges, posttag.EdgePosts)
+	}
+	return edges
 }
 
-// SetPublishedAt sets the "published_at" field.
-func (m *PostMutation) SetPublishedAt(t time.Time) {
-	m.published_at = &t
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *PostTagMutation) AddedIDs(name string) []ent.Value {
+	switch name {
+	case posttag.EdgePosts:
+		ids := make([]ent.Value, 0, len(m.posts))
+		for id := range m.posts {
+			ids = append(ids, id)
+		}
+		return ids
+	}
+	return nil
 }
 
-// PublishedAt returns the value of the "published_at" field in the mutation.
-func (m *PostMutation) PublishedAt() (r time.Time, exists bool) {
-	v := m.published_at
-	if v == nil {
-		return
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *PostTagMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 1)
+	if m.removedposts != nil {
+		edges = append(edges, posttag.EdgePosts)
 	}
-	return *v, true
+	return edges
 }
 
-// OldPublishedAt returns the old "published_at" field's value of the Post entity.
-// If the Post object wasn't provided to the builder, the object is fetched from the database.
-// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *PostMutation) OldPublishedAt(ctx context.Context) (v *time.Time, err error) {
-	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldPublishedAt is only allowed on UpdateOne operations")
-	}
-	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldPublishedAt requires an ID field in the mutation")
-	}
-	oldValue, err := m.oldValue(ctx)
-	if err != nil {
-		return v, fmt.Errorf("querying old value for OldPublishedAt: %w", err)
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *PostTagMutation) RemovedIDs(name string) []ent.Value {
+	switch name {
+	case posttag.EdgePosts:
+		ids := make([]ent.Value, 0, len(m.removedposts))
+		for id := range m.removedposts {
+			ids = append(ids, id)
+		}
+		return ids
 	}
-	return oldValue.PublishedAt, nil
+	return nil
 }
 
-// ClearPublishedAt clears the value of the "published_at" field.
-func (m *PostMutation) ClearPublishedAt() {
-	m.published_at = nil
-	m.clearedFields[post.FieldPublishedAt] = struct{}{}
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *PostTagMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 1)
+	if m.clearedposts {
+		edges = append(edges, posttag.EdgePosts)
+	}
+	return edges
 }
 
-// PublishedAtCleared returns if the "published_at" field was cleared in this mutation.
-func (m *PostMutation) PublishedAtCleared() bool {
-	_, ok := m.clearedFields[post.FieldPublishedAt]
-	return ok
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *PostTagMutation) EdgeCleared(name string) bool {
+	switch name {
+	case posttag.EdgePosts:
+		return m.clearedposts
+	}
+	return false
 }
 
-// ResetPublishedAt resets all changes to the "published_at" field.
-func (m *PostMutation) ResetPublishedAt() {
-	m.published_at = nil
-	delete(m.clearedFields, post.FieldPublishedAt)
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *PostTagMutation) ClearEdge(name string) error {
+	switch name {
+	}
+	return fmt.Errorf("unknown PostTag unique edge %s", name)
 }
 
-// SetAuthorID sets the "author" edge to the User entity by id.
-func (m *PostMutation) SetAuthorID(id uint) {
-	m.author = &id
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *PostTagMutation) ResetEdge(name string) error {
+	switch name {
+	case posttag.EdgePosts:
+		m.ResetPosts()
+		return nil
+	}
+	return fmt.Errorf("unknown PostTag edge %s", name)
 }
 
-// ClearAuthor clears the "author" edge to the User entity.
-func (m *PostMutation) ClearAuthor() {
-	m.clearedauthor = true
-	m.clearedFields[post.FieldUserID] = struct{}{}
+// PostTagRelationMutation represents an operation that mutates the PostTagRelation nodes in the graph.
+type PostTagRelationMutation struct {
+	config
+	op            Op
+	typ           string
+	clearedFields map[string]struct{}
+	post          *uint
+	clearedpost   bool
+	tag           *uint
+	clearedtag    bool
+	done          bool
+	oldValue      func(context.Context) (*PostTagRelation, error)
+	predicates    []predicate.PostTagRelation
 }
 
-// AuthorCleared reports if the "author" edge to the User entity was cleared.
-func (m *PostMutation) AuthorCleared() bool {
-	return m.clearedauthor
-}
+var _ ent.Mutation = (*PostTagRelationMutation)(nil)
 
-// AuthorID returns the "author" edge ID in the mutation.
-func (m *PostMutation) AuthorID() (id uint, exists bool) {
-	if m.author != nil {
-		return *m.author, true
+// posttagrelationOption allows management of the mutation configuration using functional options.
+type posttagrelationOption func(*PostTagRelationMutation)
+
+// newPostTagRelationMutation creates new mutation for the PostTagRelation entity.
+func newPostTagRelationMutation(c config, op Op, opts ...posttagrelationOption) *PostTagRelationMutation {
+	m := &PostTagRelationMutation{
+		config:        c,
+		op:            op,
+		typ:           TypePostTagRelation,
+		clearedFields: make(map[string]struct{}),
 	}
-	return
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m PostTagRelationMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
 }
 
-// AuthorIDs returns the "author" edge IDs in the mutation.
-// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
-// AuthorID instead. It exists only for internal usage by the builders.
-func (m *PostMutation) AuthorIDs() (ids []uint) {
-	if id := m.author; id != nil {
-		ids = append(ids, *id)
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m PostTagRelationMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("ent: mutation is not running in a transaction")
 	}
-	return
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
 }
 
-// ResetAuthor resets all changes to the "author" edge.
-func (m *PostMutation) ResetAuthor() {
-	m.author = nil
-	m.clearedauthor = false
+// SetPostID sets the "post_id" field.
+func (m *PostTagRelationMutation) SetPostID(u uint) {
+	m.post = &u
 }
 
-// AddCategoryIDs adds the "categories" edge to the PostCategory entity by ids.
-func (m *PostMutation) AddCategoryIDs(ids ...uint) {
-	if m.categories == nil {
-		m.categories = make(map[uint]struct{})
-	}
-	for i := range ids {
-		m.categories[ids[i]] = struct{}{}
+// PostID returns the value of the "post_id" field in the mutation.
+func (m *PostTagRelationMutation) PostID() (r uint, exists bool) {
+	v := m.post
+	if v == nil {
+		return
 	}
+	return *v, true
 }
 
-// ClearCategories clears the "categories" edge to the PostCategory entity.
-func (m *PostMutation) ClearCategories() {
-	m.clearedcategories = true
+// ResetPostID resets all changes to the "post_id" field.
+func (m *PostTagRelationMutation) ResetPostID() {
+	m.post = nil
 }
 
-// CategoriesCleared reports if the "categories" edge to the PostCategory entity was cleared.
-func (m *PostMutation) CategoriesCleared() bool {
-	return m.clearedcategories
+// SetPostTagID sets the "post_tag_id" field.
+func (m *PostTagRelationMutation) SetPostTagID(u uint) {
+	m.tag = &u
 }
 
-// RemoveCategoryIDs removes the "categories" edge to the PostCategory entity by IDs.
-func (m *PostMutation) RemoveCategoryIDs(ids ...uint) {
-	if m.removedcategories == nil {
-		m.removedcategories = make(map[uint]struct{})
-	}
-	for i := range ids {
-		delete(m.categories, ids[i])
-		m.removedcategories[ids[i]] = struct{}{}
+// PostTagID returns the value of the "post_tag_id" field in the mutation.
+func (m *PostTagRelationMutation) PostTagID() (r uint, exists bool) {
+	v := m.tag
+	if v == nil {
+		return
 	}
+	return *v, true
 }
 
-// RemovedCategories returns the removed IDs of the "categories" edge to the PostCategory entity.
-func (m *PostMutation) RemovedCategoriesIDs() (ids []uint) {
-	for id := range m.removedcategories {
-		ids = append(ids, id)
-	}
-	return
+// ResetPostTagID resets all changes to the "post_tag_id" field.
+func (m *PostTagRelationMutation) ResetPostTagID() {
+	m.tag = nil
 }
 
-// CategoriesIDs returns the "categories" edge IDs in the mutation.
-func (m *PostMutation) CategoriesIDs() (ids []uint) {
-	for id := range m.categories {
-		ids = append(ids, id)
-	}
-	return
+// ClearPost clears the "post" edge to the Post entity.
+func (m *PostTagRelationMutation) ClearPost() {
+	m.clearedpost = true
+	m.clearedFields[posttagrelation.FieldPostID] = struct{}{}
 }
 
-// ResetCategories resets all changes to the "categories" edge.
-func (m *PostMutation) ResetCategories() {
-	m.categories = nil
-	m.clearedcategories = false
-	m.removedcategories = nil
+// PostCleared reports if the "post" edge to the Post entity was cleared.
+func (m *PostTagRelationMutation) PostCleared() bool {
+	return m.clearedpost
 }
 
-// AddTagIDs adds the "tags" edge to the PostTag entity by ids.
-func (m *PostMutation) AddTagIDs(ids ...uint) {
-	if m.tags == nil {
-		m.tags = make(map[uint]struct{})
-	}
-	for i := range ids {
-		m.tags[ids[i]] = struct{}{}
+// PostIDs returns the "post" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// PostID instead. It exists only for internal usage by the builders.
+func (m *PostTagRelationMutation) PostIDs() (ids []uint) {
+	if id := m.post; id != nil {
+		ids = append(ids, *id)
 	}
+	return
 }
 
-// ClearTags clears the "tags" edge to the PostTag entity.
-func (m *PostMutation) ClearTags() {
-	m.clearedtags = true
+// ResetPost resets all changes to the "post" edge.
+func (m *PostTagRelationMutation) ResetPost() {
+	m.post = nil
+	m.clearedpost = false
 }
 
-// TagsCleared reports if the "tags" edge to the PostTag entity was cleared.
-func (m *PostMutation) TagsCleared() bool {
-	return m.clearedtags
+// SetTagID sets the "tag" edge to the PostTag entity by id.
+func (m *PostTagRelationMutation) SetTagID(id uint) {
+	m.tag = &id
 }
 
-// RemoveTagIDs removes the "tags" edge to the PostTag entity by IDs.
-func (m *PostMutation) RemoveTagIDs(ids ...uint) {
-	if m.removedtags == nil {
-		m.removedtags = make(map[uint]struct{})
-	}
-	for i := range ids {
-		delete(m.tags, ids[i])
-		m.removedtags[ids[i]] = struct{}{}
-	}
+// ClearTag clears the "tag" edge to the PostTag entity.
+func (m *PostTagRelationMutation) ClearTag() {
+	m.clearedtag = true
+	m.clearedFields[posttagrelation.FieldPostTagID] = struct{}{}
 }
 
-// RemovedTags returns the removed IDs of the "tags" edge to the PostTag entity.
-func (m *PostMutation) RemovedTagsIDs() (ids []uint) {
-	for id := range m.removedtags {
-		ids = append(ids, id)
+// TagCleared reports if the "tag" edge to the PostTag entity was cleared.
+func (m *PostTagRelationMutation) TagCleared() bool {
+	return m.clearedtag
+}
+
+// TagID returns the "tag" edge ID in the mutation.
+func (m *PostTagRelationMutation) TagID() (id uint, exists bool) {
+	if m.tag != nil {
+		return *m.tag, true
 	}
 	return
 }
 
-// TagsIDs returns the "tags" edge IDs in the mutation.
-func (m *PostMutation) TagsIDs() (ids []uint) {
-	for id := range m.tags {
-		ids = append(ids, id)
+// TagIDs returns the "tag" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// TagID instead. It exists only for internal usage by the builders.
+func (m *PostTagRelationMutation) TagIDs() (ids []uint) {
+	if id := m.tag; id != nil {
+		ids = append(ids, *id)
 	}
 	return
 }
 
-// ResetTags resets all changes to the "tags" edge.
-func (m *PostMutation) ResetTags() {
-	m.tags = nil
-	m.clearedtags = false
-	m.removedtags = nil
+// ResetTag resets all changes to the "tag" edge.
+func (m *PostTagRelationMutation) ResetTag() {
+	m.tag = nil
+	m.clearedtag = false
 }
 
-// Where appends a list predicates to the PostMutation builder.
-func (m *PostMutation) Where(ps ...predicate.Post) {
+// Where appends a list predicates to the PostTagRelationMutation builder.
+func (m *PostTagRelationMutation) Where(ps ...predicate.PostTagRelation) {
 	m.predicates = append(m.predicates, ps...)
 }
 
-// WhereP appends storage-level predicates to the PostMutation builder. Using this method,
+// WhereP appends storage-level predicates to the PostTagRelationMutation builder. Using this method,
 // users can use type-assertion to append predicates that do not depend on any generated package.
-func (m *PostMutation) WhereP(ps ...func(*sql.Selector)) {
-	p := make([]predicate.Post, len(ps))
+func (m *PostTagRelationMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.PostTagRelation, len(ps))
 	for i := range ps {
 		p[i] = ps[i]
 	}
@@ -3072,93 +10853,43 @@ func (m *PostMutation) WhereP(ps ...func(*sql.Selector)) {
 }
 
 // Op returns the operation name.
-func (m *PostMutation) Op() Op {
+func (m *PostTagRelationMutation) Op() Op {
 	return m.op
 }
 
 // SetOp allows setting the mutation operation.
-func (m *PostMutation) SetOp(op Op) {
+func (m *PostTagRelationMutation) SetOp(op Op) {
 	m.op = op
 }
 
-// Type returns the node type of this mutation (Post).
-func (m *PostMutation) Type() string {
+// Type returns the node type of this mutation (PostTagRelation).
+func (m *PostTagRelationMutation) Type() string {
 	return m.typ
-}
-
-// Fields returns all fields that were changed during this mutation. Note that in
-// order to get all numeric fields that were incremented/decremented, call
-// AddedFields().
-func (m *PostMutation) Fields() []string {
-	fields := make([]string, 0, 12)
-	if m.created_at != nil {
-		fields = append(fields, post.FieldCreatedAt)
-	}
-	if m.updated_at != nil {
-		fields = append(fields, post.FieldUpdatedAt)
-	}
-	if m.deleted_at != nil {
-		fields = append(fields, post.FieldDeletedAt)
-	}
-	if m.author != nil {
-		fields = append(fields, post.FieldUserID)
-	}
-	if m.title != nil {
-		fields = append(fields, post.FieldTitle)
-	}
-	if m.summary != nil {
-		fields = append(fields, post.FieldSummary)
-	}
-	if m.content != nil {
-		fields = append(fields, post.FieldContent)
-	}
-	if m.cover != nil {
-		fields = append(fields, post.FieldCover)
-	}
-	if m.read_time_minutes != nil {
-		fields = append(fields, post.FieldReadTimeMinutes)
-	}
-	if m.view_count != nil {
-		fields = append(fields, post.FieldViewCount)
-	}
-	if m.status != nil {
-		fields = append(fields, post.FieldStatus)
-	}
-	if m.published_at != nil {
-		fields = append(fields, post.FieldPublishedAt)
-	}
-	return fields
-}
-
-// Field returns the value of a field with the given name. The second boolean
-// return value indicates that this field was not set, or was not defined in the
-// schema.
-func (m *PostMutation) Field(name string) (ent.Value, bool) {
-	switch name {
-	case post.FieldCreatedAt:
-		return m.CreatedAt()
-	case post.FieldUpdatedAt:
-		return m.UpdatedAt()
-	case post.FieldDeletedAt:
-		return m.DeletedAt()
-	case post.FieldUserID:
-		return m.UserID()
-	case post.FieldTitle:
-		return m.Title()
-	case post.FieldSummary:
-		return m.Summary()
-	case post.FieldContent:
-		return m.Content()
-	case post.FieldCover:
-		return m.Cover()
-	case post.FieldReadTimeMinutes:
-		return m.ReadTimeMinutes()
-	case post.FieldViewCount:
-		return m.ViewCount()
-	case post.FieldStatus:
-		return m.Status()
-	case post.FieldPublishedAt:
-		return m.PublishedAt()
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *PostTagRelationMutation) Fields() []string {
+	fields := make([]string, 0, 2)
+	if m.post != nil {
+		fields = append(fields, posttagrelation.FieldPostID)
+	}
+	if m.tag != nil {
+		fields = append(fields, posttagrelation.FieldPostTagID)
+	}
+	return fields
+}
+
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *PostTagRelationMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case posttagrelation.FieldPostID:
+		return m.PostID()
+	case posttagrelation.FieldPostTagID:
+		return m.PostTagID()
 	}
 	return nil, false
 }
@@ -3166,151 +10897,45 @@ func (m *PostMutation) Field(name string) (ent.Value, bool) {
 // OldField returns the old value of the field from the database. An error is
 // returned if the mutation operation is not UpdateOne, or the query to the
 // database failed.
-func (m *PostMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
-	switch name {
-	case post.FieldCreatedAt:
-		return m.OldCreatedAt(ctx)
-	case post.FieldUpdatedAt:
-		return m.OldUpdatedAt(ctx)
-	case post.FieldDeletedAt:
-		return m.OldDeletedAt(ctx)
-	case post.FieldUserID:
-		return m.OldUserID(ctx)
-	case post.FieldTitle:
-		return m.OldTitle(ctx)
-	case post.FieldSummary:
-		return m.OldSummary(ctx)
-	case post.FieldContent:
-		return m.OldContent(ctx)
-	case post.FieldCover:
-		return m.OldCover(ctx)
-	case post.FieldReadTimeMinutes:
-		return m.OldReadTimeMinutes(ctx)
-	case post.FieldViewCount:
-		return m.OldViewCount(ctx)
-	case post.FieldStatus:
-		return m.OldStatus(ctx)
-	case post.FieldPublishedAt:
-		return m.OldPublishedAt(ctx)
-	}
-	return nil, fmt.Errorf("unknown Post field %s", name)
+func (m *PostTagRelationMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	return nil, errors.New("edge schema PostTagRelation does not support getting old values")
 }
 
 // SetField sets the value of a field with the given name. It returns an error if
 // the field is not defined in the schema, or if the type mismatched the field
 // type.
-func (m *PostMutation) SetField(name string, value ent.Value) error {
+func (m *PostTagRelationMutation) SetField(name string, value ent.Value) error {
 	switch name {
-	case post.FieldCreatedAt:
-		v, ok := value.(time.Time)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetCreatedAt(v)
-		return nil
-	case post.FieldUpdatedAt:
-		v, ok := value.(time.Time)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetUpdatedAt(v)
-		return nil
-	case post.FieldDeletedAt:
-		v, ok := value.(time.Time)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetDeletedAt(v)
-		return nil
-	case post.FieldUserID:
-		v, ok := value.(uint)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetUserID(v)
-		return nil
-	case post.FieldTitle:
-		v, ok := value.(string)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetTitle(v)
-		return nil
-	case post.FieldSummary:
-		v, ok := value.(string)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetSummary(v)
-		return nil
-	case post.FieldContent:
-		v, ok := value.(string)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetContent(v)
-		return nil
-	case post.FieldCover:
-		v, ok := value.(string)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetCover(v)
-		return nil
-	case post.FieldReadTimeMinutes:
+	case posttagrelation.FieldPostID:
 		v, ok := value.(uint)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetReadTimeMinutes(v)
+		m.SetPostID(v)
 		return nil
-	case post.FieldViewCount:
+	case posttagrelation.FieldPostTagID:
 		v, ok := value.(uint)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetViewCount(v)
-		return nil
-	case post.FieldStatus:
-		v, ok := value.(entity.PostStatus)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetStatus(v)
-		return nil
-	case post.FieldPublishedAt:
-		v, ok := value.(time.Time)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetPublishedAt(v)
+		m.SetPostTagID(v)
 		return nil
 	}
-	return fmt.Errorf("unknown Post field %s", name)
+	return fmt.Errorf("unknown PostTagRelation field %s", name)
 }
 
 // AddedFields returns all numeric fields that were incremented/decremented during
 // this mutation.
-func (m *PostMutation) AddedFields() []string {
+func (m *PostTagRelationMutation) AddedFields() []string {
 	var fields []string
-	if m.addread_time_minutes != nil {
-		fields = append(fields, post.FieldReadTimeMinutes)
-	}
-	if m.addview_count != nil {
-		fields = append(fields, post.FieldViewCount)
-	}
 	return fields
 }
 
 // AddedField returns the numeric value that was incremented/decremented on a field
 // with the given name. The second boolean return value indicates that this field
 // was not set, or was not defined in the schema.
-func (m *PostMutation) AddedField(name string) (ent.Value, bool) {
+func (m *PostTagRelationMutation) AddedField(name string) (ent.Value, bool) {
 	switch name {
-	case post.FieldReadTimeMinutes:
-		return m.AddedReadTimeMinutes()
-	case post.FieldViewCount:
-		return m.AddedViewCount()
 	}
 	return nil, false
 }
@@ -3318,246 +10943,139 @@ func (m *PostMutation) AddedField(name string) (ent.Value, bool) {
 // AddField adds the value to the field with the given name. It returns an error if
 // the field is not defined in the schema, or if the type mismatched the field
 // type.
-func (m *PostMutation) AddField(name string, value ent.Value) error {
+func (m *PostTagRelationMutation) AddField(name string, value ent.Value) error {
 	switch name {
-	case post.FieldReadTimeMinutes:
-		v, ok := value.(int)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.AddReadTimeMinutes(v)
-		return nil
-	case post.FieldViewCount:
-		v, ok := value.(int)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.AddViewCount(v)
-		return nil
 	}
-	return fmt.Errorf("unknown Post numeric field %s", name)
+	return fmt.Errorf("unknown PostTagRelation numeric field %s", name)
 }
 
 // ClearedFields returns all nullable fields that were cleared during this
 // mutation.
-func (m *PostMutation) ClearedFields() []string {
-	var fields []string
-	if m.FieldCleared(post.FieldDeletedAt) {
-		fields = append(fields, post.FieldDeletedAt)
-	}
-	if m.FieldCleared(post.FieldSummary) {
-		fields = append(fields, post.FieldSummary)
-	}
-	if m.FieldCleared(post.FieldCover) {
-		fields = append(fields, post.FieldCover)
-	}
-	if m.FieldCleared(post.FieldPublishedAt) {
-		fields = append(fields, post.FieldPublishedAt)
-	}
-	return fields
+func (m *PostTagRelationMutation) ClearedFields() []string {
+	return nil
 }
 
 // FieldCleared returns a boolean indicating if a field with the given name was
 // cleared in this mutation.
-func (m *PostMutation) FieldCleared(name string) bool {
+func (m *PostTagRelationMutation) FieldCleared(name string) bool {
 	_, ok := m.clearedFields[name]
 	return ok
 }
 
 // ClearField clears the value of the field with the given name. It returns an
 // error if the field is not defined in the schema.
-func (m *PostMutation) ClearField(name string) error {
-	switch name {
-	case post.FieldDeletedAt:
-		m.ClearDeletedAt()
-		return nil
-	case post.FieldSummary:
-		m.ClearSummary()
-		return nil
-	case post.FieldCover:
-		m.ClearCover()
-		return nil
-	case post.FieldPublishedAt:
-		m.ClearPublishedAt()
-		return nil
-	}
-	return fmt.Errorf("unknown Post nullable field %s", name)
+func (m *PostTagRelationMutation) ClearField(name string) error {
+	return fmt.Errorf("unknown PostTagRelation nullable field %s", name)
 }
 
 // ResetField resets all changes in the mutation for the field with the given name.
 // It returns an error if the field is not defined in the schema.
-func (m *PostMutation) ResetField(name string) error {
+func (m *PostTagRelationMutation) ResetField(name string) error {
 	switch name {
-	case post.FieldCreatedAt:
-		m.ResetCreatedAt()
-		return nil
-	case post.FieldUpdatedAt:
-		m.ResetUpdatedAt()
-		return nil
-	case post.FieldDeletedAt:
-		m.ResetDeletedAt()
-		return nil
-	case post.FieldUserID:
-		m.ResetUserID()
-		return nil
-	case post.FieldTitle:
-		m.ResetTitle()
-		return nil
-	case post.FieldSummary:
-		m.ResetSummary()
-		return nil
-	case post.FieldContent:
-		m.ResetContent()
-		return nil
-	case post.FieldCover:
-		m.ResetCover()
-		return nil
-	case post.FieldReadTimeMinutes:
-		m.ResetReadTimeMinutes()
-		return nil
-	case post.FieldViewCount:
-		m.ResetViewCount()
-		return nil
-	case post.FieldStatus:
-		m.ResetStatus()
+	case posttagrelation.FieldPostID:
+		m.ResetPostID()
 		return nil
-	case post.FieldPublishedAt:
-		m.ResetPublishedAt()
+	case posttagrelation.FieldPostTagID:
+		m.ResetPostTagID()
 		return nil
 	}
-	return fmt.Errorf("unknown Post field %s", name)
-}
-
-// AddedEdges returns all edge names that were set/added in this mutation.
-func (m *PostMutation) AddedEdges() []string {
-	edges := make([]string, 0, 3)
-	if m.author != nil {
-		edges = append(edges, post.EdgeAuthor)
-	}
-	if m.categories != nil {
-		edges = append(edges, post.EdgeCategories)
+	return fmt.Errorf("unknown PostTagRelation field %s", name)
+}
+
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *PostTagRelationMutation) AddedEdges() []string {
+	edges := make([]string, 0, 2)
+	if m.post != nil {
+		edges = append(edges, posttagrelation.EdgePost)
 	}
-	if m.tags != nil {
-		edges = append(edges, post.EdgeTags)
+	if m.tag != nil {
+		edges = append(edges, posttagrelation.EdgeTag)
 	}
 	return edges
 }
 
 // AddedIDs returns all IDs (to other nodes) that were added for the given edge
 // name in this mutation.
-func (m *PostMutation) AddedIDs(name string) []ent.Value {
+func (m *PostTagRelationMutation) AddedIDs(name string) []ent.Value {
 	switch name {
-	case post.EdgeAuthor:
-		if id := m.author; id != nil {
+	case posttagrelation.EdgePost:
+		if id := m.post; id != nil {
 			return []ent.Value{*id}
 		}
-	case post.EdgeCategories:
-		ids := make([]ent.Value, 0, len(m.categories))
-		for id := range m.categories {
-			ids = append(ids, id)
-		}
-		return ids
-	case post.EdgeTags:
-		ids := make([]ent.Value, 0, len(m.tags))
-		for id := range m.tags {
-			ids = append(ids, id)
+	case posttagrelation.EdgeTag:
+		if id := m.tag; id != nil {
+			return []ent.Value{*id}
 		}
-		return ids
 	}
 	return nil
 }
 
 // RemovedEdges returns all edge names that were removed in this mutation.
-func (m *PostMutation) RemovedEdges() []string {
-	edges := make([]string, 0, 3)
-	if m.removedcategories != nil {
-		edges = append(edges, post.EdgeCategories)
-	}
-	if m.removedtags != nil {
-		edges = append(edges, post.EdgeTags)
-	}
+func (m *PostTagRelationMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 2)
 	return edges
 }
 
 // RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
 // the given name in this mutation.
-func (m *PostMutation) RemovedIDs(name string) []ent.Value {
-	switch name {
-	case post.EdgeCategories:
-		ids := make([]ent.Value, 0, len(m.removedcategories))
-		for id := range m.removedcategories {
-			ids = append(ids, id)
-		}
-		return ids
-	case post.EdgeTags:
-		ids := make([]ent.Value, 0, len(m.removedtags))
-		for id := range m.removedtags {
-			ids = append(ids, id)
-		}
-		return ids
-	}
+func (m *PostTagRelationMutation) RemovedIDs(name string) []ent.Value {
 	return nil
 }
 
 // ClearedEdges returns all edge names that were cleared in this mutation.
-func (m *PostMutation) ClearedEdges() []string {
-	edges := make([]string, 0, 3)
-	if m.clearedauthor {
-		edges = append(edges, post.EdgeAuthor)
-	}
-	if m.clearedcategories {
-		edges = append(edges, post.EdgeCategories)
+func (m *PostTagRelationMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 2)
+	if m.clearedpost {
+		edges = append(edges, posttagrelation.EdgePost)
 	}
-	if m.clearedtags {
-		edges = append(edges, post.EdgeTags)
+	if m.clearedtag {
+		edges = append(edges, posttagrelation.EdgeTag)
 	}
 	return edges
 }
 
 // EdgeCleared returns a boolean which indicates if the edge with the given name
 // was cleared in this mutation.
-func (m *PostMutation) EdgeCleared(name string) bool {
+func (m *PostTagRelationMutation) EdgeCleared(name string) bool {
 	switch name {
-	case post.EdgeAuthor:
-		return m.clearedauthor
-	case post.EdgeCategories:
-		return m.clearedcategories
-	case post.EdgeTags:
-		return m.clearedtags
+	case posttagrelation.EdgePost:
+		return m.clearedpost
+	case posttagrelation.EdgeTag:
+		return m.clearedtag
 	}
 	return false
 }
 
 // ClearEdge clears the value of the edge with the given name. It returns an error
 // if that edge is not defined in the schema.
-func (m *PostMutation) ClearEdge(name string) error {
+func (m *PostTagRelationMutation) ClearEdge(name string) error {
 	switch name {
-	case post.EdgeAuthor:
-		m.ClearAuthor()
+	case posttagrelation.EdgePost:
+		m.ClearPost()
+		return nil
+	case posttagrelation.EdgeTag:
+		m.ClearTag()
 		return nil
 	}
-	return fmt.Errorf("unknown Post unique edge %s", name)
+	return fmt.Errorf("unknown PostTagRelation unique edge %s", name)
 }
 
 // ResetEdge resets all changes to the edge with the given name in this mutation.
 // It returns an error if the edge is not defined in the schema.
-func (m *PostMutation) ResetEdge(name string) error {
+func (m *PostTagRelationMutation) ResetEdge(name string) error {
 	switch name {
-	case post.EdgeAuthor:
-		m.ResetAuthor()
-		return nil
-	case post.EdgeCategories:
-		m.ResetCategories()
+	case posttagrelation.EdgePost:
+		m.ResetPost()
 		return nil
-	case post.EdgeTags:
-		m.ResetTags()
+	case posttagrelation.EdgeTag:
+		m.ResetTag()
 		return nil
 	}
-	return fmt.Errorf("unknown Post edge %s", name)
+	return fmt.Errorf("unknown PostTagRelation edge %s", name)
 }
 
-// PostCategoryMutation represents an operation that mutates the PostCategory nodes in the graph.
-type PostCategoryMutation struct {
+// SigningKeyMutation represents an operation that mutates the SigningKey nodes in the graph.
+type SigningKeyMutation struct {
 	config
 	op            Op
 	typ           string
@@ -3565,28 +11083,29 @@ type PostCategoryMutation struct {
 	created_at    *time.Time
 	updated_at    *time.Time
 	deleted_at    *time.Time
-	name          *string
-	slug          *string
+	kid           *string
+	algorithm     *string
+	private_key   *string
+	public_key    *string
+	activates_at  *time.Time
+	retired_at    *time.Time
 	clearedFields map[string]struct{}
-	posts         map[uint]struct{}
-	removedposts  map[uint]struct{}
-	clearedposts  bool
 	done          bool
-	oldValue      func(context.Context) (*PostCategory, error)
-	predicates    []predicate.PostCategory
+	oldValue      func(context.Context) (*SigningKey, error)
+	predicates    []predicate.SigningKey
 }
 
-var _ ent.Mutation = (*PostCategoryMutation)(nil)
+var _ ent.Mutation = (*SigningKeyMutation)(nil)
 
-// postcategoryOption allows management of the mutation configuration using functional options.
-type postcategoryOption func(*PostCategoryMutation)
+// signingkeyOption allows management of the mutation configuration using functional options.
+type signingkeyOption func(*SigningKeyMutation)
 
-// newPostCategoryMutation creates new mutation for the PostCategory entity.
-func newPostCategoryMutation(c config, op Op, opts ...postcategoryOption) *PostCategoryMutation {
-	m := &PostCategoryMutation{
+// newSigningKeyMutation creates new mutation for the SigningKey entity.
+func newSigningKeyMutation(c config, op Op, opts ...signingkeyOption) *SigningKeyMutation {
+	m := &SigningKeyMutation{
 		config:        c,
 		op:            op,
-		typ:           TypePostCategory,
+		typ:           TypeSigningKey,
 		clearedFields: make(map[string]struct{}),
 	}
 	for _, opt := range opts {
@@ -3595,20 +11114,20 @@ func newPostCategoryMutation(c config, op Op, opts ...postcategoryOption) *PostC
 	return m
 }
 
-// withPostCategoryID sets the ID field of the mutation.
-func withPostCategoryID(id uint) postcategoryOption {
-	return func(m *PostCategoryMutation) {
+// withSigningKeyID sets the ID field of the mutation.
+func withSigningKeyID(id uint) signingkeyOption {
+	return func(m *SigningKeyMutation) {
 		var (
 			err   error
 			once  sync.Once
-			value *PostCategory
+			value *SigningKey
 		)
-		m.oldValue = func(ctx context.Context) (*PostCategory, error) {
+		m.oldValue = func(ctx context.Context) (*SigningKey, error) {
 			once.Do(func() {
 				if m.done {
 					err = errors.New("querying old values post mutation is not allowed")
 				} else {
-					value, err = m.Client().PostCategory.Get(ctx, id)
+					value, err = m.Client().SigningKey.Get(ctx, id)
 				}
 			})
 			return value, err
@@ -3617,10 +11136,10 @@ func withPostCategoryID(id uint) postcategoryOption {
 	}
 }
 
-// withPostCategory sets the old PostCategory of the mutation.
-func withPostCategory(node *PostCategory) postcategoryOption {
-	return func(m *PostCategoryMutation) {
-		m.oldValue = func(context.Context) (*PostCategory, error) {
+// withSigningKey sets the old SigningKey of the mutation.
+func withSigningKey(node *SigningKey) signingkeyOption {
+	return func(m *SigningKeyMutation) {
+		m.oldValue = func(context.Context) (*SigningKey, error) {
 			return node, nil
 		}
 		m.id = &node.ID
@@ -3629,7 +11148,7 @@ func withPostCategory(node *PostCategory) postcategoryOption {
 
 // Client returns a new `ent.Client` from the mutation. If the mutation was
 // executed in a transaction (ent.Tx), a transactional client is returned.
-func (m PostCategoryMutation) Client() *Client {
+func (m SigningKeyMutation) Client() *Client {
 	client := &Client{config: m.config}
 	client.init()
 	return client
@@ -3637,7 +11156,7 @@ func (m PostCategoryMutation) Client() *Client {
 
 // Tx returns an `ent.Tx` for mutations that were executed in transactions;
 // it returns an error otherwise.
-func (m PostCategoryMutation) Tx() (*Tx, error) {
+func (m SigningKeyMutation) Tx() (*Tx, error) {
 	if _, ok := m.driver.(*txDriver); !ok {
 		return nil, errors.New("ent: mutation is not running in a transaction")
 	}
@@ -3647,14 +11166,14 @@ func (m PostCategoryMutation) Tx() (*Tx, error) {
 }
 
 // SetID sets the value of the id field. Note that this
-// operation is only accepted on creation of PostCategory entities.
-func (m *PostCategoryMutation) SetID(id uint) {
+// operation is only accepted on creation of SigningKey entities.
+func (m *SigningKeyMutation) SetID(id uint) {
 	m.id = &id
 }
 
 // ID returns the ID value in the mutation. Note that the ID is only available
 // if it was provided to the builder or after it was returned from the database.
-func (m *PostCategoryMutation) ID() (id uint, exists bool) {
+func (m *SigningKeyMutation) ID() (id uint, exists bool) {
 	if m.id == nil {
 		return
 	}
@@ -3665,7 +11184,7 @@ func (m *PostCategoryMutation) ID() (id uint, exists bool) {
 // That means, if the mutation is applied within a transaction with an isolation level such
 // as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
 // or updated by the mutation.
-func (m *PostCategoryMutation) IDs(ctx context.Context) ([]uint, error) {
+func (m *SigningKeyMutation) IDs(ctx context.Context) ([]uint, error) {
 	switch {
 	case m.op.Is(OpUpdateOne | OpDeleteOne):
 		id, exists := m.ID()
@@ -3674,19 +11193,19 @@ func (m *PostCategoryMutation) IDs(ctx context.Context) ([]uint, error) {
 		}
 		fallthrough
 	case m.op.Is(OpUpdate | OpDelete):
-		return m.Client().PostCategory.Query().Where(m.predicates...).IDs(ctx)
+		return m.Client().SigningKey.Query().Where(m.predicates...).IDs(ctx)
 	default:
 		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
 	}
 }
 
 // SetCreatedAt sets the "created_at" field.
-func (m *PostCategoryMutation) SetCreatedAt(t time.Time) {
+func (m *SigningKeyMutation) SetCreatedAt(t time.Time) {
 	m.created_at = &t
 }
 
 // CreatedAt returns the value of the "created_at" field in the mutation.
-func (m *PostCategoryMutation) CreatedAt() (r time.Time, exists bool) {
+func (m *SigningKeyMutation) CreatedAt() (r time.Time, exists bool) {
 	v := m.created_at
 	if v == nil {
 		return
@@ -3694,10 +11213,10 @@ func (m *PostCategoryMutation) CreatedAt() (r time.Time, exists bool) {
 	return *v, true
 }
 
-// OldCreatedAt returns the old "created_at" field's value of the PostCategory entity.
-// If the PostCategory object wasn't provided to the builder, the object is fetched from the database.
+// OldCreatedAt returns the old "created_at" field's value of the SigningKey entity.
+// If the SigningKey object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *PostCategoryMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
+func (m *SigningKeyMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
 	if !m.op.Is(OpUpdateOne) {
 		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
 	}
@@ -3712,17 +11231,17 @@ func (m *PostCategoryMutation) OldCreatedAt(ctx context.Context) (v time.Time, e
 }
 
 // ResetCreatedAt resets all changes to the "created_at" field.
-func (m *PostCategoryMutation) ResetCreatedAt() {
+func (m *SigningKeyMutation) ResetCreatedAt() {
 	m.created_at = nil
 }
 
 // SetUpdatedAt sets the "updated_at" field.
-func (m *PostCategoryMutation) SetUpdatedAt(t time.Time) {
+func (m *SigningKeyMutation) SetUpdatedAt(t time.Time) {
 	m.updated_at = &t
 }
 
 // UpdatedAt returns the value of the "updated_at" field in the mutation.
-func (m *PostCategoryMutation) UpdatedAt() (r time.Time, exists bool) {
+func (m *SigningKeyMutation) UpdatedAt() (r time.Time, exists bool) {
 	v := m.updated_at
 	if v == nil {
 		return
@@ -3730,10 +11249,10 @@ func (m *PostCategoryMutation) UpdatedAt() (r time.Time, exists bool) {
 	return *v, true
 }
 
-// OldUpdatedAt returns the old "updated_at" field's value of the PostCategory entity.
-// If the PostCategory object wasn't provided to the builder, the object is fetched from the database.
+// OldUpdatedAt returns the old "updated_at" field's value of the SigningKey entity.
+// If the SigningKey object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *PostCategoryMutation) OldUpdatedAt(ctx context.Context) (v time.Time, err error) {
+func (m *SigningKeyMutation) OldUpdatedAt(ctx context.Context) (v time.Time, err error) {
 	if !m.op.Is(OpUpdateOne) {
 		return v, errors.New("OldUpdatedAt is only allowed on UpdateOne operations")
 	}
@@ -3748,17 +11267,17 @@ func (m *PostCategoryMutation) OldUpdatedAt(ctx context.Context) (v time.Time, e
 }
 
 // ResetUpdatedAt resets all changes to the "updated_at" field.
-func (m *PostCategoryMutation) ResetUpdatedAt() {
+func (m *SigningKeyMutation) ResetUpdatedAt() {
 	m.updated_at = nil
 }
 
 // SetDeletedAt sets the "deleted_at" field.
-func (m *PostCategoryMutation) SetDeletedAt(t time.Time) {
+func (m *SigningKeyMutation) SetDeletedAt(t time.Time) {
 	m.deleted_at = &t
 }
 
 // DeletedAt returns the value of the "deleted_at" field in the mutation.
-func (m *PostCategoryMutation) DeletedAt() (r time.Time, exists bool) {
+func (m *SigningKeyMutation) DeletedAt() (r time.Time, exists bool) {
 	v := m.deleted_at
 	if v == nil {
 		return
@@ -3766,10 +11285,10 @@ func (m *PostCategoryMutation) DeletedAt() (r time.Time, exists bool) {
 	return *v, true
 }
 
-// OldDeletedAt returns the old "deleted_at" field's value of the PostCategory entity.
-// If the PostCategory object wasn't provided to the builder, the object is fetched from the database.
+// OldDeletedAt returns the old "deleted_at" field's value of the SigningKey entity.
+// If the SigningKey object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *PostCategoryMutation) OldDeletedAt(ctx context.Context) (v *time.Time, err error) {
+func (m *SigningKeyMutation) OldDeletedAt(ctx context.Context) (v *time.Time, err error) {
 	if !m.op.Is(OpUpdateOne) {
 		return v, errors.New("OldDeletedAt is only allowed on UpdateOne operations")
 	}
@@ -3784,158 +11303,274 @@ func (m *PostCategoryMutation) OldDeletedAt(ctx context.Context) (v *time.Time,
 }
 
 // ClearDeletedAt clears the value of the "deleted_at" field.
-func (m *PostCategoryMutation) ClearDeletedAt() {
+func (m *SigningKeyMutation) ClearDeletedAt() {
 	m.deleted_at = nil
-	m.clearedFields[postcategory.FieldDeletedAt] = struct{}{}
+	m.clearedFields[signingkey.FieldDeletedAt] = struct{}{}
 }
 
 // DeletedAtCleared returns if the "deleted_at" field was cleared in this mutation.
-func (m *PostCategoryMutation) DeletedAtCleared() bool {
-	_, ok := m.clearedFields[postcategory.FieldDeletedAt]
+func (m *SigningKeyMutation) DeletedAtCleared() bool {
+	_, ok := m.clearedFields[signingkey.FieldDeletedAt]
 	return ok
 }
 
 // ResetDeletedAt resets all changes to the "deleted_at" field.
-func (m *PostCategoryMutation) ResetDeletedAt() {
+func (m *SigningKeyMutation) ResetDeletedAt() {
 	m.deleted_at = nil
-	delete(m.clearedFields, postcategory.FieldDeletedAt)
+	delete(m.clearedFields, signingkey.FieldDeletedAt)
 }
 
-// SetName sets the "name" field.
-func (m *PostCategoryMutation) SetName(s string) {
-	m.name = &s
+// SetKid sets the "kid" field.
+func (m *SigningKeyMutation) SetKid(s string) {
+	m.kid = &s
 }
 
-// Name returns the value of the "name" field in the mutation.
-func (m *PostCategoryMutation) Name() (r string, exists bool) {
-	v := m.name
+// Kid returns the value of the "kid" field in the mutation.
+func (m *SigningKeyMutation) Kid() (r string, exists bool) {
+	v := m.kid
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldName returns the old "name" field's value of the PostCategory entity.
-// If the PostCategory object wasn't provided to the builder, the object is fetched from the database.
+// OldKid returns the old "kid" field's value of the SigningKey entity.
+// If the SigningKey object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *PostCategoryMutation) OldName(ctx context.Context) (v string, err error) {
+func (m *SigningKeyMutation) OldKid(ctx context.Context) (v string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldName is only allowed on UpdateOne operations")
+		return v, errors.New("OldKid is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldName requires an ID field in the mutation")
+		return v, errors.New("OldKid requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldName: %w", err)
+		return v, fmt.Errorf("querying old value for OldKid: %w", err)
 	}
-	return oldValue.Name, nil
+	return oldValue.Kid, nil
 }
 
-// ResetName resets all changes to the "name" field.
-func (m *PostCategoryMutation) ResetName() {
-	m.name = nil
+// ResetKid resets all changes to the "kid" field.
+func (m *SigningKeyMutation) ResetKid() {
+	m.kid = nil
 }
 
-// SetSlug sets the "slug" field.
-func (m *PostCategoryMutation) SetSlug(s string) {
-	m.slug = &s
+// SetAlgorithm sets the "algorithm" field.
+func (m *SigningKeyMutation) SetAlgorithm(s string) {
+	m.algorithm = &s
 }
 
-// Slug returns the value of the "slug" field in the mutation.
-func (m *PostCategoryMutation) Slug() (r string, exists bool) {
-	v := m.slug
+// Algorithm returns the value of the "algorithm" field in the mutation.
+func (m *SigningKeyMutation) Algorithm() (r string, exists bool) {
+	v := m.algorithm
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldSlug returns the old "slug" field's value of the PostCategory entity.
-// If the PostCategory object wasn't provided to the builder, the object is fetched from the database.
+// OldAlgorithm returns the old "algorithm" field's value of the SigningKey entity.
+// If the SigningKey object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *PostCategoryMutation) OldSlug(ctx context.Context) (v string, err error) {
+func (m *SigningKeyMutation) OldAlgorithm(ctx context.Context) (v string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldSlug is only allowed on UpdateOne operations")
+		return v, errors.New("OldAlgorithm is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldSlug requires an ID field in the mutation")
+		return v, errors.New("OldAlgorithm requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldSlug: %w", err)
+		return v, fmt.Errorf("querying old value for OldAlgorithm: %w", err)
 	}
-	return oldValue.Slug, nil
+	return oldValue.Algorithm, nil
+}
+
+// ResetAlgorithm resets all changes to the "algorithm" field.
+func (m *SigningKeyMutation) ResetAlgorithm() {
+	m.algorithm = nil
+}
+
+// SetPrivateKey sets the "private_key" field.
+func (m *SigningKeyMutation) SetPrivateKey(s string) {
+	m.private_key = &s
+}
+
+// PrivateKey returns the value of the "private_key" field in the mutation.
+func (m *SigningKeyMutation) PrivateKey() (r string, exists bool) {
+	v := m.private_key
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldPrivateKey returns the old "private_key" field's value of the SigningKey entity.
+// If the SigningKey object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *SigningKeyMutation) OldPrivateKey(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldPrivateKey is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldPrivateKey requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldPrivateKey: %w", err)
+	}
+	return oldValue.PrivateKey, nil
+}
+
+// ResetPrivateKey resets all changes to the "private_key" field.
+func (m *SigningKeyMutation) ResetPrivateKey() {
+	m.private_key = nil
+}
+
+// SetPublicKey sets the "public_key" field.
+func (m *SigningKeyMutation) SetPublicKey(s string) {
+	m.public_key = &s
+}
+
+// PublicKey returns the value of the "public_key" field in the mutation.
+func (m *SigningKeyMutation) PublicKey() (r string, exists bool) {
+	v := m.public_key
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldPublicKey returns the old "public_key" field's value of the SigningKey entity.
+// If the SigningKey object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *SigningKeyMutation) OldPublicKey(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldPublicKey is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldPublicKey requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldPublicKey: %w", err)
+	}
+	return oldValue.PublicKey, nil
+}
+
+// ResetPublicKey resets all changes to the "public_key" field.
+func (m *SigningKeyMutation) ResetPublicKey() {
+	m.public_key = nil
+}
+
+// SetActivatesAt sets the "activates_at" field.
+func (m *SigningKeyMutation) SetActivatesAt(t time.Time) {
+	m.activates_at = &t
+}
+
+// ActivatesAt returns the value of the "activates_at" field in the mutation.
+func (m *SigningKeyMutation) ActivatesAt() (r time.Time, exists bool) {
+	v := m.activates_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldActivatesAt returns the old "activates_at" field's value of the SigningKey entity.
+// If the SigningKey object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *SigningKeyMutation) OldActivatesAt(ctx context.Context) (v *time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldActivatesAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldActivatesAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldActivatesAt: %w", err)
+	}
+	return oldValue.ActivatesAt, nil
+}
+
+// ClearActivatesAt clears the value of the "activates_at" field.
+func (m *SigningKeyMutation) ClearActivatesAt() {
+	m.activates_at = nil
+	m.clearedFields[signingkey.FieldActivatesAt] = struct{}{}
 }
 
-// ResetSlug resets all changes to the "slug" field.
-func (m *PostCategoryMutation) ResetSlug() {
-	m.slug = nil
+// ActivatesAtCleared returns if the "activates_at" field was cleared in this mutation.
+func (m *SigningKeyMutation) ActivatesAtCleared() bool {
+	_, ok := m.clearedFields[signingkey.FieldActivatesAt]
+	return ok
 }
 
-// AddPostIDs adds the "posts" edge to the Post entity by ids.
-func (m *PostCategoryMutation) AddPostIDs(ids ...uint) {
-	if m.posts == nil {
-		m.posts = make(map[uint]struct{})
-	}
-	for i := range ids {
-		m.posts[ids[i]] = struct{}{}
-	}
+// ResetActivatesAt resets all changes to the "activates_at" field.
+func (m *SigningKeyMutation) ResetActivatesAt() {
+	m.activates_at = nil
+	delete(m.clearedFields, signingkey.FieldActivatesAt)
 }
 
-// ClearPosts clears the "posts" edge to the Post entity.
-func (m *PostCategoryMutation) ClearPosts() {
-	m.clearedposts = true
+// SetRetiredAt sets the "retired_at" field.
+func (m *SigningKeyMutation) SetRetiredAt(t time.Time) {
+	m.retired_at = &t
 }
 
-// PostsCleared reports if the "posts" edge to the Post entity was cleared.
-func (m *PostCategoryMutation) PostsCleared() bool {
-	return m.clearedposts
+// RetiredAt returns the value of the "retired_at" field in the mutation.
+func (m *SigningKeyMutation) RetiredAt() (r time.Time, exists bool) {
+	v := m.retired_at
+	if v == nil {
+		return
+	}
+	return *v, true
 }
 
-// RemovePostIDs removes the "posts" edge to the Post entity by IDs.
-func (m *PostCategoryMutation) RemovePostIDs(ids ...uint) {
-	if m.removedposts == nil {
-		m.removedposts = make(map[uint]struct{})
+// OldRetiredAt returns the old "retired_at" field's value of the SigningKey entity.
+// If the SigningKey object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *SigningKeyMutation) OldRetiredAt(ctx context.Context) (v *time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldRetiredAt is only allowed on UpdateOne operations")
 	}
-	for i := range ids {
-		delete(m.posts, ids[i])
-		m.removedposts[ids[i]] = struct{}{}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldRetiredAt requires an ID field in the mutation")
 	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldRetiredAt: %w", err)
+	}
+	return oldValue.RetiredAt, nil
 }
 
-// RemovedPosts returns the removed IDs of the "posts" edge to the Post entity.
-func (m *PostCategoryMutation) RemovedPostsIDs() (ids []uint) {
-	for id := range m.removedposts {
-		ids = append(ids, id)
-	}
-	return
+// ClearRetiredAt clears the value of the "retired_at" field.
+func (m *SigningKeyMutation) ClearRetiredAt() {
+	m.retired_at = nil
+	m.clearedFields[signingkey.FieldRetiredAt] = struct{}{}
 }
 
-// PostsIDs returns the "posts" edge IDs in the mutation.
-func (m *PostCategoryMutation) PostsIDs() (ids []uint) {
-	for id := range m.posts {
-		ids = append(ids, id)
-	}
-	return
+// RetiredAtCleared returns if the "retired_at" field was cleared in this mutation.
+func (m *SigningKeyMutation) RetiredAtCleared() bool {
+	_, ok := m.clearedFields[signingkey.FieldRetiredAt]
+	return ok
 }
 
-// ResetPosts resets all changes to the "posts" edge.
-func (m *PostCategoryMutation) ResetPosts() {
-	m.posts = nil
-	m.clearedposts = false
-	m.removedposts = nil
+// ResetRetiredAt resets all changes to the "retired_at" field.
+func (m *SigningKeyMutation) ResetRetiredAt() {
+	m.retired_at = nil
+	delete(m.clearedFields, signingkey.FieldRetiredAt)
 }
 
-// Where appends a list predicates to the PostCategoryMutation builder.
-func (m *PostCategoryMutation) Where(ps ...predicate.PostCategory) {
+// Where appends a list predicates to the SigningKeyMutation builder.
+func (m *SigningKeyMutation) Where(ps ...predicate.SigningKey) {
 	m.predicates = append(m.predicates, ps...)
 }
 
-// WhereP appends storage-level predicates to the PostCategoryMutation builder. Using this method,
+// WhereP appends storage-level predicates to the SigningKeyMutation builder. Using this method,
 // users can use type-assertion to append predicates that do not depend on any generated package.
-func (m *PostCategoryMutation) WhereP(ps ...func(*sql.Selector)) {
-	p := make([]predicate.PostCategory, len(ps))
+func (m *SigningKeyMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.SigningKey, len(ps))
 	for i := range ps {
 		p[i] = ps[i]
 	}
@@ -3943,39 +11578,51 @@ func (m *PostCategoryMutation) WhereP(ps ...func(*sql.Selector)) {
 }
 
 // Op returns the operation name.
-func (m *PostCategoryMutation) Op() Op {
+func (m *SigningKeyMutation) Op() Op {
 	return m.op
 }
 
 // SetOp allows setting the mutation operation.
-func (m *PostCategoryMutation) SetOp(op Op) {
+func (m *SigningKeyMutation) SetOp(op Op) {
 	m.op = op
 }
 
-// Type returns the node type of this mutation (PostCategory).
-func (m *PostCategoryMutation) Type() string {
+// Type returns the node type of this mutation (SigningKey).
+func (m *SigningKeyMutation) Type() string {
 	return m.typ
 }
 
 // Fields returns all fields that were changed during this mutation. Note that in
 // order to get all numeric fields that were incremented/decremented, call
 // AddedFields().
-func (m *PostCategoryMutation) Fields() []string {
-	fields := make([]string, 0, 5)
+func (m *SigningKeyMutation) Fields() []string {
+	fields := make([]string, 0, 9)
 	if m.created_at != nil {
-		fields = append(fields, postcategory.FieldCreatedAt)
+		fields = append(fields, signingkey.FieldCreatedAt)
 	}
 	if m.updated_at != nil {
-		fields = append(fields, postcategory.FieldUpdatedAt)
+		fields = append(fields, signingkey.FieldUpdatedAt)
 	}
 	if m.deleted_at != nil {
-		fields = append(fields, postcategory.FieldDeletedAt)
+		fields = append(fields, signingkey.FieldDeletedAt)
 	}
-	if m.name != nil {
-		fields = append(fields, postcategory.FieldName)
+	if m.kid != nil {
+		fields = append(fields, signingkey.FieldKid)
 	}
-	if m.slug != nil {
-		fields = append(fields, postcategory.FieldSlug)
+	if m.algorithm != nil {
+		fields = append(fields, signingkey.FieldAlgorithm)
+	}
+	if m.private_key != nil {
+		fields = append(fields, signingkey.FieldPrivateKey)
+	}
+	if m.public_key != nil {
+		fields = append(fields, signingkey.FieldPublicKey)
+	}
+	if m.activates_at != nil {
+		fields = append(fields, signingkey.FieldActivatesAt)
+	}
+	if m.retired_at != nil {
+		fields = append(fields, signingkey.FieldRetiredAt)
 	}
 	return fields
 }
@@ -3983,18 +11630,26 @@ func (m *PostCategoryMutation) Fields() []string {
 // Field returns the value of a field with the given name. The second boolean
 // return value indicates that this field was not set, or was not defined in the
 // schema.
-func (m *PostCategoryMutation) Field(name string) (ent.Value, bool) {
+func (m *SigningKeyMutation) Field(name string) (ent.Value, bool) {
 	switch name {
-	case postcategory.FieldCreatedAt:
+	case signingkey.FieldCreatedAt:
 		return m.CreatedAt()
-	case postcategory.FieldUpdatedAt:
+	case signingkey.FieldUpdatedAt:
 		return m.UpdatedAt()
-	case postcategory.FieldDeletedAt:
+	case signingkey.FieldDeletedAt:
 		return m.DeletedAt()
-	case postcategory.FieldName:
-		return m.Name()
-	case postcategory.FieldSlug:
-		return m.Slug()
+	case signingkey.FieldKid:
+		return m.Kid()
+	case signingkey.FieldAlgorithm:
+		return m.Algorithm()
+	case signingkey.FieldPrivateKey:
+		return m.PrivateKey()
+	case signingkey.FieldPublicKey:
+		return m.PublicKey()
+	case signingkey.FieldActivatesAt:
+		return m.ActivatesAt()
+	case signingkey.FieldRetiredAt:
+		return m.RetiredAt()
 	}
 	return nil, false
 }
@@ -4002,260 +11657,346 @@ func (m *PostCategoryMutation) Field(name string) (ent.Value, bool) {
 // OldField returns the old value of the field from the database. An error is
 // returned if the mutation operation is not UpdateOne, or the query to the
 // database failed.
-func (m *PostCategoryMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+func (m *SigningKeyMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
 	switch name {
-	case postcategory.FieldCreatedAt:
+	case signingkey.FieldCreatedAt:
 		return m.OldCreatedAt(ctx)
-	case postcategory.FieldUpdatedAt:
+	case signingkey.FieldUpdatedAt:
 		return m.OldUpdatedAt(ctx)
-	case postcategory.FieldDeletedAt:
+	case signingkey.FieldDeletedAt:
 		return m.OldDeletedAt(ctx)
-	case postcategory.FieldName:
-		return m.OldName(ctx)
-	case postcategory.FieldSlug:
-		return m.OldSlug(ctx)
-	}
-	return nil, fmt.Errorf("unknown PostCategory field %s", name)
+	case signingkey.FieldKid:
+		return m.OldKid(ctx)
+	case signingkey.FieldAlgorithm:
+		return m.OldAlgorithm(ctx)
+	case signingkey.FieldPrivateKey:
+		return m.OldPrivateKey(ctx)
+	case signingkey.FieldPublicKey:
+		return m.OldPublicKey(ctx)
+	case signingkey.FieldActivatesAt:
+		return m.OldActivatesAt(ctx)
+	case signingkey.FieldRetiredAt:
+		return m.OldRetiredAt(ctx)
+	}
+	return nil, fmt.Errorf("unknown SigningKey field %s", name)
 }
 
 // SetField sets the value of a field with the given name. It returns an error if
 // the field is not defined in the schema, or if the type mismatched the field
 // type.
-func (m *PostCategoryMutation) SetField(name string, value ent.Value) error {
+func (m *SigningKeyMutation) SetField(name string, value ent.Value) error {
 	switch name {
-	case postcategory.FieldCreatedAt:
+	case signingkey.FieldCreatedAt:
 		v, ok := value.(time.Time)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
 		m.SetCreatedAt(v)
 		return nil
-	case postcategory.FieldUpdatedAt:
+	case signingkey.FieldUpdatedAt:
 		v, ok := value.(time.Time)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
 		m.SetUpdatedAt(v)
 		return nil
-	case postcategory.FieldDeletedAt:
+	case signingkey.FieldDeletedAt:
 		v, ok := value.(time.Time)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
 		m.SetDeletedAt(v)
 		return nil
-	case postcategory.FieldName:
+	case signingkey.FieldKid:
 		v, ok := value.(string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetName(v)
+		m.SetKid(v)
 		return nil
-	case postcategory.FieldSlug:
+	case signingkey.FieldAlgorithm:
 		v, ok := value.(string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetSlug(v)
+		m.SetAlgorithm(v)
+		return nil
+	case signingkey.FieldPrivateKey:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetPrivateKey(v)
+		return nil
+	case signingkey.FieldPublicKey:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetPublicKey(v)
+		return nil
+	case signingkey.FieldActivatesAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetActivatesAt(v)
+		return nil
+	case signingkey.FieldRetiredAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetRetiredAt(v)
 		return nil
 	}
-	return fmt.Errorf("unknown PostCategory field %s", name)
+	return fmt.Errorf("unknown SigningKey field %s", name)
 }
 
 // AddedFields returns all numeric fields that were incremented/decremented during
 // this mutation.
-func (m *PostCategoryMutation) AddedFields() []string {
+func (m *SigningKeyMutation) AddedFields() []string {
 	return nil
 }
 
 // AddedField returns the numeric value that was incremented/decremented on a field
 // with the given name. The second boolean return value indicates that this field
 // was not set, or was not defined in the schema.
-func (m *PostCategoryMutation) AddedField(name string) (ent.Value, bool) {
+func (m *SigningKeyMutation) AddedField(name string) (ent.Value, bool) {
 	return nil, false
 }
 
 // AddField adds the value to the field with the given name. It returns an error if
 // the field is not defined in the schema, or if the type mismatched the field
 // type.
-func (m *PostCategoryMutation) AddField(name string, value ent.Value) error {
+func (m *SigningKeyMutation) AddField(name string, value ent.Value) error {
 	switch name {
 	}
-	return fmt.Errorf("unknown PostCategory numeric field %s", name)
+	return fmt.Errorf("unknown SigningKey numeric field %s", name)
 }
 
 // ClearedFields returns all nullable fields that were cleared during this
 // mutation.
-func (m *PostCategoryMutation) ClearedFields() []string {
+func (m *SigningKeyMutation) ClearedFields() []string {
 	var fields []string
-	if m.FieldCleared(postcategory.FieldDeletedAt) {
-		fields = append(fields, postcategory.FieldDeletedAt)
+	if m.FieldCleared(signingkey.FieldDeletedAt) {
+		fields = append(fields, signingkey.FieldDeletedAt)
+	}
+	if m.FieldCleared(signingkey.FieldActivatesAt) {
+		fields = append(fields, signingkey.FieldActivatesAt)
+	}
+	if m.FieldCleared(signingkey.FieldRetiredAt) {
+		fields = append(fields, signingkey.FieldRetiredAt)
 	}
 	return fields
 }
 
 // FieldCleared returns a boolean indicating if a field with the given name was
 // cleared in this mutation.
-func (m *PostCategoryMutation) FieldCleared(name string) bool {
+func (m *SigningKeyMutation) FieldCleared(name string) bool {
 	_, ok := m.clearedFields[name]
 	return ok
 }
 
 // ClearField clears the value of the field with the given name. It returns an
 // error if the field is not defined in the schema.
-func (m *PostCategoryMutation) ClearField(name string) error {
+func (m *SigningKeyMutation) ClearField(name string) error {
 	switch name {
-	case postcategory.FieldDeletedAt:
+	case signingkey.FieldDeletedAt:
 		m.ClearDeletedAt()
 		return nil
+	case signingkey.FieldActivatesAt:
+		m.ClearActivatesAt()
+		return nil
+	case signingkey.FieldRetiredAt:
+		m.ClearRetiredAt()
+		return nil
 	}
-	return fmt.Errorf("unknown PostCategory nullable field %s", name)
+	return fmt.Errorf("unknown SigningKey nullable field %s", name)
 }
 
 // ResetField resets all changes in the mutation for the field with the given name.
 // It returns an error if the field is not defined in the schema.
-func (m *PostCategoryMutation) ResetField(name string) error {
+func (m *SigningKeyMutation) ResetField(name string) error {
 	switch name {
-	case postcategory.FieldCreatedAt:
+	case signingkey.FieldCreatedAt:
 		m.ResetCreatedAt()
 		return nil
-	case postcategory.FieldUpdatedAt:
+	case signingkey.FieldUpdatedAt:
 		m.ResetUpdatedAt()
 		return nil
-	case postcategory.FieldDeletedAt:
+	case signingkey.FieldDeletedAt:
 		m.ResetDeletedAt()
 		return nil
-	case postcategory.FieldName:
-		m.ResetName()
+	case signingkey.FieldKid:
+		m.ResetKid()
 		return nil
-	case postcategory.FieldSlug:
-		m.ResetSlug()
+	case signingkey.FieldAlgorithm:
+		m.ResetAlgorithm()
+		return nil
+	case signingkey.FieldPrivateKey:
+		m.ResetPrivateKey()
+		return nil
+	case signingkey.FieldPublicKey:
+		m.ResetPublicKey()
+		return nil
+	case signingkey.FieldActivatesAt:
+		m.ResetActivatesAt()
+		return nil
+	case signingkey.FieldRetiredAt:
+		m.ResetRetiredAt()
 		return nil
 	}
-	return fmt.Errorf("unknown PostCategory field %s", name)
+	return fmt.Errorf("unknown SigningKey field %s", name)
 }
 
 // AddedEdges returns all edge names that were set/added in this mutation.
-func (m *PostCategoryMutation) AddedEdges() []string {
-	edges := make([]string, 0, 1)
-	if m.posts != nil {
-		edges = append(edges, postcategory.EdgePosts)
-	}
+func (m *SigningKeyMutation) AddedEdges() []string {
+	edges := make([]string, 0, 0)
 	return edges
 }
 
 // AddedIDs returns all IDs (to other nodes) that were added for the given edge
 // name in this mutation.
-func (m *PostCategoryMutation) AddedIDs(name string) []ent.Value {
-	switch name {
-	case postcategory.EdgePosts:
-		ids := make([]ent.Value, 0, len(m.posts))
-		for id := range m.posts {
-			ids = append(ids, id)
-		}
-		return ids
-	}
+func (m *SigningKeyMutation) AddedIDs(name string) []ent.Value {
 	return nil
 }
 
 // RemovedEdges returns all edge names that were removed in this mutation.
-func (m *PostCategoryMutation) RemovedEdges() []string {
-	edges := make([]string, 0, 1)
-	if m.removedposts != nil {
-		edges = append(edges, postcategory.EdgePosts)
-	}
+func (m *SigningKeyMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 0)
 	return edges
 }
 
 // RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
 // the given name in this mutation.
-func (m *PostCategoryMutation) RemovedIDs(name string) []ent.Value {
-	switch name {
-	case postcategory.EdgePosts:
-		ids := make([]ent.Value, 0, len(m.removedposts))
-		for id := range m.removedposts {
-			ids = append(ids, id)
-		}
-		return ids
-	}
+func (m *SigningKeyMutation) RemovedIDs(name string) []ent.Value {
 	return nil
 }
 
 // ClearedEdges returns all edge names that were cleared in this mutation.
-func (m *PostCategoryMutation) ClearedEdges() []string {
-	edges := make([]string, 0, 1)
-	if m.clearedposts {
-		edges = append(edges, postcategory.EdgePosts)
-	}
+func (m *SigningKeyMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 0)
 	return edges
 }
 
 // EdgeCleared returns a boolean which indicates if the edge with the given name
 // was cleared in this mutation.
-func (m *PostCategoryMutation) EdgeCleared(name string) bool {
-	switch name {
-	case postcategory.EdgePosts:
-		return m.clearedposts
-	}
+func (m *SigningKeyMutation) EdgeCleared(name string) bool {
 	return false
 }
 
 // ClearEdge clears the value of the edge with the given name. It returns an error
 // if that edge is not defined in the schema.
-func (m *PostCategoryMutation) ClearEdge(name string) error {
-	switch name {
-	}
-	return fmt.Errorf("unknown PostCategory unique edge %s", name)
+func (m *SigningKeyMutation) ClearEdge(name string) error {
+	return fmt.Errorf("unknown SigningKey unique edge %s", name)
 }
 
 // ResetEdge resets all changes to the edge with the given name in this mutation.
 // It returns an error if the edge is not defined in the schema.
-func (m *PostCategoryMutation) ResetEdge(name string) error {
-	switch name {
-	case postcategory.EdgePosts:
-		m.ResetPosts()
-		return nil
-	}
-	return fmt.Errorf("unknown PostCategory edge %s", name)
+func (m *SigningKeyMutation) ResetEdge(name string) error {
+	return fmt.Errorf("unknown SigningKey edge %s", name)
 }
 
-// PostCategoryRelationMutation represents an operation that mutates the PostCategoryRelation nodes in the graph.
-type PostCategoryRelationMutation struct {
+// UserMutation represents an operation that mutates the User nodes in the graph.
+type UserMutation struct {
 	config
-	op              Op
-	typ             string
-	clearedFields   map[string]struct{}
-	post            *uint
-	clearedpost     bool
-	category        *uint
-	clearedcategory bool
-	done            bool
-	oldValue        func(context.Context) (*PostCategoryRelation, error)
-	predicates      []predicate.PostCategoryRelation
+	op                        Op
+	typ                       string
+	id                        *uint
+	created_at                *time.Time
+	updated_at                *time.Time
+	deleted_at                *time.Time
+	uuid                      *uuid.UUID
+	avatar                    *string
+	email                     *string
+	password                  *string
+	role                      *entity.UserRole
+	status                    *entity.UserStatus
+	username                  *string
+	bio                       *string
+	totp_secret               *string
+	totp_enabled              *bool
+	totp_recovery_codes       *[]string
+	appendtotp_recovery_codes []string
+	clearedFields             map[string]struct{}
+	posts                     map[uint]struct{}
+	removedposts              map[uint]struct{}
+	clearedposts              bool
+	comments                  map[uint]struct{}
+	removedcomments           map[uint]struct{}
+	clearedcomments           bool
+	passkeys                  map[uint]struct{}
+	removedpasskeys           map[uint]struct{}
+	clearedpasskeys           bool
+	identities                map[uint]struct{}
+	removedidentities         map[uint]struct{}
+	clearedidentities         bool
+	access_tokens             map[uint]struct{}
+	removedaccess_tokens      map[uint]struct{}
+	clearedaccess_tokens      bool
+	post_revisions            map[uint]struct{}
+	removedpost_revisions     map[uint]struct{}
+	clearedpost_revisions     bool
+	done                      bool
+	oldValue                  func(context.Context) (*User, error)
+	predicates                []predicate.User
 }
 
-var _ ent.Mutation = (*PostCategoryRelationMutation)(nil)
+var _ ent.Mutation = (*UserMutation)(nil)
 
-// postcategoryrelationOption allows management of the mutation configuration using functional options.
-type postcategoryrelationOption func(*PostCategoryRelationMutation)
+// userOption allows management of the mutation configuration using functional options.
+type userOption func(*UserMutation)
 
-// newPostCategoryRelationMutation creates new mutation for the PostCategoryRelation entity.
-func newPostCategoryRelationMutation(c config, op Op, opts ...postcategoryrelationOption) *PostCategoryRelationMutation {
-	m := &PostCategoryRelationMutation{
+// newUserMutation creates new mutation for the User entity.
+func newUserMutation(c config, op Op, opts ...userOption) *UserMutation {
+	m := &UserMutation{
 		config:        c,
 		op:            op,
-		typ:           TypePostCategoryRelation,
+		typ:           TypeUser,
 		clearedFields: make(map[string]struct{}),
 	}
 	for _, opt := range opts {
 		opt(m)
 	}
-	return m
+	return m
+}
+
+// withUserID sets the ID field of the mutation.
+func withUserID(id uint) userOption {
+	return func(m *UserMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *User
+		)
+		m.oldValue = func(ctx context.Context) (*User, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().User.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
+	}
+}
+
+// withUser sets the old User of the mutation.
+func withUser(node *User) userOption {
+	return func(m *UserMutation) {
+		m.oldValue = func(context.Context) (*User, error) {
+			return node, nil
+		}
+		m.id = &node.ID
+	}
 }
 
 // Client returns a new `ent.Client` from the mutation. If the mutation was
 // executed in a transaction (ent.Tx), a transactional client is returned.
-func (m PostCategoryRelationMutation) Client() *Client {
+func (m UserMutation) Client() *Client {
 	client := &Client{config: m.config}
 	client.init()
 	return client
@@ -4263,7 +12004,7 @@ func (m PostCategoryRelationMutation) Client() *Client {
 
 // Tx returns an `ent.Tx` for mutations that were executed in transactions;
 // it returns an error otherwise.
-func (m PostCategoryRelationMutation) Tx() (*Tx, error) {
+func (m UserMutation) Tx() (*Tx, error) {
 	if _, ok := m.driver.(*txDriver); !ok {
 		return nil, errors.New("ent: mutation is not running in a transaction")
 	}
@@ -4272,667 +12013,627 @@ func (m PostCategoryRelationMutation) Tx() (*Tx, error) {
 	return tx, nil
 }
 
-// SetPostID sets the "post_id" field.
-func (m *PostCategoryRelationMutation) SetPostID(u uint) {
-	m.post = &u
+// SetID sets the value of the id field. Note that this
+// operation is only accepted on creation of User entities.
+func (m *UserMutation) SetID(id uint) {
+	m.id = &id
 }
 
-// PostID returns the value of the "post_id" field in the mutation.
-func (m *PostCategoryRelationMutation) PostID() (r uint, exists bool) {
-	v := m.post
-	if v == nil {
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *UserMutation) ID() (id uint, exists bool) {
+	if m.id == nil {
 		return
 	}
-	return *v, true
+	return *m.id, true
 }
 
-// ResetPostID resets all changes to the "post_id" field.
-func (m *PostCategoryRelationMutation) ResetPostID() {
-	m.post = nil
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *UserMutation) IDs(ctx context.Context) ([]uint, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []uint{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().User.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
 }
 
-// SetPostCategoryID sets the "post_category_id" field.
-func (m *PostCategoryRelationMutation) SetPostCategoryID(u uint) {
-	m.category = &u
+// SetCreatedAt sets the "created_at" field.
+func (m *UserMutation) SetCreatedAt(t time.Time) {
+	m.created_at = &t
 }
 
-// PostCategoryID returns the value of the "post_category_id" field in the mutation.
-func (m *PostCategoryRelationMutation) PostCategoryID() (r uint, exists bool) {
-	v := m.category
+// CreatedAt returns the value of the "created_at" field in the mutation.
+func (m *UserMutation) CreatedAt() (r time.Time, exists bool) {
+	v := m.created_at
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// ResetPostCategoryID resets all changes to the "post_category_id" field.
-func (m *PostCategoryRelationMutation) ResetPostCategoryID() {
-	m.category = nil
-}
-
-// ClearPost clears the "post" edge to the Post entity.
-func (m *PostCategoryRelationMutation) ClearPost() {
-	m.clearedpost = true
-	m.clearedFields[postcategoryrelation.FieldPostID] = struct{}{}
-}
-
-// PostCleared reports if the "post" edge to the Post entity was cleared.
-func (m *PostCategoryRelationMutation) PostCleared() bool {
-	return m.clearedpost
-}
-
-// PostIDs returns the "post" edge IDs in the mutation.
-// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
-// PostID instead. It exists only for internal usage by the builders.
-func (m *PostCategoryRelationMutation) PostIDs() (ids []uint) {
-	if id := m.post; id != nil {
-		ids = append(ids, *id)
+// OldCreatedAt returns the old "created_at" field's value of the User entity.
+// If the User object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *UserMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
 	}
-	return
-}
-
-// ResetPost resets all changes to the "post" edge.
-func (m *PostCategoryRelationMutation) ResetPost() {
-	m.post = nil
-	m.clearedpost = false
-}
-
-// SetCategoryID sets the "category" edge to the PostCategory entity by id.
-func (m *PostCategoryRelationMutation) SetCategoryID(id uint) {
-	m.category = &id
-}
-
-// ClearCategory clears the "category" edge to the PostCategory entity.
-func (m *PostCategoryRelationMutation) ClearCategory() {
-	m.clearedcategory = true
-	m.clearedFields[postcategoryrelation.FieldPostCategoryID] = struct{}{}
-}
-
-// CategoryCleared reports if the "category" edge to the PostCategory entity was cleared.
-func (m *PostCategoryRelationMutation) CategoryCleared() bool {
-	return m.clearedcategory
-}
-
-// CategoryID returns the "category" edge ID in the mutation.
-func (m *PostCategoryRelationMutation) CategoryID() (id uint, exists bool) {
-	if m.category != nil {
-		return *m.category, true
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCreatedAt requires an ID field in the mutation")
 	}
-	return
-}
-
-// CategoryIDs returns the "category" edge IDs in the mutation.
-// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
-// CategoryID instead. It exists only for internal usage by the builders.
-func (m *PostCategoryRelationMutation) CategoryIDs() (ids []uint) {
-	if id := m.category; id != nil {
-		ids = append(ids, *id)
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCreatedAt: %w", err)
 	}
-	return
+	return oldValue.CreatedAt, nil
 }
 
-// ResetCategory resets all changes to the "category" edge.
-func (m *PostCategoryRelationMutation) ResetCategory() {
-	m.category = nil
-	m.clearedcategory = false
+// ResetCreatedAt resets all changes to the "created_at" field.
+func (m *UserMutation) ResetCreatedAt() {
+	m.created_at = nil
 }
 
-// Where appends a list predicates to the PostCategoryRelationMutation builder.
-func (m *PostCategoryRelationMutation) Where(ps ...predicate.PostCategoryRelation) {
-	m.predicates = append(m.predicates, ps...)
+// SetUpdatedAt sets the "updated_at" field.
+func (m *UserMutation) SetUpdatedAt(t time.Time) {
+	m.updated_at = &t
 }
 
-// WhereP appends storage-level predicates to the PostCategoryRelationMutation builder. Using this method,
-// users can use type-assertion to append predicates that do not depend on any generated package.
-func (m *PostCategoryRelationMutation) WhereP(ps ...func(*sql.Selector)) {
-	p := make([]predicate.PostCategoryRelation, len(ps))
-	for i := range ps {
-		p[i] = ps[i]
+// UpdatedAt returns the value of the "updated_at" field in the mutation.
+func (m *UserMutation) UpdatedAt() (r time.Time, exists bool) {
+	v := m.updated_at
+	if v == nil {
+		return
 	}
-	m.Where(p...)
-}
-
-// Op returns the operation name.
-func (m *PostCategoryRelationMutation) Op() Op {
-	return m.op
-}
-
-// SetOp allows setting the mutation operation.
-func (m *PostCategoryRelationMutation) SetOp(op Op) {
-	m.op = op
-}
-
-// Type returns the node type of this mutation (PostCategoryRelation).
-func (m *PostCategoryRelationMutation) Type() string {
-	return m.typ
+	return *v, true
 }
 
-// Fields returns all fields that were changed during this mutation. Note that in
-// order to get all numeric fields that were incremented/decremented, call
-// AddedFields().
-func (m *PostCategoryRelationMutation) Fields() []string {
-	fields := make([]string, 0, 2)
-	if m.post != nil {
-		fields = append(fields, postcategoryrelation.FieldPostID)
+// OldUpdatedAt returns the old "updated_at" field's value of the User entity.
+// If the User object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *UserMutation) OldUpdatedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldUpdatedAt is only allowed on UpdateOne operations")
 	}
-	if m.category != nil {
-		fields = append(fields, postcategoryrelation.FieldPostCategoryID)
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldUpdatedAt requires an ID field in the mutation")
 	}
-	return fields
-}
-
-// Field returns the value of a field with the given name. The second boolean
-// return value indicates that this field was not set, or was not defined in the
-// schema.
-func (m *PostCategoryRelationMutation) Field(name string) (ent.Value, bool) {
-	switch name {
-	case postcategoryrelation.FieldPostID:
-		return m.PostID()
-	case postcategoryrelation.FieldPostCategoryID:
-		return m.PostCategoryID()
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldUpdatedAt: %w", err)
 	}
-	return nil, false
-}
-
-// OldField returns the old value of the field from the database. An error is
-// returned if the mutation operation is not UpdateOne, or the query to the
-// database failed.
-func (m *PostCategoryRelationMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
-	return nil, errors.New("edge schema PostCategoryRelation does not support getting old values")
+	return oldValue.UpdatedAt, nil
 }
 
-// SetField sets the value of a field with the given name. It returns an error if
-// the field is not defined in the schema, or if the type mismatched the field
-// type.
-func (m *PostCategoryRelationMutation) SetField(name string, value ent.Value) error {
-	switch name {
-	case postcategoryrelation.FieldPostID:
-		v, ok := value.(uint)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetPostID(v)
-		return nil
-	case postcategoryrelation.FieldPostCategoryID:
-		v, ok := value.(uint)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetPostCategoryID(v)
-		return nil
-	}
-	return fmt.Errorf("unknown PostCategoryRelation field %s", name)
+// ResetUpdatedAt resets all changes to the "updated_at" field.
+func (m *UserMutation) ResetUpdatedAt() {
+	m.updated_at = nil
 }
 
-// AddedFields returns all numeric fields that were incremented/decremented during
-// this mutation.
-func (m *PostCategoryRelationMutation) AddedFields() []string {
-	var fields []string
-	return fields
+// SetDeletedAt sets the "deleted_at" field.
+func (m *UserMutation) SetDeletedAt(t time.Time) {
+	m.deleted_at = &t
 }
 
-// AddedField returns the numeric value that was incremented/decremented on a field
-// with the given name. The second boolean return value indicates that this field
-// was not set, or was not defined in the schema.
-func (m *PostCategoryRelationMutation) AddedField(name string) (ent.Value, bool) {
-	switch name {
+// DeletedAt returns the value of the "deleted_at" field in the mutation.
+func (m *UserMutation) DeletedAt() (r time.Time, exists bool) {
+	v := m.deleted_at
+	if v == nil {
+		return
 	}
-	return nil, false
+	return *v, true
 }
 
-// AddField adds the value to the field with the given name. It returns an error if
-// the field is not defined in the schema, or if the type mismatched the field
-// type.
-func (m *PostCategoryRelationMutation) AddField(name string, value ent.Value) error {
-	switch name {
+// OldDeletedAt returns the old "deleted_at" field's value of the User entity.
+// If the User object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *UserMutation) OldDeletedAt(ctx context.Context) (v *time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldDeletedAt is only allowed on UpdateOne operations")
 	}
-	return fmt.Errorf("unknown PostCategoryRelation numeric field %s", name)
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldDeletedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldDeletedAt: %w", err)
+	}
+	return oldValue.DeletedAt, nil
 }
 
-// ClearedFields returns all nullable fields that were cleared during this
-// mutation.
-func (m *PostCategoryRelationMutation) ClearedFields() []string {
-	return nil
+// ClearDeletedAt clears the value of the "deleted_at" field.
+func (m *UserMutation) ClearDeletedAt() {
+	m.deleted_at = nil
+	m.clearedFields[user.FieldDeletedAt] = struct{}{}
 }
 
-// FieldCleared returns a boolean indicating if a field with the given name was
-// cleared in this mutation.
-func (m *PostCategoryRelationMutation) FieldCleared(name string) bool {
-	_, ok := m.clearedFields[name]
+// DeletedAtCleared returns if the "deleted_at" field was cleared in this mutation.
+func (m *UserMutation) DeletedAtCleared() bool {
+	_, ok := m.clearedFields[user.FieldDeletedAt]
 	return ok
 }
 
-// ClearField clears the value of the field with the given name. It returns an
-// error if the field is not defined in the schema.
-func (m *PostCategoryRelationMutation) ClearField(name string) error {
-	return fmt.Errorf("unknown PostCategoryRelation nullable field %s", name)
+// ResetDeletedAt resets all changes to the "deleted_at" field.
+func (m *UserMutation) ResetDeletedAt() {
+	m.deleted_at = nil
+	delete(m.clearedFields, user.FieldDeletedAt)
 }
 
-// ResetField resets all changes in the mutation for the field with the given name.
-// It returns an error if the field is not defined in the schema.
-func (m *PostCategoryRelationMutation) ResetField(name string) error {
-	switch name {
-	case postcategoryrelation.FieldPostID:
-		m.ResetPostID()
-		return nil
-	case postcategoryrelation.FieldPostCategoryID:
-		m.ResetPostCategoryID()
-		return nil
+// SetUUID sets the "uuid" field.
+func (m *UserMutation) SetUUID(u uuid.UUID) {
+	m.uuid = &u
+}
+
+// UUID returns the value of the "uuid" field in the mutation.
+func (m *UserMutation) UUID() (r uuid.UUID, exists bool) {
+	v := m.uuid
+	if v == nil {
+		return
 	}
-	return fmt.Errorf("unknown PostCategoryRelation field %s", name)
+	return *v, true
 }
 
-// AddedEdges returns all edge names that were set/added in this mutation.
-func (m *PostCategoryRelationMutation) AddedEdges() []string {
-	edges := make([]string, 0, 2)
-	if m.post != nil {
-		edges = append(edges, postcategoryrelation.EdgePost)
+// OldUUID returns the old "uuid" field's value of the User entity.
+// If the User object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *UserMutation) OldUUID(ctx context.Context) (v uuid.UUID, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldUUID is only allowed on UpdateOne operations")
 	}
-	if m.category != nil {
-		edges = append(edges, postcategoryrelation.EdgeCategory)
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldUUID requires an ID field in the mutation")
 	}
-	return edges
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldUUID: %w", err)
+	}
+	return oldValue.UUID, nil
 }
 
-// AddedIDs returns all IDs (to other nodes) that were added for the given edge
-// name in this mutation.
-func (m *PostCategoryRelationMutation) AddedIDs(name string) []ent.Value {
-	switch name {
-	case postcategoryrelation.EdgePost:
-		if id := m.post; id != nil {
-			return []ent.Value{*id}
-		}
-	case postcategoryrelation.EdgeCategory:
-		if id := m.category; id != nil {
-			return []ent.Value{*id}
-		}
-	}
-	return nil
+// ResetUUID resets all changes to the "uuid" field.
+func (m *UserMutation) ResetUUID() {
+	m.uuid = nil
 }
 
-// RemovedEdges returns all edge names that were removed in this mutation.
-func (m *PostCategoryRelationMutation) RemovedEdges() []string {
-	edges := make([]string, 0, 2)
-	return edges
+// SetAvatar sets the "avatar" field.
+func (m *UserMutation) SetAvatar(s string) {
+	m.avatar = &s
 }
 
-// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
-// the given name in this mutation.
-func (m *PostCategoryRelationMutation) RemovedIDs(name string) []ent.Value {
-	return nil
+// Avatar returns the value of the "avatar" field in the mutation.
+func (m *UserMutation) Avatar() (r string, exists bool) {
+	v := m.avatar
+	if v == nil {
+		return
+	}
+	return *v, true
 }
 
-// ClearedEdges returns all edge names that were cleared in this mutation.
-func (m *PostCategoryRelationMutation) ClearedEdges() []string {
-	edges := make([]string, 0, 2)
-	if m.clearedpost {
-		edges = append(edges, postcategoryrelation.EdgePost)
+// OldAvatar returns the old "avatar" field's value of the User entity.
+// If the User object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *UserMutation) OldAvatar(ctx context.Context) (v *string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldAvatar is only allowed on UpdateOne operations")
 	}
-	if m.clearedcategory {
-		edges = append(edges, postcategoryrelation.EdgeCategory)
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldAvatar requires an ID field in the mutation")
 	}
-	return edges
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldAvatar: %w", err)
+	}
+	return oldValue.Avatar, nil
 }
 
-// EdgeCleared returns a boolean which indicates if the edge with the given name
-// was cleared in this mutation.
-func (m *PostCategoryRelationMutation) EdgeCleared(name string) bool {
-	switch name {
-	case postcategoryrelation.EdgePost:
-		return m.clearedpost
-	case postcategoryrelation.EdgeCategory:
-		return m.clearedcategory
-	}
-	return false
+// ClearAvatar clears the value of the "avatar" field.
+func (m *UserMutation) ClearAvatar() {
+	m.avatar = nil
+	m.clearedFields[user.FieldAvatar] = struct{}{}
 }
 
-// ClearEdge clears the value of the edge with the given name. It returns an error
-// if that edge is not defined in the schema.
-func (m *PostCategoryRelationMutation) ClearEdge(name string) error {
-	switch name {
-	case postcategoryrelation.EdgePost:
-		m.ClearPost()
-		return nil
-	case postcategoryrelation.EdgeCategory:
-		m.ClearCategory()
-		return nil
+// AvatarCleared returns if the "avatar" field was cleared in this mutation.
+func (m *UserMutation) AvatarCleared() bool {
+	_, ok := m.clearedFields[user.FieldAvatar]
+	return ok
+}
+
+// ResetAvatar resets all changes to the "avatar" field.
+func (m *UserMutation) ResetAvatar() {
+	m.avatar = nil
+	delete(m.clearedFields, user.FieldAvatar)
+}
+
+// SetEmail sets the "email" field.
+func (m *UserMutation) SetEmail(s string) {
+	m.email = &s
+}
+
+// Email returns the value of the "email" field in the mutation.
+func (m *UserMutation) Email() (r string, exists bool) {
+	v := m.email
+	if v == nil {
+		return
 	}
-	return fmt.Errorf("unknown PostCategoryRelation unique edge %s", name)
+	return *v, true
 }
 
-// ResetEdge resets all changes to the edge with the given name in this mutation.
-// It returns an error if the edge is not defined in the schema.
-func (m *PostCategoryRelationMutation) ResetEdge(name string) error {
-	switch name {
-	case postcategoryrelation.EdgePost:
-		m.ResetPost()
-		return nil
-	case postcategoryrelation.EdgeCategory:
-		m.ResetCategory()
-		return nil
+// OldEmail returns the old "email" field's value of the User entity.
+// If the User object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *UserMutation) OldEmail(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldEmail is only allowed on UpdateOne operations")
 	}
-	return fmt.Errorf("unknown PostCategoryRelation edge %s", name)
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldEmail requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldEmail: %w", err)
+	}
+	return oldValue.Email, nil
 }
 
-// PostTagMutation represents an operation that mutates the PostTag nodes in the graph.
-type PostTagMutation struct {
-	config
-	op            Op
-	typ           string
-	id            *uint
-	created_at    *time.Time
-	updated_at    *time.Time
-	deleted_at    *time.Time
-	name          *string
-	slug          *string
-	clearedFields map[string]struct{}
-	posts         map[uint]struct{}
-	removedposts  map[uint]struct{}
-	clearedposts  bool
-	done          bool
-	oldValue      func(context.Context) (*PostTag, error)
-	predicates    []predicate.PostTag
+// ResetEmail resets all changes to the "email" field.
+func (m *UserMutation) ResetEmail() {
+	m.email = nil
 }
 
-var _ ent.Mutation = (*PostTagMutation)(nil)
+// SetPassword sets the "password" field.
+func (m *UserMutation) SetPassword(s string) {
+	m.password = &s
+}
 
-// posttagOption allows management of the mutation configuration using functional options.
-type posttagOption func(*PostTagMutation)
+// Password returns the value of the "password" field in the mutation.
+func (m *UserMutation) Password() (r string, exists bool) {
+	v := m.password
+	if v == nil {
+		return
+	}
+	return *v, true
+}
 
-// newPostTagMutation creates new mutation for the PostTag entity.
-func newPostTagMutation(c config, op Op, opts ...posttagOption) *PostTagMutation {
-	m := &PostTagMutation{
-		config:        c,
-		op:            op,
-		typ:           TypePostTag,
-		clearedFields: make(map[string]struct{}),
+// OldPassword returns the old "password" field's value of the User entity.
+// If the User object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *UserMutation) OldPassword(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldPassword is only allowed on UpdateOne operations")
 	}
-	for _, opt := range opts {
-		opt(m)
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldPassword requires an ID field in the mutation")
 	}
-	return m
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldPassword: %w", err)
+	}
+	return oldValue.Password, nil
 }
 
-// withPostTagID sets the ID field of the mutation.
-func withPostTagID(id uint) posttagOption {
-	return func(m *PostTagMutation) {
-		var (
-			err   error
-			once  sync.Once
-			value *PostTag
-		)
-		m.oldValue = func(ctx context.Context) (*PostTag, error) {
-			once.Do(func() {
-				if m.done {
-					err = errors.New("querying old values post mutation is not allowed")
-				} else {
-					value, err = m.Client().PostTag.Get(ctx, id)
-				}
-			})
-			return value, err
-		}
-		m.id = &id
-	}
+// ResetPassword resets all changes to the "password" field.
+func (m *UserMutation) ResetPassword() {
+	m.password = nil
 }
 
-// withPostTag sets the old PostTag of the mutation.
-func withPostTag(node *PostTag) posttagOption {
-	return func(m *PostTagMutation) {
-		m.oldValue = func(context.Context) (*PostTag, error) {
-			return node, nil
-		}
-		m.id = &node.ID
-	}
+// SetRole sets the "role" field.
+func (m *UserMutation) SetRole(er entity.UserRole) {
+	m.role = &er
 }
 
-// Client returns a new `ent.Client` from the mutation. If the mutation was
-// executed in a transaction (ent.Tx), a transactional client is returned.
-func (m PostTagMutation) Client() *Client {
-	client := &Client{config: m.config}
-	client.init()
-	return client
+// Role returns the value of the "role" field in the mutation.
+func (m *UserMutation) Role() (r entity.UserRole, exists bool) {
+	v := m.role
+	if v == nil {
+		return
+	}
+	return *v, true
 }
 
-// Tx returns an `ent.Tx` for mutations that were executed in transactions;
-// it returns an error otherwise.
-func (m PostTagMutation) Tx() (*Tx, error) {
-	if _, ok := m.driver.(*txDriver); !ok {
-		return nil, errors.New("ent: mutation is not running in a transaction")
+// OldRole returns the old "role" field's value of the User entity.
+// If the User object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *UserMutation) OldRole(ctx context.Context) (v entity.UserRole, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldRole is only allowed on UpdateOne operations")
 	}
-	tx := &Tx{config: m.config}
-	tx.init()
-	return tx, nil
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldRole requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldRole: %w", err)
+	}
+	return oldValue.Role, nil
 }
 
-// SetID sets the value of the id field. Note that this
-// operation is only accepted on creation of PostTag entities.
-func (m *PostTagMutation) SetID(id uint) {
-	m.id = &id
+// ResetRole resets all changes to the "role" field.
+func (m *UserMutation) ResetRole() {
+	m.role = nil
 }
 
-// ID returns the ID value in the mutation. Note that the ID is only available
-// if it was provided to the builder or after it was returned from the database.
-func (m *PostTagMutation) ID() (id uint, exists bool) {
-	if m.id == nil {
+// SetStatus sets the "status" field.
+func (m *UserMutation) SetStatus(es entity.UserStatus) {
+	m.status = &es
+}
+
+// Status returns the value of the "status" field in the mutation.
+func (m *UserMutation) Status() (r entity.UserStatus, exists bool) {
+	v := m.status
+	if v == nil {
 		return
 	}
-	return *m.id, true
+	return *v, true
 }
 
-// IDs queries the database and returns the entity ids that match the mutation's predicate.
-// That means, if the mutation is applied within a transaction with an isolation level such
-// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
-// or updated by the mutation.
-func (m *PostTagMutation) IDs(ctx context.Context) ([]uint, error) {
-	switch {
-	case m.op.Is(OpUpdateOne | OpDeleteOne):
-		id, exists := m.ID()
-		if exists {
-			return []uint{id}, nil
-		}
-		fallthrough
-	case m.op.Is(OpUpdate | OpDelete):
-		return m.Client().PostTag.Query().Where(m.predicates...).IDs(ctx)
-	default:
-		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+// OldStatus returns the old "status" field's value of the User entity.
+// If the User object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *UserMutation) OldStatus(ctx context.Context) (v entity.UserStatus, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldStatus is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldStatus requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldStatus: %w", err)
 	}
+	return oldValue.Status, nil
 }
 
-// SetCreatedAt sets the "created_at" field.
-func (m *PostTagMutation) SetCreatedAt(t time.Time) {
-	m.created_at = &t
+// ResetStatus resets all changes to the "status" field.
+func (m *UserMutation) ResetStatus() {
+	m.status = nil
 }
 
-// CreatedAt returns the value of the "created_at" field in the mutation.
-func (m *PostTagMutation) CreatedAt() (r time.Time, exists bool) {
-	v := m.created_at
+// SetUsername sets the "username" field.
+func (m *UserMutation) SetUsername(s string) {
+	m.username = &s
+}
+
+// Username returns the value of the "username" field in the mutation.
+func (m *UserMutation) Username() (r string, exists bool) {
+	v := m.username
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldCreatedAt returns the old "created_at" field's value of the PostTag entity.
-// If the PostTag object wasn't provided to the builder, the object is fetched from the database.
+// OldUsername returns the old "username" field's value of the User entity.
+// If the User object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *PostTagMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
+func (m *UserMutation) OldUsername(ctx context.Context) (v string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
+		return v, errors.New("OldUsername is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldCreatedAt requires an ID field in the mutation")
+		return v, errors.New("OldUsername requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldCreatedAt: %w", err)
+		return v, fmt.Errorf("querying old value for OldUsername: %w", err)
 	}
-	return oldValue.CreatedAt, nil
+	return oldValue.Username, nil
 }
 
-// ResetCreatedAt resets all changes to the "created_at" field.
-func (m *PostTagMutation) ResetCreatedAt() {
-	m.created_at = nil
+// ResetUsername resets all changes to the "username" field.
+func (m *UserMutation) ResetUsername() {
+	m.username = nil
 }
 
-// SetUpdatedAt sets the "updated_at" field.
-func (m *PostTagMutation) SetUpdatedAt(t time.Time) {
-	m.updated_at = &t
+// SetBio sets the "bio" field.
+func (m *UserMutation) SetBio(s string) {
+	m.bio = &s
 }
 
-// UpdatedAt returns the value of the "updated_at" field in the mutation.
-func (m *PostTagMutation) UpdatedAt() (r time.Time, exists bool) {
-	v := m.updated_at
+// Bio returns the value of the "bio" field in the mutation.
+func (m *UserMutation) Bio() (r string, exists bool) {
+	v := m.bio
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldUpdatedAt returns the old "updated_at" field's value of the PostTag entity.
-// If the PostTag object wasn't provided to the builder, the object is fetched from the database.
+// OldBio returns the old "bio" field's value of the User entity.
+// If the User object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *PostTagMutation) OldUpdatedAt(ctx context.Context) (v time.Time, err error) {
+func (m *UserMutation) OldBio(ctx context.Context) (v *string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldUpdatedAt is only allowed on UpdateOne operations")
+		return v, errors.New("OldBio is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldUpdatedAt requires an ID field in the mutation")
+		return v, errors.New("OldBio requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldUpdatedAt: %w", err)
+		return v, fmt.Errorf("querying old value for OldBio: %w", err)
 	}
-	return oldValue.UpdatedAt, nil
+	return oldValue.Bio, nil
 }
 
-// ResetUpdatedAt resets all changes to the "updated_at" field.
-func (m *PostTagMutation) ResetUpdatedAt() {
-	m.updated_at = nil
+// ClearBio clears the value of the "bio" field.
+func (m *UserMutation) ClearBio() {
+	m.bio = nil
+	m.clearedFields[user.FieldBio] = struct{}{}
 }
 
-// SetDeletedAt sets the "deleted_at" field.
-func (m *PostTagMutation) SetDeletedAt(t time.Time) {
-	m.deleted_at = &t
+// BioCleared returns if the "bio" field was cleared in this mutation.
+func (m *UserMutation) BioCleared() bool {
+	_, ok := m.clearedFields[user.FieldBio]
+	return ok
 }
 
-// DeletedAt returns the value of the "deleted_at" field in the mutation.
-func (m *PostTagMutation) DeletedAt() (r time.Time, exists bool) {
-	v := m.deleted_at
+// ResetBio resets all changes to the "bio" field.
+func (m *UserMutation) ResetBio() {
+	m.bio = nil
+	delete(m.clearedFields, user.FieldBio)
+}
+
+// SetTotpSecret sets the "totp_secret" field.
+func (m *UserMutation) SetTotpSecret(s string) {
+	m.totp_secret = &s
+}
+
+// TotpSecret returns the value of the "totp_secret" field in the mutation.
+func (m *UserMutation) TotpSecret() (r string, exists bool) {
+	v := m.totp_secret
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldDeletedAt returns the old "deleted_at" field's value of the PostTag entity.
-// If the PostTag object wasn't provided to the builder, the object is fetched from the database.
+// OldTotpSecret returns the old "totp_secret" field's value of the User entity.
+// If the User object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *PostTagMutation) OldDeletedAt(ctx context.Context) (v *time.Time, err error) {
+func (m *UserMutation) OldTotpSecret(ctx context.Context) (v *string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldDeletedAt is only allowed on UpdateOne operations")
+		return v, errors.New("OldTotpSecret is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldDeletedAt requires an ID field in the mutation")
+		return v, errors.New("OldTotpSecret requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldDeletedAt: %w", err)
+		return v, fmt.Errorf("querying old value for OldTotpSecret: %w", err)
 	}
-	return oldValue.DeletedAt, nil
+	return oldValue.TotpSecret, nil
 }
 
-// ClearDeletedAt clears the value of the "deleted_at" field.
-func (m *PostTagMutation) ClearDeletedAt() {
-	m.deleted_at = nil
-	m.clearedFields[posttag.FieldDeletedAt] = struct{}{}
+// ClearTotpSecret clears the value of the "totp_secret" field.
+func (m *UserMutation) ClearTotpSecret() {
+	m.totp_secret = nil
+	m.clearedFields[user.FieldTotpSecret] = struct{}{}
 }
 
-// DeletedAtCleared returns if the "deleted_at" field was cleared in this mutation.
-func (m *PostTagMutation) DeletedAtCleared() bool {
-	_, ok := m.clearedFields[posttag.FieldDeletedAt]
+// TotpSecretCleared returns if the "totp_secret" field was cleared in this mutation.
+func (m *UserMutation) TotpSecretCleared() bool {
+	_, ok := m.clearedFields[user.FieldTotpSecret]
 	return ok
 }
 
-// ResetDeletedAt resets all changes to the "deleted_at" field.
-func (m *PostTagMutation) ResetDeletedAt() {
-	m.deleted_at = nil
-	delete(m.clearedFields, posttag.FieldDeletedAt)
+// ResetTotpSecret resets all changes to the "totp_secret" field.
+func (m *UserMutation) ResetTotpSecret() {
+	m.totp_secret = nil
+	delete(m.clearedFields, user.FieldTotpSecret)
 }
 
-// SetName sets the "name" field.
-func (m *PostTagMutation) SetName(s string) {
-	m.name = &s
+// SetTotpEnabled sets the "totp_enabled" field.
+func (m *UserMutation) SetTotpEnabled(b bool) {
+	m.totp_enabled = &b
 }
 
-// Name returns the value of the "name" field in the mutation.
-func (m *PostTagMutation) Name() (r string, exists bool) {
-	v := m.name
+// TotpEnabled returns the value of the "totp_enabled" field in the mutation.
+func (m *UserMutation) TotpEnabled() (r bool, exists bool) {
+	v := m.totp_enabled
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldName returns the old "name" field's value of the PostTag entity.
-// If the PostTag object wasn't provided to the builder, the object is fetched from the database.
+// OldTotpEnabled returns the old "totp_enabled" field's value of the User entity.
+// If the User object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *PostTagMutation) OldName(ctx context.Context) (v string, err error) {
+func (m *UserMutation) OldTotpEnabled(ctx context.Context) (v bool, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldName is only allowed on UpdateOne operations")
+		return v, errors.New("OldTotpEnabled is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldName requires an ID field in the mutation")
+		return v, errors.New("OldTotpEnabled requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldName: %w", err)
+		return v, fmt.Errorf("querying old value for OldTotpEnabled: %w", err)
 	}
-	return oldValue.Name, nil
+	return oldValue.TotpEnabled, nil
 }
 
-// ResetName resets all changes to the "name" field.
-func (m *PostTagMutation) ResetName() {
-	m.name = nil
+// ResetTotpEnabled resets all changes to the "totp_enabled" field.
+func (m *UserMutation) ResetTotpEnabled() {
+	m.totp_enabled = nil
 }
 
-// SetSlug sets the "slug" field.
-func (m *PostTagMutation) SetSlug(s string) {
-	m.slug = &s
+// SetTotpRecoveryCodes sets the "totp_recovery_codes" field.
+func (m *UserMutation) SetTotpRecoveryCodes(s []string) {
+	m.totp_recovery_codes = &s
+	m.appendtotp_recovery_codes = nil
 }
 
-// Slug returns the value of the "slug" field in the mutation.
-func (m *PostTagMutation) Slug() (r string, exists bool) {
-	v := m.slug
+// TotpRecoveryCodes returns the value of the "totp_recovery_codes" field in the mutation.
+func (m *UserMutation) TotpRecoveryCodes() (r []string, exists bool) {
+	v := m.totp_recovery_codes
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldSlug returns the old "slug" field's value of the PostTag entity.
-// If the PostTag object wasn't provided to the builder, the object is fetched from the database.
+// OldTotpRecoveryCodes returns the old "totp_recovery_codes" field's value of the User entity.
+// If the User object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *PostTagMutation) OldSlug(ctx context.Context) (v string, err error) {
+func (m *UserMutation) OldTotpRecoveryCodes(ctx context.Context) (v []string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldSlug is only allowed on UpdateOne operations")
+		return v, errors.New("OldTotpRecoveryCodes is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldSlug requires an ID field in the mutation")
+		return v, errors.New("OldTotpRecoveryCodes requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldSlug: %w", err)
+		return v, fmt.Errorf("querying old value for OldTotpRecoveryCodes: %w", err)
+	}
+	return oldValue.TotpRecoveryCodes, nil
+}
+
+// AppendTotpRecoveryCodes adds s to the "totp_recovery_codes" field.
+func (m *UserMutation) AppendTotpRecoveryCodes(s []string) {
+	m.appendtotp_recovery_codes = append(m.appendtotp_recovery_codes, s...)
+}
+
+// AppendedTotpRecoveryCodes returns the list of values that were appended to the "totp_recovery_codes" field in this mutation.
+func (m *UserMutation) AppendedTotpRecoveryCodes() ([]string, bool) {
+	if len(m.appendtotp_recovery_codes) == 0 {
+		return nil, false
 	}
-	return oldValue.Slug, nil
+	return m.appendtotp_recovery_codes, true
 }
 
-// ResetSlug resets all changes to the "slug" field.
-func (m *PostTagMutation) ResetSlug() {
-	m.slug = nil
+// ClearTotpRecoveryCodes clears the value of the "totp_recovery_codes" field.
+func (m *UserMutation) ClearTotpRecoveryCodes() {
+	m.totp_recovery_codes = nil
+	m.appendtotp_recovery_codes = nil
+	m.clearedFields[user.FieldTotpRecoveryCodes] = struct{}{}
+}
+
+// TotpRecoveryCodesCleared returns if the "totp_recovery_codes" field was cleared in this mutation.
+func (m *UserMutation) TotpRecoveryCodesCleared() bool {
+	_, ok := m.clearedFields[user.FieldTotpRecoveryCodes]
+	return ok
+}
+
+// ResetTotpRecoveryCodes resets all changes to the "totp_recovery_codes" field.
+func (m *UserMutation) ResetTotpRecoveryCodes() {
+	m.totp_recovery_codes = nil
+	m.appendtotp_recovery_codes = nil
+	delete(m.clearedFields, user.FieldTotpRecoveryCodes)
 }
 
 // AddPostIDs adds the "posts" edge to the Post entity by ids.
-func (m *PostTagMutation) AddPostIDs(ids ...uint) {
+func (m *UserMutation) AddPostIDs(ids ...uint) {
 	if m.posts == nil {
 		m.posts = make(map[uint]struct{})
 	}
@@ -4942,17 +12643,17 @@ func (m *PostTagMutation) AddPostIDs(ids ...uint) {
 }
 
 // ClearPosts clears the "posts" edge to the Post entity.
-func (m *PostTagMutation) ClearPosts() {
+func (m *UserMutation) ClearPosts() {
 	m.clearedposts = true
 }
 
 // PostsCleared reports if the "posts" edge to the Post entity was cleared.
-func (m *PostTagMutation) PostsCleared() bool {
+func (m *UserMutation) PostsCleared() bool {
 	return m.clearedposts
 }
 
 // RemovePostIDs removes the "posts" edge to the Post entity by IDs.
-func (m *PostTagMutation) RemovePostIDs(ids ...uint) {
+func (m *UserMutation) RemovePostIDs(ids ...uint) {
 	if m.removedposts == nil {
 		m.removedposts = make(map[uint]struct{})
 	}
@@ -4963,7 +12664,7 @@ func (m *PostTagMutation) RemovePostIDs(ids ...uint) {
 }
 
 // RemovedPosts returns the removed IDs of the "posts" edge to the Post entity.
-func (m *PostTagMutation) RemovedPostsIDs() (ids []uint) {
+func (m *UserMutation) RemovedPostsIDs() (ids []uint) {
 	for id := range m.removedposts {
 		ids = append(ids, id)
 	}
@@ -4971,7 +12672,7 @@ func (m *PostTagMutation) RemovedPostsIDs() (ids []uint) {
 }
 
 // PostsIDs returns the "posts" edge IDs in the mutation.
-func (m *PostTagMutation) PostsIDs() (ids []uint) {
+func (m *UserMutation) PostsIDs() (ids []uint) {
 	for id := range m.posts {
 		ids = append(ids, id)
 	}
@@ -4979,471 +12680,291 @@ func (m *PostTagMutation) PostsIDs() (ids []uint) {
 }
 
 // ResetPosts resets all changes to the "posts" edge.
-func (m *PostTagMutation) ResetPosts() {
+func (m *UserMutation) ResetPosts() {
 	m.posts = nil
 	m.clearedposts = false
 	m.removedposts = nil
 }
 
-// Where appends a list predicates to the PostTagMutation builder.
-func (m *PostTagMutation) Where(ps ...predicate.PostTag) {
-	m.predicates = append(m.predicates, ps...)
-}
-
-// WhereP appends storage-level predicates to the PostTagMutation builder. Using this method,
-// users can use type-assertion to append predicates that do not depend on any generated package.
-func (m *PostTagMutation) WhereP(ps ...func(*sql.Selector)) {
-	p := make([]predicate.PostTag, len(ps))
-	for i := range ps {
-		p[i] = ps[i]
-	}
-	m.Where(p...)
-}
-
-// Op returns the operation name.
-func (m *PostTagMutation) Op() Op {
-	return m.op
-}
-
-// SetOp allows setting the mutation operation.
-func (m *PostTagMutation) SetOp(op Op) {
-	m.op = op
-}
-
-// Type returns the node type of this mutation (PostTag).
-func (m *PostTagMutation) Type() string {
-	return m.typ
-}
-
-// Fields returns all fields that were changed during this mutation. Note that in
-// order to get all numeric fields that were incremented/decremented, call
-// AddedFields().
-func (m *PostTagMutation) Fields() []string {
-	fields := make([]string, 0, 5)
-	if m.created_at != nil {
-		fields = append(fields, posttag.FieldCreatedAt)
-	}
-	if m.updated_at != nil {
-		fields = append(fields, posttag.FieldUpdatedAt)
-	}
-	if m.deleted_at != nil {
-		fields = append(fields, posttag.FieldDeletedAt)
-	}
-	if m.name != nil {
-		fields = append(fields, posttag.FieldName)
-	}
-	if m.slug != nil {
-		fields = append(fields, posttag.FieldSlug)
-	}
-	return fields
-}
-
-// Field returns the value of a field with the given name. The second boolean
-// return value indicates that this field was not set, or was not defined in the
-// schema.
-func (m *PostTagMutation) Field(name string) (ent.Value, bool) {
-	switch name {
-	case posttag.FieldCreatedAt:
-		return m.CreatedAt()
-	case posttag.FieldUpdatedAt:
-		return m.UpdatedAt()
-	case posttag.FieldDeletedAt:
-		return m.DeletedAt()
-	case posttag.FieldName:
-		return m.Name()
-	case posttag.FieldSlug:
-		return m.Slug()
-	}
-	return nil, false
-}
-
-// OldField returns the old value of the field from the database. An error is
-// returned if the mutation operation is not UpdateOne, or the query to the
-// database failed.
-func (m *PostTagMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
-	switch name {
-	case posttag.FieldCreatedAt:
-		return m.OldCreatedAt(ctx)
-	case posttag.FieldUpdatedAt:
-		return m.OldUpdatedAt(ctx)
-	case posttag.FieldDeletedAt:
-		return m.OldDeletedAt(ctx)
-	case posttag.FieldName:
-		return m.OldName(ctx)
-	case posttag.FieldSlug:
-		return m.OldSlug(ctx)
-	}
-	return nil, fmt.Errorf("unknown PostTag field %s", name)
-}
-
-// SetField sets the value of a field with the given name. It returns an error if
-// the field is not defined in the schema, or if the type mismatched the field
-// type.
-func (m *PostTagMutation) SetField(name string, value ent.Value) error {
-	switch name {
-	case posttag.FieldCreatedAt:
-		v, ok := value.(time.Time)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetCreatedAt(v)
-		return nil
-	case posttag.FieldUpdatedAt:
-		v, ok := value.(time.Time)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetUpdatedAt(v)
-		return nil
-	case posttag.FieldDeletedAt:
-		v, ok := value.(time.Time)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetDeletedAt(v)
-		return nil
-	case posttag.FieldName:
-		v, ok := value.(string)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetName(v)
-		return nil
-	case posttag.FieldSlug:
-		v, ok := value.(string)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetSlug(v)
-		return nil
-	}
-	return fmt.Errorf("unknown PostTag field %s", name)
-}
-
-// AddedFields returns all numeric fields that were incremented/decremented during
-// this mutation.
-func (m *PostTagMutation) AddedFields() []string {
-	return nil
-}
-
-// AddedField returns the numeric value that was incremented/decremented on a field
-// with the given name. The second boolean return value indicates that this field
-// was not set, or was not defined in the schema.
-func (m *PostTagMutation) AddedField(name string) (ent.Value, bool) {
-	return nil, false
-}
-
-// AddField adds the value to the field with the given name. It returns an error if
-// the field is not defined in the schema, or if the type mismatched the field
-// type.
-func (m *PostTagMutation) AddField(name string, value ent.Value) error {
-	switch name {
-	}
-	return fmt.Errorf("unknown PostTag numeric field %s", name)
-}
-
-// ClearedFields returns all nullable fields that were cleared during this
-// mutation.
-func (m *PostTagMutation) ClearedFields() []string {
-	var fields []string
-	if m.FieldCleared(posttag.FieldDeletedAt) {
-		fields = append(fields, posttag.FieldDeletedAt)
-	}
-	return fields
-}
-
-// FieldCleared returns a boolean indicating if a field with the given name was
-// cleared in this mutation.
-func (m *PostTagMutation) FieldCleared(name string) bool {
-	_, ok := m.clearedFields[name]
-	return ok
-}
-
-// ClearField clears the value of the field with the given name. It returns an
-// error if the field is not defined in the schema.
-func (m *PostTagMutation) ClearField(name string) error {
-	switch name {
-	case posttag.FieldDeletedAt:
-		m.ClearDeletedAt()
-		return nil
-	}
-	return fmt.Errorf("unknown PostTag nullable field %s", name)
-}
-
-// ResetField resets all changes in the mutation for the field with the given name.
-// It returns an error if the field is not defined in the schema.
-func (m *PostTagMutation) ResetField(name string) error {
-	switch name {
-	case posttag.FieldCreatedAt:
-		m.ResetCreatedAt()
-		return nil
-	case posttag.FieldUpdatedAt:
-		m.ResetUpdatedAt()
-		return nil
-	case posttag.FieldDeletedAt:
-		m.ResetDeletedAt()
-		return nil
-	case posttag.FieldName:
-		m.ResetName()
-		return nil
-	case posttag.FieldSlug:
-		m.ResetSlug()
-		return nil
-	}
-	return fmt.Errorf("unknown PostTag field %s", name)
-}
-
-// AddedEdges returns all edge names that were set/added in this mutation.
-func (m *PostTagMutation) AddedEdges() []string {
-	edges := make([]string, 0, 1)
-	if m.posts != nil {
-		edges = append(edges, posttag.EdgePosts)
-	}
-	return edges
-}
-
-// AddedIDs returns all IDs (to other nodes) that were added for the given edge
-// name in this mutation.
-func (m *PostTagMutation) AddedIDs(name string) []ent.Value {
-	switch name {
-	case posttag.EdgePosts:
-		ids := make([]ent.Value, 0, len(m.posts))
-		for id := range m.posts {
-			ids = append(ids, id)
-		}
-		return ids
-	}
-	return nil
-}
-
-// RemovedEdges returns all edge names that were removed in this mutation.
-func (m *PostTagMutation) RemovedEdges() []string {
-	edges := make([]string, 0, 1)
-	if m.removedposts != nil {
-		edges = append(edges, posttag.EdgePosts)
+// AddCommentIDs adds the "comments" edge to the Comment entity by ids.
+func (m *UserMutation) AddCommentIDs(ids ...uint) {
+	if m.comments == nil {
+		m.comments = make(map[uint]struct{})
+	}
+	for i := range ids {
+		m.comments[ids[i]] = struct{}{}
 	}
-	return edges
 }
 
-// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
-// the given name in this mutation.
-func (m *PostTagMutation) RemovedIDs(name string) []ent.Value {
-	switch name {
-	case posttag.EdgePosts:
-		ids := make([]ent.Value, 0, len(m.removedposts))
-		for id := range m.removedposts {
-			ids = append(ids, id)
-		}
-		return ids
-	}
-	return nil
+// ClearComments clears the "comments" edge to the Comment entity.
+func (m *UserMutation) ClearComments() {
+	m.clearedcomments = true
 }
 
-// ClearedEdges returns all edge names that were cleared in this mutation.
-func (m *PostTagMutation) ClearedEdges() []string {
-	edges := make([]string, 0, 1)
-	if m.clearedposts {
-		edges = append(edges, posttag.EdgePosts)
+// CommentsCleared reports if the "comments" edge to the Comment entity was cleared.
+func (m *UserMutation) CommentsCleared() bool {
+	return m.clearedcomments
+}
+
+// RemoveCommentIDs removes the "comments" edge to the Comment entity by IDs.
+func (m *UserMutation) RemoveCommentIDs(ids ...uint) {
+	if m.removedcomments == nil {
+		m.removedcomments = make(map[uint]struct{})
+	}
+	for i := range ids {
+		delete(m.comments, ids[i])
+		m.removedcomments[ids[i]] = struct{}{}
 	}
-	return edges
 }
 
-// EdgeCleared returns a boolean which indicates if the edge with the given name
-// was cleared in this mutation.
-func (m *PostTagMutation) EdgeCleared(name string) bool {
-	switch name {
-	case posttag.EdgePosts:
-		return m.clearedposts
+// RemovedComments returns the removed IDs of the "comments" edge to the Comment entity.
+func (m *UserMutation) RemovedCommentsIDs() (ids []uint) {
+	for id := range m.removedcomments {
+		ids = append(ids, id)
 	}
-	return false
+	return
 }
 
-// ClearEdge clears the value of the edge with the given name. It returns an error
-// if that edge is not defined in the schema.
-func (m *PostTagMutation) ClearEdge(name string) error {
-	switch name {
+// CommentsIDs returns the "comments" edge IDs in the mutation.
+func (m *UserMutation) CommentsIDs() (ids []uint) {
+	for id := range m.comments {
+		ids = append(ids, id)
 	}
-	return fmt.Errorf("unknown PostTag unique edge %s", name)
+	return
 }
 
-// ResetEdge resets all changes to the edge with the given name in this mutation.
-// It returns an error if the edge is not defined in the schema.
-func (m *PostTagMutation) ResetEdge(name string) error {
-	switch name {
-	case posttag.EdgePosts:
-		m.ResetPosts()
-		return nil
+// ResetComments resets all changes to the "comments" edge.
+func (m *UserMutation) ResetComments() {
+	m.comments = nil
+	m.clearedcomments = false
+	m.removedcomments = nil
+}
+
+// AddPasskeyIDs adds the "passkeys" edge to the Passkey entity by ids.
+func (m *UserMutation) AddPasskeyIDs(ids ...uint) {
+	if m.passkeys == nil {
+		m.passkeys = make(map[uint]struct{})
+	}
+	for i := range ids {
+		m.passkeys[ids[i]] = struct{}{}
 	}
-	return fmt.Errorf("unknown PostTag edge %s", name)
 }
 
-// PostTagRelationMutation represents an operation that mutates the PostTagRelation nodes in the graph.
-type PostTagRelationMutation struct {
-	config
-	op            Op
-	typ           string
-	clearedFields map[string]struct{}
-	post          *uint
-	clearedpost   bool
-	tag           *uint
-	clearedtag    bool
-	done          bool
-	oldValue      func(context.Context) (*PostTagRelation, error)
-	predicates    []predicate.PostTagRelation
+// ClearPasskeys clears the "passkeys" edge to the Passkey entity.
+func (m *UserMutation) ClearPasskeys() {
+	m.clearedpasskeys = true
 }
 
-var _ ent.Mutation = (*PostTagRelationMutation)(nil)
+// PasskeysCleared reports if the "passkeys" edge to the Passkey entity was cleared.
+func (m *UserMutation) PasskeysCleared() bool {
+	return m.clearedpasskeys
+}
 
-// posttagrelationOption allows management of the mutation configuration using functional options.
-type posttagrelationOption func(*PostTagRelationMutation)
+// RemovePasskeyIDs removes the "passkeys" edge to the Passkey entity by IDs.
+func (m *UserMutation) RemovePasskeyIDs(ids ...uint) {
+	if m.removedpasskeys == nil {
+		m.removedpasskeys = make(map[uint]struct{})
+	}
+	for i := range ids {
+		delete(m.passkeys, ids[i])
+		m.removedpasskeys[ids[i]] = struct{}{}
+	}
+}
 
-// newPostTagRelationMutation creates new mutation for the PostTagRelation entity.
-func newPostTagRelationMutation(c config, op Op, opts ...posttagrelationOption) *PostTagRelationMutation {
-	m := &PostTagRelationMutation{
-		config:        c,
-		op:            op,
-		typ:           TypePostTagRelation,
-		clearedFields: make(map[string]struct{}),
+// RemovedPasskeys returns the removed IDs of the "passkeys" edge to the Passkey entity.
+func (m *UserMutation) RemovedPasskeysIDs() (ids []uint) {
+	for id := range m.removedpasskeys {
+		ids = append(ids, id)
 	}
-	for _, opt := range opts {
-		opt(m)
+	return
+}
+
+// PasskeysIDs returns the "passkeys" edge IDs in the mutation.
+func (m *UserMutation) PasskeysIDs() (ids []uint) {
+	for id := range m.passkeys {
+		ids = append(ids, id)
 	}
-	return m
+	return
 }
 
-// Client returns a new `ent.Client` from the mutation. If the mutation was
-// executed in a transaction (ent.Tx), a transactional client is returned.
-func (m PostTagRelationMutation) Client() *Client {
-	client := &Client{config: m.config}
-	client.init()
-	return client
+// ResetPasskeys resets all changes to the "passkeys" edge.
+func (m *UserMutation) ResetPasskeys() {
+	m.passkeys = nil
+	m.clearedpasskeys = false
+	m.removedpasskeys = nil
 }
 
-// Tx returns an `ent.Tx` for mutations that were executed in transactions;
-// it returns an error otherwise.
-func (m PostTagRelationMutation) Tx() (*Tx, error) {
-	if _, ok := m.driver.(*txDriver); !ok {
-		return nil, errors.New("ent: mutation is not running in a transaction")
+// AddIdentityIDs adds the "identities" edge to the UserIdentity entity by ids.
+func (m *UserMutation) AddIdentityIDs(ids ...uint) {
+	if m.identities == nil {
+		m.identities = make(map[uint]struct{})
+	}
+	for i := range ids {
+		m.identities[ids[i]] = struct{}{}
 	}
-	tx := &Tx{config: m.config}
-	tx.init()
-	return tx, nil
 }
 
-// SetPostID sets the "post_id" field.
-func (m *PostTagRelationMutation) SetPostID(u uint) {
-	m.post = &u
+// ClearIdentities clears the "identities" edge to the UserIdentity entity.
+func (m *UserMutation) ClearIdentities() {
+	m.clearedidentities = true
 }
 
-// PostID returns the value of the "post_id" field in the mutation.
-func (m *PostTagRelationMutation) PostID() (r uint, exists bool) {
-	v := m.post
-	if v == nil {
-		return
+// IdentitiesCleared reports if the "identities" edge to the UserIdentity entity was cleared.
+func (m *UserMutation) IdentitiesCleared() bool {
+	return m.clearedidentities
+}
+
+// RemoveIdentityIDs removes the "identities" edge to the UserIdentity entity by IDs.
+func (m *UserMutation) RemoveIdentityIDs(ids ...uint) {
+	if m.removedidentities == nil {
+		m.removedidentities = make(map[uint]struct{})
+	}
+	for i := range ids {
+		delete(m.identities, ids[i])
+		m.removedidentities[ids[i]] = struct{}{}
 	}
-	return *v, true
 }
 
-// ResetPostID resets all changes to the "post_id" field.
-func (m *PostTagRelationMutation) ResetPostID() {
-	m.post = nil
+// RemovedIdentities returns the removed IDs of the "identities" edge to the UserIdentity entity.
+func (m *UserMutation) RemovedIdentitiesIDs() (ids []uint) {
+	for id := range m.removedidentities {
+		ids = append(ids, id)
+	}
+	return
 }
 
-// SetPostTagID sets the "post_tag_id" field.
-func (m *PostTagRelationMutation) SetPostTagID(u uint) {
-	m.tag = &u
+// IdentitiesIDs returns the "identities" edge IDs in the mutation.
+func (m *UserMutation) IdentitiesIDs() (ids []uint) {
+	for id := range m.identities {
+		ids = append(ids, id)
+	}
+	return
 }
 
-// PostTagID returns the value of the "post_tag_id" field in the mutation.
-func (m *PostTagRelationMutation) PostTagID() (r uint, exists bool) {
-	v := m.tag
-	if v == nil {
-		return
+// ResetIdentities resets all changes to the "identities" edge.
+func (m *UserMutation) ResetIdentities() {
+	m.identities = nil
+	m.clearedidentities = false
+	m.removedidentities = nil
+}
+
+// AddAccessTokenIDs adds the "access_tokens" edge to the PersonalAccessToken entity by ids.
+func (m *UserMutation) AddAccessTokenIDs(ids ...uint) {
+	if m.access_tokens == nil {
+		m.access_tokens = make(map[uint]struct{})
+	}
+	for i := range ids {
+		m.access_tokens[ids[i]] = struct{}{}
 	}
-	return *v, true
 }
 
-// ResetPostTagID resets all changes to the "post_tag_id" field.
-func (m *PostTagRelationMutation) ResetPostTagID() {
-	m.tag = nil
+// ClearAccessTokens clears the "access_tokens" edge to the PersonalAccessToken entity.
+func (m *UserMutation) ClearAccessTokens() {
+	m.clearedaccess_tokens = true
 }
 
-// ClearPost clears the "post" edge to the Post entity.
-func (m *PostTagRelationMutation) ClearPost() {
-	m.clearedpost = true
-	m.clearedFields[posttagrelation.FieldPostID] = struct{}{}
+// AccessTokensCleared reports if the "access_tokens" edge to the PersonalAccessToken entity was cleared.
+func (m *UserMutation) AccessTokensCleared() bool {
+	return m.clearedaccess_tokens
 }
 
-// PostCleared reports if the "post" edge to the Post entity was cleared.
-func (m *PostTagRelationMutation) PostCleared() bool {
-	return m.clearedpost
+// RemoveAccessTokenIDs removes the "access_tokens" edge to the PersonalAccessToken entity by IDs.
+func (m *UserMutation) RemoveAccessTokenIDs(ids ...uint) {
+	if m.removedaccess_tokens == nil {
+		m.removedaccess_tokens = make(map[uint]struct{})
+	}
+	for i := range ids {
+		delete(m.access_tokens, ids[i])
+		m.removedaccess_tokens[ids[i]] = struct{}{}
+	}
 }
 
-// PostIDs returns the "post" edge IDs in the mutation.
-// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
-// PostID instead. It exists only for internal usage by the builders.
-func (m *PostTagRelationMutation) PostIDs() (ids []uint) {
-	if id := m.post; id != nil {
-		ids = append(ids, *id)
+// RemovedAccessTokens returns the removed IDs of the "access_tokens" edge to the PersonalAccessToken entity.
+func (m *UserMutation) RemovedAccessTokensIDs() (ids []uint) {
+	for id := range m.removedaccess_tokens {
+		ids = append(ids, id)
 	}
 	return
 }
 
-// ResetPost resets all changes to the "post" edge.
-func (m *PostTagRelationMutation) ResetPost() {
-	m.post = nil
-	m.clearedpost = false
+// AccessTokensIDs returns the "access_tokens" edge IDs in the mutation.
+func (m *UserMutation) AccessTokensIDs() (ids []uint) {
+	for id := range m.access_tokens {
+		ids = append(ids, id)
+	}
+	return
 }
 
-// SetTagID sets the "tag" edge to the PostTag entity by id.
-func (m *PostTagRelationMutation) SetTagID(id uint) {
-	m.tag = &id
+// ResetAccessTokens resets all changes to the "access_tokens" edge.
+func (m *UserMutation) ResetAccessTokens() {
+	m.access_tokens = nil
+	m.clearedaccess_tokens = false
+	m.removedaccess_tokens = nil
 }
 
-// ClearTag clears the "tag" edge to the PostTag entity.
-func (m *PostTagRelationMutation) ClearTag() {
-	m.clearedtag = true
-	m.clearedFields[posttagrelation.FieldPostTagID] = struct{}{}
+// AddPostRevisionIDs adds the "post_revisions" edge to the PostRevision entity by ids.
+func (m *UserMutation) AddPostRevisionIDs(ids ...uint) {
+	if m.post_revisions == nil {
+		m.post_revisions = make(map[uint]struct{})
+	}
+	for i := range ids {
+		m.post_revisions[ids[i]] = struct{}{}
+	}
 }
 
-// TagCleared reports if the "tag" edge to the PostTag entity was cleared.
-func (m *PostTagRelationMutation) TagCleared() bool {
-	return m.clearedtag
+// ClearPostRevisions clears the "post_revisions" edge to the PostRevision entity.
+func (m *UserMutation) ClearPostRevisions() {
+	m.clearedpost_revisions = true
 }
 
-// TagID returns the "tag" edge ID in the mutation.
-func (m *PostTagRelationMutation) TagID() (id uint, exists bool) {
-	if m.tag != nil {
-		return *m.tag, true
+// PostRevisionsCleared reports if the "post_revisions" edge to the PostRevision entity was cleared.
+func (m *UserMutation) PostRevisionsCleared() bool {
+	return m.clearedpost_revisions
+}
+
+// RemovePostRevisionIDs removes the "post_revisions" edge to the PostRevision entity by IDs.
+func (m *UserMutation) RemovePostRevisionIDs(ids ...uint) {
+	if m.removedpost_revisions == nil {
+		m.removedpost_revisions = make(map[uint]struct{})
+	}
+	for i := range ids {
+		delete(m.post_revisions, ids[i])
+		m.removedpost_revisions[ids[i]] = struct{}{}
+	}
+}
+
+// RemovedPostRevisions returns the removed IDs of the "post_revisions" edge to the PostRevision entity.
+func (m *UserMutation) RemovedPostRevisionsIDs() (ids []uint) {
+	for id := range m.removedpost_revisions {
+		ids = append(ids, id)
 	}
 	return
 }
 
-// TagIDs returns the "tag" edge IDs in the mutation.
-// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
-// TagID instead. It exists only for internal usage by the builders.
-func (m *PostTagRelationMutation) TagIDs() (ids []uint) {
-	if id := m.tag; id != nil {
-		ids = append(ids, *id)
+// PostRevisionsIDs returns the "post_revisions" edge IDs in the mutation.
+func (m *UserMutation) PostRevisionsIDs() (ids []uint) {
+	for id := range m.post_revisions {
+		ids = append(ids, id)
 	}
 	return
 }
 
-// ResetTag resets all changes to the "tag" edge.
-func (m *PostTagRelationMutation) ResetTag() {
-	m.tag = nil
-	m.clearedtag = false
+// ResetPostRevisions resets all changes to the "post_revisions" edge.
+func (m *UserMutation) ResetPostRevisions() {
+	m.post_revisions = nil
+	m.clearedpost_revisions = false
+	m.removedpost_revisions = nil
 }
 
-// Where appends a list predicates to the PostTagRelationMutation builder.
-func (m *PostTagRelationMutation) Where(ps ...predicate.PostTagRelation) {
+// Where appends a list predicates to the UserMutation builder.
+func (m *UserMutation) Where(ps ...predicate.User) {
 	m.predicates = append(m.predicates, ps...)
 }
 
-// WhereP appends storage-level predicates to the PostTagRelationMutation builder. Using this method,
+// WhereP appends storage-level predicates to the UserMutation builder. Using this method,
 // users can use type-assertion to append predicates that do not depend on any generated package.
-func (m *PostTagRelationMutation) WhereP(ps ...func(*sql.Selector)) {
-	p := make([]predicate.PostTagRelation, len(ps))
+func (m *UserMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.User, len(ps))
 	for i := range ps {
 		p[i] = ps[i]
 	}
@@ -5451,30 +12972,66 @@ func (m *PostTagRelationMutation) WhereP(ps ...func(*sql.Selector)) {
 }
 
 // Op returns the operation name.
-func (m *PostTagRelationMutation) Op() Op {
+func (m *UserMutation) Op() Op {
 	return m.op
 }
 
 // SetOp allows setting the mutation operation.
-func (m *PostTagRelationMutation) SetOp(op Op) {
+func (m *UserMutation) SetOp(op Op) {
 	m.op = op
 }
 
-// Type returns the node type of this mutation (PostTagRelation).
-func (m *PostTagRelationMutation) Type() string {
+// Type returns the node type of this mutation (User).
+func (m *UserMutation) Type() string {
 	return m.typ
 }
 
 // Fields returns all fields that were changed during this mutation. Note that in
 // order to get all numeric fields that were incremented/decremented, call
 // AddedFields().
-func (m *PostTagRelationMutation) Fields() []string {
-	fields := make([]string, 0, 2)
-	if m.post != nil {
-		fields = append(fields, posttagrelation.FieldPostID)
+func (m *UserMutation) Fields() []string {
+	fields := make([]string, 0, 14)
+	if m.created_at != nil {
+		fields = append(fields, user.FieldCreatedAt)
+	}
+	if m.updated_at != nil {
+		fields = append(fields, user.FieldUpdatedAt)
+	}
+	if m.deleted_at != nil {
+		fields = append(fields, user.FieldDeletedAt)
+	}
+	if m.uuid != nil {
+		fields = append(fields, user.FieldUUID)
+	}
+	if m.avatar != nil {
+		fields = append(fields, user.FieldAvatar)
+	}
+	if m.email != nil {
+		fields = append(fields, user.FieldEmail)
+	}
+	if m.password != nil {
+		fields = append(fields, user.FieldPassword)
+	}
+	if m.role != nil {
+		fields = append(fields, user.FieldRole)
+	}
+	if m.status != nil {
+		fields = append(fields, user.FieldStatus)
 	}
-	if m.tag != nil {
-		fields = append(fields, posttagrelation.FieldPostTagID)
+	if m.username != nil {
+		fields = append(fields, user.FieldUsername)
+	}
+	if m.bio != nil {
+		fields = append(fields, user.FieldBio)
+	}
+	if m.totp_secret != nil {
+		fields = append(fields, user.FieldTotpSecret)
+	}
+	if m.totp_enabled != nil {
+		fields = append(fields, user.FieldTotpEnabled)
+	}
+	if m.totp_recovery_codes != nil {
+		fields = append(fields, user.FieldTotpRecoveryCodes)
 	}
 	return fields
 }
@@ -5482,12 +13039,36 @@ func (m *PostTagRelationMutation) Fields() []string {
 // Field returns the value of a field with the given name. The second boolean
 // return value indicates that this field was not set, or was not defined in the
 // schema.
-func (m *PostTagRelationMutation) Field(name string) (ent.Value, bool) {
+func (m *UserMutation) Field(name string) (ent.Value, bool) {
 	switch name {
-	case posttagrelation.FieldPostID:
-		return m.PostID()
-	case posttagrelation.FieldPostTagID:
-		return m.PostTagID()
+	case user.FieldCreatedAt:
+		return m.CreatedAt()
+	case user.FieldUpdatedAt:
+		return m.UpdatedAt()
+	case user.FieldDeletedAt:
+		return m.DeletedAt()
+	case user.FieldUUID:
+		return m.UUID()
+	case user.FieldAvatar:
+		return m.Avatar()
+	case user.FieldEmail:
+		return m.Email()
+	case user.FieldPassword:
+		return m.Password()
+	case user.FieldRole:
+		return m.Role()
+	case user.FieldStatus:
+		return m.Status()
+	case user.FieldUsername:
+		return m.Username()
+	case user.FieldBio:
+		return m.Bio()
+	case user.FieldTotpSecret:
+		return m.TotpSecret()
+	case user.FieldTotpEnabled:
+		return m.TotpEnabled()
+	case user.FieldTotpRecoveryCodes:
+		return m.TotpRecoveryCodes()
 	}
 	return nil, false
 }
@@ -5495,185 +13076,487 @@ func (m *PostTagRelationMutation) Field(name string) (ent.Value, bool) {
 // OldField returns the old value of the field from the database. An error is
 // returned if the mutation operation is not UpdateOne, or the query to the
 // database failed.
-func (m *PostTagRelationMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
-	return nil, errors.New("edge schema PostTagRelation does not support getting old values")
+func (m *UserMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case user.FieldCreatedAt:
+		return m.OldCreatedAt(ctx)
+	case user.FieldUpdatedAt:
+		return m.OldUpdatedAt(ctx)
+	case user.FieldDeletedAt:
+		return m.OldDeletedAt(ctx)
+	case user.FieldUUID:
+		return m.OldUUID(ctx)
+	case user.FieldAvatar:
+		return m.OldAvatar(ctx)
+	case user.FieldEmail:
+		return m.OldEmail(ctx)
+	case user.FieldPassword:
+		return m.OldPassword(ctx)
+	case user.FieldRole:
+		return m.OldRole(ctx)
+	case user.FieldStatus:
+		return m.OldStatus(ctx)
+	case user.FieldUsername:
+		return m.OldUsername(ctx)
+	case user.FieldBio:
+		return m.OldBio(ctx)
+	case user.FieldTotpSecret:
+		return m.OldTotpSecret(ctx)
+	case user.FieldTotpEnabled:
+		return m.OldTotpEnabled(ctx)
+	case user.FieldTotpRecoveryCodes:
+		return m.OldTotpRecoveryCodes(ctx)
+	}
+	return nil, fmt.Errorf("unknown User field %s", name)
 }
 
 // SetField sets the value of a field with the given name. It returns an error if
 // the field is not defined in the schema, or if the type mismatched the field
 // type.
-func (m *PostTagRelationMutation) SetField(name string, value ent.Value) error {
+func (m *UserMutation) SetField(name string, value ent.Value) error {
 	switch name {
-	case posttagrelation.FieldPostID:
-		v, ok := value.(uint)
+	case user.FieldCreatedAt:
+		v, ok := value.(time.Time)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetPostID(v)
+		m.SetCreatedAt(v)
 		return nil
-	case posttagrelation.FieldPostTagID:
-		v, ok := value.(uint)
+	case user.FieldUpdatedAt:
+		v, ok := value.(time.Time)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetPostTagID(v)
+		m.SetUpdatedAt(v)
+		return nil
+	case user.FieldDeletedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetDeletedAt(v)
+		return nil
+	case user.FieldUUID:
+		v, ok := value.(uuid.UUID)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetUUID(v)
+		return nil
+	case user.FieldAvatar:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetAvatar(v)
+		return nil
+	case user.FieldEmail:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetEmail(v)
+		return nil
+	case user.FieldPassword:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetPassword(v)
+		return nil
+	case user.FieldRole:
+		v, ok := value.(entity.UserRole)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetRole(v)
+		return nil
+	case user.FieldStatus:
+		v, ok := value.(entity.UserStatus)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetStatus(v)
+		return nil
+	case user.FieldUsername:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetUsername(v)
+		return nil
+	case user.FieldBio:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetBio(v)
+		return nil
+	case user.FieldTotpSecret:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetTotpSecret(v)
+		return nil
+	case user.FieldTotpEnabled:
+		v, ok := value.(bool)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetTotpEnabled(v)
+		return nil
+	case user.FieldTotpRecoveryCodes:
+		v, ok := value.([]string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetTotpRecoveryCodes(v)
 		return nil
 	}
-	return fmt.Errorf("unknown PostTagRelation field %s", name)
+	return fmt.Errorf("unknown User field %s", name)
 }
 
 // AddedFields returns all numeric fields that were incremented/decremented during
 // this mutation.
-func (m *PostTagRelationMutation) AddedFields() []string {
-	var fields []string
-	return fields
+func (m *UserMutation) AddedFields() []string {
+	return nil
 }
 
 // AddedField returns the numeric value that was incremented/decremented on a field
 // with the given name. The second boolean return value indicates that this field
 // was not set, or was not defined in the schema.
-func (m *PostTagRelationMutation) AddedField(name string) (ent.Value, bool) {
-	switch name {
-	}
+func (m *UserMutation) AddedField(name string) (ent.Value, bool) {
 	return nil, false
 }
 
 // AddField adds the value to the field with the given name. It returns an error if
 // the field is not defined in the schema, or if the type mismatched the field
 // type.
-func (m *PostTagRelationMutation) AddField(name string, value ent.Value) error {
+func (m *UserMutation) AddField(name string, value ent.Value) error {
 	switch name {
 	}
-	return fmt.Errorf("unknown PostTagRelation numeric field %s", name)
+	return fmt.Errorf("unknown User numeric field %s", name)
 }
 
 // ClearedFields returns all nullable fields that were cleared during this
 // mutation.
-func (m *PostTagRelationMutation) ClearedFields() []string {
-	return nil
+func (m *UserMutation) ClearedFields() []string {
+	var fields []string
+	if m.FieldCleared(user.FieldDeletedAt) {
+		fields = append(fields, user.FieldDeletedAt)
+	}
+	if m.FieldCleared(user.FieldAvatar) {
+		fields = append(fields, user.FieldAvatar)
+	}
+	if m.FieldCleared(user.FieldBio) {
+		fields = append(fields, user.FieldBio)
+	}
+	if m.FieldCleared(user.FieldTotpSecret) {
+		fields = append(fields, user.FieldTotpSecret)
+	}
+	if m.FieldCleared(user.FieldTotpRecoveryCodes) {
+		fields = append(fields, user.FieldTotpRecoveryCodes)
+	}
+	return fields
 }
 
 // FieldCleared returns a boolean indicating if a field with the given name was
 // cleared in this mutation.
-func (m *PostTagRelationMutation) FieldCleared(name string) bool {
+func (m *UserMutation) FieldCleared(name string) bool {
 	_, ok := m.clearedFields[name]
 	return ok
 }
 
 // ClearField clears the value of the field with the given name. It returns an
 // error if the field is not defined in the schema.
-func (m *PostTagRelationMutation) ClearField(name string) error {
-	return fmt.Errorf("unknown PostTagRelation nullable field %s", name)
+func (m *UserMutation) ClearField(name string) error {
+	switch name {
+	case user.FieldDeletedAt:
+		m.ClearDeletedAt()
+		return nil
+	case user.FieldAvatar:
+		m.ClearAvatar()
+		return nil
+	case user.FieldBio:
+		m.ClearBio()
+		return nil
+	case user.FieldTotpSecret:
+		m.ClearTotpSecret()
+		return nil
+	case user.FieldTotpRecoveryCodes:
+		m.ClearTotpRecoveryCodes()
+		return nil
+	}
+	return fmt.Errorf("unknown User nullable field %s", name)
 }
 
 // ResetField resets all changes in the mutation for the field with the given name.
 // It returns an error if the field is not defined in the schema.
-func (m *PostTagRelationMutation) ResetField(name string) error {
+func (m *UserMutation) ResetField(name string) error {
 	switch name {
-	case posttagrelation.FieldPostID:
-		m.ResetPostID()
+	case user.FieldCreatedAt:
+		m.ResetCreatedAt()
 		return nil
-	case posttagrelation.FieldPostTagID:
-		m.ResetPostTagID()
+	case user.FieldUpdatedAt:
+		m.ResetUpdatedAt()
+		return nil
+	case user.FieldDeletedAt:
+		m.ResetDeletedAt()
+		return nil
+	case user.FieldUUID:
+		m.ResetUUID()
+		return nil
+	case user.FieldAvatar:
+		m.ResetAvatar()
+		return nil
+	case user.FieldEmail:
+		m.ResetEmail()
+		return nil
+	case user.FieldPassword:
+		m.ResetPassword()
+		return nil
+	case user.FieldRole:
+		m.ResetRole()
+		return nil
+	case user.FieldStatus:
+		m.ResetStatus()
+		return nil
+	case user.FieldUsername:
+		m.ResetUsername()
+		return nil
+	case user.FieldBio:
+		m.ResetBio()
+		return nil
+	case user.FieldTotpSecret:
+		m.ResetTotpSecret()
+		return nil
+	case user.FieldTotpEnabled:
+		m.ResetTotpEnabled()
+		return nil
+	case user.FieldTotpRecoveryCodes:
+		m.ResetTotpRecoveryCodes()
 		return nil
 	}
-	return fmt.Errorf("unknown PostTagRelation field %s", name)
+	return fmt.Errorf("unknown User field %s", name)
 }
 
 // AddedEdges returns all edge names that were set/added in this mutation.
-func (m *PostTagRelationMutation) AddedEdges() []string {
-	edges := make([]string, 0, 2)
-	if m.post != nil {
-		edges = append(edges, posttagrelation.EdgePost)
+func (m *UserMutation) AddedEdges() []string {
+	edges := make([]string, 0, 6)
+	if m.posts != nil {
+		edges = append(edges, user.EdgePosts)
 	}
-	if m.tag != nil {
-		edges = append(edges, posttagrelation.EdgeTag)
+	if m.comments != nil {
+		edges = append(edges, user.EdgeComments)
+	}
+	if m.passkeys != nil {
+		edges = append(edges, user.EdgePasskeys)
+	}
+	if m.identities != nil {
+		edges = append(edges, user.EdgeIdentities)
+	}
+	if m.access_tokens != nil {
+		edges = append(edges, user.EdgeAccessTokens)
+	}
+	if m.post_revisions != nil {
+		edges = append(edges, user.EdgePostRevisions)
 	}
 	return edges
 }
 
 // AddedIDs returns all IDs (to other nodes) that were added for the given edge
 // name in this mutation.
-func (m *PostTagRelationMutation) AddedIDs(name string) []ent.Value {
+func (m *UserMutation) AddedIDs(name string) []ent.Value {
 	switch name {
-	case posttagrelation.EdgePost:
-		if id := m.post; id != nil {
-			return []ent.Value{*id}
+	case user.EdgePosts:
+		ids := make([]ent.Value, 0, len(m.posts))
+		for id := range m.posts {
+			ids = append(ids, id)
+		}
+		return ids
+	case user.EdgeComments:
+		ids := make([]ent.Value, 0, len(m.comments))
+		for id := range m.comments {
+			ids = append(ids, id)
 		}
-	case posttagrelation.EdgeTag:
-		if id := m.tag; id != nil {
-			return []ent.Value{*id}
+		return ids
+	case user.EdgePasskeys:
+		ids := make([]ent.Value, 0, len(m.passkeys))
+		for id := range m.passkeys {
+			ids = append(ids, id)
+		}
+		return ids
+	case user.EdgeIdentities:
+		ids := make([]ent.Value, 0, len(m.identities))
+		for id := range m.identities {
+			ids = append(ids, id)
 		}
+		return ids
+	case user.EdgeAccessTokens:
+		ids := make([]ent.Value, 0, len(m.access_tokens))
+		for id := range m.access_tokens {
+			ids = append(ids, id)
+		}
+		return ids
+	case user.EdgePostRevisions:
+		ids := make([]ent.Value, 0, len(m.post_revisions))
+		for id := range m.post_revisions {
+			ids = append(ids, id)
+		}
+		return ids
 	}
 	return nil
 }
 
 // RemovedEdges returns all edge names that were removed in this mutation.
-func (m *PostTagRelationMutation) RemovedEdges() []string {
-	edges := make([]string, 0, 2)
+func (m *UserMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 6)
+	if m.removedposts != nil {
+		edges = append(edges, user.EdgePosts)
+	}
+	if m.removedcomments != nil {
+		edges = append(edges, user.EdgeComments)
+	}
+	if m.removedpasskeys != nil {
+		edges = append(edges, user.EdgePasskeys)
+	}
+	if m.removedidentities != nil {
+		edges = append(edges, user.EdgeIdentities)
+	}
+	if m.removedaccess_tokens != nil {
+		edges = append(edges, user.EdgeAccessTokens)
+	}
+	if m.removedpost_revisions != nil {
+		edges = append(edges, user.EdgePostRevisions)
+	}
 	return edges
 }
 
 // RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
 // the given name in this mutation.
-func (m *PostTagRelationMutation) RemovedIDs(name string) []ent.Value {
+func (m *UserMutation) RemovedIDs(name string) []ent.Value {
+	switch name {
+	case user.EdgePosts:
+		ids := make([]ent.Value, 0, len(m.removedposts))
+		for id := range m.removedposts {
+			ids = append(ids, id)
+		}
+		return ids
+	case user.EdgeComments:
+		ids := make([]ent.Value, 0, len(m.removedcomments))
+		for id := range m.removedcomments {
+			ids = append(ids, id)
+		}
+		return ids
+	case user.EdgePasskeys:
+		ids := make([]ent.Value, 0, len(m.removedpasskeys))
+		for id := range m.removedpasskeys {
+			ids = append(ids, id)
+		}
+		return ids
+	case user.EdgeIdentities:
+		ids := make([]ent.Value, 0, len(m.removedidentities))
+		for id := range m.removedidentities {
+			ids = append(ids, id)
+		}
+		return ids
+	case user.EdgeAccessTokens:
+		ids := make([]ent.Value, 0, len(m.removedaccess_tokens))
+		for id := range m.removedaccess_tokens {
+			ids = append(ids, id)
+		}
+		return ids
+	case user.EdgePostRevisions:
+		ids := make([]ent.Value, 0, len(m.removedpost_revisions))
+		for id := range m.removedpost_revisions {
+			ids = append(ids, id)
+		}
+		return ids
+	}
 	return nil
 }
 
 // ClearedEdges returns all edge names that were cleared in this mutation.
-func (m *PostTagRelationMutation) ClearedEdges() []string {
-	edges := make([]string, 0, 2)
-	if m.clearedpost {
-		edges = append(edges, posttagrelation.EdgePost)
+func (m *UserMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 6)
+	if m.clearedposts {
+		edges = append(edges, user.EdgePosts)
 	}
-	if m.clearedtag {
-		edges = append(edges, posttagrelation.EdgeTag)
+	if m.clearedcomments {
+		edges = append(edges, user.EdgeComments)
+	}
+	if m.clearedpasskeys {
+		edges = append(edges, user.EdgePasskeys)
+	}
+	if m.clearedidentities {
+		edges = append(edges, user.EdgeIdentities)
+	}
+	if m.clearedaccess_tokens {
+		edges = append(edges, user.EdgeAccessTokens)
+	}
+	if m.clearedpost_revisions {
+		edges = append(edges, user.EdgePostRevisions)
 	}
 	return edges
 }
 
 // EdgeCleared returns a boolean which indicates if the edge with the given name
 // was cleared in this mutation.
-func (m *PostTagRelationMutation) EdgeCleared(name string) bool {
+func (m *UserMutation) EdgeCleared(name string) bool {
 	switch name {
-	case posttagrelation.EdgePost:
-		return m.clearedpost
-	case posttagrelation.EdgeTag:
-		return m.clearedtag
+	case user.EdgePosts:
+		return m.clearedposts
+	case user.EdgeComments:
+		return m.clearedcomments
+	case user.EdgePasskeys:
+		return m.clearedpasskeys
+	case user.EdgeIdentities:
+		return m.clearedidentities
+	case user.EdgeAccessTokens:
+		return m.clearedaccess_tokens
+	case user.EdgePostRevisions:
+		return m.clearedpost_revisions
 	}
 	return false
 }
 
 // ClearEdge clears the value of the edge with the given name. It returns an error
 // if that edge is not defined in the schema.
-func (m *PostTagRelationMutation) ClearEdge(name string) error {
+func (m *UserMutation) ClearEdge(name string) error {
 	switch name {
-	case posttagrelation.EdgePost:
-		m.ClearPost()
-		return nil
-	case posttagrelation.EdgeTag:
-		m.ClearTag()
-		return nil
 	}
-	return fmt.Errorf("unknown PostTagRelation unique edge %s", name)
+	return fmt.Errorf("unknown User unique edge %s", name)
 }
 
 // ResetEdge resets all changes to the edge with the given name in this mutation.
 // It returns an error if the edge is not defined in the schema.
-func (m *PostTagRelationMutation) ResetEdge(name string) error {
+func (m *UserMutation) ResetEdge(name string) error {
 	switch name {
-	case posttagrelation.EdgePost:
-		m.ResetPost()
+	case user.EdgePosts:
+		m.ResetPosts()
 		return nil
-	case posttagrelation.EdgeTag:
-		m.ResetTag()
+	case user.EdgeComments:
+		m.ResetComments()
+		return nil
+	case user.EdgePasskeys:
+		m.ResetPasskeys()
+		return nil
+	case user.EdgeIdentities:
+		m.ResetIdentities()
+		return nil
+	case user.EdgeAccessTokens:
+		m.ResetAccessTokens()
+		return nil
+	case user.EdgePostRevisions:
+		m.ResetPostRevisions()
 		return nil
 	}
-	return fmt.Errorf("unknown PostTagRelation edge %s", name)
+	return fmt.Errorf("unknown User edge %s", name)
 }
 
-// UserMutation represents an operation that mutates the User nodes in the graph.
-type UserMutation struct {
+// UserIdentityMutation represents an operation that mutates the UserIdentity nodes in the graph.
+type UserIdentityMutation struct {
 	config
 	op            Op
 	typ           string
@@ -5681,32 +13564,29 @@ type UserMutation struct {
 	created_at    *time.Time
 	updated_at    *time.Time
 	deleted_at    *time.Time
-	uuid          *uuid.UUID
-	avatar        *string
+	provider      *string
+	subject       *string
 	email         *string
-	password      *string
-	role          *entity.UserRole
-	username      *string
+	last_login_at *time.Time
 	clearedFields map[string]struct{}
-	posts         map[uint]struct{}
-	removedposts  map[uint]struct{}
-	clearedposts  bool
+	user          *uint
+	cleareduser   bool
 	done          bool
-	oldValue      func(context.Context) (*User, error)
-	predicates    []predicate.User
+	oldValue      func(context.Context) (*UserIdentity, error)
+	predicates    []predicate.UserIdentity
 }
 
-var _ ent.Mutation = (*UserMutation)(nil)
+var _ ent.Mutation = (*UserIdentityMutation)(nil)
 
-// userOption allows management of the mutation configuration using functional options.
-type userOption func(*UserMutation)
+// useridentityOption allows management of the mutation configuration using functional options.
+type useridentityOption func(*UserIdentityMutation)
 
-// newUserMutation creates new mutation for the User entity.
-func newUserMutation(c config, op Op, opts ...userOption) *UserMutation {
-	m := &UserMutation{
+// newUserIdentityMutation creates new mutation for the UserIdentity entity.
+func newUserIdentityMutation(c config, op Op, opts ...useridentityOption) *UserIdentityMutation {
+	m := &UserIdentityMutation{
 		config:        c,
 		op:            op,
-		typ:           TypeUser,
+		typ:           TypeUserIdentity,
 		clearedFields: make(map[string]struct{}),
 	}
 	for _, opt := range opts {
@@ -5715,20 +13595,20 @@ func newUserMutation(c config, op Op, opts ...userOption) *UserMutation {
 	return m
 }
 
-// withUserID sets the ID field of the mutation.
-func withUserID(id uint) userOption {
-	return func(m *UserMutation) {
+// withUserIdentityID sets the ID field of the mutation.
+func withUserIdentityID(id uint) useridentityOption {
+	return func(m *UserIdentityMutation) {
 		var (
 			err   error
 			once  sync.Once
-			value *User
+			value *UserIdentity
 		)
-		m.oldValue = func(ctx context.Context) (*User, error) {
+		m.oldValue = func(ctx context.Context) (*UserIdentity, error) {
 			once.Do(func() {
 				if m.done {
 					err = errors.New("querying old values post mutation is not allowed")
 				} else {
-					value, err = m.Client().User.Get(ctx, id)
+					value, err = m.Client().UserIdentity.Get(ctx, id)
 				}
 			})
 			return value, err
@@ -5737,10 +13617,10 @@ func withUserID(id uint) userOption {
 	}
 }
 
-// withUser sets the old User of the mutation.
-func withUser(node *User) userOption {
-	return func(m *UserMutation) {
-		m.oldValue = func(context.Context) (*User, error) {
+// withUserIdentity sets the old UserIdentity of the mutation.
+func withUserIdentity(node *UserIdentity) useridentityOption {
+	return func(m *UserIdentityMutation) {
+		m.oldValue = func(context.Context) (*UserIdentity, error) {
 			return node, nil
 		}
 		m.id = &node.ID
@@ -5749,7 +13629,7 @@ func withUser(node *User) userOption {
 
 // Client returns a new `ent.Client` from the mutation. If the mutation was
 // executed in a transaction (ent.Tx), a transactional client is returned.
-func (m UserMutation) Client() *Client {
+func (m UserIdentityMutation) Client() *Client {
 	client := &Client{config: m.config}
 	client.init()
 	return client
@@ -5757,7 +13637,7 @@ func (m UserMutation) Client() *Client {
 
 // Tx returns an `ent.Tx` for mutations that were executed in transactions;
 // it returns an error otherwise.
-func (m UserMutation) Tx() (*Tx, error) {
+func (m UserIdentityMutation) Tx() (*Tx, error) {
 	if _, ok := m.driver.(*txDriver); !ok {
 		return nil, errors.New("ent: mutation is not running in a transaction")
 	}
@@ -5767,14 +13647,14 @@ func (m UserMutation) Tx() (*Tx, error) {
 }
 
 // SetID sets the value of the id field. Note that this
-// operation is only accepted on creation of User entities.
-func (m *UserMutation) SetID(id uint) {
+// operation is only accepted on creation of UserIdentity entities.
+func (m *UserIdentityMutation) SetID(id uint) {
 	m.id = &id
 }
 
 // ID returns the ID value in the mutation. Note that the ID is only available
 // if it was provided to the builder or after it was returned from the database.
-func (m *UserMutation) ID() (id uint, exists bool) {
+func (m *UserIdentityMutation) ID() (id uint, exists bool) {
 	if m.id == nil {
 		return
 	}
@@ -5785,7 +13665,7 @@ func (m *UserMutation) ID() (id uint, exists bool) {
 // That means, if the mutation is applied within a transaction with an isolation level such
 // as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
 // or updated by the mutation.
-func (m *UserMutation) IDs(ctx context.Context) ([]uint, error) {
+func (m *UserIdentityMutation) IDs(ctx context.Context) ([]uint, error) {
 	switch {
 	case m.op.Is(OpUpdateOne | OpDeleteOne):
 		id, exists := m.ID()
@@ -5794,19 +13674,19 @@ func (m *UserMutation) IDs(ctx context.Context) ([]uint, error) {
 		}
 		fallthrough
 	case m.op.Is(OpUpdate | OpDelete):
-		return m.Client().User.Query().Where(m.predicates...).IDs(ctx)
+		return m.Client().UserIdentity.Query().Where(m.predicates...).IDs(ctx)
 	default:
 		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
 	}
 }
 
 // SetCreatedAt sets the "created_at" field.
-func (m *UserMutation) SetCreatedAt(t time.Time) {
+func (m *UserIdentityMutation) SetCreatedAt(t time.Time) {
 	m.created_at = &t
 }
 
 // CreatedAt returns the value of the "created_at" field in the mutation.
-func (m *UserMutation) CreatedAt() (r time.Time, exists bool) {
+func (m *UserIdentityMutation) CreatedAt() (r time.Time, exists bool) {
 	v := m.created_at
 	if v == nil {
 		return
@@ -5814,10 +13694,10 @@ func (m *UserMutation) CreatedAt() (r time.Time, exists bool) {
 	return *v, true
 }
 
-// OldCreatedAt returns the old "created_at" field's value of the User entity.
-// If the User object wasn't provided to the builder, the object is fetched from the database.
+// OldCreatedAt returns the old "created_at" field's value of the UserIdentity entity.
+// If the UserIdentity object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *UserMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
+func (m *UserIdentityMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
 	if !m.op.Is(OpUpdateOne) {
 		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
 	}
@@ -5832,17 +13712,17 @@ func (m *UserMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error
 }
 
 // ResetCreatedAt resets all changes to the "created_at" field.
-func (m *UserMutation) ResetCreatedAt() {
+func (m *UserIdentityMutation) ResetCreatedAt() {
 	m.created_at = nil
 }
 
 // SetUpdatedAt sets the "updated_at" field.
-func (m *UserMutation) SetUpdatedAt(t time.Time) {
+func (m *UserIdentityMutation) SetUpdatedAt(t time.Time) {
 	m.updated_at = &t
 }
 
 // UpdatedAt returns the value of the "updated_at" field in the mutation.
-func (m *UserMutation) UpdatedAt() (r time.Time, exists bool) {
+func (m *UserIdentityMutation) UpdatedAt() (r time.Time, exists bool) {
 	v := m.updated_at
 	if v == nil {
 		return
@@ -5850,10 +13730,10 @@ func (m *UserMutation) UpdatedAt() (r time.Time, exists bool) {
 	return *v, true
 }
 
-// OldUpdatedAt returns the old "updated_at" field's value of the User entity.
-// If the User object wasn't provided to the builder, the object is fetched from the database.
+// OldUpdatedAt returns the old "updated_at" field's value of the UserIdentity entity.
+// If the UserIdentity object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *UserMutation) OldUpdatedAt(ctx context.Context) (v time.Time, err error) {
+func (m *UserIdentityMutation) OldUpdatedAt(ctx context.Context) (v time.Time, err error) {
 	if !m.op.Is(OpUpdateOne) {
 		return v, errors.New("OldUpdatedAt is only allowed on UpdateOne operations")
 	}
@@ -5868,17 +13748,17 @@ func (m *UserMutation) OldUpdatedAt(ctx context.Context) (v time.Time, err error
 }
 
 // ResetUpdatedAt resets all changes to the "updated_at" field.
-func (m *UserMutation) ResetUpdatedAt() {
+func (m *UserIdentityMutation) ResetUpdatedAt() {
 	m.updated_at = nil
 }
 
 // SetDeletedAt sets the "deleted_at" field.
-func (m *UserMutation) SetDeletedAt(t time.Time) {
+func (m *UserIdentityMutation) SetDeletedAt(t time.Time) {
 	m.deleted_at = &t
 }
 
 // DeletedAt returns the value of the "deleted_at" field in the mutation.
-func (m *UserMutation) DeletedAt() (r time.Time, exists bool) {
+func (m *UserIdentityMutation) DeletedAt() (r time.Time, exists bool) {
 	v := m.deleted_at
 	if v == nil {
 		return
@@ -5886,10 +13766,10 @@ func (m *UserMutation) DeletedAt() (r time.Time, exists bool) {
 	return *v, true
 }
 
-// OldDeletedAt returns the old "deleted_at" field's value of the User entity.
-// If the User object wasn't provided to the builder, the object is fetched from the database.
+// OldDeletedAt returns the old "deleted_at" field's value of the UserIdentity entity.
+// If the UserIdentity object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *UserMutation) OldDeletedAt(ctx context.Context) (v *time.Time, err error) {
+func (m *UserIdentityMutation) OldDeletedAt(ctx context.Context) (v *time.Time, err error) {
 	if !m.op.Is(OpUpdateOne) {
 		return v, errors.New("OldDeletedAt is only allowed on UpdateOne operations")
 	}
@@ -5904,315 +13784,265 @@ func (m *UserMutation) OldDeletedAt(ctx context.Context) (v *time.Time, err erro
 }
 
 // ClearDeletedAt clears the value of the "deleted_at" field.
-func (m *UserMutation) ClearDeletedAt() {
+func (m *UserIdentityMutation) ClearDeletedAt() {
 	m.deleted_at = nil
-	m.clearedFields[user.FieldDeletedAt] = struct{}{}
+	m.clearedFields[useridentity.FieldDeletedAt] = struct{}{}
 }
 
 // DeletedAtCleared returns if the "deleted_at" field was cleared in this mutation.
-func (m *UserMutation) DeletedAtCleared() bool {
-	_, ok := m.clearedFields[user.FieldDeletedAt]
+func (m *UserIdentityMutation) DeletedAtCleared() bool {
+	_, ok := m.clearedFields[useridentity.FieldDeletedAt]
 	return ok
 }
 
 // ResetDeletedAt resets all changes to the "deleted_at" field.
-func (m *UserMutation) ResetDeletedAt() {
+func (m *UserIdentityMutation) ResetDeletedAt() {
 	m.deleted_at = nil
-	delete(m.clearedFields, user.FieldDeletedAt)
-}
-
-// SetUUID sets the "uuid" field.
-func (m *UserMutation) SetUUID(u uuid.UUID) {
-	m.uuid = &u
-}
-
-// UUID returns the value of the "uuid" field in the mutation.
-func (m *UserMutation) UUID() (r uuid.UUID, exists bool) {
-	v := m.uuid
-	if v == nil {
-		return
-	}
-	return *v, true
-}
-
-// OldUUID returns the old "uuid" field's value of the User entity.
-// If the User object wasn't provided to the builder, the object is fetched from the database.
-// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *UserMutation) OldUUID(ctx context.Context) (v uuid.UUID, err error) {
-	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldUUID is only allowed on UpdateOne operations")
-	}
-	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldUUID requires an ID field in the mutation")
-	}
-	oldValue, err := m.oldValue(ctx)
-	if err != nil {
-		return v, fmt.Errorf("querying old value for OldUUID: %w", err)
-	}
-	return oldValue.UUID, nil
-}
-
-// ResetUUID resets all changes to the "uuid" field.
-func (m *UserMutation) ResetUUID() {
-	m.uuid = nil
+	delete(m.clearedFields, useridentity.FieldDeletedAt)
 }
 
-// SetAvatar sets the "avatar" field.
-func (m *UserMutation) SetAvatar(s string) {
-	m.avatar = &s
+// SetUserID sets the "user_id" field.
+func (m *UserIdentityMutation) SetUserID(u uint) {
+	m.user = &u
 }
 
-// Avatar returns the value of the "avatar" field in the mutation.
-func (m *UserMutation) Avatar() (r string, exists bool) {
-	v := m.avatar
+// UserID returns the value of the "user_id" field in the mutation.
+func (m *UserIdentityMutation) UserID() (r uint, exists bool) {
+	v := m.user
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldAvatar returns the old "avatar" field's value of the User entity.
-// If the User object wasn't provided to the builder, the object is fetched from the database.
+// OldUserID returns the old "user_id" field's value of the UserIdentity entity.
+// If the UserIdentity object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *UserMutation) OldAvatar(ctx context.Context) (v *string, err error) {
+func (m *UserIdentityMutation) OldUserID(ctx context.Context) (v uint, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldAvatar is only allowed on UpdateOne operations")
+		return v, errors.New("OldUserID is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldAvatar requires an ID field in the mutation")
+		return v, errors.New("OldUserID requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldAvatar: %w", err)
+		return v, fmt.Errorf("querying old value for OldUserID: %w", err)
 	}
-	return oldValue.Avatar, nil
-}
-
-// ClearAvatar clears the value of the "avatar" field.
-func (m *UserMutation) ClearAvatar() {
-	m.avatar = nil
-	m.clearedFields[user.FieldAvatar] = struct{}{}
-}
-
-// AvatarCleared returns if the "avatar" field was cleared in this mutation.
-func (m *UserMutation) AvatarCleared() bool {
-	_, ok := m.clearedFields[user.FieldAvatar]
-	return ok
+	return oldValue.UserID, nil
 }
 
-// ResetAvatar resets all changes to the "avatar" field.
-func (m *UserMutation) ResetAvatar() {
-	m.avatar = nil
-	delete(m.clearedFields, user.FieldAvatar)
+// ResetUserID resets all changes to the "user_id" field.
+func (m *UserIdentityMutation) ResetUserID() {
+	m.user = nil
 }
 
-// SetEmail sets the "email" field.
-func (m *UserMutation) SetEmail(s string) {
-	m.email = &s
+// SetProvider sets the "provider" field.
+func (m *UserIdentityMutation) SetProvider(s string) {
+	m.provider = &s
 }
 
-// Email returns the value of the "email" field in the mutation.
-func (m *UserMutation) Email() (r string, exists bool) {
-	v := m.email
+// Provider returns the value of the "provider" field in the mutation.
+func (m *UserIdentityMutation) Provider() (r string, exists bool) {
+	v := m.provider
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldEmail returns the old "email" field's value of the User entity.
-// If the User object wasn't provided to the builder, the object is fetched from the database.
+// OldProvider returns the old "provider" field's value of the UserIdentity entity.
+// If the UserIdentity object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *UserMutation) OldEmail(ctx context.Context) (v string, err error) {
+func (m *UserIdentityMutation) OldProvider(ctx context.Context) (v string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldEmail is only allowed on UpdateOne operations")
+		return v, errors.New("OldProvider is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldEmail requires an ID field in the mutation")
+		return v, errors.New("OldProvider requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldEmail: %w", err)
+		return v, fmt.Errorf("querying old value for OldProvider: %w", err)
 	}
-	return oldValue.Email, nil
+	return oldValue.Provider, nil
 }
 
-// ResetEmail resets all changes to the "email" field.
-func (m *UserMutation) ResetEmail() {
-	m.email = nil
+// ResetProvider resets all changes to the "provider" field.
+func (m *UserIdentityMutation) ResetProvider() {
+	m.provider = nil
 }
 
-// SetPassword sets the "password" field.
-func (m *UserMutation) SetPassword(s string) {
-	m.password = &s
+// SetSubject sets the "subject" field.
+func (m *UserIdentityMutation) SetSubject(s string) {
+	m.subject = &s
 }
 
-// Password returns the value of the "password" field in the mutation.
-func (m *UserMutation) Password() (r string, exists bool) {
-	v := m.password
+// Subject returns the value of the "subject" field in the mutation.
+func (m *UserIdentityMutation) Subject() (r string, exists bool) {
+	v := m.subject
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldPassword returns the old "password" field's value of the User entity.
-// If the User object wasn't provided to the builder, the object is fetched from the database.
+// OldSubject returns the old "subject" field's value of the UserIdentity entity.
+// If the UserIdentity object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *UserMutation) OldPassword(ctx context.Context) (v string, err error) {
+func (m *UserIdentityMutation) OldSubject(ctx context.Context) (v string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldPassword is only allowed on UpdateOne operations")
+		return v, errors.New("OldSubject is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldPassword requires an ID field in the mutation")
+		return v, errors.New("OldSubject requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldPassword: %w", err)
+		return v, fmt.Errorf("querying old value for OldSubject: %w", err)
 	}
-	return oldValue.Password, nil
+	return oldValue.Subject, nil
 }
 
-// ResetPassword resets all changes to the "password" field.
-func (m *UserMutation) ResetPassword() {
-	m.password = nil
+// ResetSubject resets all changes to the "subject" field.
+func (m *UserIdentityMutation) ResetSubject() {
+	m.subject = nil
 }
-
-// SetRole sets the "role" field.
-func (m *UserMutation) SetRole(er entity.UserRole) {
-	m.role = &er
+
+// SetEmail sets the "email" field.
+func (m *UserIdentityMutation) SetEmail(s string) {
+	m.email = &s
 }
 
-// Role returns the value of the "role" field in the mutation.
-func (m *UserMutation) Role() (r entity.UserRole, exists bool) {
-	v := m.role
+// Email returns the value of the "email" field in the mutation.
+func (m *UserIdentityMutation) Email() (r string, exists bool) {
+	v := m.email
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldRole returns the old "role" field's value of the User entity.
-// If the User object wasn't provided to the builder, the object is fetched from the database.
+// OldEmail returns the old "email" field's value of the UserIdentity entity.
+// If the UserIdentity object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *UserMutation) OldRole(ctx context.Context) (v entity.UserRole, err error) {
+func (m *UserIdentityMutation) OldEmail(ctx context.Context) (v string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldRole is only allowed on UpdateOne operations")
+		return v, errors.New("OldEmail is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldRole requires an ID field in the mutation")
+		return v, errors.New("OldEmail requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldRole: %w", err)
+		return v, fmt.Errorf("querying old value for OldEmail: %w", err)
 	}
-	return oldValue.Role, nil
+	return oldValue.Email, nil
 }
 
-// ResetRole resets all changes to the "role" field.
-func (m *UserMutation) ResetRole() {
-	m.role = nil
+// ClearEmail clears the value of the "email" field.
+func (m *UserIdentityMutation) ClearEmail() {
+	m.email = nil
+	m.clearedFields[useridentity.FieldEmail] = struct{}{}
 }
 
-// SetUsername sets the "username" field.
-func (m *UserMutation) SetUsername(s string) {
-	m.username = &s
+// EmailCleared returns if the "email" field was cleared in this mutation.
+func (m *UserIdentityMutation) EmailCleared() bool {
+	_, ok := m.clearedFields[useridentity.FieldEmail]
+	return ok
 }
 
-// Username returns the value of the "username" field in the mutation.
-func (m *UserMutation) Username() (r string, exists bool) {
-	v := m.username
+// ResetEmail resets all changes to the "email" field.
+func (m *UserIdentityMutation) ResetEmail() {
+	m.email = nil
+	delete(m.clearedFields, useridentity.FieldEmail)
+}
+
+// SetLastLoginAt sets the "last_login_at" field.
+func (m *UserIdentityMutation) SetLastLoginAt(t time.Time) {
+	m.last_login_at = &t
+}
+
+// LastLoginAt returns the value of the "last_login_at" field in the mutation.
+func (m *UserIdentityMutation) LastLoginAt() (r time.Time, exists bool) {
+	v := m.last_login_at
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldUsername returns the old "username" field's value of the User entity.
-// If the User object wasn't provided to the builder, the object is fetched from the database.
+// OldLastLoginAt returns the old "last_login_at" field's value of the UserIdentity entity.
+// If the UserIdentity object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *UserMutation) OldUsername(ctx context.Context) (v string, err error) {
+func (m *UserIdentityMutation) OldLastLoginAt(ctx context.Context) (v *time.Time, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldUsername is only allowed on UpdateOne operations")
+		return v, errors.New("OldLastLoginAt is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldUsername requires an ID field in the mutation")
+		return v, errors.New("OldLastLoginAt requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldUsername: %w", err)
+		return v, fmt.Errorf("querying old value for OldLastLoginAt: %w", err)
 	}
-	return oldValue.Username, nil
-}
-
-// ResetUsername resets all changes to the "username" field.
-func (m *UserMutation) ResetUsername() {
-	m.username = nil
+	return oldValue.LastLoginAt, nil
 }
 
-// AddPostIDs adds the "posts" edge to the Post entity by ids.
-func (m *UserMutation) AddPostIDs(ids ...uint) {
-	if m.posts == nil {
-		m.posts = make(map[uint]struct{})
-	}
-	for i := range ids {
-		m.posts[ids[i]] = struct{}{}
-	}
+// ClearLastLoginAt clears the value of the "last_login_at" field.
+func (m *UserIdentityMutation) ClearLastLoginAt() {
+	m.last_login_at = nil
+	m.clearedFields[useridentity.FieldLastLoginAt] = struct{}{}
 }
 
-// ClearPosts clears the "posts" edge to the Post entity.
-func (m *UserMutation) ClearPosts() {
-	m.clearedposts = true
+// LastLoginAtCleared returns if the "last_login_at" field was cleared in this mutation.
+func (m *UserIdentityMutation) LastLoginAtCleared() bool {
+	_, ok := m.clearedFields[useridentity.FieldLastLoginAt]
+	return ok
 }
 
-// PostsCleared reports if the "posts" edge to the Post entity was cleared.
-func (m *UserMutation) PostsCleared() bool {
-	return m.clearedposts
+// ResetLastLoginAt resets all changes to the "last_login_at" field.
+func (m *UserIdentityMutation) ResetLastLoginAt() {
+	m.last_login_at = nil
+	delete(m.clearedFields, useridentity.FieldLastLoginAt)
 }
 
-// RemovePostIDs removes the "posts" edge to the Post entity by IDs.
-func (m *UserMutation) RemovePostIDs(ids ...uint) {
-	if m.removedposts == nil {
-		m.removedposts = make(map[uint]struct{})
-	}
-	for i := range ids {
-		delete(m.posts, ids[i])
-		m.removedposts[ids[i]] = struct{}{}
-	}
+// ClearUser clears the "user" edge to the User entity.
+func (m *UserIdentityMutation) ClearUser() {
+	m.cleareduser = true
+	m.clearedFields[useridentity.FieldUserID] = struct{}{}
 }
 
-// RemovedPosts returns the removed IDs of the "posts" edge to the Post entity.
-func (m *UserMutation) RemovedPostsIDs() (ids []uint) {
-	for id := range m.removedposts {
-		ids = append(ids, id)
-	}
-	return
+// UserCleared reports if the "user" edge to the User entity was cleared.
+func (m *UserIdentityMutation) UserCleared() bool {
+	return m.cleareduser
 }
 
-// PostsIDs returns the "posts" edge IDs in the mutation.
-func (m *UserMutation) PostsIDs() (ids []uint) {
-	for id := range m.posts {
-		ids = append(ids, id)
+// UserIDs returns the "user" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// UserID instead. It exists only for internal usage by the builders.
+func (m *UserIdentityMutation) UserIDs() (ids []uint) {
+	if id := m.user; id != nil {
+		ids = append(ids, *id)
 	}
 	return
 }
 
-// ResetPosts resets all changes to the "posts" edge.
-func (m *UserMutation) ResetPosts() {
-	m.posts = nil
-	m.clearedposts = false
-	m.removedposts = nil
+// ResetUser resets all changes to the "user" edge.
+func (m *UserIdentityMutation) ResetUser() {
+	m.user = nil
+	m.cleareduser = false
 }
 
-// Where appends a list predicates to the UserMutation builder.
-func (m *UserMutation) Where(ps ...predicate.User) {
+// Where appends a list predicates to the UserIdentityMutation builder.
+func (m *UserIdentityMutation) Where(ps ...predicate.UserIdentity) {
 	m.predicates = append(m.predicates, ps...)
 }
 
-// WhereP appends storage-level predicates to the UserMutation builder. Using this method,
+// WhereP appends storage-level predicates to the UserIdentityMutation builder. Using this method,
 // users can use type-assertion to append predicates that do not depend on any generated package.
-func (m *UserMutation) WhereP(ps ...func(*sql.Selector)) {
-	p := make([]predicate.User, len(ps))
+func (m *UserIdentityMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.UserIdentity, len(ps))
 	for i := range ps {
 		p[i] = ps[i]
 	}
@@ -6220,51 +14050,48 @@ func (m *UserMutation) WhereP(ps ...func(*sql.Selector)) {
 }
 
 // Op returns the operation name.
-func (m *UserMutation) Op() Op {
+func (m *UserIdentityMutation) Op() Op {
 	return m.op
 }
 
 // SetOp allows setting the mutation operation.
-func (m *UserMutation) SetOp(op Op) {
+func (m *UserIdentityMutation) SetOp(op Op) {
 	m.op = op
 }
 
-// Type returns the node type of this mutation (User).
-func (m *UserMutation) Type() string {
+// Type returns the node type of this mutation (UserIdentity).
+func (m *UserIdentityMutation) Type() string {
 	return m.typ
 }
 
 // Fields returns all fields that were changed during this mutation. Note that in
 // order to get all numeric fields that were incremented/decremented, call
 // AddedFields().
-func (m *UserMutation) Fields() []string {
-	fields := make([]string, 0, 9)
+func (m *UserIdentityMutation) Fields() []string {
+	fields := make([]string, 0, 8)
 	if m.created_at != nil {
-		fields = append(fields, user.FieldCreatedAt)
+		fields = append(fields, useridentity.FieldCreatedAt)
 	}
 	if m.updated_at != nil {
-		fields = append(fields, user.FieldUpdatedAt)
+		fields = append(fields, useridentity.FieldUpdatedAt)
 	}
 	if m.deleted_at != nil {
-		fields = append(fields, user.FieldDeletedAt)
-	}
-	if m.uuid != nil {
-		fields = append(fields, user.FieldUUID)
+		fields = append(fields, useridentity.FieldDeletedAt)
 	}
-	if m.avatar != nil {
-		fields = append(fields, user.FieldAvatar)
+	if m.user != nil {
+		fields = append(fields, useridentity.FieldUserID)
 	}
-	if m.email != nil {
-		fields = append(fields, user.FieldEmail)
+	if m.provider != nil {
+		fields = append(fields, useridentity.FieldProvider)
 	}
-	if m.password != nil {
-		fields = append(fields, user.FieldPassword)
+	if m.subject != nil {
+		fields = append(fields, useridentity.FieldSubject)
 	}
-	if m.role != nil {
-		fields = append(fields, user.FieldRole)
+	if m.email != nil {
+		fields = append(fields, useridentity.FieldEmail)
 	}
-	if m.username != nil {
-		fields = append(fields, user.FieldUsername)
+	if m.last_login_at != nil {
+		fields = append(fields, useridentity.FieldLastLoginAt)
 	}
 	return fields
 }
@@ -6272,26 +14099,24 @@ func (m *UserMutation) Fields() []string {
 // Field returns the value of a field with the given name. The second boolean
 // return value indicates that this field was not set, or was not defined in the
 // schema.
-func (m *UserMutation) Field(name string) (ent.Value, bool) {
+func (m *UserIdentityMutation) Field(name string) (ent.Value, bool) {
 	switch name {
-	case user.FieldCreatedAt:
+	case useridentity.FieldCreatedAt:
 		return m.CreatedAt()
-	case user.FieldUpdatedAt:
+	case useridentity.FieldUpdatedAt:
 		return m.UpdatedAt()
-	case user.FieldDeletedAt:
+	case useridentity.FieldDeletedAt:
 		return m.DeletedAt()
-	case user.FieldUUID:
-		return m.UUID()
-	case user.FieldAvatar:
-		return m.Avatar()
-	case user.FieldEmail:
+	case useridentity.FieldUserID:
+		return m.UserID()
+	case useridentity.FieldProvider:
+		return m.Provider()
+	case useridentity.FieldSubject:
+		return m.Subject()
+	case useridentity.FieldEmail:
 		return m.Email()
-	case user.FieldPassword:
-		return m.Password()
-	case user.FieldRole:
-		return m.Role()
-	case user.FieldUsername:
-		return m.Username()
+	case useridentity.FieldLastLoginAt:
+		return m.LastLoginAt()
 	}
 	return nil, false
 }
@@ -6299,273 +14124,260 @@ func (m *UserMutation) Field(name string) (ent.Value, bool) {
 // OldField returns the old value of the field from the database. An error is
 // returned if the mutation operation is not UpdateOne, or the query to the
 // database failed.
-func (m *UserMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+func (m *UserIdentityMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
 	switch name {
-	case user.FieldCreatedAt:
+	case useridentity.FieldCreatedAt:
 		return m.OldCreatedAt(ctx)
-	case user.FieldUpdatedAt:
+	case useridentity.FieldUpdatedAt:
 		return m.OldUpdatedAt(ctx)
-	case user.FieldDeletedAt:
+	case useridentity.FieldDeletedAt:
 		return m.OldDeletedAt(ctx)
-	case user.FieldUUID:
-		return m.OldUUID(ctx)
-	case user.FieldAvatar:
-		return m.OldAvatar(ctx)
-	case user.FieldEmail:
+	case useridentity.FieldUserID:
+		return m.OldUserID(ctx)
+	case useridentity.FieldProvider:
+		return m.OldProvider(ctx)
+	case useridentity.FieldSubject:
+		return m.OldSubject(ctx)
+	case useridentity.FieldEmail:
 		return m.OldEmail(ctx)
-	case user.FieldPassword:
-		return m.OldPassword(ctx)
-	case user.FieldRole:
-		return m.OldRole(ctx)
-	case user.FieldUsername:
-		return m.OldUsername(ctx)
+	case useridentity.FieldLastLoginAt:
+		return m.OldLastLoginAt(ctx)
 	}
-	return nil, fmt.Errorf("unknown User field %s", name)
+	return nil, fmt.Errorf("unknown UserIdentity field %s", name)
 }
 
 // SetField sets the value of a field with the given name. It returns an error if
 // the field is not defined in the schema, or if the type mismatched the field
 // type.
-func (m *UserMutation) SetField(name string, value ent.Value) error {
+func (m *UserIdentityMutation) SetField(name string, value ent.Value) error {
 	switch name {
-	case user.FieldCreatedAt:
+	case useridentity.FieldCreatedAt:
 		v, ok := value.(time.Time)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
 		m.SetCreatedAt(v)
 		return nil
-	case user.FieldUpdatedAt:
+	case useridentity.FieldUpdatedAt:
 		v, ok := value.(time.Time)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
 		m.SetUpdatedAt(v)
 		return nil
-	case user.FieldDeletedAt:
+	case useridentity.FieldDeletedAt:
 		v, ok := value.(time.Time)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
 		m.SetDeletedAt(v)
 		return nil
-	case user.FieldUUID:
-		v, ok := value.(uuid.UUID)
+	case useridentity.FieldUserID:
+		v, ok := value.(uint)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetUUID(v)
+		m.SetUserID(v)
 		return nil
-	case user.FieldAvatar:
+	case useridentity.FieldProvider:
 		v, ok := value.(string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetAvatar(v)
+		m.SetProvider(v)
 		return nil
-	case user.FieldEmail:
+	case useridentity.FieldSubject:
 		v, ok := value.(string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetEmail(v)
+		m.SetSubject(v)
 		return nil
-	case user.FieldPassword:
+	case useridentity.FieldEmail:
 		v, ok := value.(string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetPassword(v)
-		return nil
-	case user.FieldRole:
-		v, ok := value.(entity.UserRole)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetRole(v)
+		m.SetEmail(v)
 		return nil
-	case user.FieldUsername:
-		v, ok := value.(string)
+	case useridentity.FieldLastLoginAt:
+		v, ok := value.(time.Time)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetUsername(v)
+		m.SetLastLoginAt(v)
 		return nil
 	}
-	return fmt.Errorf("unknown User field %s", name)
+	return fmt.Errorf("unknown UserIdentity field %s", name)
 }
 
 // AddedFields returns all numeric fields that were incremented/decremented during
 // this mutation.
-func (m *UserMutation) AddedFields() []string {
-	return nil
+func (m *UserIdentityMutation) AddedFields() []string {
+	var fields []string
+	return fields
 }
 
 // AddedField returns the numeric value that was incremented/decremented on a field
 // with the given name. The second boolean return value indicates that this field
 // was not set, or was not defined in the schema.
-func (m *UserMutation) AddedField(name string) (ent.Value, bool) {
+func (m *UserIdentityMutation) AddedField(name string) (ent.Value, bool) {
+	switch name {
+	}
 	return nil, false
 }
 
 // AddField adds the value to the field with the given name. It returns an error if
 // the field is not defined in the schema, or if the type mismatched the field
 // type.
-func (m *UserMutation) AddField(name string, value ent.Value) error {
+func (m *UserIdentityMutation) AddField(name string, value ent.Value) error {
 	switch name {
 	}
-	return fmt.Errorf("unknown User numeric field %s", name)
+	return fmt.Errorf("unknown UserIdentity numeric field %s", name)
 }
 
 // ClearedFields returns all nullable fields that were cleared during this
 // mutation.
-func (m *UserMutation) ClearedFields() []string {
+func (m *UserIdentityMutation) ClearedFields() []string {
 	var fields []string
-	if m.FieldCleared(user.FieldDeletedAt) {
-		fields = append(fields, user.FieldDeletedAt)
+	if m.FieldCleared(useridentity.FieldDeletedAt) {
+		fields = append(fields, useridentity.FieldDeletedAt)
 	}
-	if m.FieldCleared(user.FieldAvatar) {
-		fields = append(fields, user.FieldAvatar)
+	if m.FieldCleared(useridentity.FieldEmail) {
+		fields = append(fields, useridentity.FieldEmail)
+	}
+	if m.FieldCleared(useridentity.FieldLastLoginAt) {
+		fields = append(fields, useridentity.FieldLastLoginAt)
 	}
 	return fields
 }
 
 // FieldCleared returns a boolean indicating if a field with the given name was
 // cleared in this mutation.
-func (m *UserMutation) FieldCleared(name string) bool {
+func (m *UserIdentityMutation) FieldCleared(name string) bool {
 	_, ok := m.clearedFields[name]
 	return ok
 }
 
 // ClearField clears the value of the field with the given name. It returns an
 // error if the field is not defined in the schema.
-func (m *UserMutation) ClearField(name string) error {
+func (m *UserIdentityMutation) ClearField(name string) error {
 	switch name {
-	case user.FieldDeletedAt:
+	case useridentity.FieldDeletedAt:
 		m.ClearDeletedAt()
 		return nil
-	case user.FieldAvatar:
-		m.ClearAvatar()
+	case useridentity.FieldEmail:
+		m.ClearEmail()
+		return nil
+	case useridentity.FieldLastLoginAt:
+		m.ClearLastLoginAt()
 		return nil
 	}
-	return fmt.Errorf("unknown User nullable field %s", name)
+	return fmt.Errorf("unknown UserIdentity nullable field %s", name)
 }
 
 // ResetField resets all changes in the mutation for the field with the given name.
 // It returns an error if the field is not defined in the schema.
-func (m *UserMutation) ResetField(name string) error {
+func (m *UserIdentityMutation) ResetField(name string) error {
 	switch name {
-	case user.FieldCreatedAt:
+	case useridentity.FieldCreatedAt:
 		m.ResetCreatedAt()
 		return nil
-	case user.FieldUpdatedAt:
+	case useridentity.FieldUpdatedAt:
 		m.ResetUpdatedAt()
 		return nil
-	case user.FieldDeletedAt:
+	case useridentity.FieldDeletedAt:
 		m.ResetDeletedAt()
 		return nil
-	case user.FieldUUID:
-		m.ResetUUID()
-		return nil
-	case user.FieldAvatar:
-		m.ResetAvatar()
+	case useridentity.FieldUserID:
+		m.ResetUserID()
 		return nil
-	case user.FieldEmail:
-		m.ResetEmail()
+	case useridentity.FieldProvider:
+		m.ResetProvider()
 		return nil
-	case user.FieldPassword:
-		m.ResetPassword()
+	case useridentity.FieldSubject:
+		m.ResetSubject()
 		return nil
-	case user.FieldRole:
-		m.ResetRole()
+	case useridentity.FieldEmail:
+		m.ResetEmail()
 		return nil
-	case user.FieldUsername:
-		m.ResetUsername()
+	case useridentity.FieldLastLoginAt:
+		m.ResetLastLoginAt()
 		return nil
 	}
-	return fmt.Errorf("unknown User field %s", name)
+	return fmt.Errorf("unknown UserIdentity field %s", name)
 }
 
 // AddedEdges returns all edge names that were set/added in this mutation.
-func (m *UserMutation) AddedEdges() []string {
+func (m *UserIdentityMutation) AddedEdges() []string {
 	edges := make([]string, 0, 1)
-	if m.posts != nil {
-		edges = append(edges, user.EdgePosts)
+	if m.user != nil {
+		edges = append(edges, useridentity.EdgeUser)
 	}
 	return edges
 }
 
 // AddedIDs returns all IDs (to other nodes) that were added for the given edge
 // name in this mutation.
-func (m *UserMutation) AddedIDs(name string) []ent.Value {
+func (m *UserIdentityMutation) AddedIDs(name string) []ent.Value {
 	switch name {
-	case user.EdgePosts:
-		ids := make([]ent.Value, 0, len(m.posts))
-		for id := range m.posts {
-			ids = append(ids, id)
+	case useridentity.EdgeUser:
+		if id := m.user; id != nil {
+			return []ent.Value{*id}
 		}
-		return ids
 	}
 	return nil
 }
 
 // RemovedEdges returns all edge names that were removed in this mutation.
-func (m *UserMutation) RemovedEdges() []string {
+func (m *UserIdentityMutation) RemovedEdges() []string {
 	edges := make([]string, 0, 1)
-	if m.removedposts != nil {
-		edges = append(edges, user.EdgePosts)
-	}
 	return edges
 }
 
 // RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
 // the given name in this mutation.
-func (m *UserMutation) RemovedIDs(name string) []ent.Value {
-	switch name {
-	case user.EdgePosts:
-		ids := make([]ent.Value, 0, len(m.removedposts))
-		for id := range m.removedposts {
-			ids = append(ids, id)
-		}
-		return ids
-	}
+func (m *UserIdentityMutation) RemovedIDs(name string) []ent.Value {
 	return nil
 }
 
 // ClearedEdges returns all edge names that were cleared in this mutation.
-func (m *UserMutation) ClearedEdges() []string {
+func (m *UserIdentityMutation) ClearedEdges() []string {
 	edges := make([]string, 0, 1)
-	if m.clearedposts {
-		edges = append(edges, user.EdgePosts)
+	if m.cleareduser {
+		edges = append(edges, useridentity.EdgeUser)
 	}
 	return edges
 }
 
 // EdgeCleared returns a boolean which indicates if the edge with the given name
 // was cleared in this mutation.
-func (m *UserMutation) EdgeCleared(name string) bool {
+func (m *UserIdentityMutation) EdgeCleared(name string) bool {
 	switch name {
-	case user.EdgePosts:
-		return m.clearedposts
+	case useridentity.EdgeUser:
+		return m.cleareduser
 	}
 	return false
 }
 
 // ClearEdge clears the value of the edge with the given name. It returns an error
 // if that edge is not defined in the schema.
-func (m *UserMutation) ClearEdge(name string) error {
+func (m *UserIdentityMutation) ClearEdge(name string) error {
 	switch name {
+	case useridentity.EdgeUser:
+		m.ClearUser()
+		return nil
 	}
-	return fmt.Errorf("unknown User unique edge %s", name)
+	return fmt.Errorf("unknown UserIdentity unique edge %s", name)
 }
 
 // ResetEdge resets all changes to the edge with the given name in this mutation.
 // It returns an error if the edge is not defined in the schema.
-func (m *UserMutation) ResetEdge(name string) error {
+func (m *UserIdentityMutation) ResetEdge(name string) error {
 	switch name {
-	case user.EdgePosts:
-		m.ResetPosts()
+	case useridentity.EdgeUser:
+		m.ResetUser()
 		return nil
 	}
-	return fmt.Errorf("unknown User edge %s", name)
+	return fmt.Errorf("unknown UserIdentity edge %s", name)
 }
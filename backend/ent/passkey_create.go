@@ -0,0 +1,1123 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"blog-server/ent/passkey"
+	"blog-server/ent/user"
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// PasskeyCreate is the builder for creating a Passkey entity.
+type PasskeyCreate struct {
+	config
+	mutation *PasskeyMutation
+	hooks    []Hook
+	conflict []sql.ConflictOption
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (_c *PasskeyCreate) SetCreatedAt(v time.Time) *PasskeyCreate {
+	_c.mutation.SetCreatedAt(v)
+	return _c
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (_c *PasskeyCreate) SetNillableCreatedAt(v *time.Time) *PasskeyCreate {
+	if v != nil {
+		_c.SetCreatedAt(*v)
+	}
+	return _c
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (_c *PasskeyCreate) SetUpdatedAt(v time.Time) *PasskeyCreate {
+	_c.mutation.SetUpdatedAt(v)
+	return _c
+}
+
+// SetNillableUpdatedAt sets the "updated_at" field if the given value is not nil.
+func (_c *PasskeyCreate) SetNillableUpdatedAt(v *time.Time) *PasskeyCreate {
+	if v != nil {
+		_c.SetUpdatedAt(*v)
+	}
+	return _c
+}
+
+// SetDeletedAt sets the "deleted_at" field.
+func (_c *PasskeyCreate) SetDeletedAt(v time.Time) *PasskeyCreate {
+	_c.mutation.SetDeletedAt(v)
+	return _c
+}
+
+// SetNillableDeletedAt sets the "deleted_at" field if the given value is not nil.
+func (_c *PasskeyCreate) SetNillableDeletedAt(v *time.Time) *PasskeyCreate {
+	if v != nil {
+		_c.SetDeletedAt(*v)
+	}
+	return _c
+}
+
+// SetUserID sets the "user_id" field.
+func (_c *PasskeyCreate) SetUserID(v uint) *PasskeyCreate {
+	_c.mutation.SetUserID(v)
+	return _c
+}
+
+// SetCredentialID sets the "credential_id" field.
+func (_c *PasskeyCreate) SetCredentialID(v string) *PasskeyCreate {
+	_c.mutation.SetCredentialID(v)
+	return _c
+}
+
+// SetPublicKey sets the "public_key" field.
+func (_c *PasskeyCreate) SetPublicKey(v []byte) *PasskeyCreate {
+	_c.mutation.SetPublicKey(v)
+	return _c
+}
+
+// SetAlgorithm sets the "algorithm" field.
+func (_c *PasskeyCreate) SetAlgorithm(v int) *PasskeyCreate {
+	_c.mutation.SetAlgorithm(v)
+	return _c
+}
+
+// SetSignCount sets the "sign_count" field.
+func (_c *PasskeyCreate) SetSignCount(v uint32) *PasskeyCreate {
+	_c.mutation.SetSignCount(v)
+	return _c
+}
+
+// SetNillableSignCount sets the "sign_count" field if the given value is not nil.
+func (_c *PasskeyCreate) SetNillableSignCount(v *uint32) *PasskeyCreate {
+	if v != nil {
+		_c.SetSignCount(*v)
+	}
+	return _c
+}
+
+// SetName sets the "name" field.
+func (_c *PasskeyCreate) SetName(v string) *PasskeyCreate {
+	_c.mutation.SetName(v)
+	return _c
+}
+
+// SetAaguid sets the "aaguid" field.
+func (_c *PasskeyCreate) SetAaguid(v string) *PasskeyCreate {
+	_c.mutation.SetAaguid(v)
+	return _c
+}
+
+// SetNillableAaguid sets the "aaguid" field if the given value is not nil.
+func (_c *PasskeyCreate) SetNillableAaguid(v *string) *PasskeyCreate {
+	if v != nil {
+		_c.SetAaguid(*v)
+	}
+	return _c
+}
+
+// SetTransports sets the "transports" field.
+func (_c *PasskeyCreate) SetTransports(v []string) *PasskeyCreate {
+	_c.mutation.SetTransports(v)
+	return _c
+}
+
+// SetLastUsedAt sets the "last_used_at" field.
+func (_c *PasskeyCreate) SetLastUsedAt(v time.Time) *PasskeyCreate {
+	_c.mutation.SetLastUsedAt(v)
+	return _c
+}
+
+// SetNillableLastUsedAt sets the "last_used_at" field if the given value is not nil.
+func (_c *PasskeyCreate) SetNillableLastUsedAt(v *time.Time) *PasskeyCreate {
+	if v != nil {
+		_c.SetLastUsedAt(*v)
+	}
+	return _c
+}
+
+// SetID sets the "id" field.
+func (_c *PasskeyCreate) SetID(v uint) *PasskeyCreate {
+	_c.mutation.SetID(v)
+	return _c
+}
+
+// SetUser sets the "user" edge to the User entity.
+func (_c *PasskeyCreate) SetUser(v *User) *PasskeyCreate {
+	return _c.SetUserID(v.ID)
+}
+
+// Mutation returns the PasskeyMutation object of the builder.
+func (_c *PasskeyCreate) Mutation() *PasskeyMutation {
+	return _c.mutation
+}
+
+// Save creates the Passkey in the database.
+func (_c *PasskeyCreate) Save(ctx context.Context) (*Passkey, error) {
+	_c.defaults()
+	return withHooks(ctx, _c.sqlSave, _c.mutation, _c.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (_c *PasskeyCreate) SaveX(ctx context.Context) *Passkey {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *PasskeyCreate) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *PasskeyCreate) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_c *PasskeyCreate) defaults() {
+	if _, ok := _c.mutation.CreatedAt(); !ok {
+		v := passkey.DefaultCreatedAt()
+		_c.mutation.SetCreatedAt(v)
+	}
+	if _, ok := _c.mutation.UpdatedAt(); !ok {
+		v := passkey.DefaultUpdatedAt()
+		_c.mutation.SetUpdatedAt(v)
+	}
+	if _, ok := _c.mutation.SignCount(); !ok {
+		v := passkey.DefaultSignCount
+		_c.mutation.SetSignCount(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_c *PasskeyCreate) check() error {
+	if _, ok := _c.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "Passkey.created_at"`)}
+	}
+	if _, ok := _c.mutation.UpdatedAt(); !ok {
+		return &ValidationError{Name: "updated_at", err: errors.New(`ent: missing required field "Passkey.updated_at"`)}
+	}
+	if _, ok := _c.mutation.UserID(); !ok {
+		return &ValidationError{Name: "user_id", err: errors.New(`ent: missing required field "Passkey.user_id"`)}
+	}
+	if _, ok := _c.mutation.CredentialID(); !ok {
+		return &ValidationError{Name: "credential_id", err: errors.New(`ent: missing required field "Passkey.credential_id"`)}
+	}
+	if v, ok := _c.mutation.CredentialID(); ok {
+		if err := passkey.CredentialIDValidator(v); err != nil {
+			return &ValidationError{Name: "credential_id", err: fmt.Errorf(`ent: validator failed for field "Passkey.credential_id": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.PublicKey(); !ok {
+		return &ValidationError{Name: "public_key", err: errors.New(`ent: missing required field "Passkey.public_key"`)}
+	}
+	if _, ok := _c.mutation.Algorithm(); !ok {
+		return &ValidationError{Name: "algorithm", err: errors.New(`ent: missing required field "Passkey.algorithm"`)}
+	}
+	if _, ok := _c.mutation.SignCount(); !ok {
+		return &ValidationError{Name: "sign_count", err: errors.New(`ent: missing required field "Passkey.sign_count"`)}
+	}
+	if _, ok := _c.mutation.Name(); !ok {
+		return &ValidationError{Name: "name", err: errors.New(`ent: missing required field "Passkey.name"`)}
+	}
+	if v, ok := _c.mutation.Name(); ok {
+		if err := passkey.NameValidator(v); err != nil {
+			return &ValidationError{Name: "name", err: fmt.Errorf(`ent: validator failed for field "Passkey.name": %w`, err)}
+		}
+	}
+	if v, ok := _c.mutation.Aaguid(); ok {
+		if err := passkey.AaguidValidator(v); err != nil {
+			return &ValidationError{Name: "aaguid", err: fmt.Errorf(`ent: validator failed for field "Passkey.aaguid": %w`, err)}
+		}
+	}
+	if len(_c.mutation.UserIDs()) == 0 {
+		return &ValidationError{Name: "user", err: errors.New(`ent: missing required edge "Passkey.user"`)}
+	}
+	return nil
+}
+
+func (_c *PasskeyCreate) sqlSave(ctx context.Context) (*Passkey, error) {
+	if err := _c.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := _c.createSpec()
+	if err := sqlgraph.CreateNode(ctx, _c.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	if _spec.ID.Value != _node.ID {
+		id := _spec.ID.Value.(int64)
+		_node.ID = uint(id)
+	}
+	_c.mutation.id = &_node.ID
+	_c.mutation.done = true
+	return _node, nil
+}
+
+func (_c *PasskeyCreate) createSpec() (*Passkey, *sqlgraph.CreateSpec) {
+	var (
+		_node = &Passkey{config: _c.config}
+		_spec = sqlgraph.NewCreateSpec(passkey.Table, sqlgraph.NewFieldSpec(passkey.FieldID, field.TypeUint))
+	)
+	_spec.OnConflict = _c.conflict
+	if id, ok := _c.mutation.ID(); ok {
+		_node.ID = id
+		_spec.ID.Value = id
+	}
+	if value, ok := _c.mutation.CreatedAt(); ok {
+		_spec.SetField(passkey.FieldCreatedAt, field.TypeTime, value)
+		_node.CreatedAt = value
+	}
+	if value, ok := _c.mutation.UpdatedAt(); ok {
+		_spec.SetField(passkey.FieldUpdatedAt, field.TypeTime, value)
+		_node.UpdatedAt = value
+	}
+	if value, ok := _c.mutation.DeletedAt(); ok {
+		_spec.SetField(passkey.FieldDeletedAt, field.TypeTime, value)
+		_node.DeletedAt = &value
+	}
+	if value, ok := _c.mutation.CredentialID(); ok {
+		_spec.SetField(passkey.FieldCredentialID, field.TypeString, value)
+		_node.CredentialID = value
+	}
+	if value, ok := _c.mutation.PublicKey(); ok {
+		_spec.SetField(passkey.FieldPublicKey, field.TypeBytes, value)
+		_node.PublicKey = value
+	}
+	if value, ok := _c.mutation.Algorithm(); ok {
+		_spec.SetField(passkey.FieldAlgorithm, field.TypeInt, value)
+		_node.Algorithm = value
+	}
+	if value, ok := _c.mutation.SignCount(); ok {
+		_spec.SetField(passkey.FieldSignCount, field.TypeUint32, value)
+		_node.SignCount = value
+	}
+	if value, ok := _c.mutation.Name(); ok {
+		_spec.SetField(passkey.FieldName, field.TypeString, value)
+		_node.Name = value
+	}
+	if value, ok := _c.mutation.Aaguid(); ok {
+		_spec.SetField(passkey.FieldAaguid, field.TypeString, value)
+		_node.Aaguid = value
+	}
+	if value, ok := _c.mutation.Transports(); ok {
+		_spec.SetField(passkey.FieldTransports, field.TypeJSON, value)
+		_node.Transports = value
+	}
+	if value, ok := _c.mutation.LastUsedAt(); ok {
+		_spec.SetField(passkey.FieldLastUsedAt, field.TypeTime, value)
+		_node.LastUsedAt = &value
+	}
+	if nodes := _c.mutation.UserIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   passkey.UserTable,
+			Columns: []string{passkey.UserColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeUint),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.UserID = nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	return _node, _spec
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.Passkey.Create().
+//		SetCreatedAt(v).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.PasskeyUpsert) {
+//			SetCreatedAt(v+v).
+//		}).
+//		Exec(ctx)
+func (_c *PasskeyCreate) OnConflict(opts ...sql.ConflictOption) *PasskeyUpsertOne {
+	_c.conflict = opts
+	return &PasskeyUpsertOne{
+		create: _c,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.Passkey.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (_c *PasskeyCreate) OnConflictColumns(columns ...string) *PasskeyUpsertOne {
+	_c.conflict = append(_c.conflict, sql.ConflictColumns(columns...))
+	return &PasskeyUpsertOne{
+		create: _c,
+	}
+}
+
+type (
+	// PasskeyUpsertOne is the builder for "upsert"-ing
+	//  one Passkey node.
+	PasskeyUpsertOne struct {
+		create *PasskeyCreate
+	}
+
+	// PasskeyUpsert is the "OnConflict" setter.
+	PasskeyUpsert struct {
+		*sql.UpdateSet
+	}
+)
+
+// SetCreatedAt sets the "created_at" field.
+func (u *PasskeyUpsert) SetCreatedAt(v time.Time) *PasskeyUpsert {
+	u.Set(passkey.FieldCreatedAt, v)
+	return u
+}
+
+// UpdateCreatedAt sets the "created_at" field to the value that was provided on create.
+func (u *PasskeyUpsert) UpdateCreatedAt() *PasskeyUpsert {
+	u.SetExcluded(passkey.FieldCreatedAt)
+	return u
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (u *PasskeyUpsert) SetUpdatedAt(v time.Time) *PasskeyUpsert {
+	u.Set(passkey.FieldUpdatedAt, v)
+	return u
+}
+
+// UpdateUpdatedAt sets the "updated_at" field to the value that was provided on create.
+func (u *PasskeyUpsert) UpdateUpdatedAt() *PasskeyUpsert {
+	u.SetExcluded(passkey.FieldUpdatedAt)
+	return u
+}
+
+// SetDeletedAt sets the "deleted_at" field.
+func (u *PasskeyUpsert) SetDeletedAt(v time.Time) *PasskeyUpsert {
+	u.Set(passkey.FieldDeletedAt, v)
+	return u
+}
+
+// UpdateDeletedAt sets the "deleted_at" field to the value that was provided on create.
+func (u *PasskeyUpsert) UpdateDeletedAt() *PasskeyUpsert {
+	u.SetExcluded(passkey.FieldDeletedAt)
+	return u
+}
+
+// ClearDeletedAt clears the value of the "deleted_at" field.
+func (u *PasskeyUpsert) ClearDeletedAt() *PasskeyUpsert {
+	u.SetNull(passkey.FieldDeletedAt)
+	return u
+}
+
+// SetSignCount sets the "sign_count" field.
+func (u *PasskeyUpsert) SetSignCount(v uint32) *PasskeyUpsert {
+	u.Set(passkey.FieldSignCount, v)
+	return u
+}
+
+// UpdateSignCount sets the "sign_count" field to the value that was provided on create.
+func (u *PasskeyUpsert) UpdateSignCount() *PasskeyUpsert {
+	u.SetExcluded(passkey.FieldSignCount)
+	return u
+}
+
+// AddSignCount adds v to the "sign_count" field.
+func (u *PasskeyUpsert) AddSignCount(v uint32) *PasskeyUpsert {
+	u.Add(passkey.FieldSignCount, v)
+	return u
+}
+
+// SetName sets the "name" field.
+func (u *PasskeyUpsert) SetName(v string) *PasskeyUpsert {
+	u.Set(passkey.FieldName, v)
+	return u
+}
+
+// UpdateName sets the "name" field to the value that was provided on create.
+func (u *PasskeyUpsert) UpdateName() *PasskeyUpsert {
+	u.SetExcluded(passkey.FieldName)
+	return u
+}
+
+// SetAaguid sets the "aaguid" field.
+func (u *PasskeyUpsert) SetAaguid(v string) *PasskeyUpsert {
+	u.Set(passkey.FieldAaguid, v)
+	return u
+}
+
+// UpdateAaguid sets the "aaguid" field to the value that was provided on create.
+func (u *PasskeyUpsert) UpdateAaguid() *PasskeyUpsert {
+	u.SetExcluded(passkey.FieldAaguid)
+	return u
+}
+
+// ClearAaguid clears the value of the "aaguid" field.
+func (u *PasskeyUpsert) ClearAaguid() *PasskeyUpsert {
+	u.SetNull(passkey.FieldAaguid)
+	return u
+}
+
+// SetTransports sets the "transports" field.
+func (u *PasskeyUpsert) SetTransports(v []string) *PasskeyUpsert {
+	u.Set(passkey.FieldTransports, v)
+	return u
+}
+
+// UpdateTransports sets the "transports" field to the value that was provided on create.
+func (u *PasskeyUpsert) UpdateTransports() *PasskeyUpsert {
+	u.SetExcluded(passkey.FieldTransports)
+	return u
+}
+
+// ClearTransports clears the value of the "transports" field.
+func (u *PasskeyUpsert) ClearTransports() *PasskeyUpsert {
+	u.SetNull(passkey.FieldTransports)
+	return u
+}
+
+// SetLastUsedAt sets the "last_used_at" field.
+func (u *PasskeyUpsert) SetLastUsedAt(v time.Time) *PasskeyUpsert {
+	u.Set(passkey.FieldLastUsedAt, v)
+	return u
+}
+
+// UpdateLastUsedAt sets the "last_used_at" field to the value that was provided on create.
+func (u *PasskeyUpsert) UpdateLastUsedAt() *PasskeyUpsert {
+	u.SetExcluded(passkey.FieldLastUsedAt)
+	return u
+}
+
+// ClearLastUsedAt clears the value of the "last_used_at" field.
+func (u *PasskeyUpsert) ClearLastUsedAt() *PasskeyUpsert {
+	u.SetNull(passkey.FieldLastUsedAt)
+	return u
+}
+
+// UpdateNewValues updates the mutable fields using the new values that were set on create except the ID field.
+// Using this option is equivalent to using:
+//
+//	client.Passkey.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//			sql.ResolveWith(func(u *sql.UpdateSet) {
+//				u.SetIgnore(passkey.FieldID)
+//			}),
+//		).
+//		Exec(ctx)
+func (u *PasskeyUpsertOne) UpdateNewValues() *PasskeyUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		if _, exists := u.create.mutation.ID(); exists {
+			s.SetIgnore(passkey.FieldID)
+		}
+		if _, exists := u.create.mutation.UserID(); exists {
+			s.SetIgnore(passkey.FieldUserID)
+		}
+		if _, exists := u.create.mutation.CredentialID(); exists {
+			s.SetIgnore(passkey.FieldCredentialID)
+		}
+		if _, exists := u.create.mutation.PublicKey(); exists {
+			s.SetIgnore(passkey.FieldPublicKey)
+		}
+		if _, exists := u.create.mutation.Algorithm(); exists {
+			s.SetIgnore(passkey.FieldAlgorithm)
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.Passkey.Create().
+//	    OnConflict(sql.ResolveWithIgnore()).
+//	    Exec(ctx)
+func (u *PasskeyUpsertOne) Ignore() *PasskeyUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *PasskeyUpsertOne) DoNothing() *PasskeyUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the PasskeyCreate.OnConflict
+// documentation for more info.
+func (u *PasskeyUpsertOne) Update(set func(*PasskeyUpsert)) *PasskeyUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&PasskeyUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (u *PasskeyUpsertOne) SetCreatedAt(v time.Time) *PasskeyUpsertOne {
+	return u.Update(func(s *PasskeyUpsert) {
+		s.SetCreatedAt(v)
+	})
+}
+
+// UpdateCreatedAt sets the "created_at" field to the value that was provided on create.
+func (u *PasskeyUpsertOne) UpdateCreatedAt() *PasskeyUpsertOne {
+	return u.Update(func(s *PasskeyUpsert) {
+		s.UpdateCreatedAt()
+	})
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (u *PasskeyUpsertOne) SetUpdatedAt(v time.Time) *PasskeyUpsertOne {
+	return u.Update(func(s *PasskeyUpsert) {
+		s.SetUpdatedAt(v)
+	})
+}
+
+// UpdateUpdatedAt sets the "updated_at" field to the value that was provided on create.
+func (u *PasskeyUpsertOne) UpdateUpdatedAt() *PasskeyUpsertOne {
+	return u.Update(func(s *PasskeyUpsert) {
+		s.UpdateUpdatedAt()
+	})
+}
+
+// SetDeletedAt sets the "deleted_at" field.
+func (u *PasskeyUpsertOne) SetDeletedAt(v time.Time) *PasskeyUpsertOne {
+	return u.Update(func(s *PasskeyUpsert) {
+		s.SetDeletedAt(v)
+	})
+}
+
+// UpdateDeletedAt sets the "deleted_at" field to the value that was provided on create.
+func (u *PasskeyUpsertOne) UpdateDeletedAt() *PasskeyUpsertOne {
+	return u.Update(func(s *PasskeyUpsert) {
+		s.UpdateDeletedAt()
+	})
+}
+
+// ClearDeletedAt clears the value of the "deleted_at" field.
+func (u *PasskeyUpsertOne) ClearDeletedAt() *PasskeyUpsertOne {
+	return u.Update(func(s *PasskeyUpsert) {
+		s.ClearDeletedAt()
+	})
+}
+
+// SetSignCount sets the "sign_count" field.
+func (u *PasskeyUpsertOne) SetSignCount(v uint32) *PasskeyUpsertOne {
+	return u.Update(func(s *PasskeyUpsert) {
+		s.SetSignCount(v)
+	})
+}
+
+// AddSignCount adds v to the "sign_count" field.
+func (u *PasskeyUpsertOne) AddSignCount(v uint32) *PasskeyUpsertOne {
+	return u.Update(func(s *PasskeyUpsert) {
+		s.AddSignCount(v)
+	})
+}
+
+// UpdateSignCount sets the "sign_count" field to the value that was provided on create.
+func (u *PasskeyUpsertOne) UpdateSignCount() *PasskeyUpsertOne {
+	return u.Update(func(s *PasskeyUpsert) {
+		s.UpdateSignCount()
+	})
+}
+
+// SetName sets the "name" field.
+func (u *PasskeyUpsertOne) SetName(v string) *PasskeyUpsertOne {
+	return u.Update(func(s *PasskeyUpsert) {
+		s.SetName(v)
+	})
+}
+
+// UpdateName sets the "name" field to the value that was provided on create.
+func (u *PasskeyUpsertOne) UpdateName() *PasskeyUpsertOne {
+	return u.Update(func(s *PasskeyUpsert) {
+		s.UpdateName()
+	})
+}
+
+// SetAaguid sets the "aaguid" field.
+func (u *PasskeyUpsertOne) SetAaguid(v string) *PasskeyUpsertOne {
+	return u.Update(func(s *PasskeyUpsert) {
+		s.SetAaguid(v)
+	})
+}
+
+// UpdateAaguid sets the "aaguid" field to the value that was provided on create.
+func (u *PasskeyUpsertOne) UpdateAaguid() *PasskeyUpsertOne {
+	return u.Update(func(s *PasskeyUpsert) {
+		s.UpdateAaguid()
+	})
+}
+
+// ClearAaguid clears the value of the "aaguid" field.
+func (u *PasskeyUpsertOne) ClearAaguid() *PasskeyUpsertOne {
+	return u.Update(func(s *PasskeyUpsert) {
+		s.ClearAaguid()
+	})
+}
+
+// SetTransports sets the "transports" field.
+func (u *PasskeyUpsertOne) SetTransports(v []string) *PasskeyUpsertOne {
+	return u.Update(func(s *PasskeyUpsert) {
+		s.SetTransports(v)
+	})
+}
+
+// UpdateTransports sets the "transports" field to the value that was provided on create.
+func (u *PasskeyUpsertOne) UpdateTransports() *PasskeyUpsertOne {
+	return u.Update(func(s *PasskeyUpsert) {
+		s.UpdateTransports()
+	})
+}
+
+// ClearTransports clears the value of the "transports" field.
+func (u *PasskeyUpsertOne) ClearTransports() *PasskeyUpsertOne {
+	return u.Update(func(s *PasskeyUpsert) {
+		s.ClearTransports()
+	})
+}
+
+// SetLastUsedAt sets the "last_used_at" field.
+func (u *PasskeyUpsertOne) SetLastUsedAt(v time.Time) *PasskeyUpsertOne {
+	return u.Update(func(s *PasskeyUpsert) {
+		s.SetLastUsedAt(v)
+	})
+}
+
+// UpdateLastUsedAt sets the "last_used_at" field to the value that was provided on create.
+func (u *PasskeyUpsertOne) UpdateLastUsedAt() *PasskeyUpsertOne {
+	return u.Update(func(s *PasskeyUpsert) {
+		s.UpdateLastUsedAt()
+	})
+}
+
+// ClearLastUsedAt clears the value of the "last_used_at" field.
+func (u *PasskeyUpsertOne) ClearLastUsedAt() *PasskeyUpsertOne {
+	return u.Update(func(s *PasskeyUpsert) {
+		s.ClearLastUsedAt()
+	})
+}
+
+// Exec executes the query.
+func (u *PasskeyUpsertOne) Exec(ctx context.Context) error {
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for PasskeyCreate.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *PasskeyUpsertOne) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// Exec executes the UPSERT query and returns the inserted/updated ID.
+func (u *PasskeyUpsertOne) ID(ctx context.Context) (id uint, err error) {
+	node, err := u.create.Save(ctx)
+	if err != nil {
+		return id, err
+	}
+	return node.ID, nil
+}
+
+// IDX is like ID, but panics if an error occurs.
+func (u *PasskeyUpsertOne) IDX(ctx context.Context) uint {
+	id, err := u.ID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// PasskeyCreateBulk is the builder for creating many Passkey entities in bulk.
+type PasskeyCreateBulk struct {
+	config
+	err      error
+	builders []*PasskeyCreate
+	conflict []sql.ConflictOption
+}
+
+// Save creates the Passkey entities in the database.
+func (_c *PasskeyCreateBulk) Save(ctx context.Context) ([]*Passkey, error) {
+	if _c.err != nil {
+		return nil, _c.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(_c.builders))
+	nodes := make([]*Passkey, len(_c.builders))
+	mutators := make([]Mutator, len(_c.builders))
+	for i := range _c.builders {
+		func(i int, root context.Context) {
+			builder := _c.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*PasskeyMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, _c.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					spec.OnConflict = _c.conflict
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, _c.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				if specs[i].ID.Value != nil && nodes[i].ID == 0 {
+					id := specs[i].ID.Value.(int64)
+					nodes[i].ID = uint(id)
+				}
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, _c.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_c *PasskeyCreateBulk) SaveX(ctx context.Context) []*Passkey {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *PasskeyCreateBulk) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *PasskeyCreateBulk) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.Passkey.CreateBulk(builders...).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.PasskeyUpsert) {
+//			SetCreatedAt(v+v).
+//		}).
+//		Exec(ctx)
+func (_c *PasskeyCreateBulk) OnConflict(opts ...sql.ConflictOption) *PasskeyUpsertBulk {
+	_c.conflict = opts
+	return &PasskeyUpsertBulk{
+		create: _c,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.Passkey.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (_c *PasskeyCreateBulk) OnConflictColumns(columns ...string) *PasskeyUpsertBulk {
+	_c.conflict = append(_c.conflict, sql.ConflictColumns(columns...))
+	return &PasskeyUpsertBulk{
+		create: _c,
+	}
+}
+
+// PasskeyUpsertBulk is the builder for "upsert"-ing
+// a bulk of Passkey nodes.
+type PasskeyUpsertBulk struct {
+	create *PasskeyCreateBulk
+}
+
+// UpdateNewValues updates the mutable fields using the new values that
+// were set on create. Using this option is equivalent to using:
+//
+//	client.Passkey.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//			sql.ResolveWith(func(u *sql.UpdateSet) {
+//				u.SetIgnore(passkey.FieldID)
+//			}),
+//		).
+//		Exec(ctx)
+func (u *PasskeyUpsertBulk) UpdateNewValues() *PasskeyUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		for _, b := range u.create.builders {
+			if _, exists := b.mutation.ID(); exists {
+				s.SetIgnore(passkey.FieldID)
+			}
+			if _, exists := b.mutation.UserID(); exists {
+				s.SetIgnore(passkey.FieldUserID)
+			}
+			if _, exists := b.mutation.CredentialID(); exists {
+				s.SetIgnore(passkey.FieldCredentialID)
+			}
+			if _, exists := b.mutation.PublicKey(); exists {
+				s.SetIgnore(passkey.FieldPublicKey)
+			}
+			if _, exists := b.mutation.Algorithm(); exists {
+				s.SetIgnore(passkey.FieldAlgorithm)
+			}
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.Passkey.Create().
+//		OnConflict(sql.ResolveWithIgnore()).
+//		Exec(ctx)
+func (u *PasskeyUpsertBulk) Ignore() *PasskeyUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *PasskeyUpsertBulk) DoNothing() *PasskeyUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the PasskeyCreateBulk.OnConflict
+// documentation for more info.
+func (u *PasskeyUpsertBulk) Update(set func(*PasskeyUpsert)) *PasskeyUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&PasskeyUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (u *PasskeyUpsertBulk) SetCreatedAt(v time.Time) *PasskeyUpsertBulk {
+	return u.Update(func(s *PasskeyUpsert) {
+		s.SetCreatedAt(v)
+	})
+}
+
+// UpdateCreatedAt sets the "created_at" field to the value that was provided on create.
+func (u *PasskeyUpsertBulk) UpdateCreatedAt() *PasskeyUpsertBulk {
+	return u.Update(func(s *PasskeyUpsert) {
+		s.UpdateCreatedAt()
+	})
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (u *PasskeyUpsertBulk) SetUpdatedAt(v time.Time) *PasskeyUpsertBulk {
+	return u.Update(func(s *PasskeyUpsert) {
+		s.SetUpdatedAt(v)
+	})
+}
+
+// UpdateUpdatedAt sets the "updated_at" field to the value that was provided on create.
+func (u *PasskeyUpsertBulk) UpdateUpdatedAt() *PasskeyUpsertBulk {
+	return u.Update(func(s *PasskeyUpsert) {
+		s.UpdateUpdatedAt()
+	})
+}
+
+// SetDeletedAt sets the "deleted_at" field.
+func (u *PasskeyUpsertBulk) SetDeletedAt(v time.Time) *PasskeyUpsertBulk {
+	return u.Update(func(s *PasskeyUpsert) {
+		s.SetDeletedAt(v)
+	})
+}
+
+// UpdateDeletedAt sets the "deleted_at" field to the value that was provided on create.
+func (u *PasskeyUpsertBulk) UpdateDeletedAt() *PasskeyUpsertBulk {
+	return u.Update(func(s *PasskeyUpsert) {
+		s.UpdateDeletedAt()
+	})
+}
+
+// ClearDeletedAt clears the value of the "deleted_at" field.
+func (u *PasskeyUpsertBulk) ClearDeletedAt() *PasskeyUpsertBulk {
+	return u.Update(func(s *PasskeyUpsert) {
+		s.ClearDeletedAt()
+	})
+}
+
+// SetSignCount sets the "sign_count" field.
+func (u *PasskeyUpsertBulk) SetSignCount(v uint32) *PasskeyUpsertBulk {
+	return u.Update(func(s *PasskeyUpsert) {
+		s.SetSignCount(v)
+	})
+}
+
+// AddSignCount adds v to the "sign_count" field.
+func (u *PasskeyUpsertBulk) AddSignCount(v uint32) *PasskeyUpsertBulk {
+	return u.Update(func(s *PasskeyUpsert) {
+		s.AddSignCount(v)
+	})
+}
+
+// UpdateSignCount sets the "sign_count" field to the value that was provided on create.
+func (u *PasskeyUpsertBulk) UpdateSignCount() *PasskeyUpsertBulk {
+	return u.Update(func(s *PasskeyUpsert) {
+		s.UpdateSignCount()
+	})
+}
+
+// SetName sets the "name" field.
+func (u *PasskeyUpsertBulk) SetName(v string) *PasskeyUpsertBulk {
+	return u.Update(func(s *PasskeyUpsert) {
+		s.SetName(v)
+	})
+}
+
+// UpdateName sets the "name" field to the value that was provided on create.
+func (u *PasskeyUpsertBulk) UpdateName() *PasskeyUpsertBulk {
+	return u.Update(func(s *PasskeyUpsert) {
+		s.UpdateName()
+	})
+}
+
+// SetAaguid sets the "aaguid" field.
+func (u *PasskeyUpsertBulk) SetAaguid(v string) *PasskeyUpsertBulk {
+	return u.Update(func(s *PasskeyUpsert) {
+		s.SetAaguid(v)
+	})
+}
+
+// UpdateAaguid sets the "aaguid" field to the value that was provided on create.
+func (u *PasskeyUpsertBulk) UpdateAaguid() *PasskeyUpsertBulk {
+	return u.Update(func(s *PasskeyUpsert) {
+		s.UpdateAaguid()
+	})
+}
+
+// ClearAaguid clears the value of the "aaguid" field.
+func (u *PasskeyUpsertBulk) ClearAaguid() *PasskeyUpsertBulk {
+	return u.Update(func(s *PasskeyUpsert) {
+		s.ClearAaguid()
+	})
+}
+
+// SetTransports sets the "transports" field.
+func (u *PasskeyUpsertBulk) SetTransports(v []string) *PasskeyUpsertBulk {
+	return u.Update(func(s *PasskeyUpsert) {
+		s.SetTransports(v)
+	})
+}
+
+// UpdateTransports sets the "transports" field to the value that was provided on create.
+func (u *PasskeyUpsertBulk) UpdateTransports() *PasskeyUpsertBulk {
+	return u.Update(func(s *PasskeyUpsert) {
+		s.UpdateTransports()
+	})
+}
+
+// ClearTransports clears the value of the "transports" field.
+func (u *PasskeyUpsertBulk) ClearTransports() *PasskeyUpsertBulk {
+	return u.Update(func(s *PasskeyUpsert) {
+		s.ClearTransports()
+	})
+}
+
+// SetLastUsedAt sets the "last_used_at" field.
+func (u *PasskeyUpsertBulk) SetLastUsedAt(v time.Time) *PasskeyUpsertBulk {
+	return u.Update(func(s *PasskeyUpsert) {
+		s.SetLastUsedAt(v)
+	})
+}
+
+// UpdateLastUsedAt sets the "last_used_at" field to the value that was provided on create.
+func (u *PasskeyUpsertBulk) UpdateLastUsedAt() *PasskeyUpsertBulk {
+	return u.Update(func(s *PasskeyUpsert) {
+		s.UpdateLastUsedAt()
+	})
+}
+
+// ClearLastUsedAt clears the value of the "last_used_at" field.
+func (u *PasskeyUpsertBulk) ClearLastUsedAt() *PasskeyUpsertBulk {
+	return u.Update(func(s *PasskeyUpsert) {
+		s.ClearLastUsedAt()
+	})
+}
+
+// Exec executes the query.
+func (u *PasskeyUpsertBulk) Exec(ctx context.Context) error {
+	if u.create.err != nil {
+		return u.create.err
+	}
+	for i, b := range u.create.builders {
+		if len(b.conflict) != 0 {
+			return fmt.Errorf("ent: OnConflict was set for builder %d. Set it on the PasskeyCreateBulk instead", i)
+		}
+	}
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for PasskeyCreateBulk.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *PasskeyUpsertBulk) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,605 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"blog-server/ent/passkey"
+	"blog-server/ent/predicate"
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/dialect/sql/sqljson"
+	"entgo.io/ent/schema/field"
+)
+
+// PasskeyUpdate is the builder for updating Passkey entities.
+type PasskeyUpdate struct {
+	config
+	hooks     []Hook
+	mutation  *PasskeyMutation
+	modifiers []func(*sql.UpdateBuilder)
+}
+
+// Where appends a list predicates to the PasskeyUpdate builder.
+func (_u *PasskeyUpdate) Where(ps ...predicate.Passkey) *PasskeyUpdate {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (_u *PasskeyUpdate) SetCreatedAt(v time.Time) *PasskeyUpdate {
+	_u.mutation.SetCreatedAt(v)
+	return _u
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (_u *PasskeyUpdate) SetNillableCreatedAt(v *time.Time) *PasskeyUpdate {
+	if v != nil {
+		_u.SetCreatedAt(*v)
+	}
+	return _u
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (_u *PasskeyUpdate) SetUpdatedAt(v time.Time) *PasskeyUpdate {
+	_u.mutation.SetUpdatedAt(v)
+	return _u
+}
+
+// SetNillableUpdatedAt sets the "updated_at" field if the given value is not nil.
+func (_u *PasskeyUpdate) SetNillableUpdatedAt(v *time.Time) *PasskeyUpdate {
+	if v != nil {
+		_u.SetUpdatedAt(*v)
+	}
+	return _u
+}
+
+// SetDeletedAt sets the "deleted_at" field.
+func (_u *PasskeyUpdate) SetDeletedAt(v time.Time) *PasskeyUpdate {
+	_u.mutation.SetDeletedAt(v)
+	return _u
+}
+
+// SetNillableDeletedAt sets the "deleted_at" field if the given value is not nil.
+func (_u *PasskeyUpdate) SetNillableDeletedAt(v *time.Time) *PasskeyUpdate {
+	if v != nil {
+		_u.SetDeletedAt(*v)
+	}
+	return _u
+}
+
+// ClearDeletedAt clears the value of the "deleted_at" field.
+func (_u *PasskeyUpdate) ClearDeletedAt() *PasskeyUpdate {
+	_u.mutation.ClearDeletedAt()
+	return _u
+}
+
+// SetSignCount sets the "sign_count" field.
+func (_u *PasskeyUpdate) SetSignCount(v uint32) *PasskeyUpdate {
+	_u.mutation.ResetSignCount()
+	_u.mutation.SetSignCount(v)
+	return _u
+}
+
+// SetNillableSignCount sets the "sign_count" field if the given value is not nil.
+func (_u *PasskeyUpdate) SetNillableSignCount(v *uint32) *PasskeyUpdate {
+	if v != nil {
+		_u.SetSignCount(*v)
+	}
+	return _u
+}
+
+// AddSignCount adds value to the "sign_count" field.
+func (_u *PasskeyUpdate) AddSignCount(v int32) *PasskeyUpdate {
+	_u.mutation.AddSignCount(v)
+	return _u
+}
+
+// SetName sets the "name" field.
+func (_u *PasskeyUpdate) SetName(v string) *PasskeyUpdate {
+	_u.mutation.SetName(v)
+	return _u
+}
+
+// SetNillableName sets the "name" field if the given value is not nil.
+func (_u *PasskeyUpdate) SetNillableName(v *string) *PasskeyUpdate {
+	if v != nil {
+		_u.SetName(*v)
+	}
+	return _u
+}
+
+// SetAaguid sets the "aaguid" field.
+func (_u *PasskeyUpdate) SetAaguid(v string) *PasskeyUpdate {
+	_u.mutation.SetAaguid(v)
+	return _u
+}
+
+// SetNillableAaguid sets the "aaguid" field if the given value is not nil.
+func (_u *PasskeyUpdate) SetNillableAaguid(v *string) *PasskeyUpdate {
+	if v != nil {
+		_u.SetAaguid(*v)
+	}
+	return _u
+}
+
+// ClearAaguid clears the value of the "aaguid" field.
+func (_u *PasskeyUpdate) ClearAaguid() *PasskeyUpdate {
+	_u.mutation.ClearAaguid()
+	return _u
+}
+
+// SetTransports sets the "transports" field.
+func (_u *PasskeyUpdate) SetTransports(v []string) *PasskeyUpdate {
+	_u.mutation.SetTransports(v)
+	return _u
+}
+
+// AppendTransports appends value to the "transports" field.
+func (_u *PasskeyUpdate) AppendTransports(v []string) *PasskeyUpdate {
+	_u.mutation.AppendTransports(v)
+	return _u
+}
+
+// ClearTransports clears the value of the "transports" field.
+func (_u *PasskeyUpdate) ClearTransports() *PasskeyUpdate {
+	_u.mutation.ClearTransports()
+	return _u
+}
+
+// SetLastUsedAt sets the "last_used_at" field.
+func (_u *PasskeyUpdate) SetLastUsedAt(v time.Time) *PasskeyUpdate {
+	_u.mutation.SetLastUsedAt(v)
+	return _u
+}
+
+// SetNillableLastUsedAt sets the "last_used_at" field if the given value is not nil.
+func (_u *PasskeyUpdate) SetNillableLastUsedAt(v *time.Time) *PasskeyUpdate {
+	if v != nil {
+		_u.SetLastUsedAt(*v)
+	}
+	return _u
+}
+
+// ClearLastUsedAt clears the value of the "last_used_at" field.
+func (_u *PasskeyUpdate) ClearLastUsedAt() *PasskeyUpdate {
+	_u.mutation.ClearLastUsedAt()
+	return _u
+}
+
+// Mutation returns the PasskeyMutation object of the builder.
+func (_u *PasskeyUpdate) Mutation() *PasskeyMutation {
+	return _u.mutation
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (_u *PasskeyUpdate) Save(ctx context.Context) (int, error) {
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *PasskeyUpdate) SaveX(ctx context.Context) int {
+	affected, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (_u *PasskeyUpdate) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *PasskeyUpdate) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *PasskeyUpdate) check() error {
+	if v, ok := _u.mutation.Name(); ok {
+		if err := passkey.NameValidator(v); err != nil {
+			return &ValidationError{Name: "name", err: fmt.Errorf(`ent: validator failed for field "Passkey.name": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.Aaguid(); ok {
+		if err := passkey.AaguidValidator(v); err != nil {
+			return &ValidationError{Name: "aaguid", err: fmt.Errorf(`ent: validator failed for field "Passkey.aaguid": %w`, err)}
+		}
+	}
+	if _u.mutation.UserCleared() && len(_u.mutation.UserIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "Passkey.user"`)
+	}
+	return nil
+}
+
+// Modify adds a statement modifier for attaching custom logic to the UPDATE statement.
+func (_u *PasskeyUpdate) Modify(modifiers ...func(u *sql.UpdateBuilder)) *PasskeyUpdate {
+	_u.modifiers = append(_u.modifiers, modifiers...)
+	return _u
+}
+
+func (_u *PasskeyUpdate) sqlSave(ctx context.Context) (_node int, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(passkey.Table, passkey.Columns, sqlgraph.NewFieldSpec(passkey.FieldID, field.TypeUint))
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.CreatedAt(); ok {
+		_spec.SetField(passkey.FieldCreatedAt, field.TypeTime, value)
+	}
+	if value, ok := _u.mutation.UpdatedAt(); ok {
+		_spec.SetField(passkey.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if value, ok := _u.mutation.DeletedAt(); ok {
+		_spec.SetField(passkey.FieldDeletedAt, field.TypeTime, value)
+	}
+	if _u.mutation.DeletedAtCleared() {
+		_spec.ClearField(passkey.FieldDeletedAt, field.TypeTime)
+	}
+	if value, ok := _u.mutation.SignCount(); ok {
+		_spec.SetField(passkey.FieldSignCount, field.TypeUint32, value)
+	}
+	if value, ok := _u.mutation.AddedSignCount(); ok {
+		_spec.AddField(passkey.FieldSignCount, field.TypeUint32, value)
+	}
+	if value, ok := _u.mutation.Name(); ok {
+		_spec.SetField(passkey.FieldName, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Aaguid(); ok {
+		_spec.SetField(passkey.FieldAaguid, field.TypeString, value)
+	}
+	if _u.mutation.AaguidCleared() {
+		_spec.ClearField(passkey.FieldAaguid, field.TypeString)
+	}
+	if value, ok := _u.mutation.Transports(); ok {
+		_spec.SetField(passkey.FieldTransports, field.TypeJSON, value)
+	}
+	if value, ok := _u.mutation.AppendedTransports(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, passkey.FieldTransports, value)
+		})
+	}
+	if _u.mutation.TransportsCleared() {
+		_spec.ClearField(passkey.FieldTransports, field.TypeJSON)
+	}
+	if value, ok := _u.mutation.LastUsedAt(); ok {
+		_spec.SetField(passkey.FieldLastUsedAt, field.TypeTime, value)
+	}
+	if _u.mutation.LastUsedAtCleared() {
+		_spec.ClearField(passkey.FieldLastUsedAt, field.TypeTime)
+	}
+	_spec.AddModifiers(_u.modifiers...)
+	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{passkey.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
+
+// PasskeyUpdateOne is the builder for updating a single Passkey entity.
+type PasskeyUpdateOne struct {
+	config
+	fields    []string
+	hooks     []Hook
+	mutation  *PasskeyMutation
+	modifiers []func(*sql.UpdateBuilder)
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (_u *PasskeyUpdateOne) SetCreatedAt(v time.Time) *PasskeyUpdateOne {
+	_u.mutation.SetCreatedAt(v)
+	return _u
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (_u *PasskeyUpdateOne) SetNillableCreatedAt(v *time.Time) *PasskeyUpdateOne {
+	if v != nil {
+		_u.SetCreatedAt(*v)
+	}
+	return _u
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (_u *PasskeyUpdateOne) SetUpdatedAt(v time.Time) *PasskeyUpdateOne {
+	_u.mutation.SetUpdatedAt(v)
+	return _u
+}
+
+// SetNillableUpdatedAt sets the "updated_at" field if the given value is not nil.
+func (_u *PasskeyUpdateOne) SetNillableUpdatedAt(v *time.Time) *PasskeyUpdateOne {
+	if v != nil {
+		_u.SetUpdatedAt(*v)
+	}
+	return _u
+}
+
+// SetDeletedAt sets the "deleted_at" field.
+func (_u *PasskeyUpdateOne) SetDeletedAt(v time.Time) *PasskeyUpdateOne {
+	_u.mutation.SetDeletedAt(v)
+	return _u
+}
+
+// SetNillableDeletedAt sets the "deleted_at" field if the given value is not nil.
+func (_u *PasskeyUpdateOne) SetNillableDeletedAt(v *time.Time) *PasskeyUpdateOne {
+	if v != nil {
+		_u.SetDeletedAt(*v)
+	}
+	return _u
+}
+
+// ClearDeletedAt clears the value of the "deleted_at" field.
+func (_u *PasskeyUpdateOne) ClearDeletedAt() *PasskeyUpdateOne {
+	_u.mutation.ClearDeletedAt()
+	return _u
+}
+
+// SetSignCount sets the "sign_count" field.
+func (_u *PasskeyUpdateOne) SetSignCount(v uint32) *PasskeyUpdateOne {
+	_u.mutation.ResetSignCount()
+	_u.mutation.SetSignCount(v)
+	return _u
+}
+
+// SetNillableSignCount sets the "sign_count" field if the given value is not nil.
+func (_u *PasskeyUpdateOne) SetNillableSignCount(v *uint32) *PasskeyUpdateOne {
+	if v != nil {
+		_u.SetSignCount(*v)
+	}
+	return _u
+}
+
+// AddSignCount adds value to the "sign_count" field.
+func (_u *PasskeyUpdateOne) AddSignCount(v int32) *PasskeyUpdateOne {
+	_u.mutation.AddSignCount(v)
+	return _u
+}
+
+// SetName sets the "name" field.
+func (_u *PasskeyUpdateOne) SetName(v string) *PasskeyUpdateOne {
+	_u.mutation.SetName(v)
+	return _u
+}
+
+// SetNillableName sets the "name" field if the given value is not nil.
+func (_u *PasskeyUpdateOne) SetNillableName(v *string) *PasskeyUpdateOne {
+	if v != nil {
+		_u.SetName(*v)
+	}
+	return _u
+}
+
+// SetAaguid sets the "aaguid" field.
+func (_u *PasskeyUpdateOne) SetAaguid(v string) *PasskeyUpdateOne {
+	_u.mutation.SetAaguid(v)
+	return _u
+}
+
+// SetNillableAaguid sets the "aaguid" field if the given value is not nil.
+func (_u *PasskeyUpdateOne) SetNillableAaguid(v *string) *PasskeyUpdateOne {
+	if v != nil {
+		_u.SetAaguid(*v)
+	}
+	return _u
+}
+
+// ClearAaguid clears the value of the "aaguid" field.
+func (_u *PasskeyUpdateOne) ClearAaguid() *PasskeyUpdateOne {
+	_u.mutation.ClearAaguid()
+	return _u
+}
+
+// SetTransports sets the "transports" field.
+func (_u *PasskeyUpdateOne) SetTransports(v []string) *PasskeyUpdateOne {
+	_u.mutation.SetTransports(v)
+	return _u
+}
+
+// AppendTransports appends value to the "transports" field.
+func (_u *PasskeyUpdateOne) AppendTransports(v []string) *PasskeyUpdateOne {
+	_u.mutation.AppendTransports(v)
+	return _u
+}
+
+// ClearTransports clears the value of the "transports" field.
+func (_u *PasskeyUpdateOne) ClearTransports() *PasskeyUpdateOne {
+	_u.mutation.ClearTransports()
+	return _u
+}
+
+// SetLastUsedAt sets the "last_used_at" field.
+func (_u *PasskeyUpdateOne) SetLastUsedAt(v time.Time) *PasskeyUpdateOne {
+	_u.mutation.SetLastUsedAt(v)
+	return _u
+}
+
+// SetNillableLastUsedAt sets the "last_used_at" field if the given value is not nil.
+func (_u *PasskeyUpdateOne) SetNillableLastUsedAt(v *time.Time) *PasskeyUpdateOne {
+	if v != nil {
+		_u.SetLastUsedAt(*v)
+	}
+	return _u
+}
+
+// ClearLastUsedAt clears the value of the "last_used_at" field.
+func (_u *PasskeyUpdateOne) ClearLastUsedAt() *PasskeyUpdateOne {
+	_u.mutation.ClearLastUsedAt()
+	return _u
+}
+
+// Mutation returns the PasskeyMutation object of the builder.
+func (_u *PasskeyUpdateOne) Mutation() *PasskeyMutation {
+	return _u.mutation
+}
+
+// Where appends a list predicates to the PasskeyUpdate builder.
+func (_u *PasskeyUpdateOne) Where(ps ...predicate.Passkey) *PasskeyUpdateOne {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (_u *PasskeyUpdateOne) Select(field string, fields ...string) *PasskeyUpdateOne {
+	_u.fields = append([]string{field}, fields...)
+	return _u
+}
+
+// Save executes the query and returns the updated Passkey entity.
+func (_u *PasskeyUpdateOne) Save(ctx context.Context) (*Passkey, error) {
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *PasskeyUpdateOne) SaveX(ctx context.Context) *Passkey {
+	node, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (_u *PasskeyUpdateOne) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *PasskeyUpdateOne) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *PasskeyUpdateOne) check() error {
+	if v, ok := _u.mutation.Name(); ok {
+		if err := passkey.NameValidator(v); err != nil {
+			return &ValidationError{Name: "name", err: fmt.Errorf(`ent: validator failed for field "Passkey.name": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.Aaguid(); ok {
+		if err := passkey.AaguidValidator(v); err != nil {
+			return &ValidationError{Name: "aaguid", err: fmt.Errorf(`ent: validator failed for field "Passkey.aaguid": %w`, err)}
+		}
+	}
+	if _u.mutation.UserCleared() && len(_u.mutation.UserIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "Passkey.user"`)
+	}
+	return nil
+}
+
+// Modify adds a statement modifier for attaching custom logic to the UPDATE statement.
+func (_u *PasskeyUpdateOne) Modify(modifiers ...func(u *sql.UpdateBuilder)) *PasskeyUpdateOne {
+	_u.modifiers = append(_u.modifiers, modifiers...)
+	return _u
+}
+
+func (_u *PasskeyUpdateOne) sqlSave(ctx context.Context) (_node *Passkey, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(passkey.Table, passkey.Columns, sqlgraph.NewFieldSpec(passkey.FieldID, field.TypeUint))
+	id, ok := _u.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "Passkey.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := _u.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, passkey.FieldID)
+		for _, f := range fields {
+			if !passkey.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != passkey.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.CreatedAt(); ok {
+		_spec.SetField(passkey.FieldCreatedAt, field.TypeTime, value)
+	}
+	if value, ok := _u.mutation.UpdatedAt(); ok {
+		_spec.SetField(passkey.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if value, ok := _u.mutation.DeletedAt(); ok {
+		_spec.SetField(passkey.FieldDeletedAt, field.TypeTime, value)
+	}
+	if _u.mutation.DeletedAtCleared() {
+		_spec.ClearField(passkey.FieldDeletedAt, field.TypeTime)
+	}
+	if value, ok := _u.mutation.SignCount(); ok {
+		_spec.SetField(passkey.FieldSignCount, field.TypeUint32, value)
+	}
+	if value, ok := _u.mutation.AddedSignCount(); ok {
+		_spec.AddField(passkey.FieldSignCount, field.TypeUint32, value)
+	}
+	if value, ok := _u.mutation.Name(); ok {
+		_spec.SetField(passkey.FieldName, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Aaguid(); ok {
+		_spec.SetField(passkey.FieldAaguid, field.TypeString, value)
+	}
+	if _u.mutation.AaguidCleared() {
+		_spec.ClearField(passkey.FieldAaguid, field.TypeString)
+	}
+	if value, ok := _u.mutation.Transports(); ok {
+		_spec.SetField(passkey.FieldTransports, field.TypeJSON, value)
+	}
+	if value, ok := _u.mutation.AppendedTransports(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, passkey.FieldTransports, value)
+		})
+	}
+	if _u.mutation.TransportsCleared() {
+		_spec.ClearField(passkey.FieldTransports, field.TypeJSON)
+	}
+	if value, ok := _u.mutation.LastUsedAt(); ok {
+		_spec.SetField(passkey.FieldLastUsedAt, field.TypeTime, value)
+	}
+	if _u.mutation.LastUsedAtCleared() {
+		_spec.ClearField(passkey.FieldLastUsedAt, field.TypeTime)
+	}
+	_spec.AddModifiers(_u.modifiers...)
+	_node = &Passkey{config: _u.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{passkey.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
@@ -0,0 +1,1018 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"blog-server/ent/personalaccesstoken"
+	"blog-server/ent/user"
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// PersonalAccessTokenCreate is the builder for creating a PersonalAccessToken entity.
+type PersonalAccessTokenCreate struct {
+	config
+	mutation *PersonalAccessTokenMutation
+	hooks    []Hook
+	conflict []sql.ConflictOption
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (_c *PersonalAccessTokenCreate) SetCreatedAt(v time.Time) *PersonalAccessTokenCreate {
+	_c.mutation.SetCreatedAt(v)
+	return _c
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (_c *PersonalAccessTokenCreate) SetNillableCreatedAt(v *time.Time) *PersonalAccessTokenCreate {
+	if v != nil {
+		_c.SetCreatedAt(*v)
+	}
+	return _c
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (_c *PersonalAccessTokenCreate) SetUpdatedAt(v time.Time) *PersonalAccessTokenCreate {
+	_c.mutation.SetUpdatedAt(v)
+	return _c
+}
+
+// SetNillableUpdatedAt sets the "updated_at" field if the given value is not nil.
+func (_c *PersonalAccessTokenCreate) SetNillableUpdatedAt(v *time.Time) *PersonalAccessTokenCreate {
+	if v != nil {
+		_c.SetUpdatedAt(*v)
+	}
+	return _c
+}
+
+// SetDeletedAt sets the "deleted_at" field.
+func (_c *PersonalAccessTokenCreate) SetDeletedAt(v time.Time) *PersonalAccessTokenCreate {
+	_c.mutation.SetDeletedAt(v)
+	return _c
+}
+
+// SetNillableDeletedAt sets the "deleted_at" field if the given value is not nil.
+func (_c *PersonalAccessTokenCreate) SetNillableDeletedAt(v *time.Time) *PersonalAccessTokenCreate {
+	if v != nil {
+		_c.SetDeletedAt(*v)
+	}
+	return _c
+}
+
+// SetUserID sets the "user_id" field.
+func (_c *PersonalAccessTokenCreate) SetUserID(v uint) *PersonalAccessTokenCreate {
+	_c.mutation.SetUserID(v)
+	return _c
+}
+
+// SetName sets the "name" field.
+func (_c *PersonalAccessTokenCreate) SetName(v string) *PersonalAccessTokenCreate {
+	_c.mutation.SetName(v)
+	return _c
+}
+
+// SetTokenHash sets the "token_hash" field.
+func (_c *PersonalAccessTokenCreate) SetTokenHash(v string) *PersonalAccessTokenCreate {
+	_c.mutation.SetTokenHash(v)
+	return _c
+}
+
+// SetTokenPrefix sets the "token_prefix" field.
+func (_c *PersonalAccessTokenCreate) SetTokenPrefix(v string) *PersonalAccessTokenCreate {
+	_c.mutation.SetTokenPrefix(v)
+	return _c
+}
+
+// SetScopes sets the "scopes" field.
+func (_c *PersonalAccessTokenCreate) SetScopes(v []string) *PersonalAccessTokenCreate {
+	_c.mutation.SetScopes(v)
+	return _c
+}
+
+// SetExpiresAt sets the "expires_at" field.
+func (_c *PersonalAccessTokenCreate) SetExpiresAt(v time.Time) *PersonalAccessTokenCreate {
+	_c.mutation.SetExpiresAt(v)
+	return _c
+}
+
+// SetTwoFactor sets the "two_factor" field.
+func (_c *PersonalAccessTokenCreate) SetTwoFactor(v bool) *PersonalAccessTokenCreate {
+	_c.mutation.SetTwoFactor(v)
+	return _c
+}
+
+// SetNillableTwoFactor sets the "two_factor" field if the given value is not nil.
+func (_c *PersonalAccessTokenCreate) SetNillableTwoFactor(v *bool) *PersonalAccessTokenCreate {
+	if v != nil {
+		_c.SetTwoFactor(*v)
+	}
+	return _c
+}
+
+// SetLastUsedAt sets the "last_used_at" field.
+func (_c *PersonalAccessTokenCreate) SetLastUsedAt(v time.Time) *PersonalAccessTokenCreate {
+	_c.mutation.SetLastUsedAt(v)
+	return _c
+}
+
+// SetNillableLastUsedAt sets the "last_used_at" field if the given value is not nil.
+func (_c *PersonalAccessTokenCreate) SetNillableLastUsedAt(v *time.Time) *PersonalAccessTokenCreate {
+	if v != nil {
+		_c.SetLastUsedAt(*v)
+	}
+	return _c
+}
+
+// SetRevokedAt sets the "revoked_at" field.
+func (_c *PersonalAccessTokenCreate) SetRevokedAt(v time.Time) *PersonalAccessTokenCreate {
+	_c.mutation.SetRevokedAt(v)
+	return _c
+}
+
+// SetNillableRevokedAt sets the "revoked_at" field if the given value is not nil.
+func (_c *PersonalAccessTokenCreate) SetNillableRevokedAt(v *time.Time) *PersonalAccessTokenCreate {
+	if v != nil {
+		_c.SetRevokedAt(*v)
+	}
+	return _c
+}
+
+// SetID sets the "id" field.
+func (_c *PersonalAccessTokenCreate) SetID(v uint) *PersonalAccessTokenCreate {
+	_c.mutation.SetID(v)
+	return _c
+}
+
+// SetUser sets the "user" edge to the User entity.
+func (_c *PersonalAccessTokenCreate) SetUser(v *User) *PersonalAccessTokenCreate {
+	return _c.SetUserID(v.ID)
+}
+
+// Mutation returns the PersonalAccessTokenMutation object of the builder.
+func (_c *PersonalAccessTokenCreate) Mutation() *PersonalAccessTokenMutation {
+	return _c.mutation
+}
+
+// Save creates the PersonalAccessToken in the database.
+func (_c *PersonalAccessTokenCreate) Save(ctx context.Context) (*PersonalAccessToken, error) {
+	_c.defaults()
+	return withHooks(ctx, _c.sqlSave, _c.mutation, _c.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (_c *PersonalAccessTokenCreate) SaveX(ctx context.Context) *PersonalAccessToken {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *PersonalAccessTokenCreate) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *PersonalAccessTokenCreate) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_c *PersonalAccessTokenCreate) defaults() {
+	if _, ok := _c.mutation.CreatedAt(); !ok {
+		v := personalaccesstoken.DefaultCreatedAt()
+		_c.mutation.SetCreatedAt(v)
+	}
+	if _, ok := _c.mutation.UpdatedAt(); !ok {
+		v := personalaccesstoken.DefaultUpdatedAt()
+		_c.mutation.SetUpdatedAt(v)
+	}
+	if _, ok := _c.mutation.TwoFactor(); !ok {
+		v := personalaccesstoken.DefaultTwoFactor
+		_c.mutation.SetTwoFactor(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_c *PersonalAccessTokenCreate) check() error {
+	if _, ok := _c.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "PersonalAccessToken.created_at"`)}
+	}
+	if _, ok := _c.mutation.UpdatedAt(); !ok {
+		return &ValidationError{Name: "updated_at", err: errors.New(`ent: missing required field "PersonalAccessToken.updated_at"`)}
+	}
+	if _, ok := _c.mutation.UserID(); !ok {
+		return &ValidationError{Name: "user_id", err: errors.New(`ent: missing required field "PersonalAccessToken.user_id"`)}
+	}
+	if _, ok := _c.mutation.Name(); !ok {
+		return &ValidationError{Name: "name", err: errors.New(`ent: missing required field "PersonalAccessToken.name"`)}
+	}
+	if v, ok := _c.mutation.Name(); ok {
+		if err := personalaccesstoken.NameValidator(v); err != nil {
+			return &ValidationError{Name: "name", err: fmt.Errorf(`ent: validator failed for field "PersonalAccessToken.name": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.TokenHash(); !ok {
+		return &ValidationError{Name: "token_hash", err: errors.New(`ent: missing required field "PersonalAccessToken.token_hash"`)}
+	}
+	if v, ok := _c.mutation.TokenHash(); ok {
+		if err := personalaccesstoken.TokenHashValidator(v); err != nil {
+			return &ValidationError{Name: "token_hash", err: fmt.Errorf(`ent: validator failed for field "PersonalAccessToken.token_hash": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.TokenPrefix(); !ok {
+		return &ValidationError{Name: "token_prefix", err: errors.New(`ent: missing required field "PersonalAccessToken.token_prefix"`)}
+	}
+	if v, ok := _c.mutation.TokenPrefix(); ok {
+		if err := personalaccesstoken.TokenPrefixValidator(v); err != nil {
+			return &ValidationError{Name: "token_prefix", err: fmt.Errorf(`ent: validator failed for field "PersonalAccessToken.token_prefix": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.Scopes(); !ok {
+		return &ValidationError{Name: "scopes", err: errors.New(`ent: missing required field "PersonalAccessToken.scopes"`)}
+	}
+	if _, ok := _c.mutation.ExpiresAt(); !ok {
+		return &ValidationError{Name: "expires_at", err: errors.New(`ent: missing required field "PersonalAccessToken.expires_at"`)}
+	}
+	if _, ok := _c.mutation.TwoFactor(); !ok {
+		return &ValidationError{Name: "two_factor", err: errors.New(`ent: missing required field "PersonalAccessToken.two_factor"`)}
+	}
+	if len(_c.mutation.UserIDs()) == 0 {
+		return &ValidationError{Name: "user", err: errors.New(`ent: missing required edge "PersonalAccessToken.user"`)}
+	}
+	return nil
+}
+
+func (_c *PersonalAccessTokenCreate) sqlSave(ctx context.Context) (*PersonalAccessToken, error) {
+	if err := _c.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := _c.createSpec()
+	if err := sqlgraph.CreateNode(ctx, _c.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	if _spec.ID.Value != _node.ID {
+		id := _spec.ID.Value.(int64)
+		_node.ID = uint(id)
+	}
+	_c.mutation.id = &_node.ID
+	_c.mutation.done = true
+	return _node, nil
+}
+
+func (_c *PersonalAccessTokenCreate) createSpec() (*PersonalAccessToken, *sqlgraph.CreateSpec) {
+	var (
+		_node = &PersonalAccessToken{config: _c.config}
+		_spec = sqlgraph.NewCreateSpec(personalaccesstoken.Table, sqlgraph.NewFieldSpec(personalaccesstoken.FieldID, field.TypeUint))
+	)
+	_spec.OnConflict = _c.conflict
+	if id, ok := _c.mutation.ID(); ok {
+		_node.ID = id
+		_spec.ID.Value = id
+	}
+	if value, ok := _c.mutation.CreatedAt(); ok {
+		_spec.SetField(personalaccesstoken.FieldCreatedAt, field.TypeTime, value)
+		_node.CreatedAt = value
+	}
+	if value, ok := _c.mutation.UpdatedAt(); ok {
+		_spec.SetField(personalaccesstoken.FieldUpdatedAt, field.TypeTime, value)
+		_node.UpdatedAt = value
+	}
+	if value, ok := _c.mutation.DeletedAt(); ok {
+		_spec.SetField(personalaccesstoken.FieldDeletedAt, field.TypeTime, value)
+		_node.DeletedAt = &value
+	}
+	if value, ok := _c.mutation.Name(); ok {
+		_spec.SetField(personalaccesstoken.FieldName, field.TypeString, value)
+		_node.Name = value
+	}
+	if value, ok := _c.mutation.TokenHash(); ok {
+		_spec.SetField(personalaccesstoken.FieldTokenHash, field.TypeString, value)
+		_node.TokenHash = value
+	}
+	if value, ok := _c.mutation.TokenPrefix(); ok {
+		_spec.SetField(personalaccesstoken.FieldTokenPrefix, field.TypeString, value)
+		_node.TokenPrefix = value
+	}
+	if value, ok := _c.mutation.Scopes(); ok {
+		_spec.SetField(personalaccesstoken.FieldScopes, field.TypeJSON, value)
+		_node.Scopes = value
+	}
+	if value, ok := _c.mutation.ExpiresAt(); ok {
+		_spec.SetField(personalaccesstoken.FieldExpiresAt, field.TypeTime, value)
+		_node.ExpiresAt = value
+	}
+	if value, ok := _c.mutation.TwoFactor(); ok {
+		_spec.SetField(personalaccesstoken.FieldTwoFactor, field.TypeBool, value)
+		_node.TwoFactor = value
+	}
+	if value, ok := _c.mutation.LastUsedAt(); ok {
+		_spec.SetField(personalaccesstoken.FieldLastUsedAt, field.TypeTime, value)
+		_node.LastUsedAt = &value
+	}
+	if value, ok := _c.mutation.RevokedAt(); ok {
+		_spec.SetField(personalaccesstoken.FieldRevokedAt, field.TypeTime, value)
+		_node.RevokedAt = &value
+	}
+	if nodes := _c.mutation.UserIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   personalaccesstoken.UserTable,
+			Columns: []string{personalaccesstoken.UserColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeUint),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.UserID = nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	return _node, _spec
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.PersonalAccessToken.Create().
+//		SetCreatedAt(v).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.PersonalAccessTokenUpsert) {
+//			SetCreatedAt(v+v).
+//		}).
+//		Exec(ctx)
+func (_c *PersonalAccessTokenCreate) OnConflict(opts ...sql.ConflictOption) *PersonalAccessTokenUpsertOne {
+	_c.conflict = opts
+	return &PersonalAccessTokenUpsertOne{
+		create: _c,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.PersonalAccessToken.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (_c *PersonalAccessTokenCreate) OnConflictColumns(columns ...string) *PersonalAccessTokenUpsertOne {
+	_c.conflict = append(_c.conflict, sql.ConflictColumns(columns...))
+	return &PersonalAccessTokenUpsertOne{
+		create: _c,
+	}
+}
+
+type (
+	// PersonalAccessTokenUpsertOne is the builder for "upsert"-ing
+	//  one PersonalAccessToken node.
+	PersonalAccessTokenUpsertOne struct {
+		create *PersonalAccessTokenCreate
+	}
+
+	// PersonalAccessTokenUpsert is the "OnConflict" setter.
+	PersonalAccessTokenUpsert struct {
+		*sql.UpdateSet
+	}
+)
+
+// SetCreatedAt sets the "created_at" field.
+func (u *PersonalAccessTokenUpsert) SetCreatedAt(v time.Time) *PersonalAccessTokenUpsert {
+	u.Set(personalaccesstoken.FieldCreatedAt, v)
+	return u
+}
+
+// UpdateCreatedAt sets the "created_at" field to the value that was provided on create.
+func (u *PersonalAccessTokenUpsert) UpdateCreatedAt() *PersonalAccessTokenUpsert {
+	u.SetExcluded(personalaccesstoken.FieldCreatedAt)
+	return u
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (u *PersonalAccessTokenUpsert) SetUpdatedAt(v time.Time) *PersonalAccessTokenUpsert {
+	u.Set(personalaccesstoken.FieldUpdatedAt, v)
+	return u
+}
+
+// UpdateUpdatedAt sets the "updated_at" field to the value that was provided on create.
+func (u *PersonalAccessTokenUpsert) UpdateUpdatedAt() *PersonalAccessTokenUpsert {
+	u.SetExcluded(personalaccesstoken.FieldUpdatedAt)
+	return u
+}
+
+// SetDeletedAt sets the "deleted_at" field.
+func (u *PersonalAccessTokenUpsert) SetDeletedAt(v time.Time) *PersonalAccessTokenUpsert {
+	u.Set(personalaccesstoken.FieldDeletedAt, v)
+	return u
+}
+
+// UpdateDeletedAt sets the "deleted_at" field to the value that was provided on create.
+func (u *PersonalAccessTokenUpsert) UpdateDeletedAt() *PersonalAccessTokenUpsert {
+	u.SetExcluded(personalaccesstoken.FieldDeletedAt)
+	return u
+}
+
+// ClearDeletedAt clears the value of the "deleted_at" field.
+func (u *PersonalAccessTokenUpsert) ClearDeletedAt() *PersonalAccessTokenUpsert {
+	u.SetNull(personalaccesstoken.FieldDeletedAt)
+	return u
+}
+
+// SetName sets the "name" field.
+func (u *PersonalAccessTokenUpsert) SetName(v string) *PersonalAccessTokenUpsert {
+	u.Set(personalaccesstoken.FieldName, v)
+	return u
+}
+
+// UpdateName sets the "name" field to the value that was provided on create.
+func (u *PersonalAccessTokenUpsert) UpdateName() *PersonalAccessTokenUpsert {
+	u.SetExcluded(personalaccesstoken.FieldName)
+	return u
+}
+
+// SetLastUsedAt sets the "last_used_at" field.
+func (u *PersonalAccessTokenUpsert) SetLastUsedAt(v time.Time) *PersonalAccessTokenUpsert {
+	u.Set(personalaccesstoken.FieldLastUsedAt, v)
+	return u
+}
+
+// UpdateLastUsedAt sets the "last_used_at" field to the value that was provided on create.
+func (u *PersonalAccessTokenUpsert) UpdateLastUsedAt() *PersonalAccessTokenUpsert {
+	u.SetExcluded(personalaccesstoken.FieldLastUsedAt)
+	return u
+}
+
+// ClearLastUsedAt clears the value of the "last_used_at" field.
+func (u *PersonalAccessTokenUpsert) ClearLastUsedAt() *PersonalAccessTokenUpsert {
+	u.SetNull(personalaccesstoken.FieldLastUsedAt)
+	return u
+}
+
+// SetRevokedAt sets the "revoked_at" field.
+func (u *PersonalAccessTokenUpsert) SetRevokedAt(v time.Time) *PersonalAccessTokenUpsert {
+	u.Set(personalaccesstoken.FieldRevokedAt, v)
+	return u
+}
+
+// UpdateRevokedAt sets the "revoked_at" field to the value that was provided on create.
+func (u *PersonalAccessTokenUpsert) UpdateRevokedAt() *PersonalAccessTokenUpsert {
+	u.SetExcluded(personalaccesstoken.FieldRevokedAt)
+	return u
+}
+
+// ClearRevokedAt clears the value of the "revoked_at" field.
+func (u *PersonalAccessTokenUpsert) ClearRevokedAt() *PersonalAccessTokenUpsert {
+	u.SetNull(personalaccesstoken.FieldRevokedAt)
+	return u
+}
+
+// UpdateNewValues updates the mutable fields using the new values that were set on create except the ID field.
+// Using this option is equivalent to using:
+//
+//	client.PersonalAccessToken.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//			sql.ResolveWith(func(u *sql.UpdateSet) {
+//				u.SetIgnore(personalaccesstoken.FieldID)
+//			}),
+//		).
+//		Exec(ctx)
+func (u *PersonalAccessTokenUpsertOne) UpdateNewValues() *PersonalAccessTokenUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		if _, exists := u.create.mutation.ID(); exists {
+			s.SetIgnore(personalaccesstoken.FieldID)
+		}
+		if _, exists := u.create.mutation.UserID(); exists {
+			s.SetIgnore(personalaccesstoken.FieldUserID)
+		}
+		if _, exists := u.create.mutation.TokenHash(); exists {
+			s.SetIgnore(personalaccesstoken.FieldTokenHash)
+		}
+		if _, exists := u.create.mutation.TokenPrefix(); exists {
+			s.SetIgnore(personalaccesstoken.FieldTokenPrefix)
+		}
+		if _, exists := u.create.mutation.Scopes(); exists {
+			s.SetIgnore(personalaccesstoken.FieldScopes)
+		}
+		if _, exists := u.create.mutation.ExpiresAt(); exists {
+			s.SetIgnore(personalaccesstoken.FieldExpiresAt)
+		}
+		if _, exists := u.create.mutation.TwoFactor(); exists {
+			s.SetIgnore(personalaccesstoken.FieldTwoFactor)
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.PersonalAccessToken.Create().
+//	    OnConflict(sql.ResolveWithIgnore()).
+//	    Exec(ctx)
+func (u *PersonalAccessTokenUpsertOne) Ignore() *PersonalAccessTokenUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *PersonalAccessTokenUpsertOne) DoNothing() *PersonalAccessTokenUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the PersonalAccessTokenCreate.OnConflict
+// documentation for more info.
+func (u *PersonalAccessTokenUpsertOne) Update(set func(*PersonalAccessTokenUpsert)) *PersonalAccessTokenUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&PersonalAccessTokenUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (u *PersonalAccessTokenUpsertOne) SetCreatedAt(v time.Time) *PersonalAccessTokenUpsertOne {
+	return u.Update(func(s *PersonalAccessTokenUpsert) {
+		s.SetCreatedAt(v)
+	})
+}
+
+// UpdateCreatedAt sets the "created_at" field to the value that was provided on create.
+func (u *PersonalAccessTokenUpsertOne) UpdateCreatedAt() *PersonalAccessTokenUpsertOne {
+	return u.Update(func(s *PersonalAccessTokenUpsert) {
+		s.UpdateCreatedAt()
+	})
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (u *PersonalAccessTokenUpsertOne) SetUpdatedAt(v time.Time) *PersonalAccessTokenUpsertOne {
+	return u.Update(func(s *PersonalAccessTokenUpsert) {
+		s.SetUpdatedAt(v)
+	})
+}
+
+// UpdateUpdatedAt sets the "updated_at" field to the value that was provided on create.
+func (u *PersonalAccessTokenUpsertOne) UpdateUpdatedAt() *PersonalAccessTokenUpsertOne {
+	return u.Update(func(s *PersonalAccessTokenUpsert) {
+		s.UpdateUpdatedAt()
+	})
+}
+
+// SetDeletedAt sets the "deleted_at" field.
+func (u *PersonalAccessTokenUpsertOne) SetDeletedAt(v time.Time) *PersonalAccessTokenUpsertOne {
+	return u.Update(func(s *PersonalAccessTokenUpsert) {
+		s.SetDeletedAt(v)
+	})
+}
+
+// UpdateDeletedAt sets the "deleted_at" field to the value that was provided on create.
+func (u *PersonalAccessTokenUpsertOne) UpdateDeletedAt() *PersonalAccessTokenUpsertOne {
+	return u.Update(func(s *PersonalAccessTokenUpsert) {
+		s.UpdateDeletedAt()
+	})
+}
+
+// ClearDeletedAt clears the value of the "deleted_at" field.
+func (u *PersonalAccessTokenUpsertOne) ClearDeletedAt() *PersonalAccessTokenUpsertOne {
+	return u.Update(func(s *PersonalAccessTokenUpsert) {
+		s.ClearDeletedAt()
+	})
+}
+
+// SetName sets the "name" field.
+func (u *PersonalAccessTokenUpsertOne) SetName(v string) *PersonalAccessTokenUpsertOne {
+	return u.Update(func(s *PersonalAccessTokenUpsert) {
+		s.SetName(v)
+	})
+}
+
+// UpdateName sets the "name" field to the value that was provided on create.
+func (u *PersonalAccessTokenUpsertOne) UpdateName() *PersonalAccessTokenUpsertOne {
+	return u.Update(func(s *PersonalAccessTokenUpsert) {
+		s.UpdateName()
+	})
+}
+
+// SetLastUsedAt sets the "last_used_at" field.
+func (u *PersonalAccessTokenUpsertOne) SetLastUsedAt(v time.Time) *PersonalAccessTokenUpsertOne {
+	return u.Update(func(s *PersonalAccessTokenUpsert) {
+		s.SetLastUsedAt(v)
+	})
+}
+
+// UpdateLastUsedAt sets the "last_used_at" field to the value that was provided on create.
+func (u *PersonalAccessTokenUpsertOne) UpdateLastUsedAt() *PersonalAccessTokenUpsertOne {
+	return u.Update(func(s *PersonalAccessTokenUpsert) {
+		s.UpdateLastUsedAt()
+	})
+}
+
+// ClearLastUsedAt clears the value of the "last_used_at" field.
+func (u *PersonalAccessTokenUpsertOne) ClearLastUsedAt() *PersonalAccessTokenUpsertOne {
+	return u.Update(func(s *PersonalAccessTokenUpsert) {
+		s.ClearLastUsedAt()
+	})
+}
+
+// SetRevokedAt sets the "revoked_at" field.
+func (u *PersonalAccessTokenUpsertOne) SetRevokedAt(v time.Time) *PersonalAccessTokenUpsertOne {
+	return u.Update(func(s *PersonalAccessTokenUpsert) {
+		s.SetRevokedAt(v)
+	})
+}
+
+// UpdateRevokedAt sets the "revoked_at" field to the value that was provided on create.
+func (u *PersonalAccessTokenUpsertOne) UpdateRevokedAt() *PersonalAccessTokenUpsertOne {
+	return u.Update(func(s *PersonalAccessTokenUpsert) {
+		s.UpdateRevokedAt()
+	})
+}
+
+// ClearRevokedAt clears the value of the "revoked_at" field.
+func (u *PersonalAccessTokenUpsertOne) ClearRevokedAt() *PersonalAccessTokenUpsertOne {
+	return u.Update(func(s *PersonalAccessTokenUpsert) {
+		s.ClearRevokedAt()
+	})
+}
+
+// Exec executes the query.
+func (u *PersonalAccessTokenUpsertOne) Exec(ctx context.Context) error {
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for PersonalAccessTokenCreate.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *PersonalAccessTokenUpsertOne) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// Exec executes the UPSERT query and returns the inserted/updated ID.
+func (u *PersonalAccessTokenUpsertOne) ID(ctx context.Context) (id uint, err error) {
+	node, err := u.create.Save(ctx)
+	if err != nil {
+		return id, err
+	}
+	return node.ID, nil
+}
+
+// IDX is like ID, but panics if an error occurs.
+func (u *PersonalAccessTokenUpsertOne) IDX(ctx context.Context) uint {
+	id, err := u.ID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// PersonalAccessTokenCreateBulk is the builder for creating many PersonalAccessToken entities in bulk.
+type PersonalAccessTokenCreateBulk struct {
+	config
+	err      error
+	builders []*PersonalAccessTokenCreate
+	conflict []sql.ConflictOption
+}
+
+// Save creates the PersonalAccessToken entities in the database.
+func (_c *PersonalAccessTokenCreateBulk) Save(ctx context.Context) ([]*PersonalAccessToken, error) {
+	if _c.err != nil {
+		return nil, _c.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(_c.builders))
+	nodes := make([]*PersonalAccessToken, len(_c.builders))
+	mutators := make([]Mutator, len(_c.builders))
+	for i := range _c.builders {
+		func(i int, root context.Context) {
+			builder := _c.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*PersonalAccessTokenMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, _c.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					spec.OnConflict = _c.conflict
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, _c.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				if specs[i].ID.Value != nil && nodes[i].ID == 0 {
+					id := specs[i].ID.Value.(int64)
+					nodes[i].ID = uint(id)
+				}
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, _c.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_c *PersonalAccessTokenCreateBulk) SaveX(ctx context.Context) []*PersonalAccessToken {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *PersonalAccessTokenCreateBulk) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *PersonalAccessTokenCreateBulk) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.PersonalAccessToken.CreateBulk(builders...).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.PersonalAccessTokenUpsert) {
+//			SetCreatedAt(v+v).
+//		}).
+//		Exec(ctx)
+func (_c *PersonalAccessTokenCreateBulk) OnConflict(opts ...sql.ConflictOption) *PersonalAccessTokenUpsertBulk {
+	_c.conflict = opts
+	return &PersonalAccessTokenUpsertBulk{
+		create: _c,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.PersonalAccessToken.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (_c *PersonalAccessTokenCreateBulk) OnConflictColumns(columns ...string) *PersonalAccessTokenUpsertBulk {
+	_c.conflict = append(_c.conflict, sql.ConflictColumns(columns...))
+	return &PersonalAccessTokenUpsertBulk{
+		create: _c,
+	}
+}
+
+// PersonalAccessTokenUpsertBulk is the builder for "upsert"-ing
+// a bulk of PersonalAccessToken nodes.
+type PersonalAccessTokenUpsertBulk struct {
+	create *PersonalAccessTokenCreateBulk
+}
+
+// UpdateNewValues updates the mutable fields using the new values that
+// were set on create. Using this option is equivalent to using:
+//
+//	client.PersonalAccessToken.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//			sql.ResolveWith(func(u *sql.UpdateSet) {
+//				u.SetIgnore(personalaccesstoken.FieldID)
+//			}),
+//		).
+//		Exec(ctx)
+func (u *PersonalAccessTokenUpsertBulk) UpdateNewValues() *PersonalAccessTokenUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		for _, b := range u.create.builders {
+			if _, exists := b.mutation.ID(); exists {
+				s.SetIgnore(personalaccesstoken.FieldID)
+			}
+			if _, exists := b.mutation.UserID(); exists {
+				s.SetIgnore(personalaccesstoken.FieldUserID)
+			}
+			if _, exists := b.mutation.TokenHash(); exists {
+				s.SetIgnore(personalaccesstoken.FieldTokenHash)
+			}
+			if _, exists := b.mutation.TokenPrefix(); exists {
+				s.SetIgnore(personalaccesstoken.FieldTokenPrefix)
+			}
+			if _, exists := b.mutation.Scopes(); exists {
+				s.SetIgnore(personalaccesstoken.FieldScopes)
+			}
+			if _, exists := b.mutation.ExpiresAt(); exists {
+				s.SetIgnore(personalaccesstoken.FieldExpiresAt)
+			}
+			if _, exists := b.mutation.TwoFactor(); exists {
+				s.SetIgnore(personalaccesstoken.FieldTwoFactor)
+			}
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.PersonalAccessToken.Create().
+//		OnConflict(sql.ResolveWithIgnore()).
+//		Exec(ctx)
+func (u *PersonalAccessTokenUpsertBulk) Ignore() *PersonalAccessTokenUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *PersonalAccessTokenUpsertBulk) DoNothing() *PersonalAccessTokenUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the PersonalAccessTokenCreateBulk.OnConflict
+// documentation for more info.
+func (u *PersonalAccessTokenUpsertBulk) Update(set func(*PersonalAccessTokenUpsert)) *PersonalAccessTokenUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&PersonalAccessTokenUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (u *PersonalAccessTokenUpsertBulk) SetCreatedAt(v time.Time) *PersonalAccessTokenUpsertBulk {
+	return u.Update(func(s *PersonalAccessTokenUpsert) {
+		s.SetCreatedAt(v)
+	})
+}
+
+// UpdateCreatedAt sets the "created_at" field to the value that was provided on create.
+func (u *PersonalAccessTokenUpsertBulk) UpdateCreatedAt() *PersonalAccessTokenUpsertBulk {
+	return u.Update(func(s *PersonalAccessTokenUpsert) {
+		s.UpdateCreatedAt()
+	})
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (u *PersonalAccessTokenUpsertBulk) SetUpdatedAt(v time.Time) *PersonalAccessTokenUpsertBulk {
+	return u.Update(func(s *PersonalAccessTokenUpsert) {
+		s.SetUpdatedAt(v)
+	})
+}
+
+// UpdateUpdatedAt sets the "updated_at" field to the value that was provided on create.
+func (u *PersonalAccessTokenUpsertBulk) UpdateUpdatedAt() *PersonalAccessTokenUpsertBulk {
+	return u.Update(func(s *PersonalAccessTokenUpsert) {
+		s.UpdateUpdatedAt()
+	})
+}
+
+// SetDeletedAt sets the "deleted_at" field.
+func (u *PersonalAccessTokenUpsertBulk) SetDeletedAt(v time.Time) *PersonalAccessTokenUpsertBulk {
+	return u.Update(func(s *PersonalAccessTokenUpsert) {
+		s.SetDeletedAt(v)
+	})
+}
+
+// UpdateDeletedAt sets the "deleted_at" field to the value that was provided on create.
+func (u *PersonalAccessTokenUpsertBulk) UpdateDeletedAt() *PersonalAccessTokenUpsertBulk {
+	return u.Update(func(s *PersonalAccessTokenUpsert) {
+		s.UpdateDeletedAt()
+	})
+}
+
+// ClearDeletedAt clears the value of the "deleted_at" field.
+func (u *PersonalAccessTokenUpsertBulk) ClearDeletedAt() *PersonalAccessTokenUpsertBulk {
+	return u.Update(func(s *PersonalAccessTokenUpsert) {
+		s.ClearDeletedAt()
+	})
+}
+
+// SetName sets the "name" field.
+func (u *PersonalAccessTokenUpsertBulk) SetName(v string) *PersonalAccessTokenUpsertBulk {
+	return u.Update(func(s *PersonalAccessTokenUpsert) {
+		s.SetName(v)
+	})
+}
+
+// UpdateName sets the "name" field to the value that was provided on create.
+func (u *PersonalAccessTokenUpsertBulk) UpdateName() *PersonalAccessTokenUpsertBulk {
+	return u.Update(func(s *PersonalAccessTokenUpsert) {
+		s.UpdateName()
+	})
+}
+
+// SetLastUsedAt sets the "last_used_at" field.
+func (u *PersonalAccessTokenUpsertBulk) SetLastUsedAt(v time.Time) *PersonalAccessTokenUpsertBulk {
+	return u.Update(func(s *PersonalAccessTokenUpsert) {
+		s.SetLastUsedAt(v)
+	})
+}
+
+// UpdateLastUsedAt sets the "last_used_at" field to the value that was provided on create.
+func (u *PersonalAccessTokenUpsertBulk) UpdateLastUsedAt() *PersonalAccessTokenUpsertBulk {
+	return u.Update(func(s *PersonalAccessTokenUpsert) {
+		s.UpdateLastUsedAt()
+	})
+}
+
+// ClearLastUsedAt clears the value of the "last_used_at" field.
+func (u *PersonalAccessTokenUpsertBulk) ClearLastUsedAt() *PersonalAccessTokenUpsertBulk {
+	return u.Update(func(s *PersonalAccessTokenUpsert) {
+		s.ClearLastUsedAt()
+	})
+}
+
+// SetRevokedAt sets the "revoked_at" field.
+func (u *PersonalAccessTokenUpsertBulk) SetRevokedAt(v time.Time) *PersonalAccessTokenUpsertBulk {
+	return u.Update(func(s *PersonalAccessTokenUpsert) {
+		s.SetRevokedAt(v)
+	})
+}
+
+// UpdateRevokedAt sets the "revoked_at" field to the value that was provided on create.
+func (u *PersonalAccessTokenUpsertBulk) UpdateRevokedAt() *PersonalAccessTokenUpsertBulk {
+	return u.Update(func(s *PersonalAccessTokenUpsert) {
+		s.UpdateRevokedAt()
+	})
+}
+
+// ClearRevokedAt clears the value of the "revoked_at" field.
+func (u *PersonalAccessTokenUpsertBulk) ClearRevokedAt() *PersonalAccessTokenUpsertBulk {
+	return u.Update(func(s *PersonalAccessTokenUpsert) {
+		s.ClearRevokedAt()
+	})
+}
+
+// Exec executes the query.
+func (u *PersonalAccessTokenUpsertBulk) Exec(ctx context.Context) error {
+	if u.create.err != nil {
+		return u.create.err
+	}
+	for i, b := range u.create.builders {
+		if len(b.conflict) != 0 {
+			return fmt.Errorf("ent: OnConflict was set for builder %d. Set it on the PersonalAccessTokenCreateBulk instead", i)
+		}
+	}
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for PersonalAccessTokenCreateBulk.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *PersonalAccessTokenUpsertBulk) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -29,6 +29,8 @@ type Post struct {
 	UserID uint `json:"user_id,omitempty"`
 	// Title holds the value of the "title" field.
 	Title string `json:"title,omitempty"`
+	// Slug holds the value of the "slug" field.
+	Slug string `json:"slug,omitempty"`
 	// Summary holds the value of the "summary" field.
 	Summary *string `json:"summary,omitempty"`
 	// Content holds the value of the "content" field.
@@ -43,6 +45,8 @@ type Post struct {
 	Status entity.PostStatus `json:"status,omitempty"`
 	// PublishedAt holds the value of the "published_at" field.
 	PublishedAt *time.Time `json:"published_at,omitempty"`
+	// SearchVector holds the value of the "search_vector" field.
+	SearchVector *string `json:"-"`
 	// Edges holds the relations/edges for other nodes in the graph.
 	// The values are being populated by the PostQuery when eager-loading is set.
 	Edges        PostEdges `json:"edges"`
@@ -57,13 +61,19 @@ type PostEdges struct {
 	Categories []*PostCategory `json:"categories,omitempty"`
 	// Tags holds the value of the tags edge.
 	Tags []*PostTag `json:"tags,omitempty"`
+	// Comments holds the value of the comments edge.
+	Comments []*Comment `json:"comments,omitempty"`
+	// SlugHistory holds the value of the slug_history edge.
+	SlugHistory []*PostSlug `json:"slug_history,omitempty"`
+	// Revisions holds the value of the revisions edge.
+	Revisions []*PostRevision `json:"revisions,omitempty"`
 	// PostCategoryRelations holds the value of the post_category_relations edge.
 	PostCategoryRelations []*PostCategoryRelation `json:"post_category_relations,omitempty"`
 	// PostTagRelations holds the value of the post_tag_relations edge.
 	PostTagRelations []*PostTagRelation `json:"post_tag_relations,omitempty"`
 	// loadedTypes holds the information for reporting if a
 	// type was loaded (or requested) in eager-loading or not.
-	loadedTypes [5]bool
+	loadedTypes [8]bool
 }
 
 // AuthorOrErr returns the Author value or an error if the edge
@@ -95,10 +105,37 @@ func (e PostEdges) TagsOrErr() ([]*PostTag, error) {
 	return nil, &NotLoadedError{edge: "tags"}
 }
 
+// CommentsOrErr returns the Comments value or an error if the edge
+// was not loaded in eager-loading.
+func (e PostEdges) CommentsOrErr() ([]*Comment, error) {
+	if e.loadedTypes[3] {
+		return e.Comments, nil
+	}
+	return nil, &NotLoadedError{edge: "comments"}
+}
+
+// SlugHistoryOrErr returns the SlugHistory value or an error if the edge
+// was not loaded in eager-loading.
+func (e PostEdges) SlugHistoryOrErr() ([]*PostSlug, error) {
+	if e.loadedTypes[4] {
+		return e.SlugHistory, nil
+	}
+	return nil, &NotLoadedError{edge: "slug_history"}
+}
+
+// RevisionsOrErr returns the Revisions value or an error if the edge
+// was not loaded in eager-loading.
+func (e PostEdges) RevisionsOrErr() ([]*PostRevision, error) {
+	if e.loadedTypes[5] {
+		return e.Revisions, nil
+	}
+	return nil, &NotLoadedError{edge: "revisions"}
+}
+
 // PostCategoryRelationsOrErr returns the PostCategoryRelations value or an error if the edge
 // was not loaded in eager-loading.
 func (e PostEdges) PostCategoryRelationsOrErr() ([]*PostCategoryRelation, error) {
-	if e.loadedTypes[3] {
+	if e.loadedTypes[6] {
 		return e.PostCategoryRelations, nil
 	}
 	return nil, &NotLoadedError{edge: "post_category_relations"}
@@ -107,7 +144,7 @@ func (e PostEdges) PostCategoryRelationsOrErr() ([]*PostCategoryRelation, error)
 // PostTagRelationsOrErr returns the PostTagRelations value or an error if the edge
 // was not loaded in eager-loading.
 func (e PostEdges) PostTagRelationsOrErr() ([]*PostTagRelation, error) {
-	if e.loadedTypes[4] {
+	if e.loadedTypes[7] {
 		return e.PostTagRelations, nil
 	}
 	return nil, &NotLoadedError{edge: "post_tag_relations"}
@@ -120,7 +157,7 @@ func (*Post) scanValues(columns []string) ([]any, error) {
 		switch columns[i] {
 		case post.FieldID, post.FieldUserID, post.FieldReadTimeMinutes, post.FieldViewCount:
 			values[i] = new(sql.NullInt64)
-		case post.FieldTitle, post.FieldSummary, post.FieldContent, post.FieldCover, post.FieldStatus:
+		case post.FieldTitle, post.FieldSlug, post.FieldSummary, post.FieldContent, post.FieldCover, post.FieldStatus, post.FieldSearchVector:
 			values[i] = new(sql.NullString)
 		case post.FieldCreatedAt, post.FieldUpdatedAt, post.FieldDeletedAt, post.FieldPublishedAt:
 			values[i] = new(sql.NullTime)
@@ -176,6 +213,12 @@ func (_m *Post) assignValues(columns []string, values []any) error {
 			} else if value.Valid {
 				_m.Title = value.String
 			}
+		case post.FieldSlug:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field slug", values[i])
+			} else if value.Valid {
+				_m.Slug = value.String
+			}
 		case post.FieldSummary:
 			if value, ok := values[i].(*sql.NullString); !ok {
 				return fmt.Errorf("unexpected type %T for field summary", values[i])
@@ -221,6 +264,13 @@ func (_m *Post) assignValues(columns []string, values []any) error {
 				_m.PublishedAt = new(time.Time)
 				*_m.PublishedAt = value.Time
 			}
+		case post.FieldSearchVector:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field search_vector", values[i])
+			} else if value.Valid {
+				_m.SearchVector = new(string)
+				*_m.SearchVector = value.String
+			}
 		default:
 			_m.selectValues.Set(columns[i], values[i])
 		}
@@ -249,6 +299,21 @@ func (_m *Post) QueryTags() *PostTagQuery {
 	return NewPostClient(_m.config).QueryTags(_m)
 }
 
+// QueryComments queries the "comments" edge of the Post entity.
+func (_m *Post) QueryComments() *CommentQuery {
+	return NewPostClient(_m.config).QueryComments(_m)
+}
+
+// QuerySlugHistory queries the "slug_history" edge of the Post entity.
+func (_m *Post) QuerySlugHistory() *PostSlugQuery {
+	return NewPostClient(_m.config).QuerySlugHistory(_m)
+}
+
+// QueryRevisions queries the "revisions" edge of the Post entity.
+func (_m *Post) QueryRevisions() *PostRevisionQuery {
+	return NewPostClient(_m.config).QueryRevisions(_m)
+}
+
 // QueryPostCategoryRelations queries the "post_category_relations" edge of the Post entity.
 func (_m *Post) QueryPostCategoryRelations() *PostCategoryRelationQuery {
 	return NewPostClient(_m.config).QueryPostCategoryRelations(_m)
@@ -299,6 +364,9 @@ func (_m *Post) String() string {
 	builder.WriteString("title=")
 	builder.WriteString(_m.Title)
 	builder.WriteString(", ")
+	builder.WriteString("slug=")
+	builder.WriteString(_m.Slug)
+	builder.WriteString(", ")
 	if v := _m.Summary; v != nil {
 		builder.WriteString("summary=")
 		builder.WriteString(*v)
@@ -325,6 +393,8 @@ func (_m *Post) String() string {
 		builder.WriteString("published_at=")
 		builder.WriteString(v.Format(time.ANSIC))
 	}
+	builder.WriteString(", ")
+	builder.WriteString("search_vector=<sensitive>")
 	builder.WriteByte(')')
 	return builder.String()
 }
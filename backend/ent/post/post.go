@@ -26,6 +26,8 @@ const (
 	FieldUserID = "user_id"
 	// FieldTitle holds the string denoting the title field in the database.
 	FieldTitle = "title"
+	// FieldSlug holds the string denoting the slug field in the database.
+	FieldSlug = "slug"
 	// FieldSummary holds the string denoting the summary field in the database.
 	FieldSummary = "summary"
 	// FieldContent holds the string denoting the content field in the database.
@@ -40,12 +42,20 @@ const (
 	FieldStatus = "status"
 	// FieldPublishedAt holds the string denoting the published_at field in the database.
 	FieldPublishedAt = "published_at"
+	// FieldSearchVector holds the string denoting the search_vector field in the database.
+	FieldSearchVector = "search_vector"
 	// EdgeAuthor holds the string denoting the author edge name in mutations.
 	EdgeAuthor = "author"
 	// EdgeCategories holds the string denoting the categories edge name in mutations.
 	EdgeCategories = "categories"
 	// EdgeTags holds the string denoting the tags edge name in mutations.
 	EdgeTags = "tags"
+	// EdgeComments holds the string denoting the comments edge name in mutations.
+	EdgeComments = "comments"
+	// EdgeSlugHistory holds the string denoting the slug_history edge name in mutations.
+	EdgeSlugHistory = "slug_history"
+	// EdgeRevisions holds the string denoting the revisions edge name in mutations.
+	EdgeRevisions = "revisions"
 	// EdgePostCategoryRelations holds the string denoting the post_category_relations edge name in mutations.
 	EdgePostCategoryRelations = "post_category_relations"
 	// EdgePostTagRelations holds the string denoting the post_tag_relations edge name in mutations.
@@ -69,6 +79,27 @@ const (
 	// TagsInverseTable is the table name for the PostTag entity.
 	// It exists in this package in order to avoid circular dependency with the "posttag" package.
 	TagsInverseTable = "post_tags"
+	// CommentsTable is the table that holds the comments relation/edge.
+	CommentsTable = "comments"
+	// CommentsInverseTable is the table name for the Comment entity.
+	// It exists in this package in order to avoid circular dependency with the "comment" package.
+	CommentsInverseTable = "comments"
+	// CommentsColumn is the table column denoting the comments relation/edge.
+	CommentsColumn = "post_id"
+	// SlugHistoryTable is the table that holds the slug_history relation/edge.
+	SlugHistoryTable = "post_slugs"
+	// SlugHistoryInverseTable is the table name for the PostSlug entity.
+	// It exists in this package in order to avoid circular dependency with the "postslug" package.
+	SlugHistoryInverseTable = "post_slugs"
+	// SlugHistoryColumn is the table column denoting the slug_history relation/edge.
+	SlugHistoryColumn = "post_id"
+	// RevisionsTable is the table that holds the revisions relation/edge.
+	RevisionsTable = "post_revisions"
+	// RevisionsInverseTable is the table name for the PostRevision entity.
+	// It exists in this package in order to avoid circular dependency with the "postrevision" package.
+	RevisionsInverseTable = "post_revisions"
+	// RevisionsColumn is the table column denoting the revisions relation/edge.
+	RevisionsColumn = "post_id"
 	// PostCategoryRelationsTable is the table that holds the post_category_relations relation/edge.
 	PostCategoryRelationsTable = "post_category_relations"
 	// PostCategoryRelationsInverseTable is the table name for the PostCategoryRelation entity.
@@ -93,6 +124,7 @@ var Columns = []string{
 	FieldDeletedAt,
 	FieldUserID,
 	FieldTitle,
+	FieldSlug,
 	FieldSummary,
 	FieldContent,
 	FieldCover,
@@ -100,6 +132,7 @@ var Columns = []string{
 	FieldViewCount,
 	FieldStatus,
 	FieldPublishedAt,
+	FieldSearchVector,
 }
 
 var (
@@ -128,6 +161,8 @@ var (
 	DefaultUpdatedAt func() time.Time
 	// TitleValidator is a validator for the "title" field. It is called by the builders before save.
 	TitleValidator func(string) error
+	// SlugValidator is a validator for the "slug" field. It is called by the builders before save.
+	SlugValidator func(string) error
 	// SummaryValidator is a validator for the "summary" field. It is called by the builders before save.
 	SummaryValidator func(string) error
 	// CoverValidator is a validator for the "cover" field. It is called by the builders before save.
@@ -141,7 +176,7 @@ const DefaultStatus entity.PostStatus = "draft"
 // StatusValidator is a validator for the "status" field enum values. It is called by the builders before save.
 func StatusValidator(s entity.PostStatus) error {
 	switch s {
-	case "draft", "published", "archived":
+	case "draft", "published", "archived", "scheduled":
 		return nil
 	default:
 		return fmt.Errorf("post: invalid enum value for status field: %q", s)
@@ -181,6 +216,11 @@ func ByTitle(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldTitle, opts...).ToFunc()
 }
 
+// BySlug orders the results by the slug field.
+func BySlug(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldSlug, opts...).ToFunc()
+}
+
 // BySummary orders the results by the summary field.
 func BySummary(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldSummary, opts...).ToFunc()
@@ -216,6 +256,11 @@ func ByPublishedAt(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldPublishedAt, opts...).ToFunc()
 }
 
+// BySearchVector orders the results by the search_vector field.
+func BySearchVector(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldSearchVector, opts...).ToFunc()
+}
+
 // ByAuthorField orders the results by author field.
 func ByAuthorField(field string, opts ...sql.OrderTermOption) OrderOption {
 	return func(s *sql.Selector) {
@@ -251,6 +296,48 @@ func ByTags(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
 	}
 }
 
+// ByCommentsCount orders the results by comments count.
+func ByCommentsCount(opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborsCount(s, newCommentsStep(), opts...)
+	}
+}
+
+// ByComments orders the results by comments terms.
+func ByComments(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newCommentsStep(), append([]sql.OrderTerm{term}, terms...)...)
+	}
+}
+
+// BySlugHistoryCount orders the results by slug_history count.
+func BySlugHistoryCount(opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborsCount(s, newSlugHistoryStep(), opts...)
+	}
+}
+
+// BySlugHistory orders the results by slug_history terms.
+func BySlugHistory(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newSlugHistoryStep(), append([]sql.OrderTerm{term}, terms...)...)
+	}
+}
+
+// ByRevisionsCount orders the results by revisions count.
+func ByRevisionsCount(opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborsCount(s, newRevisionsStep(), opts...)
+	}
+}
+
+// ByRevisions orders the results by revisions terms.
+func ByRevisions(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newRevisionsStep(), append([]sql.OrderTerm{term}, terms...)...)
+	}
+}
+
 // ByPostCategoryRelationsCount orders the results by post_category_relations count.
 func ByPostCategoryRelationsCount(opts ...sql.OrderTermOption) OrderOption {
 	return func(s *sql.Selector) {
@@ -299,6 +386,27 @@ func newTagsStep() *sqlgraph.Step {
 		sqlgraph.Edge(sqlgraph.M2M, false, TagsTable, TagsPrimaryKey...),
 	)
 }
+func newCommentsStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(CommentsInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.O2M, false, CommentsTable, CommentsColumn),
+	)
+}
+func newSlugHistoryStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(SlugHistoryInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.O2M, false, SlugHistoryTable, SlugHistoryColumn),
+	)
+}
+func newRevisionsStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(RevisionsInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.O2M, false, RevisionsTable, RevisionsColumn),
+	)
+}
 func newPostCategoryRelationsStep() *sqlgraph.Step {
 	return sqlgraph.NewStep(
 		sqlgraph.From(Table, FieldID),
@@ -81,6 +81,11 @@ func Title(v string) predicate.Post {
 	return predicate.Post(sql.FieldEQ(FieldTitle, v))
 }
 
+// Slug applies equality check predicate on the "slug" field. It's identical to SlugEQ.
+func Slug(v string) predicate.Post {
+	return predicate.Post(sql.FieldEQ(FieldSlug, v))
+}
+
 // Summary applies equality check predicate on the "summary" field. It's identical to SummaryEQ.
 func Summary(v string) predicate.Post {
 	return predicate.Post(sql.FieldEQ(FieldSummary, v))
@@ -111,6 +116,11 @@ func PublishedAt(v time.Time) predicate.Post {
 	return predicate.Post(sql.FieldEQ(FieldPublishedAt, v))
 }
 
+// SearchVector applies equality check predicate on the "search_vector" field. It's identical to SearchVectorEQ.
+func SearchVector(v string) predicate.Post {
+	return predicate.Post(sql.FieldEQ(FieldSearchVector, v))
+}
+
 // CreatedAtEQ applies the EQ predicate on the "created_at" field.
 func CreatedAtEQ(v time.Time) predicate.Post {
 	return predicate.Post(sql.FieldEQ(FieldCreatedAt, v))
@@ -326,6 +336,81 @@ func TitleContainsFold(v string) predicate.Post {
 	return predicate.Post(sql.FieldContainsFold(FieldTitle, v))
 }
 
+// SlugEQ applies the EQ predicate on the "slug" field.
+func SlugEQ(v string) predicate.Post {
+	return predicate.Post(sql.FieldEQ(FieldSlug, v))
+}
+
+// SlugNEQ applies the NEQ predicate on the "slug" field.
+func SlugNEQ(v string) predicate.Post {
+	return predicate.Post(sql.FieldNEQ(FieldSlug, v))
+}
+
+// SlugIn applies the In predicate on the "slug" field.
+func SlugIn(vs ...string) predicate.Post {
+	return predicate.Post(sql.FieldIn(FieldSlug, vs...))
+}
+
+// SlugNotIn applies the NotIn predicate on the "slug" field.
+func SlugNotIn(vs ...string) predicate.Post {
+	return predicate.Post(sql.FieldNotIn(FieldSlug, vs...))
+}
+
+// SlugGT applies the GT predicate on the "slug" field.
+func SlugGT(v string) predicate.Post {
+	return predicate.Post(sql.FieldGT(FieldSlug, v))
+}
+
+// SlugGTE applies the GTE predicate on the "slug" field.
+func SlugGTE(v string) predicate.Post {
+	return predicate.Post(sql.FieldGTE(FieldSlug, v))
+}
+
+// SlugLT applies the LT predicate on the "slug" field.
+func SlugLT(v string) predicate.Post {
+	return predicate.Post(sql.FieldLT(FieldSlug, v))
+}
+
+// SlugLTE applies the LTE predicate on the "slug" field.
+func SlugLTE(v string) predicate.Post {
+	return predicate.Post(sql.FieldLTE(FieldSlug, v))
+}
+
+// SlugContains applies the Contains predicate on the "slug" field.
+func SlugContains(v string) predicate.Post {
+	return predicate.Post(sql.FieldContains(FieldSlug, v))
+}
+
+// SlugHasPrefix applies the HasPrefix predicate on the "slug" field.
+func SlugHasPrefix(v string) predicate.Post {
+	return predicate.Post(sql.FieldHasPrefix(FieldSlug, v))
+}
+
+// SlugHasSuffix applies the HasSuffix predicate on the "slug" field.
+func SlugHasSuffix(v string) predicate.Post {
+	return predicate.Post(sql.FieldHasSuffix(FieldSlug, v))
+}
+
+// SlugIsNil applies the IsNil predicate on the "slug" field.
+func SlugIsNil() predicate.Post {
+	return predicate.Post(sql.FieldIsNull(FieldSlug))
+}
+
+// SlugNotNil applies the NotNil predicate on the "slug" field.
+func SlugNotNil() predicate.Post {
+	return predicate.Post(sql.FieldNotNull(FieldSlug))
+}
+
+// SlugEqualFold applies the EqualFold predicate on the "slug" field.
+func SlugEqualFold(v string) predicate.Post {
+	return predicate.Post(sql.FieldEqualFold(FieldSlug, v))
+}
+
+// SlugContainsFold applies the ContainsFold predicate on the "slug" field.
+func SlugContainsFold(v string) predicate.Post {
+	return predicate.Post(sql.FieldContainsFold(FieldSlug, v))
+}
+
 // SummaryEQ applies the EQ predicate on the "summary" field.
 func SummaryEQ(v string) predicate.Post {
 	return predicate.Post(sql.FieldEQ(FieldSummary, v))
@@ -701,6 +786,81 @@ func PublishedAtNotNil() predicate.Post {
 	return predicate.Post(sql.FieldNotNull(FieldPublishedAt))
 }
 
+// SearchVectorEQ applies the EQ predicate on the "search_vector" field.
+func SearchVectorEQ(v string) predicate.Post {
+	return predicate.Post(sql.FieldEQ(FieldSearchVector, v))
+}
+
+// SearchVectorNEQ applies the NEQ predicate on the "search_vector" field.
+func SearchVectorNEQ(v string) predicate.Post {
+	return predicate.Post(sql.FieldNEQ(FieldSearchVector, v))
+}
+
+// SearchVectorIn applies the In predicate on the "search_vector" field.
+func SearchVectorIn(vs ...string) predicate.Post {
+	return predicate.Post(sql.FieldIn(FieldSearchVector, vs...))
+}
+
+// SearchVectorNotIn applies the NotIn predicate on the "search_vector" field.
+func SearchVectorNotIn(vs ...string) predicate.Post {
+	return predicate.Post(sql.FieldNotIn(FieldSearchVector, vs...))
+}
+
+// SearchVectorGT applies the GT predicate on the "search_vector" field.
+func SearchVectorGT(v string) predicate.Post {
+	return predicate.Post(sql.FieldGT(FieldSearchVector, v))
+}
+
+// SearchVectorGTE applies the GTE predicate on the "search_vector" field.
+func SearchVectorGTE(v string) predicate.Post {
+	return predicate.Post(sql.FieldGTE(FieldSearchVector, v))
+}
+
+// SearchVectorLT applies the LT predicate on the "search_vector" field.
+func SearchVectorLT(v string) predicate.Post {
+	return predicate.Post(sql.FieldLT(FieldSearchVector, v))
+}
+
+// SearchVectorLTE applies the LTE predicate on the "search_vector" field.
+func SearchVectorLTE(v string) predicate.Post {
+	return predicate.Post(sql.FieldLTE(FieldSearchVector, v))
+}
+
+// SearchVectorContains applies the Contains predicate on the "search_vector" field.
+func SearchVectorContains(v string) predicate.Post {
+	return predicate.Post(sql.FieldContains(FieldSearchVector, v))
+}
+
+// SearchVectorHasPrefix applies the HasPrefix predicate on the "search_vector" field.
+func SearchVectorHasPrefix(v string) predicate.Post {
+	return predicate.Post(sql.FieldHasPrefix(FieldSearchVector, v))
+}
+
+// SearchVectorHasSuffix applies the HasSuffix predicate on the "search_vector" field.
+func SearchVectorHasSuffix(v string) predicate.Post {
+	return predicate.Post(sql.FieldHasSuffix(FieldSearchVector, v))
+}
+
+// SearchVectorIsNil applies the IsNil predicate on the "search_vector" field.
+func SearchVectorIsNil() predicate.Post {
+	return predicate.Post(sql.FieldIsNull(FieldSearchVector))
+}
+
+// SearchVectorNotNil applies the NotNil predicate on the "search_vector" field.
+func SearchVectorNotNil() predicate.Post {
+	return predicate.Post(sql.FieldNotNull(FieldSearchVector))
+}
+
+// SearchVectorEqualFold applies the EqualFold predicate on the "search_vector" field.
+func SearchVectorEqualFold(v string) predicate.Post {
+	return predicate.Post(sql.FieldEqualFold(FieldSearchVector, v))
+}
+
+// SearchVectorContainsFold applies the ContainsFold predicate on the "search_vector" field.
+func SearchVectorContainsFold(v string) predicate.Post {
+	return predicate.Post(sql.FieldContainsFold(FieldSearchVector, v))
+}
+
 // HasAuthor applies the HasEdge predicate on the "author" edge.
 func HasAuthor() predicate.Post {
 	return predicate.Post(func(s *sql.Selector) {
@@ -770,6 +930,75 @@ func HasTagsWith(preds ...predicate.PostTag) predicate.Post {
 	})
 }
 
+// HasComments applies the HasEdge predicate on the "comments" edge.
+func HasComments() predicate.Post {
+	return predicate.Post(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, CommentsTable, CommentsColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasCommentsWith applies the HasEdge predicate on the "comments" edge with a given conditions (other predicates).
+func HasCommentsWith(preds ...predicate.Comment) predicate.Post {
+	return predicate.Post(func(s *sql.Selector) {
+		step := newCommentsStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// HasSlugHistory applies the HasEdge predicate on the "slug_history" edge.
+func HasSlugHistory() predicate.Post {
+	return predicate.Post(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, SlugHistoryTable, SlugHistoryColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasSlugHistoryWith applies the HasEdge predicate on the "slug_history" edge with a given conditions (other predicates).
+func HasSlugHistoryWith(preds ...predicate.PostSlug) predicate.Post {
+	return predicate.Post(func(s *sql.Selector) {
+		step := newSlugHistoryStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// HasRevisions applies the HasEdge predicate on the "revisions" edge.
+func HasRevisions() predicate.Post {
+	return predicate.Post(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, RevisionsTable, RevisionsColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasRevisionsWith applies the HasEdge predicate on the "revisions" edge with a given conditions (other predicates).
+func HasRevisionsWith(preds ...predicate.PostRevision) predicate.Post {
+	return predicate.Post(func(s *sql.Selector) {
+		step := newRevisionsStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
 // HasPostCategoryRelations applies the HasEdge predicate on the "post_category_relations" edge.
 func HasPostCategoryRelations() predicate.Post {
 	return predicate.Post(func(s *sql.Selector) {
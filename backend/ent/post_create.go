@@ -3,8 +3,11 @@
 package ent
 
 import (
+	"blog-server/ent/comment"
 	"blog-server/ent/post"
 	"blog-server/ent/postcategory"
+	"blog-server/ent/postrevision"
+	"blog-server/ent/postslug"
 	"blog-server/ent/posttag"
 	"blog-server/ent/user"
 	"blog-server/entity"
@@ -80,6 +83,20 @@ func (_c *PostCreate) SetTitle(v string) *PostCreate {
 	return _c
 }
 
+// SetSlug sets the "slug" field.
+func (_c *PostCreate) SetSlug(v string) *PostCreate {
+	_c.mutation.SetSlug(v)
+	return _c
+}
+
+// SetNillableSlug sets the "slug" field if the given value is not nil.
+func (_c *PostCreate) SetNillableSlug(v *string) *PostCreate {
+	if v != nil {
+		_c.SetSlug(*v)
+	}
+	return _c
+}
+
 // SetSummary sets the "summary" field.
 func (_c *PostCreate) SetSummary(v string) *PostCreate {
 	_c.mutation.SetSummary(v)
@@ -162,6 +179,20 @@ func (_c *PostCreate) SetNillablePublishedAt(v *time.Time) *PostCreate {
 	return _c
 }
 
+// SetSearchVector sets the "search_vector" field.
+func (_c *PostCreate) SetSearchVector(v string) *PostCreate {
+	_c.mutation.SetSearchVector(v)
+	return _c
+}
+
+// SetNillableSearchVector sets the "search_vector" field if the given value is not nil.
+func (_c *PostCreate) SetNillableSearchVector(v *string) *PostCreate {
+	if v != nil {
+		_c.SetSearchVector(*v)
+	}
+	return _c
+}
+
 // SetID sets the "id" field.
 func (_c *PostCreate) SetID(v uint) *PostCreate {
 	_c.mutation.SetID(v)
@@ -209,6 +240,51 @@ func (_c *PostCreate) AddTags(v ...*PostTag) *PostCreate {
 	return _c.AddTagIDs(ids...)
 }
 
+// AddCommentIDs adds the "comments" edge to the Comment entity by IDs.
+func (_c *PostCreate) AddCommentIDs(ids ...uint) *PostCreate {
+	_c.mutation.AddCommentIDs(ids...)
+	return _c
+}
+
+// AddComments adds the "comments" edges to the Comment entity.
+func (_c *PostCreate) AddComments(v ...*Comment) *PostCreate {
+	ids := make([]uint, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _c.AddCommentIDs(ids...)
+}
+
+// AddSlugHistoryIDs adds the "slug_history" edge to the PostSlug entity by IDs.
+func (_c *PostCreate) AddSlugHistoryIDs(ids ...uint) *PostCreate {
+	_c.mutation.AddSlugHistoryIDs(ids...)
+	return _c
+}
+
+// AddSlugHistory adds the "slug_history" edges to the PostSlug entity.
+func (_c *PostCreate) AddSlugHistory(v ...*PostSlug) *PostCreate {
+	ids := make([]uint, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _c.AddSlugHistoryIDs(ids...)
+}
+
+// AddRevisionIDs adds the "revisions" edge to the PostRevision entity by IDs.
+func (_c *PostCreate) AddRevisionIDs(ids ...uint) *PostCreate {
+	_c.mutation.AddRevisionIDs(ids...)
+	return _c
+}
+
+// AddRevisions adds the "revisions" edges to the PostRevision entity.
+func (_c *PostCreate) AddRevisions(v ...*PostRevision) *PostCreate {
+	ids := make([]uint, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _c.AddRevisionIDs(ids...)
+}
+
 // Mutation returns the PostMutation object of the builder.
 func (_c *PostCreate) Mutation() *PostMutation {
 	return _c.mutation
@@ -281,6 +357,11 @@ func (_c *PostCreate) check() error {
 			return &ValidationError{Name: "title", err: fmt.Errorf(`ent: validator failed for field "Post.title": %w`, err)}
 		}
 	}
+	if v, ok := _c.mutation.Slug(); ok {
+		if err := post.SlugValidator(v); err != nil {
+			return &ValidationError{Name: "slug", err: fmt.Errorf(`ent: validator failed for field "Post.slug": %w`, err)}
+		}
+	}
 	if v, ok := _c.mutation.Summary(); ok {
 		if err := post.SummaryValidator(v); err != nil {
 			return &ValidationError{Name: "summary", err: fmt.Errorf(`ent: validator failed for field "Post.summary": %w`, err)}
@@ -360,6 +441,10 @@ func (_c *PostCreate) createSpec() (*Post, *sqlgraph.CreateSpec) {
 		_spec.SetField(post.FieldTitle, field.TypeString, value)
 		_node.Title = value
 	}
+	if value, ok := _c.mutation.Slug(); ok {
+		_spec.SetField(post.FieldSlug, field.TypeString, value)
+		_node.Slug = value
+	}
 	if value, ok := _c.mutation.Summary(); ok {
 		_spec.SetField(post.FieldSummary, field.TypeString, value)
 		_node.Summary = &value
@@ -388,6 +473,10 @@ func (_c *PostCreate) createSpec() (*Post, *sqlgraph.CreateSpec) {
 		_spec.SetField(post.FieldPublishedAt, field.TypeTime, value)
 		_node.PublishedAt = &value
 	}
+	if value, ok := _c.mutation.SearchVector(); ok {
+		_spec.SetField(post.FieldSearchVector, field.TypeString, value)
+		_node.SearchVector = &value
+	}
 	if nodes := _c.mutation.AuthorIDs(); len(nodes) > 0 {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.M2O,
@@ -437,6 +526,54 @@ func (_c *PostCreate) createSpec() (*Post, *sqlgraph.CreateSpec) {
 		}
 		_spec.Edges = append(_spec.Edges, edge)
 	}
+	if nodes := _c.mutation.CommentsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   post.CommentsTable,
+			Columns: []string{post.CommentsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(comment.FieldID, field.TypeUint),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := _c.mutation.SlugHistoryIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   post.SlugHistoryTable,
+			Columns: []string{post.SlugHistoryColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(postslug.FieldID, field.TypeUint),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := _c.mutation.RevisionsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   post.RevisionsTable,
+			Columns: []string{post.RevisionsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(postrevision.FieldID, field.TypeUint),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
 	return _node, _spec
 }
 
@@ -555,6 +692,24 @@ func (u *PostUpsert) UpdateTitle() *PostUpsert {
 	return u
 }
 
+// SetSlug sets the "slug" field.
+func (u *PostUpsert) SetSlug(v string) *PostUpsert {
+	u.Set(post.FieldSlug, v)
+	return u
+}
+
+// UpdateSlug sets the "slug" field to the value that was provided on create.
+func (u *PostUpsert) UpdateSlug() *PostUpsert {
+	u.SetExcluded(post.FieldSlug)
+	return u
+}
+
+// ClearSlug clears the value of the "slug" field.
+func (u *PostUpsert) ClearSlug() *PostUpsert {
+	u.SetNull(post.FieldSlug)
+	return u
+}
+
 // SetSummary sets the "summary" field.
 func (u *PostUpsert) SetSummary(v string) *PostUpsert {
 	u.Set(post.FieldSummary, v)
@@ -669,6 +824,24 @@ func (u *PostUpsert) ClearPublishedAt() *PostUpsert {
 	return u
 }
 
+// SetSearchVector sets the "search_vector" field.
+func (u *PostUpsert) SetSearchVector(v string) *PostUpsert {
+	u.Set(post.FieldSearchVector, v)
+	return u
+}
+
+// UpdateSearchVector sets the "search_vector" field to the value that was provided on create.
+func (u *PostUpsert) UpdateSearchVector() *PostUpsert {
+	u.SetExcluded(post.FieldSearchVector)
+	return u
+}
+
+// ClearSearchVector clears the value of the "search_vector" field.
+func (u *PostUpsert) ClearSearchVector() *PostUpsert {
+	u.SetNull(post.FieldSearchVector)
+	return u
+}
+
 // UpdateNewValues updates the mutable fields using the new values that were set on create except the ID field.
 // Using this option is equivalent to using:
 //
@@ -794,6 +967,27 @@ func (u *PostUpsertOne) UpdateTitle() *PostUpsertOne {
 	})
 }
 
+// SetSlug sets the "slug" field.
+func (u *PostUpsertOne) SetSlug(v string) *PostUpsertOne {
+	return u.Update(func(s *PostUpsert) {
+		s.SetSlug(v)
+	})
+}
+
+// UpdateSlug sets the "slug" field to the value that was provided on create.
+func (u *PostUpsertOne) UpdateSlug() *PostUpsertOne {
+	return u.Update(func(s *PostUpsert) {
+		s.UpdateSlug()
+	})
+}
+
+// ClearSlug clears the value of the "slug" field.
+func (u *PostUpsertOne) ClearSlug() *PostUpsertOne {
+	return u.Update(func(s *PostUpsert) {
+		s.ClearSlug()
+	})
+}
+
 // SetSummary sets the "summary" field.
 func (u *PostUpsertOne) SetSummary(v string) *PostUpsertOne {
 	return u.Update(func(s *PostUpsert) {
@@ -927,6 +1121,27 @@ func (u *PostUpsertOne) ClearPublishedAt() *PostUpsertOne {
 	})
 }
 
+// SetSearchVector sets the "search_vector" field.
+func (u *PostUpsertOne) SetSearchVector(v string) *PostUpsertOne {
+	return u.Update(func(s *PostUpsert) {
+		s.SetSearchVector(v)
+	})
+}
+
+// UpdateSearchVector sets the "search_vector" field to the value that was provided on create.
+func (u *PostUpsertOne) UpdateSearchVector() *PostUpsertOne {
+	return u.Update(func(s *PostUpsert) {
+		s.UpdateSearchVector()
+	})
+}
+
+// ClearSearchVector clears the value of the "search_vector" field.
+func (u *PostUpsertOne) ClearSearchVector() *PostUpsertOne {
+	return u.Update(func(s *PostUpsert) {
+		s.ClearSearchVector()
+	})
+}
+
 // Exec executes the query.
 func (u *PostUpsertOne) Exec(ctx context.Context) error {
 	if len(u.create.conflict) == 0 {
@@ -1218,6 +1433,27 @@ func (u *PostUpsertBulk) UpdateTitle() *PostUpsertBulk {
 	})
 }
 
+// SetSlug sets the "slug" field.
+func (u *PostUpsertBulk) SetSlug(v string) *PostUpsertBulk {
+	return u.Update(func(s *PostUpsert) {
+		s.SetSlug(v)
+	})
+}
+
+// UpdateSlug sets the "slug" field to the value that was provided on create.
+func (u *PostUpsertBulk) UpdateSlug() *PostUpsertBulk {
+	return u.Update(func(s *PostUpsert) {
+		s.UpdateSlug()
+	})
+}
+
+// ClearSlug clears the value of the "slug" field.
+func (u *PostUpsertBulk) ClearSlug() *PostUpsertBulk {
+	return u.Update(func(s *PostUpsert) {
+		s.ClearSlug()
+	})
+}
+
 // SetSummary sets the "summary" field.
 func (u *PostUpsertBulk) SetSummary(v string) *PostUpsertBulk {
 	return u.Update(func(s *PostUpsert) {
@@ -1351,6 +1587,27 @@ func (u *PostUpsertBulk) ClearPublishedAt() *PostUpsertBulk {
 	})
 }
 
+// SetSearchVector sets the "search_vector" field.
+func (u *PostUpsertBulk) SetSearchVector(v string) *PostUpsertBulk {
+	return u.Update(func(s *PostUpsert) {
+		s.SetSearchVector(v)
+	})
+}
+
+// UpdateSearchVector sets the "search_vector" field to the value that was provided on create.
+func (u *PostUpsertBulk) UpdateSearchVector() *PostUpsertBulk {
+	return u.Update(func(s *PostUpsert) {
+		s.UpdateSearchVector()
+	})
+}
+
+// ClearSearchVector clears the value of the "search_vector" field.
+func (u *PostUpsertBulk) ClearSearchVector() *PostUpsertBulk {
+	return u.Update(func(s *PostUpsert) {
+		s.ClearSearchVector()
+	})
+}
+
 // Exec executes the query.
 func (u *PostUpsertBulk) Exec(ctx context.Context) error {
 	if u.create.err != nil {
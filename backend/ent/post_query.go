@@ -3,9 +3,12 @@
 package ent
 
 import (
+	"blog-server/ent/comment"
 	"blog-server/ent/post"
 	"blog-server/ent/postcategory"
 	"blog-server/ent/postcategoryrelation"
+	"blog-server/ent/postrevision"
+	"blog-server/ent/postslug"
 	"blog-server/ent/posttag"
 	"blog-server/ent/posttagrelation"
 	"blog-server/ent/predicate"
@@ -31,6 +34,9 @@ type PostQuery struct {
 	withAuthor                *UserQuery
 	withCategories            *PostCategoryQuery
 	withTags                  *PostTagQuery
+	withComments              *CommentQuery
+	withSlugHistory           *PostSlugQuery
+	withRevisions             *PostRevisionQuery
 	withPostCategoryRelations *PostCategoryRelationQuery
 	withPostTagRelations      *PostTagRelationQuery
 	modifiers                 []func(*sql.Selector)
@@ -136,6 +142,72 @@ func (_q *PostQuery) QueryTags() *PostTagQuery {
 	return query
 }
 
+// QueryComments chains the current query on the "comments" edge.
+func (_q *PostQuery) QueryComments() *CommentQuery {
+	query := (&CommentClient{config: _q.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := _q.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := _q.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(post.Table, post.FieldID, selector),
+			sqlgraph.To(comment.Table, comment.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, post.CommentsTable, post.CommentsColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(_q.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// QuerySlugHistory chains the current query on the "slug_history" edge.
+func (_q *PostQuery) QuerySlugHistory() *PostSlugQuery {
+	query := (&PostSlugClient{config: _q.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := _q.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := _q.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(post.Table, post.FieldID, selector),
+			sqlgraph.To(postslug.Table, postslug.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, post.SlugHistoryTable, post.SlugHistoryColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(_q.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// QueryRevisions chains the current query on the "revisions" edge.
+func (_q *PostQuery) QueryRevisions() *PostRevisionQuery {
+	query := (&PostRevisionClient{config: _q.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := _q.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := _q.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(post.Table, post.FieldID, selector),
+			sqlgraph.To(postrevision.Table, postrevision.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, post.RevisionsTable, post.RevisionsColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(_q.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
 // QueryPostCategoryRelations chains the current query on the "post_category_relations" edge.
 func (_q *PostQuery) QueryPostCategoryRelations() *PostCategoryRelationQuery {
 	query := (&PostCategoryRelationClient{config: _q.config}).Query()
@@ -375,6 +447,9 @@ func (_q *PostQuery) Clone() *PostQuery {
 		withAuthor:                _q.withAuthor.Clone(),
 		withCategories:            _q.withCategories.Clone(),
 		withTags:                  _q.withTags.Clone(),
+		withComments:              _q.withComments.Clone(),
+		withSlugHistory:           _q.withSlugHistory.Clone(),
+		withRevisions:             _q.withRevisions.Clone(),
 		withPostCategoryRelations: _q.withPostCategoryRelations.Clone(),
 		withPostTagRelations:      _q.withPostTagRelations.Clone(),
 		// clone intermediate query.
@@ -417,6 +492,39 @@ func (_q *PostQuery) WithTags(opts ...func(*PostTagQuery)) *PostQuery {
 	return _q
 }
 
+// WithComments tells the query-builder to eager-load the nodes that are connected to
+// the "comments" edge. The optional arguments are used to configure the query builder of the edge.
+func (_q *PostQuery) WithComments(opts ...func(*CommentQuery)) *PostQuery {
+	query := (&CommentClient{config: _q.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	_q.withComments = query
+	return _q
+}
+
+// WithSlugHistory tells the query-builder to eager-load the nodes that are connected to
+// the "slug_history" edge. The optional arguments are used to configure the query builder of the edge.
+func (_q *PostQuery) WithSlugHistory(opts ...func(*PostSlugQuery)) *PostQuery {
+	query := (&PostSlugClient{config: _q.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	_q.withSlugHistory = query
+	return _q
+}
+
+// WithRevisions tells the query-builder to eager-load the nodes that are connected to
+// the "revisions" edge. The optional arguments are used to configure the query builder of the edge.
+func (_q *PostQuery) WithRevisions(opts ...func(*PostRevisionQuery)) *PostQuery {
+	query := (&PostRevisionClient{config: _q.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	_q.withRevisions = query
+	return _q
+}
+
 // WithPostCategoryRelations tells the query-builder to eager-load the nodes that are connected to
 // the "post_category_relations" edge. The optional arguments are used to configure the query builder of the edge.
 func (_q *PostQuery) WithPostCategoryRelations(opts ...func(*PostCategoryRelationQuery)) *PostQuery {
@@ -517,10 +625,13 @@ func (_q *PostQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*Post, e
 	var (
 		nodes       = []*Post{}
 		_spec       = _q.querySpec()
-		loadedTypes = [5]bool{
+		loadedTypes = [8]bool{
 			_q.withAuthor != nil,
 			_q.withCategories != nil,
 			_q.withTags != nil,
+			_q.withComments != nil,
+			_q.withSlugHistory != nil,
+			_q.withRevisions != nil,
 			_q.withPostCategoryRelations != nil,
 			_q.withPostTagRelations != nil,
 		}
@@ -566,6 +677,27 @@ func (_q *PostQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*Post, e
 			return nil, err
 		}
 	}
+	if query := _q.withComments; query != nil {
+		if err := _q.loadComments(ctx, query, nodes,
+			func(n *Post) { n.Edges.Comments = []*Comment{} },
+			func(n *Post, e *Comment) { n.Edges.Comments = append(n.Edges.Comments, e) }); err != nil {
+			return nil, err
+		}
+	}
+	if query := _q.withSlugHistory; query != nil {
+		if err := _q.loadSlugHistory(ctx, query, nodes,
+			func(n *Post) { n.Edges.SlugHistory = []*PostSlug{} },
+			func(n *Post, e *PostSlug) { n.Edges.SlugHistory = append(n.Edges.SlugHistory, e) }); err != nil {
+			return nil, err
+		}
+	}
+	if query := _q.withRevisions; query != nil {
+		if err := _q.loadRevisions(ctx, query, nodes,
+			func(n *Post) { n.Edges.Revisions = []*PostRevision{} },
+			func(n *Post, e *PostRevision) { n.Edges.Revisions = append(n.Edges.Revisions, e) }); err != nil {
+			return nil, err
+		}
+	}
 	if query := _q.withPostCategoryRelations; query != nil {
 		if err := _q.loadPostCategoryRelations(ctx, query, nodes,
 			func(n *Post) { n.Edges.PostCategoryRelations = []*PostCategoryRelation{} },
@@ -736,6 +868,96 @@ func (_q *PostQuery) loadTags(ctx context.Context, query *PostTagQuery, nodes []
 	}
 	return nil
 }
+func (_q *PostQuery) loadComments(ctx context.Context, query *CommentQuery, nodes []*Post, init func(*Post), assign func(*Post, *Comment)) error {
+	fks := make([]driver.Value, 0, len(nodes))
+	nodeids := make(map[uint]*Post)
+	for i := range nodes {
+		fks = append(fks, nodes[i].ID)
+		nodeids[nodes[i].ID] = nodes[i]
+		if init != nil {
+			init(nodes[i])
+		}
+	}
+	if len(query.ctx.Fields) > 0 {
+		query.ctx.AppendFieldOnce(comment.FieldPostID)
+	}
+	query.Where(predicate.Comment(func(s *sql.Selector) {
+		s.Where(sql.InValues(s.C(post.CommentsColumn), fks...))
+	}))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		fk := n.PostID
+		node, ok := nodeids[fk]
+		if !ok {
+			return fmt.Errorf(`unexpected referenced foreign-key "post_id" returned %v for node %v`, fk, n.ID)
+		}
+		assign(node, n)
+	}
+	return nil
+}
+func (_q *PostQuery) loadSlugHistory(ctx context.Context, query *PostSlugQuery, nodes []*Post, init func(*Post), assign func(*Post, *PostSlug)) error {
+	fks := make([]driver.Value, 0, len(nodes))
+	nodeids := make(map[uint]*Post)
+	for i := range nodes {
+		fks = append(fks, nodes[i].ID)
+		nodeids[nodes[i].ID] = nodes[i]
+		if init != nil {
+			init(nodes[i])
+		}
+	}
+	if len(query.ctx.Fields) > 0 {
+		query.ctx.AppendFieldOnce(postslug.FieldPostID)
+	}
+	query.Where(predicate.PostSlug(func(s *sql.Selector) {
+		s.Where(sql.InValues(s.C(post.SlugHistoryColumn), fks...))
+	}))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		fk := n.PostID
+		node, ok := nodeids[fk]
+		if !ok {
+			return fmt.Errorf(`unexpected referenced foreign-key "post_id" returned %v for node %v`, fk, n.ID)
+		}
+		assign(node, n)
+	}
+	return nil
+}
+func (_q *PostQuery) loadRevisions(ctx context.Context, query *PostRevisionQuery, nodes []*Post, init func(*Post), assign func(*Post, *PostRevision)) error {
+	fks := make([]driver.Value, 0, len(nodes))
+	nodeids := make(map[uint]*Post)
+	for i := range nodes {
+		fks = append(fks, nodes[i].ID)
+		nodeids[nodes[i].ID] = nodes[i]
+		if init != nil {
+			init(nodes[i])
+		}
+	}
+	if len(query.ctx.Fields) > 0 {
+		query.ctx.AppendFieldOnce(postrevision.FieldPostID)
+	}
+	query.Where(predicate.PostRevision(func(s *sql.Selector) {
+		s.Where(sql.InValues(s.C(post.RevisionsColumn), fks...))
+	}))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		fk := n.PostID
+		node, ok := nodeids[fk]
+		if !ok {
+			return fmt.Errorf(`unexpected referenced foreign-key "post_id" returned %v for node %v`, fk, n.ID)
+		}
+		assign(node, n)
+	}
+	return nil
+}
 func (_q *PostQuery) loadPostCategoryRelations(ctx context.Context, query *PostCategoryRelationQuery, nodes []*Post, init func(*Post), assign func(*Post, *PostCategoryRelation)) error {
 	fks := make([]driver.Value, 0, len(nodes))
 	nodeids := make(map[uint]*Post)
@@ -3,8 +3,11 @@
 package ent
 
 import (
+	"blog-server/ent/comment"
 	"blog-server/ent/post"
 	"blog-server/ent/postcategory"
+	"blog-server/ent/postrevision"
+	"blog-server/ent/postslug"
 	"blog-server/ent/posttag"
 	"blog-server/ent/predicate"
 	"blog-server/ent/user"
@@ -109,6 +112,26 @@ func (_u *PostUpdate) SetNillableTitle(v *string) *PostUpdate {
 	return _u
 }
 
+// SetSlug sets the "slug" field.
+func (_u *PostUpdate) SetSlug(v string) *PostUpdate {
+	_u.mutation.SetSlug(v)
+	return _u
+}
+
+// SetNillableSlug sets the "slug" field if the given value is not nil.
+func (_u *PostUpdate) SetNillableSlug(v *string) *PostUpdate {
+	if v != nil {
+		_u.SetSlug(*v)
+	}
+	return _u
+}
+
+// ClearSlug clears the value of the "slug" field.
+func (_u *PostUpdate) ClearSlug() *PostUpdate {
+	_u.mutation.ClearSlug()
+	return _u
+}
+
 // SetSummary sets the "summary" field.
 func (_u *PostUpdate) SetSummary(v string) *PostUpdate {
 	_u.mutation.SetSummary(v)
@@ -239,6 +262,26 @@ func (_u *PostUpdate) ClearPublishedAt() *PostUpdate {
 	return _u
 }
 
+// SetSearchVector sets the "search_vector" field.
+func (_u *PostUpdate) SetSearchVector(v string) *PostUpdate {
+	_u.mutation.SetSearchVector(v)
+	return _u
+}
+
+// SetNillableSearchVector sets the "search_vector" field if the given value is not nil.
+func (_u *PostUpdate) SetNillableSearchVector(v *string) *PostUpdate {
+	if v != nil {
+		_u.SetSearchVector(*v)
+	}
+	return _u
+}
+
+// ClearSearchVector clears the value of the "search_vector" field.
+func (_u *PostUpdate) ClearSearchVector() *PostUpdate {
+	_u.mutation.ClearSearchVector()
+	return _u
+}
+
 // SetAuthorID sets the "author" edge to the User entity by ID.
 func (_u *PostUpdate) SetAuthorID(id uint) *PostUpdate {
 	_u.mutation.SetAuthorID(id)
@@ -280,6 +323,51 @@ func (_u *PostUpdate) AddTags(v ...*PostTag) *PostUpdate {
 	return _u.AddTagIDs(ids...)
 }
 
+// AddCommentIDs adds the "comments" edge to the Comment entity by IDs.
+func (_u *PostUpdate) AddCommentIDs(ids ...uint) *PostUpdate {
+	_u.mutation.AddCommentIDs(ids...)
+	return _u
+}
+
+// AddComments adds the "comments" edges to the Comment entity.
+func (_u *PostUpdate) AddComments(v ...*Comment) *PostUpdate {
+	ids := make([]uint, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddCommentIDs(ids...)
+}
+
+// AddSlugHistoryIDs adds the "slug_history" edge to the PostSlug entity by IDs.
+func (_u *PostUpdate) AddSlugHistoryIDs(ids ...uint) *PostUpdate {
+	_u.mutation.AddSlugHistoryIDs(ids...)
+	return _u
+}
+
+// AddSlugHistory adds the "slug_history" edges to the PostSlug entity.
+func (_u *PostUpdate) AddSlugHistory(v ...*PostSlug) *PostUpdate {
+	ids := make([]uint, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddSlugHistoryIDs(ids...)
+}
+
+// AddRevisionIDs adds the "revisions" edge to the PostRevision entity by IDs.
+func (_u *PostUpdate) AddRevisionIDs(ids ...uint) *PostUpdate {
+	_u.mutation.AddRevisionIDs(ids...)
+	return _u
+}
+
+// AddRevisions adds the "revisions" edges to the PostRevision entity.
+func (_u *PostUpdate) AddRevisions(v ...*PostRevision) *PostUpdate {
+	ids := make([]uint, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddRevisionIDs(ids...)
+}
+
 // Mutation returns the PostMutation object of the builder.
 func (_u *PostUpdate) Mutation() *PostMutation {
 	return _u.mutation
@@ -333,6 +421,69 @@ func (_u *PostUpdate) RemoveTags(v ...*PostTag) *PostUpdate {
 	return _u.RemoveTagIDs(ids...)
 }
 
+// ClearComments clears all "comments" edges to the Comment entity.
+func (_u *PostUpdate) ClearComments() *PostUpdate {
+	_u.mutation.ClearComments()
+	return _u
+}
+
+// RemoveCommentIDs removes the "comments" edge to Comment entities by IDs.
+func (_u *PostUpdate) RemoveCommentIDs(ids ...uint) *PostUpdate {
+	_u.mutation.RemoveCommentIDs(ids...)
+	return _u
+}
+
+// RemoveComments removes "comments" edges to Comment entities.
+func (_u *PostUpdate) RemoveComments(v ...*Comment) *PostUpdate {
+	ids := make([]uint, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveCommentIDs(ids...)
+}
+
+// ClearSlugHistory clears all "slug_history" edges to the PostSlug entity.
+func (_u *PostUpdate) ClearSlugHistory() *PostUpdate {
+	_u.mutation.ClearSlugHistory()
+	return _u
+}
+
+// RemoveSlugHistoryIDs removes the "slug_history" edge to PostSlug entities by IDs.
+func (_u *PostUpdate) RemoveSlugHistoryIDs(ids ...uint) *PostUpdate {
+	_u.mutation.RemoveSlugHistoryIDs(ids...)
+	return _u
+}
+
+// RemoveSlugHistory removes "slug_history" edges to PostSlug entities.
+func (_u *PostUpdate) RemoveSlugHistory(v ...*PostSlug) *PostUpdate {
+	ids := make([]uint, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveSlugHistoryIDs(ids...)
+}
+
+// ClearRevisions clears all "revisions" edges to the PostRevision entity.
+func (_u *PostUpdate) ClearRevisions() *PostUpdate {
+	_u.mutation.ClearRevisions()
+	return _u
+}
+
+// RemoveRevisionIDs removes the "revisions" edge to PostRevision entities by IDs.
+func (_u *PostUpdate) RemoveRevisionIDs(ids ...uint) *PostUpdate {
+	_u.mutation.RemoveRevisionIDs(ids...)
+	return _u
+}
+
+// RemoveRevisions removes "revisions" edges to PostRevision entities.
+func (_u *PostUpdate) RemoveRevisions(v ...*PostRevision) *PostUpdate {
+	ids := make([]uint, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveRevisionIDs(ids...)
+}
+
 // Save executes the query and returns the number of nodes affected by the update operation.
 func (_u *PostUpdate) Save(ctx context.Context) (int, error) {
 	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
@@ -367,6 +518,11 @@ func (_u *PostUpdate) check() error {
 			return &ValidationError{Name: "title", err: fmt.Errorf(`ent: validator failed for field "Post.title": %w`, err)}
 		}
 	}
+	if v, ok := _u.mutation.Slug(); ok {
+		if err := post.SlugValidator(v); err != nil {
+			return &ValidationError{Name: "slug", err: fmt.Errorf(`ent: validator failed for field "Post.slug": %w`, err)}
+		}
+	}
 	if v, ok := _u.mutation.Summary(); ok {
 		if err := post.SummaryValidator(v); err != nil {
 			return &ValidationError{Name: "summary", err: fmt.Errorf(`ent: validator failed for field "Post.summary": %w`, err)}
@@ -421,6 +577,12 @@ func (_u *PostUpdate) sqlSave(ctx context.Context) (_node int, err error) {
 	if value, ok := _u.mutation.Title(); ok {
 		_spec.SetField(post.FieldTitle, field.TypeString, value)
 	}
+	if value, ok := _u.mutation.Slug(); ok {
+		_spec.SetField(post.FieldSlug, field.TypeString, value)
+	}
+	if _u.mutation.SlugCleared() {
+		_spec.ClearField(post.FieldSlug, field.TypeString)
+	}
 	if value, ok := _u.mutation.Summary(); ok {
 		_spec.SetField(post.FieldSummary, field.TypeString, value)
 	}
@@ -457,6 +619,12 @@ func (_u *PostUpdate) sqlSave(ctx context.Context) (_node int, err error) {
 	if _u.mutation.PublishedAtCleared() {
 		_spec.ClearField(post.FieldPublishedAt, field.TypeTime)
 	}
+	if value, ok := _u.mutation.SearchVector(); ok {
+		_spec.SetField(post.FieldSearchVector, field.TypeString, value)
+	}
+	if _u.mutation.SearchVectorCleared() {
+		_spec.ClearField(post.FieldSearchVector, field.TypeString)
+	}
 	if _u.mutation.AuthorCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.M2O,
@@ -576,6 +744,141 @@ func (_u *PostUpdate) sqlSave(ctx context.Context) (_node int, err error) {
 		}
 		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
+	if _u.mutation.CommentsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   post.CommentsTable,
+			Columns: []string{post.CommentsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(comment.FieldID, field.TypeUint),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RemovedCommentsIDs(); len(nodes) > 0 && !_u.mutation.CommentsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   post.CommentsTable,
+			Columns: []string{post.CommentsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(comment.FieldID, field.TypeUint),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.CommentsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   post.CommentsTable,
+			Columns: []string{post.CommentsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(comment.FieldID, field.TypeUint),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.SlugHistoryCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   post.SlugHistoryTable,
+			Columns: []string{post.SlugHistoryColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(postslug.FieldID, field.TypeUint),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RemovedSlugHistoryIDs(); len(nodes) > 0 && !_u.mutation.SlugHistoryCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   post.SlugHistoryTable,
+			Columns: []string{post.SlugHistoryColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(postslug.FieldID, field.TypeUint),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.SlugHistoryIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   post.SlugHistoryTable,
+			Columns: []string{post.SlugHistoryColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(postslug.FieldID, field.TypeUint),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.RevisionsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   post.RevisionsTable,
+			Columns: []string{post.RevisionsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(postrevision.FieldID, field.TypeUint),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RemovedRevisionsIDs(); len(nodes) > 0 && !_u.mutation.RevisionsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   post.RevisionsTable,
+			Columns: []string{post.RevisionsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(postrevision.FieldID, field.TypeUint),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RevisionsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   post.RevisionsTable,
+			Columns: []string{post.RevisionsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(postrevision.FieldID, field.TypeUint),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
 	_spec.AddModifiers(_u.modifiers...)
 	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
 		if _, ok := err.(*sqlgraph.NotFoundError); ok {
@@ -674,6 +977,26 @@ func (_u *PostUpdateOne) SetNillableTitle(v *string) *PostUpdateOne {
 	return _u
 }
 
+// SetSlug sets the "slug" field.
+func (_u *PostUpdateOne) SetSlug(v string) *PostUpdateOne {
+	_u.mutation.SetSlug(v)
+	return _u
+}
+
+// SetNillableSlug sets the "slug" field if the given value is not nil.
+func (_u *PostUpdateOne) SetNillableSlug(v *string) *PostUpdateOne {
+	if v != nil {
+		_u.SetSlug(*v)
+	}
+	return _u
+}
+
+// ClearSlug clears the value of the "slug" field.
+func (_u *PostUpdateOne) ClearSlug() *PostUpdateOne {
+	_u.mutation.ClearSlug()
+	return _u
+}
+
 // SetSummary sets the "summary" field.
 func (_u *PostUpdateOne) SetSummary(v string) *PostUpdateOne {
 	_u.mutation.SetSummary(v)
@@ -804,6 +1127,26 @@ func (_u *PostUpdateOne) ClearPublishedAt() *PostUpdateOne {
 	return _u
 }
 
+// SetSearchVector sets the "search_vector" field.
+func (_u *PostUpdateOne) SetSearchVector(v string) *PostUpdateOne {
+	_u.mutation.SetSearchVector(v)
+	return _u
+}
+
+// SetNillableSearchVector sets the "search_vector" field if the given value is not nil.
+func (_u *PostUpdateOne) SetNillableSearchVector(v *string) *PostUpdateOne {
+	if v != nil {
+		_u.SetSearchVector(*v)
+	}
+	return _u
+}
+
+// ClearSearchVector clears the value of the "search_vector" field.
+func (_u *PostUpdateOne) ClearSearchVector() *PostUpdateOne {
+	_u.mutation.ClearSearchVector()
+	return _u
+}
+
 // SetAuthorID sets the "author" edge to the User entity by ID.
 func (_u *PostUpdateOne) SetAuthorID(id uint) *PostUpdateOne {
 	_u.mutation.SetAuthorID(id)
@@ -845,6 +1188,51 @@ func (_u *PostUpdateOne) AddTags(v ...*PostTag) *PostUpdateOne {
 	return _u.AddTagIDs(ids...)
 }
 
+// AddCommentIDs adds the "comments" edge to the Comment entity by IDs.
+func (_u *PostUpdateOne) AddCommentIDs(ids ...uint) *PostUpdateOne {
+	_u.mutation.AddCommentIDs(ids...)
+	return _u
+}
+
+// AddComments adds the "comments" edges to the Comment entity.
+func (_u *PostUpdateOne) AddComments(v ...*Comment) *PostUpdateOne {
+	ids := make([]uint, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddCommentIDs(ids...)
+}
+
+// AddSlugHistoryIDs adds the "slug_history" edge to the PostSlug entity by IDs.
+func (_u *PostUpdateOne) AddSlugHistoryIDs(ids ...uint) *PostUpdateOne {
+	_u.mutation.AddSlugHistoryIDs(ids...)
+	return _u
+}
+
+// AddSlugHistory adds the "slug_history" edges to the PostSlug entity.
+func (_u *PostUpdateOne) AddSlugHistory(v ...*PostSlug) *PostUpdateOne {
+	ids := make([]uint, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddSlugHistoryIDs(ids...)
+}
+
+// AddRevisionIDs adds the "revisions" edge to the PostRevision entity by IDs.
+func (_u *PostUpdateOne) AddRevisionIDs(ids ...uint) *PostUpdateOne {
+	_u.mutation.AddRevisionIDs(ids...)
+	return _u
+}
+
+// AddRevisions adds the "revisions" edges to the PostRevision entity.
+func (_u *PostUpdateOne) AddRevisions(v ...*PostRevision) *PostUpdateOne {
+	ids := make([]uint, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddRevisionIDs(ids...)
+}
+
 // Mutation returns the PostMutation object of the builder.
 func (_u *PostUpdateOne) Mutation() *PostMutation {
 	return _u.mutation
@@ -898,6 +1286,69 @@ func (_u *PostUpdateOne) RemoveTags(v ...*PostTag) *PostUpdateOne {
 	return _u.RemoveTagIDs(ids...)
 }
 
+// ClearComments clears all "comments" edges to the Comment entity.
+func (_u *PostUpdateOne) ClearComments() *PostUpdateOne {
+	_u.mutation.ClearComments()
+	return _u
+}
+
+// RemoveCommentIDs removes the "comments" edge to Comment entities by IDs.
+func (_u *PostUpdateOne) RemoveCommentIDs(ids ...uint) *PostUpdateOne {
+	_u.mutation.RemoveCommentIDs(ids...)
+	return _u
+}
+
+// RemoveComments removes "comments" edges to Comment entities.
+func (_u *PostUpdateOne) RemoveComments(v ...*Comment) *PostUpdateOne {
+	ids := make([]uint, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveCommentIDs(ids...)
+}
+
+// ClearSlugHistory clears all "slug_history" edges to the PostSlug entity.
+func (_u *PostUpdateOne) ClearSlugHistory() *PostUpdateOne {
+	_u.mutation.ClearSlugHistory()
+	return _u
+}
+
+// RemoveSlugHistoryIDs removes the "slug_history" edge to PostSlug entities by IDs.
+func (_u *PostUpdateOne) RemoveSlugHistoryIDs(ids ...uint) *PostUpdateOne {
+	_u.mutation.RemoveSlugHistoryIDs(ids...)
+	return _u
+}
+
+// RemoveSlugHistory removes "slug_history" edges to PostSlug entities.
+func (_u *PostUpdateOne) RemoveSlugHistory(v ...*PostSlug) *PostUpdateOne {
+	ids := make([]uint, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveSlugHistoryIDs(ids...)
+}
+
+// ClearRevisions clears all "revisions" edges to the PostRevision entity.
+func (_u *PostUpdateOne) ClearRevisions() *PostUpdateOne {
+	_u.mutation.ClearRevisions()
+	return _u
+}
+
+// RemoveRevisionIDs removes the "revisions" edge to PostRevision entities by IDs.
+func (_u *PostUpdateOne) RemoveRevisionIDs(ids ...uint) *PostUpdateOne {
+	_u.mutation.RemoveRevisionIDs(ids...)
+	return _u
+}
+
+// RemoveRevisions removes "revisions" edges to PostRevision entities.
+func (_u *PostUpdateOne) RemoveRevisions(v ...*PostRevision) *PostUpdateOne {
+	ids := make([]uint, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveRevisionIDs(ids...)
+}
+
 // Where appends a list predicates to the PostUpdate builder.
 func (_u *PostUpdateOne) Where(ps ...predicate.Post) *PostUpdateOne {
 	_u.mutation.Where(ps...)
@@ -945,6 +1396,11 @@ func (_u *PostUpdateOne) check() error {
 			return &ValidationError{Name: "title", err: fmt.Errorf(`ent: validator failed for field "Post.title": %w`, err)}
 		}
 	}
+	if v, ok := _u.mutation.Slug(); ok {
+		if err := post.SlugValidator(v); err != nil {
+			return &ValidationError{Name: "slug", err: fmt.Errorf(`ent: validator failed for field "Post.slug": %w`, err)}
+		}
+	}
 	if v, ok := _u.mutation.Summary(); ok {
 		if err := post.SummaryValidator(v); err != nil {
 			return &ValidationError{Name: "summary", err: fmt.Errorf(`ent: validator failed for field "Post.summary": %w`, err)}
@@ -1016,6 +1472,12 @@ func (_u *PostUpdateOne) sqlSave(ctx context.Context) (_node *Post, err error) {
 	if value, ok := _u.mutation.Title(); ok {
 		_spec.SetField(post.FieldTitle, field.TypeString, value)
 	}
+	if value, ok := _u.mutation.Slug(); ok {
+		_spec.SetField(post.FieldSlug, field.TypeString, value)
+	}
+	if _u.mutation.SlugCleared() {
+		_spec.ClearField(post.FieldSlug, field.TypeString)
+	}
 	if value, ok := _u.mutation.Summary(); ok {
 		_spec.SetField(post.FieldSummary, field.TypeString, value)
 	}
@@ -1052,6 +1514,12 @@ func (_u *PostUpdateOne) sqlSave(ctx context.Context) (_node *Post, err error) {
 	if _u.mutation.PublishedAtCleared() {
 		_spec.ClearField(post.FieldPublishedAt, field.TypeTime)
 	}
+	if value, ok := _u.mutation.SearchVector(); ok {
+		_spec.SetField(post.FieldSearchVector, field.TypeString, value)
+	}
+	if _u.mutation.SearchVectorCleared() {
+		_spec.ClearField(post.FieldSearchVector, field.TypeString)
+	}
 	if _u.mutation.AuthorCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.M2O,
@@ -1171,6 +1639,141 @@ func (_u *PostUpdateOne) sqlSave(ctx context.Context) (_node *Post, err error) {
 		}
 		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
+	if _u.mutation.CommentsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   post.CommentsTable,
+			Columns: []string{post.CommentsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(comment.FieldID, field.TypeUint),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RemovedCommentsIDs(); len(nodes) > 0 && !_u.mutation.CommentsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   post.CommentsTable,
+			Columns: []string{post.CommentsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(comment.FieldID, field.TypeUint),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.CommentsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   post.CommentsTable,
+			Columns: []string{post.CommentsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(comment.FieldID, field.TypeUint),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.SlugHistoryCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   post.SlugHistoryTable,
+			Columns: []string{post.SlugHistoryColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(postslug.FieldID, field.TypeUint),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RemovedSlugHistoryIDs(); len(nodes) > 0 && !_u.mutation.SlugHistoryCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   post.SlugHistoryTable,
+			Columns: []string{post.SlugHistoryColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(postslug.FieldID, field.TypeUint),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.SlugHistoryIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   post.SlugHistoryTable,
+			Columns: []string{post.SlugHistoryColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(postslug.FieldID, field.TypeUint),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.RevisionsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   post.RevisionsTable,
+			Columns: []string{post.RevisionsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(postrevision.FieldID, field.TypeUint),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RemovedRevisionsIDs(); len(nodes) > 0 && !_u.mutation.RevisionsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   post.RevisionsTable,
+			Columns: []string{post.RevisionsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(postrevision.FieldID, field.TypeUint),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RevisionsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   post.RevisionsTable,
+			Columns: []string{post.RevisionsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(postrevision.FieldID, field.TypeUint),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
 	_spec.AddModifiers(_u.modifiers...)
 	_node = &Post{config: _u.config}
 	_spec.Assign = _node.assignValues
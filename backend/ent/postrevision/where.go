@@ -0,0 +1,642 @@
+// Code generated by ent, DO NOT EDIT.
+
+package postrevision
+
+import (
+	"blog-server/ent/predicate"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id uint) predicate.PostRevision {
+	return predicate.PostRevision(sql.FieldEQ(FieldID, id))
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id uint) predicate.PostRevision {
+	return predicate.PostRevision(sql.FieldEQ(FieldID, id))
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id uint) predicate.PostRevision {
+	return predicate.PostRevision(sql.FieldNEQ(FieldID, id))
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...uint) predicate.PostRevision {
+	return predicate.PostRevision(sql.FieldIn(FieldID, ids...))
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...uint) predicate.PostRevision {
+	return predicate.PostRevision(sql.FieldNotIn(FieldID, ids...))
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id uint) predicate.PostRevision {
+	return predicate.PostRevision(sql.FieldGT(FieldID, id))
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id uint) predicate.PostRevision {
+	return predicate.PostRevision(sql.FieldGTE(FieldID, id))
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id uint) predicate.PostRevision {
+	return predicate.PostRevision(sql.FieldLT(FieldID, id))
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id uint) predicate.PostRevision {
+	return predicate.PostRevision(sql.FieldLTE(FieldID, id))
+}
+
+// CreatedAt applies equality check predicate on the "created_at" field. It's identical to CreatedAtEQ.
+func CreatedAt(v time.Time) predicate.PostRevision {
+	return predicate.PostRevision(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// UpdatedAt applies equality check predicate on the "updated_at" field. It's identical to UpdatedAtEQ.
+func UpdatedAt(v time.Time) predicate.PostRevision {
+	return predicate.PostRevision(sql.FieldEQ(FieldUpdatedAt, v))
+}
+
+// DeletedAt applies equality check predicate on the "deleted_at" field. It's identical to DeletedAtEQ.
+func DeletedAt(v time.Time) predicate.PostRevision {
+	return predicate.PostRevision(sql.FieldEQ(FieldDeletedAt, v))
+}
+
+// PostID applies equality check predicate on the "post_id" field. It's identical to PostIDEQ.
+func PostID(v uint) predicate.PostRevision {
+	return predicate.PostRevision(sql.FieldEQ(FieldPostID, v))
+}
+
+// Version applies equality check predicate on the "version" field. It's identical to VersionEQ.
+func Version(v uint) predicate.PostRevision {
+	return predicate.PostRevision(sql.FieldEQ(FieldVersion, v))
+}
+
+// UserID applies equality check predicate on the "user_id" field. It's identical to UserIDEQ.
+func UserID(v uint) predicate.PostRevision {
+	return predicate.PostRevision(sql.FieldEQ(FieldUserID, v))
+}
+
+// Title applies equality check predicate on the "title" field. It's identical to TitleEQ.
+func Title(v string) predicate.PostRevision {
+	return predicate.PostRevision(sql.FieldEQ(FieldTitle, v))
+}
+
+// Summary applies equality check predicate on the "summary" field. It's identical to SummaryEQ.
+func Summary(v string) predicate.PostRevision {
+	return predicate.PostRevision(sql.FieldEQ(FieldSummary, v))
+}
+
+// Content applies equality check predicate on the "content" field. It's identical to ContentEQ.
+func Content(v string) predicate.PostRevision {
+	return predicate.PostRevision(sql.FieldEQ(FieldContent, v))
+}
+
+// RestoredFrom applies equality check predicate on the "restored_from" field. It's identical to RestoredFromEQ.
+func RestoredFrom(v uint) predicate.PostRevision {
+	return predicate.PostRevision(sql.FieldEQ(FieldRestoredFrom, v))
+}
+
+// CreatedAtEQ applies the EQ predicate on the "created_at" field.
+func CreatedAtEQ(v time.Time) predicate.PostRevision {
+	return predicate.PostRevision(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtNEQ applies the NEQ predicate on the "created_at" field.
+func CreatedAtNEQ(v time.Time) predicate.PostRevision {
+	return predicate.PostRevision(sql.FieldNEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtIn applies the In predicate on the "created_at" field.
+func CreatedAtIn(vs ...time.Time) predicate.PostRevision {
+	return predicate.PostRevision(sql.FieldIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtNotIn applies the NotIn predicate on the "created_at" field.
+func CreatedAtNotIn(vs ...time.Time) predicate.PostRevision {
+	return predicate.PostRevision(sql.FieldNotIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtGT applies the GT predicate on the "created_at" field.
+func CreatedAtGT(v time.Time) predicate.PostRevision {
+	return predicate.PostRevision(sql.FieldGT(FieldCreatedAt, v))
+}
+
+// CreatedAtGTE applies the GTE predicate on the "created_at" field.
+func CreatedAtGTE(v time.Time) predicate.PostRevision {
+	return predicate.PostRevision(sql.FieldGTE(FieldCreatedAt, v))
+}
+
+// CreatedAtLT applies the LT predicate on the "created_at" field.
+func CreatedAtLT(v time.Time) predicate.PostRevision {
+	return predicate.PostRevision(sql.FieldLT(FieldCreatedAt, v))
+}
+
+// CreatedAtLTE applies the LTE predicate on the "created_at" field.
+func CreatedAtLTE(v time.Time) predicate.PostRevision {
+	return predicate.PostRevision(sql.FieldLTE(FieldCreatedAt, v))
+}
+
+// UpdatedAtEQ applies the EQ predicate on the "updated_at" field.
+func UpdatedAtEQ(v time.Time) predicate.PostRevision {
+	return predicate.PostRevision(sql.FieldEQ(FieldUpdatedAt, v))
+}
+
+// UpdatedAtNEQ applies the NEQ predicate on the "updated_at" field.
+func UpdatedAtNEQ(v time.Time) predicate.PostRevision {
+	return predicate.PostRevision(sql.FieldNEQ(FieldUpdatedAt, v))
+}
+
+// UpdatedAtIn applies the In predicate on the "updated_at" field.
+func UpdatedAtIn(vs ...time.Time) predicate.PostRevision {
+	return predicate.PostRevision(sql.FieldIn(FieldUpdatedAt, vs...))
+}
+
+// UpdatedAtNotIn applies the NotIn predicate on the "updated_at" field.
+func UpdatedAtNotIn(vs ...time.Time) predicate.PostRevision {
+	return predicate.PostRevision(sql.FieldNotIn(FieldUpdatedAt, vs...))
+}
+
+// UpdatedAtGT applies the GT predicate on the "updated_at" field.
+func UpdatedAtGT(v time.Time) predicate.PostRevision {
+	return predicate.PostRevision(sql.FieldGT(FieldUpdatedAt, v))
+}
+
+// UpdatedAtGTE applies the GTE predicate on the "updated_at" field.
+func UpdatedAtGTE(v time.Time) predicate.PostRevision {
+	return predicate.PostRevision(sql.FieldGTE(FieldUpdatedAt, v))
+}
+
+// UpdatedAtLT applies the LT predicate on the "updated_at" field.
+func UpdatedAtLT(v time.Time) predicate.PostRevision {
+	return predicate.PostRevision(sql.FieldLT(FieldUpdatedAt, v))
+}
+
+// UpdatedAtLTE applies the LTE predicate on the "updated_at" field.
+func UpdatedAtLTE(v time.Time) predicate.PostRevision {
+	return predicate.PostRevision(sql.FieldLTE(FieldUpdatedAt, v))
+}
+
+// DeletedAtEQ applies the EQ predicate on the "deleted_at" field.
+func DeletedAtEQ(v time.Time) predicate.PostRevision {
+	return predicate.PostRevision(sql.FieldEQ(FieldDeletedAt, v))
+}
+
+// DeletedAtNEQ applies the NEQ predicate on the "deleted_at" field.
+func DeletedAtNEQ(v time.Time) predicate.PostRevision {
+	return predicate.PostRevision(sql.FieldNEQ(FieldDeletedAt, v))
+}
+
+// DeletedAtIn applies the In predicate on the "deleted_at" field.
+func DeletedAtIn(vs ...time.Time) predicate.PostRevision {
+	return predicate.PostRevision(sql.FieldIn(FieldDeletedAt, vs...))
+}
+
+// DeletedAtNotIn applies the NotIn predicate on the "deleted_at" field.
+func DeletedAtNotIn(vs ...time.Time) predicate.PostRevision {
+	return predicate.PostRevision(sql.FieldNotIn(FieldDeletedAt, vs...))
+}
+
+// DeletedAtGT applies the GT predicate on the "deleted_at" field.
+func DeletedAtGT(v time.Time) predicate.PostRevision {
+	return predicate.PostRevision(sql.FieldGT(FieldDeletedAt, v))
+}
+
+// DeletedAtGTE applies the GTE predicate on the "deleted_at" field.
+func DeletedAtGTE(v time.Time) predicate.PostRevision {
+	return predicate.PostRevision(sql.FieldGTE(FieldDeletedAt, v))
+}
+
+// DeletedAtLT applies the LT predicate on the "deleted_at" field.
+func DeletedAtLT(v time.Time) predicate.PostRevision {
+	return predicate.PostRevision(sql.FieldLT(FieldDeletedAt, v))
+}
+
+// DeletedAtLTE applies the LTE predicate on the "deleted_at" field.
+func DeletedAtLTE(v time.Time) predicate.PostRevision {
+	return predicate.PostRevision(sql.FieldLTE(FieldDeletedAt, v))
+}
+
+// DeletedAtIsNil applies the IsNil predicate on the "deleted_at" field.
+func DeletedAtIsNil() predicate.PostRevision {
+	return predicate.PostRevision(sql.FieldIsNull(FieldDeletedAt))
+}
+
+// DeletedAtNotNil applies the NotNil predicate on the "deleted_at" field.
+func DeletedAtNotNil() predicate.PostRevision {
+	return predicate.PostRevision(sql.FieldNotNull(FieldDeletedAt))
+}
+
+// PostIDEQ applies the EQ predicate on the "post_id" field.
+func PostIDEQ(v uint) predicate.PostRevision {
+	return predicate.PostRevision(sql.FieldEQ(FieldPostID, v))
+}
+
+// PostIDNEQ applies the NEQ predicate on the "post_id" field.
+func PostIDNEQ(v uint) predicate.PostRevision {
+	return predicate.PostRevision(sql.FieldNEQ(FieldPostID, v))
+}
+
+// PostIDIn applies the In predicate on the "post_id" field.
+func PostIDIn(vs ...uint) predicate.PostRevision {
+	return predicate.PostRevision(sql.FieldIn(FieldPostID, vs...))
+}
+
+// PostIDNotIn applies the NotIn predicate on the "post_id" field.
+func PostIDNotIn(vs ...uint) predicate.PostRevision {
+	return predicate.PostRevision(sql.FieldNotIn(FieldPostID, vs...))
+}
+
+// VersionEQ applies the EQ predicate on the "version" field.
+func VersionEQ(v uint) predicate.PostRevision {
+	return predicate.PostRevision(sql.FieldEQ(FieldVersion, v))
+}
+
+// VersionNEQ applies the NEQ predicate on the "version" field.
+func VersionNEQ(v uint) predicate.PostRevision {
+	return predicate.PostRevision(sql.FieldNEQ(FieldVersion, v))
+}
+
+// VersionIn applies the In predicate on the "version" field.
+func VersionIn(vs ...uint) predicate.PostRevision {
+	return predicate.PostRevision(sql.FieldIn(FieldVersion, vs...))
+}
+
+// VersionNotIn applies the NotIn predicate on the "version" field.
+func VersionNotIn(vs ...uint) predicate.PostRevision {
+	return predicate.PostRevision(sql.FieldNotIn(FieldVersion, vs...))
+}
+
+// VersionGT applies the GT predicate on the "version" field.
+func VersionGT(v uint) predicate.PostRevision {
+	return predicate.PostRevision(sql.FieldGT(FieldVersion, v))
+}
+
+// VersionGTE applies the GTE predicate on the "version" field.
+func VersionGTE(v uint) predicate.PostRevision {
+	return predicate.PostRevision(sql.FieldGTE(FieldVersion, v))
+}
+
+// VersionLT applies the LT predicate on the "version" field.
+func VersionLT(v uint) predicate.PostRevision {
+	return predicate.PostRevision(sql.FieldLT(FieldVersion, v))
+}
+
+// VersionLTE applies the LTE predicate on the "version" field.
+func VersionLTE(v uint) predicate.PostRevision {
+	return predicate.PostRevision(sql.FieldLTE(FieldVersion, v))
+}
+
+// UserIDEQ applies the EQ predicate on the "user_id" field.
+func UserIDEQ(v uint) predicate.PostRevision {
+	return predicate.PostRevision(sql.FieldEQ(FieldUserID, v))
+}
+
+// UserIDNEQ applies the NEQ predicate on the "user_id" field.
+func UserIDNEQ(v uint) predicate.PostRevision {
+	return predicate.PostRevision(sql.FieldNEQ(FieldUserID, v))
+}
+
+// UserIDIn applies the In predicate on the "user_id" field.
+func UserIDIn(vs ...uint) predicate.PostRevision {
+	return predicate.PostRevision(sql.FieldIn(FieldUserID, vs...))
+}
+
+// UserIDNotIn applies the NotIn predicate on the "user_id" field.
+func UserIDNotIn(vs ...uint) predicate.PostRevision {
+	return predicate.PostRevision(sql.FieldNotIn(FieldUserID, vs...))
+}
+
+// UserIDIsNil applies the IsNil predicate on the "user_id" field.
+func UserIDIsNil() predicate.PostRevision {
+	return predicate.PostRevision(sql.FieldIsNull(FieldUserID))
+}
+
+// UserIDNotNil applies the NotNil predicate on the "user_id" field.
+func UserIDNotNil() predicate.PostRevision {
+	return predicate.PostRevision(sql.FieldNotNull(FieldUserID))
+}
+
+// TitleEQ applies the EQ predicate on the "title" field.
+func TitleEQ(v string) predicate.PostRevision {
+	return predicate.PostRevision(sql.FieldEQ(FieldTitle, v))
+}
+
+// TitleNEQ applies the NEQ predicate on the "title" field.
+func TitleNEQ(v string) predicate.PostRevision {
+	return predicate.PostRevision(sql.FieldNEQ(FieldTitle, v))
+}
+
+// TitleIn applies the In predicate on the "title" field.
+func TitleIn(vs ...string) predicate.PostRevision {
+	return predicate.PostRevision(sql.FieldIn(FieldTitle, vs...))
+}
+
+// TitleNotIn applies the NotIn predicate on the "title" field.
+func TitleNotIn(vs ...string) predicate.PostRevision {
+	return predicate.PostRevision(sql.FieldNotIn(FieldTitle, vs...))
+}
+
+// TitleGT applies the GT predicate on the "title" field.
+func TitleGT(v string) predicate.PostRevision {
+	return predicate.PostRevision(sql.FieldGT(FieldTitle, v))
+}
+
+// TitleGTE applies the GTE predicate on the "title" field.
+func TitleGTE(v string) predicate.PostRevision {
+	return predicate.PostRevision(sql.FieldGTE(FieldTitle, v))
+}
+
+// TitleLT applies the LT predicate on the "title" field.
+func TitleLT(v string) predicate.PostRevision {
+	return predicate.PostRevision(sql.FieldLT(FieldTitle, v))
+}
+
+// TitleLTE applies the LTE predicate on the "title" field.
+func TitleLTE(v string) predicate.PostRevision {
+	return predicate.PostRevision(sql.FieldLTE(FieldTitle, v))
+}
+
+// TitleContains applies the Contains predicate on the "title" field.
+func TitleContains(v string) predicate.PostRevision {
+	return predicate.PostRevision(sql.FieldContains(FieldTitle, v))
+}
+
+// TitleHasPrefix applies the HasPrefix predicate on the "title" field.
+func TitleHasPrefix(v string) predicate.PostRevision {
+	return predicate.PostRevision(sql.FieldHasPrefix(FieldTitle, v))
+}
+
+// TitleHasSuffix applies the HasSuffix predicate on the "title" field.
+func TitleHasSuffix(v string) predicate.PostRevision {
+	return predicate.PostRevision(sql.FieldHasSuffix(FieldTitle, v))
+}
+
+// TitleEqualFold applies the EqualFold predicate on the "title" field.
+func TitleEqualFold(v string) predicate.PostRevision {
+	return predicate.PostRevision(sql.FieldEqualFold(FieldTitle, v))
+}
+
+// TitleContainsFold applies the ContainsFold predicate on the "title" field.
+func TitleContainsFold(v string) predicate.PostRevision {
+	return predicate.PostRevision(sql.FieldContainsFold(FieldTitle, v))
+}
+
+// SummaryEQ applies the EQ predicate on the "summary" field.
+func SummaryEQ(v string) predicate.PostRevision {
+	return predicate.PostRevision(sql.FieldEQ(FieldSummary, v))
+}
+
+// SummaryNEQ applies the NEQ predicate on the "summary" field.
+func SummaryNEQ(v string) predicate.PostRevision {
+	return predicate.PostRevision(sql.FieldNEQ(FieldSummary, v))
+}
+
+// SummaryIn applies the In predicate on the "summary" field.
+func SummaryIn(vs ...string) predicate.PostRevision {
+	return predicate.PostRevision(sql.FieldIn(FieldSummary, vs...))
+}
+
+// SummaryNotIn applies the NotIn predicate on the "summary" field.
+func SummaryNotIn(vs ...string) predicate.PostRevision {
+	return predicate.PostRevision(sql.FieldNotIn(FieldSummary, vs...))
+}
+
+// SummaryGT applies the GT predicate on the "summary" field.
+func SummaryGT(v string) predicate.PostRevision {
+	return predicate.PostRevision(sql.FieldGT(FieldSummary, v))
+}
+
+// SummaryGTE applies the GTE predicate on the "summary" field.
+func SummaryGTE(v string) predicate.PostRevision {
+	return predicate.PostRevision(sql.FieldGTE(FieldSummary, v))
+}
+
+// SummaryLT applies the LT predicate on the "summary" field.
+func SummaryLT(v string) predicate.PostRevision {
+	return predicate.PostRevision(sql.FieldLT(FieldSummary, v))
+}
+
+// SummaryLTE applies the LTE predicate on the "summary" field.
+func SummaryLTE(v string) predicate.PostRevision {
+	return predicate.PostRevision(sql.FieldLTE(FieldSummary, v))
+}
+
+// SummaryContains applies the Contains predicate on the "summary" field.
+func SummaryContains(v string) predicate.PostRevision {
+	return predicate.PostRevision(sql.FieldContains(FieldSummary, v))
+}
+
+// SummaryHasPrefix applies the HasPrefix predicate on the "summary" field.
+func SummaryHasPrefix(v string) predicate.PostRevision {
+	return predicate.PostRevision(sql.FieldHasPrefix(FieldSummary, v))
+}
+
+// SummaryHasSuffix applies the HasSuffix predicate on the "summary" field.
+func SummaryHasSuffix(v string) predicate.PostRevision {
+	return predicate.PostRevision(sql.FieldHasSuffix(FieldSummary, v))
+}
+
+// SummaryIsNil applies the IsNil predicate on the "summary" field.
+func SummaryIsNil() predicate.PostRevision {
+	return predicate.PostRevision(sql.FieldIsNull(FieldSummary))
+}
+
+// SummaryNotNil applies the NotNil predicate on the "summary" field.
+func SummaryNotNil() predicate.PostRevision {
+	return predicate.PostRevision(sql.FieldNotNull(FieldSummary))
+}
+
+// SummaryEqualFold applies the EqualFold predicate on the "summary" field.
+func SummaryEqualFold(v string) predicate.PostRevision {
+	return predicate.PostRevision(sql.FieldEqualFold(FieldSummary, v))
+}
+
+// SummaryContainsFold applies the ContainsFold predicate on the "summary" field.
+func SummaryContainsFold(v string) predicate.PostRevision {
+	return predicate.PostRevision(sql.FieldContainsFold(FieldSummary, v))
+}
+
+// ContentEQ applies the EQ predicate on the "content" field.
+func ContentEQ(v string) predicate.PostRevision {
+	return predicate.PostRevision(sql.FieldEQ(FieldContent, v))
+}
+
+// ContentNEQ applies the NEQ predicate on the "content" field.
+func ContentNEQ(v string) predicate.PostRevision {
+	return predicate.PostRevision(sql.FieldNEQ(FieldContent, v))
+}
+
+// ContentIn applies the In predicate on the "content" field.
+func ContentIn(vs ...string) predicate.PostRevision {
+	return predicate.PostRevision(sql.FieldIn(FieldContent, vs...))
+}
+
+// ContentNotIn applies the NotIn predicate on the "content" field.
+func ContentNotIn(vs ...string) predicate.PostRevision {
+	return predicate.PostRevision(sql.FieldNotIn(FieldContent, vs...))
+}
+
+// ContentGT applies the GT predicate on the "content" field.
+func ContentGT(v string) predicate.PostRevision {
+	return predicate.PostRevision(sql.FieldGT(FieldContent, v))
+}
+
+// ContentGTE applies the GTE predicate on the "content" field.
+func ContentGTE(v string) predicate.PostRevision {
+	return predicate.PostRevision(sql.FieldGTE(FieldContent, v))
+}
+
+// ContentLT applies the LT predicate on the "content" field.
+func ContentLT(v string) predicate.PostRevision {
+	return predicate.PostRevision(sql.FieldLT(FieldContent, v))
+}
+
+// ContentLTE applies the LTE predicate on the "content" field.
+func ContentLTE(v string) predicate.PostRevision {
+	return predicate.PostRevision(sql.FieldLTE(FieldContent, v))
+}
+
+// ContentContains applies the Contains predicate on the "content" field.
+func ContentContains(v string) predicate.PostRevision {
+	return predicate.PostRevision(sql.FieldContains(FieldContent, v))
+}
+
+// ContentHasPrefix applies the HasPrefix predicate on the "content" field.
+func ContentHasPrefix(v string) predicate.PostRevision {
+	return predicate.PostRevision(sql.FieldHasPrefix(FieldContent, v))
+}
+
+// ContentHasSuffix applies the HasSuffix predicate on the "content" field.
+func ContentHasSuffix(v string) predicate.PostRevision {
+	return predicate.PostRevision(sql.FieldHasSuffix(FieldContent, v))
+}
+
+// ContentEqualFold applies the EqualFold predicate on the "content" field.
+func ContentEqualFold(v string) predicate.PostRevision {
+	return predicate.PostRevision(sql.FieldEqualFold(FieldContent, v))
+}
+
+// ContentContainsFold applies the ContainsFold predicate on the "content" field.
+func ContentContainsFold(v string) predicate.PostRevision {
+	return predicate.PostRevision(sql.FieldContainsFold(FieldContent, v))
+}
+
+// RestoredFromEQ applies the EQ predicate on the "restored_from" field.
+func RestoredFromEQ(v uint) predicate.PostRevision {
+	return predicate.PostRevision(sql.FieldEQ(FieldRestoredFrom, v))
+}
+
+// RestoredFromNEQ applies the NEQ predicate on the "restored_from" field.
+func RestoredFromNEQ(v uint) predicate.PostRevision {
+	return predicate.PostRevision(sql.FieldNEQ(FieldRestoredFrom, v))
+}
+
+// RestoredFromIn applies the In predicate on the "restored_from" field.
+func RestoredFromIn(vs ...uint) predicate.PostRevision {
+	return predicate.PostRevision(sql.FieldIn(FieldRestoredFrom, vs...))
+}
+
+// RestoredFromNotIn applies the NotIn predicate on the "restored_from" field.
+func RestoredFromNotIn(vs ...uint) predicate.PostRevision {
+	return predicate.PostRevision(sql.FieldNotIn(FieldRestoredFrom, vs...))
+}
+
+// RestoredFromGT applies the GT predicate on the "restored_from" field.
+func RestoredFromGT(v uint) predicate.PostRevision {
+	return predicate.PostRevision(sql.FieldGT(FieldRestoredFrom, v))
+}
+
+// RestoredFromGTE applies the GTE predicate on the "restored_from" field.
+func RestoredFromGTE(v uint) predicate.PostRevision {
+	return predicate.PostRevision(sql.FieldGTE(FieldRestoredFrom, v))
+}
+
+// RestoredFromLT applies the LT predicate on the "restored_from" field.
+func RestoredFromLT(v uint) predicate.PostRevision {
+	return predicate.PostRevision(sql.FieldLT(FieldRestoredFrom, v))
+}
+
+// RestoredFromLTE applies the LTE predicate on the "restored_from" field.
+func RestoredFromLTE(v uint) predicate.PostRevision {
+	return predicate.PostRevision(sql.FieldLTE(FieldRestoredFrom, v))
+}
+
+// RestoredFromIsNil applies the IsNil predicate on the "restored_from" field.
+func RestoredFromIsNil() predicate.PostRevision {
+	return predicate.PostRevision(sql.FieldIsNull(FieldRestoredFrom))
+}
+
+// RestoredFromNotNil applies the NotNil predicate on the "restored_from" field.
+func RestoredFromNotNil() predicate.PostRevision {
+	return predicate.PostRevision(sql.FieldNotNull(FieldRestoredFrom))
+}
+
+// HasPost applies the HasEdge predicate on the "post" edge.
+func HasPost() predicate.PostRevision {
+	return predicate.PostRevision(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, PostTable, PostColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasPostWith applies the HasEdge predicate on the "post" edge with a given conditions (other predicates).
+func HasPostWith(preds ...predicate.Post) predicate.PostRevision {
+	return predicate.PostRevision(func(s *sql.Selector) {
+		step := newPostStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// HasUser applies the HasEdge predicate on the "user" edge.
+func HasUser() predicate.PostRevision {
+	return predicate.PostRevision(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, UserTable, UserColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasUserWith applies the HasEdge predicate on the "user" edge with a given conditions (other predicates).
+func HasUserWith(preds ...predicate.User) predicate.PostRevision {
+	return predicate.PostRevision(func(s *sql.Selector) {
+		step := newUserStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.PostRevision) predicate.PostRevision {
+	return predicate.PostRevision(sql.AndPredicates(predicates...))
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.PostRevision) predicate.PostRevision {
+	return predicate.PostRevision(sql.OrPredicates(predicates...))
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.PostRevision) predicate.PostRevision {
+	return predicate.PostRevision(sql.NotPredicates(p))
+}
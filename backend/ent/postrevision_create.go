@@ -0,0 +1,845 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"blog-server/ent/post"
+	"blog-server/ent/postrevision"
+	"blog-server/ent/user"
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// PostRevisionCreate is the builder for creating a PostRevision entity.
+type PostRevisionCreate struct {
+	config
+	mutation *PostRevisionMutation
+	hooks    []Hook
+	conflict []sql.ConflictOption
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (_c *PostRevisionCreate) SetCreatedAt(v time.Time) *PostRevisionCreate {
+	_c.mutation.SetCreatedAt(v)
+	return _c
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (_c *PostRevisionCreate) SetNillableCreatedAt(v *time.Time) *PostRevisionCreate {
+	if v != nil {
+		_c.SetCreatedAt(*v)
+	}
+	return _c
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (_c *PostRevisionCreate) SetUpdatedAt(v time.Time) *PostRevisionCreate {
+	_c.mutation.SetUpdatedAt(v)
+	return _c
+}
+
+// SetNillableUpdatedAt sets the "updated_at" field if the given value is not nil.
+func (_c *PostRevisionCreate) SetNillableUpdatedAt(v *time.Time) *PostRevisionCreate {
+	if v != nil {
+		_c.SetUpdatedAt(*v)
+	}
+	return _c
+}
+
+// SetDeletedAt sets the "deleted_at" field.
+func (_c *PostRevisionCreate) SetDeletedAt(v time.Time) *PostRevisionCreate {
+	_c.mutation.SetDeletedAt(v)
+	return _c
+}
+
+// SetNillableDeletedAt sets the "deleted_at" field if the given value is not nil.
+func (_c *PostRevisionCreate) SetNillableDeletedAt(v *time.Time) *PostRevisionCreate {
+	if v != nil {
+		_c.SetDeletedAt(*v)
+	}
+	return _c
+}
+
+// SetPostID sets the "post_id" field.
+func (_c *PostRevisionCreate) SetPostID(v uint) *PostRevisionCreate {
+	_c.mutation.SetPostID(v)
+	return _c
+}
+
+// SetVersion sets the "version" field.
+func (_c *PostRevisionCreate) SetVersion(v uint) *PostRevisionCreate {
+	_c.mutation.SetVersion(v)
+	return _c
+}
+
+// SetUserID sets the "user_id" field.
+func (_c *PostRevisionCreate) SetUserID(v uint) *PostRevisionCreate {
+	_c.mutation.SetUserID(v)
+	return _c
+}
+
+// SetNillableUserID sets the "user_id" field if the given value is not nil.
+func (_c *PostRevisionCreate) SetNillableUserID(v *uint) *PostRevisionCreate {
+	if v != nil {
+		_c.SetUserID(*v)
+	}
+	return _c
+}
+
+// SetTitle sets the "title" field.
+func (_c *PostRevisionCreate) SetTitle(v string) *PostRevisionCreate {
+	_c.mutation.SetTitle(v)
+	return _c
+}
+
+// SetSummary sets the "summary" field.
+func (_c *PostRevisionCreate) SetSummary(v string) *PostRevisionCreate {
+	_c.mutation.SetSummary(v)
+	return _c
+}
+
+// SetNillableSummary sets the "summary" field if the given value is not nil.
+func (_c *PostRevisionCreate) SetNillableSummary(v *string) *PostRevisionCreate {
+	if v != nil {
+		_c.SetSummary(*v)
+	}
+	return _c
+}
+
+// SetContent sets the "content" field.
+func (_c *PostRevisionCreate) SetContent(v string) *PostRevisionCreate {
+	_c.mutation.SetContent(v)
+	return _c
+}
+
+// SetRestoredFrom sets the "restored_from" field.
+func (_c *PostRevisionCreate) SetRestoredFrom(v uint) *PostRevisionCreate {
+	_c.mutation.SetRestoredFrom(v)
+	return _c
+}
+
+// SetNillableRestoredFrom sets the "restored_from" field if the given value is not nil.
+func (_c *PostRevisionCreate) SetNillableRestoredFrom(v *uint) *PostRevisionCreate {
+	if v != nil {
+		_c.SetRestoredFrom(*v)
+	}
+	return _c
+}
+
+// SetID sets the "id" field.
+func (_c *PostRevisionCreate) SetID(v uint) *PostRevisionCreate {
+	_c.mutation.SetID(v)
+	return _c
+}
+
+// SetPost sets the "post" edge to the Post entity.
+func (_c *PostRevisionCreate) SetPost(v *Post) *PostRevisionCreate {
+	return _c.SetPostID(v.ID)
+}
+
+// SetUser sets the "user" edge to the User entity.
+func (_c *PostRevisionCreate) SetUser(v *User) *PostRevisionCreate {
+	return _c.SetUserID(v.ID)
+}
+
+// Mutation returns the PostRevisionMutation object of the builder.
+func (_c *PostRevisionCreate) Mutation() *PostRevisionMutation {
+	return _c.mutation
+}
+
+// Save creates the PostRevision in the database.
+func (_c *PostRevisionCreate) Save(ctx context.Context) (*PostRevision, error) {
+	_c.defaults()
+	return withHooks(ctx, _c.sqlSave, _c.mutation, _c.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (_c *PostRevisionCreate) SaveX(ctx context.Context) *PostRevision {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *PostRevisionCreate) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *PostRevisionCreate) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_c *PostRevisionCreate) defaults() {
+	if _, ok := _c.mutation.CreatedAt(); !ok {
+		v := postrevision.DefaultCreatedAt()
+		_c.mutation.SetCreatedAt(v)
+	}
+	if _, ok := _c.mutation.UpdatedAt(); !ok {
+		v := postrevision.DefaultUpdatedAt()
+		_c.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_c *PostRevisionCreate) check() error {
+	if _, ok := _c.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "PostRevision.created_at"`)}
+	}
+	if _, ok := _c.mutation.UpdatedAt(); !ok {
+		return &ValidationError{Name: "updated_at", err: errors.New(`ent: missing required field "PostRevision.updated_at"`)}
+	}
+	if _, ok := _c.mutation.PostID(); !ok {
+		return &ValidationError{Name: "post_id", err: errors.New(`ent: missing required field "PostRevision.post_id"`)}
+	}
+	if _, ok := _c.mutation.Version(); !ok {
+		return &ValidationError{Name: "version", err: errors.New(`ent: missing required field "PostRevision.version"`)}
+	}
+	if _, ok := _c.mutation.Title(); !ok {
+		return &ValidationError{Name: "title", err: errors.New(`ent: missing required field "PostRevision.title"`)}
+	}
+	if v, ok := _c.mutation.Title(); ok {
+		if err := postrevision.TitleValidator(v); err != nil {
+			return &ValidationError{Name: "title", err: fmt.Errorf(`ent: validator failed for field "PostRevision.title": %w`, err)}
+		}
+	}
+	if v, ok := _c.mutation.Summary(); ok {
+		if err := postrevision.SummaryValidator(v); err != nil {
+			return &ValidationError{Name: "summary", err: fmt.Errorf(`ent: validator failed for field "PostRevision.summary": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.Content(); !ok {
+		return &ValidationError{Name: "content", err: errors.New(`ent: missing required field "PostRevision.content"`)}
+	}
+	if len(_c.mutation.PostIDs()) == 0 {
+		return &ValidationError{Name: "post", err: errors.New(`ent: missing required edge "PostRevision.post"`)}
+	}
+	return nil
+}
+
+func (_c *PostRevisionCreate) sqlSave(ctx context.Context) (*PostRevision, error) {
+	if err := _c.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := _c.createSpec()
+	if err := sqlgraph.CreateNode(ctx, _c.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	if _spec.ID.Value != _node.ID {
+		id := _spec.ID.Value.(int64)
+		_node.ID = uint(id)
+	}
+	_c.mutation.id = &_node.ID
+	_c.mutation.done = true
+	return _node, nil
+}
+
+func (_c *PostRevisionCreate) createSpec() (*PostRevision, *sqlgraph.CreateSpec) {
+	var (
+		_node = &PostRevision{config: _c.config}
+		_spec = sqlgraph.NewCreateSpec(postrevision.Table, sqlgraph.NewFieldSpec(postrevision.FieldID, field.TypeUint))
+	)
+	_spec.OnConflict = _c.conflict
+	if id, ok := _c.mutation.ID(); ok {
+		_node.ID = id
+		_spec.ID.Value = id
+	}
+	if value, ok := _c.mutation.CreatedAt(); ok {
+		_spec.SetField(postrevision.FieldCreatedAt, field.TypeTime, value)
+		_node.CreatedAt = value
+	}
+	if value, ok := _c.mutation.UpdatedAt(); ok {
+		_spec.SetField(postrevision.FieldUpdatedAt, field.TypeTime, value)
+		_node.UpdatedAt = value
+	}
+	if value, ok := _c.mutation.DeletedAt(); ok {
+		_spec.SetField(postrevision.FieldDeletedAt, field.TypeTime, value)
+		_node.DeletedAt = &value
+	}
+	if value, ok := _c.mutation.Version(); ok {
+		_spec.SetField(postrevision.FieldVersion, field.TypeUint, value)
+		_node.Version = value
+	}
+	if value, ok := _c.mutation.Title(); ok {
+		_spec.SetField(postrevision.FieldTitle, field.TypeString, value)
+		_node.Title = value
+	}
+	if value, ok := _c.mutation.Summary(); ok {
+		_spec.SetField(postrevision.FieldSummary, field.TypeString, value)
+		_node.Summary = &value
+	}
+	if value, ok := _c.mutation.Content(); ok {
+		_spec.SetField(postrevision.FieldContent, field.TypeString, value)
+		_node.Content = value
+	}
+	if value, ok := _c.mutation.RestoredFrom(); ok {
+		_spec.SetField(postrevision.FieldRestoredFrom, field.TypeUint, value)
+		_node.RestoredFrom = &value
+	}
+	if nodes := _c.mutation.PostIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   postrevision.PostTable,
+			Columns: []string{postrevision.PostColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(post.FieldID, field.TypeUint),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.PostID = nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := _c.mutation.UserIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   postrevision.UserTable,
+			Columns: []string{postrevision.UserColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeUint),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.UserID = &nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	return _node, _spec
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.PostRevision.Create().
+//		SetCreatedAt(v).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.PostRevisionUpsert) {
+//			SetCreatedAt(v+v).
+//		}).
+//		Exec(ctx)
+func (_c *PostRevisionCreate) OnConflict(opts ...sql.ConflictOption) *PostRevisionUpsertOne {
+	_c.conflict = opts
+	return &PostRevisionUpsertOne{
+		create: _c,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.PostRevision.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (_c *PostRevisionCreate) OnConflictColumns(columns ...string) *PostRevisionUpsertOne {
+	_c.conflict = append(_c.conflict, sql.ConflictColumns(columns...))
+	return &PostRevisionUpsertOne{
+		create: _c,
+	}
+}
+
+type (
+	// PostRevisionUpsertOne is the builder for "upsert"-ing
+	//  one PostRevision node.
+	PostRevisionUpsertOne struct {
+		create *PostRevisionCreate
+	}
+
+	// PostRevisionUpsert is the "OnConflict" setter.
+	PostRevisionUpsert struct {
+		*sql.UpdateSet
+	}
+)
+
+// SetCreatedAt sets the "created_at" field.
+func (u *PostRevisionUpsert) SetCreatedAt(v time.Time) *PostRevisionUpsert {
+	u.Set(postrevision.FieldCreatedAt, v)
+	return u
+}
+
+// UpdateCreatedAt sets the "created_at" field to the value that was provided on create.
+func (u *PostRevisionUpsert) UpdateCreatedAt() *PostRevisionUpsert {
+	u.SetExcluded(postrevision.FieldCreatedAt)
+	return u
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (u *PostRevisionUpsert) SetUpdatedAt(v time.Time) *PostRevisionUpsert {
+	u.Set(postrevision.FieldUpdatedAt, v)
+	return u
+}
+
+// UpdateUpdatedAt sets the "updated_at" field to the value that was provided on create.
+func (u *PostRevisionUpsert) UpdateUpdatedAt() *PostRevisionUpsert {
+	u.SetExcluded(postrevision.FieldUpdatedAt)
+	return u
+}
+
+// SetDeletedAt sets the "deleted_at" field.
+func (u *PostRevisionUpsert) SetDeletedAt(v time.Time) *PostRevisionUpsert {
+	u.Set(postrevision.FieldDeletedAt, v)
+	return u
+}
+
+// UpdateDeletedAt sets the "deleted_at" field to the value that was provided on create.
+func (u *PostRevisionUpsert) UpdateDeletedAt() *PostRevisionUpsert {
+	u.SetExcluded(postrevision.FieldDeletedAt)
+	return u
+}
+
+// ClearDeletedAt clears the value of the "deleted_at" field.
+func (u *PostRevisionUpsert) ClearDeletedAt() *PostRevisionUpsert {
+	u.SetNull(postrevision.FieldDeletedAt)
+	return u
+}
+
+// UpdateNewValues updates the mutable fields using the new values that were set on create except the ID field.
+// Using this option is equivalent to using:
+//
+//	client.PostRevision.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//			sql.ResolveWith(func(u *sql.UpdateSet) {
+//				u.SetIgnore(postrevision.FieldID)
+//			}),
+//		).
+//		Exec(ctx)
+func (u *PostRevisionUpsertOne) UpdateNewValues() *PostRevisionUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		if _, exists := u.create.mutation.ID(); exists {
+			s.SetIgnore(postrevision.FieldID)
+		}
+		if _, exists := u.create.mutation.PostID(); exists {
+			s.SetIgnore(postrevision.FieldPostID)
+		}
+		if _, exists := u.create.mutation.Version(); exists {
+			s.SetIgnore(postrevision.FieldVersion)
+		}
+		if _, exists := u.create.mutation.UserID(); exists {
+			s.SetIgnore(postrevision.FieldUserID)
+		}
+		if _, exists := u.create.mutation.Title(); exists {
+			s.SetIgnore(postrevision.FieldTitle)
+		}
+		if _, exists := u.create.mutation.Summary(); exists {
+			s.SetIgnore(postrevision.FieldSummary)
+		}
+		if _, exists := u.create.mutation.Content(); exists {
+			s.SetIgnore(postrevision.FieldContent)
+		}
+		if _, exists := u.create.mutation.RestoredFrom(); exists {
+			s.SetIgnore(postrevision.FieldRestoredFrom)
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.PostRevision.Create().
+//	    OnConflict(sql.ResolveWithIgnore()).
+//	    Exec(ctx)
+func (u *PostRevisionUpsertOne) Ignore() *PostRevisionUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *PostRevisionUpsertOne) DoNothing() *PostRevisionUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the PostRevisionCreate.OnConflict
+// documentation for more info.
+func (u *PostRevisionUpsertOne) Update(set func(*PostRevisionUpsert)) *PostRevisionUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&PostRevisionUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (u *PostRevisionUpsertOne) SetCreatedAt(v time.Time) *PostRevisionUpsertOne {
+	return u.Update(func(s *PostRevisionUpsert) {
+		s.SetCreatedAt(v)
+	})
+}
+
+// UpdateCreatedAt sets the "created_at" field to the value that was provided on create.
+func (u *PostRevisionUpsertOne) UpdateCreatedAt() *PostRevisionUpsertOne {
+	return u.Update(func(s *PostRevisionUpsert) {
+		s.UpdateCreatedAt()
+	})
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (u *PostRevisionUpsertOne) SetUpdatedAt(v time.Time) *PostRevisionUpsertOne {
+	return u.Update(func(s *PostRevisionUpsert) {
+		s.SetUpdatedAt(v)
+	})
+}
+
+// UpdateUpdatedAt sets the "updated_at" field to the value that was provided on create.
+func (u *PostRevisionUpsertOne) UpdateUpdatedAt() *PostRevisionUpsertOne {
+	return u.Update(func(s *PostRevisionUpsert) {
+		s.UpdateUpdatedAt()
+	})
+}
+
+// SetDeletedAt sets the "deleted_at" field.
+func (u *PostRevisionUpsertOne) SetDeletedAt(v time.Time) *PostRevisionUpsertOne {
+	return u.Update(func(s *PostRevisionUpsert) {
+		s.SetDeletedAt(v)
+	})
+}
+
+// UpdateDeletedAt sets the "deleted_at" field to the value that was provided on create.
+func (u *PostRevisionUpsertOne) UpdateDeletedAt() *PostRevisionUpsertOne {
+	return u.Update(func(s *PostRevisionUpsert) {
+		s.UpdateDeletedAt()
+	})
+}
+
+// ClearDeletedAt clears the value of the "deleted_at" field.
+func (u *PostRevisionUpsertOne) ClearDeletedAt() *PostRevisionUpsertOne {
+	return u.Update(func(s *PostRevisionUpsert) {
+		s.ClearDeletedAt()
+	})
+}
+
+// Exec executes the query.
+func (u *PostRevisionUpsertOne) Exec(ctx context.Context) error {
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for PostRevisionCreate.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *PostRevisionUpsertOne) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// Exec executes the UPSERT query and returns the inserted/updated ID.
+func (u *PostRevisionUpsertOne) ID(ctx context.Context) (id uint, err error) {
+	node, err := u.create.Save(ctx)
+	if err != nil {
+		return id, err
+	}
+	return node.ID, nil
+}
+
+// IDX is like ID, but panics if an error occurs.
+func (u *PostRevisionUpsertOne) IDX(ctx context.Context) uint {
+	id, err := u.ID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// PostRevisionCreateBulk is the builder for creating many PostRevision entities in bulk.
+type PostRevisionCreateBulk struct {
+	config
+	err      error
+	builders []*PostRevisionCreate
+	conflict []sql.ConflictOption
+}
+
+// Save creates the PostRevision entities in the database.
+func (_c *PostRevisionCreateBulk) Save(ctx context.Context) ([]*PostRevision, error) {
+	if _c.err != nil {
+		return nil, _c.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(_c.builders))
+	nodes := make([]*PostRevision, len(_c.builders))
+	mutators := make([]Mutator, len(_c.builders))
+	for i := range _c.builders {
+		func(i int, root context.Context) {
+			builder := _c.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*PostRevisionMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, _c.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					spec.OnConflict = _c.conflict
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, _c.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				if specs[i].ID.Value != nil && nodes[i].ID == 0 {
+					id := specs[i].ID.Value.(int64)
+					nodes[i].ID = uint(id)
+				}
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, _c.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_c *PostRevisionCreateBulk) SaveX(ctx context.Context) []*PostRevision {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *PostRevisionCreateBulk) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *PostRevisionCreateBulk) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.PostRevision.CreateBulk(builders...).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.PostRevisionUpsert) {
+//			SetCreatedAt(v+v).
+//		}).
+//		Exec(ctx)
+func (_c *PostRevisionCreateBulk) OnConflict(opts ...sql.ConflictOption) *PostRevisionUpsertBulk {
+	_c.conflict = opts
+	return &PostRevisionUpsertBulk{
+		create: _c,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.PostRevision.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (_c *PostRevisionCreateBulk) OnConflictColumns(columns ...string) *PostRevisionUpsertBulk {
+	_c.conflict = append(_c.conflict, sql.ConflictColumns(columns...))
+	return &PostRevisionUpsertBulk{
+		create: _c,
+	}
+}
+
+// PostRevisionUpsertBulk is the builder for "upsert"-ing
+// a bulk of PostRevision nodes.
+type PostRevisionUpsertBulk struct {
+	create *PostRevisionCreateBulk
+}
+
+// UpdateNewValues updates the mutable fields using the new values that
+// were set on create. Using this option is equivalent to using:
+//
+//	client.PostRevision.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//			sql.ResolveWith(func(u *sql.UpdateSet) {
+//				u.SetIgnore(postrevision.FieldID)
+//			}),
+//		).
+//		Exec(ctx)
+func (u *PostRevisionUpsertBulk) UpdateNewValues() *PostRevisionUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		for _, b := range u.create.builders {
+			if _, exists := b.mutation.ID(); exists {
+				s.SetIgnore(postrevision.FieldID)
+			}
+			if _, exists := b.mutation.PostID(); exists {
+				s.SetIgnore(postrevision.FieldPostID)
+			}
+			if _, exists := b.mutation.Version(); exists {
+				s.SetIgnore(postrevision.FieldVersion)
+			}
+			if _, exists := b.mutation.UserID(); exists {
+				s.SetIgnore(postrevision.FieldUserID)
+			}
+			if _, exists := b.mutation.Title(); exists {
+				s.SetIgnore(postrevision.FieldTitle)
+			}
+			if _, exists := b.mutation.Summary(); exists {
+				s.SetIgnore(postrevision.FieldSummary)
+			}
+			if _, exists := b.mutation.Content(); exists {
+				s.SetIgnore(postrevision.FieldContent)
+			}
+			if _, exists := b.mutation.RestoredFrom(); exists {
+				s.SetIgnore(postrevision.FieldRestoredFrom)
+			}
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.PostRevision.Create().
+//		OnConflict(sql.ResolveWithIgnore()).
+//		Exec(ctx)
+func (u *PostRevisionUpsertBulk) Ignore() *PostRevisionUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *PostRevisionUpsertBulk) DoNothing() *PostRevisionUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the PostRevisionCreateBulk.OnConflict
+// documentation for more info.
+func (u *PostRevisionUpsertBulk) Update(set func(*PostRevisionUpsert)) *PostRevisionUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&PostRevisionUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (u *PostRevisionUpsertBulk) SetCreatedAt(v time.Time) *PostRevisionUpsertBulk {
+	return u.Update(func(s *PostRevisionUpsert) {
+		s.SetCreatedAt(v)
+	})
+}
+
+// UpdateCreatedAt sets the "created_at" field to the value that was provided on create.
+func (u *PostRevisionUpsertBulk) UpdateCreatedAt() *PostRevisionUpsertBulk {
+	return u.Update(func(s *PostRevisionUpsert) {
+		s.UpdateCreatedAt()
+	})
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (u *PostRevisionUpsertBulk) SetUpdatedAt(v time.Time) *PostRevisionUpsertBulk {
+	return u.Update(func(s *PostRevisionUpsert) {
+		s.SetUpdatedAt(v)
+	})
+}
+
+// UpdateUpdatedAt sets the "updated_at" field to the value that was provided on create.
+func (u *PostRevisionUpsertBulk) UpdateUpdatedAt() *PostRevisionUpsertBulk {
+	return u.Update(func(s *PostRevisionUpsert) {
+		s.UpdateUpdatedAt()
+	})
+}
+
+// SetDeletedAt sets the "deleted_at" field.
+func (u *PostRevisionUpsertBulk) SetDeletedAt(v time.Time) *PostRevisionUpsertBulk {
+	return u.Update(func(s *PostRevisionUpsert) {
+		s.SetDeletedAt(v)
+	})
+}
+
+// UpdateDeletedAt sets the "deleted_at" field to the value that was provided on create.
+func (u *PostRevisionUpsertBulk) UpdateDeletedAt() *PostRevisionUpsertBulk {
+	return u.Update(func(s *PostRevisionUpsert) {
+		s.UpdateDeletedAt()
+	})
+}
+
+// ClearDeletedAt clears the value of the "deleted_at" field.
+func (u *PostRevisionUpsertBulk) ClearDeletedAt() *PostRevisionUpsertBulk {
+	return u.Update(func(s *PostRevisionUpsert) {
+		s.ClearDeletedAt()
+	})
+}
+
+// Exec executes the query.
+func (u *PostRevisionUpsertBulk) Exec(ctx context.Context) error {
+	if u.create.err != nil {
+		return u.create.err
+	}
+	for i, b := range u.create.builders {
+		if len(b.conflict) != 0 {
+			return fmt.Errorf("ent: OnConflict was set for builder %d. Set it on the PostRevisionCreateBulk instead", i)
+		}
+	}
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for PostRevisionCreateBulk.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *PostRevisionUpsertBulk) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,346 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"blog-server/ent/postrevision"
+	"blog-server/ent/predicate"
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// PostRevisionUpdate is the builder for updating PostRevision entities.
+type PostRevisionUpdate struct {
+	config
+	hooks     []Hook
+	mutation  *PostRevisionMutation
+	modifiers []func(*sql.UpdateBuilder)
+}
+
+// Where appends a list predicates to the PostRevisionUpdate builder.
+func (_u *PostRevisionUpdate) Where(ps ...predicate.PostRevision) *PostRevisionUpdate {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (_u *PostRevisionUpdate) SetCreatedAt(v time.Time) *PostRevisionUpdate {
+	_u.mutation.SetCreatedAt(v)
+	return _u
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (_u *PostRevisionUpdate) SetNillableCreatedAt(v *time.Time) *PostRevisionUpdate {
+	if v != nil {
+		_u.SetCreatedAt(*v)
+	}
+	return _u
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (_u *PostRevisionUpdate) SetUpdatedAt(v time.Time) *PostRevisionUpdate {
+	_u.mutation.SetUpdatedAt(v)
+	return _u
+}
+
+// SetNillableUpdatedAt sets the "updated_at" field if the given value is not nil.
+func (_u *PostRevisionUpdate) SetNillableUpdatedAt(v *time.Time) *PostRevisionUpdate {
+	if v != nil {
+		_u.SetUpdatedAt(*v)
+	}
+	return _u
+}
+
+// SetDeletedAt sets the "deleted_at" field.
+func (_u *PostRevisionUpdate) SetDeletedAt(v time.Time) *PostRevisionUpdate {
+	_u.mutation.SetDeletedAt(v)
+	return _u
+}
+
+// SetNillableDeletedAt sets the "deleted_at" field if the given value is not nil.
+func (_u *PostRevisionUpdate) SetNillableDeletedAt(v *time.Time) *PostRevisionUpdate {
+	if v != nil {
+		_u.SetDeletedAt(*v)
+	}
+	return _u
+}
+
+// ClearDeletedAt clears the value of the "deleted_at" field.
+func (_u *PostRevisionUpdate) ClearDeletedAt() *PostRevisionUpdate {
+	_u.mutation.ClearDeletedAt()
+	return _u
+}
+
+// Mutation returns the PostRevisionMutation object of the builder.
+func (_u *PostRevisionUpdate) Mutation() *PostRevisionMutation {
+	return _u.mutation
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (_u *PostRevisionUpdate) Save(ctx context.Context) (int, error) {
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *PostRevisionUpdate) SaveX(ctx context.Context) int {
+	affected, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (_u *PostRevisionUpdate) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *PostRevisionUpdate) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *PostRevisionUpdate) check() error {
+	if _u.mutation.PostCleared() && len(_u.mutation.PostIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "PostRevision.post"`)
+	}
+	return nil
+}
+
+// Modify adds a statement modifier for attaching custom logic to the UPDATE statement.
+func (_u *PostRevisionUpdate) Modify(modifiers ...func(u *sql.UpdateBuilder)) *PostRevisionUpdate {
+	_u.modifiers = append(_u.modifiers, modifiers...)
+	return _u
+}
+
+func (_u *PostRevisionUpdate) sqlSave(ctx context.Context) (_node int, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(postrevision.Table, postrevision.Columns, sqlgraph.NewFieldSpec(postrevision.FieldID, field.TypeUint))
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.CreatedAt(); ok {
+		_spec.SetField(postrevision.FieldCreatedAt, field.TypeTime, value)
+	}
+	if value, ok := _u.mutation.UpdatedAt(); ok {
+		_spec.SetField(postrevision.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if value, ok := _u.mutation.DeletedAt(); ok {
+		_spec.SetField(postrevision.FieldDeletedAt, field.TypeTime, value)
+	}
+	if _u.mutation.DeletedAtCleared() {
+		_spec.ClearField(postrevision.FieldDeletedAt, field.TypeTime)
+	}
+	if _u.mutation.SummaryCleared() {
+		_spec.ClearField(postrevision.FieldSummary, field.TypeString)
+	}
+	if _u.mutation.RestoredFromCleared() {
+		_spec.ClearField(postrevision.FieldRestoredFrom, field.TypeUint)
+	}
+	_spec.AddModifiers(_u.modifiers...)
+	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{postrevision.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
+
+// PostRevisionUpdateOne is the builder for updating a single PostRevision entity.
+type PostRevisionUpdateOne struct {
+	config
+	fields    []string
+	hooks     []Hook
+	mutation  *PostRevisionMutation
+	modifiers []func(*sql.UpdateBuilder)
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (_u *PostRevisionUpdateOne) SetCreatedAt(v time.Time) *PostRevisionUpdateOne {
+	_u.mutation.SetCreatedAt(v)
+	return _u
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (_u *PostRevisionUpdateOne) SetNillableCreatedAt(v *time.Time) *PostRevisionUpdateOne {
+	if v != nil {
+		_u.SetCreatedAt(*v)
+	}
+	return _u
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (_u *PostRevisionUpdateOne) SetUpdatedAt(v time.Time) *PostRevisionUpdateOne {
+	_u.mutation.SetUpdatedAt(v)
+	return _u
+}
+
+// SetNillableUpdatedAt sets the "updated_at" field if the given value is not nil.
+func (_u *PostRevisionUpdateOne) SetNillableUpdatedAt(v *time.Time) *PostRevisionUpdateOne {
+	if v != nil {
+		_u.SetUpdatedAt(*v)
+	}
+	return _u
+}
+
+// SetDeletedAt sets the "deleted_at" field.
+func (_u *PostRevisionUpdateOne) SetDeletedAt(v time.Time) *PostRevisionUpdateOne {
+	_u.mutation.SetDeletedAt(v)
+	return _u
+}
+
+// SetNillableDeletedAt sets the "deleted_at" field if the given value is not nil.
+func (_u *PostRevisionUpdateOne) SetNillableDeletedAt(v *time.Time) *PostRevisionUpdateOne {
+	if v != nil {
+		_u.SetDeletedAt(*v)
+	}
+	return _u
+}
+
+// ClearDeletedAt clears the value of the "deleted_at" field.
+func (_u *PostRevisionUpdateOne) ClearDeletedAt() *PostRevisionUpdateOne {
+	_u.mutation.ClearDeletedAt()
+	return _u
+}
+
+// Mutation returns the PostRevisionMutation object of the builder.
+func (_u *PostRevisionUpdateOne) Mutation() *PostRevisionMutation {
+	return _u.mutation
+}
+
+// Where appends a list predicates to the PostRevisionUpdate builder.
+func (_u *PostRevisionUpdateOne) Where(ps ...predicate.PostRevision) *PostRevisionUpdateOne {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (_u *PostRevisionUpdateOne) Select(field string, fields ...string) *PostRevisionUpdateOne {
+	_u.fields = append([]string{field}, fields...)
+	return _u
+}
+
+// Save executes the query and returns the updated PostRevision entity.
+func (_u *PostRevisionUpdateOne) Save(ctx context.Context) (*PostRevision, error) {
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *PostRevisionUpdateOne) SaveX(ctx context.Context) *PostRevision {
+	node, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (_u *PostRevisionUpdateOne) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *PostRevisionUpdateOne) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *PostRevisionUpdateOne) check() error {
+	if _u.mutation.PostCleared() && len(_u.mutation.PostIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "PostRevision.post"`)
+	}
+	return nil
+}
+
+// Modify adds a statement modifier for attaching custom logic to the UPDATE statement.
+func (_u *PostRevisionUpdateOne) Modify(modifiers ...func(u *sql.UpdateBuilder)) *PostRevisionUpdateOne {
+	_u.modifiers = append(_u.modifiers, modifiers...)
+	return _u
+}
+
+func (_u *PostRevisionUpdateOne) sqlSave(ctx context.Context) (_node *PostRevision, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(postrevision.Table, postrevision.Columns, sqlgraph.NewFieldSpec(postrevision.FieldID, field.TypeUint))
+	id, ok := _u.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "PostRevision.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := _u.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, postrevision.FieldID)
+		for _, f := range fields {
+			if !postrevision.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != postrevision.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.CreatedAt(); ok {
+		_spec.SetField(postrevision.FieldCreatedAt, field.TypeTime, value)
+	}
+	if value, ok := _u.mutation.UpdatedAt(); ok {
+		_spec.SetField(postrevision.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if value, ok := _u.mutation.DeletedAt(); ok {
+		_spec.SetField(postrevision.FieldDeletedAt, field.TypeTime, value)
+	}
+	if _u.mutation.DeletedAtCleared() {
+		_spec.ClearField(postrevision.FieldDeletedAt, field.TypeTime)
+	}
+	if _u.mutation.SummaryCleared() {
+		_spec.ClearField(postrevision.FieldSummary, field.TypeString)
+	}
+	if _u.mutation.RestoredFromCleared() {
+		_spec.ClearField(postrevision.FieldRestoredFrom, field.TypeUint)
+	}
+	_spec.AddModifiers(_u.modifiers...)
+	_node = &PostRevision{config: _u.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{postrevision.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
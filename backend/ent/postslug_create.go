@@ -0,0 +1,779 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"blog-server/ent/post"
+	"blog-server/ent/postslug"
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// PostSlugCreate is the builder for creating a PostSlug entity.
+type PostSlugCreate struct {
+	config
+	mutation *PostSlugMutation
+	hooks    []Hook
+	conflict []sql.ConflictOption
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (_c *PostSlugCreate) SetCreatedAt(v time.Time) *PostSlugCreate {
+	_c.mutation.SetCreatedAt(v)
+	return _c
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (_c *PostSlugCreate) SetNillableCreatedAt(v *time.Time) *PostSlugCreate {
+	if v != nil {
+		_c.SetCreatedAt(*v)
+	}
+	return _c
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (_c *PostSlugCreate) SetUpdatedAt(v time.Time) *PostSlugCreate {
+	_c.mutation.SetUpdatedAt(v)
+	return _c
+}
+
+// SetNillableUpdatedAt sets the "updated_at" field if the given value is not nil.
+func (_c *PostSlugCreate) SetNillableUpdatedAt(v *time.Time) *PostSlugCreate {
+	if v != nil {
+		_c.SetUpdatedAt(*v)
+	}
+	return _c
+}
+
+// SetDeletedAt sets the "deleted_at" field.
+func (_c *PostSlugCreate) SetDeletedAt(v time.Time) *PostSlugCreate {
+	_c.mutation.SetDeletedAt(v)
+	return _c
+}
+
+// SetNillableDeletedAt sets the "deleted_at" field if the given value is not nil.
+func (_c *PostSlugCreate) SetNillableDeletedAt(v *time.Time) *PostSlugCreate {
+	if v != nil {
+		_c.SetDeletedAt(*v)
+	}
+	return _c
+}
+
+// SetPostID sets the "post_id" field.
+func (_c *PostSlugCreate) SetPostID(v uint) *PostSlugCreate {
+	_c.mutation.SetPostID(v)
+	return _c
+}
+
+// SetSlug sets the "slug" field.
+func (_c *PostSlugCreate) SetSlug(v string) *PostSlugCreate {
+	_c.mutation.SetSlug(v)
+	return _c
+}
+
+// SetID sets the "id" field.
+func (_c *PostSlugCreate) SetID(v uint) *PostSlugCreate {
+	_c.mutation.SetID(v)
+	return _c
+}
+
+// SetPost sets the "post" edge to the Post entity.
+func (_c *PostSlugCreate) SetPost(v *Post) *PostSlugCreate {
+	return _c.SetPostID(v.ID)
+}
+
+// Mutation returns the PostSlugMutation object of the builder.
+func (_c *PostSlugCreate) Mutation() *PostSlugMutation {
+	return _c.mutation
+}
+
+// Save creates the PostSlug in the database.
+func (_c *PostSlugCreate) Save(ctx context.Context) (*PostSlug, error) {
+	_c.defaults()
+	return withHooks(ctx, _c.sqlSave, _c.mutation, _c.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (_c *PostSlugCreate) SaveX(ctx context.Context) *PostSlug {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *PostSlugCreate) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *PostSlugCreate) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_c *PostSlugCreate) defaults() {
+	if _, ok := _c.mutation.CreatedAt(); !ok {
+		v := postslug.DefaultCreatedAt()
+		_c.mutation.SetCreatedAt(v)
+	}
+	if _, ok := _c.mutation.UpdatedAt(); !ok {
+		v := postslug.DefaultUpdatedAt()
+		_c.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_c *PostSlugCreate) check() error {
+	if _, ok := _c.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "PostSlug.created_at"`)}
+	}
+	if _, ok := _c.mutation.UpdatedAt(); !ok {
+		return &ValidationError{Name: "updated_at", err: errors.New(`ent: missing required field "PostSlug.updated_at"`)}
+	}
+	if _, ok := _c.mutation.PostID(); !ok {
+		return &ValidationError{Name: "post_id", err: errors.New(`ent: missing required field "PostSlug.post_id"`)}
+	}
+	if _, ok := _c.mutation.Slug(); !ok {
+		return &ValidationError{Name: "slug", err: errors.New(`ent: missing required field "PostSlug.slug"`)}
+	}
+	if v, ok := _c.mutation.Slug(); ok {
+		if err := postslug.SlugValidator(v); err != nil {
+			return &ValidationError{Name: "slug", err: fmt.Errorf(`ent: validator failed for field "PostSlug.slug": %w`, err)}
+		}
+	}
+	if len(_c.mutation.PostIDs()) == 0 {
+		return &ValidationError{Name: "post", err: errors.New(`ent: missing required edge "PostSlug.post"`)}
+	}
+	return nil
+}
+
+func (_c *PostSlugCreate) sqlSave(ctx context.Context) (*PostSlug, error) {
+	if err := _c.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := _c.createSpec()
+	if err := sqlgraph.CreateNode(ctx, _c.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	if _spec.ID.Value != _node.ID {
+		id := _spec.ID.Value.(int64)
+		_node.ID = uint(id)
+	}
+	_c.mutation.id = &_node.ID
+	_c.mutation.done = true
+	return _node, nil
+}
+
+func (_c *PostSlugCreate) createSpec() (*PostSlug, *sqlgraph.CreateSpec) {
+	var (
+		_node = &PostSlug{config: _c.config}
+		_spec = sqlgraph.NewCreateSpec(postslug.Table, sqlgraph.NewFieldSpec(postslug.FieldID, field.TypeUint))
+	)
+	_spec.OnConflict = _c.conflict
+	if id, ok := _c.mutation.ID(); ok {
+		_node.ID = id
+		_spec.ID.Value = id
+	}
+	if value, ok := _c.mutation.CreatedAt(); ok {
+		_spec.SetField(postslug.FieldCreatedAt, field.TypeTime, value)
+		_node.CreatedAt = value
+	}
+	if value, ok := _c.mutation.UpdatedAt(); ok {
+		_spec.SetField(postslug.FieldUpdatedAt, field.TypeTime, value)
+		_node.UpdatedAt = value
+	}
+	if value, ok := _c.mutation.DeletedAt(); ok {
+		_spec.SetField(postslug.FieldDeletedAt, field.TypeTime, value)
+		_node.DeletedAt = &value
+	}
+	if value, ok := _c.mutation.Slug(); ok {
+		_spec.SetField(postslug.FieldSlug, field.TypeString, value)
+		_node.Slug = value
+	}
+	if nodes := _c.mutation.PostIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   postslug.PostTable,
+			Columns: []string{postslug.PostColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(post.FieldID, field.TypeUint),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.PostID = nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	return _node, _spec
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.PostSlug.Create().
+//		SetCreatedAt(v).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.PostSlugUpsert) {
+//			SetCreatedAt(v+v).
+//		}).
+//		Exec(ctx)
+func (_c *PostSlugCreate) OnConflict(opts ...sql.ConflictOption) *PostSlugUpsertOne {
+	_c.conflict = opts
+	return &PostSlugUpsertOne{
+		create: _c,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.PostSlug.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (_c *PostSlugCreate) OnConflictColumns(columns ...string) *PostSlugUpsertOne {
+	_c.conflict = append(_c.conflict, sql.ConflictColumns(columns...))
+	return &PostSlugUpsertOne{
+		create: _c,
+	}
+}
+
+type (
+	// PostSlugUpsertOne is the builder for "upsert"-ing
+	//  one PostSlug node.
+	PostSlugUpsertOne struct {
+		create *PostSlugCreate
+	}
+
+	// PostSlugUpsert is the "OnConflict" setter.
+	PostSlugUpsert struct {
+		*sql.UpdateSet
+	}
+)
+
+// SetCreatedAt sets the "created_at" field.
+func (u *PostSlugUpsert) SetCreatedAt(v time.Time) *PostSlugUpsert {
+	u.Set(postslug.FieldCreatedAt, v)
+	return u
+}
+
+// UpdateCreatedAt sets the "created_at" field to the value that was provided on create.
+func (u *PostSlugUpsert) UpdateCreatedAt() *PostSlugUpsert {
+	u.SetExcluded(postslug.FieldCreatedAt)
+	return u
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (u *PostSlugUpsert) SetUpdatedAt(v time.Time) *PostSlugUpsert {
+	u.Set(postslug.FieldUpdatedAt, v)
+	return u
+}
+
+// UpdateUpdatedAt sets the "updated_at" field to the value that was provided on create.
+func (u *PostSlugUpsert) UpdateUpdatedAt() *PostSlugUpsert {
+	u.SetExcluded(postslug.FieldUpdatedAt)
+	return u
+}
+
+// SetDeletedAt sets the "deleted_at" field.
+func (u *PostSlugUpsert) SetDeletedAt(v time.Time) *PostSlugUpsert {
+	u.Set(postslug.FieldDeletedAt, v)
+	return u
+}
+
+// UpdateDeletedAt sets the "deleted_at" field to the value that was provided on create.
+func (u *PostSlugUpsert) UpdateDeletedAt() *PostSlugUpsert {
+	u.SetExcluded(postslug.FieldDeletedAt)
+	return u
+}
+
+// ClearDeletedAt clears the value of the "deleted_at" field.
+func (u *PostSlugUpsert) ClearDeletedAt() *PostSlugUpsert {
+	u.SetNull(postslug.FieldDeletedAt)
+	return u
+}
+
+// SetPostID sets the "post_id" field.
+func (u *PostSlugUpsert) SetPostID(v uint) *PostSlugUpsert {
+	u.Set(postslug.FieldPostID, v)
+	return u
+}
+
+// UpdatePostID sets the "post_id" field to the value that was provided on create.
+func (u *PostSlugUpsert) UpdatePostID() *PostSlugUpsert {
+	u.SetExcluded(postslug.FieldPostID)
+	return u
+}
+
+// SetSlug sets the "slug" field.
+func (u *PostSlugUpsert) SetSlug(v string) *PostSlugUpsert {
+	u.Set(postslug.FieldSlug, v)
+	return u
+}
+
+// UpdateSlug sets the "slug" field to the value that was provided on create.
+func (u *PostSlugUpsert) UpdateSlug() *PostSlugUpsert {
+	u.SetExcluded(postslug.FieldSlug)
+	return u
+}
+
+// UpdateNewValues updates the mutable fields using the new values that were set on create except the ID field.
+// Using this option is equivalent to using:
+//
+//	client.PostSlug.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//			sql.ResolveWith(func(u *sql.UpdateSet) {
+//				u.SetIgnore(postslug.FieldID)
+//			}),
+//		).
+//		Exec(ctx)
+func (u *PostSlugUpsertOne) UpdateNewValues() *PostSlugUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		if _, exists := u.create.mutation.ID(); exists {
+			s.SetIgnore(postslug.FieldID)
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.PostSlug.Create().
+//	    OnConflict(sql.ResolveWithIgnore()).
+//	    Exec(ctx)
+func (u *PostSlugUpsertOne) Ignore() *PostSlugUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *PostSlugUpsertOne) DoNothing() *PostSlugUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the PostSlugCreate.OnConflict
+// documentation for more info.
+func (u *PostSlugUpsertOne) Update(set func(*PostSlugUpsert)) *PostSlugUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&PostSlugUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (u *PostSlugUpsertOne) SetCreatedAt(v time.Time) *PostSlugUpsertOne {
+	return u.Update(func(s *PostSlugUpsert) {
+		s.SetCreatedAt(v)
+	})
+}
+
+// UpdateCreatedAt sets the "created_at" field to the value that was provided on create.
+func (u *PostSlugUpsertOne) UpdateCreatedAt() *PostSlugUpsertOne {
+	return u.Update(func(s *PostSlugUpsert) {
+		s.UpdateCreatedAt()
+	})
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (u *PostSlugUpsertOne) SetUpdatedAt(v time.Time) *PostSlugUpsertOne {
+	return u.Update(func(s *PostSlugUpsert) {
+		s.SetUpdatedAt(v)
+	})
+}
+
+// UpdateUpdatedAt sets the "updated_at" field to the value that was provided on create.
+func (u *PostSlugUpsertOne) UpdateUpdatedAt() *PostSlugUpsertOne {
+	return u.Update(func(s *PostSlugUpsert) {
+		s.UpdateUpdatedAt()
+	})
+}
+
+// SetDeletedAt sets the "deleted_at" field.
+func (u *PostSlugUpsertOne) SetDeletedAt(v time.Time) *PostSlugUpsertOne {
+	return u.Update(func(s *PostSlugUpsert) {
+		s.SetDeletedAt(v)
+	})
+}
+
+// UpdateDeletedAt sets the "deleted_at" field to the value that was provided on create.
+func (u *PostSlugUpsertOne) UpdateDeletedAt() *PostSlugUpsertOne {
+	return u.Update(func(s *PostSlugUpsert) {
+		s.UpdateDeletedAt()
+	})
+}
+
+// ClearDeletedAt clears the value of the "deleted_at" field.
+func (u *PostSlugUpsertOne) ClearDeletedAt() *PostSlugUpsertOne {
+	return u.Update(func(s *PostSlugUpsert) {
+		s.ClearDeletedAt()
+	})
+}
+
+// SetPostID sets the "post_id" field.
+func (u *PostSlugUpsertOne) SetPostID(v uint) *PostSlugUpsertOne {
+	return u.Update(func(s *PostSlugUpsert) {
+		s.SetPostID(v)
+	})
+}
+
+// UpdatePostID sets the "post_id" field to the value that was provided on create.
+func (u *PostSlugUpsertOne) UpdatePostID() *PostSlugUpsertOne {
+	return u.Update(func(s *PostSlugUpsert) {
+		s.UpdatePostID()
+	})
+}
+
+// SetSlug sets the "slug" field.
+func (u *PostSlugUpsertOne) SetSlug(v string) *PostSlugUpsertOne {
+	return u.Update(func(s *PostSlugUpsert) {
+		s.SetSlug(v)
+	})
+}
+
+// UpdateSlug sets the "slug" field to the value that was provided on create.
+func (u *PostSlugUpsertOne) UpdateSlug() *PostSlugUpsertOne {
+	return u.Update(func(s *PostSlugUpsert) {
+		s.UpdateSlug()
+	})
+}
+
+// Exec executes the query.
+func (u *PostSlugUpsertOne) Exec(ctx context.Context) error {
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for PostSlugCreate.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *PostSlugUpsertOne) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// Exec executes the UPSERT query and returns the inserted/updated ID.
+func (u *PostSlugUpsertOne) ID(ctx context.Context) (id uint, err error) {
+	node, err := u.create.Save(ctx)
+	if err != nil {
+		return id, err
+	}
+	return node.ID, nil
+}
+
+// IDX is like ID, but panics if an error occurs.
+func (u *PostSlugUpsertOne) IDX(ctx context.Context) uint {
+	id, err := u.ID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// PostSlugCreateBulk is the builder for creating many PostSlug entities in bulk.
+type PostSlugCreateBulk struct {
+	config
+	err      error
+	builders []*PostSlugCreate
+	conflict []sql.ConflictOption
+}
+
+// Save creates the PostSlug entities in the database.
+func (_c *PostSlugCreateBulk) Save(ctx context.Context) ([]*PostSlug, error) {
+	if _c.err != nil {
+		return nil, _c.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(_c.builders))
+	nodes := make([]*PostSlug, len(_c.builders))
+	mutators := make([]Mutator, len(_c.builders))
+	for i := range _c.builders {
+		func(i int, root context.Context) {
+			builder := _c.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*PostSlugMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, _c.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					spec.OnConflict = _c.conflict
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, _c.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				if specs[i].ID.Value != nil && nodes[i].ID == 0 {
+					id := specs[i].ID.Value.(int64)
+					nodes[i].ID = uint(id)
+				}
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, _c.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_c *PostSlugCreateBulk) SaveX(ctx context.Context) []*PostSlug {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *PostSlugCreateBulk) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *PostSlugCreateBulk) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.PostSlug.CreateBulk(builders...).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.PostSlugUpsert) {
+//			SetCreatedAt(v+v).
+//		}).
+//		Exec(ctx)
+func (_c *PostSlugCreateBulk) OnConflict(opts ...sql.ConflictOption) *PostSlugUpsertBulk {
+	_c.conflict = opts
+	return &PostSlugUpsertBulk{
+		create: _c,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.PostSlug.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (_c *PostSlugCreateBulk) OnConflictColumns(columns ...string) *PostSlugUpsertBulk {
+	_c.conflict = append(_c.conflict, sql.ConflictColumns(columns...))
+	return &PostSlugUpsertBulk{
+		create: _c,
+	}
+}
+
+// PostSlugUpsertBulk is the builder for "upsert"-ing
+// a bulk of PostSlug nodes.
+type PostSlugUpsertBulk struct {
+	create *PostSlugCreateBulk
+}
+
+// UpdateNewValues updates the mutable fields using the new values that
+// were set on create. Using this option is equivalent to using:
+//
+//	client.PostSlug.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//			sql.ResolveWith(func(u *sql.UpdateSet) {
+//				u.SetIgnore(postslug.FieldID)
+//			}),
+//		).
+//		Exec(ctx)
+func (u *PostSlugUpsertBulk) UpdateNewValues() *PostSlugUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		for _, b := range u.create.builders {
+			if _, exists := b.mutation.ID(); exists {
+				s.SetIgnore(postslug.FieldID)
+			}
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.PostSlug.Create().
+//		OnConflict(sql.ResolveWithIgnore()).
+//		Exec(ctx)
+func (u *PostSlugUpsertBulk) Ignore() *PostSlugUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *PostSlugUpsertBulk) DoNothing() *PostSlugUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the PostSlugCreateBulk.OnConflict
+// documentation for more info.
+func (u *PostSlugUpsertBulk) Update(set func(*PostSlugUpsert)) *PostSlugUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&PostSlugUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (u *PostSlugUpsertBulk) SetCreatedAt(v time.Time) *PostSlugUpsertBulk {
+	return u.Update(func(s *PostSlugUpsert) {
+		s.SetCreatedAt(v)
+	})
+}
+
+// UpdateCreatedAt sets the "created_at" field to the value that was provided on create.
+func (u *PostSlugUpsertBulk) UpdateCreatedAt() *PostSlugUpsertBulk {
+	return u.Update(func(s *PostSlugUpsert) {
+		s.UpdateCreatedAt()
+	})
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (u *PostSlugUpsertBulk) SetUpdatedAt(v time.Time) *PostSlugUpsertBulk {
+	return u.Update(func(s *PostSlugUpsert) {
+		s.SetUpdatedAt(v)
+	})
+}
+
+// UpdateUpdatedAt sets the "updated_at" field to the value that was provided on create.
+func (u *PostSlugUpsertBulk) UpdateUpdatedAt() *PostSlugUpsertBulk {
+	return u.Update(func(s *PostSlugUpsert) {
+		s.UpdateUpdatedAt()
+	})
+}
+
+// SetDeletedAt sets the "deleted_at" field.
+func (u *PostSlugUpsertBulk) SetDeletedAt(v time.Time) *PostSlugUpsertBulk {
+	return u.Update(func(s *PostSlugUpsert) {
+		s.SetDeletedAt(v)
+	})
+}
+
+// UpdateDeletedAt sets the "deleted_at" field to the value that was provided on create.
+func (u *PostSlugUpsertBulk) UpdateDeletedAt() *PostSlugUpsertBulk {
+	return u.Update(func(s *PostSlugUpsert) {
+		s.UpdateDeletedAt()
+	})
+}
+
+// ClearDeletedAt clears the value of the "deleted_at" field.
+func (u *PostSlugUpsertBulk) ClearDeletedAt() *PostSlugUpsertBulk {
+	return u.Update(func(s *PostSlugUpsert) {
+		s.ClearDeletedAt()
+	})
+}
+
+// SetPostID sets the "post_id" field.
+func (u *PostSlugUpsertBulk) SetPostID(v uint) *PostSlugUpsertBulk {
+	return u.Update(func(s *PostSlugUpsert) {
+		s.SetPostID(v)
+	})
+}
+
+// UpdatePostID sets the "post_id" field to the value that was provided on create.
+func (u *PostSlugUpsertBulk) UpdatePostID() *PostSlugUpsertBulk {
+	return u.Update(func(s *PostSlugUpsert) {
+		s.UpdatePostID()
+	})
+}
+
+// SetSlug sets the "slug" field.
+func (u *PostSlugUpsertBulk) SetSlug(v string) *PostSlugUpsertBulk {
+	return u.Update(func(s *PostSlugUpsert) {
+		s.SetSlug(v)
+	})
+}
+
+// UpdateSlug sets the "slug" field to the value that was provided on create.
+func (u *PostSlugUpsertBulk) UpdateSlug() *PostSlugUpsertBulk {
+	return u.Update(func(s *PostSlugUpsert) {
+		s.UpdateSlug()
+	})
+}
+
+// Exec executes the query.
+func (u *PostSlugUpsertBulk) Exec(ctx context.Context) error {
+	if u.create.err != nil {
+		return u.create.err
+	}
+	for i, b := range u.create.builders {
+		if len(b.conflict) != 0 {
+			return fmt.Errorf("ent: OnConflict was set for builder %d. Set it on the PostSlugCreateBulk instead", i)
+		}
+	}
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for PostSlugCreateBulk.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *PostSlugUpsertBulk) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -15,6 +15,12 @@ type Link func(*sql.Selector)
 // LinkCategory is the predicate function for linkcategory builders.
 type LinkCategory func(*sql.Selector)
 
+// Passkey is the predicate function for passkey builders.
+type Passkey func(*sql.Selector)
+
+// PersonalAccessToken is the predicate function for personalaccesstoken builders.
+type PersonalAccessToken func(*sql.Selector)
+
 // Post is the predicate function for post builders.
 type Post func(*sql.Selector)
 
@@ -24,11 +30,23 @@ type PostCategory func(*sql.Selector)
 // PostCategoryRelation is the predicate function for postcategoryrelation builders.
 type PostCategoryRelation func(*sql.Selector)
 
+// PostRevision is the predicate function for postrevision builders.
+type PostRevision func(*sql.Selector)
+
+// PostSlug is the predicate function for postslug builders.
+type PostSlug func(*sql.Selector)
+
 // PostTag is the predicate function for posttag builders.
 type PostTag func(*sql.Selector)
 
 // PostTagRelation is the predicate function for posttagrelation builders.
 type PostTagRelation func(*sql.Selector)
 
+// SigningKey is the predicate function for signingkey builders.
+type SigningKey func(*sql.Selector)
+
 // User is the predicate function for user builders.
 type User func(*sql.Selector)
+
+// UserIdentity is the predicate function for useridentity builders.
+type UserIdentity func(*sql.Selector)
@@ -6,11 +6,17 @@ import (
 	"blog-server/ent/comment"
 	"blog-server/ent/link"
 	"blog-server/ent/linkcategory"
+	"blog-server/ent/passkey"
+	"blog-server/ent/personalaccesstoken"
 	"blog-server/ent/post"
 	"blog-server/ent/postcategory"
+	"blog-server/ent/postrevision"
+	"blog-server/ent/postslug"
 	"blog-server/ent/posttag"
 	"blog-server/ent/schema"
+	"blog-server/ent/signingkey"
 	"blog-server/ent/user"
+	"blog-server/ent/useridentity"
 	"time"
 
 	"github.com/google/uuid"
@@ -33,6 +39,38 @@ func init() {
 	commentDescUpdatedAt := commentMixinFields0[2].Descriptor()
 	// comment.DefaultUpdatedAt holds the default value on creation for the updated_at field.
 	comment.DefaultUpdatedAt = commentDescUpdatedAt.Default.(func() time.Time)
+	// commentDescAuthorName is the schema descriptor for author_name field.
+	commentDescAuthorName := commentFields[4].Descriptor()
+	// comment.AuthorNameValidator is a validator for the "author_name" field. It is called by the builders before save.
+	comment.AuthorNameValidator = commentDescAuthorName.Validators[0].(func(string) error)
+	// commentDescAuthorEmail is the schema descriptor for author_email field.
+	commentDescAuthorEmail := commentFields[5].Descriptor()
+	// comment.AuthorEmailValidator is a validator for the "author_email" field. It is called by the builders before save.
+	comment.AuthorEmailValidator = commentDescAuthorEmail.Validators[0].(func(string) error)
+	// commentDescAuthorWebsite is the schema descriptor for author_website field.
+	commentDescAuthorWebsite := commentFields[6].Descriptor()
+	// comment.AuthorWebsiteValidator is a validator for the "author_website" field. It is called by the builders before save.
+	comment.AuthorWebsiteValidator = commentDescAuthorWebsite.Validators[0].(func(string) error)
+	// commentDescNotifyOnReply is the schema descriptor for notify_on_reply field.
+	commentDescNotifyOnReply := commentFields[9].Descriptor()
+	// comment.DefaultNotifyOnReply holds the default value on creation for the notify_on_reply field.
+	comment.DefaultNotifyOnReply = commentDescNotifyOnReply.Default.(bool)
+	// commentDescSpamReason is the schema descriptor for spam_reason field.
+	commentDescSpamReason := commentFields[10].Descriptor()
+	// comment.SpamReasonValidator is a validator for the "spam_reason" field. It is called by the builders before save.
+	comment.SpamReasonValidator = commentDescSpamReason.Validators[0].(func(string) error)
+	// commentDescIP is the schema descriptor for ip field.
+	commentDescIP := commentFields[11].Descriptor()
+	// comment.DefaultIP holds the default value on creation for the ip field.
+	comment.DefaultIP = commentDescIP.Default.(string)
+	// comment.IPValidator is a validator for the "ip" field. It is called by the builders before save.
+	comment.IPValidator = commentDescIP.Validators[0].(func(string) error)
+	// commentDescUserAgent is the schema descriptor for user_agent field.
+	commentDescUserAgent := commentFields[12].Descriptor()
+	// comment.DefaultUserAgent holds the default value on creation for the user_agent field.
+	comment.DefaultUserAgent = commentDescUserAgent.Default.(string)
+	// comment.UserAgentValidator is a validator for the "user_agent" field. It is called by the builders before save.
+	comment.UserAgentValidator = commentDescUserAgent.Validators[0].(func(string) error)
 	linkMixin := schema.Link{}.Mixin()
 	linkMixinFields0 := linkMixin[0].Fields()
 	_ = linkMixinFields0
@@ -91,6 +129,64 @@ func init() {
 	linkcategoryDescSortOrder := linkcategoryFields[1].Descriptor()
 	// linkcategory.DefaultSortOrder holds the default value on creation for the sort_order field.
 	linkcategory.DefaultSortOrder = linkcategoryDescSortOrder.Default.(int)
+	passkeyMixin := schema.Passkey{}.Mixin()
+	passkeyMixinFields0 := passkeyMixin[0].Fields()
+	_ = passkeyMixinFields0
+	passkeyFields := schema.Passkey{}.Fields()
+	_ = passkeyFields
+	// passkeyDescCreatedAt is the schema descriptor for created_at field.
+	passkeyDescCreatedAt := passkeyMixinFields0[1].Descriptor()
+	// passkey.DefaultCreatedAt holds the default value on creation for the created_at field.
+	passkey.DefaultCreatedAt = passkeyDescCreatedAt.Default.(func() time.Time)
+	// passkeyDescUpdatedAt is the schema descriptor for updated_at field.
+	passkeyDescUpdatedAt := passkeyMixinFields0[2].Descriptor()
+	// passkey.DefaultUpdatedAt holds the default value on creation for the updated_at field.
+	passkey.DefaultUpdatedAt = passkeyDescUpdatedAt.Default.(func() time.Time)
+	// passkeyDescCredentialID is the schema descriptor for credential_id field.
+	passkeyDescCredentialID := passkeyFields[1].Descriptor()
+	// passkey.CredentialIDValidator is a validator for the "credential_id" field. It is called by the builders before save.
+	passkey.CredentialIDValidator = passkeyDescCredentialID.Validators[0].(func(string) error)
+	// passkeyDescSignCount is the schema descriptor for sign_count field.
+	passkeyDescSignCount := passkeyFields[4].Descriptor()
+	// passkey.DefaultSignCount holds the default value on creation for the sign_count field.
+	passkey.DefaultSignCount = passkeyDescSignCount.Default.(uint32)
+	// passkeyDescName is the schema descriptor for name field.
+	passkeyDescName := passkeyFields[5].Descriptor()
+	// passkey.NameValidator is a validator for the "name" field. It is called by the builders before save.
+	passkey.NameValidator = passkeyDescName.Validators[0].(func(string) error)
+	// passkeyDescAaguid is the schema descriptor for aaguid field.
+	passkeyDescAaguid := passkeyFields[6].Descriptor()
+	// passkey.AaguidValidator is a validator for the "aaguid" field. It is called by the builders before save.
+	passkey.AaguidValidator = passkeyDescAaguid.Validators[0].(func(string) error)
+	personalaccesstokenMixin := schema.PersonalAccessToken{}.Mixin()
+	personalaccesstokenMixinFields0 := personalaccesstokenMixin[0].Fields()
+	_ = personalaccesstokenMixinFields0
+	personalaccesstokenFields := schema.PersonalAccessToken{}.Fields()
+	_ = personalaccesstokenFields
+	// personalaccesstokenDescCreatedAt is the schema descriptor for created_at field.
+	personalaccesstokenDescCreatedAt := personalaccesstokenMixinFields0[1].Descriptor()
+	// personalaccesstoken.DefaultCreatedAt holds the default value on creation for the created_at field.
+	personalaccesstoken.DefaultCreatedAt = personalaccesstokenDescCreatedAt.Default.(func() time.Time)
+	// personalaccesstokenDescUpdatedAt is the schema descriptor for updated_at field.
+	personalaccesstokenDescUpdatedAt := personalaccesstokenMixinFields0[2].Descriptor()
+	// personalaccesstoken.DefaultUpdatedAt holds the default value on creation for the updated_at field.
+	personalaccesstoken.DefaultUpdatedAt = personalaccesstokenDescUpdatedAt.Default.(func() time.Time)
+	// personalaccesstokenDescName is the schema descriptor for name field.
+	personalaccesstokenDescName := personalaccesstokenFields[1].Descriptor()
+	// personalaccesstoken.NameValidator is a validator for the "name" field. It is called by the builders before save.
+	personalaccesstoken.NameValidator = personalaccesstokenDescName.Validators[0].(func(string) error)
+	// personalaccesstokenDescTokenHash is the schema descriptor for token_hash field.
+	personalaccesstokenDescTokenHash := personalaccesstokenFields[2].Descriptor()
+	// personalaccesstoken.TokenHashValidator is a validator for the "token_hash" field. It is called by the builders before save.
+	personalaccesstoken.TokenHashValidator = personalaccesstokenDescTokenHash.Validators[0].(func(string) error)
+	// personalaccesstokenDescTokenPrefix is the schema descriptor for token_prefix field.
+	personalaccesstokenDescTokenPrefix := personalaccesstokenFields[3].Descriptor()
+	// personalaccesstoken.TokenPrefixValidator is a validator for the "token_prefix" field. It is called by the builders before save.
+	personalaccesstoken.TokenPrefixValidator = personalaccesstokenDescTokenPrefix.Validators[0].(func(string) error)
+	// personalaccesstokenDescTwoFactor is the schema descriptor for two_factor field.
+	personalaccesstokenDescTwoFactor := personalaccesstokenFields[6].Descriptor()
+	// personalaccesstoken.DefaultTwoFactor holds the default value on creation for the two_factor field.
+	personalaccesstoken.DefaultTwoFactor = personalaccesstokenDescTwoFactor.Default.(bool)
 	postMixin := schema.Post{}.Mixin()
 	postMixinFields0 := postMixin[0].Fields()
 	_ = postMixinFields0
@@ -108,16 +204,20 @@ func init() {
 	postDescTitle := postFields[1].Descriptor()
 	// post.TitleValidator is a validator for the "title" field. It is called by the builders before save.
 	post.TitleValidator = postDescTitle.Validators[0].(func(string) error)
+	// postDescSlug is the schema descriptor for slug field.
+	postDescSlug := postFields[2].Descriptor()
+	// post.SlugValidator is a validator for the "slug" field. It is called by the builders before save.
+	post.SlugValidator = postDescSlug.Validators[0].(func(string) error)
 	// postDescSummary is the schema descriptor for summary field.
-	postDescSummary := postFields[2].Descriptor()
+	postDescSummary := postFields[3].Descriptor()
 	// post.SummaryValidator is a validator for the "summary" field. It is called by the builders before save.
 	post.SummaryValidator = postDescSummary.Validators[0].(func(string) error)
 	// postDescCover is the schema descriptor for cover field.
-	postDescCover := postFields[4].Descriptor()
+	postDescCover := postFields[5].Descriptor()
 	// post.CoverValidator is a validator for the "cover" field. It is called by the builders before save.
 	post.CoverValidator = postDescCover.Validators[0].(func(string) error)
 	// postDescViewCount is the schema descriptor for view_count field.
-	postDescViewCount := postFields[6].Descriptor()
+	postDescViewCount := postFields[7].Descriptor()
 	// post.DefaultViewCount holds the default value on creation for the view_count field.
 	post.DefaultViewCount = postDescViewCount.Default.(uint)
 	postcategoryMixin := schema.PostCategory{}.Mixin()
@@ -141,6 +241,44 @@ func init() {
 	postcategoryDescSlug := postcategoryFields[1].Descriptor()
 	// postcategory.SlugValidator is a validator for the "slug" field. It is called by the builders before save.
 	postcategory.SlugValidator = postcategoryDescSlug.Validators[0].(func(string) error)
+	postrevisionMixin := schema.PostRevision{}.Mixin()
+	postrevisionMixinFields0 := postrevisionMixin[0].Fields()
+	_ = postrevisionMixinFields0
+	postrevisionFields := schema.PostRevision{}.Fields()
+	_ = postrevisionFields
+	// postrevisionDescCreatedAt is the schema descriptor for created_at field.
+	postrevisionDescCreatedAt := postrevisionMixinFields0[1].Descriptor()
+	// postrevision.DefaultCreatedAt holds the default value on creation for the created_at field.
+	postrevision.DefaultCreatedAt = postrevisionDescCreatedAt.Default.(func() time.Time)
+	// postrevisionDescUpdatedAt is the schema descriptor for updated_at field.
+	postrevisionDescUpdatedAt := postrevisionMixinFields0[2].Descriptor()
+	// postrevision.DefaultUpdatedAt holds the default value on creation for the updated_at field.
+	postrevision.DefaultUpdatedAt = postrevisionDescUpdatedAt.Default.(func() time.Time)
+	// postrevisionDescTitle is the schema descriptor for title field.
+	postrevisionDescTitle := postrevisionFields[3].Descriptor()
+	// postrevision.TitleValidator is a validator for the "title" field. It is called by the builders before save.
+	postrevision.TitleValidator = postrevisionDescTitle.Validators[0].(func(string) error)
+	// postrevisionDescSummary is the schema descriptor for summary field.
+	postrevisionDescSummary := postrevisionFields[4].Descriptor()
+	// postrevision.SummaryValidator is a validator for the "summary" field. It is called by the builders before save.
+	postrevision.SummaryValidator = postrevisionDescSummary.Validators[0].(func(string) error)
+	postslugMixin := schema.PostSlug{}.Mixin()
+	postslugMixinFields0 := postslugMixin[0].Fields()
+	_ = postslugMixinFields0
+	postslugFields := schema.PostSlug{}.Fields()
+	_ = postslugFields
+	// postslugDescCreatedAt is the schema descriptor for created_at field.
+	postslugDescCreatedAt := postslugMixinFields0[1].Descriptor()
+	// postslug.DefaultCreatedAt holds the default value on creation for the created_at field.
+	postslug.DefaultCreatedAt = postslugDescCreatedAt.Default.(func() time.Time)
+	// postslugDescUpdatedAt is the schema descriptor for updated_at field.
+	postslugDescUpdatedAt := postslugMixinFields0[2].Descriptor()
+	// postslug.DefaultUpdatedAt holds the default value on creation for the updated_at field.
+	postslug.DefaultUpdatedAt = postslugDescUpdatedAt.Default.(func() time.Time)
+	// postslugDescSlug is the schema descriptor for slug field.
+	postslugDescSlug := postslugFields[1].Descriptor()
+	// postslug.SlugValidator is a validator for the "slug" field. It is called by the builders before save.
+	postslug.SlugValidator = postslugDescSlug.Validators[0].(func(string) error)
 	posttagMixin := schema.PostTag{}.Mixin()
 	posttagMixinFields0 := posttagMixin[0].Fields()
 	_ = posttagMixinFields0
@@ -162,6 +300,27 @@ func init() {
 	posttagDescSlug := posttagFields[1].Descriptor()
 	// posttag.SlugValidator is a validator for the "slug" field. It is called by the builders before save.
 	posttag.SlugValidator = posttagDescSlug.Validators[0].(func(string) error)
+	signingkeyMixin := schema.SigningKey{}.Mixin()
+	signingkeyMixinFields0 := signingkeyMixin[0].Fields()
+	_ = signingkeyMixinFields0
+	signingkeyFields := schema.SigningKey{}.Fields()
+	_ = signingkeyFields
+	// signingkeyDescCreatedAt is the schema descriptor for created_at field.
+	signingkeyDescCreatedAt := signingkeyMixinFields0[1].Descriptor()
+	// signingkey.DefaultCreatedAt holds the default value on creation for the created_at field.
+	signingkey.DefaultCreatedAt = signingkeyDescCreatedAt.Default.(func() time.Time)
+	// signingkeyDescUpdatedAt is the schema descriptor for updated_at field.
+	signingkeyDescUpdatedAt := signingkeyMixinFields0[2].Descriptor()
+	// signingkey.DefaultUpdatedAt holds the default value on creation for the updated_at field.
+	signingkey.DefaultUpdatedAt = signingkeyDescUpdatedAt.Default.(func() time.Time)
+	// signingkeyDescKid is the schema descriptor for kid field.
+	signingkeyDescKid := signingkeyFields[0].Descriptor()
+	// signingkey.KidValidator is a validator for the "kid" field. It is called by the builders before save.
+	signingkey.KidValidator = signingkeyDescKid.Validators[0].(func(string) error)
+	// signingkeyDescAlgorithm is the schema descriptor for algorithm field.
+	signingkeyDescAlgorithm := signingkeyFields[1].Descriptor()
+	// signingkey.AlgorithmValidator is a validator for the "algorithm" field. It is called by the builders before save.
+	signingkey.AlgorithmValidator = signingkeyDescAlgorithm.Validators[0].(func(string) error)
 	userMixin := schema.User{}.Mixin()
 	userMixinFields0 := userMixin[0].Fields()
 	_ = userMixinFields0
@@ -192,7 +351,44 @@ func init() {
 	// user.PasswordValidator is a validator for the "password" field. It is called by the builders before save.
 	user.PasswordValidator = userDescPassword.Validators[0].(func(string) error)
 	// userDescUsername is the schema descriptor for username field.
-	userDescUsername := userFields[5].Descriptor()
+	userDescUsername := userFields[6].Descriptor()
 	// user.UsernameValidator is a validator for the "username" field. It is called by the builders before save.
 	user.UsernameValidator = userDescUsername.Validators[0].(func(string) error)
+	// userDescBio is the schema descriptor for bio field.
+	userDescBio := userFields[7].Descriptor()
+	// user.BioValidator is a validator for the "bio" field. It is called by the builders before save.
+	user.BioValidator = userDescBio.Validators[0].(func(string) error)
+	// userDescTotpSecret is the schema descriptor for totp_secret field.
+	userDescTotpSecret := userFields[8].Descriptor()
+	// user.TotpSecretValidator is a validator for the "totp_secret" field. It is called by the builders before save.
+	user.TotpSecretValidator = userDescTotpSecret.Validators[0].(func(string) error)
+	// userDescTotpEnabled is the schema descriptor for totp_enabled field.
+	userDescTotpEnabled := userFields[9].Descriptor()
+	// user.DefaultTotpEnabled holds the default value on creation for the totp_enabled field.
+	user.DefaultTotpEnabled = userDescTotpEnabled.Default.(bool)
+	useridentityMixin := schema.UserIdentity{}.Mixin()
+	useridentityMixinFields0 := useridentityMixin[0].Fields()
+	_ = useridentityMixinFields0
+	useridentityFields := schema.UserIdentity{}.Fields()
+	_ = useridentityFields
+	// useridentityDescCreatedAt is the schema descriptor for created_at field.
+	useridentityDescCreatedAt := useridentityMixinFields0[1].Descriptor()
+	// useridentity.DefaultCreatedAt holds the default value on creation for the created_at field.
+	useridentity.DefaultCreatedAt = useridentityDescCreatedAt.Default.(func() time.Time)
+	// useridentityDescUpdatedAt is the schema descriptor for updated_at field.
+	useridentityDescUpdatedAt := useridentityMixinFields0[2].Descriptor()
+	// useridentity.DefaultUpdatedAt holds the default value on creation for the updated_at field.
+	useridentity.DefaultUpdatedAt = useridentityDescUpdatedAt.Default.(func() time.Time)
+	// useridentityDescProvider is the schema descriptor for provider field.
+	useridentityDescProvider := useridentityFields[1].Descriptor()
+	// useridentity.ProviderValidator is a validator for the "provider" field. It is called by the builders before save.
+	useridentity.ProviderValidator = useridentityDescProvider.Validators[0].(func(string) error)
+	// useridentityDescSubject is the schema descriptor for subject field.
+	useridentityDescSubject := useridentityFields[2].Descriptor()
+	// useridentity.SubjectValidator is a validator for the "subject" field. It is called by the builders before save.
+	useridentity.SubjectValidator = useridentityDescSubject.Validators[0].(func(string) error)
+	// useridentityDescEmail is the schema descriptor for email field.
+	useridentityDescEmail := useridentityFields[3].Descriptor()
+	// useridentity.EmailValidator is a validator for the "email" field. It is called by the builders before save.
+	useridentity.EmailValidator = useridentityDescEmail.Validators[0].(func(string) error)
 }
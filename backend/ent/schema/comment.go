@@ -1,7 +1,12 @@
 package schema
 
 import (
+	"blog-server/entity"
+
 	"entgo.io/ent"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
 )
 
 // Comment holds the schema definition for the Comment entity.
@@ -17,10 +22,85 @@ func (Comment) Mixin() []ent.Mixin {
 
 // Fields of the Comment.
 func (Comment) Fields() []ent.Field {
-	return []ent.Field{}
+	return []ent.Field{
+		field.Uint("post_id"),
+
+		field.Uint("parent_id").
+			Optional().
+			Nillable(),
+
+		field.Uint("root_id").
+			Optional().
+			Nillable(),
+
+		field.Uint("user_id").
+			Optional().
+			Nillable(),
+
+		field.String("author_name").
+			MaxLen(50),
+
+		field.String("author_email").
+			MaxLen(100).
+			Optional().
+			Nillable(),
+
+		field.String("author_website").
+			MaxLen(255).
+			Optional().
+			Nillable(),
+
+		field.Text("content"),
+
+		field.Enum("status").
+			GoType(entity.CommentStatus("")).
+			Default(string(entity.CommentStatusPending)),
+
+		// notify_on_reply asks for an email when someone replies to the comment.
+		field.Bool("notify_on_reply").
+			Default(false),
+
+		// spam_reason records why the spam filters flagged the comment.
+		field.String("spam_reason").
+			MaxLen(512).
+			Optional().
+			Nillable(),
+
+		field.String("ip").
+			MaxLen(64).
+			Default(""),
+
+		field.String("user_agent").
+			MaxLen(512).
+			Default(""),
+	}
 }
 
 // Edges of the Comment.
 func (Comment) Edges() []ent.Edge {
-	return []ent.Edge{}
+	return []ent.Edge{
+		edge.From("post", Post.Type).
+			Ref("comments").
+			Field("post_id").
+			Unique().
+			Required(),
+
+		edge.From("author", User.Type).
+			Ref("comments").
+			Field("user_id").
+			Unique(),
+
+		edge.To("replies", Comment.Type).
+			From("parent").
+			Field("parent_id").
+			Unique(),
+	}
+}
+
+// Indexes of the Comment.
+func (Comment) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("post_id", "status"),
+		index.Fields("root_id"),
+	}
 }
@@ -0,0 +1,70 @@
+package schema
+
+import (
+	"entgo.io/ent"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+)
+
+// Passkey holds the schema definition for the Passkey entity.
+//
+// Each row is a WebAuthn credential a user can log in with instead of a password.
+type Passkey struct {
+	ent.Schema
+}
+
+func (Passkey) Mixin() []ent.Mixin {
+	return []ent.Mixin{
+		TimeMixin{},
+	}
+}
+
+// Fields of the Passkey.
+func (Passkey) Fields() []ent.Field {
+	return []ent.Field{
+		field.Uint("user_id").
+			Immutable(),
+
+		// Base64url encoded credential ID chosen by the authenticator.
+		field.String("credential_id").
+			MaxLen(1366).
+			Unique().
+			Immutable(),
+
+		// COSE encoded public key.
+		field.Bytes("public_key").
+			Immutable(),
+
+		field.Int("algorithm").
+			Immutable(),
+
+		field.Uint32("sign_count").
+			Default(0),
+
+		field.String("name").
+			MaxLen(64),
+
+		field.String("aaguid").
+			MaxLen(36).
+			Optional(),
+
+		field.Strings("transports").
+			Optional(),
+
+		field.Time("last_used_at").
+			Optional().
+			Nillable(),
+	}
+}
+
+// Edges of the Passkey.
+func (Passkey) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.From("user", User.Type).
+			Ref("passkeys").
+			Field("user_id").
+			Unique().
+			Required().
+			Immutable(),
+	}
+}
@@ -0,0 +1,85 @@
+package schema
+
+import (
+	"entgo.io/ent"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+)
+
+// PersonalAccessToken holds the schema definition for the PersonalAccessToken entity.
+//
+// Each row is a long-lived, scoped API credential of a user. Only the hash of
+// the token is stored; the token itself is shown once when created.
+type PersonalAccessToken struct {
+	ent.Schema
+}
+
+func (PersonalAccessToken) Mixin() []ent.Mixin {
+	return []ent.Mixin{
+		TimeMixin{},
+	}
+}
+
+// Fields of the PersonalAccessToken.
+func (PersonalAccessToken) Fields() []ent.Field {
+	return []ent.Field{
+		field.Uint("user_id").
+			Immutable(),
+
+		field.String("name").
+			MaxLen(64),
+
+		// SHA-256 hash of the token.
+		field.String("token_hash").
+			Sensitive().
+			MaxLen(64).
+			Unique().
+			Immutable(),
+
+		// Leading characters of the token, to tell tokens apart in listings.
+		field.String("token_prefix").
+			MaxLen(16).
+			Immutable(),
+
+		// Permissions in "resource:action" form.
+		field.Strings("scopes").
+			Immutable(),
+
+		field.Time("expires_at").
+			Immutable(),
+
+		// Whether the session that created the token passed a second factor.
+		// Roles that must use one only act through tokens created that way.
+		field.Bool("two_factor").
+			Default(false).
+			Immutable(),
+
+		field.Time("last_used_at").
+			Optional().
+			Nillable(),
+
+		field.Time("revoked_at").
+			Optional().
+			Nillable(),
+	}
+}
+
+// Edges of the PersonalAccessToken.
+func (PersonalAccessToken) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.From("user", User.Type).
+			Ref("access_tokens").
+			Field("user_id").
+			Unique().
+			Required().
+			Immutable(),
+	}
+}
+
+// Indexes of the PersonalAccessToken.
+func (PersonalAccessToken) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("user_id"),
+	}
+}
@@ -4,8 +4,11 @@ import (
 	"blog-server/entity"
 
 	"entgo.io/ent"
+	"entgo.io/ent/dialect"
+	"entgo.io/ent/dialect/entsql"
 	"entgo.io/ent/schema/edge"
 	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
 )
 
 // Post holds the schema definition for the Post entity.
@@ -27,6 +30,13 @@ func (Post) Fields() []ent.Field {
 		field.String("title").
 			MaxLen(255),
 
+		// Optional so that posts created before slugs existed keep working;
+		// they are given one on startup.
+		field.String("slug").
+			MaxLen(255).
+			Optional().
+			Unique(),
+
 		field.String("summary").
 			MaxLen(255).
 			Optional().
@@ -51,6 +61,14 @@ func (Post) Fields() []ent.Field {
 		field.Time("published_at").
 			Optional().
 			Nillable(),
+
+		// Weighted full-text index over the title, summary and content,
+		// written by the post service from utils.SearchDocument.
+		field.String("search_vector").
+			SchemaType(map[string]string{dialect.Postgres: "tsvector"}).
+			Optional().
+			Nillable().
+			Sensitive(),
 	}
 }
 
@@ -68,5 +86,19 @@ func (Post) Edges() []ent.Edge {
 
 		edge.To("tags", PostTag.Type).
 			Through("post_tag_relations", PostTagRelation.Type),
+
+		edge.To("comments", Comment.Type),
+
+		edge.To("slug_history", PostSlug.Type),
+
+		edge.To("revisions", PostRevision.Type),
+	}
+}
+
+// Indexes of the Post.
+func (Post) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("search_vector").
+			Annotations(entsql.IndexType("GIN")),
 	}
 }
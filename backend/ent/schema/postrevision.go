@@ -0,0 +1,85 @@
+package schema
+
+import (
+	"entgo.io/ent"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+)
+
+// PostRevision holds the schema definition for the PostRevision entity.
+//
+// Each row is a snapshot of the title, summary and content of a post as saved
+// by one edit. Versions count up per post, so the latest revision matches the
+// post itself.
+type PostRevision struct {
+	ent.Schema
+}
+
+func (PostRevision) Mixin() []ent.Mixin {
+	return []ent.Mixin{
+		TimeMixin{},
+	}
+}
+
+// Fields of the PostRevision.
+func (PostRevision) Fields() []ent.Field {
+	return []ent.Field{
+		field.Uint("post_id").
+			Immutable(),
+
+		field.Uint("version").
+			Immutable(),
+
+		// The user who saved the revision.
+		field.Uint("user_id").
+			Optional().
+			Nillable().
+			Immutable(),
+
+		field.String("title").
+			MaxLen(255).
+			Immutable(),
+
+		field.String("summary").
+			MaxLen(255).
+			Optional().
+			Nillable().
+			Immutable(),
+
+		field.Text("content").
+			Immutable(),
+
+		// The version this revision restored, if it was created by a restore.
+		field.Uint("restored_from").
+			Optional().
+			Nillable().
+			Immutable(),
+	}
+}
+
+// Edges of the PostRevision.
+func (PostRevision) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.From("post", Post.Type).
+			Ref("revisions").
+			Field("post_id").
+			Unique().
+			Required().
+			Immutable(),
+
+		edge.From("user", User.Type).
+			Ref("post_revisions").
+			Field("user_id").
+			Unique().
+			Immutable(),
+	}
+}
+
+// Indexes of the PostRevision.
+func (PostRevision) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("post_id", "version").
+			Unique(),
+	}
+}
@@ -0,0 +1,51 @@
+package schema
+
+import (
+	"entgo.io/ent"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+)
+
+// PostSlug holds the schema definition for the PostSlug entity.
+//
+// Each row is a slug a post used before, kept so that old URLs redirect to
+// the current slug of the post.
+type PostSlug struct {
+	ent.Schema
+}
+
+func (PostSlug) Mixin() []ent.Mixin {
+	return []ent.Mixin{
+		TimeMixin{},
+	}
+}
+
+// Fields of the PostSlug.
+func (PostSlug) Fields() []ent.Field {
+	return []ent.Field{
+		field.Uint("post_id"),
+
+		field.String("slug").
+			MaxLen(255).
+			Unique(),
+	}
+}
+
+// Edges of the PostSlug.
+func (PostSlug) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.From("post", Post.Type).
+			Ref("slug_history").
+			Field("post_id").
+			Unique().
+			Required(),
+	}
+}
+
+// Indexes of the PostSlug.
+func (PostSlug) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("post_id"),
+	}
+}
@@ -0,0 +1,57 @@
+package schema
+
+import (
+	"entgo.io/ent"
+	"entgo.io/ent/schema/field"
+)
+
+// SigningKey holds the schema definition for the SigningKey entity.
+//
+// Each row is a key pair used to sign JWTs. Only the newest active key signs;
+// a new key is published before it activates, and retired keys are kept
+// until every token they signed has expired.
+type SigningKey struct {
+	ent.Schema
+}
+
+func (SigningKey) Mixin() []ent.Mixin {
+	return []ent.Mixin{
+		TimeMixin{},
+	}
+}
+
+// Fields of the SigningKey.
+func (SigningKey) Fields() []ent.Field {
+	return []ent.Field{
+		field.String("kid").
+			MaxLen(64).
+			Unique().
+			Immutable(),
+
+		field.String("algorithm").
+			MaxLen(16).
+			Immutable(),
+
+		field.Text("private_key").
+			Sensitive().
+			Immutable(),
+
+		field.Text("public_key").
+			Immutable(),
+
+		// activates_at is when the key starts signing; nil means on creation.
+		field.Time("activates_at").
+			Optional().
+			Nillable().
+			Immutable(),
+
+		field.Time("retired_at").
+			Optional().
+			Nillable(),
+	}
+}
+
+// Edges of the SigningKey.
+func (SigningKey) Edges() []ent.Edge {
+	return nil
+}
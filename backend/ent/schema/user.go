@@ -44,8 +44,32 @@ func (User) Fields() []ent.Field {
 			GoType(entity.UserRole("")).
 			Default(string(entity.UserRoleReader)),
 
+		field.Enum("status").
+			GoType(entity.UserStatus("")).
+			Default(string(entity.UserStatusActive)),
+
 		field.String("username").
-			MaxLen(50),
+			MaxLen(50).
+			Unique(),
+
+		field.String("bio").
+			MaxLen(500).
+			Optional().
+			Nillable(),
+
+		field.String("totp_secret").
+			Sensitive().
+			MaxLen(64).
+			Optional().
+			Nillable(),
+
+		field.Bool("totp_enabled").
+			Default(false),
+
+		// SHA-256 hashes of the unused recovery codes.
+		field.Strings("totp_recovery_codes").
+			Sensitive().
+			Optional(),
 	}
 }
 
@@ -54,5 +78,20 @@ func (User) Edges() []ent.Edge {
 	return []ent.Edge{
 		edge.
 			To("posts", Post.Type),
+
+		edge.
+			To("comments", Comment.Type),
+
+		edge.
+			To("passkeys", Passkey.Type),
+
+		edge.
+			To("identities", UserIdentity.Type),
+
+		edge.
+			To("access_tokens", PersonalAccessToken.Type),
+
+		edge.
+			To("post_revisions", PostRevision.Type),
 	}
 }
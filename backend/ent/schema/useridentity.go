@@ -0,0 +1,69 @@
+package schema
+
+import (
+	"entgo.io/ent"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+)
+
+// UserIdentity holds the schema definition for the UserIdentity entity.
+//
+// Each row links an account at an external login provider to a user.
+type UserIdentity struct {
+	ent.Schema
+}
+
+func (UserIdentity) Mixin() []ent.Mixin {
+	return []ent.Mixin{
+		TimeMixin{},
+	}
+}
+
+// Fields of the UserIdentity.
+func (UserIdentity) Fields() []ent.Field {
+	return []ent.Field{
+		field.Uint("user_id").
+			Immutable(),
+
+		// Provider name as configured, e.g. "github".
+		field.String("provider").
+			MaxLen(32).
+			Immutable(),
+
+		// Stable account ID at the provider.
+		field.String("subject").
+			MaxLen(255).
+			Immutable(),
+
+		// Email reported by the provider when the account was linked.
+		field.String("email").
+			MaxLen(100).
+			Optional(),
+
+		field.Time("last_login_at").
+			Optional().
+			Nillable(),
+	}
+}
+
+// Edges of the UserIdentity.
+func (UserIdentity) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.From("user", User.Type).
+			Ref("identities").
+			Field("user_id").
+			Unique().
+			Required().
+			Immutable(),
+	}
+}
+
+// Indexes of the UserIdentity.
+func (UserIdentity) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("provider", "subject").
+			Unique(),
+		index.Fields("user_id"),
+	}
+}
@@ -0,0 +1,202 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"blog-server/ent/signingkey"
+	"fmt"
+	"strings"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+)
+
+// SigningKey is the model entity for the SigningKey schema.
+type SigningKey struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID uint `json:"id,omitempty"`
+	// CreatedAt holds the value of the "created_at" field.
+	CreatedAt time.Time `json:"created_at,omitempty"`
+	// UpdatedAt holds the value of the "updated_at" field.
+	UpdatedAt time.Time `json:"updated_at,omitempty"`
+	// DeletedAt holds the value of the "deleted_at" field.
+	DeletedAt *time.Time `json:"deleted_at,omitempty"`
+	// Kid holds the value of the "kid" field.
+	Kid string `json:"kid,omitempty"`
+	// Algorithm holds the value of the "algorithm" field.
+	Algorithm string `json:"algorithm,omitempty"`
+	// PrivateKey holds the value of the "private_key" field.
+	PrivateKey string `json:"-"`
+	// PublicKey holds the value of the "public_key" field.
+	PublicKey string `json:"public_key,omitempty"`
+	// ActivatesAt holds the value of the "activates_at" field.
+	ActivatesAt *time.Time `json:"activates_at,omitempty"`
+	// RetiredAt holds the value of the "retired_at" field.
+	RetiredAt    *time.Time `json:"retired_at,omitempty"`
+	selectValues sql.SelectValues
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*SigningKey) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case signingkey.FieldID:
+			values[i] = new(sql.NullInt64)
+		case signingkey.FieldKid, signingkey.FieldAlgorithm, signingkey.FieldPrivateKey, signingkey.FieldPublicKey:
+			values[i] = new(sql.NullString)
+		case signingkey.FieldCreatedAt, signingkey.FieldUpdatedAt, signingkey.FieldDeletedAt, signingkey.FieldActivatesAt, signingkey.FieldRetiredAt:
+			values[i] = new(sql.NullTime)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the SigningKey fields.
+func (_m *SigningKey) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case signingkey.FieldID:
+			value, ok := values[i].(*sql.NullInt64)
+			if !ok {
+				return fmt.Errorf("unexpected type %T for field id", value)
+			}
+			_m.ID = uint(value.Int64)
+		case signingkey.FieldCreatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field created_at", values[i])
+			} else if value.Valid {
+				_m.CreatedAt = value.Time
+			}
+		case signingkey.FieldUpdatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field updated_at", values[i])
+			} else if value.Valid {
+				_m.UpdatedAt = value.Time
+			}
+		case signingkey.FieldDeletedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field deleted_at", values[i])
+			} else if value.Valid {
+				_m.DeletedAt = new(time.Time)
+				*_m.DeletedAt = value.Time
+			}
+		case signingkey.FieldKid:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field kid", values[i])
+			} else if value.Valid {
+				_m.Kid = value.String
+			}
+		case signingkey.FieldAlgorithm:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field algorithm", values[i])
+			} else if value.Valid {
+				_m.Algorithm = value.String
+			}
+		case signingkey.FieldPrivateKey:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field private_key", values[i])
+			} else if value.Valid {
+				_m.PrivateKey = value.String
+			}
+		case signingkey.FieldPublicKey:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field public_key", values[i])
+			} else if value.Valid {
+				_m.PublicKey = value.String
+			}
+		case signingkey.FieldActivatesAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field activates_at", values[i])
+			} else if value.Valid {
+				_m.ActivatesAt = new(time.Time)
+				*_m.ActivatesAt = value.Time
+			}
+		case signingkey.FieldRetiredAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field retired_at", values[i])
+			} else if value.Valid {
+				_m.RetiredAt = new(time.Time)
+				*_m.RetiredAt = value.Time
+			}
+		default:
+			_m.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the SigningKey.
+// This includes values selected through modifiers, order, etc.
+func (_m *SigningKey) Value(name string) (ent.Value, error) {
+	return _m.selectValues.Get(name)
+}
+
+// Update returns a builder for updating this SigningKey.
+// Note that you need to call SigningKey.Unwrap() before calling this method if this SigningKey
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (_m *SigningKey) Update() *SigningKeyUpdateOne {
+	return NewSigningKeyClient(_m.config).UpdateOne(_m)
+}
+
+// Unwrap unwraps the SigningKey entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (_m *SigningKey) Unwrap() *SigningKey {
+	_tx, ok := _m.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: SigningKey is not a transactional entity")
+	}
+	_m.config.driver = _tx.drv
+	return _m
+}
+
+// String implements the fmt.Stringer.
+func (_m *SigningKey) String() string {
+	var builder strings.Builder
+	builder.WriteString("SigningKey(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", _m.ID))
+	builder.WriteString("created_at=")
+	builder.WriteString(_m.CreatedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("updated_at=")
+	builder.WriteString(_m.UpdatedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	if v := _m.DeletedAt; v != nil {
+		builder.WriteString("deleted_at=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteString(", ")
+	builder.WriteString("kid=")
+	builder.WriteString(_m.Kid)
+	builder.WriteString(", ")
+	builder.WriteString("algorithm=")
+	builder.WriteString(_m.Algorithm)
+	builder.WriteString(", ")
+	builder.WriteString("private_key=<sensitive>")
+	builder.WriteString(", ")
+	builder.WriteString("public_key=")
+	builder.WriteString(_m.PublicKey)
+	builder.WriteString(", ")
+	if v := _m.ActivatesAt; v != nil {
+		builder.WriteString("activates_at=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteString(", ")
+	if v := _m.RetiredAt; v != nil {
+		builder.WriteString("retired_at=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// SigningKeys is a parsable slice of SigningKey.
+type SigningKeys []*SigningKey
@@ -0,0 +1,605 @@
+// Code generated by ent, DO NOT EDIT.
+
+package signingkey
+
+import (
+	"blog-server/ent/predicate"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id uint) predicate.SigningKey {
+	return predicate.SigningKey(sql.FieldEQ(FieldID, id))
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id uint) predicate.SigningKey {
+	return predicate.SigningKey(sql.FieldEQ(FieldID, id))
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id uint) predicate.SigningKey {
+	return predicate.SigningKey(sql.FieldNEQ(FieldID, id))
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...uint) predicate.SigningKey {
+	return predicate.SigningKey(sql.FieldIn(FieldID, ids...))
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...uint) predicate.SigningKey {
+	return predicate.SigningKey(sql.FieldNotIn(FieldID, ids...))
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id uint) predicate.SigningKey {
+	return predicate.SigningKey(sql.FieldGT(FieldID, id))
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id uint) predicate.SigningKey {
+	return predicate.SigningKey(sql.FieldGTE(FieldID, id))
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id uint) predicate.SigningKey {
+	return predicate.SigningKey(sql.FieldLT(FieldID, id))
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id uint) predicate.SigningKey {
+	return predicate.SigningKey(sql.FieldLTE(FieldID, id))
+}
+
+// CreatedAt applies equality check predicate on the "created_at" field. It's identical to CreatedAtEQ.
+func CreatedAt(v time.Time) predicate.SigningKey {
+	return predicate.SigningKey(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// UpdatedAt applies equality check predicate on the "updated_at" field. It's identical to UpdatedAtEQ.
+func UpdatedAt(v time.Time) predicate.SigningKey {
+	return predicate.SigningKey(sql.FieldEQ(FieldUpdatedAt, v))
+}
+
+// DeletedAt applies equality check predicate on the "deleted_at" field. It's identical to DeletedAtEQ.
+func DeletedAt(v time.Time) predicate.SigningKey {
+	return predicate.SigningKey(sql.FieldEQ(FieldDeletedAt, v))
+}
+
+// Kid applies equality check predicate on the "kid" field. It's identical to KidEQ.
+func Kid(v string) predicate.SigningKey {
+	return predicate.SigningKey(sql.FieldEQ(FieldKid, v))
+}
+
+// Algorithm applies equality check predicate on the "algorithm" field. It's identical to AlgorithmEQ.
+func Algorithm(v string) predicate.SigningKey {
+	return predicate.SigningKey(sql.FieldEQ(FieldAlgorithm, v))
+}
+
+// PrivateKey applies equality check predicate on the "private_key" field. It's identical to PrivateKeyEQ.
+func PrivateKey(v string) predicate.SigningKey {
+	return predicate.SigningKey(sql.FieldEQ(FieldPrivateKey, v))
+}
+
+// PublicKey applies equality check predicate on the "public_key" field. It's identical to PublicKeyEQ.
+func PublicKey(v string) predicate.SigningKey {
+	return predicate.SigningKey(sql.FieldEQ(FieldPublicKey, v))
+}
+
+// ActivatesAt applies equality check predicate on the "activates_at" field. It's identical to ActivatesAtEQ.
+func ActivatesAt(v time.Time) predicate.SigningKey {
+	return predicate.SigningKey(sql.FieldEQ(FieldActivatesAt, v))
+}
+
+// RetiredAt applies equality check predicate on the "retired_at" field. It's identical to RetiredAtEQ.
+func RetiredAt(v time.Time) predicate.SigningKey {
+	return predicate.SigningKey(sql.FieldEQ(FieldRetiredAt, v))
+}
+
+// CreatedAtEQ applies the EQ predicate on the "created_at" field.
+func CreatedAtEQ(v time.Time) predicate.SigningKey {
+	return predicate.SigningKey(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtNEQ applies the NEQ predicate on the "created_at" field.
+func CreatedAtNEQ(v time.Time) predicate.SigningKey {
+	return predicate.SigningKey(sql.FieldNEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtIn applies the In predicate on the "created_at" field.
+func CreatedAtIn(vs ...time.Time) predicate.SigningKey {
+	return predicate.SigningKey(sql.FieldIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtNotIn applies the NotIn predicate on the "created_at" field.
+func CreatedAtNotIn(vs ...time.Time) predicate.SigningKey {
+	return predicate.SigningKey(sql.FieldNotIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtGT applies the GT predicate on the "created_at" field.
+func CreatedAtGT(v time.Time) predicate.SigningKey {
+	return predicate.SigningKey(sql.FieldGT(FieldCreatedAt, v))
+}
+
+// CreatedAtGTE applies the GTE predicate on the "created_at" field.
+func CreatedAtGTE(v time.Time) predicate.SigningKey {
+	return predicate.SigningKey(sql.FieldGTE(FieldCreatedAt, v))
+}
+
+// CreatedAtLT applies the LT predicate on the "created_at" field.
+func CreatedAtLT(v time.Time) predicate.SigningKey {
+	return predicate.SigningKey(sql.FieldLT(FieldCreatedAt, v))
+}
+
+// CreatedAtLTE applies the LTE predicate on the "created_at" field.
+func CreatedAtLTE(v time.Time) predicate.SigningKey {
+	return predicate.SigningKey(sql.FieldLTE(FieldCreatedAt, v))
+}
+
+// UpdatedAtEQ applies the EQ predicate on the "updated_at" field.
+func UpdatedAtEQ(v time.Time) predicate.SigningKey {
+	return predicate.SigningKey(sql.FieldEQ(FieldUpdatedAt, v))
+}
+
+// UpdatedAtNEQ applies the NEQ predicate on the "updated_at" field.
+func UpdatedAtNEQ(v time.Time) predicate.SigningKey {
+	return predicate.SigningKey(sql.FieldNEQ(FieldUpdatedAt, v))
+}
+
+// UpdatedAtIn applies the In predicate on the "updated_at" field.
+func UpdatedAtIn(vs ...time.Time) predicate.SigningKey {
+	return predicate.SigningKey(sql.FieldIn(FieldUpdatedAt, vs...))
+}
+
+// UpdatedAtNotIn applies the NotIn predicate on the "updated_at" field.
+func UpdatedAtNotIn(vs ...time.Time) predicate.SigningKey {
+	return predicate.SigningKey(sql.FieldNotIn(FieldUpdatedAt, vs...))
+}
+
+// UpdatedAtGT applies the GT predicate on the "updated_at" field.
+func UpdatedAtGT(v time.Time) predicate.SigningKey {
+	return predicate.SigningKey(sql.FieldGT(FieldUpdatedAt, v))
+}
+
+// UpdatedAtGTE applies the GTE predicate on the "updated_at" field.
+func UpdatedAtGTE(v time.Time) predicate.SigningKey {
+	return predicate.SigningKey(sql.FieldGTE(FieldUpdatedAt, v))
+}
+
+// UpdatedAtLT applies the LT predicate on the "updated_at" field.
+func UpdatedAtLT(v time.Time) predicate.SigningKey {
+	return predicate.SigningKey(sql.FieldLT(FieldUpdatedAt, v))
+}
+
+// UpdatedAtLTE applies the LTE predicate on the "updated_at" field.
+func UpdatedAtLTE(v time.Time) predicate.SigningKey {
+	return predicate.SigningKey(sql.FieldLTE(FieldUpdatedAt, v))
+}
+
+// DeletedAtEQ applies the EQ predicate on the "deleted_at" field.
+func DeletedAtEQ(v time.Time) predicate.SigningKey {
+	return predicate.SigningKey(sql.FieldEQ(FieldDeletedAt, v))
+}
+
+// DeletedAtNEQ applies the NEQ predicate on the "deleted_at" field.
+func DeletedAtNEQ(v time.Time) predicate.SigningKey {
+	return predicate.SigningKey(sql.FieldNEQ(FieldDeletedAt, v))
+}
+
+// DeletedAtIn applies the In predicate on the "deleted_at" field.
+func DeletedAtIn(vs ...time.Time) predicate.SigningKey {
+	return predicate.SigningKey(sql.FieldIn(FieldDeletedAt, vs...))
+}
+
+// DeletedAtNotIn applies the NotIn predicate on the "deleted_at" field.
+func DeletedAtNotIn(vs ...time.Time) predicate.SigningKey {
+	return predicate.SigningKey(sql.FieldNotIn(FieldDeletedAt, vs...))
+}
+
+// DeletedAtGT applies the GT predicate on the "deleted_at" field.
+func DeletedAtGT(v time.Time) predicate.SigningKey {
+	return predicate.SigningKey(sql.FieldGT(FieldDeletedAt, v))
+}
+
+// DeletedAtGTE applies the GTE predicate on the "deleted_at" field.
+func DeletedAtGTE(v time.Time) predicate.SigningKey {
+	return predicate.SigningKey(sql.FieldGTE(FieldDeletedAt, v))
+}
+
+// DeletedAtLT applies the LT predicate on the "deleted_at" field.
+func DeletedAtLT(v time.Time) predicate.SigningKey {
+	return predicate.SigningKey(sql.FieldLT(FieldDeletedAt, v))
+}
+
+// DeletedAtLTE applies the LTE predicate on the "deleted_at" field.
+func DeletedAtLTE(v time.Time) predicate.SigningKey {
+	return predicate.SigningKey(sql.FieldLTE(FieldDeletedAt, v))
+}
+
+// DeletedAtIsNil applies the IsNil predicate on the "deleted_at" field.
+func DeletedAtIsNil() predicate.SigningKey {
+	return predicate.SigningKey(sql.FieldIsNull(FieldDeletedAt))
+}
+
+// DeletedAtNotNil applies the NotNil predicate on the "deleted_at" field.
+func DeletedAtNotNil() predicate.SigningKey {
+	return predicate.SigningKey(sql.FieldNotNull(FieldDeletedAt))
+}
+
+// KidEQ applies the EQ predicate on the "kid" field.
+func KidEQ(v string) predicate.SigningKey {
+	return predicate.SigningKey(sql.FieldEQ(FieldKid, v))
+}
+
+// KidNEQ applies the NEQ predicate on the "kid" field.
+func KidNEQ(v string) predicate.SigningKey {
+	return predicate.SigningKey(sql.FieldNEQ(FieldKid, v))
+}
+
+// KidIn applies the In predicate on the "kid" field.
+func KidIn(vs ...string) predicate.SigningKey {
+	return predicate.SigningKey(sql.FieldIn(FieldKid, vs...))
+}
+
+// KidNotIn applies the NotIn predicate on the "kid" field.
+func KidNotIn(vs ...string) predicate.SigningKey {
+	return predicate.SigningKey(sql.FieldNotIn(FieldKid, vs...))
+}
+
+// KidGT applies the GT predicate on the "kid" field.
+func KidGT(v string) predicate.SigningKey {
+	return predicate.SigningKey(sql.FieldGT(FieldKid, v))
+}
+
+// KidGTE applies the GTE predicate on the "kid" field.
+func KidGTE(v string) predicate.SigningKey {
+	return predicate.SigningKey(sql.FieldGTE(FieldKid, v))
+}
+
+// KidLT applies the LT predicate on the "kid" field.
+func KidLT(v string) predicate.SigningKey {
+	return predicate.SigningKey(sql.FieldLT(FieldKid, v))
+}
+
+// KidLTE applies the LTE predicate on the "kid" field.
+func KidLTE(v string) predicate.SigningKey {
+	return predicate.SigningKey(sql.FieldLTE(FieldKid, v))
+}
+
+// KidContains applies the Contains predicate on the "kid" field.
+func KidContains(v string) predicate.SigningKey {
+	return predicate.SigningKey(sql.FieldContains(FieldKid, v))
+}
+
+// KidHasPrefix applies the HasPrefix predicate on the "kid" field.
+func KidHasPrefix(v string) predicate.SigningKey {
+	return predicate.SigningKey(sql.FieldHasPrefix(FieldKid, v))
+}
+
+// KidHasSuffix applies the HasSuffix predicate on the "kid" field.
+func KidHasSuffix(v string) predicate.SigningKey {
+	return predicate.SigningKey(sql.FieldHasSuffix(FieldKid, v))
+}
+
+// KidEqualFold applies the EqualFold predicate on the "kid" field.
+func KidEqualFold(v string) predicate.SigningKey {
+	return predicate.SigningKey(sql.FieldEqualFold(FieldKid, v))
+}
+
+// KidContainsFold applies the ContainsFold predicate on the "kid" field.
+func KidContainsFold(v string) predicate.SigningKey {
+	return predicate.SigningKey(sql.FieldContainsFold(FieldKid, v))
+}
+
+// AlgorithmEQ applies the EQ predicate on the "algorithm" field.
+func AlgorithmEQ(v string) predicate.SigningKey {
+	return predicate.SigningKey(sql.FieldEQ(FieldAlgorithm, v))
+}
+
+// AlgorithmNEQ applies the NEQ predicate on the "algorithm" field.
+func AlgorithmNEQ(v string) predicate.SigningKey {
+	return predicate.SigningKey(sql.FieldNEQ(FieldAlgorithm, v))
+}
+
+// AlgorithmIn applies the In predicate on the "algorithm" field.
+func AlgorithmIn(vs ...string) predicate.SigningKey {
+	return predicate.SigningKey(sql.FieldIn(FieldAlgorithm, vs...))
+}
+
+// AlgorithmNotIn applies the NotIn predicate on the "algorithm" field.
+func AlgorithmNotIn(vs ...string) predicate.SigningKey {
+	return predicate.SigningKey(sql.FieldNotIn(FieldAlgorithm, vs...))
+}
+
+// AlgorithmGT applies the GT predicate on the "algorithm" field.
+func AlgorithmGT(v string) predicate.SigningKey {
+	return predicate.SigningKey(sql.FieldGT(FieldAlgorithm, v))
+}
+
+// AlgorithmGTE applies the GTE predicate on the "algorithm" field.
+func AlgorithmGTE(v string) predicate.SigningKey {
+	return predicate.SigningKey(sql.FieldGTE(FieldAlgorithm, v))
+}
+
+// AlgorithmLT applies the LT predicate on the "algorithm" field.
+func AlgorithmLT(v string) predicate.SigningKey {
+	return predicate.SigningKey(sql.FieldLT(FieldAlgorithm, v))
+}
+
+// AlgorithmLTE applies the LTE predicate on the "algorithm" field.
+func AlgorithmLTE(v string) predicate.SigningKey {
+	return predicate.SigningKey(sql.FieldLTE(FieldAlgorithm, v))
+}
+
+// AlgorithmContains applies the Contains predicate on the "algorithm" field.
+func AlgorithmContains(v string) predicate.SigningKey {
+	return predicate.SigningKey(sql.FieldContains(FieldAlgorithm, v))
+}
+
+// AlgorithmHasPrefix applies the HasPrefix predicate on the "algorithm" field.
+func AlgorithmHasPrefix(v string) predicate.SigningKey {
+	return predicate.SigningKey(sql.FieldHasPrefix(FieldAlgorithm, v))
+}
+
+// AlgorithmHasSuffix applies the HasSuffix predicate on the "algorithm" field.
+func AlgorithmHasSuffix(v string) predicate.SigningKey {
+	return predicate.SigningKey(sql.FieldHasSuffix(FieldAlgorithm, v))
+}
+
+// AlgorithmEqualFold applies the EqualFold predicate on the "algorithm" field.
+func AlgorithmEqualFold(v string) predicate.SigningKey {
+	return predicate.SigningKey(sql.FieldEqualFold(FieldAlgorithm, v))
+}
+
+// AlgorithmContainsFold applies the ContainsFold predicate on the "algorithm" field.
+func AlgorithmContainsFold(v string) predicate.SigningKey {
+	return predicate.SigningKey(sql.FieldContainsFold(FieldAlgorithm, v))
+}
+
+// PrivateKeyEQ applies the EQ predicate on the "private_key" field.
+func PrivateKeyEQ(v string) predicate.SigningKey {
+	return predicate.SigningKey(sql.FieldEQ(FieldPrivateKey, v))
+}
+
+// PrivateKeyNEQ applies the NEQ predicate on the "private_key" field.
+func PrivateKeyNEQ(v string) predicate.SigningKey {
+	return predicate.SigningKey(sql.FieldNEQ(FieldPrivateKey, v))
+}
+
+// PrivateKeyIn applies the In predicate on the "private_key" field.
+func PrivateKeyIn(vs ...string) predicate.SigningKey {
+	return predicate.SigningKey(sql.FieldIn(FieldPrivateKey, vs...))
+}
+
+// PrivateKeyNotIn applies the NotIn predicate on the "private_key" field.
+func PrivateKeyNotIn(vs ...string) predicate.SigningKey {
+	return predicate.SigningKey(sql.FieldNotIn(FieldPrivateKey, vs...))
+}
+
+// PrivateKeyGT applies the GT predicate on the "private_key" field.
+func PrivateKeyGT(v string) predicate.SigningKey {
+	return predicate.SigningKey(sql.FieldGT(FieldPrivateKey, v))
+}
+
+// PrivateKeyGTE applies the GTE predicate on the "private_key" field.
+func PrivateKeyGTE(v string) predicate.SigningKey {
+	return predicate.SigningKey(sql.FieldGTE(FieldPrivateKey, v))
+}
+
+// PrivateKeyLT applies the LT predicate on the "private_key" field.
+func PrivateKeyLT(v string) predicate.SigningKey {
+	return predicate.SigningKey(sql.FieldLT(FieldPrivateKey, v))
+}
+
+// PrivateKeyLTE applies the LTE predicate on the "private_key" field.
+func PrivateKeyLTE(v string) predicate.SigningKey {
+	return predicate.SigningKey(sql.FieldLTE(FieldPrivateKey, v))
+}
+
+// PrivateKeyContains applies the Contains predicate on the "private_key" field.
+func PrivateKeyContains(v string) predicate.SigningKey {
+	return predicate.SigningKey(sql.FieldContains(FieldPrivateKey, v))
+}
+
+// PrivateKeyHasPrefix applies the HasPrefix predicate on the "private_key" field.
+func PrivateKeyHasPrefix(v string) predicate.SigningKey {
+	return predicate.SigningKey(sql.FieldHasPrefix(FieldPrivateKey, v))
+}
+
+// PrivateKeyHasSuffix applies the HasSuffix predicate on the "private_key" field.
+func PrivateKeyHasSuffix(v string) predicate.SigningKey {
+	return predicate.SigningKey(sql.FieldHasSuffix(FieldPrivateKey, v))
+}
+
+// PrivateKeyEqualFold applies the EqualFold predicate on the "private_key" field.
+func PrivateKeyEqualFold(v string) predicate.SigningKey {
+	return predicate.SigningKey(sql.FieldEqualFold(FieldPrivateKey, v))
+}
+
+// PrivateKeyContainsFold applies the ContainsFold predicate on the "private_key" field.
+func PrivateKeyContainsFold(v string) predicate.SigningKey {
+	return predicate.SigningKey(sql.FieldContainsFold(FieldPrivateKey, v))
+}
+
+// PublicKeyEQ applies the EQ predicate on the "public_key" field.
+func PublicKeyEQ(v string) predicate.SigningKey {
+	return predicate.SigningKey(sql.FieldEQ(FieldPublicKey, v))
+}
+
+// PublicKeyNEQ applies the NEQ predicate on the "public_key" field.
+func PublicKeyNEQ(v string) predicate.SigningKey {
+	return predicate.SigningKey(sql.FieldNEQ(FieldPublicKey, v))
+}
+
+// PublicKeyIn applies the In predicate on the "public_key" field.
+func PublicKeyIn(vs ...string) predicate.SigningKey {
+	return predicate.SigningKey(sql.FieldIn(FieldPublicKey, vs...))
+}
+
+// PublicKeyNotIn applies the NotIn predicate on the "public_key" field.
+func PublicKeyNotIn(vs ...string) predicate.SigningKey {
+	return predicate.SigningKey(sql.FieldNotIn(FieldPublicKey, vs...))
+}
+
+// PublicKeyGT applies the GT predicate on the "public_key" field.
+func PublicKeyGT(v string) predicate.SigningKey {
+	return predicate.SigningKey(sql.FieldGT(FieldPublicKey, v))
+}
+
+// PublicKeyGTE applies the GTE predicate on the "public_key" field.
+func PublicKeyGTE(v string) predicate.SigningKey {
+	return predicate.SigningKey(sql.FieldGTE(FieldPublicKey, v))
+}
+
+// PublicKeyLT applies the LT predicate on the "public_key" field.
+func PublicKeyLT(v string) predicate.SigningKey {
+	return predicate.SigningKey(sql.FieldLT(FieldPublicKey, v))
+}
+
+// PublicKeyLTE applies the LTE predicate on the "public_key" field.
+func PublicKeyLTE(v string) predicate.SigningKey {
+	return predicate.SigningKey(sql.FieldLTE(FieldPublicKey, v))
+}
+
+// PublicKeyContains applies the Contains predicate on the "public_key" field.
+func PublicKeyContains(v string) predicate.SigningKey {
+	return predicate.SigningKey(sql.FieldContains(FieldPublicKey, v))
+}
+
+// PublicKeyHasPrefix applies the HasPrefix predicate on the "public_key" field.
+func PublicKeyHasPrefix(v string) predicate.SigningKey {
+	return predicate.SigningKey(sql.FieldHasPrefix(FieldPublicKey, v))
+}
+
+// PublicKeyHasSuffix applies the HasSuffix predicate on the "public_key" field.
+func PublicKeyHasSuffix(v string) predicate.SigningKey {
+	return predicate.SigningKey(sql.FieldHasSuffix(FieldPublicKey, v))
+}
+
+// PublicKeyEqualFold applies the EqualFold predicate on the "public_key" field.
+func PublicKeyEqualFold(v string) predicate.SigningKey {
+	return predicate.SigningKey(sql.FieldEqualFold(FieldPublicKey, v))
+}
+
+// PublicKeyContainsFold applies the ContainsFold predicate on the "public_key" field.
+func PublicKeyContainsFold(v string) predicate.SigningKey {
+	return predicate.SigningKey(sql.FieldContainsFold(FieldPublicKey, v))
+}
+
+// ActivatesAtEQ applies the EQ predicate on the "activates_at" field.
+func ActivatesAtEQ(v time.Time) predicate.SigningKey {
+	return predicate.SigningKey(sql.FieldEQ(FieldActivatesAt, v))
+}
+
+// ActivatesAtNEQ applies the NEQ predicate on the "activates_at" field.
+func ActivatesAtNEQ(v time.Time) predicate.SigningKey {
+	return predicate.SigningKey(sql.FieldNEQ(FieldActivatesAt, v))
+}
+
+// ActivatesAtIn applies the In predicate on the "activates_at" field.
+func ActivatesAtIn(vs ...time.Time) predicate.SigningKey {
+	return predicate.SigningKey(sql.FieldIn(FieldActivatesAt, vs...))
+}
+
+// ActivatesAtNotIn applies the NotIn predicate on the "activates_at" field.
+func ActivatesAtNotIn(vs ...time.Time) predicate.SigningKey {
+	return predicate.SigningKey(sql.FieldNotIn(FieldActivatesAt, vs...))
+}
+
+// ActivatesAtGT applies the GT predicate on the "activates_at" field.
+func ActivatesAtGT(v time.Time) predicate.SigningKey {
+	return predicate.SigningKey(sql.FieldGT(FieldActivatesAt, v))
+}
+
+// ActivatesAtGTE applies the GTE predicate on the "activates_at" field.
+func ActivatesAtGTE(v time.Time) predicate.SigningKey {
+	return predicate.SigningKey(sql.FieldGTE(FieldActivatesAt, v))
+}
+
+// ActivatesAtLT applies the LT predicate on the "activates_at" field.
+func ActivatesAtLT(v time.Time) predicate.SigningKey {
+	return predicate.SigningKey(sql.FieldLT(FieldActivatesAt, v))
+}
+
+// ActivatesAtLTE applies the LTE predicate on the "activates_at" field.
+func ActivatesAtLTE(v time.Time) predicate.SigningKey {
+	return predicate.SigningKey(sql.FieldLTE(FieldActivatesAt, v))
+}
+
+// ActivatesAtIsNil applies the IsNil predicate on the "activates_at" field.
+func ActivatesAtIsNil() predicate.SigningKey {
+	return predicate.SigningKey(sql.FieldIsNull(FieldActivatesAt))
+}
+
+// ActivatesAtNotNil applies the NotNil predicate on the "activates_at" field.
+func ActivatesAtNotNil() predicate.SigningKey {
+	return predicate.SigningKey(sql.FieldNotNull(FieldActivatesAt))
+}
+
+// RetiredAtEQ applies the EQ predicate on the "retired_at" field.
+func RetiredAtEQ(v time.Time) predicate.SigningKey {
+	return predicate.SigningKey(sql.FieldEQ(FieldRetiredAt, v))
+}
+
+// RetiredAtNEQ applies the NEQ predicate on the "retired_at" field.
+func RetiredAtNEQ(v time.Time) predicate.SigningKey {
+	return predicate.SigningKey(sql.FieldNEQ(FieldRetiredAt, v))
+}
+
+// RetiredAtIn applies the In predicate on the "retired_at" field.
+func RetiredAtIn(vs ...time.Time) predicate.SigningKey {
+	return predicate.SigningKey(sql.FieldIn(FieldRetiredAt, vs...))
+}
+
+// RetiredAtNotIn applies the NotIn predicate on the "retired_at" field.
+func RetiredAtNotIn(vs ...time.Time) predicate.SigningKey {
+	return predicate.SigningKey(sql.FieldNotIn(FieldRetiredAt, vs...))
+}
+
+// RetiredAtGT applies the GT predicate on the "retired_at" field.
+func RetiredAtGT(v time.Time) predicate.SigningKey {
+	return predicate.SigningKey(sql.FieldGT(FieldRetiredAt, v))
+}
+
+// RetiredAtGTE applies the GTE predicate on the "retired_at" field.
+func RetiredAtGTE(v time.Time) predicate.SigningKey {
+	return predicate.SigningKey(sql.FieldGTE(FieldRetiredAt, v))
+}
+
+// RetiredAtLT applies the LT predicate on the "retired_at" field.
+func RetiredAtLT(v time.Time) predicate.SigningKey {
+	return predicate.SigningKey(sql.FieldLT(FieldRetiredAt, v))
+}
+
+// RetiredAtLTE applies the LTE predicate on the "retired_at" field.
+func RetiredAtLTE(v time.Time) predicate.SigningKey {
+	return predicate.SigningKey(sql.FieldLTE(FieldRetiredAt, v))
+}
+
+// RetiredAtIsNil applies the IsNil predicate on the "retired_at" field.
+func RetiredAtIsNil() predicate.SigningKey {
+	return predicate.SigningKey(sql.FieldIsNull(FieldRetiredAt))
+}
+
+// RetiredAtNotNil applies the NotNil predicate on the "retired_at" field.
+func RetiredAtNotNil() predicate.SigningKey {
+	return predicate.SigningKey(sql.FieldNotNull(FieldRetiredAt))
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.SigningKey) predicate.SigningKey {
+	return predicate.SigningKey(sql.AndPredicates(predicates...))
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.SigningKey) predicate.SigningKey {
+	return predicate.SigningKey(sql.OrPredicates(predicates...))
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.SigningKey) predicate.SigningKey {
+	return predicate.SigningKey(sql.NotPredicates(p))
+}
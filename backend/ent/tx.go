@@ -18,18 +18,30 @@ type Tx struct {
 	Link *LinkClient
 	// LinkCategory is the client for interacting with the LinkCategory builders.
 	LinkCategory *LinkCategoryClient
+	// Passkey is the client for interacting with the Passkey builders.
+	Passkey *PasskeyClient
+	// PersonalAccessToken is the client for interacting with the PersonalAccessToken builders.
+	PersonalAccessToken *PersonalAccessTokenClient
 	// Post is the client for interacting with the Post builders.
 	Post *PostClient
 	// PostCategory is the client for interacting with the PostCategory builders.
 	PostCategory *PostCategoryClient
 	// PostCategoryRelation is the client for interacting with the PostCategoryRelation builders.
 	PostCategoryRelation *PostCategoryRelationClient
+	// PostRevision is the client for interacting with the PostRevision builders.
+	PostRevision *PostRevisionClient
+	// PostSlug is the client for interacting with the PostSlug builders.
+	PostSlug *PostSlugClient
 	// PostTag is the client for interacting with the PostTag builders.
 	PostTag *PostTagClient
 	// PostTagRelation is the client for interacting with the PostTagRelation builders.
 	PostTagRelation *PostTagRelationClient
+	// SigningKey is the client for interacting with the SigningKey builders.
+	SigningKey *SigningKeyClient
 	// User is the client for interacting with the User builders.
 	User *UserClient
+	// UserIdentity is the client for interacting with the UserIdentity builders.
+	UserIdentity *UserIdentityClient
 
 	// lazily loaded.
 	client     *Client
@@ -164,12 +176,18 @@ func (tx *Tx) init() {
 	tx.Comment = NewCommentClient(tx.config)
 	tx.Link = NewLinkClient(tx.config)
 	tx.LinkCategory = NewLinkCategoryClient(tx.config)
+	tx.Passkey = NewPasskeyClient(tx.config)
+	tx.PersonalAccessToken = NewPersonalAccessTokenClient(tx.config)
 	tx.Post = NewPostClient(tx.config)
 	tx.PostCategory = NewPostCategoryClient(tx.config)
 	tx.PostCategoryRelation = NewPostCategoryRelationClient(tx.config)
+	tx.PostRevision = NewPostRevisionClient(tx.config)
+	tx.PostSlug = NewPostSlugClient(tx.config)
 	tx.PostTag = NewPostTagClient(tx.config)
 	tx.PostTagRelation = NewPostTagRelationClient(tx.config)
+	tx.SigningKey = NewSigningKeyClient(tx.config)
 	tx.User = NewUserClient(tx.config)
+	tx.UserIdentity = NewUserIdentityClient(tx.config)
 }
 
 // txDriver wraps the given dialect.Tx with a nop dialect.Driver implementation.
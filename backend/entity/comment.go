@@ -4,8 +4,54 @@ import (
 	"time"
 )
 
+type CommentStatus string
+
+const (
+	CommentStatusPending  CommentStatus = "pending"
+	CommentStatusApproved CommentStatus = "approved"
+	CommentStatusRejected CommentStatus = "rejected"
+	CommentStatusSpam     CommentStatus = "spam"
+	CommentStatusDeleted  CommentStatus = "deleted"
+)
+
+func (CommentStatus) Values() []string {
+	return []string{
+		string(CommentStatusPending),
+		string(CommentStatusApproved),
+		string(CommentStatusRejected),
+		string(CommentStatusSpam),
+		string(CommentStatusDeleted),
+	}
+}
+
 type Comment struct {
-	ID        uint
+	ID uint
+
+	PostID    uint
+	PostTitle string
+	ParentID  *uint
+	// RootID points to the top-level comment of the thread; nil for top-level comments.
+	RootID *uint
+
+	// UserID is set for registered authors; guests only carry the Author* fields.
+	UserID        *uint
+	AuthorName    string
+	AuthorEmail   *string
+	AuthorWebsite *string
+	AuthorAvatar  *string
+
+	Content string
+	Status  CommentStatus
+	// NotifyOnReply asks for an email when someone replies to the comment.
+	NotifyOnReply bool
+	// SpamReason lists the spam filters that flagged the comment, if any.
+	SpamReason *string
+
+	IP        string
+	UserAgent string
+
 	CreatedAt time.Time
 	UpdatedAt time.Time
+
+	Replies []*Comment
 }
@@ -0,0 +1,39 @@
+package entity
+
+import "time"
+
+// MailMessage is a rendered email waiting in the outbound queue.
+type MailMessage struct {
+	ID string `json:"id"`
+
+	To      string `json:"to"`
+	Subject string `json:"subject"`
+	Body    string `json:"body"`
+	// Headers are extra MIME headers, e.g. List-Unsubscribe.
+	Headers map[string]string `json:"headers,omitempty"`
+
+	Attempts  int    `json:"attempts"`
+	LastError string `json:"lastError,omitempty"`
+
+	CreatedAt     time.Time `json:"createdAt"`
+	NextAttemptAt time.Time `json:"nextAttemptAt"`
+	// ExpiresAt is when the email is no longer worth delivering, e.g. because
+	// the code it carries expired. Nil means it does not expire.
+	ExpiresAt *time.Time `json:"expiresAt,omitempty"`
+	// FailedAt is when the email ran out of attempts.
+	FailedAt *time.Time `json:"failedAt,omitempty"`
+}
+
+// DeadSince returns when the email ran out of attempts. Dead letters from
+// before FailedAt was recorded report their last scheduled attempt.
+func (m *MailMessage) DeadSince() time.Time {
+	if m.FailedAt != nil {
+		return *m.FailedAt
+	}
+	return m.NextAttemptAt
+}
+
+// Expired reports whether the email is no longer worth delivering at the given time.
+func (m *MailMessage) Expired(at time.Time) bool {
+	return m.ExpiresAt != nil && !at.Before(*m.ExpiresAt)
+}
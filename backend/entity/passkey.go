@@ -0,0 +1,22 @@
+package entity
+
+import "time"
+
+// Passkey is a WebAuthn credential registered by a user.
+type Passkey struct {
+	ID     uint
+	UserID uint
+
+	// CredentialID is the base64url encoded credential ID.
+	CredentialID string
+	// PublicKey is the COSE encoded credential public key.
+	PublicKey  []byte
+	Algorithm  int
+	SignCount  uint32
+	Name       string
+	AAGUID     string
+	Transports []string
+
+	LastUsedAt *time.Time
+	CreatedAt  time.Time
+}
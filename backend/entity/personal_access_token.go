@@ -0,0 +1,32 @@
+package entity
+
+import "time"
+
+// AccessTokenPrefix starts every personal access token, which tells them
+// apart from JWTs and makes leaked tokens easy to find by secret scanners.
+const AccessTokenPrefix = "blogpat_"
+
+// PersonalAccessToken is a scoped API credential of a user.
+type PersonalAccessToken struct {
+	ID     uint
+	UserID uint
+
+	Name        string
+	TokenHash   string
+	TokenPrefix string
+	// Scopes are permissions in "resource:action" form.
+	Scopes []string
+	// TwoFactor reports whether the token was created from a session that
+	// passed a second factor.
+	TwoFactor bool
+
+	ExpiresAt  time.Time
+	LastUsedAt *time.Time
+	RevokedAt  *time.Time
+	CreatedAt  time.Time
+}
+
+// Active reports whether the token can still be used at the given time.
+func (t *PersonalAccessToken) Active(now time.Time) bool {
+	return t.RevokedAt == nil && now.Before(t.ExpiresAt)
+}
@@ -5,9 +5,10 @@ import "time"
 type PostStatus string
 
 const (
-	PostStatusDraft    PostStatus = "draft"
-	PostStatusPublish  PostStatus = "published"
-	PostStatusArchived PostStatus = "archived"
+	PostStatusDraft     PostStatus = "draft"
+	PostStatusPublish   PostStatus = "published"
+	PostStatusArchived  PostStatus = "archived"
+	PostStatusScheduled PostStatus = "scheduled"
 )
 
 func (PostStatus) Values() []string {
@@ -15,12 +16,14 @@ func (PostStatus) Values() []string {
 		string(PostStatusDraft),
 		string(PostStatusPublish),
 		string(PostStatusArchived),
+		string(PostStatusScheduled),
 	}
 }
 
 type Post struct {
 	ID      uint
 	Title   string
+	Slug    string
 	Summary *string
 	Cover   *string
 
@@ -33,10 +36,20 @@ type Post struct {
 
 	Status PostStatus
 
+	// PublishedAt is when a scheduled post is due to be published.
 	PublishedAt *time.Time
 	CreatedAt   time.Time
 	UpdatedAt   time.Time
+	DeletedAt   *time.Time
 
 	Tags       []PostTag
 	Categories []PostCategory
 }
+
+// PostSearchFilter narrows a full-text search of published posts. Terms are
+// the tokens every post must contain; nil fields do not filter.
+type PostSearchFilter struct {
+	Terms      []string
+	TagID      *uint
+	CategoryID *uint
+}
@@ -0,0 +1,22 @@
+package entity
+
+import "time"
+
+// PostRevision is a saved version of the title, summary and content of a post.
+type PostRevision struct {
+	ID      uint
+	PostID  uint
+	Version uint
+
+	UserID *uint
+	User   string
+
+	Title   string
+	Summary *string
+	Content string
+
+	// RestoredFrom is the version this revision restored, if any.
+	RestoredFrom *uint
+
+	CreatedAt time.Time
+}
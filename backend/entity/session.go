@@ -0,0 +1,21 @@
+package entity
+
+import "time"
+
+// Session is a login on one device. Every refresh rotates its refresh token,
+// of which only the hash of the latest one is kept.
+type Session struct {
+	ID     string   `json:"id"`
+	UserID uint     `json:"userId"`
+	Role   UserRole `json:"role"`
+
+	UserAgent string `json:"userAgent"`
+	IP        string `json:"ip"`
+	TwoFactor bool   `json:"twoFactor"`
+
+	RefreshTokenHash string `json:"refreshTokenHash"`
+
+	CreatedAt  time.Time `json:"createdAt"`
+	LastUsedAt time.Time `json:"lastUsedAt"`
+	ExpiresAt  time.Time `json:"expiresAt"`
+}
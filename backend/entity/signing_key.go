@@ -0,0 +1,19 @@
+package entity
+
+import "time"
+
+// SigningKey is a stored JWT signing key pair, both halves PEM encoded. The
+// private key is encrypted when a key encryption key is configured.
+type SigningKey struct {
+	ID uint
+
+	KID        string
+	Algorithm  string
+	PrivateKey string
+	PublicKey  string
+
+	// ActivatesAt is when the key starts signing; nil means on creation.
+	ActivatesAt *time.Time
+	RetiredAt   *time.Time
+	CreatedAt   time.Time
+}
@@ -0,0 +1,11 @@
+package entity
+
+// DashboardStats is the summary shown on the admin dashboard.
+type DashboardStats struct {
+	// TotalPV is the sum of the view counts of published posts.
+	TotalPV int64
+	// TotalComments counts approved comments.
+	TotalComments int64
+	// TotalPosts counts published posts.
+	TotalPosts int64
+}
@@ -12,16 +12,38 @@ type UserRole string
 
 const (
 	UserRoleReader UserRole = "reader"
+	UserRoleAuthor UserRole = "author"
+	UserRoleEditor UserRole = "editor"
 	UserRoleAdmin  UserRole = "admin"
 )
 
 func (UserRole) Values() []string {
 	return []string{
 		string(UserRoleReader),
+		string(UserRoleAuthor),
+		string(UserRoleEditor),
 		string(UserRoleAdmin),
 	}
 }
 
+// UserStatus tells whether a user may log in. Disabled and banned users are
+// both locked out; banned marks abuse rather than a temporary suspension.
+type UserStatus string
+
+const (
+	UserStatusActive   UserStatus = "active"
+	UserStatusDisabled UserStatus = "disabled"
+	UserStatusBanned   UserStatus = "banned"
+)
+
+func (UserStatus) Values() []string {
+	return []string{
+		string(UserStatusActive),
+		string(UserStatusDisabled),
+		string(UserStatusBanned),
+	}
+}
+
 type User struct {
 	ID   uint
 	UUID uuid.UUID
@@ -29,17 +51,46 @@ type User struct {
 	Username string
 	Email    string
 	Avatar   *string
+	Bio      *string
+
+	Role   UserRole
+	Status UserStatus
 
-	Role UserRole
+	TwoFactorEnabled bool
 
 	CreatedAt time.Time
 	UpdatedAt time.Time
 }
 
+// UserFilter narrows a user list. Nil fields do not filter.
+type UserFilter struct {
+	Role    *UserRole
+	Status  *UserStatus
+	Keyword *string
+}
+
 type UserAuth struct {
 	ID       uint
 	Password string
 	Role     UserRole
+	Status   UserStatus
+
+	TwoFactorEnabled bool
+}
+
+// UserTwoFactor holds the TOTP settings of a user. RecoveryCodes are hashed.
+type UserTwoFactor struct {
+	Enabled       bool
+	Secret        string
+	RecoveryCodes []string
+}
+
+// UserProfileUpdate holds the profile fields to change. Nil fields are kept,
+// and an empty Avatar or Bio clears it.
+type UserProfileUpdate struct {
+	Username *string
+	Avatar   *string
+	Bio      *string
 }
 
 func GenerateUsername() string {
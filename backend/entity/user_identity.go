@@ -0,0 +1,16 @@
+package entity
+
+import "time"
+
+// UserIdentity links an account at an external login provider to a user.
+type UserIdentity struct {
+	ID     uint
+	UserID uint
+
+	Provider string
+	Subject  string
+	Email    string
+
+	LastLoginAt *time.Time
+	CreatedAt   time.Time
+}
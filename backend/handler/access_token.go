@@ -0,0 +1,130 @@
+package handler
+
+import (
+	"fmt"
+	"strconv"
+	"time"
+
+	"blog-server/contextx"
+	"blog-server/entity"
+	"blog-server/middleware"
+	"blog-server/pkg/errx"
+	"blog-server/pkg/validatorx"
+	"blog-server/request"
+	"blog-server/response"
+	"blog-server/service"
+
+	"github.com/labstack/echo/v5"
+)
+
+// AccessTokenHandler defines the interface for personal access token HTTP handlers.
+type AccessTokenHandler interface {
+	List(c *echo.Context) error
+	Create(c *echo.Context) error
+	Revoke(c *echo.Context) error
+}
+
+// accessTokenHandler implements the AccessTokenHandler interface.
+type accessTokenHandler struct {
+	svc      service.AccessTokenService
+	validate validatorx.Validator
+}
+
+// NewAccessTokenHandler creates a new personal access token handler instance.
+func NewAccessTokenHandler(svc service.AccessTokenService, validate validatorx.Validator) AccessTokenHandler {
+	return &accessTokenHandler{svc: svc, validate: validate}
+}
+
+// List returns the personal access tokens of the logged-in user.
+func (h *accessTokenHandler) List(c *echo.Context) error {
+	u, ok := contextx.GetUser(c.Request().Context())
+	if !ok {
+		return errx.New(errx.CodeUnauthorized, fmt.Errorf("missing user in context"))
+	}
+
+	tokens, err := h.svc.List(c.Request().Context(), u.ID)
+	if err != nil {
+		return err
+	}
+
+	res := make([]*response.AccessTokenRes, len(tokens))
+	for i, t := range tokens {
+		res[i] = toAccessTokenRes(t)
+	}
+
+	return response.OK(c, response.Success(res))
+}
+
+// Create issues a personal access token for the logged-in user.
+func (h *accessTokenHandler) Create(c *echo.Context) error {
+	req := new(request.CreateAccessTokenReq)
+
+	if err := c.Bind(req); err != nil {
+		return errx.New(errx.CodeInvalidParam, err)
+	}
+
+	if err := h.validate.Struct(req); err != nil {
+		return errx.New(errx.CodeValidationFailed, err)
+	}
+
+	u, ok := contextx.GetUser(c.Request().Context())
+	if !ok {
+		return errx.New(errx.CodeUnauthorized, fmt.Errorf("missing user in context"))
+	}
+
+	created, token, err := h.svc.Create(c.Request().Context(), u, &service.CreateAccessTokenInput{
+		Name:      req.Name,
+		Scopes:    req.Scopes,
+		ExpiresIn: time.Duration(req.ExpiresInDays) * 24 * time.Hour,
+	})
+	if err != nil {
+		return err
+	}
+
+	return response.OK(c, response.Success(response.CreatedAccessTokenRes{
+		AccessTokenRes: toAccessTokenRes(created),
+		Token:          token,
+	}))
+}
+
+// Revoke revokes a personal access token of the logged-in user.
+func (h *accessTokenHandler) Revoke(c *echo.Context) error {
+	id, err := strconv.Atoi(c.Param("id"))
+	if err != nil {
+		return errx.New(errx.CodeInvalidParam, err)
+	}
+
+	u, ok := contextx.GetUser(c.Request().Context())
+	if !ok {
+		return errx.New(errx.CodeUnauthorized, fmt.Errorf("missing user in context"))
+	}
+
+	if err := h.svc.Revoke(c.Request().Context(), u.ID, uint(id)); err != nil {
+		return err
+	}
+
+	return response.OK(c, response.Success[any](nil))
+}
+
+// RegisterAccessTokenRoutes registers all personal access token routes.
+func RegisterAccessTokenRoutes(r *echo.Group, h AccessTokenHandler, am *middleware.AuthMiddleware) {
+	group := r.Group("/me/tokens")
+	group.GET("", h.List, am.SessionHandler())
+	group.POST("", h.Create, am.SessionHandler())
+	group.DELETE("/:id", h.Revoke, am.SessionHandler())
+}
+
+// toAccessTokenRes converts a personal access token into an AccessTokenRes.
+func toAccessTokenRes(t *entity.PersonalAccessToken) *response.AccessTokenRes {
+	return &response.AccessTokenRes{
+		ID:         t.ID,
+		Name:       t.Name,
+		Prefix:     t.TokenPrefix,
+		Scopes:     t.Scopes,
+		TwoFactor:  t.TwoFactor,
+		ExpiresAt:  t.ExpiresAt,
+		LastUsedAt: t.LastUsedAt,
+		RevokedAt:  t.RevokedAt,
+		CreatedAt:  t.CreatedAt,
+	}
+}
@@ -0,0 +1,196 @@
+package handler
+
+import (
+	"fmt"
+	"strconv"
+
+	"blog-server/contextx"
+	"blog-server/entity"
+	"blog-server/middleware"
+	"blog-server/pkg/errx"
+	"blog-server/pkg/validatorx"
+	"blog-server/request"
+	"blog-server/response"
+	"blog-server/service"
+
+	"github.com/labstack/echo/v5"
+)
+
+// AdminUserHandler defines the interface for admin user management HTTP handlers.
+type AdminUserHandler interface {
+	GetUsers(c *echo.Context) error
+	GetUser(c *echo.Context) error
+	UpdateRole(c *echo.Context) error
+	UpdateStatus(c *echo.Context) error
+	DeleteUser(c *echo.Context) error
+}
+
+// adminUserHandler implements the AdminUserHandler interface.
+type adminUserHandler struct {
+	svc      service.UserService
+	validate validatorx.Validator
+}
+
+// NewAdminUserHandler creates a new admin user handler instance.
+func NewAdminUserHandler(svc service.UserService, validate validatorx.Validator) AdminUserHandler {
+	return &adminUserHandler{svc: svc, validate: validate}
+}
+
+// GetUsers lists users filtered by role, status and keyword.
+func (h *adminUserHandler) GetUsers(c *echo.Context) error {
+	query := &request.AdminUserListReq{Page: 1, PageSize: 20}
+	if err := c.Bind(query); err != nil {
+		return errx.New(errx.CodeInvalidParam, err)
+	}
+
+	if err := h.validate.Struct(query); err != nil {
+		return errx.New(errx.CodeValidationFailed, err)
+	}
+
+	u, ok := contextx.GetUser(c.Request().Context())
+	if !ok {
+		return errx.New(errx.CodeUnauthorized, fmt.Errorf("missing user in context"))
+	}
+
+	users, total, err := h.svc.AdminGetUsers(c.Request().Context(), u, &entity.UserFilter{
+		Role:    query.Role,
+		Status:  query.Status,
+		Keyword: query.Keyword,
+	}, query.Page, query.PageSize)
+	if err != nil {
+		return err
+	}
+
+	userDTOs := make([]response.AdminUserRes, len(users))
+	for i, user := range users {
+		userDTOs[i] = toAdminUserRes(user)
+	}
+
+	return response.OK(c, response.Success(response.Page[response.AdminUserRes]{
+		Total: total,
+		List:  userDTOs,
+	}))
+}
+
+// GetUser returns a single user by ID.
+func (h *adminUserHandler) GetUser(c *echo.Context) error {
+	id, err := strconv.Atoi(c.Param("id"))
+	if err != nil {
+		return errx.New(errx.CodeInvalidParam, err)
+	}
+
+	u, ok := contextx.GetUser(c.Request().Context())
+	if !ok {
+		return errx.New(errx.CodeUnauthorized, fmt.Errorf("missing user in context"))
+	}
+
+	user, err := h.svc.AdminGetUser(c.Request().Context(), u, uint(id))
+	if err != nil {
+		return err
+	}
+
+	return response.OK(c, response.Success(toAdminUserRes(user)))
+}
+
+// UpdateRole changes the role of a user.
+func (h *adminUserHandler) UpdateRole(c *echo.Context) error {
+	id, err := strconv.Atoi(c.Param("id"))
+	if err != nil {
+		return errx.New(errx.CodeInvalidParam, err)
+	}
+
+	req := new(request.UpdateUserRoleReq)
+	if err := c.Bind(req); err != nil {
+		return errx.New(errx.CodeInvalidParam, err)
+	}
+
+	if err := h.validate.Struct(req); err != nil {
+		return errx.New(errx.CodeValidationFailed, err)
+	}
+
+	u, ok := contextx.GetUser(c.Request().Context())
+	if !ok {
+		return errx.New(errx.CodeUnauthorized, fmt.Errorf("missing user in context"))
+	}
+
+	user, err := h.svc.AdminUpdateRole(c.Request().Context(), u, uint(id), req.Role)
+	if err != nil {
+		return err
+	}
+
+	return response.OK(c, response.Success(toAdminUserRes(user)))
+}
+
+// UpdateStatus enables, disables or bans a user.
+func (h *adminUserHandler) UpdateStatus(c *echo.Context) error {
+	id, err := strconv.Atoi(c.Param("id"))
+	if err != nil {
+		return errx.New(errx.CodeInvalidParam, err)
+	}
+
+	req := new(request.UpdateUserStatusReq)
+	if err := c.Bind(req); err != nil {
+		return errx.New(errx.CodeInvalidParam, err)
+	}
+
+	if err := h.validate.Struct(req); err != nil {
+		return errx.New(errx.CodeValidationFailed, err)
+	}
+
+	u, ok := contextx.GetUser(c.Request().Context())
+	if !ok {
+		return errx.New(errx.CodeUnauthorized, fmt.Errorf("missing user in context"))
+	}
+
+	user, err := h.svc.AdminUpdateStatus(c.Request().Context(), u, uint(id), req.Status)
+	if err != nil {
+		return err
+	}
+
+	return response.OK(c, response.Success(toAdminUserRes(user)))
+}
+
+// DeleteUser soft-deletes a user.
+func (h *adminUserHandler) DeleteUser(c *echo.Context) error {
+	id, err := strconv.Atoi(c.Param("id"))
+	if err != nil {
+		return errx.New(errx.CodeInvalidParam, err)
+	}
+
+	u, ok := contextx.GetUser(c.Request().Context())
+	if !ok {
+		return errx.New(errx.CodeUnauthorized, fmt.Errorf("missing user in context"))
+	}
+
+	if err := h.svc.AdminDeleteUser(c.Request().Context(), u, uint(id)); err != nil {
+		return err
+	}
+
+	return response.OK(c, response.Success[any](nil))
+}
+
+// RegisterAdminUserRoutes registers all admin user management routes.
+func RegisterAdminUserRoutes(r *echo.Group, h AdminUserHandler, am *middleware.AuthMiddleware) {
+	group := r.Group("/admin/users")
+	group.GET("", h.GetUsers, am.Handler())
+	group.GET("/:id", h.GetUser, am.Handler())
+	group.PATCH("/:id/role", h.UpdateRole, am.Handler())
+	group.PATCH("/:id/status", h.UpdateStatus, am.Handler())
+	group.DELETE("/:id", h.DeleteUser, am.Handler())
+}
+
+// toAdminUserRes maps a domain User to the admin response DTO.
+func toAdminUserRes(u *entity.User) response.AdminUserRes {
+	return response.AdminUserRes{
+		ID:               u.ID,
+		UUID:             u.UUID.String(),
+		Username:         u.Username,
+		Email:            u.Email,
+		Avatar:           u.Avatar,
+		Role:             string(u.Role),
+		Status:           string(u.Status),
+		TwoFactorEnabled: u.TwoFactorEnabled,
+		CreatedAt:        u.CreatedAt,
+		UpdatedAt:        u.UpdatedAt,
+	}
+}
@@ -6,6 +6,8 @@ import (
 	"time"
 
 	"blog-server/config"
+	"blog-server/contextx"
+	"blog-server/middleware"
 	"blog-server/pkg/errx"
 	"blog-server/pkg/validatorx"
 	"blog-server/request"
@@ -20,8 +22,13 @@ type AuthHandler interface {
 	SendCaptcha(c *echo.Context) error
 	Register(c *echo.Context) error
 	Login(c *echo.Context) error
+	LoginTwoFactor(c *echo.Context) error
 	Logout(c *echo.Context) error
 	Refresh(c *echo.Context) error
+	ForgotPassword(c *echo.Context) error
+	ResetPassword(c *echo.Context) error
+	SendChangeEmailCaptcha(c *echo.Context) error
+	ChangeEmail(c *echo.Context) error
 }
 
 // authHandler implements the AuthHandler interface.
@@ -51,7 +58,7 @@ func (h *authHandler) SendCaptcha(c *echo.Context) error {
 		req.Type = string(service.Register)
 	}
 
-	err := h.svc.SendCaptchaMail(c.Request().Context(), req.Email, service.CaptchaType(req.Type))
+	err := h.svc.SendCaptchaMail(c.Request().Context(), req.Email, service.CaptchaType(req.Type), c.RealIP())
 	if err != nil {
 		return err
 	}
@@ -77,6 +84,7 @@ func (h *authHandler) Register(c *echo.Context) error {
 			Email:    req.Email,
 			Password: req.Password,
 			Captcha:  req.Captcha,
+			Meta:     sessionMeta(c),
 		},
 	)
 	if err != nil {
@@ -100,7 +108,39 @@ func (h *authHandler) Login(c *echo.Context) error {
 		return errx.New(errx.CodeValidationFailed, err)
 	}
 
-	result, err := h.svc.Login(c.Request().Context(), &service.LoginInput{Email: req.Email, Password: req.Password})
+	result, err := h.svc.Login(c.Request().Context(), &service.LoginInput{
+		Email:    req.Email,
+		Password: req.Password,
+		Meta:     sessionMeta(c),
+	})
+	if err != nil {
+		return err
+	}
+
+	if !result.TwoFactorRequired {
+		setRefreshTokenCookie(c, result.RefreshToken)
+	}
+
+	return response.OK(c, response.Success(toLoginRes(result)))
+}
+
+// LoginTwoFactor completes a login with a TOTP or recovery code.
+func (h *authHandler) LoginTwoFactor(c *echo.Context) error {
+	req := new(request.TwoFactorLoginReq)
+
+	if err := c.Bind(req); err != nil {
+		return errx.New(errx.CodeInvalidParam, err)
+	}
+
+	if err := h.validate.Struct(req); err != nil {
+		return errx.New(errx.CodeValidationFailed, err)
+	}
+
+	result, err := h.svc.LoginTwoFactor(c.Request().Context(), &service.TwoFactorLoginInput{
+		ChallengeToken: req.ChallengeToken,
+		Code:           req.Code,
+		Meta:           sessionMeta(c),
+	})
 	if err != nil {
 		return err
 	}
@@ -110,8 +150,20 @@ func (h *authHandler) Login(c *echo.Context) error {
 	return response.OK(c, response.Success(toLoginRes(result)))
 }
 
-// Logout handles user logout by clearing the refresh token cookie.
+// Logout revokes the session and access token of the caller and clears the refresh token cookie.
 func (h *authHandler) Logout(c *echo.Context) error {
+	input := &service.LogoutInput{}
+	if u, ok := contextx.GetUser(c.Request().Context()); ok {
+		input.User = &u
+	}
+	if token, err := c.Cookie("refreshToken"); err == nil {
+		input.RefreshToken = token.Value
+	}
+
+	if err := h.svc.Logout(c.Request().Context(), input); err != nil {
+		return err
+	}
+
 	cookie := new(http.Cookie)
 	cookie.Name = "refreshToken"
 	cookie.Value = ""
@@ -132,7 +184,7 @@ func (h *authHandler) Refresh(c *echo.Context) error {
 	if token.Value == "" {
 		return errx.New(errx.CodeUnauthorized, fmt.Errorf("missing refresh token"))
 	}
-	accessToken, refreshToken, err := h.svc.RefreshAccessToken(c.Request().Context(), token.Value)
+	accessToken, refreshToken, err := h.svc.RefreshAccessToken(c.Request().Context(), token.Value, sessionMeta(c))
 	if err != nil {
 		return err
 	}
@@ -145,14 +197,124 @@ func (h *authHandler) Refresh(c *echo.Context) error {
 	}))
 }
 
+// ForgotPassword sends a password reset captcha to the given email.
+//
+// The response is the same whether or not the email is registered.
+func (h *authHandler) ForgotPassword(c *echo.Context) error {
+	req := new(request.ForgotPasswordReq)
+
+	if err := c.Bind(req); err != nil {
+		return errx.New(errx.CodeInvalidParam, err)
+	}
+
+	if err := h.validate.Struct(req); err != nil {
+		return errx.New(errx.CodeValidationFailed, err)
+	}
+
+	if err := h.svc.SendCaptchaMail(c.Request().Context(), req.Email, service.PasswordReset, c.RealIP()); err != nil {
+		return err
+	}
+
+	return response.OK(c, response.SuccessWithMsg("Captcha sent successfully", "Captcha sent successfully"))
+}
+
+// ResetPassword sets a new password using the emailed captcha.
+func (h *authHandler) ResetPassword(c *echo.Context) error {
+	req := new(request.ResetPasswordReq)
+
+	if err := c.Bind(req); err != nil {
+		return errx.New(errx.CodeInvalidParam, err)
+	}
+
+	if err := h.validate.Struct(req); err != nil {
+		return errx.New(errx.CodeValidationFailed, err)
+	}
+
+	err := h.svc.ResetPassword(c.Request().Context(), &service.ResetPasswordInput{
+		Email:    req.Email,
+		Password: req.Password,
+		Captcha:  req.Captcha,
+		IP:       c.RealIP(),
+	})
+	if err != nil {
+		return err
+	}
+
+	return response.OK(c, response.SuccessWithMsg("password reset success", "password reset success"))
+}
+
+// SendChangeEmailCaptcha sends a captcha to the new email of the logged-in user.
+func (h *authHandler) SendChangeEmailCaptcha(c *echo.Context) error {
+	req := new(request.ChangeEmailCaptchaReq)
+
+	if err := c.Bind(req); err != nil {
+		return errx.New(errx.CodeInvalidParam, err)
+	}
+
+	if err := h.validate.Struct(req); err != nil {
+		return errx.New(errx.CodeValidationFailed, err)
+	}
+
+	u, ok := contextx.GetUser(c.Request().Context())
+	if !ok {
+		return errx.New(errx.CodeUnauthorized, fmt.Errorf("missing user in context"))
+	}
+
+	if err := h.svc.SendChangeEmailCaptcha(c.Request().Context(), u.ID, req.Email, c.RealIP()); err != nil {
+		return err
+	}
+
+	return response.OK(c, response.SuccessWithMsg("Captcha sent successfully", "Captcha sent successfully"))
+}
+
+// ChangeEmail switches the email of the logged-in user and rotates the tokens.
+func (h *authHandler) ChangeEmail(c *echo.Context) error {
+	req := new(request.ChangeEmailReq)
+
+	if err := c.Bind(req); err != nil {
+		return errx.New(errx.CodeInvalidParam, err)
+	}
+
+	if err := h.validate.Struct(req); err != nil {
+		return errx.New(errx.CodeValidationFailed, err)
+	}
+
+	u, ok := contextx.GetUser(c.Request().Context())
+	if !ok {
+		return errx.New(errx.CodeUnauthorized, fmt.Errorf("missing user in context"))
+	}
+
+	// The new session inherits the second factor of the current one.
+	meta := sessionMeta(c)
+	meta.TwoFactor = u.TwoFactor
+
+	result, err := h.svc.ChangeEmail(c.Request().Context(), u.ID, &service.ChangeEmailInput{
+		Email:   req.Email,
+		Captcha: req.Captcha,
+		Meta:    meta,
+	})
+	if err != nil {
+		return err
+	}
+
+	setRefreshTokenCookie(c, result.RefreshToken)
+
+	return response.OK(c, response.Success(toLoginRes(result)))
+}
+
 // RegisterAuthRoutes registers all auth-related routes.
-func RegisterAuthRoutes(r *echo.Group, h AuthHandler) {
+func RegisterAuthRoutes(r *echo.Group, h AuthHandler, am *middleware.AuthMiddleware) {
 	group := r.Group("/auth")
 	group.POST("/captcha", h.SendCaptcha)
 	group.POST("/register", h.Register)
 	group.POST("/login", h.Login)
-	group.POST("/logout", h.Logout)
+	group.POST("/login/2fa", h.LoginTwoFactor)
+	group.POST("/logout", h.Logout, am.OptionalHandler())
 	group.POST("/refresh", h.Refresh)
+	group.POST("/password/forgot", h.ForgotPassword)
+	group.POST("/password/reset", h.ResetPassword)
+	group.POST("/email/captcha", h.SendChangeEmailCaptcha, am.SessionHandler())
+	group.POST("/email/change", h.ChangeEmail, am.SessionHandler())
 }
 
 // toLoginRes converts an AuthResult into a LoginRes.
@@ -164,6 +326,17 @@ func toLoginRes(result *service.AuthResult) *response.LoginRes {
 		Avatar:       result.Avatar,
 		Username:     result.Username,
 		Role:         result.Role,
+
+		TwoFactorRequired: result.TwoFactorRequired,
+		ChallengeToken:    result.ChallengeToken,
+	}
+}
+
+// sessionMeta describes the client of the request for the session it starts or refreshes.
+func sessionMeta(c *echo.Context) service.SessionMeta {
+	return service.SessionMeta{
+		IP:        c.RealIP(),
+		UserAgent: c.Request().UserAgent(),
 	}
 }
 
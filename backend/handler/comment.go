@@ -0,0 +1,463 @@
+package handler
+
+import (
+	"bytes"
+	"fmt"
+	"html/template"
+	"net/http"
+	"strconv"
+	"time"
+
+	"blog-server/contextx"
+	"blog-server/entity"
+	"blog-server/middleware"
+	"blog-server/pkg/errx"
+	"blog-server/pkg/validatorx"
+	"blog-server/request"
+	"blog-server/response"
+	"blog-server/service"
+	"blog-server/templates"
+
+	"github.com/labstack/echo/v5"
+)
+
+const (
+	// commentGuestCookie carries the guest token of the last comment a guest left.
+	commentGuestCookie = "commentGuest"
+	commentCookiePath  = "/api/v1/posts"
+	commentGuestMaxAge = 365 * 24 * time.Hour
+)
+
+var (
+	// subscribePage is the page behind the confirmation link sent to guests
+	// who ask for reply notifications.
+	subscribePage = template.Must(template.ParseFS(templates.FS, "layout.html", "pages/subscribe.html"))
+	// unsubscribePage is the page behind the unsubscribe link of reply notifications.
+	unsubscribePage = template.Must(template.ParseFS(templates.FS, "layout.html", "pages/unsubscribe.html"))
+)
+
+// notifyPageData is the data of the subscribe and unsubscribe pages.
+type notifyPageData struct {
+	Title string
+	// State is one of confirm, done and invalid.
+	State  string
+	Email  string
+	Action string
+}
+
+// CommentHandler defines the interface for comment HTTP handlers.
+type CommentHandler interface {
+	GetComments(c *echo.Context) error
+	CreateComment(c *echo.Context) error
+	ConfirmSubscribe(c *echo.Context) error
+	Subscribe(c *echo.Context) error
+	ConfirmUnsubscribe(c *echo.Context) error
+	Unsubscribe(c *echo.Context) error
+
+	AdminGetComments(c *echo.Context) error
+	UpdateComment(c *echo.Context) error
+	ApproveComment(c *echo.Context) error
+	RejectComment(c *echo.Context) error
+	SpamComment(c *echo.Context) error
+	DeleteComment(c *echo.Context) error
+	BatchModerate(c *echo.Context) error
+}
+
+// commentHandler implements the CommentHandler interface.
+type commentHandler struct {
+	svc      service.CommentService
+	validate validatorx.Validator
+}
+
+// NewCommentHandler creates a new comment handler instance.
+func NewCommentHandler(svc service.CommentService, validate validatorx.Validator) CommentHandler {
+	return &commentHandler{svc: svc, validate: validate}
+}
+
+// GetComments retrieves a cursor-paginated page of comment threads of a post.
+func (h *commentHandler) GetComments(c *echo.Context) error {
+	postID, err := strconv.Atoi(c.Param("id"))
+	if err != nil {
+		return errx.New(errx.CodeInvalidParam, err)
+	}
+
+	query := new(request.CommentListReq)
+	if err := c.Bind(query); err != nil {
+		return errx.New(errx.CodeInvalidParam, err)
+	}
+
+	if err := h.validate.Struct(query); err != nil {
+		return errx.New(errx.CodeValidationFailed, err)
+	}
+
+	comments, next, err := h.svc.ListComments(c.Request().Context(), uint(postID), query.Cursor, query.Limit)
+	if err != nil {
+		return err
+	}
+
+	return response.OK(c, response.Success(response.CursorPage[response.CommentRes]{
+		List:       toCommentResList(comments),
+		NextCursor: next,
+	}))
+}
+
+// CreateComment posts a new comment or reply as a logged-in user or guest.
+func (h *commentHandler) CreateComment(c *echo.Context) error {
+	postID, err := strconv.Atoi(c.Param("id"))
+	if err != nil {
+		return errx.New(errx.CodeInvalidParam, err)
+	}
+
+	req := new(request.CreateCommentReq)
+	if err := c.Bind(req); err != nil {
+		return errx.New(errx.CodeInvalidParam, err)
+	}
+
+	if err := h.validate.Struct(req); err != nil {
+		return errx.New(errx.CodeValidationFailed, err)
+	}
+
+	input := &service.CreateCommentInput{
+		PostID:        uint(postID),
+		ParentID:      req.ParentID,
+		AuthorName:    req.AuthorName,
+		AuthorEmail:   req.AuthorEmail,
+		AuthorWebsite: req.AuthorWebsite,
+		Content:       req.Content,
+		IP:            c.RealIP(),
+		UserAgent:     c.Request().UserAgent(),
+		Honeypot:      req.Honeypot,
+		NotifyOnReply: req.NotifyOnReply,
+	}
+	if req.RenderedAt != nil {
+		renderedAt := time.UnixMilli(*req.RenderedAt)
+		input.RenderedAt = &renderedAt
+	}
+	if u, ok := contextx.GetUser(c.Request().Context()); ok {
+		input.User = &u
+	} else if cookie, err := c.Cookie(commentGuestCookie); err == nil {
+		input.GuestToken = cookie.Value
+	}
+
+	result, err := h.svc.CreateComment(c.Request().Context(), input)
+	if err != nil {
+		return err
+	}
+	if result.GuestToken != "" {
+		setCommentGuestCookie(c, result.GuestToken)
+	}
+
+	return response.OK(c, response.Success(toCommentRes(result.Comment)))
+}
+
+// ConfirmSubscribe renders the page behind the link in the confirmation
+// email. Like ConfirmUnsubscribe, GET only asks and the form POSTs to Subscribe.
+func (h *commentHandler) ConfirmSubscribe(c *echo.Context) error {
+	query := new(request.SubscribeReq)
+	if err := c.Bind(query); err != nil || h.validate.Struct(query) != nil {
+		return renderNotifyPage(c, http.StatusBadRequest, subscribePage, "subscribe.html", &notifyPageData{State: "invalid"})
+	}
+
+	email, err := h.svc.SubscriptionAddress(query.Token)
+	if err != nil {
+		return renderNotifyPage(c, http.StatusBadRequest, subscribePage, "subscribe.html", &notifyPageData{State: "invalid"})
+	}
+
+	return renderNotifyPage(c, http.StatusOK, subscribePage, "subscribe.html", &notifyPageData{
+		State:  "confirm",
+		Email:  email,
+		Action: c.Request().URL.RequestURI(),
+	})
+}
+
+// Subscribe turns on reply notifications for the comment of the confirmation link.
+func (h *commentHandler) Subscribe(c *echo.Context) error {
+	query := new(request.SubscribeReq)
+	if err := echo.BindQueryParams(c, query); err != nil || h.validate.Struct(query) != nil {
+		return renderNotifyPage(c, http.StatusBadRequest, subscribePage, "subscribe.html", &notifyPageData{State: "invalid"})
+	}
+
+	email, err := h.svc.ConfirmSubscription(c.Request().Context(), query.Token)
+	if err != nil {
+		if errx.ToAppError(err).Code == errx.CodeInvalidParam {
+			return renderNotifyPage(c, http.StatusBadRequest, subscribePage, "subscribe.html", &notifyPageData{State: "invalid"})
+		}
+		return err
+	}
+
+	return renderNotifyPage(c, http.StatusOK, subscribePage, "subscribe.html", &notifyPageData{State: "done", Email: email})
+}
+
+// ConfirmUnsubscribe renders the page behind the link in a notification email.
+//
+// Mail scanners follow links, so GET only asks for confirmation and the
+// page's form POSTs to Unsubscribe.
+func (h *commentHandler) ConfirmUnsubscribe(c *echo.Context) error {
+	query := new(request.UnsubscribeReq)
+	if err := c.Bind(query); err != nil || h.validate.Struct(query) != nil {
+		return renderNotifyPage(c, http.StatusBadRequest, unsubscribePage, "unsubscribe.html", &notifyPageData{State: "invalid"})
+	}
+
+	email, err := h.svc.UnsubscribeAddress(query.Token)
+	if err != nil {
+		return renderNotifyPage(c, http.StatusBadRequest, unsubscribePage, "unsubscribe.html", &notifyPageData{State: "invalid"})
+	}
+
+	return renderNotifyPage(c, http.StatusOK, unsubscribePage, "unsubscribe.html", &notifyPageData{
+		State:  "confirm",
+		Email:  email,
+		Action: c.Request().URL.RequestURI(),
+	})
+}
+
+// Unsubscribe turns off reply notifications. It serves the confirmation form
+// as well as one-click unsubscribe (RFC 8058), which both carry the token in
+// the query of the link.
+func (h *commentHandler) Unsubscribe(c *echo.Context) error {
+	query := new(request.UnsubscribeReq)
+	if err := echo.BindQueryParams(c, query); err != nil || h.validate.Struct(query) != nil {
+		return renderNotifyPage(c, http.StatusBadRequest, unsubscribePage, "unsubscribe.html", &notifyPageData{State: "invalid"})
+	}
+
+	email, err := h.svc.Unsubscribe(c.Request().Context(), query.Token)
+	if err != nil {
+		if errx.ToAppError(err).Code == errx.CodeInvalidParam {
+			return renderNotifyPage(c, http.StatusBadRequest, unsubscribePage, "unsubscribe.html", &notifyPageData{State: "invalid"})
+		}
+		return err
+	}
+
+	return renderNotifyPage(c, http.StatusOK, unsubscribePage, "unsubscribe.html", &notifyPageData{State: "done", Email: email})
+}
+
+// AdminGetComments retrieves the comment moderation queue.
+func (h *commentHandler) AdminGetComments(c *echo.Context) error {
+	query := new(request.AdminCommentListReq)
+	if err := c.Bind(query); err != nil {
+		return errx.New(errx.CodeInvalidParam, err)
+	}
+
+	if err := h.validate.Struct(query); err != nil {
+		return errx.New(errx.CodeValidationFailed, err)
+	}
+
+	u, ok := contextx.GetUser(c.Request().Context())
+	if !ok {
+		return errx.New(errx.CodeUnauthorized, fmt.Errorf("missing user in context"))
+	}
+
+	filter := &service.AdminCommentFilter{
+		Status:  query.Status,
+		PostID:  query.PostID,
+		Keyword: query.Keyword,
+	}
+
+	comments, total, err := h.svc.AdminGetComments(c.Request().Context(), u, filter, query.Page, query.PageSize)
+	if err != nil {
+		return err
+	}
+
+	commentDTOs := make([]response.AdminCommentRes, len(comments))
+	for i, cm := range comments {
+		commentDTOs[i] = toAdminCommentRes(cm)
+	}
+
+	return response.OK(c, response.Success(response.Page[response.AdminCommentRes]{
+		Total: total,
+		List:  commentDTOs,
+	}))
+}
+
+// UpdateComment edits the content of a comment.
+func (h *commentHandler) UpdateComment(c *echo.Context) error {
+	id, err := strconv.Atoi(c.Param("id"))
+	if err != nil {
+		return errx.New(errx.CodeInvalidParam, err)
+	}
+
+	req := new(request.UpdateCommentReq)
+	if err := c.Bind(req); err != nil {
+		return errx.New(errx.CodeInvalidParam, err)
+	}
+
+	if err := h.validate.Struct(req); err != nil {
+		return errx.New(errx.CodeValidationFailed, err)
+	}
+
+	u, ok := contextx.GetUser(c.Request().Context())
+	if !ok {
+		return errx.New(errx.CodeUnauthorized, fmt.Errorf("missing user in context"))
+	}
+
+	comment, err := h.svc.UpdateComment(c.Request().Context(), u, uint(id), req.Content)
+	if err != nil {
+		return err
+	}
+
+	return response.OK(c, response.Success(toAdminCommentRes(comment)))
+}
+
+// ApproveComment publishes a single comment.
+func (h *commentHandler) ApproveComment(c *echo.Context) error {
+	return h.moderateOne(c, service.CommentActionApprove)
+}
+
+// RejectComment rejects a single comment.
+func (h *commentHandler) RejectComment(c *echo.Context) error {
+	return h.moderateOne(c, service.CommentActionReject)
+}
+
+// SpamComment marks a single comment as spam.
+func (h *commentHandler) SpamComment(c *echo.Context) error {
+	return h.moderateOne(c, service.CommentActionSpam)
+}
+
+// DeleteComment soft-deletes a single comment.
+func (h *commentHandler) DeleteComment(c *echo.Context) error {
+	return h.moderateOne(c, service.CommentActionDelete)
+}
+
+// BatchModerate applies a moderation action to several comments at once.
+func (h *commentHandler) BatchModerate(c *echo.Context) error {
+	req := new(request.BatchCommentReq)
+	if err := c.Bind(req); err != nil {
+		return errx.New(errx.CodeInvalidParam, err)
+	}
+
+	if err := h.validate.Struct(req); err != nil {
+		return errx.New(errx.CodeValidationFailed, err)
+	}
+
+	u, ok := contextx.GetUser(c.Request().Context())
+	if !ok {
+		return errx.New(errx.CodeUnauthorized, fmt.Errorf("missing user in context"))
+	}
+
+	if err := h.svc.ModerateComments(c.Request().Context(), u, req.IDs, service.CommentAction(req.Action)); err != nil {
+		return err
+	}
+
+	return response.OK(c, response.Success[any](nil))
+}
+
+// moderateOne applies a moderation action to the comment identified by the path.
+func (h *commentHandler) moderateOne(c *echo.Context, action service.CommentAction) error {
+	id, err := strconv.Atoi(c.Param("id"))
+	if err != nil {
+		return errx.New(errx.CodeInvalidParam, err)
+	}
+
+	u, ok := contextx.GetUser(c.Request().Context())
+	if !ok {
+		return errx.New(errx.CodeUnauthorized, fmt.Errorf("missing user in context"))
+	}
+
+	if err := h.svc.ModerateComments(c.Request().Context(), u, []uint{uint(id)}, action); err != nil {
+		return err
+	}
+
+	return response.OK(c, response.Success[any](nil))
+}
+
+// RegisterCommentRoutes registers all comment-related routes.
+func RegisterCommentRoutes(r *echo.Group, h CommentHandler, am *middleware.AuthMiddleware, rl *middleware.RateLimiter) {
+	group := r.Group("/posts/:id/comments")
+	group.GET("", h.GetComments)
+	group.POST("", h.CreateComment, am.OptionalHandler(), rl.RateLimit("comment"))
+
+	// GET serves the link in the email, POST subscribes from the
+	// confirmation form.
+	r.GET("/comments/subscribe", h.ConfirmSubscribe)
+	r.POST("/comments/subscribe", h.Subscribe)
+
+	// GET serves the link in the email, POST unsubscribes from the
+	// confirmation form or one-click unsubscribe (RFC 8058).
+	r.GET("/comments/unsubscribe", h.ConfirmUnsubscribe)
+	r.POST("/comments/unsubscribe", h.Unsubscribe)
+
+	// Admin routes
+	adminGroup := r.Group("/admin/comments")
+	adminGroup.GET("", h.AdminGetComments, am.Handler())
+	adminGroup.POST("/batch", h.BatchModerate, am.Handler())
+	adminGroup.PUT("/:id", h.UpdateComment, am.Handler())
+	adminGroup.POST("/:id/approve", h.ApproveComment, am.Handler())
+	adminGroup.POST("/:id/reject", h.RejectComment, am.Handler())
+	adminGroup.POST("/:id/spam", h.SpamComment, am.Handler())
+	adminGroup.DELETE("/:id", h.DeleteComment, am.Handler())
+}
+
+// toCommentRes maps a domain Comment and its replies to the public response DTO.
+func toCommentRes(cm *entity.Comment) response.CommentRes {
+	return response.CommentRes{
+		ID:       cm.ID,
+		ParentID: cm.ParentID,
+		Author: response.CommentAuthorRes{
+			Name:       cm.AuthorName,
+			Website:    cm.AuthorWebsite,
+			Avatar:     cm.AuthorAvatar,
+			Registered: cm.UserID != nil,
+		},
+		Content:   cm.Content,
+		Status:    string(cm.Status),
+		CreatedAt: cm.CreatedAt,
+		Replies:   toCommentResList(cm.Replies),
+	}
+}
+
+// toAdminCommentRes maps a domain Comment to the admin moderation response DTO.
+func toAdminCommentRes(cm *entity.Comment) response.AdminCommentRes {
+	return response.AdminCommentRes{
+		ID:            cm.ID,
+		PostID:        cm.PostID,
+		PostTitle:     cm.PostTitle,
+		ParentID:      cm.ParentID,
+		UserID:        cm.UserID,
+		AuthorName:    cm.AuthorName,
+		AuthorEmail:   cm.AuthorEmail,
+		AuthorWebsite: cm.AuthorWebsite,
+		Content:       cm.Content,
+		Status:        string(cm.Status),
+		SpamReason:    cm.SpamReason,
+		IP:            cm.IP,
+		UserAgent:     cm.UserAgent,
+		CreatedAt:     cm.CreatedAt,
+		UpdatedAt:     cm.UpdatedAt,
+	}
+}
+
+// toCommentResList converts a list of domain comments to response DTOs.
+func toCommentResList(comments []*entity.Comment) []response.CommentRes {
+	if len(comments) == 0 {
+		return []response.CommentRes{}
+	}
+	result := make([]response.CommentRes, len(comments))
+	for i, cm := range comments {
+		result[i] = toCommentRes(cm)
+	}
+	return result
+}
+
+// renderNotifyPage renders a subscribe or unsubscribe page in the given state.
+func renderNotifyPage(c *echo.Context, status int, t *template.Template, name string, data *notifyPageData) error {
+	data.Title = "Reply Notifications"
+
+	var page bytes.Buffer
+	if err := t.ExecuteTemplate(&page, name, data); err != nil {
+		return errx.New(errx.CodeInternalError, err)
+	}
+	return c.HTMLBlob(status, page.Bytes())
+}
+
+// setCommentGuestCookie hands a guest the token of their comment, which lets
+// their later comments skip moderation once this one is approved.
+func setCommentGuestCookie(c *echo.Context, token string) {
+	c.SetCookie(&http.Cookie{
+		Name:     commentGuestCookie,
+		Value:    token,
+		Path:     commentCookiePath,
+		Expires:  time.Now().Add(commentGuestMaxAge),
+		HttpOnly: true,
+		Secure:   true,
+		SameSite: http.SameSiteStrictMode,
+	})
+}
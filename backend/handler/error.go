@@ -1,6 +1,8 @@
 package handler
 
 import (
+	"math"
+	"strconv"
 	"time"
 
 	"blog-server/config"
@@ -44,6 +46,10 @@ func ErrorHandler(
 			logCtx.Error("request failed", logger.Err(err))
 		}
 
+		if after, ok := errx.RetryAfter(err); ok {
+			c.Response().Header().Set("Retry-After", strconv.Itoa(int(math.Ceil(after.Seconds()))))
+		}
+
 		if sendErr := c.JSON(httpCode, response.Error(
 			appErr.Code,
 			errx.MessageForCode(appErr.Code),
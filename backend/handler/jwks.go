@@ -0,0 +1,43 @@
+package handler
+
+import (
+	"fmt"
+	"net/http"
+
+	"blog-server/service"
+
+	"github.com/labstack/echo/v5"
+)
+
+// JWKSHandler defines the interface for the public signing key HTTP handlers.
+type JWKSHandler interface {
+	GetJWKS(c *echo.Context) error
+}
+
+// jwksHandler implements the JWKSHandler interface.
+type jwksHandler struct {
+	svc service.SigningKeyService
+}
+
+// NewJWKSHandler creates a new JWKS handler instance.
+func NewJWKSHandler(svc service.SigningKeyService) JWKSHandler {
+	return &jwksHandler{svc: svc}
+}
+
+// GetJWKS serves the public keys tokens can be verified with.
+//
+// The document follows RFC 7517 and is not wrapped in the API response envelope.
+func (h *jwksHandler) GetJWKS(c *echo.Context) error {
+	set, err := h.svc.JWKS(c.Request().Context())
+	if err != nil {
+		return err
+	}
+
+	c.Response().Header().Set("Cache-Control", fmt.Sprintf("public, max-age=%d", int(service.JWKSMaxAge.Seconds())))
+	return c.JSON(http.StatusOK, set)
+}
+
+// RegisterJWKSRoutes registers the well-known JWKS route at the server root.
+func RegisterJWKSRoutes(app *echo.Echo, h JWKSHandler) {
+	app.GET("/.well-known/jwks.json", h.GetJWKS)
+}
@@ -4,6 +4,7 @@ import (
 	"fmt"
 
 	"blog-server/entity"
+	"blog-server/middleware"
 	"blog-server/pkg/errx"
 	"blog-server/pkg/validatorx"
 	"blog-server/request"
@@ -78,10 +79,10 @@ func (h *linkHandler) ApplyForALinks(c *echo.Context) error {
 }
 
 // RegisterLinkRoutes registers all link-related routes.
-func RegisterLinkRoutes(r *echo.Group, h LinkHandler) {
+func RegisterLinkRoutes(r *echo.Group, h LinkHandler, rl *middleware.RateLimiter) {
 	group := r.Group("/links")
 	group.GET("", h.GetLinks)
-	group.POST("/apply-link", h.ApplyForALinks)
+	group.POST("/apply-link", h.ApplyForALinks, rl.RateLimit("apply_link"))
 }
 
 // toLinkResponse maps a domain Link to the response DTO.
@@ -0,0 +1,101 @@
+package handler
+
+import (
+	"fmt"
+
+	"blog-server/contextx"
+	"blog-server/entity"
+	"blog-server/middleware"
+	"blog-server/pkg/errx"
+	"blog-server/response"
+	"blog-server/service"
+
+	"github.com/labstack/echo/v5"
+)
+
+// MailHandler defines the interface for outbound mail HTTP handlers.
+type MailHandler interface {
+	GetDeadLetters(c *echo.Context) error
+	RetryDeadLetter(c *echo.Context) error
+	DeleteDeadLetter(c *echo.Context) error
+}
+
+// mailHandler implements the MailHandler interface.
+type mailHandler struct {
+	svc service.MailService
+}
+
+// NewMailHandler creates a new mail handler instance.
+func NewMailHandler(svc service.MailService) MailHandler {
+	return &mailHandler{svc: svc}
+}
+
+// GetDeadLetters lists emails that could not be delivered.
+func (h *mailHandler) GetDeadLetters(c *echo.Context) error {
+	u, ok := contextx.GetUser(c.Request().Context())
+	if !ok {
+		return errx.New(errx.CodeUnauthorized, fmt.Errorf("missing user in context"))
+	}
+
+	msgs, err := h.svc.ListDeadLetters(c.Request().Context(), u)
+	if err != nil {
+		return err
+	}
+
+	res := make([]response.MailDeadLetterRes, len(msgs))
+	for i, msg := range msgs {
+		res[i] = toMailDeadLetterRes(msg)
+	}
+
+	return response.OK(c, response.Success(res))
+}
+
+// RetryDeadLetter puts an undelivered email back into the queue.
+func (h *mailHandler) RetryDeadLetter(c *echo.Context) error {
+	u, ok := contextx.GetUser(c.Request().Context())
+	if !ok {
+		return errx.New(errx.CodeUnauthorized, fmt.Errorf("missing user in context"))
+	}
+
+	if err := h.svc.RetryDeadLetter(c.Request().Context(), u, c.Param("id")); err != nil {
+		return err
+	}
+
+	return response.OK(c, response.Success[any](nil))
+}
+
+// DeleteDeadLetter discards an undelivered email.
+func (h *mailHandler) DeleteDeadLetter(c *echo.Context) error {
+	u, ok := contextx.GetUser(c.Request().Context())
+	if !ok {
+		return errx.New(errx.CodeUnauthorized, fmt.Errorf("missing user in context"))
+	}
+
+	if err := h.svc.DeleteDeadLetter(c.Request().Context(), u, c.Param("id")); err != nil {
+		return err
+	}
+
+	return response.OK(c, response.Success[any](nil))
+}
+
+// RegisterMailRoutes registers all mail-related routes.
+func RegisterMailRoutes(r *echo.Group, h MailHandler, am *middleware.AuthMiddleware) {
+	adminGroup := r.Group("/admin/mail/dead-letters")
+	adminGroup.GET("", h.GetDeadLetters, am.Handler())
+	adminGroup.POST("/:id/retry", h.RetryDeadLetter, am.Handler())
+	adminGroup.DELETE("/:id", h.DeleteDeadLetter, am.Handler())
+}
+
+// toMailDeadLetterRes maps a dead-lettered message to its response DTO.
+func toMailDeadLetterRes(msg *entity.MailMessage) response.MailDeadLetterRes {
+	return response.MailDeadLetterRes{
+		ID:        msg.ID,
+		To:        msg.To,
+		Subject:   msg.Subject,
+		Attempts:  msg.Attempts,
+		LastError: msg.LastError,
+		CreatedAt: msg.CreatedAt,
+		FailedAt:  msg.DeadSince(),
+		ExpiresAt: msg.ExpiresAt,
+	}
+}
@@ -7,6 +7,7 @@ import (
 	"sync"
 
 	"blog-server/logger"
+	"blog-server/middleware"
 	"blog-server/pkg/errx"
 	"blog-server/response"
 	"blog-server/service"
@@ -139,8 +140,8 @@ func (h *modelHandler) stream(c *echo.Context, sessionID string) error {
 }
 
 // RegisterModelRoutes registers all model-related routes.
-func RegisterModelRoutes(r *echo.Group, h ModelHandler) {
+func RegisterModelRoutes(r *echo.Group, h ModelHandler, rl *middleware.RateLimiter) {
 	group := r.Group("/model")
-	group.POST("/summarize", h.CreateSummarySession)
+	group.POST("/summarize", h.CreateSummarySession, rl.RateLimit("summarize"))
 	group.GET("/summarize/:sessionId", h.SummaryStream)
 }
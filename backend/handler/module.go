@@ -11,17 +11,31 @@ import (
 type Handlers struct {
 	fx.In
 
-	Post  PostHandler
-	Rss   RssHandler
-	Auth  AuthHandler
-	Link  LinkHandler
-	Model ModelHandler
+	Post         PostHandler
+	PostRevision PostRevisionHandler
+	PostTrash    PostTrashHandler
+	Rss          RssHandler
+	Auth         AuthHandler
+	Link         LinkHandler
+	Model        ModelHandler
+	Comment      CommentHandler
+	Stats        StatsHandler
+	Mail         MailHandler
+	Session      SessionHandler
+	JWKS         JWKSHandler
+	TwoFactor    TwoFactorHandler
+	Passkey      PasskeyHandler
+	OAuth        OAuthHandler
+	AccessToken  AccessTokenHandler
+	User         UserHandler
+	AdminUser    AdminUserHandler
 }
 
 type Middlewares struct {
 	fx.In
 
-	Auth *middleware.AuthMiddleware
+	Auth      *middleware.AuthMiddleware
+	RateLimit *middleware.RateLimiter
 }
 
 func RegisterRoutes(
@@ -29,13 +43,27 @@ func RegisterRoutes(
 	h Handlers,
 	m Middlewares,
 ) {
+	RegisterJWKSRoutes(app, h.JWKS)
+
 	api := app.Group("/api")
 	v1 := api.Group("/v1")
-	RegisterAuthRoutes(v1, h.Auth)
-	RegisterPostRoutes(v1, h.Post, m.Auth)
+	RegisterAuthRoutes(v1, h.Auth, m.Auth)
+	RegisterPostRoutes(v1, h.Post, m.Auth, m.RateLimit)
+	RegisterPostRevisionRoutes(v1, h.PostRevision, m.Auth)
+	RegisterPostTrashRoutes(v1, h.PostTrash, m.Auth)
 	RegisterRssRoutes(v1, h.Rss)
-	RegisterLinkRoutes(v1, h.Link)
-	RegisterModelRoutes(v1, h.Model)
+	RegisterLinkRoutes(v1, h.Link, m.RateLimit)
+	RegisterModelRoutes(v1, h.Model, m.RateLimit)
+	RegisterCommentRoutes(v1, h.Comment, m.Auth, m.RateLimit)
+	RegisterStatsRoutes(v1, h.Stats, m.Auth)
+	RegisterMailRoutes(v1, h.Mail, m.Auth)
+	RegisterSessionRoutes(v1, h.Session, m.Auth)
+	RegisterTwoFactorRoutes(v1, h.TwoFactor, m.Auth)
+	RegisterPasskeyRoutes(v1, h.Passkey, m.Auth)
+	RegisterOAuthRoutes(v1, h.OAuth, m.Auth)
+	RegisterAccessTokenRoutes(v1, h.AccessToken, m.Auth)
+	RegisterUserRoutes(v1, h.User, m.Auth)
+	RegisterAdminUserRoutes(v1, h.AdminUser, m.Auth)
 }
 
 func Module() fx.Option {
@@ -43,10 +71,23 @@ func Module() fx.Option {
 		"handler",
 		fx.Provide(
 			NewPostHandler,
+			NewPostRevisionHandler,
+			NewPostTrashHandler,
 			NewRssHandler,
 			NewAuthHandler,
 			NewLinkHandler,
 			NewModelHandler,
+			NewCommentHandler,
+			NewStatsHandler,
+			NewMailHandler,
+			NewSessionHandler,
+			NewJWKSHandler,
+			NewTwoFactorHandler,
+			NewPasskeyHandler,
+			NewOAuthHandler,
+			NewAccessTokenHandler,
+			NewUserHandler,
+			NewAdminUserHandler,
 		),
 		fx.Invoke(
 			RegisterRoutes,
@@ -0,0 +1,266 @@
+package handler
+
+import (
+	"fmt"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+
+	"blog-server/contextx"
+	"blog-server/entity"
+	"blog-server/middleware"
+	"blog-server/pkg/errx"
+	"blog-server/pkg/validatorx"
+	"blog-server/request"
+	"blog-server/response"
+	"blog-server/service"
+
+	"github.com/labstack/echo/v5"
+)
+
+const (
+	// oauthBindingCookie carries the binding of a pending authorization.
+	oauthBindingCookie = "oauthBinding"
+	oauthCookiePath    = "/api/v1/auth/oauth"
+)
+
+// OAuthHandler defines the interface for external login HTTP handlers.
+type OAuthHandler interface {
+	ListProviders(c *echo.Context) error
+	Start(c *echo.Context) error
+	Callback(c *echo.Context) error
+	ListIdentities(c *echo.Context) error
+	Link(c *echo.Context) error
+	Unlink(c *echo.Context) error
+}
+
+// oauthHandler implements the OAuthHandler interface.
+type oauthHandler struct {
+	svc      service.OAuthService
+	auth     service.AuthService
+	validate validatorx.Validator
+}
+
+// NewOAuthHandler creates a new external login handler instance.
+func NewOAuthHandler(svc service.OAuthService, auth service.AuthService, validate validatorx.Validator) OAuthHandler {
+	return &oauthHandler{svc: svc, auth: auth, validate: validate}
+}
+
+// ListProviders returns the names of the enabled login providers.
+func (h *oauthHandler) ListProviders(c *echo.Context) error {
+	providers := h.svc.Providers()
+	if providers == nil {
+		providers = []string{}
+	}
+	return response.OK(c, response.Success(response.OAuthProvidersRes{Providers: providers}))
+}
+
+// Start redirects the browser to the provider to log in.
+func (h *oauthHandler) Start(c *echo.Context) error {
+	req := new(request.OAuthStartReq)
+
+	if err := c.Bind(req); err != nil {
+		return errx.New(errx.CodeInvalidParam, err)
+	}
+
+	if err := h.validate.Struct(req); err != nil {
+		return errx.New(errx.CodeValidationFailed, err)
+	}
+
+	authorization, err := h.svc.Authorize(c.Request().Context(), &service.OAuthStartInput{
+		Provider: c.Param("provider"),
+		Redirect: req.Redirect,
+	})
+	if err != nil {
+		return err
+	}
+
+	setOAuthBindingCookie(c, authorization)
+
+	return c.Redirect(http.StatusFound, authorization.URL)
+}
+
+// Callback completes a login or link when the provider redirects back.
+//
+// After a login the refresh token cookie is set and the frontend obtains an
+// access token with /auth/refresh. When a second factor is needed, the login
+// challenge is passed in the URL fragment, which never reaches a server.
+func (h *oauthHandler) Callback(c *echo.Context) error {
+	req := new(request.OAuthCallbackReq)
+
+	if err := c.Bind(req); err != nil {
+		return errx.New(errx.CodeInvalidParam, err)
+	}
+
+	if err := h.validate.Struct(req); err != nil {
+		return errx.New(errx.CodeValidationFailed, err)
+	}
+
+	provider := c.Param("provider")
+	if req.Error != "" {
+		return errx.New(errx.CodeUnauthorized, fmt.Errorf("login with %s was not completed: %s", provider, req.Error))
+	}
+
+	var binding string
+	if cookie, err := c.Cookie(oauthBindingCookie); err == nil {
+		binding = cookie.Value
+	}
+	clearOAuthBindingCookie(c)
+
+	ctx := c.Request().Context()
+	callback, err := h.svc.Callback(ctx, provider, req.Code, req.State, binding)
+	if err != nil {
+		return err
+	}
+
+	if callback.LinkUserID != 0 {
+		if _, err := h.svc.Link(ctx, callback.LinkUserID, provider, callback.Identity); err != nil {
+			return err
+		}
+		return c.Redirect(http.StatusFound, callback.Redirect)
+	}
+
+	result, err := h.auth.LoginOAuth(ctx, &service.OAuthLoginInput{
+		Provider: provider,
+		Identity: callback.Identity,
+		Meta:     sessionMeta(c),
+	})
+	if err != nil {
+		return err
+	}
+
+	if result.TwoFactorRequired {
+		fragment := url.Values{
+			"twoFactorRequired": {"true"},
+			"challengeToken":    {result.ChallengeToken},
+		}
+		target, _, _ := strings.Cut(callback.Redirect, "#")
+		return c.Redirect(http.StatusFound, target+"#"+fragment.Encode())
+	}
+
+	setRefreshTokenCookie(c, result.RefreshToken)
+
+	return c.Redirect(http.StatusFound, callback.Redirect)
+}
+
+// ListIdentities returns the external accounts linked to the logged-in user.
+func (h *oauthHandler) ListIdentities(c *echo.Context) error {
+	u, ok := contextx.GetUser(c.Request().Context())
+	if !ok {
+		return errx.New(errx.CodeUnauthorized, fmt.Errorf("missing user in context"))
+	}
+
+	identities, err := h.svc.ListIdentities(c.Request().Context(), u.ID)
+	if err != nil {
+		return err
+	}
+
+	res := make([]*response.IdentityRes, len(identities))
+	for i, identity := range identities {
+		res[i] = toIdentityRes(identity)
+	}
+
+	return response.OK(c, response.Success(res))
+}
+
+// Link returns the provider URL for linking an external account to the logged-in user.
+func (h *oauthHandler) Link(c *echo.Context) error {
+	req := new(request.OAuthLinkReq)
+
+	if err := c.Bind(req); err != nil {
+		return errx.New(errx.CodeInvalidParam, err)
+	}
+
+	if err := h.validate.Struct(req); err != nil {
+		return errx.New(errx.CodeValidationFailed, err)
+	}
+
+	u, ok := contextx.GetUser(c.Request().Context())
+	if !ok {
+		return errx.New(errx.CodeUnauthorized, fmt.Errorf("missing user in context"))
+	}
+
+	authorization, err := h.svc.Authorize(c.Request().Context(), &service.OAuthStartInput{
+		Provider:   c.Param("provider"),
+		Redirect:   req.Redirect,
+		LinkUserID: u.ID,
+	})
+	if err != nil {
+		return err
+	}
+
+	setOAuthBindingCookie(c, authorization)
+
+	return response.OK(c, response.Success(response.OAuthLinkRes{URL: authorization.URL}))
+}
+
+// Unlink removes an external account of the logged-in user.
+func (h *oauthHandler) Unlink(c *echo.Context) error {
+	id, err := strconv.Atoi(c.Param("id"))
+	if err != nil {
+		return errx.New(errx.CodeInvalidParam, err)
+	}
+
+	u, ok := contextx.GetUser(c.Request().Context())
+	if !ok {
+		return errx.New(errx.CodeUnauthorized, fmt.Errorf("missing user in context"))
+	}
+
+	if err := h.svc.Unlink(c.Request().Context(), u.ID, uint(id)); err != nil {
+		return err
+	}
+
+	return response.OK(c, response.Success[any](nil))
+}
+
+// RegisterOAuthRoutes registers all external login routes.
+func RegisterOAuthRoutes(r *echo.Group, h OAuthHandler, am *middleware.AuthMiddleware) {
+	group := r.Group("/auth/oauth")
+	group.GET("/providers", h.ListProviders)
+	group.GET("/:provider/start", h.Start)
+	group.GET("/:provider/callback", h.Callback)
+
+	identities := r.Group("/me/identities")
+	identities.GET("", h.ListIdentities, am.SessionHandler())
+	identities.POST("/:provider", h.Link, am.SessionHandler())
+	identities.DELETE("/:id", h.Unlink, am.SessionHandler())
+}
+
+// setOAuthBindingCookie hands the binding of an authorization to the browser.
+// It is sent on the top-level redirect back from the provider, which
+// SameSite=Strict would prevent.
+func setOAuthBindingCookie(c *echo.Context, authorization *service.OAuthAuthorization) {
+	c.SetCookie(&http.Cookie{
+		Name:     oauthBindingCookie,
+		Value:    authorization.Binding,
+		Path:     oauthCookiePath,
+		Expires:  authorization.ExpiresAt,
+		HttpOnly: true,
+		Secure:   true,
+		SameSite: http.SameSiteLaxMode,
+	})
+}
+
+// clearOAuthBindingCookie removes the binding once the callback consumed it.
+func clearOAuthBindingCookie(c *echo.Context) {
+	c.SetCookie(&http.Cookie{
+		Name:     oauthBindingCookie,
+		Path:     oauthCookiePath,
+		MaxAge:   -1,
+		HttpOnly: true,
+		Secure:   true,
+		SameSite: http.SameSiteLaxMode,
+	})
+}
+
+// toIdentityRes converts a linked identity into an IdentityRes.
+func toIdentityRes(i *entity.UserIdentity) *response.IdentityRes {
+	return &response.IdentityRes{
+		ID:          i.ID,
+		Provider:    i.Provider,
+		Email:       i.Email,
+		CreatedAt:   i.CreatedAt,
+		LastLoginAt: i.LastLoginAt,
+	}
+}
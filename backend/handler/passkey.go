@@ -0,0 +1,183 @@
+package handler
+
+import (
+	"fmt"
+	"strconv"
+
+	"blog-server/contextx"
+	"blog-server/entity"
+	"blog-server/middleware"
+	"blog-server/pkg/errx"
+	"blog-server/pkg/validatorx"
+	"blog-server/request"
+	"blog-server/response"
+	"blog-server/service"
+
+	"github.com/labstack/echo/v5"
+)
+
+// PasskeyHandler defines the interface for passkey HTTP handlers.
+type PasskeyHandler interface {
+	List(c *echo.Context) error
+	BeginRegistration(c *echo.Context) error
+	FinishRegistration(c *echo.Context) error
+	Delete(c *echo.Context) error
+	BeginLogin(c *echo.Context) error
+	FinishLogin(c *echo.Context) error
+}
+
+// passkeyHandler implements the PasskeyHandler interface.
+type passkeyHandler struct {
+	svc      service.PasskeyService
+	auth     service.AuthService
+	validate validatorx.Validator
+}
+
+// NewPasskeyHandler creates a new passkey handler instance.
+func NewPasskeyHandler(svc service.PasskeyService, auth service.AuthService, validate validatorx.Validator) PasskeyHandler {
+	return &passkeyHandler{svc: svc, auth: auth, validate: validate}
+}
+
+// List returns the passkeys of the logged-in user.
+func (h *passkeyHandler) List(c *echo.Context) error {
+	u, ok := contextx.GetUser(c.Request().Context())
+	if !ok {
+		return errx.New(errx.CodeUnauthorized, fmt.Errorf("missing user in context"))
+	}
+
+	passkeys, err := h.svc.List(c.Request().Context(), u.ID)
+	if err != nil {
+		return err
+	}
+
+	res := make([]*response.PasskeyRes, len(passkeys))
+	for i, p := range passkeys {
+		res[i] = toPasskeyRes(p)
+	}
+
+	return response.OK(c, response.Success(res))
+}
+
+// BeginRegistration returns the options for navigator.credentials.create().
+func (h *passkeyHandler) BeginRegistration(c *echo.Context) error {
+	u, ok := contextx.GetUser(c.Request().Context())
+	if !ok {
+		return errx.New(errx.CodeUnauthorized, fmt.Errorf("missing user in context"))
+	}
+
+	options, err := h.svc.BeginRegistration(c.Request().Context(), u.ID)
+	if err != nil {
+		return err
+	}
+
+	return response.OK(c, response.Success(options))
+}
+
+// FinishRegistration stores the passkey created by the browser.
+func (h *passkeyHandler) FinishRegistration(c *echo.Context) error {
+	req := new(request.PasskeyRegisterReq)
+
+	if err := c.Bind(req); err != nil {
+		return errx.New(errx.CodeInvalidParam, err)
+	}
+
+	if err := h.validate.Struct(req); err != nil {
+		return errx.New(errx.CodeValidationFailed, err)
+	}
+
+	u, ok := contextx.GetUser(c.Request().Context())
+	if !ok {
+		return errx.New(errx.CodeUnauthorized, fmt.Errorf("missing user in context"))
+	}
+
+	passkey, err := h.svc.FinishRegistration(c.Request().Context(), u.ID, req.Name, req.Credential)
+	if err != nil {
+		return err
+	}
+
+	return response.OK(c, response.Success(toPasskeyRes(passkey)))
+}
+
+// Delete removes a passkey of the logged-in user.
+func (h *passkeyHandler) Delete(c *echo.Context) error {
+	id, err := strconv.Atoi(c.Param("id"))
+	if err != nil {
+		return errx.New(errx.CodeInvalidParam, err)
+	}
+
+	u, ok := contextx.GetUser(c.Request().Context())
+	if !ok {
+		return errx.New(errx.CodeUnauthorized, fmt.Errorf("missing user in context"))
+	}
+
+	if err := h.svc.Delete(c.Request().Context(), u.ID, uint(id)); err != nil {
+		return err
+	}
+
+	return response.OK(c, response.Success[any](nil))
+}
+
+// BeginLogin returns the options for navigator.credentials.get().
+func (h *passkeyHandler) BeginLogin(c *echo.Context) error {
+	challenge, err := h.svc.BeginLogin(c.Request().Context())
+	if err != nil {
+		return err
+	}
+
+	return response.OK(c, response.Success(response.PasskeyLoginOptionsRes{
+		ChallengeID: challenge.ID,
+		Options:     challenge.Options,
+	}))
+}
+
+// FinishLogin logs in with the passkey assertion from the browser.
+func (h *passkeyHandler) FinishLogin(c *echo.Context) error {
+	req := new(request.PasskeyLoginReq)
+
+	if err := c.Bind(req); err != nil {
+		return errx.New(errx.CodeInvalidParam, err)
+	}
+
+	if err := h.validate.Struct(req); err != nil {
+		return errx.New(errx.CodeValidationFailed, err)
+	}
+
+	result, err := h.auth.LoginPasskey(c.Request().Context(), &service.PasskeyLoginInput{
+		ChallengeID: req.ChallengeID,
+		Response:    req.Credential,
+		Meta:        sessionMeta(c),
+	})
+	if err != nil {
+		return err
+	}
+
+	if !result.TwoFactorRequired {
+		setRefreshTokenCookie(c, result.RefreshToken)
+	}
+
+	return response.OK(c, response.Success(toLoginRes(result)))
+}
+
+// RegisterPasskeyRoutes registers all passkey routes.
+func RegisterPasskeyRoutes(r *echo.Group, h PasskeyHandler, am *middleware.AuthMiddleware) {
+	group := r.Group("/me/passkeys")
+	group.GET("", h.List, am.SessionHandler())
+	group.POST("/register/begin", h.BeginRegistration, am.SessionHandler())
+	group.POST("/register/finish", h.FinishRegistration, am.SessionHandler())
+	group.DELETE("/:id", h.Delete, am.SessionHandler())
+
+	login := r.Group("/auth/passkey/login")
+	login.POST("/begin", h.BeginLogin)
+	login.POST("/finish", h.FinishLogin)
+}
+
+// toPasskeyRes converts a passkey into a PasskeyRes.
+func toPasskeyRes(p *entity.Passkey) *response.PasskeyRes {
+	return &response.PasskeyRes{
+		ID:         p.ID,
+		Name:       p.Name,
+		Transports: p.Transports,
+		CreatedAt:  p.CreatedAt,
+		LastUsedAt: p.LastUsedAt,
+	}
+}
@@ -2,6 +2,9 @@ package handler
 
 import (
 	"fmt"
+	"net/http"
+	"net/url"
+	"path"
 	"strconv"
 
 	"blog-server/contextx"
@@ -20,6 +23,8 @@ import (
 type PostHandler interface {
 	GetPosts(c *echo.Context) error
 	GetPost(c *echo.Context) error
+	GetPostBySlug(c *echo.Context) error
+	SearchPosts(c *echo.Context) error
 	GetPostIds(c *echo.Context) error
 	CreatePost(c *echo.Context) error
 
@@ -82,6 +87,56 @@ func (h *postHandler) GetPost(c *echo.Context) error {
 	return response.OK(c, response.Success(toPostRes(post)))
 }
 
+// SearchPosts runs a full-text search of published posts, most relevant
+// first, optionally within a tag or category.
+func (h *postHandler) SearchPosts(c *echo.Context) error {
+	query := &request.PostSearchReq{Page: 1, PageSize: 10}
+	if err := c.Bind(query); err != nil {
+		return errx.New(errx.CodeInvalidParam, err)
+	}
+
+	if err := h.validate.Struct(query); err != nil {
+		return errx.New(errx.CodeValidationFailed, err)
+	}
+
+	results, total, err := h.svc.SearchPosts(c.Request().Context(), &service.SearchPostsInput{
+		Query:      query.Q,
+		TagID:      query.TagID,
+		CategoryID: query.CategoryID,
+		Page:       query.Page,
+		PageSize:   query.PageSize,
+	})
+	if err != nil {
+		return err
+	}
+
+	resultDTOs := make([]response.PostSearchRes, len(results))
+	for i, result := range results {
+		resultDTOs[i] = toPostSearchRes(result)
+	}
+
+	return response.OK(c, response.Success(response.Page[response.PostSearchRes]{
+		Total: total,
+		List:  resultDTOs,
+	}))
+}
+
+// GetPostBySlug retrieves a single published post by slug. Former slugs of a
+// post redirect permanently to its current slug.
+func (h *postHandler) GetPostBySlug(c *echo.Context) error {
+	post, current, err := h.svc.GetPostBySlug(c.Request().Context(), c.Param("slug"))
+	if err != nil {
+		return err
+	}
+
+	if current != "" {
+		location := path.Join(path.Dir(c.Request().URL.Path), url.PathEscape(current))
+		return c.Redirect(http.StatusMovedPermanently, location)
+	}
+
+	return response.OK(c, response.Success(toPostRes(post)))
+}
+
 // GetPostIds retrieves metadata (id and updated_at) for all posts.
 func (h *postHandler) GetPostIds(c *echo.Context) error {
 	metas := h.svc.GetPostsMeta(c.Request().Context())
@@ -90,6 +145,7 @@ func (h *postHandler) GetPostIds(c *echo.Context) error {
 	for i, meta := range metas {
 		metasDTO[i] = response.PostMetaRes{
 			ID:        meta.ID,
+			Slug:      meta.Slug,
 			UpdatedAt: meta.UpdatedAt,
 		}
 	}
@@ -115,11 +171,13 @@ func (h *postHandler) CreatePost(c *echo.Context) error {
 
 	input := &service.CreatePostInput{
 		Title:       req.Title,
+		Slug:        req.Slug,
 		Summary:     req.Summary,
 		Cover:       req.Cover,
 		Content:     req.Content,
 		UserID:      u.ID,
 		Status:      req.Status,
+		PublishAt:   req.PublishAt,
 		Tags:        req.Tags,
 		CategoryIDs: req.CategoryIDs,
 	}
@@ -143,7 +201,12 @@ func (h *postHandler) AdminGetPosts(c *echo.Context) error {
 		return errx.New(errx.CodeValidationFailed, err)
 	}
 
-	posts, total, err := h.svc.AdminGetPosts(c.Request().Context(), query.Status, query.Keyword, query.Page, query.PageSize)
+	u, ok := contextx.GetUser(c.Request().Context())
+	if !ok {
+		return errx.New(errx.CodeUnauthorized, fmt.Errorf("missing user in context"))
+	}
+
+	posts, total, err := h.svc.AdminGetPosts(c.Request().Context(), u, query.Status, query.Keyword, query.Page, query.PageSize)
 	if err != nil {
 		return err
 	}
@@ -166,7 +229,12 @@ func (h *postHandler) AdminGetPost(c *echo.Context) error {
 		return errx.New(errx.CodeInvalidParam, err)
 	}
 
-	post, err := h.svc.AdminGetPostByID(c.Request().Context(), uint(id))
+	u, ok := contextx.GetUser(c.Request().Context())
+	if !ok {
+		return errx.New(errx.CodeUnauthorized, fmt.Errorf("missing user in context"))
+	}
+
+	post, err := h.svc.AdminGetPostByID(c.Request().Context(), u, uint(id))
 	if err != nil {
 		return err
 	}
@@ -198,10 +266,12 @@ func (h *postHandler) UpdatePost(c *echo.Context) error {
 	input := &service.UpdatePostInput{
 		ID:          uint(id),
 		Title:       req.Title,
+		Slug:        req.Slug,
 		Summary:     req.Summary,
 		Cover:       req.Cover,
 		Content:     req.Content,
 		Status:      req.Status,
+		PublishAt:   req.PublishAt,
 		Tags:        req.Tags,
 		CategoryIDs: req.CategoryIDs,
 	}
@@ -234,10 +304,12 @@ func (h *postHandler) DeletePost(c *echo.Context) error {
 }
 
 // RegisterPostRoutes registers all post-related routes.
-func RegisterPostRoutes(r *echo.Group, h PostHandler, am *middleware.AuthMiddleware) {
+func RegisterPostRoutes(r *echo.Group, h PostHandler, am *middleware.AuthMiddleware, rl *middleware.RateLimiter) {
 	group := r.Group("/posts")
 	group.GET("", h.GetPosts)
 	group.GET("/meta", h.GetPostIds)
+	group.GET("/search", h.SearchPosts, rl.RateLimit("search"))
+	group.GET("/slug/:slug", h.GetPostBySlug)
 	group.GET("/:id", h.GetPost)
 	group.POST("", h.CreatePost, am.Handler())
 
@@ -254,6 +326,7 @@ func toPostRes(p *entity.Post) response.PostRes {
 	return response.PostRes{
 		ID:              p.ID,
 		Title:           p.Title,
+		Slug:            p.Slug,
 		Summary:         p.Summary,
 		Content:         p.Content,
 		Cover:           p.Cover,
@@ -272,6 +345,7 @@ func toPostListRes(p *entity.Post) response.PostListRes {
 	return response.PostListRes{
 		ID:              p.ID,
 		Title:           p.Title,
+		Slug:            p.Slug,
 		Summary:         p.Summary,
 		Cover:           p.Cover,
 		ReadTimeMinutes: p.ReadTimeMinutes,
@@ -284,11 +358,29 @@ func toPostListRes(p *entity.Post) response.PostListRes {
 	}
 }
 
+// toPostSearchRes maps a search result to the response DTO.
+func toPostSearchRes(r *service.PostSearchResult) response.PostSearchRes {
+	return response.PostSearchRes{
+		ID:               r.Post.ID,
+		Title:            r.Post.Title,
+		HighlightedTitle: r.Title,
+		Snippet:          r.Snippet,
+		Slug:             r.Post.Slug,
+		Cover:            r.Post.Cover,
+		ReadTimeMinutes:  r.Post.ReadTimeMinutes,
+		PublishedAt:      r.Post.PublishedAt,
+		Author:           r.Post.User,
+		Tags:             toTagResList(r.Post.Tags),
+		Categories:       toCategoryResList(r.Post.Categories),
+	}
+}
+
 // toAdminPostRes maps a domain Post to the admin detail response DTO.
 func toAdminPostRes(p *entity.Post) response.AdminPostRes {
 	return response.AdminPostRes{
 		ID:              p.ID,
 		Title:           p.Title,
+		Slug:            p.Slug,
 		Summary:         p.Summary,
 		Content:         p.Content,
 		Cover:           p.Cover,
@@ -309,6 +401,7 @@ func toAdminPostListRes(p *entity.Post) response.AdminPostListRes {
 	return response.AdminPostListRes{
 		ID:              p.ID,
 		Title:           p.Title,
+		Slug:            p.Slug,
 		Summary:         p.Summary,
 		Cover:           p.Cover,
 		Status:          string(p.Status),
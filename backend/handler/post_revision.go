@@ -0,0 +1,195 @@
+package handler
+
+import (
+	"fmt"
+	"strconv"
+
+	"blog-server/contextx"
+	"blog-server/entity"
+	"blog-server/middleware"
+	"blog-server/pkg/errx"
+	"blog-server/pkg/validatorx"
+	"blog-server/request"
+	"blog-server/response"
+	"blog-server/service"
+	"blog-server/utils"
+
+	"github.com/labstack/echo/v5"
+)
+
+// PostRevisionHandler defines the interface for post revision HTTP handlers.
+type PostRevisionHandler interface {
+	ListRevisions(c *echo.Context) error
+	GetRevision(c *echo.Context) error
+	DiffRevisions(c *echo.Context) error
+	RestoreRevision(c *echo.Context) error
+}
+
+// postRevisionHandler implements the PostRevisionHandler interface.
+type postRevisionHandler struct {
+	svc      service.PostRevisionService
+	validate validatorx.Validator
+}
+
+// NewPostRevisionHandler creates a new post revision handler instance.
+func NewPostRevisionHandler(svc service.PostRevisionService, validate validatorx.Validator) PostRevisionHandler {
+	return &postRevisionHandler{svc: svc, validate: validate}
+}
+
+// ListRevisions lists the revisions of a post, newest first.
+func (h *postRevisionHandler) ListRevisions(c *echo.Context) error {
+	id, err := strconv.Atoi(c.Param("id"))
+	if err != nil {
+		return errx.New(errx.CodeInvalidParam, err)
+	}
+
+	query := &request.PostPageReq{Page: 1, PageSize: 20}
+	if err := c.Bind(query); err != nil {
+		return errx.New(errx.CodeInvalidParam, err)
+	}
+
+	if err := h.validate.Struct(query); err != nil {
+		return errx.New(errx.CodeValidationFailed, err)
+	}
+
+	u, ok := contextx.GetUser(c.Request().Context())
+	if !ok {
+		return errx.New(errx.CodeUnauthorized, fmt.Errorf("missing user in context"))
+	}
+
+	revs, total, err := h.svc.ListRevisions(c.Request().Context(), u, uint(id), query.Page, query.PageSize)
+	if err != nil {
+		return err
+	}
+
+	revDTOs := make([]response.PostRevisionRes, len(revs))
+	for i, rev := range revs {
+		revDTOs[i] = toPostRevisionRes(rev)
+	}
+
+	return response.OK(c, response.Success(response.Page[response.PostRevisionRes]{
+		Total: total,
+		List:  revDTOs,
+	}))
+}
+
+// GetRevision returns one revision of a post with its content.
+func (h *postRevisionHandler) GetRevision(c *echo.Context) error {
+	id, err := strconv.Atoi(c.Param("id"))
+	if err != nil {
+		return errx.New(errx.CodeInvalidParam, err)
+	}
+	version, err := strconv.Atoi(c.Param("version"))
+	if err != nil {
+		return errx.New(errx.CodeInvalidParam, err)
+	}
+
+	u, ok := contextx.GetUser(c.Request().Context())
+	if !ok {
+		return errx.New(errx.CodeUnauthorized, fmt.Errorf("missing user in context"))
+	}
+
+	rev, err := h.svc.GetRevision(c.Request().Context(), u, uint(id), uint(version))
+	if err != nil {
+		return err
+	}
+
+	return response.OK(c, response.Success(toPostRevisionRes(rev)))
+}
+
+// DiffRevisions returns the line-level diff between two revisions of a post.
+func (h *postRevisionHandler) DiffRevisions(c *echo.Context) error {
+	id, err := strconv.Atoi(c.Param("id"))
+	if err != nil {
+		return errx.New(errx.CodeInvalidParam, err)
+	}
+
+	query := new(request.PostRevisionDiffReq)
+	if err := c.Bind(query); err != nil {
+		return errx.New(errx.CodeInvalidParam, err)
+	}
+
+	if err := h.validate.Struct(query); err != nil {
+		return errx.New(errx.CodeValidationFailed, err)
+	}
+
+	u, ok := contextx.GetUser(c.Request().Context())
+	if !ok {
+		return errx.New(errx.CodeUnauthorized, fmt.Errorf("missing user in context"))
+	}
+
+	diff, err := h.svc.DiffRevisions(c.Request().Context(), u, uint(id), query.From, query.To)
+	if err != nil {
+		return err
+	}
+
+	res := response.PostRevisionDiffRes{
+		From:  toPostRevisionRes(diff.From),
+		To:    toPostRevisionRes(diff.To),
+		Lines: make([]response.DiffLineRes, len(diff.Lines)),
+	}
+	// The texts are in the lines; the revisions only describe both sides.
+	res.From.Content, res.To.Content = "", ""
+	for i, line := range diff.Lines {
+		switch line.Op {
+		case utils.DiffInsert:
+			res.Added++
+		case utils.DiffDelete:
+			res.Removed++
+		}
+		res.Lines[i] = response.DiffLineRes{
+			Op:      string(line.Op),
+			Text:    line.Text,
+			OldLine: line.OldLine,
+			NewLine: line.NewLine,
+		}
+	}
+
+	return response.OK(c, response.Success(res))
+}
+
+// RestoreRevision restores a revision into the post.
+func (h *postRevisionHandler) RestoreRevision(c *echo.Context) error {
+	id, err := strconv.Atoi(c.Param("id"))
+	if err != nil {
+		return errx.New(errx.CodeInvalidParam, err)
+	}
+	version, err := strconv.Atoi(c.Param("version"))
+	if err != nil {
+		return errx.New(errx.CodeInvalidParam, err)
+	}
+
+	u, ok := contextx.GetUser(c.Request().Context())
+	if !ok {
+		return errx.New(errx.CodeUnauthorized, fmt.Errorf("missing user in context"))
+	}
+
+	post, err := h.svc.RestoreRevision(c.Request().Context(), u, uint(id), uint(version))
+	if err != nil {
+		return err
+	}
+
+	return response.OK(c, response.Success(toAdminPostRes(post)))
+}
+
+// RegisterPostRevisionRoutes registers all post revision routes.
+func RegisterPostRevisionRoutes(r *echo.Group, h PostRevisionHandler, am *middleware.AuthMiddleware) {
+	group := r.Group("/admin/posts/:id/revisions")
+	group.GET("", h.ListRevisions, am.Handler())
+	group.GET("/diff", h.DiffRevisions, am.Handler())
+	group.GET("/:version", h.GetRevision, am.Handler())
+	group.POST("/:version/restore", h.RestoreRevision, am.Handler())
+}
+
+// toPostRevisionRes maps a domain PostRevision to the response DTO.
+func toPostRevisionRes(rev *entity.PostRevision) response.PostRevisionRes {
+	return response.PostRevisionRes{
+		Version:      rev.Version,
+		User:         rev.User,
+		Title:        rev.Title,
+		Summary:      rev.Summary,
+		Content:      rev.Content,
+		RestoredFrom: rev.RestoredFrom,
+		CreatedAt:    rev.CreatedAt,
+	}
+}
@@ -0,0 +1,146 @@
+package handler
+
+import (
+	"fmt"
+	"strconv"
+
+	"blog-server/contextx"
+	"blog-server/entity"
+	"blog-server/middleware"
+	"blog-server/pkg/errx"
+	"blog-server/pkg/validatorx"
+	"blog-server/request"
+	"blog-server/response"
+	"blog-server/service"
+
+	"github.com/labstack/echo/v5"
+)
+
+// PostTrashHandler defines the interface for post trash HTTP handlers.
+type PostTrashHandler interface {
+	ListTrash(c *echo.Context) error
+	RestorePost(c *echo.Context) error
+	PurgePost(c *echo.Context) error
+	EmptyTrash(c *echo.Context) error
+}
+
+// postTrashHandler implements the PostTrashHandler interface.
+type postTrashHandler struct {
+	svc      service.PostTrashService
+	validate validatorx.Validator
+}
+
+// NewPostTrashHandler creates a new post trash handler instance.
+func NewPostTrashHandler(svc service.PostTrashService, validate validatorx.Validator) PostTrashHandler {
+	return &postTrashHandler{svc: svc, validate: validate}
+}
+
+// ListTrash lists the deleted posts, most recently deleted first.
+func (h *postTrashHandler) ListTrash(c *echo.Context) error {
+	query := &request.PostPageReq{Page: 1, PageSize: 20}
+	if err := c.Bind(query); err != nil {
+		return errx.New(errx.CodeInvalidParam, err)
+	}
+
+	if err := h.validate.Struct(query); err != nil {
+		return errx.New(errx.CodeValidationFailed, err)
+	}
+
+	u, ok := contextx.GetUser(c.Request().Context())
+	if !ok {
+		return errx.New(errx.CodeUnauthorized, fmt.Errorf("missing user in context"))
+	}
+
+	posts, total, err := h.svc.ListTrash(c.Request().Context(), u, query.Page, query.PageSize)
+	if err != nil {
+		return err
+	}
+
+	postDTOs := make([]response.TrashPostRes, len(posts))
+	for i, post := range posts {
+		postDTOs[i] = toTrashPostRes(post)
+	}
+
+	return response.OK(c, response.Success(response.Page[response.TrashPostRes]{
+		Total: total,
+		List:  postDTOs,
+	}))
+}
+
+// RestorePost takes a post out of the trash.
+func (h *postTrashHandler) RestorePost(c *echo.Context) error {
+	id, err := strconv.Atoi(c.Param("id"))
+	if err != nil {
+		return errx.New(errx.CodeInvalidParam, err)
+	}
+
+	u, ok := contextx.GetUser(c.Request().Context())
+	if !ok {
+		return errx.New(errx.CodeUnauthorized, fmt.Errorf("missing user in context"))
+	}
+
+	post, err := h.svc.RestorePost(c.Request().Context(), u, uint(id))
+	if err != nil {
+		return err
+	}
+
+	return response.OK(c, response.Success(toAdminPostRes(post)))
+}
+
+// PurgePost permanently deletes a post in the trash.
+func (h *postTrashHandler) PurgePost(c *echo.Context) error {
+	id, err := strconv.Atoi(c.Param("id"))
+	if err != nil {
+		return errx.New(errx.CodeInvalidParam, err)
+	}
+
+	u, ok := contextx.GetUser(c.Request().Context())
+	if !ok {
+		return errx.New(errx.CodeUnauthorized, fmt.Errorf("missing user in context"))
+	}
+
+	if err := h.svc.PurgePost(c.Request().Context(), u, uint(id)); err != nil {
+		return err
+	}
+
+	return response.OK(c, response.Success[any](nil))
+}
+
+// EmptyTrash permanently deletes every post in the trash.
+func (h *postTrashHandler) EmptyTrash(c *echo.Context) error {
+	u, ok := contextx.GetUser(c.Request().Context())
+	if !ok {
+		return errx.New(errx.CodeUnauthorized, fmt.Errorf("missing user in context"))
+	}
+
+	n, err := h.svc.EmptyTrash(c.Request().Context(), u)
+	if err != nil {
+		return err
+	}
+
+	return response.OK(c, response.Success(response.EmptyTrashRes{Purged: n}))
+}
+
+// RegisterPostTrashRoutes registers all post trash routes.
+func RegisterPostTrashRoutes(r *echo.Group, h PostTrashHandler, am *middleware.AuthMiddleware) {
+	group := r.Group("/admin/posts/trash")
+	group.GET("", h.ListTrash, am.Handler())
+	group.DELETE("", h.EmptyTrash, am.Handler())
+	group.POST("/:id/restore", h.RestorePost, am.Handler())
+	group.DELETE("/:id", h.PurgePost, am.Handler())
+}
+
+// toTrashPostRes maps a domain Post in the trash to the response DTO.
+func toTrashPostRes(p *entity.Post) response.TrashPostRes {
+	return response.TrashPostRes{
+		ID:          p.ID,
+		Title:       p.Title,
+		Slug:        p.Slug,
+		Summary:     p.Summary,
+		User:        p.User,
+		Status:      string(p.Status),
+		PublishedAt: p.PublishedAt,
+		CreatedAt:   p.CreatedAt,
+		DeletedAt:   p.DeletedAt,
+	}
+}
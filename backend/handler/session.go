@@ -0,0 +1,100 @@
+package handler
+
+import (
+	"fmt"
+
+	"blog-server/contextx"
+	"blog-server/entity"
+	"blog-server/middleware"
+	"blog-server/pkg/errx"
+	"blog-server/response"
+	"blog-server/service"
+
+	"github.com/labstack/echo/v5"
+)
+
+// SessionHandler defines the interface for login session HTTP handlers.
+type SessionHandler interface {
+	GetSessions(c *echo.Context) error
+	RevokeSession(c *echo.Context) error
+	RevokeAllSessions(c *echo.Context) error
+}
+
+// sessionHandler implements the SessionHandler interface.
+type sessionHandler struct {
+	svc service.SessionService
+}
+
+// NewSessionHandler creates a new session handler instance.
+func NewSessionHandler(svc service.SessionService) SessionHandler {
+	return &sessionHandler{svc: svc}
+}
+
+// GetSessions lists the active sessions of the logged-in user.
+func (h *sessionHandler) GetSessions(c *echo.Context) error {
+	u, ok := contextx.GetUser(c.Request().Context())
+	if !ok {
+		return errx.New(errx.CodeUnauthorized, fmt.Errorf("missing user in context"))
+	}
+
+	sessions, err := h.svc.List(c.Request().Context(), u.ID)
+	if err != nil {
+		return err
+	}
+
+	res := make([]response.SessionRes, len(sessions))
+	for i, s := range sessions {
+		res[i] = toSessionRes(s, u.SessionID)
+	}
+
+	return response.OK(c, response.Success(res))
+}
+
+// RevokeSession logs out a single session of the logged-in user.
+func (h *sessionHandler) RevokeSession(c *echo.Context) error {
+	u, ok := contextx.GetUser(c.Request().Context())
+	if !ok {
+		return errx.New(errx.CodeUnauthorized, fmt.Errorf("missing user in context"))
+	}
+
+	if err := h.svc.Revoke(c.Request().Context(), u.ID, c.Param("id")); err != nil {
+		return err
+	}
+
+	return response.OK(c, response.Success[any](nil))
+}
+
+// RevokeAllSessions logs out every session of the logged-in user, including the current one.
+func (h *sessionHandler) RevokeAllSessions(c *echo.Context) error {
+	u, ok := contextx.GetUser(c.Request().Context())
+	if !ok {
+		return errx.New(errx.CodeUnauthorized, fmt.Errorf("missing user in context"))
+	}
+
+	if err := h.svc.RevokeAll(c.Request().Context(), u.ID); err != nil {
+		return err
+	}
+
+	return response.OK(c, response.Success[any](nil))
+}
+
+// RegisterSessionRoutes registers all session-related routes.
+func RegisterSessionRoutes(r *echo.Group, h SessionHandler, am *middleware.AuthMiddleware) {
+	group := r.Group("/me/sessions")
+	group.GET("", h.GetSessions, am.SessionHandler())
+	group.DELETE("", h.RevokeAllSessions, am.SessionHandler())
+	group.DELETE("/:id", h.RevokeSession, am.SessionHandler())
+}
+
+// toSessionRes maps a session to its response DTO, flagging the one the request came from.
+func toSessionRes(s *entity.Session, currentID string) response.SessionRes {
+	return response.SessionRes{
+		ID:         s.ID,
+		UserAgent:  s.UserAgent,
+		IP:         s.IP,
+		CreatedAt:  s.CreatedAt,
+		LastUsedAt: s.LastUsedAt,
+		ExpiresAt:  s.ExpiresAt,
+		Current:    s.ID == currentID,
+	}
+}
@@ -0,0 +1,52 @@
+package handler
+
+import (
+	"fmt"
+
+	"blog-server/contextx"
+	"blog-server/middleware"
+	"blog-server/pkg/errx"
+	"blog-server/response"
+	"blog-server/service"
+
+	"github.com/labstack/echo/v5"
+)
+
+// StatsHandler defines the interface for statistics HTTP handlers.
+type StatsHandler interface {
+	GetDashboardStats(c *echo.Context) error
+}
+
+// statsHandler implements the StatsHandler interface.
+type statsHandler struct {
+	svc service.StatsService
+}
+
+// NewStatsHandler creates a new stats handler instance.
+func NewStatsHandler(svc service.StatsService) StatsHandler {
+	return &statsHandler{svc: svc}
+}
+
+// GetDashboardStats returns the admin dashboard summary.
+func (h *statsHandler) GetDashboardStats(c *echo.Context) error {
+	u, ok := contextx.GetUser(c.Request().Context())
+	if !ok {
+		return errx.New(errx.CodeUnauthorized, fmt.Errorf("missing user in context"))
+	}
+
+	stats, err := h.svc.GetDashboardStats(c.Request().Context(), u)
+	if err != nil {
+		return err
+	}
+
+	return response.OK(c, response.Success(response.DashboardStatsRes{
+		TotalPV:       stats.TotalPV,
+		TotalComments: stats.TotalComments,
+		TotalPosts:    stats.TotalPosts,
+	}))
+}
+
+// RegisterStatsRoutes registers all statistics routes.
+func RegisterStatsRoutes(r *echo.Group, h StatsHandler, am *middleware.AuthMiddleware) {
+	r.GET("/admin/stats", h.GetDashboardStats, am.Handler())
+}
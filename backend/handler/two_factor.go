@@ -0,0 +1,133 @@
+package handler
+
+import (
+	"fmt"
+
+	"blog-server/contextx"
+	"blog-server/middleware"
+	"blog-server/pkg/errx"
+	"blog-server/pkg/validatorx"
+	"blog-server/request"
+	"blog-server/response"
+	"blog-server/service"
+
+	"github.com/labstack/echo/v5"
+)
+
+// TwoFactorHandler defines the interface for two-factor authentication HTTP handlers.
+type TwoFactorHandler interface {
+	GetStatus(c *echo.Context) error
+	Enroll(c *echo.Context) error
+	Confirm(c *echo.Context) error
+	RegenerateRecoveryCodes(c *echo.Context) error
+	Disable(c *echo.Context) error
+}
+
+// twoFactorHandler implements the TwoFactorHandler interface.
+type twoFactorHandler struct {
+	svc      service.TwoFactorService
+	validate validatorx.Validator
+}
+
+// NewTwoFactorHandler creates a new two-factor handler instance.
+func NewTwoFactorHandler(svc service.TwoFactorService, validate validatorx.Validator) TwoFactorHandler {
+	return &twoFactorHandler{svc: svc, validate: validate}
+}
+
+// GetStatus reports whether two-factor authentication is enabled for the logged-in user.
+func (h *twoFactorHandler) GetStatus(c *echo.Context) error {
+	u, ok := contextx.GetUser(c.Request().Context())
+	if !ok {
+		return errx.New(errx.CodeUnauthorized, fmt.Errorf("missing user in context"))
+	}
+
+	status, err := h.svc.Status(c.Request().Context(), u.ID)
+	if err != nil {
+		return err
+	}
+
+	return response.OK(c, response.Success(response.TwoFactorStatusRes{
+		Enabled:           status.Enabled,
+		Required:          status.Required,
+		RecoveryCodesLeft: status.RecoveryCodesLeft,
+	}))
+}
+
+// Enroll starts enrollment and returns the secret to add to an authenticator app.
+func (h *twoFactorHandler) Enroll(c *echo.Context) error {
+	u, ok := contextx.GetUser(c.Request().Context())
+	if !ok {
+		return errx.New(errx.CodeUnauthorized, fmt.Errorf("missing user in context"))
+	}
+
+	enrollment, err := h.svc.BeginEnrollment(c.Request().Context(), u.ID)
+	if err != nil {
+		return err
+	}
+
+	return response.OK(c, response.Success(response.TwoFactorEnrollmentRes{
+		Secret: enrollment.Secret,
+		URI:    enrollment.URI,
+	}))
+}
+
+// Confirm finishes enrollment with a code from the authenticator app.
+func (h *twoFactorHandler) Confirm(c *echo.Context) error {
+	return h.withCode(c, func(u contextx.User, code string) error {
+		codes, err := h.svc.ConfirmEnrollment(c.Request().Context(), u.ID, code)
+		if err != nil {
+			return err
+		}
+		return response.OK(c, response.Success(response.RecoveryCodesRes{RecoveryCodes: codes}))
+	})
+}
+
+// RegenerateRecoveryCodes replaces the recovery codes of the logged-in user.
+func (h *twoFactorHandler) RegenerateRecoveryCodes(c *echo.Context) error {
+	return h.withCode(c, func(u contextx.User, code string) error {
+		codes, err := h.svc.RegenerateRecoveryCodes(c.Request().Context(), u.ID, code)
+		if err != nil {
+			return err
+		}
+		return response.OK(c, response.Success(response.RecoveryCodesRes{RecoveryCodes: codes}))
+	})
+}
+
+// Disable turns two-factor authentication off for the logged-in user.
+func (h *twoFactorHandler) Disable(c *echo.Context) error {
+	return h.withCode(c, func(u contextx.User, code string) error {
+		if err := h.svc.Disable(c.Request().Context(), u.ID, code); err != nil {
+			return err
+		}
+		return response.OK(c, response.Success[any](nil))
+	})
+}
+
+// withCode binds the code of the request and resolves the logged-in user before calling fn.
+func (h *twoFactorHandler) withCode(c *echo.Context, fn func(u contextx.User, code string) error) error {
+	req := new(request.TwoFactorCodeReq)
+	if err := c.Bind(req); err != nil {
+		return errx.New(errx.CodeInvalidParam, err)
+	}
+
+	if err := h.validate.Struct(req); err != nil {
+		return errx.New(errx.CodeValidationFailed, err)
+	}
+
+	u, ok := contextx.GetUser(c.Request().Context())
+	if !ok {
+		return errx.New(errx.CodeUnauthorized, fmt.Errorf("missing user in context"))
+	}
+
+	return fn(u, req.Code)
+}
+
+// RegisterTwoFactorRoutes registers all two-factor routes.
+func RegisterTwoFactorRoutes(r *echo.Group, h TwoFactorHandler, am *middleware.AuthMiddleware) {
+	group := r.Group("/me/2fa")
+	group.GET("", h.GetStatus, am.SessionHandler())
+	group.POST("/enroll", h.Enroll, am.SessionHandler())
+	group.POST("/confirm", h.Confirm, am.SessionHandler())
+	group.POST("/recovery-codes", h.RegenerateRecoveryCodes, am.SessionHandler())
+	group.POST("/disable", h.Disable, am.SessionHandler())
+}
@@ -0,0 +1,185 @@
+package handler
+
+import (
+	"fmt"
+
+	"blog-server/contextx"
+	"blog-server/entity"
+	"blog-server/middleware"
+	"blog-server/pkg/errx"
+	"blog-server/pkg/validatorx"
+	"blog-server/request"
+	"blog-server/response"
+	"blog-server/service"
+
+	"github.com/labstack/echo/v5"
+)
+
+// UserHandler defines the interface for user profile HTTP handlers.
+type UserHandler interface {
+	GetMe(c *echo.Context) error
+	UpdateMe(c *echo.Context) error
+	ChangePassword(c *echo.Context) error
+
+	GetAuthor(c *echo.Context) error
+	GetAuthorPosts(c *echo.Context) error
+}
+
+// userHandler implements the UserHandler interface.
+type userHandler struct {
+	svc      service.UserService
+	auth     service.AuthService
+	validate validatorx.Validator
+}
+
+// NewUserHandler creates a new user handler instance.
+func NewUserHandler(svc service.UserService, auth service.AuthService, validate validatorx.Validator) UserHandler {
+	return &userHandler{svc: svc, auth: auth, validate: validate}
+}
+
+// GetMe returns the profile of the logged-in user.
+func (h *userHandler) GetMe(c *echo.Context) error {
+	u, ok := contextx.GetUser(c.Request().Context())
+	if !ok {
+		return errx.New(errx.CodeUnauthorized, fmt.Errorf("missing user in context"))
+	}
+
+	profile, err := h.svc.GetProfile(c.Request().Context(), u.ID)
+	if err != nil {
+		return err
+	}
+
+	return response.OK(c, response.Success(toProfileRes(profile)))
+}
+
+// UpdateMe updates the username, avatar or bio of the logged-in user.
+func (h *userHandler) UpdateMe(c *echo.Context) error {
+	req := new(request.UpdateProfileReq)
+
+	if err := c.Bind(req); err != nil {
+		return errx.New(errx.CodeInvalidParam, err)
+	}
+
+	if err := h.validate.Struct(req); err != nil {
+		return errx.New(errx.CodeValidationFailed, err)
+	}
+
+	u, ok := contextx.GetUser(c.Request().Context())
+	if !ok {
+		return errx.New(errx.CodeUnauthorized, fmt.Errorf("missing user in context"))
+	}
+
+	profile, err := h.svc.UpdateProfile(c.Request().Context(), u.ID, &service.UpdateProfileInput{
+		Username: req.Username,
+		Avatar:   req.Avatar,
+		Bio:      req.Bio,
+	})
+	if err != nil {
+		return err
+	}
+
+	return response.OK(c, response.Success(toProfileRes(profile)))
+}
+
+// ChangePassword changes the password of the logged-in user and rotates the tokens.
+func (h *userHandler) ChangePassword(c *echo.Context) error {
+	req := new(request.ChangePasswordReq)
+
+	if err := c.Bind(req); err != nil {
+		return errx.New(errx.CodeInvalidParam, err)
+	}
+
+	if err := h.validate.Struct(req); err != nil {
+		return errx.New(errx.CodeValidationFailed, err)
+	}
+
+	u, ok := contextx.GetUser(c.Request().Context())
+	if !ok {
+		return errx.New(errx.CodeUnauthorized, fmt.Errorf("missing user in context"))
+	}
+
+	// The new session inherits the second factor of the current one.
+	meta := sessionMeta(c)
+	meta.TwoFactor = u.TwoFactor
+
+	result, err := h.auth.ChangePassword(c.Request().Context(), u.ID, &service.ChangePasswordInput{
+		CurrentPassword: req.CurrentPassword,
+		NewPassword:     req.Password,
+		Meta:            meta,
+	})
+	if err != nil {
+		return err
+	}
+
+	setRefreshTokenCookie(c, result.RefreshToken)
+
+	return response.OK(c, response.Success(toLoginRes(result)))
+}
+
+// GetAuthor returns the public profile of an author.
+func (h *userHandler) GetAuthor(c *echo.Context) error {
+	author, postCount, err := h.svc.GetAuthor(c.Request().Context(), c.Param("username"))
+	if err != nil {
+		return err
+	}
+
+	return response.OK(c, response.Success(&response.AuthorRes{
+		Username:  author.Username,
+		Avatar:    author.Avatar,
+		Bio:       author.Bio,
+		PostCount: postCount,
+		CreatedAt: author.CreatedAt,
+	}))
+}
+
+// GetAuthorPosts returns the published posts of an author.
+func (h *userHandler) GetAuthorPosts(c *echo.Context) error {
+	query := &request.PostPageReq{Page: 1, PageSize: 10}
+	if err := c.Bind(query); err != nil {
+		return errx.New(errx.CodeInvalidParam, err)
+	}
+
+	if err := h.validate.Struct(query); err != nil {
+		return errx.New(errx.CodeValidationFailed, err)
+	}
+
+	posts, total, err := h.svc.GetAuthorPosts(c.Request().Context(), c.Param("username"), query.Page, query.PageSize)
+	if err != nil {
+		return err
+	}
+
+	postDTOs := make([]response.PostListRes, len(posts))
+	for i, post := range posts {
+		postDTOs[i] = toPostListRes(post)
+	}
+
+	return response.OK(c, response.Success(response.Page[response.PostListRes]{
+		Total: total,
+		List:  postDTOs,
+	}))
+}
+
+// RegisterUserRoutes registers all user profile routes.
+func RegisterUserRoutes(r *echo.Group, h UserHandler, am *middleware.AuthMiddleware) {
+	group := r.Group("/me")
+	group.GET("", h.GetMe, am.SessionHandler())
+	group.PATCH("", h.UpdateMe, am.SessionHandler())
+	group.PUT("/password", h.ChangePassword, am.SessionHandler())
+
+	authorGroup := r.Group("/authors/:username")
+	authorGroup.GET("", h.GetAuthor)
+	authorGroup.GET("/posts", h.GetAuthorPosts)
+}
+
+// toProfileRes converts a user into a ProfileRes.
+func toProfileRes(u *entity.User) *response.ProfileRes {
+	return &response.ProfileRes{
+		UUID:      u.UUID.String(),
+		Username:  u.Username,
+		Email:     u.Email,
+		Avatar:    u.Avatar,
+		Bio:       u.Bio,
+		Role:      string(u.Role),
+		CreatedAt: u.CreatedAt,
+	}
+}
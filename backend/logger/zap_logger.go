@@ -200,3 +200,8 @@ func (z *zapLogger) Fatal(msg string, fields ...Field) {
 func (z *zapLogger) Panic(msg string, fields ...Field) {
 	z.l.Panic(msg, convert(fields)...)
 }
+
+// NewNop returns a Logger that discards every entry, for use in tests.
+func NewNop() Logger {
+	return &zapLogger{l: zap.NewNop()}
+}
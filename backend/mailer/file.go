@@ -0,0 +1,87 @@
+package mailer
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strconv"
+	"time"
+
+	"blog-server/logger"
+
+	"github.com/google/uuid"
+)
+
+// FileTransport writes every message to disk instead of sending it.
+//
+// In flat mode each message becomes a .eml file in dir. In maildir mode
+// messages are written to dir/tmp and moved to dir/new, so mail clients
+// pointed at the directory see complete messages only.
+type FileTransport struct {
+	dir     string
+	maildir bool
+	log     logger.Logger
+}
+
+// NewFileTransport creates a FileTransport writing .eml files into dir.
+func NewFileTransport(dir string, log logger.Logger) (*FileTransport, error) {
+	if err := os.MkdirAll(dir, 0o755); err != nil {
+		return nil, fmt.Errorf("failed to create mail dir %s: %w", dir, err)
+	}
+	return &FileTransport{dir: dir, log: log}, nil
+}
+
+// NewMaildirTransport creates a FileTransport delivering into a maildir at dir.
+func NewMaildirTransport(dir string, log logger.Logger) (*FileTransport, error) {
+	for _, sub := range []string{"tmp", "new", "cur"} {
+		if err := os.MkdirAll(filepath.Join(dir, sub), 0o755); err != nil {
+			return nil, fmt.Errorf("failed to create maildir %s: %w", dir, err)
+		}
+	}
+	return &FileTransport{dir: dir, maildir: true, log: log}, nil
+}
+
+// Send writes the message to disk.
+func (t *FileTransport) Send(_ context.Context, msg *Message) error {
+	name := strconv.FormatInt(time.Now().UnixNano(), 10) + "." + uuid.NewString()
+
+	path := filepath.Join(t.dir, name+".eml")
+	if t.maildir {
+		path = filepath.Join(t.dir, "tmp", name)
+	}
+
+	if err := t.write(path, msg); err != nil {
+		return err
+	}
+
+	if t.maildir {
+		dst := filepath.Join(t.dir, "new", name)
+		if err := os.Rename(path, dst); err != nil {
+			return fmt.Errorf("failed to deliver email to maildir: %w", err)
+		}
+		path = dst
+	}
+
+	t.log.Info("email written to file",
+		logger.String("to", msg.To),
+		logger.String("subject", msg.Subject),
+		logger.String("path", path),
+	)
+	return nil
+}
+
+// write stores the MIME encoding of msg at path.
+func (t *FileTransport) write(path string, msg *Message) error {
+	f, err := os.OpenFile(path, os.O_CREATE|os.O_EXCL|os.O_WRONLY, 0o644)
+	if err != nil {
+		return fmt.Errorf("failed to create email file: %w", err)
+	}
+
+	if _, err := toGomail(msg).WriteTo(f); err != nil {
+		_ = f.Close()
+		return fmt.Errorf("failed to write email file: %w", err)
+	}
+
+	return f.Close()
+}
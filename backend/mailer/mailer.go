@@ -0,0 +1,66 @@
+// Package mailer provides transports that deliver rendered emails.
+package mailer
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"blog-server/config"
+	"blog-server/logger"
+
+	"gopkg.in/gomail.v2"
+)
+
+const (
+	TransportSMTP    = "smtp"
+	TransportFile    = "file"
+	TransportMaildir = "maildir"
+	TransportMemory  = "memory"
+)
+
+// Message is a rendered email ready for delivery.
+type Message struct {
+	From    string
+	To      string
+	Subject string
+	HTML    string
+	// Headers are extra MIME headers, e.g. List-Unsubscribe.
+	Headers map[string]string
+}
+
+// Transport delivers messages to their recipients.
+type Transport interface {
+	Send(ctx context.Context, msg *Message) error
+}
+
+// NewTransport creates the transport selected by email.transport, defaulting to SMTP.
+func NewTransport(cfg *config.Config, log logger.Logger) (Transport, error) {
+	mailCfg := cfg.Email
+
+	switch strings.ToLower(mailCfg.Transport) {
+	case "", TransportSMTP:
+		return NewSMTPTransport(mailCfg), nil
+	case TransportFile:
+		return NewFileTransport(mailCfg.FileDir, log)
+	case TransportMaildir:
+		return NewMaildirTransport(mailCfg.FileDir, log)
+	case TransportMemory:
+		return NewMemoryTransport(), nil
+	default:
+		return nil, fmt.Errorf("unknown mail transport: %s", mailCfg.Transport)
+	}
+}
+
+// toGomail converts a message into a MIME message.
+func toGomail(msg *Message) *gomail.Message {
+	m := gomail.NewMessage()
+	m.SetHeader("From", msg.From)
+	m.SetHeader("To", msg.To)
+	m.SetHeader("Subject", msg.Subject)
+	for k, v := range msg.Headers {
+		m.SetHeader(k, v)
+	}
+	m.SetBody("text/html", msg.HTML)
+	return m
+}
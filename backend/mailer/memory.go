@@ -0,0 +1,57 @@
+package mailer
+
+import (
+	"context"
+	"sync"
+)
+
+// MemoryTransport records messages in memory instead of sending them.
+//
+// It is meant for tests, which can inspect what would have been sent.
+type MemoryTransport struct {
+	mu       sync.Mutex
+	messages []Message
+}
+
+// NewMemoryTransport creates an empty MemoryTransport.
+func NewMemoryTransport() *MemoryTransport {
+	return &MemoryTransport{}
+}
+
+// Send records the message.
+func (t *MemoryTransport) Send(_ context.Context, msg *Message) error {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	t.messages = append(t.messages, *msg)
+	return nil
+}
+
+// Messages returns a copy of all recorded messages, oldest first.
+func (t *MemoryTransport) Messages() []Message {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	out := make([]Message, len(t.messages))
+	copy(out, t.messages)
+	return out
+}
+
+// Last returns the most recently recorded message.
+func (t *MemoryTransport) Last() (Message, bool) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	if len(t.messages) == 0 {
+		return Message{}, false
+	}
+	return t.messages[len(t.messages)-1], true
+}
+
+// Reset discards all recorded messages.
+func (t *MemoryTransport) Reset() {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	t.messages = nil
+}
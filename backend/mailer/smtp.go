@@ -0,0 +1,30 @@
+package mailer
+
+import (
+	"context"
+	"fmt"
+
+	"blog-server/config"
+
+	"gopkg.in/gomail.v2"
+)
+
+// SMTPTransport delivers messages through an SMTP server.
+type SMTPTransport struct {
+	dialer *gomail.Dialer
+}
+
+// NewSMTPTransport creates an SMTPTransport from the email configuration.
+func NewSMTPTransport(cfg config.EmailConfig) *SMTPTransport {
+	return &SMTPTransport{
+		dialer: gomail.NewDialer(cfg.Host, cfg.Port, cfg.Username, cfg.Password),
+	}
+}
+
+// Send dials the SMTP server and sends the message.
+func (t *SMTPTransport) Send(_ context.Context, msg *Message) error {
+	if err := t.dialer.DialAndSend(toGomail(msg)); err != nil {
+		return fmt.Errorf("failed to send email to %s: %w", msg.To, err)
+	}
+	return nil
+}
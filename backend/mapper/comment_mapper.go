@@ -0,0 +1,61 @@
+package mapper
+
+import (
+	"blog-server/ent"
+	"blog-server/entity"
+)
+
+// ToComment converts an ent.Comment to entity.Comment.
+//
+// For registered authors the display name and avatar are taken from the
+// loaded author edge, falling back to the stored author_name.
+func ToComment(c *ent.Comment) *entity.Comment {
+	if c == nil {
+		return &entity.Comment{}
+	}
+
+	comment := &entity.Comment{
+		ID:            c.ID,
+		PostID:        c.PostID,
+		ParentID:      c.ParentID,
+		RootID:        c.RootID,
+		UserID:        c.UserID,
+		AuthorName:    c.AuthorName,
+		AuthorEmail:   c.AuthorEmail,
+		AuthorWebsite: c.AuthorWebsite,
+		Content:       c.Content,
+		Status:        c.Status,
+		NotifyOnReply: c.NotifyOnReply,
+		SpamReason:    c.SpamReason,
+		IP:            c.IP,
+		UserAgent:     c.UserAgent,
+		CreatedAt:     c.CreatedAt,
+		UpdatedAt:     c.UpdatedAt,
+	}
+
+	if p := c.Edges.Post; p != nil {
+		comment.PostTitle = p.Title
+	}
+
+	if a := c.Edges.Author; a != nil {
+		if a.Username != "" {
+			comment.AuthorName = a.Username
+		}
+		comment.AuthorAvatar = a.Avatar
+	}
+
+	return comment
+}
+
+func ToComments(cs []*ent.Comment) []*entity.Comment {
+	if len(cs) == 0 {
+		return nil
+	}
+
+	res := make([]*entity.Comment, len(cs))
+	for i, c := range cs {
+		res[i] = ToComment(c)
+	}
+
+	return res
+}
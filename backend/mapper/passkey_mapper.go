@@ -0,0 +1,39 @@
+package mapper
+
+import (
+	"blog-server/ent"
+	"blog-server/entity"
+)
+
+// ToPasskey converts an ent.Passkey to entity.Passkey.
+func ToPasskey(p *ent.Passkey) *entity.Passkey {
+	if p == nil {
+		return &entity.Passkey{}
+	}
+
+	return &entity.Passkey{
+		ID:           p.ID,
+		UserID:       p.UserID,
+		CredentialID: p.CredentialID,
+		PublicKey:    p.PublicKey,
+		Algorithm:    p.Algorithm,
+		SignCount:    p.SignCount,
+		Name:         p.Name,
+		AAGUID:       p.Aaguid,
+		Transports:   p.Transports,
+		LastUsedAt:   p.LastUsedAt,
+		CreatedAt:    p.CreatedAt,
+	}
+}
+
+// ToPasskeys converts a slice of ent.Passkey to entity.Passkey.
+func ToPasskeys(ps []*ent.Passkey) []*entity.Passkey {
+	if len(ps) == 0 {
+		return nil
+	}
+	result := make([]*entity.Passkey, len(ps))
+	for i, p := range ps {
+		result[i] = ToPasskey(p)
+	}
+	return result
+}
@@ -0,0 +1,39 @@
+package mapper
+
+import (
+	"blog-server/ent"
+	"blog-server/entity"
+)
+
+// ToPersonalAccessToken converts an ent.PersonalAccessToken to entity.PersonalAccessToken.
+func ToPersonalAccessToken(t *ent.PersonalAccessToken) *entity.PersonalAccessToken {
+	if t == nil {
+		return &entity.PersonalAccessToken{}
+	}
+
+	return &entity.PersonalAccessToken{
+		ID:          t.ID,
+		UserID:      t.UserID,
+		Name:        t.Name,
+		TokenHash:   t.TokenHash,
+		TokenPrefix: t.TokenPrefix,
+		Scopes:      t.Scopes,
+		TwoFactor:   t.TwoFactor,
+		ExpiresAt:   t.ExpiresAt,
+		LastUsedAt:  t.LastUsedAt,
+		RevokedAt:   t.RevokedAt,
+		CreatedAt:   t.CreatedAt,
+	}
+}
+
+// ToPersonalAccessTokens converts a slice of ent.PersonalAccessToken to entity.PersonalAccessToken.
+func ToPersonalAccessTokens(ts []*ent.PersonalAccessToken) []*entity.PersonalAccessToken {
+	if len(ts) == 0 {
+		return nil
+	}
+	result := make([]*entity.PersonalAccessToken, len(ts))
+	for i, t := range ts {
+		result[i] = ToPersonalAccessToken(t)
+	}
+	return result
+}
@@ -21,6 +21,7 @@ func ToPost(p *ent.Post) *entity.Post {
 	return &entity.Post{
 		ID:      p.ID,
 		Title:   p.Title,
+		Slug:    p.Slug,
 		Summary: p.Summary,
 		Cover:   p.Cover,
 
@@ -36,6 +37,7 @@ func ToPost(p *ent.Post) *entity.Post {
 		PublishedAt: p.PublishedAt,
 		CreatedAt:   p.CreatedAt,
 		UpdatedAt:   p.UpdatedAt,
+		DeletedAt:   p.DeletedAt,
 
 		Tags:       ToPostTags(p.Edges.Tags),
 		Categories: ToPostCategories(p.Edges.Categories),
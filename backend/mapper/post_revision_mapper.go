@@ -0,0 +1,43 @@
+package mapper
+
+import (
+	"blog-server/ent"
+	"blog-server/entity"
+)
+
+// ToPostRevision converts an ent.PostRevision to entity.PostRevision.
+func ToPostRevision(r *ent.PostRevision) *entity.PostRevision {
+	if r == nil {
+		return &entity.PostRevision{}
+	}
+
+	rev := &entity.PostRevision{
+		ID:           r.ID,
+		PostID:       r.PostID,
+		Version:      r.Version,
+		UserID:       r.UserID,
+		Title:        r.Title,
+		Summary:      r.Summary,
+		Content:      r.Content,
+		RestoredFrom: r.RestoredFrom,
+		CreatedAt:    r.CreatedAt,
+	}
+	if r.Edges.User != nil {
+		rev.User = r.Edges.User.Username
+	}
+	return rev
+}
+
+// ToPostRevisions converts ent.PostRevisions to entity.PostRevisions.
+func ToPostRevisions(rs []*ent.PostRevision) []*entity.PostRevision {
+	if len(rs) == 0 {
+		return nil
+	}
+
+	res := make([]*entity.PostRevision, len(rs))
+	for i, r := range rs {
+		res[i] = ToPostRevision(r)
+	}
+
+	return res
+}
@@ -0,0 +1,36 @@
+package mapper
+
+import (
+	"blog-server/ent"
+	"blog-server/entity"
+)
+
+// ToSigningKey converts an ent.SigningKey to entity.SigningKey.
+func ToSigningKey(k *ent.SigningKey) *entity.SigningKey {
+	if k == nil {
+		return &entity.SigningKey{}
+	}
+
+	return &entity.SigningKey{
+		ID:          k.ID,
+		KID:         k.Kid,
+		Algorithm:   k.Algorithm,
+		PrivateKey:  k.PrivateKey,
+		PublicKey:   k.PublicKey,
+		ActivatesAt: k.ActivatesAt,
+		RetiredAt:   k.RetiredAt,
+		CreatedAt:   k.CreatedAt,
+	}
+}
+
+// ToSigningKeys converts a slice of ent.SigningKey to entity.SigningKey.
+func ToSigningKeys(ks []*ent.SigningKey) []*entity.SigningKey {
+	if len(ks) == 0 {
+		return nil
+	}
+	result := make([]*entity.SigningKey, len(ks))
+	for i, k := range ks {
+		result[i] = ToSigningKey(k)
+	}
+	return result
+}
@@ -0,0 +1,35 @@
+package mapper
+
+import (
+	"blog-server/ent"
+	"blog-server/entity"
+)
+
+// ToUserIdentity converts an ent.UserIdentity to entity.UserIdentity.
+func ToUserIdentity(i *ent.UserIdentity) *entity.UserIdentity {
+	if i == nil {
+		return &entity.UserIdentity{}
+	}
+
+	return &entity.UserIdentity{
+		ID:          i.ID,
+		UserID:      i.UserID,
+		Provider:    i.Provider,
+		Subject:     i.Subject,
+		Email:       i.Email,
+		LastLoginAt: i.LastLoginAt,
+		CreatedAt:   i.CreatedAt,
+	}
+}
+
+// ToUserIdentities converts a slice of ent.UserIdentity to entity.UserIdentity.
+func ToUserIdentities(is []*ent.UserIdentity) []*entity.UserIdentity {
+	if len(is) == 0 {
+		return nil
+	}
+	result := make([]*entity.UserIdentity, len(is))
+	for i, identity := range is {
+		result[i] = ToUserIdentity(identity)
+	}
+	return result
+}
@@ -11,12 +11,17 @@ func ToUser(u *ent.User) *entity.User {
 	}
 
 	return &entity.User{
-		ID:        u.ID,
-		UUID:      u.UUID,
-		Username:  u.Username,
-		Email:     u.Email,
-		Avatar:    u.Avatar,
-		Role:      u.Role,
+		ID:       u.ID,
+		UUID:     u.UUID,
+		Username: u.Username,
+		Email:    u.Email,
+		Avatar:   u.Avatar,
+		Bio:      u.Bio,
+		Role:     u.Role,
+		Status:   u.Status,
+
+		TwoFactorEnabled: u.TotpEnabled,
+
 		CreatedAt: u.CreatedAt,
 		UpdatedAt: u.UpdatedAt,
 	}
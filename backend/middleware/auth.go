@@ -1,65 +1,230 @@
 package middleware
 
 import (
+	"context"
 	"fmt"
 	"strings"
+	"time"
 
 	"blog-server/authz"
 	"blog-server/config"
 	"blog-server/contextx"
+	"blog-server/entity"
 	"blog-server/pkg/errx"
 	"blog-server/pkg/jwt"
 
 	"github.com/labstack/echo/v5"
 )
 
-// AuthMiddleware handles JWT authentication for protected routes
+// TokenDenylist reports access tokens that were revoked before they expired.
+type TokenDenylist interface {
+	IsDenied(ctx context.Context, tokenID string) (bool, error)
+	IsSessionDenied(ctx context.Context, sessionID string) (bool, error)
+	IsUserDenied(ctx context.Context, userID uint, issuedAt time.Time) (bool, error)
+}
+
+// AccessTokenAuthenticator resolves personal access tokens.
+type AccessTokenAuthenticator interface {
+	Authenticate(ctx context.Context, token string) (*authz.AccessTokenPrincipal, error)
+}
+
+// AuthMiddleware handles JWT and personal access token authentication for protected routes
 type AuthMiddleware struct {
-	jwt jwt.Jwt
+	jwt          jwt.Jwt
+	denylist     TokenDenylist
+	accessTokens AccessTokenAuthenticator
+
+	// twoFactorRoles are the roles that must pass a second factor.
+	twoFactorRoles map[authz.Role]bool
 }
 
 // NewAuthMiddleware creates a new auth middleware instance
-func NewAuthMiddleware(cfg *config.Config) *AuthMiddleware {
+func NewAuthMiddleware(
+	cfg *config.Config,
+	j jwt.Jwt,
+	denylist TokenDenylist,
+	accessTokens AccessTokenAuthenticator,
+) *AuthMiddleware {
+	twoFactorRoles := make(map[authz.Role]bool)
+	for _, role := range cfg.Auth.TwoFactor.RequiredRoles {
+		twoFactorRoles[authz.FromString(role)] = true
+	}
+
 	return &AuthMiddleware{
-		jwt: jwt.New(cfg.JWT),
+		jwt:            j,
+		denylist:       denylist,
+		accessTokens:   accessTokens,
+		twoFactorRoles: twoFactorRoles,
 	}
 }
 
-// Handler returns a Echo handler that validates JWT tokens
+// Handler returns a Echo handler that validates JWT tokens and personal access tokens
 // Optional roles can be specified for role-based access control
+//
+// Users whose role must pass a second factor and who did not are rejected
+// with errx.CodeTwoFactorRequired.
 func (m *AuthMiddleware) Handler() echo.MiddlewareFunc {
+	return m.handler(true)
+}
+
+// SessionHandler returns a Echo handler that only accepts JWT tokens from an
+// interactive login. It guards account management, which personal access
+// tokens must not reach, e.g. to mint tokens with more scopes. It lets users
+// without a required second factor through, so that they can enroll.
+func (m *AuthMiddleware) SessionHandler() echo.MiddlewareFunc {
+	return m.handler(false)
+}
+
+func (m *AuthMiddleware) handler(allowAccessTokens bool) echo.MiddlewareFunc {
 	return func(next echo.HandlerFunc) echo.HandlerFunc {
 		return func(c *echo.Context) error {
-			authHeader := c.Request().Header.Get("Authorization")
-			if authHeader == "" {
-				return errx.New(errx.CodeUnauthorized, fmt.Errorf("missing authorization header"))
+			tokenStr, err := bearerToken(c)
+			if err != nil {
+				return err
 			}
 
-			parts := strings.SplitN(authHeader, " ", 2)
-			if len(parts) != 2 || !strings.EqualFold(parts[0], "Bearer") {
-				return errx.New(errx.CodeUnauthorized, fmt.Errorf("invalid authorization header"))
+			ctx, err := m.authenticate(c.Request().Context(), tokenStr, allowAccessTokens)
+			if err != nil {
+				return err
 			}
-
-			tokenStr := parts[1]
-			if tokenStr == "" {
-				return errx.New(errx.CodeUnauthorized, fmt.Errorf("empty token"))
+			// Only session routes are exempt, and they refuse access tokens.
+			if allowAccessTokens {
+				if err := m.requireTwoFactor(ctx); err != nil {
+					return err
+				}
 			}
 
-			claims, err := m.jwt.Parse(tokenStr)
+			c.SetRequest(c.Request().WithContext(ctx))
+
+			return next(c)
+		}
+	}
+}
+
+// OptionalHandler returns a Echo handler that attaches the user to the request
+// context when a valid bearer token is present, and lets anonymous requests
+// through otherwise.
+func (m *AuthMiddleware) OptionalHandler() echo.MiddlewareFunc {
+	return func(next echo.HandlerFunc) echo.HandlerFunc {
+		return func(c *echo.Context) error {
+			tokenStr, err := bearerToken(c)
 			if err != nil {
-				return err
+				return next(c)
 			}
 
-			user := contextx.User{
-				ID:   claims.ID,
-				Role: authz.FromEntityRole(claims.Role),
+			ctx, err := m.authenticate(c.Request().Context(), tokenStr, false)
+			if err != nil {
+				return next(c)
 			}
 
-			ctx := contextx.SetUser(c.Request().Context(), user)
-
 			c.SetRequest(c.Request().WithContext(ctx))
 
 			return next(c)
 		}
 	}
 }
+
+// authenticate validates the token and returns the request context with the
+// user it was issued to. Revoked tokens are rejected even before they expire.
+func (m *AuthMiddleware) authenticate(ctx context.Context, tokenStr string, allowAccessTokens bool) (context.Context, error) {
+	if strings.HasPrefix(tokenStr, entity.AccessTokenPrefix) {
+		if !allowAccessTokens {
+			return nil, errx.New(errx.CodeForbidden, fmt.Errorf("personal access tokens cannot be used here"))
+		}
+		return m.authenticateAccessToken(ctx, tokenStr)
+	}
+
+	claims, err := m.jwt.Parse(tokenStr)
+	if err != nil {
+		return nil, err
+	}
+	if claims.Type == jwt.TokenTypeRefresh {
+		return nil, errx.New(errx.CodeUnauthorized, fmt.Errorf("refresh token used as access token"))
+	}
+
+	denied, err := m.denylist.IsDenied(ctx, claims.TokenID())
+	if err != nil {
+		return nil, err
+	}
+	if denied {
+		return nil, errx.New(errx.CodeUnauthorized, fmt.Errorf("token has been revoked"))
+	}
+	denied, err = m.denylist.IsSessionDenied(ctx, claims.SessionID)
+	if err != nil {
+		return nil, err
+	}
+	if denied {
+		return nil, errx.New(errx.CodeUnauthorized, fmt.Errorf("session has been revoked"))
+	}
+	if claims.IssuedAt != nil {
+		denied, err = m.denylist.IsUserDenied(ctx, claims.ID, claims.IssuedAt.Time)
+		if err != nil {
+			return nil, err
+		}
+		if denied {
+			return nil, errx.New(errx.CodeUnauthorized, fmt.Errorf("token has been revoked"))
+		}
+	}
+
+	user := contextx.User{
+		ID:        claims.ID,
+		Role:      authz.FromEntityRole(claims.Role),
+		SessionID: claims.SessionID,
+		TwoFactor: claims.TwoFactor,
+		TokenID:   claims.TokenID(),
+	}
+	if claims.ExpiresAt != nil {
+		user.TokenExpiresAt = claims.ExpiresAt.Time
+	}
+
+	return contextx.SetUser(ctx, user), nil
+}
+
+// authenticateAccessToken resolves a personal access token and limits the
+// request to the scopes of the token.
+func (m *AuthMiddleware) authenticateAccessToken(ctx context.Context, tokenStr string) (context.Context, error) {
+	principal, err := m.accessTokens.Authenticate(ctx, tokenStr)
+	if err != nil {
+		return nil, err
+	}
+
+	// A token counts as having passed a second factor when the session that
+	// created it did.
+	ctx = contextx.SetUser(ctx, contextx.User{
+		ID:            principal.UserID,
+		Role:          authz.FromEntityRole(principal.Role),
+		TwoFactor:     principal.TwoFactor,
+		AccessTokenID: principal.TokenID,
+	})
+	return authz.WithScopes(ctx, principal.Scopes), nil
+}
+
+// requireTwoFactor rejects the user of ctx when their role must pass a second
+// factor and they did not.
+func (m *AuthMiddleware) requireTwoFactor(ctx context.Context) error {
+	user, _ := contextx.GetUser(ctx)
+	if m.twoFactorRoles[user.Role] && !user.TwoFactor {
+		return errx.New(errx.CodeTwoFactorRequired, fmt.Errorf("role %s requires two-factor authentication", user.Role))
+	}
+	return nil
+}
+
+// bearerToken extracts the token from the Authorization header.
+func bearerToken(c *echo.Context) (string, error) {
+	authHeader := c.Request().Header.Get("Authorization")
+	if authHeader == "" {
+		return "", errx.New(errx.CodeUnauthorized, fmt.Errorf("missing authorization header"))
+	}
+
+	parts := strings.SplitN(authHeader, " ", 2)
+	if len(parts) != 2 || !strings.EqualFold(parts[0], "Bearer") {
+		return "", errx.New(errx.CodeUnauthorized, fmt.Errorf("invalid authorization header"))
+	}
+
+	tokenStr := parts[1]
+	if tokenStr == "" {
+		return "", errx.New(errx.CodeUnauthorized, fmt.Errorf("empty token"))
+	}
+
+	return tokenStr, nil
+}
@@ -0,0 +1,141 @@
+package middleware
+
+import (
+	"fmt"
+	"math"
+	"strconv"
+	"sync"
+	"time"
+
+	"blog-server/cache"
+	"blog-server/config"
+	"blog-server/contextx"
+	"blog-server/logger"
+	"blog-server/pkg/errx"
+
+	"github.com/labstack/echo/v5"
+)
+
+// RateLimiter limits requests per route with the named policies of
+// config.RateLimitConfig.
+//
+// Buckets live in Redis so all instances share them. While Redis is
+// unavailable each instance falls back to buckets in its own memory.
+type RateLimiter struct {
+	enabled  bool
+	policies map[string]config.RateLimitPolicy
+	rc       cache.CacheClient
+	memory   *memoryRateLimiter
+	log      logger.Logger
+}
+
+// NewRateLimiter creates a new rate limiter instance
+func NewRateLimiter(cfg *config.Config, rc cache.CacheClient, log logger.Logger) *RateLimiter {
+	return &RateLimiter{
+		enabled:  cfg.RateLimit.Enabled,
+		policies: cfg.RateLimit.Policies,
+		rc:       rc,
+		memory:   newMemoryRateLimiter(),
+		log:      log.With(logger.String("module", "rate_limit")),
+	}
+}
+
+// RateLimit returns a Echo handler that limits requests with the named policy.
+//
+// Responses carry the RateLimit-Limit, RateLimit-Remaining, RateLimit-Reset
+// and RateLimit-Policy headers; rejected requests get a CodeTooManyRequests
+// error with Retry-After. Policies keyed by user must run after the auth
+// middleware.
+func (l *RateLimiter) RateLimit(name string) echo.MiddlewareFunc {
+	policy, ok := l.policies[name]
+	if !l.enabled || !ok {
+		return func(next echo.HandlerFunc) echo.HandlerFunc {
+			return next
+		}
+	}
+
+	burst := policy.Burst
+	if burst <= 0 {
+		burst = policy.Limit
+	}
+	interval := policy.Period / time.Duration(policy.Limit)
+	window := interval * time.Duration(burst)
+
+	return func(next echo.HandlerFunc) echo.HandlerFunc {
+		return func(c *echo.Context) error {
+			key := fmt.Sprintf("blog:ratelimit:%s:%s %s:%s", name, c.Request().Method, c.Path(), rateLimitSubject(c, policy.Key))
+
+			res, err := l.rc.AllowGCRA(c.Request().Context(), key, interval, burst)
+			if err != nil {
+				l.log.Warn("rate limit store unavailable, using memory", logger.Err(err))
+				res = l.memory.allow(key, interval, burst)
+			}
+
+			h := c.Response().Header()
+			h.Set("RateLimit-Limit", strconv.Itoa(burst))
+			h.Set("RateLimit-Remaining", strconv.Itoa(res.Remaining))
+			h.Set("RateLimit-Reset", strconv.Itoa(ceilSeconds(res.ResetAfter)))
+			h.Set("RateLimit-Policy", fmt.Sprintf("%d;w=%d", burst, ceilSeconds(window)))
+
+			if !res.Allowed {
+				return errx.TooManyRequests(res.RetryAfter, fmt.Errorf("rate limit %q exceeded", name))
+			}
+			return next(c)
+		}
+	}
+}
+
+// rateLimitSubject returns whom the request is counted for.
+func rateLimitSubject(c *echo.Context, key string) string {
+	switch key {
+	case "route":
+		return "all"
+	case "user":
+		if u, ok := contextx.GetUser(c.Request().Context()); ok {
+			return "user:" + strconv.FormatUint(uint64(u.ID), 10)
+		}
+	}
+	return "ip:" + c.RealIP()
+}
+
+// ceilSeconds rounds d up to whole seconds for headers.
+func ceilSeconds(d time.Duration) int {
+	return int(math.Ceil(d.Seconds()))
+}
+
+// memoryRateLimiter keeps GCRA buckets in process memory, as the
+// theoretical arrival time of each key.
+type memoryRateLimiter struct {
+	mu        sync.Mutex
+	buckets   map[string]time.Time
+	nextSweep time.Time
+}
+
+// memorySweepInterval is how often buckets with a full burst are dropped.
+const memorySweepInterval = time.Minute
+
+func newMemoryRateLimiter() *memoryRateLimiter {
+	return &memoryRateLimiter{buckets: make(map[string]time.Time)}
+}
+
+// allow admits one request to key, like cache.CacheClient.AllowGCRA.
+func (m *memoryRateLimiter) allow(key string, interval time.Duration, burst int) *cache.RateLimitResult {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	now := time.Now()
+	if now.After(m.nextSweep) {
+		for k, tat := range m.buckets {
+			if now.After(tat) {
+				delete(m.buckets, k)
+			}
+		}
+		m.nextSweep = now.Add(memorySweepInterval)
+	}
+
+	res, tat := cache.GCRA(now, m.buckets[key], interval, burst)
+	if res.Allowed {
+		m.buckets[key] = tat
+	}
+	return res
+}
@@ -8,6 +8,9 @@ const (
 	CodeUnauthorized = 1001
 	CodeForbidden    = 1002
 	CodeTokenExpired = 1003
+	// CodeTwoFactorRequired is returned to users whose role must log in with
+	// a second factor and who did not.
+	CodeTwoFactorRequired = 1004
 
 	CodeNotFound = 2001
 	CodeConflict = 2002
@@ -15,6 +18,8 @@ const (
 	CodeInvalidParam     = 3001
 	CodeValidationFailed = 3002
 
+	CodeTooManyRequests = 4001
+
 	CodeInternalError = 5000
 	CodeExternalError = 5001
 )
@@ -28,7 +33,7 @@ func MapToHTTPStatus(code int) int {
 	case CodeUnauthorized, CodeTokenExpired:
 		return http.StatusUnauthorized
 
-	case CodeForbidden:
+	case CodeForbidden, CodeTwoFactorRequired:
 		return http.StatusForbidden
 
 	case CodeInvalidParam, CodeValidationFailed:
@@ -40,6 +45,9 @@ func MapToHTTPStatus(code int) int {
 	case CodeConflict:
 		return http.StatusConflict
 
+	case CodeTooManyRequests:
+		return http.StatusTooManyRequests
+
 	default:
 		return http.StatusInternalServerError
 	}
@@ -107,8 +107,12 @@ func MessageForCode(code int) string {
 		return "未登录或登录已过期"
 	case CodeForbidden:
 		return "权限不足"
+	case CodeTwoFactorRequired:
+		return "当前角色需要启用两步验证，请启用后重新登录"
 	case CodeInvalidParam:
 		return "请求参数错误"
+	case CodeTooManyRequests:
+		return "请求过于频繁，请稍后再试"
 	default:
 		return "系统错误，请稍后重试"
 	}
@@ -0,0 +1,38 @@
+package errx
+
+import (
+	"errors"
+	"fmt"
+	"time"
+)
+
+// RetryAfterError tells the client how long to wait before trying again.
+type RetryAfterError struct {
+	After time.Duration
+	Err   error
+}
+
+// Error implements error interface.
+func (e *RetryAfterError) Error() string {
+	return fmt.Sprintf("retry after %s: %v", e.After, e.Err)
+}
+
+// Unwrap supports errors.Is / errors.As
+func (e *RetryAfterError) Unwrap() error {
+	return e.Err
+}
+
+// TooManyRequests creates a CodeTooManyRequests error that asks the client to
+// retry after the given duration.
+func TooManyRequests(after time.Duration, err error) *AppError {
+	return New(CodeTooManyRequests, &RetryAfterError{After: after, Err: err})
+}
+
+// RetryAfter returns the wait carried by err, if any.
+func RetryAfter(err error) (time.Duration, bool) {
+	var re *RetryAfterError
+	if errors.As(err, &re) {
+		return re.After, true
+	}
+	return 0, false
+}
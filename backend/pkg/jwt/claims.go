@@ -6,8 +6,36 @@ import (
 	"github.com/golang-jwt/jwt/v5"
 )
 
+// TokenType distinguishes access tokens from refresh tokens.
+type TokenType string
+
+const (
+	TokenTypeAccess  TokenType = "access"
+	TokenTypeRefresh TokenType = "refresh"
+)
+
+// Identity describes whom a token is issued to.
+type Identity struct {
+	UserID uint
+	Role   entity.UserRole
+	// SessionID binds the token to a login session.
+	SessionID string
+	// TwoFactor records that the session was established with a second factor.
+	TwoFactor bool
+}
+
+// Claims are the claims carried by every token. The registered claims carry
+// a unique token ID (jti) so a single token can be revoked.
 type Claims struct {
-	ID   uint            `json:"id"`
-	Role entity.UserRole `json:"role"`
+	ID        uint            `json:"id"`
+	Role      entity.UserRole `json:"role"`
+	SessionID string          `json:"sid,omitempty"`
+	Type      TokenType       `json:"typ,omitempty"`
+	TwoFactor bool            `json:"mfa,omitempty"`
 	jwt.RegisteredClaims
 }
+
+// TokenID returns the jti claim of the token.
+func (c *Claims) TokenID() string {
+	return c.RegisteredClaims.ID
+}
@@ -0,0 +1,106 @@
+package jwt
+
+import (
+	"crypto"
+	"crypto/ecdsa"
+	"crypto/ed25519"
+	"crypto/elliptic"
+	"crypto/rsa"
+	"encoding/base64"
+	"fmt"
+	"math/big"
+)
+
+// JWK is the public half of a signing key in JSON Web Key form (RFC 7517).
+type JWK struct {
+	Kty string `json:"kty"`
+	Use string `json:"use"`
+	Alg string `json:"alg"`
+	Kid string `json:"kid"`
+
+	// OKP (Ed25519) and EC parameters.
+	Crv string `json:"crv,omitempty"`
+	X   string `json:"x,omitempty"`
+	Y   string `json:"y,omitempty"`
+
+	// RSA parameters.
+	N string `json:"n,omitempty"`
+	E string `json:"e,omitempty"`
+}
+
+// JWKSet is the document served at /.well-known/jwks.json.
+type JWKSet struct {
+	Keys []JWK `json:"keys"`
+}
+
+// PublicJWK returns the public key of k as a JWK.
+func PublicJWK(k *Key) (JWK, error) {
+	jwk := JWK{
+		Use: "sig",
+		Alg: k.Algorithm,
+		Kid: k.ID,
+	}
+
+	switch pub := k.Public.(type) {
+	case ed25519.PublicKey:
+		jwk.Kty = "OKP"
+		jwk.Crv = "Ed25519"
+		jwk.X = base64.RawURLEncoding.EncodeToString(pub)
+	case *rsa.PublicKey:
+		jwk.Kty = "RSA"
+		jwk.N = base64.RawURLEncoding.EncodeToString(pub.N.Bytes())
+		jwk.E = base64.RawURLEncoding.EncodeToString(big.NewInt(int64(pub.E)).Bytes())
+	default:
+		return JWK{}, fmt.Errorf("key %s: unsupported public key type %T", k.ID, k.Public)
+	}
+
+	return jwk, nil
+}
+
+// PublicKey decodes the public key of j, as used to verify tokens signed by
+// other issuers.
+func (j JWK) PublicKey() (crypto.PublicKey, error) {
+	switch j.Kty {
+	case "OKP":
+		if j.Crv != "Ed25519" {
+			return nil, fmt.Errorf("key %s: unsupported curve %q", j.Kid, j.Crv)
+		}
+		x, err := base64.RawURLEncoding.DecodeString(j.X)
+		if err != nil || len(x) != ed25519.PublicKeySize {
+			return nil, fmt.Errorf("key %s: invalid Ed25519 key", j.Kid)
+		}
+		return ed25519.PublicKey(x), nil
+	case "EC":
+		var curve elliptic.Curve
+		switch j.Crv {
+		case "P-256":
+			curve = elliptic.P256()
+		case "P-384":
+			curve = elliptic.P384()
+		case "P-521":
+			curve = elliptic.P521()
+		default:
+			return nil, fmt.Errorf("key %s: unsupported curve %q", j.Kid, j.Crv)
+		}
+		x, errX := base64.RawURLEncoding.DecodeString(j.X)
+		y, errY := base64.RawURLEncoding.DecodeString(j.Y)
+		size := (curve.Params().BitSize + 7) / 8
+		if errX != nil || errY != nil || len(x) != size || len(y) != size {
+			return nil, fmt.Errorf("key %s: invalid EC key", j.Kid)
+		}
+		pub, err := ecdsa.ParseUncompressedPublicKey(curve, append(append([]byte{4}, x...), y...))
+		if err != nil {
+			return nil, fmt.Errorf("key %s: %w", j.Kid, err)
+		}
+		return pub, nil
+	case "RSA":
+		n, errN := base64.RawURLEncoding.DecodeString(j.N)
+		e, errE := base64.RawURLEncoding.DecodeString(j.E)
+		if errN != nil || errE != nil || len(e) == 0 || len(e) > 4 {
+			return nil, fmt.Errorf("key %s: invalid RSA key", j.Kid)
+		}
+		return &rsa.PublicKey{N: new(big.Int).SetBytes(n), E: int(new(big.Int).SetBytes(e).Int64())}, nil
+	default:
+		return nil, fmt.Errorf("key %s: unsupported key type %q", j.Kid, j.Kty)
+	}
+}
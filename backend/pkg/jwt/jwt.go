@@ -6,31 +6,32 @@ import (
 	"time"
 
 	"blog-server/config"
-	"blog-server/entity"
 	"blog-server/pkg/errx"
 
 	jwtv5 "github.com/golang-jwt/jwt/v5"
+	"github.com/google/uuid"
 )
 
 type jwtx struct {
-	cfg config.JWTConfig
+	cfg  config.JWTConfig
+	keys KeySet
 }
 
-func (j *jwtx) GenerateAccessToken(userID uint, role entity.UserRole) (string, error) {
-	return j.generateToken(userID, role, j.cfg.AccessExpiration)
+func (j *jwtx) GenerateAccessToken(identity Identity) (string, error) {
+	return j.generateToken(identity, TokenTypeAccess, j.cfg.AccessExpiration)
 }
 
-func (j *jwtx) GenerateRefreshToken(userID uint, role entity.UserRole) (string, error) {
-	return j.generateToken(userID, role, j.cfg.RefreshExpiration)
+func (j *jwtx) GenerateRefreshToken(identity Identity) (string, error) {
+	return j.generateToken(identity, TokenTypeRefresh, j.cfg.RefreshExpiration)
 }
 
-func (j *jwtx) GenerateAllTokens(userID uint, role entity.UserRole) (string, string, error) {
-	access, err := j.GenerateAccessToken(userID, role)
+func (j *jwtx) GenerateAllTokens(identity Identity) (string, string, error) {
+	access, err := j.GenerateAccessToken(identity)
 	if err != nil {
 		return "", "", err
 	}
 
-	refresh, err := j.GenerateRefreshToken(userID, role)
+	refresh, err := j.GenerateRefreshToken(identity)
 	if err != nil {
 		return "", "", err
 	}
@@ -39,15 +40,11 @@ func (j *jwtx) GenerateAllTokens(userID uint, role entity.UserRole) (string, str
 }
 
 func (j *jwtx) Parse(tokenStr string) (*Claims, error) {
-	token, err := jwtv5.ParseWithClaims(tokenStr, &Claims{}, func(token *jwtv5.Token) (any, error) {
-		if _, ok := token.Method.(*jwtv5.SigningMethodHMAC); !ok {
-			return nil, errx.New(
-				errx.CodeInternalError,
-				fmt.Errorf("unexpected signing method: %v", token.Header["alg"]),
-			)
-		}
-		return []byte(j.cfg.Secret), nil
-	})
+	token, err := jwtv5.ParseWithClaims(tokenStr, &Claims{}, j.verificationKey, jwtv5.WithValidMethods([]string{
+		AlgorithmHS256,
+		AlgorithmEdDSA,
+		AlgorithmRS256,
+	}))
 	if err != nil {
 		return nil, errx.New(errx.CodeUnauthorized, fmt.Errorf("invalid token: %w", err))
 	}
@@ -61,40 +58,83 @@ func (j *jwtx) Parse(tokenStr string) (*Claims, error) {
 }
 
 func (j *jwtx) Validate(tokenStr string) (bool, error) {
-	_, err := jwtv5.Parse(tokenStr, func(token *jwtv5.Token) (any, error) {
-		if _, ok := token.Method.(*jwtv5.SigningMethodHMAC); !ok {
-			return nil, errx.New(
-				errx.CodeInternalError,
-				fmt.Errorf("unexpected signing method: %v", token.Header["alg"]),
-			)
+	if _, err := j.Parse(tokenStr); err != nil {
+		return false, err
+	}
+
+	return true, nil
+}
+
+// verificationKey resolves the key a token was signed with.
+//
+// Tokens signed with a key pair name it in the kid header; tokens without one
+// are only accepted while the shared secret is in use.
+func (j *jwtx) verificationKey(token *jwtv5.Token) (any, error) {
+	kid, _ := token.Header["kid"].(string)
+	if kid == "" {
+		if _, ok := token.Method.(*jwtv5.SigningMethodHMAC); !ok || !j.cfg.UsesSharedSecret() {
+			return nil, fmt.Errorf("unexpected signing method: %v", token.Header["alg"])
 		}
 		return []byte(j.cfg.Secret), nil
-	})
+	}
+
+	if j.keys == nil {
+		return nil, fmt.Errorf("unknown signing key %s", kid)
+	}
+	key, err := j.keys.VerificationKey(kid)
 	if err != nil {
-		return false, errx.New(errx.CodeUnauthorized, err)
+		return nil, err
+	}
+	if token.Method.Alg() != key.Algorithm {
+		return nil, fmt.Errorf("signing key %s does not use %s", kid, token.Method.Alg())
 	}
 
-	return true, nil
+	return key.Public, nil
 }
 
-func (j *jwtx) generateToken(userID uint, role entity.UserRole, expires time.Duration) (string, error) {
+func (j *jwtx) generateToken(identity Identity, typ TokenType, expires time.Duration) (string, error) {
 	now := time.Now()
 
 	claims := Claims{
-		ID:   userID,
-		Role: role,
+		ID:        identity.UserID,
+		Role:      identity.Role,
+		SessionID: identity.SessionID,
+		Type:      typ,
+		TwoFactor: identity.TwoFactor,
 		RegisteredClaims: jwtv5.RegisteredClaims{
 			ExpiresAt: jwtv5.NewNumericDate(now.Add(expires)),
 			IssuedAt:  jwtv5.NewNumericDate(now),
 			NotBefore: jwtv5.NewNumericDate(now),
 			Issuer:    j.cfg.Issuer,
 			Subject:   "user token",
+			ID:        uuid.NewString(),
 		},
 	}
 
-	token := jwtv5.NewWithClaims(jwtv5.SigningMethodHS256, claims)
+	if j.cfg.UsesSharedSecret() {
+		signed, err := jwtv5.NewWithClaims(jwtv5.SigningMethodHS256, claims).SignedString([]byte(j.cfg.Secret))
+		if err != nil {
+			return "", errx.New(errx.CodeInternalError, err)
+		}
+		return signed, nil
+	}
+
+	if j.keys == nil {
+		return "", errx.New(errx.CodeInternalError, fmt.Errorf("no key set configured for %s", j.cfg.Algorithm))
+	}
+	key, err := j.keys.SigningKey()
+	if err != nil {
+		return "", errx.New(errx.CodeInternalError, err)
+	}
+	method, err := signingMethod(key.Algorithm)
+	if err != nil {
+		return "", errx.New(errx.CodeInternalError, err)
+	}
+
+	token := jwtv5.NewWithClaims(method, claims)
+	token.Header["kid"] = key.ID
 
-	signed, err := token.SignedString([]byte(j.cfg.Secret))
+	signed, err := token.SignedString(key.Private)
 	if err != nil {
 		return "", errx.New(errx.CodeInternalError, err)
 	}
@@ -102,6 +142,8 @@ func (j *jwtx) generateToken(userID uint, role entity.UserRole, expires time.Dur
 	return signed, nil
 }
 
-func New(cfg config.JWTConfig) Jwt {
-	return &jwtx{cfg: cfg}
+// New creates a Jwt signing with the shared secret or, for the key pair
+// algorithms, with the current key of the key set.
+func New(cfg config.JWTConfig, keys KeySet) Jwt {
+	return &jwtx{cfg: cfg, keys: keys}
 }
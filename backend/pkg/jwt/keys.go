@@ -0,0 +1,133 @@
+package jwt
+
+import (
+	"crypto"
+	"crypto/ed25519"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/x509"
+	"encoding/pem"
+	"fmt"
+
+	jwtv5 "github.com/golang-jwt/jwt/v5"
+	"github.com/google/uuid"
+)
+
+// Supported signing algorithms.
+const (
+	AlgorithmHS256 = "HS256"
+	AlgorithmEdDSA = "EdDSA"
+	AlgorithmRS256 = "RS256"
+)
+
+const rsaKeyBits = 2048
+
+// Key is an asymmetric signing key identified by the kid header of the tokens it signs.
+type Key struct {
+	ID        string
+	Algorithm string
+	Private   crypto.Signer
+	Public    crypto.PublicKey
+}
+
+// KeySet provides the keys used to sign and verify tokens.
+type KeySet interface {
+	// SigningKey returns the key new tokens are signed with.
+	SigningKey() (*Key, error)
+	// VerificationKey returns the key with the given ID, including retired keys
+	// whose tokens may still be valid.
+	VerificationKey(kid string) (*Key, error)
+}
+
+// GenerateKey creates a new key pair for the algorithm with a random key ID.
+func GenerateKey(algorithm string) (*Key, error) {
+	var private crypto.Signer
+	switch algorithm {
+	case AlgorithmEdDSA:
+		_, priv, err := ed25519.GenerateKey(rand.Reader)
+		if err != nil {
+			return nil, err
+		}
+		private = priv
+	case AlgorithmRS256:
+		priv, err := rsa.GenerateKey(rand.Reader, rsaKeyBits)
+		if err != nil {
+			return nil, err
+		}
+		private = priv
+	default:
+		return nil, fmt.Errorf("unsupported signing algorithm %q", algorithm)
+	}
+
+	return &Key{
+		ID:        uuid.NewString(),
+		Algorithm: algorithm,
+		Private:   private,
+		Public:    private.Public(),
+	}, nil
+}
+
+// MarshalKey encodes the private key as PKCS#8 PEM and the public key as PKIX PEM.
+func MarshalKey(k *Key) (privatePEM, publicPEM string, err error) {
+	privDER, err := x509.MarshalPKCS8PrivateKey(k.Private)
+	if err != nil {
+		return "", "", err
+	}
+	pubDER, err := x509.MarshalPKIXPublicKey(k.Public)
+	if err != nil {
+		return "", "", err
+	}
+
+	privatePEM = string(pem.EncodeToMemory(&pem.Block{Type: "PRIVATE KEY", Bytes: privDER}))
+	publicPEM = string(pem.EncodeToMemory(&pem.Block{Type: "PUBLIC KEY", Bytes: pubDER}))
+	return privatePEM, publicPEM, nil
+}
+
+// ParseKey decodes a PKCS#8 PEM private key stored by MarshalKey.
+func ParseKey(id, algorithm, privatePEM string) (*Key, error) {
+	block, _ := pem.Decode([]byte(privatePEM))
+	if block == nil {
+		return nil, fmt.Errorf("key %s: invalid PEM", id)
+	}
+	parsed, err := x509.ParsePKCS8PrivateKey(block.Bytes)
+	if err != nil {
+		return nil, fmt.Errorf("key %s: %w", id, err)
+	}
+
+	var private crypto.Signer
+	switch priv := parsed.(type) {
+	case ed25519.PrivateKey:
+		if algorithm != AlgorithmEdDSA {
+			return nil, fmt.Errorf("key %s: ed25519 key cannot be used with %s", id, algorithm)
+		}
+		private = priv
+	case *rsa.PrivateKey:
+		if algorithm != AlgorithmRS256 {
+			return nil, fmt.Errorf("key %s: rsa key cannot be used with %s", id, algorithm)
+		}
+		private = priv
+	default:
+		return nil, fmt.Errorf("key %s: unsupported key type %T", id, parsed)
+	}
+
+	return &Key{
+		ID:        id,
+		Algorithm: algorithm,
+		Private:   private,
+		Public:    private.Public(),
+	}, nil
+}
+
+// signingMethod returns the jwt signing method of an algorithm.
+func signingMethod(algorithm string) (jwtv5.SigningMethod, error) {
+	switch algorithm {
+	case AlgorithmHS256:
+		return jwtv5.SigningMethodHS256, nil
+	case AlgorithmEdDSA:
+		return jwtv5.SigningMethodEdDSA, nil
+	case AlgorithmRS256:
+		return jwtv5.SigningMethodRS256, nil
+	default:
+		return nil, fmt.Errorf("unsupported signing algorithm %q", algorithm)
+	}
+}
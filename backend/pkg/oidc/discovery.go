@@ -0,0 +1,213 @@
+package oidc
+
+import (
+	"context"
+	"crypto"
+	"errors"
+	"fmt"
+	"net/http"
+	"strings"
+	"sync"
+	"time"
+
+	"blog-server/pkg/jwt"
+
+	jwtv5 "github.com/golang-jwt/jwt/v5"
+)
+
+// keyRefreshInterval limits how often an unknown key ID triggers a JWKS fetch.
+const keyRefreshInterval = time.Minute
+
+// Metadata is the subset of the OpenID Provider Metadata used by the client.
+type Metadata struct {
+	Issuer                string `json:"issuer"`
+	AuthorizationEndpoint string `json:"authorization_endpoint"`
+	TokenEndpoint         string `json:"token_endpoint"`
+	UserInfoEndpoint      string `json:"userinfo_endpoint"`
+	JWKSURI               string `json:"jwks_uri"`
+}
+
+// OIDCProvider is an OpenID Connect provider.
+type OIDCProvider struct {
+	cfg    Config
+	meta   Metadata
+	client *http.Client
+
+	mu          sync.Mutex
+	keys        map[string]crypto.PublicKey
+	keysFetched time.Time
+}
+
+// idTokenClaims are the ID token claims read by the client.
+type idTokenClaims struct {
+	jwtv5.RegisteredClaims
+
+	Nonce   string `json:"nonce"`
+	Email   string `json:"email"`
+	Name    string `json:"name"`
+	Picture string `json:"picture"`
+	// EmailVerified is a boolean, though some providers send it as a string.
+	EmailVerified any `json:"email_verified"`
+}
+
+// userInfo is the UserInfo endpoint response.
+type userInfo struct {
+	Subject       string `json:"sub"`
+	Email         string `json:"email"`
+	Name          string `json:"name"`
+	Picture       string `json:"picture"`
+	EmailVerified any    `json:"email_verified"`
+}
+
+// Discover loads the provider configuration of issuer from its
+// /.well-known/openid-configuration document.
+func Discover(ctx context.Context, client *http.Client, issuer string, cfg Config) (*OIDCProvider, error) {
+	var meta Metadata
+	wellKnown := strings.TrimSuffix(issuer, "/") + "/.well-known/openid-configuration"
+	if err := getJSON(ctx, client, wellKnown, "", &meta); err != nil {
+		return nil, err
+	}
+	if meta.Issuer != issuer {
+		return nil, fmt.Errorf("discovery document issuer %q does not match %q", meta.Issuer, issuer)
+	}
+	if meta.AuthorizationEndpoint == "" || meta.TokenEndpoint == "" || meta.JWKSURI == "" {
+		return nil, errors.New("discovery document is missing endpoints")
+	}
+
+	if len(cfg.Scopes) == 0 {
+		cfg.Scopes = []string{"openid", "email", "profile"}
+	}
+
+	return &OIDCProvider{cfg: cfg, meta: meta, client: client}, nil
+}
+
+// AuthCodeURL implements [Provider].
+func (p *OIDCProvider) AuthCodeURL(state, nonce, codeChallenge string) string {
+	return authCodeURL(p.meta.AuthorizationEndpoint, p.cfg, state, nonce, codeChallenge)
+}
+
+// Exchange implements [Provider].
+//
+// The account is identified by the ID token, which must be signed by the
+// issuer, addressed to this client and carry the nonce of the request. The
+// UserInfo endpoint fills in the email when the token has none.
+func (p *OIDCProvider) Exchange(ctx context.Context, code, codeVerifier, nonce string) (*Identity, error) {
+	token, err := exchangeCode(ctx, p.client, p.meta.TokenEndpoint, p.cfg, code, codeVerifier)
+	if err != nil {
+		return nil, err
+	}
+	if token.IDToken == "" {
+		return nil, errors.New("token endpoint returned no ID token")
+	}
+
+	claims := new(idTokenClaims)
+	_, err = jwtv5.ParseWithClaims(token.IDToken, claims, func(t *jwtv5.Token) (any, error) {
+		kid, _ := t.Header["kid"].(string)
+		return p.publicKey(ctx, kid)
+	},
+		jwtv5.WithValidMethods([]string{"RS256", "RS384", "RS512", "ES256", "ES384", "ES512", "PS256", "EdDSA"}),
+		jwtv5.WithIssuer(p.meta.Issuer),
+		jwtv5.WithAudience(p.cfg.ClientID),
+		jwtv5.WithExpirationRequired(),
+		jwtv5.WithLeeway(time.Minute),
+	)
+	if err != nil {
+		return nil, fmt.Errorf("invalid ID token: %w", err)
+	}
+	if claims.Subject == "" {
+		return nil, errors.New("ID token has no subject")
+	}
+	if claims.Nonce != nonce {
+		return nil, errors.New("ID token nonce mismatch")
+	}
+
+	id := &Identity{
+		Subject:       claims.Subject,
+		Email:         claims.Email,
+		EmailVerified: isTrue(claims.EmailVerified),
+		Name:          claims.Name,
+		Picture:       claims.Picture,
+	}
+
+	if id.Email == "" && p.meta.UserInfoEndpoint != "" {
+		var info userInfo
+		if err := getJSON(ctx, p.client, p.meta.UserInfoEndpoint, token.AccessToken, &info); err != nil {
+			return nil, err
+		}
+		if info.Subject != id.Subject {
+			return nil, errors.New("userinfo subject does not match ID token")
+		}
+		id.Email = info.Email
+		id.EmailVerified = isTrue(info.EmailVerified)
+		if id.Name == "" {
+			id.Name = info.Name
+		}
+		if id.Picture == "" {
+			id.Picture = info.Picture
+		}
+	}
+
+	return id, nil
+}
+
+// publicKey returns the issuer key with the given ID, refetching the JWKS
+// when the key is unknown, e.g. after the issuer rotated its keys.
+func (p *OIDCProvider) publicKey(ctx context.Context, kid string) (crypto.PublicKey, error) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	if key, ok := p.lookupKey(kid); ok {
+		return key, nil
+	}
+	if time.Since(p.keysFetched) < keyRefreshInterval {
+		return nil, fmt.Errorf("unknown signing key %q", kid)
+	}
+
+	var set jwt.JWKSet
+	if err := getJSON(ctx, p.client, p.meta.JWKSURI, "", &set); err != nil {
+		return nil, err
+	}
+	keys := make(map[string]crypto.PublicKey, len(set.Keys))
+	for _, k := range set.Keys {
+		if k.Use != "" && k.Use != "sig" {
+			continue
+		}
+		pub, err := k.PublicKey()
+		if err != nil {
+			// Skip key types this client cannot use.
+			continue
+		}
+		keys[k.Kid] = pub
+	}
+	p.keys = keys
+	p.keysFetched = time.Now()
+
+	if key, ok := p.lookupKey(kid); ok {
+		return key, nil
+	}
+	return nil, fmt.Errorf("unknown signing key %q", kid)
+}
+
+// lookupKey finds a cached key. A token without key ID matches when the
+// issuer publishes a single key.
+func (p *OIDCProvider) lookupKey(kid string) (crypto.PublicKey, bool) {
+	if kid == "" && len(p.keys) == 1 {
+		for _, key := range p.keys {
+			return key, true
+		}
+	}
+	key, ok := p.keys[kid]
+	return key, ok
+}
+
+// isTrue reads a boolean claim that may be encoded as a string.
+func isTrue(v any) bool {
+	switch b := v.(type) {
+	case bool:
+		return b
+	case string:
+		return b == "true"
+	default:
+		return false
+	}
+}
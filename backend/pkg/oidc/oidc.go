@@ -0,0 +1,170 @@
+// Package oidc implements the client side of the OAuth 2.0 authorization code
+// flow with PKCE (RFC 7636) for logging in with external accounts.
+//
+// Providers speaking OpenID Connect are configured from their discovery
+// document and identify users through a verified ID token. GitHub, which only
+// speaks plain OAuth 2.0, is supported through its REST API.
+package oidc
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strings"
+)
+
+// maxResponseSize caps the bodies read from providers.
+const maxResponseSize = 1 << 20
+
+// Identity is an account at an external provider.
+type Identity struct {
+	// Subject is the stable account ID at the provider.
+	Subject       string
+	Email         string
+	EmailVerified bool
+	Name          string
+	Picture       string
+}
+
+// Provider runs the authorization code flow against one identity provider.
+type Provider interface {
+	// AuthCodeURL returns the URL to send the browser to for consent.
+	AuthCodeURL(state, nonce, codeChallenge string) string
+	// Exchange redeems the code returned to the redirect URL and identifies the account.
+	Exchange(ctx context.Context, code, codeVerifier, nonce string) (*Identity, error)
+}
+
+// Config holds the client registration at a provider.
+type Config struct {
+	ClientID     string
+	ClientSecret string
+	RedirectURL  string
+	Scopes       []string
+}
+
+// tokenResponse is the token endpoint response (RFC 6749 section 5).
+type tokenResponse struct {
+	AccessToken string `json:"access_token"`
+	TokenType   string `json:"token_type"`
+	IDToken     string `json:"id_token"`
+
+	Error            string `json:"error"`
+	ErrorDescription string `json:"error_description"`
+}
+
+// NewPKCE returns a random code verifier and its S256 code challenge.
+func NewPKCE() (verifier, challenge string) {
+	verifier = RandomToken()
+	sum := sha256.Sum256([]byte(verifier))
+	return verifier, base64.RawURLEncoding.EncodeToString(sum[:])
+}
+
+// RandomToken returns a random URL-safe token, used for state, nonce and code verifiers.
+func RandomToken() string {
+	b := make([]byte, 32)
+	_, _ = rand.Read(b)
+	return base64.RawURLEncoding.EncodeToString(b)
+}
+
+// authCodeURL builds an authorization request URL.
+func authCodeURL(endpoint string, cfg Config, state, nonce, codeChallenge string) string {
+	q := url.Values{
+		"response_type":         {"code"},
+		"client_id":             {cfg.ClientID},
+		"redirect_uri":          {cfg.RedirectURL},
+		"scope":                 {strings.Join(cfg.Scopes, " ")},
+		"state":                 {state},
+		"code_challenge":        {codeChallenge},
+		"code_challenge_method": {"S256"},
+	}
+	if nonce != "" {
+		q.Set("nonce", nonce)
+	}
+
+	sep := "?"
+	if strings.Contains(endpoint, "?") {
+		sep = "&"
+	}
+	return endpoint + sep + q.Encode()
+}
+
+// exchangeCode redeems an authorization code at the token endpoint.
+func exchangeCode(ctx context.Context, client *http.Client, endpoint string, cfg Config, code, codeVerifier string) (*tokenResponse, error) {
+	form := url.Values{
+		"grant_type":    {"authorization_code"},
+		"code":          {code},
+		"redirect_uri":  {cfg.RedirectURL},
+		"client_id":     {cfg.ClientID},
+		"client_secret": {cfg.ClientSecret},
+		"code_verifier": {codeVerifier},
+	}
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, endpoint, strings.NewReader(form.Encode()))
+	if err != nil {
+		return nil, err
+	}
+	req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+	req.Header.Set("Accept", "application/json")
+
+	resp, err := client.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("token request: %w", err)
+	}
+	defer resp.Body.Close()
+
+	body, err := io.ReadAll(io.LimitReader(resp.Body, maxResponseSize))
+	if err != nil {
+		return nil, fmt.Errorf("read token response: %w", err)
+	}
+
+	token := new(tokenResponse)
+	if err := json.Unmarshal(body, token); err != nil {
+		return nil, fmt.Errorf("token endpoint returned status %d", resp.StatusCode)
+	}
+	// GitHub reports errors with status 200.
+	if token.Error != "" {
+		if token.ErrorDescription != "" {
+			return nil, fmt.Errorf("token endpoint: %s: %s", token.Error, token.ErrorDescription)
+		}
+		return nil, fmt.Errorf("token endpoint: %s", token.Error)
+	}
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("token endpoint returned status %d", resp.StatusCode)
+	}
+	if token.AccessToken == "" {
+		return nil, fmt.Errorf("token endpoint returned no access token")
+	}
+	return token, nil
+}
+
+// getJSON fetches url and decodes the JSON response into v, authorized with
+// the access token when one is given.
+func getJSON(ctx context.Context, client *http.Client, url, accessToken string, v any) error {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, url, nil)
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Accept", "application/json")
+	if accessToken != "" {
+		req.Header.Set("Authorization", "Bearer "+accessToken)
+	}
+
+	resp, err := client.Do(req)
+	if err != nil {
+		return fmt.Errorf("get %s: %w", url, err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return fmt.Errorf("get %s: status %d", url, resp.StatusCode)
+	}
+	if err := json.NewDecoder(io.LimitReader(resp.Body, maxResponseSize)).Decode(v); err != nil {
+		return fmt.Errorf("decode %s: %w", url, err)
+	}
+	return nil
+}
@@ -0,0 +1,230 @@
+// Package oidctest provides a mock OpenID Connect provider for exercising
+// social login in tests, in the spirit of net/http/httptest.
+//
+// The provider approves every authorization request at once, as if the user
+// had logged in and consented, and issues RS256 signed ID tokens.
+package oidctest
+
+import (
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/json"
+	"math/big"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"sync"
+	"time"
+
+	"blog-server/pkg/jwt"
+
+	jwtv5 "github.com/golang-jwt/jwt/v5"
+)
+
+const keyID = "oidctest"
+
+// User is the account the provider logs in as.
+type User struct {
+	Subject       string
+	Email         string
+	EmailVerified bool
+	Name          string
+	Picture       string
+}
+
+// Server is a running mock provider. Its URL is the issuer.
+type Server struct {
+	*httptest.Server
+
+	ClientID     string
+	ClientSecret string
+
+	mu    sync.Mutex
+	user  User
+	codes map[string]authRequest
+	key   *rsa.PrivateKey
+}
+
+// authRequest is an authorization request waiting to be redeemed.
+type authRequest struct {
+	clientID      string
+	redirectURI   string
+	nonce         string
+	codeChallenge string
+}
+
+// NewServer starts a provider for the client that logs in as user.
+// The caller must call Close when done.
+func NewServer(clientID, clientSecret string, user User) *Server {
+	key, err := rsa.GenerateKey(rand.Reader, 2048)
+	if err != nil {
+		panic("oidctest: " + err.Error())
+	}
+
+	s := &Server{
+		ClientID:     clientID,
+		ClientSecret: clientSecret,
+		user:         user,
+		codes:        make(map[string]authRequest),
+		key:          key,
+	}
+
+	mux := http.NewServeMux()
+	mux.HandleFunc("GET /.well-known/openid-configuration", s.discovery)
+	mux.HandleFunc("GET /authorize", s.authorize)
+	mux.HandleFunc("POST /token", s.token)
+	mux.HandleFunc("GET /jwks", s.jwks)
+	s.Server = httptest.NewServer(mux)
+	return s
+}
+
+// SetUser switches the account later logins are issued for.
+func (s *Server) SetUser(user User) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.user = user
+}
+
+// Authorize follows an authorization URL without a browser and returns the
+// URL the provider redirects back to, carrying the code and state.
+func (s *Server) Authorize(authURL string) (*url.URL, error) {
+	client := &http.Client{
+		CheckRedirect: func(*http.Request, []*http.Request) error {
+			return http.ErrUseLastResponse
+		},
+	}
+	resp, err := client.Get(authURL)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+	return resp.Location()
+}
+
+func (s *Server) discovery(w http.ResponseWriter, _ *http.Request) {
+	writeJSON(w, http.StatusOK, map[string]any{
+		"issuer":                                s.URL,
+		"authorization_endpoint":                s.URL + "/authorize",
+		"token_endpoint":                        s.URL + "/token",
+		"jwks_uri":                              s.URL + "/jwks",
+		"response_types_supported":              []string{"code"},
+		"subject_types_supported":               []string{"public"},
+		"id_token_signing_alg_values_supported": []string{"RS256"},
+		"code_challenge_methods_supported":      []string{"S256"},
+	})
+}
+
+func (s *Server) authorize(w http.ResponseWriter, r *http.Request) {
+	q := r.URL.Query()
+	redirectURI, err := url.Parse(q.Get("redirect_uri"))
+	if err != nil || q.Get("client_id") != s.ClientID || q.Get("response_type") != "code" {
+		http.Error(w, "invalid authorization request", http.StatusBadRequest)
+		return
+	}
+	if q.Get("code_challenge_method") != "S256" || q.Get("code_challenge") == "" {
+		http.Error(w, "PKCE is required", http.StatusBadRequest)
+		return
+	}
+
+	code := rand.Text()
+	s.mu.Lock()
+	s.codes[code] = authRequest{
+		clientID:      q.Get("client_id"),
+		redirectURI:   q.Get("redirect_uri"),
+		nonce:         q.Get("nonce"),
+		codeChallenge: q.Get("code_challenge"),
+	}
+	s.mu.Unlock()
+
+	back := redirectURI.Query()
+	back.Set("code", code)
+	back.Set("state", q.Get("state"))
+	redirectURI.RawQuery = back.Encode()
+	http.Redirect(w, r, redirectURI.String(), http.StatusFound)
+}
+
+func (s *Server) token(w http.ResponseWriter, r *http.Request) {
+	if err := r.ParseForm(); err != nil {
+		tokenError(w, "invalid_request")
+		return
+	}
+
+	s.mu.Lock()
+	req, ok := s.codes[r.PostForm.Get("code")]
+	delete(s.codes, r.PostForm.Get("code"))
+	user := s.user
+	s.mu.Unlock()
+
+	switch {
+	case r.PostForm.Get("grant_type") != "authorization_code":
+		tokenError(w, "unsupported_grant_type")
+		return
+	case !ok,
+		r.PostForm.Get("redirect_uri") != req.redirectURI,
+		r.PostForm.Get("client_id") != req.clientID:
+		tokenError(w, "invalid_grant")
+		return
+	case r.PostForm.Get("client_secret") != s.ClientSecret:
+		tokenError(w, "invalid_client")
+		return
+	}
+	sum := sha256.Sum256([]byte(r.PostForm.Get("code_verifier")))
+	if base64.RawURLEncoding.EncodeToString(sum[:]) != req.codeChallenge {
+		tokenError(w, "invalid_grant")
+		return
+	}
+
+	now := time.Now()
+	claims := jwtv5.MapClaims{
+		"iss":            s.URL,
+		"sub":            user.Subject,
+		"aud":            req.clientID,
+		"iat":            now.Unix(),
+		"exp":            now.Add(time.Hour).Unix(),
+		"email":          user.Email,
+		"email_verified": user.EmailVerified,
+		"name":           user.Name,
+		"picture":        user.Picture,
+	}
+	if req.nonce != "" {
+		claims["nonce"] = req.nonce
+	}
+	t := jwtv5.NewWithClaims(jwtv5.SigningMethodRS256, claims)
+	t.Header["kid"] = keyID
+	idToken, err := t.SignedString(s.key)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+
+	writeJSON(w, http.StatusOK, map[string]any{
+		"access_token": rand.Text(),
+		"token_type":   "Bearer",
+		"expires_in":   3600,
+		"id_token":     idToken,
+	})
+}
+
+func (s *Server) jwks(w http.ResponseWriter, _ *http.Request) {
+	pub := &s.key.PublicKey
+	writeJSON(w, http.StatusOK, jwt.JWKSet{Keys: []jwt.JWK{{
+		Kty: "RSA",
+		Use: "sig",
+		Alg: "RS256",
+		Kid: keyID,
+		N:   base64.RawURLEncoding.EncodeToString(pub.N.Bytes()),
+		E:   base64.RawURLEncoding.EncodeToString(big.NewInt(int64(pub.E)).Bytes()),
+	}}})
+}
+
+func tokenError(w http.ResponseWriter, code string) {
+	writeJSON(w, http.StatusBadRequest, map[string]string{"error": code})
+}
+
+func writeJSON(w http.ResponseWriter, status int, v any) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(status)
+	_ = json.NewEncoder(w).Encode(v)
+}
@@ -0,0 +1,98 @@
+package webauthn
+
+import "time"
+
+// UserEntity identifies the account a passkey is created for.
+type UserEntity struct {
+	// ID is the user handle, opaque bytes returned on login as userHandle.
+	ID          Base64URL `json:"id"`
+	Name        string    `json:"name"`
+	DisplayName string    `json:"displayName"`
+}
+
+// CredentialDescriptor refers to an existing credential.
+type CredentialDescriptor struct {
+	Type       string    `json:"type"`
+	ID         Base64URL `json:"id"`
+	Transports []string  `json:"transports,omitempty"`
+}
+
+// CredentialParameter names an acceptable public key algorithm.
+type CredentialParameter struct {
+	Type string `json:"type"`
+	Alg  int64  `json:"alg"`
+}
+
+// AuthenticatorSelection states the requirements on the authenticator.
+type AuthenticatorSelection struct {
+	ResidentKey        string `json:"residentKey"`
+	RequireResidentKey bool   `json:"requireResidentKey"`
+	UserVerification   string `json:"userVerification"`
+}
+
+// RelyingPartyEntity is the relying party as described to the authenticator.
+type RelyingPartyEntity struct {
+	ID   string `json:"id"`
+	Name string `json:"name"`
+}
+
+// CreationOptions is the JSON form of PublicKeyCredentialCreationOptions,
+// to be passed to PublicKeyCredential.parseCreationOptionsFromJSON().
+type CreationOptions struct {
+	RP                     RelyingPartyEntity     `json:"rp"`
+	User                   UserEntity             `json:"user"`
+	Challenge              Base64URL              `json:"challenge"`
+	PubKeyCredParams       []CredentialParameter  `json:"pubKeyCredParams"`
+	Timeout                int64                  `json:"timeout"`
+	ExcludeCredentials     []CredentialDescriptor `json:"excludeCredentials"`
+	AuthenticatorSelection AuthenticatorSelection `json:"authenticatorSelection"`
+	Attestation            string                 `json:"attestation"`
+}
+
+// RequestOptions is the JSON form of PublicKeyCredentialRequestOptions,
+// to be passed to PublicKeyCredential.parseRequestOptionsFromJSON().
+type RequestOptions struct {
+	Challenge        Base64URL              `json:"challenge"`
+	Timeout          int64                  `json:"timeout"`
+	RPID             string                 `json:"rpId"`
+	AllowCredentials []CredentialDescriptor `json:"allowCredentials"`
+	UserVerification string                 `json:"userVerification"`
+}
+
+// CreationOptions returns the options for registering a discoverable passkey.
+func (rp *RelyingParty) CreationOptions(challenge []byte, user UserEntity, exclude []CredentialDescriptor, timeout time.Duration) *CreationOptions {
+	params := make([]CredentialParameter, len(SupportedAlgorithms))
+	for i, alg := range SupportedAlgorithms {
+		params[i] = CredentialParameter{Type: "public-key", Alg: alg}
+	}
+	if exclude == nil {
+		exclude = []CredentialDescriptor{}
+	}
+
+	return &CreationOptions{
+		RP:                 RelyingPartyEntity{ID: rp.ID, Name: rp.Name},
+		User:               user,
+		Challenge:          challenge,
+		PubKeyCredParams:   params,
+		Timeout:            timeout.Milliseconds(),
+		ExcludeCredentials: exclude,
+		AuthenticatorSelection: AuthenticatorSelection{
+			ResidentKey:        "required",
+			RequireResidentKey: true,
+			UserVerification:   "preferred",
+		},
+		Attestation: "none",
+	}
+}
+
+// RequestOptions returns the options for a passwordless login, leaving the
+// choice of passkey to the authenticator.
+func (rp *RelyingParty) RequestOptions(challenge []byte, timeout time.Duration) *RequestOptions {
+	return &RequestOptions{
+		Challenge:        challenge,
+		Timeout:          timeout.Milliseconds(),
+		RPID:             rp.ID,
+		AllowCredentials: []CredentialDescriptor{},
+		UserVerification: "preferred",
+	}
+}
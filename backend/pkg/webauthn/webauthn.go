@@ -0,0 +1,295 @@
+// Package webauthn verifies WebAuthn registration and authentication
+// ceremonies for passkeys.
+//
+// Only "none" attestation is requested; the attestation statement is not
+// evaluated, so the relying party trusts the public key as sent by the client.
+package webauthn
+
+import (
+	"bytes"
+	"crypto/rand"
+	"crypto/sha256"
+	"crypto/subtle"
+	"encoding/base64"
+	"encoding/binary"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"slices"
+	"strings"
+)
+
+const challengeSize = 32
+
+// Authenticator data flags.
+const (
+	flagUserPresent   = 1 << 0
+	flagUserVerified  = 1 << 2
+	flagAttestedData  = 1 << 6
+	flagExtensionData = 1 << 7
+)
+
+// Base64URL is a byte slice encoded as unpadded base64url in JSON, as used by
+// PublicKeyCredential.toJSON().
+type Base64URL []byte
+
+// MarshalJSON encodes b as unpadded base64url.
+func (b Base64URL) MarshalJSON() ([]byte, error) {
+	return json.Marshal(base64.RawURLEncoding.EncodeToString(b))
+}
+
+// UnmarshalJSON decodes base64url with or without padding.
+func (b *Base64URL) UnmarshalJSON(data []byte) error {
+	var s string
+	if err := json.Unmarshal(data, &s); err != nil {
+		return err
+	}
+	decoded, err := base64.RawURLEncoding.DecodeString(strings.TrimRight(s, "="))
+	if err != nil {
+		return err
+	}
+	*b = decoded
+	return nil
+}
+
+// RelyingParty identifies this server to authenticators.
+type RelyingParty struct {
+	// ID is the effective domain credentials are scoped to, e.g. "example.com".
+	ID   string
+	Name string
+	// Origins lists the origins ceremonies may run on, e.g. "https://example.com".
+	Origins []string
+}
+
+// RegistrationResponse is the JSON form of the credential returned by navigator.credentials.create().
+type RegistrationResponse struct {
+	ID       string    `json:"id"`
+	RawID    Base64URL `json:"rawId"`
+	Type     string    `json:"type"`
+	Response struct {
+		ClientDataJSON    Base64URL `json:"clientDataJSON"`
+		AttestationObject Base64URL `json:"attestationObject"`
+		Transports        []string  `json:"transports,omitempty"`
+	} `json:"response"`
+}
+
+// AssertionResponse is the JSON form of the credential returned by navigator.credentials.get().
+type AssertionResponse struct {
+	ID       string    `json:"id"`
+	RawID    Base64URL `json:"rawId"`
+	Type     string    `json:"type"`
+	Response struct {
+		ClientDataJSON    Base64URL `json:"clientDataJSON"`
+		AuthenticatorData Base64URL `json:"authenticatorData"`
+		Signature         Base64URL `json:"signature"`
+		UserHandle        Base64URL `json:"userHandle,omitempty"`
+	} `json:"response"`
+}
+
+// Credential is a verified new passkey.
+type Credential struct {
+	ID []byte
+	// PublicKey is the COSE encoded public key.
+	PublicKey    []byte
+	Algorithm    int64
+	SignCount    uint32
+	AAGUID       []byte
+	Transports   []string
+	UserVerified bool
+}
+
+// Assertion is the outcome of a verified login.
+type Assertion struct {
+	SignCount    uint32
+	UserVerified bool
+}
+
+// clientData is the collected client data signed by the authenticator.
+type clientData struct {
+	Type        string `json:"type"`
+	Challenge   string `json:"challenge"`
+	Origin      string `json:"origin"`
+	CrossOrigin bool   `json:"crossOrigin"`
+}
+
+// authenticatorData is the parsed authenticator data.
+type authenticatorData struct {
+	RPIDHash  []byte
+	Flags     byte
+	SignCount uint32
+
+	AAGUID       []byte
+	CredentialID []byte
+	PublicKey    []byte
+}
+
+// NewChallenge returns a random challenge.
+func NewChallenge() ([]byte, error) {
+	b := make([]byte, challengeSize)
+	if _, err := rand.Read(b); err != nil {
+		return nil, err
+	}
+	return b, nil
+}
+
+// VerifyRegistration checks a registration response against the challenge
+// issued for it and returns the new credential.
+func (rp *RelyingParty) VerifyRegistration(challenge []byte, resp *RegistrationResponse) (*Credential, error) {
+	if resp.Type != "public-key" {
+		return nil, fmt.Errorf("unexpected credential type %q", resp.Type)
+	}
+	if err := rp.verifyClientData(resp.Response.ClientDataJSON, "webauthn.create", challenge); err != nil {
+		return nil, err
+	}
+
+	v, _, err := decodeCBOR(resp.Response.AttestationObject)
+	if err != nil {
+		return nil, fmt.Errorf("invalid attestation object: %w", err)
+	}
+	att, ok := v.(map[any]any)
+	if !ok {
+		return nil, errors.New("invalid attestation object")
+	}
+	rawAuthData, ok := att["authData"].([]byte)
+	if !ok {
+		return nil, errors.New("attestation object has no authenticator data")
+	}
+
+	authData, err := rp.parseAuthenticatorData(rawAuthData)
+	if err != nil {
+		return nil, err
+	}
+	if authData.Flags&flagAttestedData == 0 || authData.CredentialID == nil {
+		return nil, errors.New("authenticator data has no attested credential")
+	}
+	if len(resp.RawID) > 0 && !bytes.Equal(resp.RawID, authData.CredentialID) {
+		return nil, errors.New("credential id does not match authenticator data")
+	}
+
+	_, alg, err := parsePublicKey(authData.PublicKey)
+	if err != nil {
+		return nil, err
+	}
+
+	return &Credential{
+		ID:           authData.CredentialID,
+		PublicKey:    authData.PublicKey,
+		Algorithm:    alg,
+		SignCount:    authData.SignCount,
+		AAGUID:       authData.AAGUID,
+		Transports:   resp.Response.Transports,
+		UserVerified: authData.Flags&flagUserVerified != 0,
+	}, nil
+}
+
+// VerifyAssertion checks a login response against the challenge issued for it,
+// the stored public key and the last seen signature counter.
+func (rp *RelyingParty) VerifyAssertion(challenge []byte, resp *AssertionResponse, publicKey []byte, signCount uint32) (*Assertion, error) {
+	if resp.Type != "public-key" {
+		return nil, fmt.Errorf("unexpected credential type %q", resp.Type)
+	}
+	if err := rp.verifyClientData(resp.Response.ClientDataJSON, "webauthn.get", challenge); err != nil {
+		return nil, err
+	}
+
+	authData, err := rp.parseAuthenticatorData(resp.Response.AuthenticatorData)
+	if err != nil {
+		return nil, err
+	}
+
+	clientDataHash := sha256.Sum256(resp.Response.ClientDataJSON)
+	signed := append(append([]byte(nil), resp.Response.AuthenticatorData...), clientDataHash[:]...)
+	if err := verifySignature(publicKey, signed, resp.Response.Signature); err != nil {
+		return nil, err
+	}
+
+	// Authenticators that keep no counter always report zero. Otherwise the
+	// counter must grow, or the credential may have been cloned.
+	if (authData.SignCount != 0 || signCount != 0) && authData.SignCount <= signCount {
+		return nil, errors.New("signature counter did not increase, credential may be cloned")
+	}
+
+	return &Assertion{
+		SignCount:    authData.SignCount,
+		UserVerified: authData.Flags&flagUserVerified != 0,
+	}, nil
+}
+
+// verifyClientData checks the ceremony type, challenge and origin of the client data.
+func (rp *RelyingParty) verifyClientData(raw []byte, ceremony string, challenge []byte) error {
+	var cd clientData
+	if err := json.Unmarshal(raw, &cd); err != nil {
+		return fmt.Errorf("invalid client data: %w", err)
+	}
+	if cd.Type != ceremony {
+		return fmt.Errorf("unexpected ceremony type %q", cd.Type)
+	}
+
+	got, err := base64.RawURLEncoding.DecodeString(strings.TrimRight(cd.Challenge, "="))
+	if err != nil || subtle.ConstantTimeCompare(got, challenge) != 1 {
+		return errors.New("challenge mismatch")
+	}
+	if cd.CrossOrigin {
+		return errors.New("cross-origin ceremonies are not allowed")
+	}
+	if !slices.Contains(rp.Origins, cd.Origin) {
+		return fmt.Errorf("origin %q is not allowed", cd.Origin)
+	}
+	return nil
+}
+
+// parseAuthenticatorData decodes authenticator data and checks the RP ID hash
+// and user presence.
+func (rp *RelyingParty) parseAuthenticatorData(data []byte) (*authenticatorData, error) {
+	if len(data) < 37 {
+		return nil, errors.New("authenticator data too short")
+	}
+
+	ad := &authenticatorData{
+		RPIDHash:  data[:32],
+		Flags:     data[32],
+		SignCount: binary.BigEndian.Uint32(data[33:37]),
+	}
+
+	rpIDHash := sha256.Sum256([]byte(rp.ID))
+	if subtle.ConstantTimeCompare(ad.RPIDHash, rpIDHash[:]) != 1 {
+		return nil, errors.New("rp id hash mismatch")
+	}
+	if ad.Flags&flagUserPresent == 0 {
+		return nil, errors.New("user presence is required")
+	}
+
+	rest := data[37:]
+	if ad.Flags&flagAttestedData != 0 {
+		if len(rest) < 18 {
+			return nil, errors.New("attested credential data too short")
+		}
+		ad.AAGUID = rest[:16]
+		idLen := int(binary.BigEndian.Uint16(rest[16:18]))
+		rest = rest[18:]
+		if idLen == 0 || idLen > 1023 || len(rest) < idLen {
+			return nil, errors.New("invalid credential id length")
+		}
+		ad.CredentialID = rest[:idLen]
+		rest = rest[idLen:]
+
+		_, after, err := decodeCBOR(rest)
+		if err != nil {
+			return nil, fmt.Errorf("invalid credential public key: %w", err)
+		}
+		ad.PublicKey = rest[:len(rest)-len(after)]
+		rest = after
+	}
+	if ad.Flags&flagExtensionData != 0 {
+		_, after, err := decodeCBOR(rest)
+		if err != nil {
+			return nil, fmt.Errorf("invalid extension data: %w", err)
+		}
+		rest = after
+	}
+	if len(rest) != 0 {
+		return nil, errors.New("trailing bytes in authenticator data")
+	}
+
+	return ad, nil
+}
@@ -0,0 +1,326 @@
+package webauthn_test
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+
+	"blog-server/pkg/webauthn"
+	"blog-server/pkg/webauthn/webauthntest"
+)
+
+const (
+	rpID   = "blog.example"
+	origin = "https://blog.example"
+)
+
+func newRP() *webauthn.RelyingParty {
+	return &webauthn.RelyingParty{ID: rpID, Name: "Blog", Origins: []string{origin}}
+}
+
+func newChallenge(t *testing.T) []byte {
+	t.Helper()
+	challenge, err := webauthn.NewChallenge()
+	if err != nil {
+		t.Fatal(err)
+	}
+	return challenge
+}
+
+// register creates a passkey on the authenticator and verifies it.
+func register(t *testing.T, rp *webauthn.RelyingParty, a *webauthntest.Authenticator) *webauthn.Credential {
+	t.Helper()
+	challenge := newChallenge(t)
+	resp, err := a.Create(challenge, []byte("user-1"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	cred, err := rp.VerifyRegistration(challenge, resp)
+	if err != nil {
+		t.Fatalf("VerifyRegistration: %v", err)
+	}
+	return cred
+}
+
+func TestRoundTrip(t *testing.T) {
+	rp := newRP()
+	a := webauthntest.New(rpID, origin)
+
+	cred := register(t, rp, a)
+	if !bytes.Equal(cred.ID, a.CredentialID) {
+		t.Errorf("credential id = %x, want %x", cred.ID, a.CredentialID)
+	}
+	if cred.Algorithm != -7 || cred.SignCount != 0 || !cred.UserVerified {
+		t.Errorf("credential = %+v", cred)
+	}
+	if len(cred.Transports) != 1 || cred.Transports[0] != "internal" {
+		t.Errorf("transports = %v", cred.Transports)
+	}
+
+	signCount := cred.SignCount
+	for i := range 3 {
+		challenge := newChallenge(t)
+		resp, err := a.Get(challenge)
+		if err != nil {
+			t.Fatal(err)
+		}
+		assertion, err := rp.VerifyAssertion(challenge, resp, cred.PublicKey, signCount)
+		if err != nil {
+			t.Fatalf("login %d: VerifyAssertion: %v", i, err)
+		}
+		if assertion.SignCount <= signCount || !assertion.UserVerified {
+			t.Errorf("login %d: assertion = %+v after counter %d", i, assertion, signCount)
+		}
+		signCount = assertion.SignCount
+	}
+}
+
+func TestRoundTripWithoutUserVerification(t *testing.T) {
+	rp := newRP()
+	a := webauthntest.New(rpID, origin)
+	a.UserVerified = false
+
+	cred := register(t, rp, a)
+	if cred.UserVerified {
+		t.Error("registration reported user verification")
+	}
+
+	challenge := newChallenge(t)
+	resp, err := a.Get(challenge)
+	if err != nil {
+		t.Fatal(err)
+	}
+	assertion, err := rp.VerifyAssertion(challenge, resp, cred.PublicKey, cred.SignCount)
+	if err != nil {
+		t.Fatalf("VerifyAssertion: %v", err)
+	}
+	if assertion.UserVerified {
+		t.Error("assertion reported user verification")
+	}
+}
+
+func TestVerifyRegistrationRejects(t *testing.T) {
+	tests := []struct {
+		name string
+		// origin and rpID of the authenticator, defaulting to the relying party's.
+		origin, rpID string
+		// tamper changes the challenge or response before verification.
+		tamper  func(challenge []byte, resp *webauthn.RegistrationResponse) []byte
+		wantErr string
+	}{
+		{
+			name:    "bad origin",
+			origin:  "https://evil.example",
+			wantErr: "origin",
+		},
+		{
+			name:    "bad rp id",
+			rpID:    "evil.example",
+			wantErr: "rp id hash mismatch",
+		},
+		{
+			name: "bad challenge",
+			tamper: func(challenge []byte, _ *webauthn.RegistrationResponse) []byte {
+				return bytes.Repeat([]byte{1}, len(challenge))
+			},
+			wantErr: "challenge mismatch",
+		},
+		{
+			name: "assertion client data",
+			tamper: func(challenge []byte, resp *webauthn.RegistrationResponse) []byte {
+				resp.Response.ClientDataJSON = bytes.Replace(resp.Response.ClientDataJSON, []byte("webauthn.create"), []byte("webauthn.get"), 1)
+				return challenge
+			},
+			wantErr: "ceremony type",
+		},
+		{
+			name: "credential type",
+			tamper: func(challenge []byte, resp *webauthn.RegistrationResponse) []byte {
+				resp.Type = "password"
+				return challenge
+			},
+			wantErr: "credential type",
+		},
+		{
+			name: "raw id mismatch",
+			tamper: func(challenge []byte, resp *webauthn.RegistrationResponse) []byte {
+				resp.RawID = []byte("another credential")
+				return challenge
+			},
+			wantErr: "credential id",
+		},
+		{
+			name: "malformed cbor",
+			tamper: func(challenge []byte, resp *webauthn.RegistrationResponse) []byte {
+				resp.Response.AttestationObject = []byte{0xa3, 0x63, 'f', 'm'}
+				return challenge
+			},
+			wantErr: "invalid attestation object",
+		},
+		{
+			name: "truncated attestation object",
+			tamper: func(challenge []byte, resp *webauthn.RegistrationResponse) []byte {
+				obj := resp.Response.AttestationObject
+				resp.Response.AttestationObject = obj[:len(obj)-10]
+				return challenge
+			},
+			wantErr: "invalid attestation object",
+		},
+		{
+			name: "attestation object not a map",
+			tamper: func(challenge []byte, resp *webauthn.RegistrationResponse) []byte {
+				resp.Response.AttestationObject = []byte{0x01}
+				return challenge
+			},
+			wantErr: "invalid attestation object",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			a := webauthntest.New(rpID, origin)
+			if tt.origin != "" {
+				a.Origin = tt.origin
+			}
+			if tt.rpID != "" {
+				a.RPID = tt.rpID
+			}
+
+			challenge := newChallenge(t)
+			resp, err := a.Create(challenge, []byte("user-1"))
+			if err != nil {
+				t.Fatal(err)
+			}
+			if tt.tamper != nil {
+				challenge = tt.tamper(challenge, resp)
+			}
+
+			_, err = newRP().VerifyRegistration(challenge, resp)
+			if err == nil || !strings.Contains(err.Error(), tt.wantErr) {
+				t.Errorf("err = %v, want %q", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestVerifyAssertionRejects(t *testing.T) {
+	tests := []struct {
+		name string
+		// tamper changes the authenticator before it signs, or the challenge
+		// and response after.
+		before  func(a *webauthntest.Authenticator)
+		tamper  func(challenge []byte, resp *webauthn.AssertionResponse) []byte
+		wantErr string
+	}{
+		{
+			name:    "bad origin",
+			before:  func(a *webauthntest.Authenticator) { a.Origin = "https://evil.example" },
+			wantErr: "origin",
+		},
+		{
+			name:    "bad rp id",
+			before:  func(a *webauthntest.Authenticator) { a.RPID = "evil.example" },
+			wantErr: "rp id hash mismatch",
+		},
+		{
+			name: "bad challenge",
+			tamper: func(challenge []byte, _ *webauthn.AssertionResponse) []byte {
+				return bytes.Repeat([]byte{1}, len(challenge))
+			},
+			wantErr: "challenge mismatch",
+		},
+		{
+			name: "sign counter regression",
+			// The stored counter is 5 after five logins; a clone still at 2 signs 3.
+			before:  func(a *webauthntest.Authenticator) { a.SignCount = 2 },
+			wantErr: "signature counter",
+		},
+		{
+			name:    "sign counter repeated",
+			before:  func(a *webauthntest.Authenticator) { a.SignCount = 4 },
+			wantErr: "signature counter",
+		},
+		{
+			name: "tampered signature",
+			tamper: func(challenge []byte, resp *webauthn.AssertionResponse) []byte {
+				resp.Response.Signature[len(resp.Response.Signature)-1] ^= 0xff
+				return challenge
+			},
+			wantErr: "signature",
+		},
+		{
+			name: "tampered authenticator data",
+			tamper: func(challenge []byte, resp *webauthn.AssertionResponse) []byte {
+				resp.Response.AuthenticatorData[36]++
+				return challenge
+			},
+			wantErr: "signature",
+		},
+		{
+			name: "truncated authenticator data",
+			tamper: func(challenge []byte, resp *webauthn.AssertionResponse) []byte {
+				resp.Response.AuthenticatorData = resp.Response.AuthenticatorData[:30]
+				return challenge
+			},
+			wantErr: "too short",
+		},
+		{
+			name: "malformed cbor extensions",
+			tamper: func(challenge []byte, resp *webauthn.AssertionResponse) []byte {
+				resp.Response.AuthenticatorData[32] |= 0x80
+				resp.Response.AuthenticatorData = append(resp.Response.AuthenticatorData, 0xa1, 0x61)
+				return challenge
+			},
+			wantErr: "invalid extension data",
+		},
+		{
+			name: "registration client data",
+			tamper: func(challenge []byte, resp *webauthn.AssertionResponse) []byte {
+				resp.Response.ClientDataJSON = bytes.Replace(resp.Response.ClientDataJSON, []byte("webauthn.get"), []byte("webauthn.create"), 1)
+				return challenge
+			},
+			wantErr: "ceremony type",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			rp := newRP()
+			a := webauthntest.New(rpID, origin)
+			cred := register(t, rp, a)
+			const storedCount = 5
+			a.SignCount = storedCount
+
+			if tt.before != nil {
+				tt.before(a)
+			}
+			challenge := newChallenge(t)
+			resp, err := a.Get(challenge)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if tt.tamper != nil {
+				challenge = tt.tamper(challenge, resp)
+			}
+
+			_, err = rp.VerifyAssertion(challenge, resp, cred.PublicKey, storedCount)
+			if err == nil || !strings.Contains(err.Error(), tt.wantErr) {
+				t.Errorf("err = %v, want %q", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestVerifyAssertionRejectsOtherKey(t *testing.T) {
+	rp := newRP()
+	a := webauthntest.New(rpID, origin)
+	register(t, rp, a)
+	other := register(t, rp, webauthntest.New(rpID, origin))
+
+	challenge := newChallenge(t)
+	resp, err := a.Get(challenge)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err := rp.VerifyAssertion(challenge, resp, other.PublicKey, 0); err == nil {
+		t.Error("assertion verified with another credential's public key")
+	}
+}
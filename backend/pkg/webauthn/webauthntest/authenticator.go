@@ -0,0 +1,135 @@
+// Package webauthntest provides a software authenticator for exercising
+// WebAuthn ceremonies in tests, in the spirit of net/http/httptest.
+package webauthntest
+
+import (
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/binary"
+	"encoding/json"
+
+	"blog-server/pkg/webauthn"
+)
+
+// Authenticator is a platform authenticator holding a single ES256 passkey.
+type Authenticator struct {
+	RPID   string
+	Origin string
+	// UserVerified controls the UV flag, as if the user entered a PIN.
+	UserVerified bool
+
+	CredentialID []byte
+	UserHandle   []byte
+	SignCount    uint32
+
+	key *ecdsa.PrivateKey
+}
+
+// New creates an authenticator for the relying party ID and origin.
+func New(rpID, origin string) *Authenticator {
+	return &Authenticator{RPID: rpID, Origin: origin, UserVerified: true}
+}
+
+// Create runs navigator.credentials.create() for the challenge and user handle.
+func (a *Authenticator) Create(challenge, userHandle []byte) (*webauthn.RegistrationResponse, error) {
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		return nil, err
+	}
+	credID := make([]byte, 16)
+	if _, err := rand.Read(credID); err != nil {
+		return nil, err
+	}
+	a.key = key
+	a.CredentialID = credID
+	a.UserHandle = userHandle
+	a.SignCount = 0
+
+	x := make([]byte, 32)
+	y := make([]byte, 32)
+	key.X.FillBytes(x)
+	key.Y.FillBytes(y)
+	coseKey := encodeMap([][2][]byte{
+		{encodeInt(1), encodeInt(2)},
+		{encodeInt(3), encodeInt(-7)},
+		{encodeInt(-1), encodeInt(1)},
+		{encodeInt(-2), encodeBytes(x)},
+		{encodeInt(-3), encodeBytes(y)},
+	})
+
+	attested := make([]byte, 0, 18+len(credID)+len(coseKey))
+	attested = append(attested, make([]byte, 16)...) // zero AAGUID
+	attested = binary.BigEndian.AppendUint16(attested, uint16(len(credID)))
+	attested = append(attested, credID...)
+	attested = append(attested, coseKey...)
+
+	authData := a.authenticatorData(0x40, attested)
+	attObj := encodeMap([][2][]byte{
+		{encodeText("fmt"), encodeText("none")},
+		{encodeText("attStmt"), encodeMap(nil)},
+		{encodeText("authData"), encodeBytes(authData)},
+	})
+
+	resp := &webauthn.RegistrationResponse{
+		ID:    base64.RawURLEncoding.EncodeToString(credID),
+		RawID: credID,
+		Type:  "public-key",
+	}
+	resp.Response.ClientDataJSON = a.clientData("webauthn.create", challenge)
+	resp.Response.AttestationObject = attObj
+	resp.Response.Transports = []string{"internal"}
+	return resp, nil
+}
+
+// Get runs navigator.credentials.get() for the challenge with the stored passkey.
+func (a *Authenticator) Get(challenge []byte) (*webauthn.AssertionResponse, error) {
+	a.SignCount++
+	authData := a.authenticatorData(0, nil)
+	clientDataJSON := a.clientData("webauthn.get", challenge)
+
+	hash := sha256.Sum256(clientDataJSON)
+	digest := sha256.Sum256(append(append([]byte(nil), authData...), hash[:]...))
+	sig, err := ecdsa.SignASN1(rand.Reader, a.key, digest[:])
+	if err != nil {
+		return nil, err
+	}
+
+	resp := &webauthn.AssertionResponse{
+		ID:    base64.RawURLEncoding.EncodeToString(a.CredentialID),
+		RawID: a.CredentialID,
+		Type:  "public-key",
+	}
+	resp.Response.ClientDataJSON = clientDataJSON
+	resp.Response.AuthenticatorData = authData
+	resp.Response.Signature = sig
+	resp.Response.UserHandle = a.UserHandle
+	return resp, nil
+}
+
+// authenticatorData builds authenticator data with the given extra flags and trailing data.
+func (a *Authenticator) authenticatorData(flags byte, trailing []byte) []byte {
+	rpIDHash := sha256.Sum256([]byte(a.RPID))
+	flags |= 0x01
+	if a.UserVerified {
+		flags |= 0x04
+	}
+
+	data := append([]byte(nil), rpIDHash[:]...)
+	data = append(data, flags)
+	data = binary.BigEndian.AppendUint32(data, a.SignCount)
+	return append(data, trailing...)
+}
+
+// clientData returns the collected client data JSON of a ceremony.
+func (a *Authenticator) clientData(typ string, challenge []byte) []byte {
+	data, _ := json.Marshal(map[string]any{
+		"type":        typ,
+		"challenge":   base64.RawURLEncoding.EncodeToString(challenge),
+		"origin":      a.Origin,
+		"crossOrigin": false,
+	})
+	return data
+}
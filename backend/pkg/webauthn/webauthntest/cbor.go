@@ -0,0 +1,44 @@
+package webauthntest
+
+import "encoding/binary"
+
+// encodeHead encodes a CBOR major type with its argument.
+func encodeHead(major byte, arg uint64) []byte {
+	switch {
+	case arg < 24:
+		return []byte{major<<5 | byte(arg)}
+	case arg <= 0xff:
+		return []byte{major<<5 | 24, byte(arg)}
+	case arg <= 0xffff:
+		return binary.BigEndian.AppendUint16([]byte{major<<5 | 25}, uint16(arg))
+	case arg <= 0xffffffff:
+		return binary.BigEndian.AppendUint32([]byte{major<<5 | 26}, uint32(arg))
+	default:
+		return binary.BigEndian.AppendUint64([]byte{major<<5 | 27}, arg)
+	}
+}
+
+func encodeInt(v int64) []byte {
+	if v >= 0 {
+		return encodeHead(0, uint64(v))
+	}
+	return encodeHead(1, uint64(-1-v))
+}
+
+func encodeBytes(b []byte) []byte {
+	return append(encodeHead(2, uint64(len(b))), b...)
+}
+
+func encodeText(s string) []byte {
+	return append(encodeHead(3, uint64(len(s))), s...)
+}
+
+// encodeMap encodes already encoded key/value pairs in the given order.
+func encodeMap(pairs [][2][]byte) []byte {
+	out := encodeHead(5, uint64(len(pairs)))
+	for _, p := range pairs {
+		out = append(out, p[0]...)
+		out = append(out, p[1]...)
+	}
+	return out
+}
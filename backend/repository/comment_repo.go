@@ -0,0 +1,375 @@
+package repository
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"blog-server/datastore"
+	"blog-server/ent"
+	"blog-server/ent/comment"
+	"blog-server/ent/post"
+	"blog-server/ent/user"
+	"blog-server/entity"
+	"blog-server/mapper"
+	"blog-server/pkg/errx"
+
+	"entgo.io/ent/dialect/sql"
+)
+
+// CommentRepo defines persistence operations for the Comment aggregate.
+//
+// All read operations implicitly apply:
+// - soft-delete filter (DeletedAt IS NULL)
+type CommentRepo interface {
+	Create(ctx context.Context, comment *entity.Comment) (*entity.Comment, error)
+	GetByID(ctx context.Context, id uint) (*entity.Comment, error)
+	ListByIDs(ctx context.Context, ids []uint) ([]*entity.Comment, error)
+
+	ListApprovedRoots(ctx context.Context, postID uint, cursor uint, limit int) ([]*entity.Comment, error)
+	ListApprovedReplies(ctx context.Context, rootIDs []uint) ([]*entity.Comment, error)
+
+	ListAll(ctx context.Context, status *entity.CommentStatus, postID *uint, keyword *string, page, pageSize int) ([]*entity.Comment, error)
+	CountAll(ctx context.Context, status *entity.CommentStatus, postID *uint, keyword *string) (int, error)
+
+	UpdateContent(ctx context.Context, id uint, content string) error
+	UpdateStatusBatch(ctx context.Context, ids []uint, status entity.CommentStatus) error
+	DeleteBatch(ctx context.Context, ids []uint) error
+
+	Count(ctx context.Context) (int, error)
+	CountApprovedByPost(ctx context.Context, postID uint) (int, error)
+
+	EnableReplyNotify(ctx context.Context, id uint, email string) error
+	DisableReplyNotify(ctx context.Context, email string) error
+
+	IsOwner(ctx context.Context, userID uint, commentID uint) (bool, error)
+}
+
+type commentRepo struct {
+	ds *datastore.DataStore
+}
+
+// NewCommentRepo creates a CommentRepo instance using the given datastore.
+func NewCommentRepo(ds *datastore.DataStore) CommentRepo {
+	return &commentRepo{ds: ds}
+}
+
+// query returns a base comment query with soft-delete filter applied.
+func (r *commentRepo) query(ctx context.Context) *ent.CommentQuery {
+	return r.ds.Client(ctx).Comment.Query().
+		Where(comment.DeletedAtIsNil())
+}
+
+// approvedQuery returns a query filtered to approved comments only.
+func (r *commentRepo) approvedQuery(ctx context.Context) *ent.CommentQuery {
+	return r.query(ctx).
+		Where(
+			comment.StatusEQ(entity.CommentStatusApproved),
+		)
+}
+
+// selectCommentAuthor eager-loads the public fields of a registered author.
+func selectCommentAuthor(q *ent.UserQuery) {
+	q.Select(user.FieldUsername, user.FieldAvatar)
+}
+
+// Create inserts a new comment record.
+func (r *commentRepo) Create(ctx context.Context, c *entity.Comment) (*entity.Comment, error) {
+	builder := r.ds.Client(ctx).Comment.
+		Create().
+		SetPostID(c.PostID).
+		SetNillableParentID(c.ParentID).
+		SetNillableRootID(c.RootID).
+		SetNillableUserID(c.UserID).
+		SetAuthorName(c.AuthorName).
+		SetNillableAuthorEmail(c.AuthorEmail).
+		SetNillableAuthorWebsite(c.AuthorWebsite).
+		SetContent(c.Content).
+		SetStatus(c.Status).
+		SetNotifyOnReply(c.NotifyOnReply).
+		SetNillableSpamReason(c.SpamReason).
+		SetIP(c.IP).
+		SetUserAgent(c.UserAgent)
+
+	created, err := builder.Save(ctx)
+	if err != nil {
+		return nil, errx.New(errx.CodeInternalError, err)
+	}
+
+	return mapper.ToComment(created), nil
+}
+
+// GetByID returns a single comment by ID regardless of its status.
+func (r *commentRepo) GetByID(ctx context.Context, id uint) (*entity.Comment, error) {
+	c, err := r.query(ctx).
+		Where(comment.IDEQ(id)).
+		WithAuthor(selectCommentAuthor).
+		Only(ctx)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return nil, errx.New(errx.CodeNotFound, err)
+		}
+		return nil, errx.New(errx.CodeInternalError, err)
+	}
+
+	return mapper.ToComment(c), nil
+}
+
+// ListByIDs returns the comments with the given IDs regardless of their status.
+func (r *commentRepo) ListByIDs(ctx context.Context, ids []uint) ([]*entity.Comment, error) {
+	if len(ids) == 0 {
+		return nil, nil
+	}
+
+	cs, err := r.query(ctx).
+		Where(comment.IDIn(ids...)).
+		All(ctx)
+	if err != nil {
+		return nil, errx.New(errx.CodeInternalError, err)
+	}
+
+	return mapper.ToComments(cs), nil
+}
+
+// ListApprovedRoots returns approved top-level comments of a post, newest first.
+//
+// cursor is the ID of the last comment of the previous page; zero starts from
+// the newest comment.
+func (r *commentRepo) ListApprovedRoots(ctx context.Context, postID uint, cursor uint, limit int) ([]*entity.Comment, error) {
+	query := r.approvedQuery(ctx).
+		Where(
+			comment.PostIDEQ(postID),
+			comment.ParentIDIsNil(),
+		)
+
+	if cursor > 0 {
+		query = query.Where(comment.IDLT(cursor))
+	}
+
+	cs, err := query.
+		WithAuthor(selectCommentAuthor).
+		Order(
+			comment.ByID(sql.OrderDesc()),
+		).
+		Limit(limit).
+		All(ctx)
+	if err != nil {
+		return nil, errx.New(errx.CodeInternalError, err)
+	}
+
+	return mapper.ToComments(cs), nil
+}
+
+// ListApprovedReplies returns all approved replies belonging to the given threads,
+// oldest first.
+func (r *commentRepo) ListApprovedReplies(ctx context.Context, rootIDs []uint) ([]*entity.Comment, error) {
+	if len(rootIDs) == 0 {
+		return nil, nil
+	}
+
+	cs, err := r.approvedQuery(ctx).
+		Where(
+			comment.RootIDIn(rootIDs...),
+		).
+		WithAuthor(selectCommentAuthor).
+		Order(
+			comment.ByID(sql.OrderAsc()),
+		).
+		All(ctx)
+	if err != nil {
+		return nil, errx.New(errx.CodeInternalError, err)
+	}
+
+	return mapper.ToComments(cs), nil
+}
+
+// filteredQuery applies the optional admin list filters to the base query.
+func (r *commentRepo) filteredQuery(ctx context.Context, status *entity.CommentStatus, postID *uint, keyword *string) *ent.CommentQuery {
+	query := r.query(ctx)
+
+	if status != nil {
+		query = query.Where(comment.StatusEQ(*status))
+	}
+
+	if postID != nil {
+		query = query.Where(comment.PostIDEQ(*postID))
+	}
+
+	if keyword != nil && *keyword != "" {
+		query = query.Where(comment.Or(
+			comment.ContentContainsFold(*keyword),
+			comment.AuthorNameContainsFold(*keyword),
+			comment.AuthorEmailContainsFold(*keyword),
+		))
+	}
+
+	return query
+}
+
+// ListAll returns comments of any status for admin moderation views, newest first.
+func (r *commentRepo) ListAll(ctx context.Context, status *entity.CommentStatus, postID *uint, keyword *string, page, pageSize int) ([]*entity.Comment, error) {
+	page, pageSize = normalizedPage(page, pageSize)
+
+	cs, err := r.filteredQuery(ctx, status, postID, keyword).
+		WithAuthor(selectCommentAuthor).
+		WithPost(func(q *ent.PostQuery) {
+			q.Select(post.FieldTitle)
+		}).
+		Order(
+			comment.ByID(sql.OrderDesc()),
+		).
+		Offset((page - 1) * pageSize).
+		Limit(pageSize).
+		All(ctx)
+	if err != nil {
+		return nil, errx.New(errx.CodeInternalError, err)
+	}
+
+	return mapper.ToComments(cs), nil
+}
+
+// CountAll returns the count of comments matching optional filters.
+func (r *commentRepo) CountAll(ctx context.Context, status *entity.CommentStatus, postID *uint, keyword *string) (int, error) {
+	count, err := r.filteredQuery(ctx, status, postID, keyword).Count(ctx)
+	if err != nil {
+		return 0, errx.New(errx.CodeInternalError, err)
+	}
+	return count, nil
+}
+
+// UpdateContent replaces the body of a comment.
+func (r *commentRepo) UpdateContent(ctx context.Context, id uint, content string) error {
+	err := r.ds.Client(ctx).Comment.
+		UpdateOneID(id).
+		Where(comment.DeletedAtIsNil()).
+		SetContent(content).
+		SetUpdatedAt(time.Now()).
+		Exec(ctx)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return errx.New(errx.CodeNotFound, err)
+		}
+		return errx.New(errx.CodeInternalError, err)
+	}
+	return nil
+}
+
+// UpdateStatusBatch sets the status of all given non-deleted comments.
+//
+// Empty input results in a no-op.
+func (r *commentRepo) UpdateStatusBatch(ctx context.Context, ids []uint, status entity.CommentStatus) error {
+	if len(ids) == 0 {
+		return nil
+	}
+
+	err := r.ds.Client(ctx).Comment.
+		Update().
+		Where(
+			comment.IDIn(ids...),
+			comment.DeletedAtIsNil(),
+		).
+		SetStatus(status).
+		SetUpdatedAt(time.Now()).
+		Exec(ctx)
+	if err != nil {
+		return errx.New(errx.CodeInternalError, err)
+	}
+	return nil
+}
+
+// DeleteBatch soft-deletes comments by setting deleted_at and the deleted status.
+//
+// Empty input results in a no-op.
+func (r *commentRepo) DeleteBatch(ctx context.Context, ids []uint) error {
+	if len(ids) == 0 {
+		return nil
+	}
+
+	now := time.Now()
+	err := r.ds.Client(ctx).Comment.
+		Update().
+		Where(
+			comment.IDIn(ids...),
+			comment.DeletedAtIsNil(),
+		).
+		SetStatus(entity.CommentStatusDeleted).
+		SetDeletedAt(now).
+		SetUpdatedAt(now).
+		Exec(ctx)
+	if err != nil {
+		return errx.New(errx.CodeInternalError, err)
+	}
+	return nil
+}
+
+// Count returns the total number of non-deleted comments.
+func (r *commentRepo) Count(ctx context.Context) (int, error) {
+	count, err := r.query(ctx).Count(ctx)
+	if err != nil {
+		return 0, errx.New(errx.CodeInternalError, err)
+	}
+	return count, nil
+}
+
+// CountApprovedByPost returns the number of approved comments of a post.
+func (r *commentRepo) CountApprovedByPost(ctx context.Context, postID uint) (int, error) {
+	count, err := r.approvedQuery(ctx).
+		Where(comment.PostIDEQ(postID)).
+		Count(ctx)
+	if err != nil {
+		return 0, errx.New(errx.CodeInternalError, err)
+	}
+	return count, nil
+}
+
+// EnableReplyNotify turns on reply notifications on a comment left with the
+// given email. It returns NotFound when there is no such comment.
+func (r *commentRepo) EnableReplyNotify(ctx context.Context, id uint, email string) error {
+	n, err := r.ds.Client(ctx).Comment.
+		Update().
+		Where(
+			comment.IDEQ(id),
+			comment.AuthorEmailEqualFold(email),
+			comment.DeletedAtIsNil(),
+		).
+		SetNotifyOnReply(true).
+		Save(ctx)
+	if err != nil {
+		return errx.New(errx.CodeInternalError, err)
+	}
+	if n == 0 {
+		return errx.New(errx.CodeNotFound, fmt.Errorf("comment %d not found", id))
+	}
+	return nil
+}
+
+// DisableReplyNotify turns off reply notifications on every comment left with the given email.
+func (r *commentRepo) DisableReplyNotify(ctx context.Context, email string) error {
+	err := r.ds.Client(ctx).Comment.
+		Update().
+		Where(
+			comment.AuthorEmailEqualFold(email),
+			comment.NotifyOnReply(true),
+		).
+		SetNotifyOnReply(false).
+		Exec(ctx)
+	if err != nil {
+		return errx.New(errx.CodeInternalError, err)
+	}
+	return nil
+}
+
+// IsOwner checks whether a registered user authored the given comment.
+func (r *commentRepo) IsOwner(ctx context.Context, userID uint, commentID uint) (bool, error) {
+	exists, err := r.query(ctx).
+		Where(
+			comment.IDEQ(commentID),
+			comment.UserIDEQ(userID),
+		).
+		Exist(ctx)
+	if err != nil {
+		return false, errx.New(errx.CodeInternalError, err)
+	}
+
+	return exists, nil
+}
@@ -14,6 +14,12 @@ func Module() fx.Option {
 			NewUserRepo,
 			NewLinkRepo,
 			NewPostRepo,
+			NewCommentRepo,
+			NewSigningKeyRepo,
+			NewPasskeyRepo,
+			NewUserIdentityRepo,
+			NewPersonalAccessTokenRepo,
+			NewPostRevisionRepo,
 		),
 	)
 }
@@ -0,0 +1,126 @@
+package repository
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"blog-server/datastore"
+	"blog-server/ent"
+	"blog-server/ent/passkey"
+	"blog-server/entity"
+	"blog-server/mapper"
+	"blog-server/pkg/errx"
+
+	"entgo.io/ent/dialect/sql"
+)
+
+// PasskeyRepo defines persistence operations for WebAuthn passkeys.
+//
+// Passkeys are hard-deleted so a removed credential can never log in again.
+type PasskeyRepo interface {
+	Create(ctx context.Context, p *entity.Passkey) (*entity.Passkey, error)
+	ListByUser(ctx context.Context, userID uint) ([]*entity.Passkey, error)
+	GetByCredentialID(ctx context.Context, credentialID string) (*entity.Passkey, error)
+	UpdateUsage(ctx context.Context, id uint, signCount uint32, usedAt time.Time) error
+	Delete(ctx context.Context, userID, id uint) error
+}
+
+type passkeyRepo struct {
+	ds *datastore.DataStore
+}
+
+// NewPasskeyRepo creates a PasskeyRepo instance backed by datastore.
+func NewPasskeyRepo(ds *datastore.DataStore) PasskeyRepo {
+	return &passkeyRepo{ds: ds}
+}
+
+// Create inserts a new passkey.
+//
+// Returns CodeConflict if the credential is already registered.
+func (r *passkeyRepo) Create(ctx context.Context, p *entity.Passkey) (*entity.Passkey, error) {
+	c := r.ds.Client(ctx).Passkey.
+		Create().
+		SetUserID(p.UserID).
+		SetCredentialID(p.CredentialID).
+		SetPublicKey(p.PublicKey).
+		SetAlgorithm(p.Algorithm).
+		SetSignCount(p.SignCount).
+		SetName(p.Name).
+		SetAaguid(p.AAGUID)
+
+	if len(p.Transports) > 0 {
+		c.SetTransports(p.Transports)
+	}
+
+	created, err := c.Save(ctx)
+	if err != nil {
+		if ent.IsConstraintError(err) {
+			return nil, errx.New(errx.CodeConflict, err)
+		}
+		return nil, errx.New(errx.CodeInternalError, err)
+	}
+
+	return mapper.ToPasskey(created), nil
+}
+
+// ListByUser returns the passkeys of a user, newest first.
+func (r *passkeyRepo) ListByUser(ctx context.Context, userID uint) ([]*entity.Passkey, error) {
+	ps, err := r.ds.Client(ctx).Passkey.
+		Query().
+		Where(passkey.UserIDEQ(userID), passkey.DeletedAtIsNil()).
+		Order(passkey.ByID(sql.OrderDesc())).
+		All(ctx)
+	if err != nil {
+		return nil, errx.New(errx.CodeInternalError, err)
+	}
+
+	return mapper.ToPasskeys(ps), nil
+}
+
+// GetByCredentialID retrieves a passkey by its base64url credential ID.
+func (r *passkeyRepo) GetByCredentialID(ctx context.Context, credentialID string) (*entity.Passkey, error) {
+	p, err := r.ds.Client(ctx).Passkey.
+		Query().
+		Where(passkey.CredentialIDEQ(credentialID), passkey.DeletedAtIsNil()).
+		Only(ctx)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return nil, errx.New(errx.CodeNotFound, err)
+		}
+		return nil, errx.New(errx.CodeInternalError, err)
+	}
+
+	return mapper.ToPasskey(p), nil
+}
+
+// UpdateUsage records a successful login with the passkey.
+func (r *passkeyRepo) UpdateUsage(ctx context.Context, id uint, signCount uint32, usedAt time.Time) error {
+	err := r.ds.Client(ctx).Passkey.
+		UpdateOneID(id).
+		SetSignCount(signCount).
+		SetLastUsedAt(usedAt).
+		Exec(ctx)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return errx.New(errx.CodeNotFound, err)
+		}
+		return errx.New(errx.CodeInternalError, err)
+	}
+	return nil
+}
+
+// Delete removes a passkey owned by the user.
+func (r *passkeyRepo) Delete(ctx context.Context, userID, id uint) error {
+	n, err := r.ds.Client(ctx).Passkey.
+		Delete().
+		Where(passkey.IDEQ(id), passkey.UserIDEQ(userID)).
+		Exec(ctx)
+	if err != nil {
+		return errx.New(errx.CodeInternalError, err)
+	}
+	if n == 0 {
+		return errx.New(errx.CodeNotFound, fmt.Errorf("passkey %d not found", id))
+	}
+	return nil
+}
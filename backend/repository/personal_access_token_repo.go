@@ -0,0 +1,153 @@
+package repository
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"blog-server/datastore"
+	"blog-server/ent"
+	"blog-server/ent/personalaccesstoken"
+	"blog-server/entity"
+	"blog-server/mapper"
+	"blog-server/pkg/errx"
+
+	"entgo.io/ent/dialect/sql"
+)
+
+// PersonalAccessTokenRepo defines persistence operations for personal access tokens.
+//
+// Revoked tokens are kept so listings show when a token was revoked.
+type PersonalAccessTokenRepo interface {
+	Create(ctx context.Context, token *entity.PersonalAccessToken) (*entity.PersonalAccessToken, error)
+	GetByHash(ctx context.Context, hash string) (*entity.PersonalAccessToken, error)
+	ListByUser(ctx context.Context, userID uint) ([]*entity.PersonalAccessToken, error)
+	UpdateLastUsed(ctx context.Context, id uint, at time.Time) error
+	Revoke(ctx context.Context, userID, id uint, at time.Time) error
+	RevokeAll(ctx context.Context, userID uint, at time.Time) error
+}
+
+type personalAccessTokenRepo struct {
+	ds *datastore.DataStore
+}
+
+// NewPersonalAccessTokenRepo creates a PersonalAccessTokenRepo instance backed by datastore.
+func NewPersonalAccessTokenRepo(ds *datastore.DataStore) PersonalAccessTokenRepo {
+	return &personalAccessTokenRepo{ds: ds}
+}
+
+// Create inserts a new token.
+func (r *personalAccessTokenRepo) Create(ctx context.Context, t *entity.PersonalAccessToken) (*entity.PersonalAccessToken, error) {
+	created, err := r.ds.Client(ctx).PersonalAccessToken.
+		Create().
+		SetUserID(t.UserID).
+		SetName(t.Name).
+		SetTokenHash(t.TokenHash).
+		SetTokenPrefix(t.TokenPrefix).
+		SetScopes(t.Scopes).
+		SetTwoFactor(t.TwoFactor).
+		SetExpiresAt(t.ExpiresAt).
+		Save(ctx)
+	if err != nil {
+		return nil, errx.New(errx.CodeInternalError, err)
+	}
+
+	return mapper.ToPersonalAccessToken(created), nil
+}
+
+// GetByHash retrieves a token by the hash of its value.
+func (r *personalAccessTokenRepo) GetByHash(ctx context.Context, hash string) (*entity.PersonalAccessToken, error) {
+	t, err := r.ds.Client(ctx).PersonalAccessToken.
+		Query().
+		Where(
+			personalaccesstoken.TokenHashEQ(hash),
+			personalaccesstoken.DeletedAtIsNil(),
+		).
+		Only(ctx)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return nil, errx.New(errx.CodeNotFound, err)
+		}
+		return nil, errx.New(errx.CodeInternalError, err)
+	}
+
+	return mapper.ToPersonalAccessToken(t), nil
+}
+
+// ListByUser returns the tokens of a user, newest first.
+func (r *personalAccessTokenRepo) ListByUser(ctx context.Context, userID uint) ([]*entity.PersonalAccessToken, error) {
+	ts, err := r.ds.Client(ctx).PersonalAccessToken.
+		Query().
+		Where(
+			personalaccesstoken.UserIDEQ(userID),
+			personalaccesstoken.DeletedAtIsNil(),
+		).
+		Order(personalaccesstoken.ByID(sql.OrderDesc())).
+		All(ctx)
+	if err != nil {
+		return nil, errx.New(errx.CodeInternalError, err)
+	}
+
+	return mapper.ToPersonalAccessTokens(ts), nil
+}
+
+// UpdateLastUsed records the time a token was last used.
+func (r *personalAccessTokenRepo) UpdateLastUsed(ctx context.Context, id uint, at time.Time) error {
+	err := r.ds.Client(ctx).PersonalAccessToken.
+		UpdateOneID(id).
+		SetLastUsedAt(at).
+		Exec(ctx)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return errx.New(errx.CodeNotFound, err)
+		}
+		return errx.New(errx.CodeInternalError, err)
+	}
+	return nil
+}
+
+// Revoke revokes a token of the user. Revoking a revoked token is a no-op.
+func (r *personalAccessTokenRepo) Revoke(ctx context.Context, userID, id uint, at time.Time) error {
+	client := r.ds.Client(ctx).PersonalAccessToken
+	exists, err := client.Query().
+		Where(
+			personalaccesstoken.IDEQ(id),
+			personalaccesstoken.UserIDEQ(userID),
+			personalaccesstoken.DeletedAtIsNil(),
+		).
+		Exist(ctx)
+	if err != nil {
+		return errx.New(errx.CodeInternalError, err)
+	}
+	if !exists {
+		return errx.New(errx.CodeNotFound, fmt.Errorf("access token %d not found", id))
+	}
+
+	_, err = client.Update().
+		Where(
+			personalaccesstoken.IDEQ(id),
+			personalaccesstoken.RevokedAtIsNil(),
+		).
+		SetRevokedAt(at).
+		Save(ctx)
+	if err != nil {
+		return errx.New(errx.CodeInternalError, err)
+	}
+	return nil
+}
+
+// RevokeAll revokes every active token of the user.
+func (r *personalAccessTokenRepo) RevokeAll(ctx context.Context, userID uint, at time.Time) error {
+	_, err := r.ds.Client(ctx).PersonalAccessToken.
+		Update().
+		Where(
+			personalaccesstoken.UserIDEQ(userID),
+			personalaccesstoken.RevokedAtIsNil(),
+		).
+		SetRevokedAt(at).
+		Save(ctx)
+	if err != nil {
+		return errx.New(errx.CodeInternalError, err)
+	}
+	return nil
+}
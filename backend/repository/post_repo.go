@@ -2,13 +2,18 @@ package repository
 
 import (
 	"context"
+	"fmt"
+	"strings"
 	"time"
 
 	"blog-server/datastore"
 	"blog-server/ent"
+	"blog-server/ent/comment"
 	"blog-server/ent/post"
 	"blog-server/ent/postcategory"
 	"blog-server/ent/postcategoryrelation"
+	"blog-server/ent/postrevision"
+	"blog-server/ent/postslug"
 	"blog-server/ent/posttag"
 	"blog-server/ent/posttagrelation"
 	"blog-server/ent/user"
@@ -31,20 +36,33 @@ type PostRepo interface {
 	Delete(ctx context.Context, id uint) error
 
 	ListPublished(ctx context.Context, page, pageSize int) ([]*entity.Post, error)
+	ListPublishedByAuthor(ctx context.Context, userID uint, page, pageSize int) ([]*entity.Post, error)
 	ListPublishedForSitemap(ctx context.Context) ([]*entity.Post, error)
 	ListPublishedForMeta(ctx context.Context, page, pageSize int) ([]*entity.Post, error)
 
-	ListAll(ctx context.Context, status *entity.PostStatus, keyword *string, page, pageSize int) ([]*entity.Post, error)
+	ListAll(ctx context.Context, authorID *uint, status *entity.PostStatus, keyword *string, page, pageSize int) ([]*entity.Post, error)
 	GetAdminListItemByID(ctx context.Context, id uint) (*entity.Post, error)
 	GetByID(ctx context.Context, id uint) (*entity.Post, error)
 	GetPublishedByID(ctx context.Context, id uint) (*entity.Post, error)
+	GetPublishedBySlug(ctx context.Context, slug string) (*entity.Post, error)
+	ExistsPublished(ctx context.Context, id uint) (bool, error)
 	GetLatestPublishedAt(ctx context.Context) (*time.Time, error)
 	GetLatestUpdatedAt(ctx context.Context) (*time.Time, error)
 
+	// ListDueScheduled returns the IDs of the scheduled posts due at now,
+	// earliest first.
+	ListDueScheduled(ctx context.Context, now time.Time, limit int) ([]uint, error)
+	// PublishScheduled publishes a scheduled post that is due at now. It
+	// reports false if the post is no longer scheduled or due, so that only one
+	// caller publishes a post.
+	PublishScheduled(ctx context.Context, id uint, now time.Time) (bool, error)
+
 	Count(ctx context.Context) (int, error)
-	CountAll(ctx context.Context, status *entity.PostStatus, keyword *string) (int, error)
+	CountAll(ctx context.Context, authorID *uint, status *entity.PostStatus, keyword *string) (int, error)
 	CountPublished(ctx context.Context) (int, error)
-	CountDeleted(ctx context.Context) (int, error)
+	CountPublishedByAuthor(ctx context.Context, userID uint) (int, error)
+	CountDeleted(ctx context.Context, authorID *uint) (int, error)
+	SumViewCount(ctx context.Context) (int64, error)
 
 	AddTags(ctx context.Context, postID uint, tagIDs []uint) error
 	SetTags(ctx context.Context, postID uint, tagIDs []uint) error
@@ -54,6 +72,29 @@ type PostRepo interface {
 	BatchIncrViewCounts(ctx context.Context, updates map[uint]int64) error
 
 	IsOwner(ctx context.Context, userID uint, postID uint) (bool, error)
+
+	// Deleted posts are kept in the trash until restored or purged.
+	ListDeleted(ctx context.Context, authorID *uint, page, pageSize int) ([]*entity.Post, error)
+	ListDeletedIDs(ctx context.Context, authorID *uint, before *time.Time) ([]uint, error)
+	GetDeletedByID(ctx context.Context, id uint) (*entity.Post, error)
+	Restore(ctx context.Context, id uint) error
+	// Purge permanently deletes a post in the trash with its comments,
+	// relations, slug history and revisions. It must run in a transaction.
+	Purge(ctx context.Context, id uint) error
+
+	// Slugs of a post are unique among all posts, including deleted ones,
+	// and among the former slugs of other posts.
+	SlugTaken(ctx context.Context, slug string, excludeID uint) (bool, error)
+	SetSlug(ctx context.Context, id uint, slug string) error
+	GetSlugFromHistory(ctx context.Context, slug string) (string, error)
+	ListWithoutSlug(ctx context.Context) ([]*entity.Post, error)
+
+	// The search index is written from documents built by
+	// utils.SearchDocument.
+	SetSearchIndex(ctx context.Context, id uint, title, summary, content string) error
+	ListUnindexed(ctx context.Context) ([]uint, error)
+	Search(ctx context.Context, filter *entity.PostSearchFilter, page, pageSize int) ([]*entity.Post, error)
+	CountSearch(ctx context.Context, filter *entity.PostSearchFilter) (int, error)
 }
 
 type postRepo struct {
@@ -81,7 +122,7 @@ func (r *postRepo) publishedQuery(ctx context.Context) *ent.PostQuery {
 
 // deletedQuery returns a query filtered to soft-deleted posts only.
 func (r *postRepo) deletedQuery(ctx context.Context) *ent.PostQuery {
-	return r.query(ctx).
+	return r.ds.Client(ctx).Post.Query().
 		Where(
 			post.DeletedAtNotNil(),
 		)
@@ -101,14 +142,20 @@ func (r *postRepo) Create(ctx context.Context, p *entity.Post) (*entity.Post, er
 		SetCreatedAt(now).
 		SetUpdatedAt(now)
 
+	if p.Slug != "" {
+		builder.SetSlug(p.Slug)
+	}
 	if p.Summary != nil {
 		builder.SetSummary(*p.Summary)
 	}
 	if p.Cover != nil {
 		builder.SetCover(*p.Cover)
 	}
-	if p.Status == entity.PostStatusPublish {
+	switch p.Status {
+	case entity.PostStatusPublish:
 		builder.SetPublishedAt(now)
+	case entity.PostStatusScheduled:
+		builder.SetNillablePublishedAt(p.PublishedAt)
 	}
 
 	ep, err := builder.Save(ctx)
@@ -175,8 +222,10 @@ func (r *postRepo) Update(ctx context.Context, p *entity.Post) error {
 
 	if p.Status != "" {
 		builder.SetStatus(p.Status)
-		if p.Status == entity.PostStatusPublish {
-			builder.SetPublishedAt(time.Now())
+		// The caller sets PublishedAt when the post becomes published, so
+		// saving a published post keeps its publication time.
+		if p.Status == entity.PostStatusPublish || p.Status == entity.PostStatusScheduled {
+			builder.SetNillablePublishedAt(p.PublishedAt)
 		}
 	}
 
@@ -197,8 +246,12 @@ func (r *postRepo) Delete(ctx context.Context, id uint) error {
 	now := time.Now()
 	err := r.ds.Client(ctx).Post.
 		UpdateOneID(id).
+		Where(post.DeletedAtIsNil()).
 		SetDeletedAt(now).
 		Exec(ctx)
+	if ent.IsNotFound(err) {
+		return errx.New(errx.CodeNotFound, err)
+	}
 	if err != nil {
 		return errx.New(errx.CodeInternalError, err)
 	}
@@ -212,6 +265,42 @@ func (r *postRepo) ListPublished(ctx context.Context, page, pageSize int) ([]*en
 		Select(
 			post.FieldID,
 			post.FieldTitle,
+			post.FieldSlug,
+			post.FieldSummary,
+			post.FieldCover,
+			post.FieldReadTimeMinutes,
+			post.FieldViewCount,
+			post.FieldPublishedAt,
+			post.FieldCreatedAt,
+			post.FieldUpdatedAt,
+		).
+		WithAuthor(func(q *ent.UserQuery) {
+			q.Select(user.FieldUsername)
+		}).
+		WithCategories().
+		WithTags().
+		Order(
+			post.ByPublishedAt(sql.OrderDesc()),
+		).
+		Offset((page - 1) * pageSize).
+		Limit(pageSize).
+		All(ctx)
+	if err != nil {
+		return nil, errx.New(errx.CodeInternalError, err)
+	}
+
+	return mapper.ToPosts(ps), nil
+}
+
+// ListPublishedByAuthor returns the published posts of one author for list views.
+func (r *postRepo) ListPublishedByAuthor(ctx context.Context, userID uint, page, pageSize int) ([]*entity.Post, error) {
+	page, pageSize = normalizedPage(page, pageSize)
+	ps, err := r.publishedQuery(ctx).
+		Where(post.UserIDEQ(userID)).
+		Select(
+			post.FieldID,
+			post.FieldTitle,
+			post.FieldSlug,
 			post.FieldSummary,
 			post.FieldCover,
 			post.FieldReadTimeMinutes,
@@ -243,6 +332,7 @@ func (r *postRepo) ListPublishedForSitemap(ctx context.Context) ([]*entity.Post,
 	ps, err := r.publishedQuery(ctx).
 		Select(
 			post.FieldID,
+			post.FieldSlug,
 			post.FieldUpdatedAt,
 		).
 		All(ctx)
@@ -261,6 +351,7 @@ func (r *postRepo) ListPublishedForMeta(ctx context.Context, page, pageSize int)
 		Select(
 			post.FieldID,
 			post.FieldTitle,
+			post.FieldSlug,
 			post.FieldSummary,
 			post.FieldUpdatedAt,
 			post.FieldPublishedAt,
@@ -278,12 +369,17 @@ func (r *postRepo) ListPublishedForMeta(ctx context.Context, page, pageSize int)
 	return mapper.ToPosts(ps), nil
 }
 
-// ListAll returns all posts including drafts for admin list views.
-func (r *postRepo) ListAll(ctx context.Context, status *entity.PostStatus, keyword *string, page, pageSize int) ([]*entity.Post, error) {
+// ListAll returns all posts including drafts for admin list views, optionally
+// limited to those of one author.
+func (r *postRepo) ListAll(ctx context.Context, authorID *uint, status *entity.PostStatus, keyword *string, page, pageSize int) ([]*entity.Post, error) {
 	page, pageSize = normalizedPage(page, pageSize)
 
 	query := r.query(ctx)
 
+	if authorID != nil {
+		query = query.Where(post.UserIDEQ(*authorID))
+	}
+
 	if status != nil {
 		query = query.Where(post.StatusEQ(*status))
 	}
@@ -296,6 +392,7 @@ func (r *postRepo) ListAll(ctx context.Context, status *entity.PostStatus, keywo
 		Select(
 			post.FieldID,
 			post.FieldTitle,
+			post.FieldSlug,
 			post.FieldSummary,
 			post.FieldCover,
 			post.FieldStatus,
@@ -329,6 +426,7 @@ func (r *postRepo) GetAdminListItemByID(ctx context.Context, id uint) (*entity.P
 		Select(
 			post.FieldID,
 			post.FieldTitle,
+			post.FieldSlug,
 			post.FieldSummary,
 			post.FieldCover,
 			post.FieldStatus,
@@ -355,9 +453,13 @@ func (r *postRepo) GetAdminListItemByID(ctx context.Context, id uint) (*entity.P
 }
 
 // CountAll returns the count of posts matching optional filters.
-func (r *postRepo) CountAll(ctx context.Context, status *entity.PostStatus, keyword *string) (int, error) {
+func (r *postRepo) CountAll(ctx context.Context, authorID *uint, status *entity.PostStatus, keyword *string) (int, error) {
 	query := r.query(ctx)
 
+	if authorID != nil {
+		query = query.Where(post.UserIDEQ(*authorID))
+	}
+
 	if status != nil {
 		query = query.Where(post.StatusEQ(entity.PostStatus(*status)))
 	}
@@ -375,6 +477,7 @@ func (r *postRepo) GetPublishedByID(ctx context.Context, id uint) (*entity.Post,
 		Select(
 			post.FieldID,
 			post.FieldTitle,
+			post.FieldSlug,
 			post.FieldSummary,
 			post.FieldCover,
 			post.FieldContent,
@@ -400,12 +503,56 @@ func (r *postRepo) GetPublishedByID(ctx context.Context, id uint) (*entity.Post,
 	return mapper.ToPost(p), nil
 }
 
+// GetPublishedBySlug returns a single published post by its current slug.
+func (r *postRepo) GetPublishedBySlug(ctx context.Context, slug string) (*entity.Post, error) {
+	p, err := r.publishedQuery(ctx).
+		Select(
+			post.FieldID,
+			post.FieldTitle,
+			post.FieldSlug,
+			post.FieldSummary,
+			post.FieldCover,
+			post.FieldContent,
+			post.FieldReadTimeMinutes,
+			post.FieldViewCount,
+			post.FieldPublishedAt,
+			post.FieldCreatedAt,
+			post.FieldUpdatedAt,
+		).
+		WithAuthor(func(q *ent.UserQuery) {
+			q.Select(user.FieldUsername)
+		}).
+		WithCategories().
+		WithTags().
+		Where(
+			post.SlugEQ(slug),
+		).
+		First(ctx)
+	if err != nil {
+		return nil, errx.New(errx.CodeNotFound, err)
+	}
+
+	return mapper.ToPost(p), nil
+}
+
+// ExistsPublished checks whether a published post with the given ID exists.
+func (r *postRepo) ExistsPublished(ctx context.Context, id uint) (bool, error) {
+	exists, err := r.publishedQuery(ctx).
+		Where(post.IDEQ(id)).
+		Exist(ctx)
+	if err != nil {
+		return false, errx.New(errx.CodeInternalError, err)
+	}
+	return exists, nil
+}
+
 // GetByID returns a single post by ID.
 func (r *postRepo) GetByID(ctx context.Context, id uint) (*entity.Post, error) {
 	p, err := r.query(ctx).
 		Select(
 			post.FieldID,
 			post.FieldTitle,
+			post.FieldSlug,
 			post.FieldSummary,
 			post.FieldCover,
 			post.FieldContent,
@@ -466,6 +613,45 @@ func (r *postRepo) GetLatestUpdatedAt(ctx context.Context) (*time.Time, error) {
 	return &p.UpdatedAt, nil
 }
 
+// ListDueScheduled returns the IDs of the scheduled posts due at now, earliest
+// first.
+func (r *postRepo) ListDueScheduled(ctx context.Context, now time.Time, limit int) ([]uint, error) {
+	ids, err := r.query(ctx).
+		Where(
+			post.StatusEQ(entity.PostStatusScheduled),
+			post.PublishedAtLTE(now),
+		).
+		Order(
+			post.ByPublishedAt(),
+		).
+		Limit(limit).
+		IDs(ctx)
+	if err != nil {
+		return nil, errx.New(errx.CodeInternalError, err)
+	}
+	return ids, nil
+}
+
+// PublishScheduled publishes a scheduled post that is due at now, keeping the
+// scheduled time as its published_at.
+func (r *postRepo) PublishScheduled(ctx context.Context, id uint, now time.Time) (bool, error) {
+	n, err := r.ds.Client(ctx).Post.
+		Update().
+		Where(
+			post.ID(id),
+			post.DeletedAtIsNil(),
+			post.StatusEQ(entity.PostStatusScheduled),
+			post.PublishedAtLTE(now),
+		).
+		SetStatus(entity.PostStatusPublish).
+		SetUpdatedAt(now).
+		Save(ctx)
+	if err != nil {
+		return false, errx.New(errx.CodeInternalError, err)
+	}
+	return n > 0, nil
+}
+
 // Count returns the total number of posts (including deleted).
 func (r *postRepo) Count(ctx context.Context) (int, error) {
 	return r.query(ctx).Count(ctx)
@@ -476,9 +662,48 @@ func (r *postRepo) CountPublished(ctx context.Context) (int, error) {
 	return r.publishedQuery(ctx).Count(ctx)
 }
 
-// CountDeleted returns the number of soft-deleted posts.
-func (r *postRepo) CountDeleted(ctx context.Context) (int, error) {
-	return r.deletedQuery(ctx).Count(ctx)
+// CountPublishedByAuthor returns the number of published posts of one author.
+func (r *postRepo) CountPublishedByAuthor(ctx context.Context, userID uint) (int, error) {
+	n, err := r.publishedQuery(ctx).
+		Where(post.UserIDEQ(userID)).
+		Count(ctx)
+	if err != nil {
+		return 0, errx.New(errx.CodeInternalError, err)
+	}
+	return n, nil
+}
+
+// SumViewCount returns the total view count of published posts. Views not yet
+// flushed from Redis are not included.
+func (r *postRepo) SumViewCount(ctx context.Context) (int64, error) {
+	var v []struct {
+		Sum *int64 `json:"sum"`
+	}
+	err := r.publishedQuery(ctx).
+		Aggregate(ent.As(ent.Sum(post.FieldViewCount), "sum")).
+		Scan(ctx, &v)
+	if err != nil {
+		return 0, errx.New(errx.CodeInternalError, err)
+	}
+	if len(v) == 0 || v[0].Sum == nil {
+		return 0, nil
+	}
+	return *v[0].Sum, nil
+}
+
+// CountDeleted returns the number of soft-deleted posts, optionally of one
+// author.
+func (r *postRepo) CountDeleted(ctx context.Context, authorID *uint) (int, error) {
+	query := r.deletedQuery(ctx)
+	if authorID != nil {
+		query = query.Where(post.UserIDEQ(*authorID))
+	}
+
+	n, err := query.Count(ctx)
+	if err != nil {
+		return 0, errx.New(errx.CodeInternalError, err)
+	}
+	return n, nil
 }
 
 // AddTags attaches tags to a post without removing existing relations.
@@ -619,9 +844,10 @@ func (r *postRepo) BatchIncrViewCounts(ctx context.Context, updates map[uint]int
 	return nil
 }
 
-// IsOwner checks whether a user is the author of a given post.
+// IsOwner checks whether a user is the author of a given post. Posts in the
+// trash count, so that authors can restore and purge their own.
 func (r *postRepo) IsOwner(ctx context.Context, userID uint, postID uint) (bool, error) {
-	exists, err := r.query(ctx).
+	exists, err := r.ds.Client(ctx).Post.Query().
 		Where(
 			post.IDEQ(postID),
 			post.UserIDEQ(userID),
@@ -633,3 +859,379 @@ func (r *postRepo) IsOwner(ctx context.Context, userID uint, postID uint) (bool,
 
 	return exists, nil
 }
+
+// SlugTaken reports whether the slug belongs to a post other than excludeID,
+// now or in the past.
+func (r *postRepo) SlugTaken(ctx context.Context, slug string, excludeID uint) (bool, error) {
+	client := r.ds.Client(ctx)
+
+	taken, err := client.Post.Query().
+		Where(
+			post.SlugEQ(slug),
+			post.IDNEQ(excludeID),
+		).
+		Exist(ctx)
+	if err != nil {
+		return false, errx.New(errx.CodeInternalError, err)
+	}
+	if taken {
+		return true, nil
+	}
+
+	taken, err = client.PostSlug.Query().
+		Where(
+			postslug.SlugEQ(slug),
+			postslug.PostIDNEQ(excludeID),
+		).
+		Exist(ctx)
+	if err != nil {
+		return false, errx.New(errx.CodeInternalError, err)
+	}
+	return taken, nil
+}
+
+// SetSlug changes the slug of a post and keeps the previous one in the slug
+// history. It must run in a transaction.
+func (r *postRepo) SetSlug(ctx context.Context, id uint, slug string) error {
+	client := r.ds.Client(ctx)
+
+	p, err := client.Post.Query().
+		Where(post.IDEQ(id)).
+		Select(post.FieldSlug).
+		Only(ctx)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return errx.New(errx.CodeNotFound, err)
+		}
+		return errx.New(errx.CodeInternalError, err)
+	}
+	if p.Slug == slug {
+		return nil
+	}
+
+	// Going back to a former slug makes it current again.
+	if _, err := client.PostSlug.Delete().
+		Where(
+			postslug.PostIDEQ(id),
+			postslug.SlugEQ(slug),
+		).
+		Exec(ctx); err != nil {
+		return errx.New(errx.CodeInternalError, err)
+	}
+
+	if p.Slug != "" {
+		now := time.Now()
+		err := client.PostSlug.Create().
+			SetPostID(id).
+			SetSlug(p.Slug).
+			SetCreatedAt(now).
+			SetUpdatedAt(now).
+			Exec(ctx)
+		if err != nil {
+			if ent.IsConstraintError(err) {
+				return errx.New(errx.CodeConflict, err)
+			}
+			return errx.New(errx.CodeInternalError, err)
+		}
+	}
+
+	err = client.Post.UpdateOneID(id).
+		SetSlug(slug).
+		SetUpdatedAt(time.Now()).
+		Exec(ctx)
+	if err != nil {
+		if ent.IsConstraintError(err) {
+			return errx.New(errx.CodeConflict, err)
+		}
+		return errx.New(errx.CodeInternalError, err)
+	}
+	return nil
+}
+
+// GetSlugFromHistory returns the current slug of the published post that
+// formerly used the given slug.
+func (r *postRepo) GetSlugFromHistory(ctx context.Context, slug string) (string, error) {
+	current, err := r.publishedQuery(ctx).
+		Where(
+			post.HasSlugHistoryWith(postslug.SlugEQ(slug)),
+			post.SlugNEQ(""),
+		).
+		Select(post.FieldSlug).
+		String(ctx)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return "", errx.New(errx.CodeNotFound, err)
+		}
+		return "", errx.New(errx.CodeInternalError, err)
+	}
+	return current, nil
+}
+
+// ListWithoutSlug returns the ID and title of all posts that have no slug yet,
+// including deleted ones.
+func (r *postRepo) ListWithoutSlug(ctx context.Context) ([]*entity.Post, error) {
+	ps, err := r.ds.Client(ctx).Post.Query().
+		Where(
+			post.Or(
+				post.SlugIsNil(),
+				post.SlugEQ(""),
+			),
+		).
+		Select(
+			post.FieldID,
+			post.FieldTitle,
+		).
+		Order(post.ByID()).
+		All(ctx)
+	if err != nil {
+		return nil, errx.New(errx.CodeInternalError, err)
+	}
+
+	return mapper.ToPosts(ps), nil
+}
+
+// ListDeleted returns soft-deleted posts, optionally of one author, most
+// recently deleted first.
+func (r *postRepo) ListDeleted(ctx context.Context, authorID *uint, page, pageSize int) ([]*entity.Post, error) {
+	page, pageSize = normalizedPage(page, pageSize)
+	query := r.deletedQuery(ctx)
+	if authorID != nil {
+		query = query.Where(post.UserIDEQ(*authorID))
+	}
+
+	ps, err := query.
+		Select(
+			post.FieldID,
+			post.FieldTitle,
+			post.FieldSlug,
+			post.FieldSummary,
+			post.FieldStatus,
+			post.FieldPublishedAt,
+			post.FieldCreatedAt,
+			post.FieldUpdatedAt,
+			post.FieldDeletedAt,
+		).
+		WithAuthor(func(q *ent.UserQuery) {
+			q.Select(user.FieldUsername)
+		}).
+		Order(
+			post.ByDeletedAt(sql.OrderDesc()),
+			post.ByID(sql.OrderDesc()),
+		).
+		Offset((page - 1) * pageSize).
+		Limit(pageSize).
+		All(ctx)
+	if err != nil {
+		return nil, errx.New(errx.CodeInternalError, err)
+	}
+
+	return mapper.ToPosts(ps), nil
+}
+
+// ListDeletedIDs returns the IDs of soft-deleted posts, optionally of one
+// author and deleted before a time.
+func (r *postRepo) ListDeletedIDs(ctx context.Context, authorID *uint, before *time.Time) ([]uint, error) {
+	query := r.deletedQuery(ctx)
+	if authorID != nil {
+		query = query.Where(post.UserIDEQ(*authorID))
+	}
+	if before != nil {
+		query = query.Where(post.DeletedAtLT(*before))
+	}
+
+	ids, err := query.IDs(ctx)
+	if err != nil {
+		return nil, errx.New(errx.CodeInternalError, err)
+	}
+	return ids, nil
+}
+
+// GetDeletedByID returns a soft-deleted post by ID.
+func (r *postRepo) GetDeletedByID(ctx context.Context, id uint) (*entity.Post, error) {
+	p, err := r.deletedQuery(ctx).
+		WithAuthor(func(q *ent.UserQuery) {
+			q.Select(user.FieldUsername)
+		}).
+		Where(post.IDEQ(id)).
+		Only(ctx)
+	if ent.IsNotFound(err) {
+		return nil, errx.New(errx.CodeNotFound, err)
+	}
+	if err != nil {
+		return nil, errx.New(errx.CodeInternalError, err)
+	}
+
+	return mapper.ToPost(p), nil
+}
+
+// Restore takes a soft-deleted post out of the trash.
+func (r *postRepo) Restore(ctx context.Context, id uint) error {
+	err := r.ds.Client(ctx).Post.
+		UpdateOneID(id).
+		Where(post.DeletedAtNotNil()).
+		ClearDeletedAt().
+		SetUpdatedAt(time.Now()).
+		Exec(ctx)
+	if ent.IsNotFound(err) {
+		return errx.New(errx.CodeNotFound, err)
+	}
+	if err != nil {
+		return errx.New(errx.CodeInternalError, err)
+	}
+	return nil
+}
+
+// Purge permanently deletes a soft-deleted post and everything that refers
+// to it.
+func (r *postRepo) Purge(ctx context.Context, id uint) error {
+	client := r.ds.Client(ctx)
+
+	if _, err := client.PostTagRelation.Delete().
+		Where(posttagrelation.PostIDEQ(id)).
+		Exec(ctx); err != nil {
+		return errx.New(errx.CodeInternalError, err)
+	}
+	if _, err := client.PostCategoryRelation.Delete().
+		Where(postcategoryrelation.PostIDEQ(id)).
+		Exec(ctx); err != nil {
+		return errx.New(errx.CodeInternalError, err)
+	}
+	if _, err := client.Comment.Delete().
+		Where(comment.PostIDEQ(id)).
+		Exec(ctx); err != nil {
+		return errx.New(errx.CodeInternalError, err)
+	}
+	if _, err := client.PostSlug.Delete().
+		Where(postslug.PostIDEQ(id)).
+		Exec(ctx); err != nil {
+		return errx.New(errx.CodeInternalError, err)
+	}
+	if _, err := client.PostRevision.Delete().
+		Where(postrevision.PostIDEQ(id)).
+		Exec(ctx); err != nil {
+		return errx.New(errx.CodeInternalError, err)
+	}
+
+	n, err := client.Post.Delete().
+		Where(
+			post.IDEQ(id),
+			post.DeletedAtNotNil(),
+		).
+		Exec(ctx)
+	if err != nil {
+		return errx.New(errx.CodeInternalError, err)
+	}
+	if n == 0 {
+		return errx.New(errx.CodeNotFound, fmt.Errorf("post %d is not in the trash", id))
+	}
+	return nil
+}
+
+// SetSearchIndex writes the search index of a post, weighting the title over
+// the summary over the content.
+func (r *postRepo) SetSearchIndex(ctx context.Context, id uint, title, summary, content string) error {
+	err := r.ds.Client(ctx).Post.
+		UpdateOneID(id).
+		Modify(func(u *sql.UpdateBuilder) {
+			u.Set(post.FieldSearchVector, sql.ExprFunc(func(b *sql.Builder) {
+				b.WriteString("setweight(to_tsvector('simple', ").Arg(title).WriteString("), 'A') || ")
+				b.WriteString("setweight(to_tsvector('simple', ").Arg(summary).WriteString("), 'B') || ")
+				b.WriteString("setweight(to_tsvector('simple', ").Arg(content).WriteString("), 'C')")
+			}))
+		}).
+		Exec(ctx)
+	if ent.IsNotFound(err) {
+		return errx.New(errx.CodeNotFound, err)
+	}
+	if err != nil {
+		return errx.New(errx.CodeInternalError, err)
+	}
+	return nil
+}
+
+// ListUnindexed returns the IDs of posts without a search index.
+func (r *postRepo) ListUnindexed(ctx context.Context) ([]uint, error) {
+	ids, err := r.query(ctx).
+		Where(post.SearchVectorIsNil()).
+		IDs(ctx)
+	if err != nil {
+		return nil, errx.New(errx.CodeInternalError, err)
+	}
+	return ids, nil
+}
+
+// Search returns the published posts matching a full-text search, most
+// relevant first, with their content for excerpts.
+func (r *postRepo) Search(ctx context.Context, filter *entity.PostSearchFilter, page, pageSize int) ([]*entity.Post, error) {
+	page, pageSize = normalizedPage(page, pageSize)
+	terms := strings.Join(filter.Terms, " ")
+
+	ps, err := r.searchQuery(ctx, filter).
+		Select(
+			post.FieldID,
+			post.FieldTitle,
+			post.FieldSlug,
+			post.FieldSummary,
+			post.FieldCover,
+			post.FieldContent,
+			post.FieldReadTimeMinutes,
+			post.FieldViewCount,
+			post.FieldPublishedAt,
+			post.FieldCreatedAt,
+			post.FieldUpdatedAt,
+		).
+		WithAuthor(func(q *ent.UserQuery) {
+			q.Select(user.FieldUsername)
+		}).
+		WithCategories().
+		WithTags().
+		Order(
+			func(s *sql.Selector) {
+				// OrderExprFunc drops the arguments, unlike OrderExpr.
+				s.OrderExpr(sql.ExprFunc(func(b *sql.Builder) {
+					b.WriteString("ts_rank_cd(").Ident(s.C(post.FieldSearchVector))
+					b.WriteString(", plainto_tsquery('simple', ").Arg(terms).WriteString(")) DESC")
+				}))
+			},
+			post.ByPublishedAt(sql.OrderDesc()),
+		).
+		Offset((page - 1) * pageSize).
+		Limit(pageSize).
+		All(ctx)
+	if err != nil {
+		return nil, errx.New(errx.CodeInternalError, err)
+	}
+
+	return mapper.ToPosts(ps), nil
+}
+
+// CountSearch returns the number of published posts matching a full-text
+// search.
+func (r *postRepo) CountSearch(ctx context.Context, filter *entity.PostSearchFilter) (int, error) {
+	n, err := r.searchQuery(ctx, filter).Count(ctx)
+	if err != nil {
+		return 0, errx.New(errx.CodeInternalError, err)
+	}
+	return n, nil
+}
+
+// searchQuery returns a query of the published posts matching a full-text
+// search.
+func (r *postRepo) searchQuery(ctx context.Context, filter *entity.PostSearchFilter) *ent.PostQuery {
+	terms := strings.Join(filter.Terms, " ")
+	query := r.publishedQuery(ctx).
+		Where(func(s *sql.Selector) {
+			s.Where(sql.P(func(b *sql.Builder) {
+				b.Ident(s.C(post.FieldSearchVector))
+				b.WriteString(" @@ plainto_tsquery('simple', ").Arg(terms).WriteString(")")
+			}))
+		})
+
+	if filter.TagID != nil {
+		query = query.Where(post.HasTagsWith(posttag.IDEQ(*filter.TagID)))
+	}
+	if filter.CategoryID != nil {
+		query = query.Where(post.HasCategoriesWith(postcategory.IDEQ(*filter.CategoryID)))
+	}
+	return query
+}
@@ -0,0 +1,210 @@
+package repository
+
+import (
+	"context"
+	"time"
+
+	"blog-server/datastore"
+	"blog-server/ent"
+	"blog-server/ent/postrevision"
+	"blog-server/ent/user"
+	"blog-server/entity"
+	"blog-server/mapper"
+	"blog-server/pkg/errx"
+
+	"entgo.io/ent/dialect/sql"
+)
+
+// PostRevisionRepo defines persistence operations for post revisions.
+//
+// Versions count up from 1 per post. Pruning only removes the oldest
+// revisions, so the versions a post keeps stay contiguous.
+type PostRevisionRepo interface {
+	Create(ctx context.Context, rev *entity.PostRevision) (*entity.PostRevision, error)
+	GetLatest(ctx context.Context, postID uint) (*entity.PostRevision, error)
+	GetByVersion(ctx context.Context, postID, version uint) (*entity.PostRevision, error)
+	ListByPost(ctx context.Context, postID uint, page, pageSize int) ([]*entity.PostRevision, error)
+	CountByPost(ctx context.Context, postID uint) (int, error)
+
+	DeleteBeyond(ctx context.Context, postID uint, keep int) (int, error)
+	DeleteOlderThan(ctx context.Context, before time.Time) (int, error)
+}
+
+type postRevisionRepo struct {
+	ds *datastore.DataStore
+}
+
+// NewPostRevisionRepo creates a PostRevisionRepo instance backed by datastore.
+func NewPostRevisionRepo(ds *datastore.DataStore) PostRevisionRepo {
+	return &postRevisionRepo{ds: ds}
+}
+
+// Create inserts a revision as the next version of its post. It must run in
+// a transaction with the change it records.
+func (r *postRevisionRepo) Create(ctx context.Context, rev *entity.PostRevision) (*entity.PostRevision, error) {
+	client := r.ds.Client(ctx)
+
+	var version uint
+	latest, err := client.PostRevision.Query().
+		Where(postrevision.PostIDEQ(rev.PostID)).
+		Order(postrevision.ByVersion(sql.OrderDesc())).
+		Select(postrevision.FieldVersion).
+		First(ctx)
+	switch {
+	case err == nil:
+		version = latest.Version
+	case !ent.IsNotFound(err):
+		return nil, errx.New(errx.CodeInternalError, err)
+	}
+
+	now := time.Now()
+	builder := client.PostRevision.
+		Create().
+		SetPostID(rev.PostID).
+		SetVersion(version + 1).
+		SetTitle(rev.Title).
+		SetContent(rev.Content).
+		SetNillableUserID(rev.UserID).
+		SetNillableSummary(rev.Summary).
+		SetNillableRestoredFrom(rev.RestoredFrom).
+		SetCreatedAt(now).
+		SetUpdatedAt(now)
+
+	created, err := builder.Save(ctx)
+	if err != nil {
+		if ent.IsConstraintError(err) {
+			return nil, errx.New(errx.CodeConflict, err)
+		}
+		return nil, errx.New(errx.CodeInternalError, err)
+	}
+
+	return mapper.ToPostRevision(created), nil
+}
+
+// GetLatest returns the newest revision of a post.
+func (r *postRevisionRepo) GetLatest(ctx context.Context, postID uint) (*entity.PostRevision, error) {
+	rev, err := r.ds.Client(ctx).PostRevision.Query().
+		Where(postrevision.PostIDEQ(postID)).
+		Order(postrevision.ByVersion(sql.OrderDesc())).
+		First(ctx)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return nil, errx.New(errx.CodeNotFound, err)
+		}
+		return nil, errx.New(errx.CodeInternalError, err)
+	}
+
+	return mapper.ToPostRevision(rev), nil
+}
+
+// GetByVersion returns one revision of a post with its content.
+func (r *postRevisionRepo) GetByVersion(ctx context.Context, postID, version uint) (*entity.PostRevision, error) {
+	rev, err := r.ds.Client(ctx).PostRevision.Query().
+		Where(
+			postrevision.PostIDEQ(postID),
+			postrevision.VersionEQ(version),
+		).
+		WithUser(func(q *ent.UserQuery) {
+			q.Select(user.FieldUsername)
+		}).
+		Only(ctx)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return nil, errx.New(errx.CodeNotFound, err)
+		}
+		return nil, errx.New(errx.CodeInternalError, err)
+	}
+
+	return mapper.ToPostRevision(rev), nil
+}
+
+// ListByPost returns the revisions of a post without content, newest first.
+func (r *postRevisionRepo) ListByPost(ctx context.Context, postID uint, page, pageSize int) ([]*entity.PostRevision, error) {
+	page, pageSize = normalizedPage(page, pageSize)
+
+	revs, err := r.ds.Client(ctx).PostRevision.Query().
+		Where(postrevision.PostIDEQ(postID)).
+		Select(
+			postrevision.FieldID,
+			postrevision.FieldPostID,
+			postrevision.FieldVersion,
+			postrevision.FieldUserID,
+			postrevision.FieldTitle,
+			postrevision.FieldSummary,
+			postrevision.FieldRestoredFrom,
+			postrevision.FieldCreatedAt,
+		).
+		WithUser(func(q *ent.UserQuery) {
+			q.Select(user.FieldUsername)
+		}).
+		Order(postrevision.ByVersion(sql.OrderDesc())).
+		Offset((page - 1) * pageSize).
+		Limit(pageSize).
+		All(ctx)
+	if err != nil {
+		return nil, errx.New(errx.CodeInternalError, err)
+	}
+
+	return mapper.ToPostRevisions(revs), nil
+}
+
+// CountByPost returns the number of revisions of a post.
+func (r *postRevisionRepo) CountByPost(ctx context.Context, postID uint) (int, error) {
+	count, err := r.ds.Client(ctx).PostRevision.Query().
+		Where(postrevision.PostIDEQ(postID)).
+		Count(ctx)
+	if err != nil {
+		return 0, errx.New(errx.CodeInternalError, err)
+	}
+	return count, nil
+}
+
+// DeleteBeyond deletes all but the newest keep revisions of a post.
+func (r *postRevisionRepo) DeleteBeyond(ctx context.Context, postID uint, keep int) (int, error) {
+	latest, err := r.GetLatest(ctx, postID)
+	if err != nil {
+		if errx.ToAppError(err).Code == errx.CodeNotFound {
+			return 0, nil
+		}
+		return 0, err
+	}
+	if latest.Version <= uint(keep) {
+		return 0, nil
+	}
+
+	n, err := r.ds.Client(ctx).PostRevision.Delete().
+		Where(
+			postrevision.PostIDEQ(postID),
+			postrevision.VersionLTE(latest.Version-uint(keep)),
+		).
+		Exec(ctx)
+	if err != nil {
+		return 0, errx.New(errx.CodeInternalError, err)
+	}
+	return n, nil
+}
+
+// DeleteOlderThan deletes revisions created before the given time, except
+// the newest revision of each post.
+func (r *postRevisionRepo) DeleteOlderThan(ctx context.Context, before time.Time) (int, error) {
+	n, err := r.ds.Client(ctx).PostRevision.Delete().
+		Where(
+			postrevision.CreatedAtLT(before),
+			func(s *sql.Selector) {
+				newer := sql.Table(postrevision.Table).As("newer")
+				s.Where(sql.Exists(
+					sql.Select(newer.C(postrevision.FieldID)).
+						From(newer).
+						Where(sql.And(
+							sql.ColumnsEQ(newer.C(postrevision.FieldPostID), s.C(postrevision.FieldPostID)),
+							sql.ColumnsGT(newer.C(postrevision.FieldVersion), s.C(postrevision.FieldVersion)),
+						)),
+				))
+			},
+		).
+		Exec(ctx)
+	if err != nil {
+		return 0, errx.New(errx.CodeInternalError, err)
+	}
+	return n, nil
+}
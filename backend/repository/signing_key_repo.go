@@ -0,0 +1,101 @@
+package repository
+
+import (
+	"context"
+	"time"
+
+	"blog-server/datastore"
+	"blog-server/ent/signingkey"
+	"blog-server/entity"
+	"blog-server/mapper"
+	"blog-server/pkg/errx"
+
+	"entgo.io/ent/dialect/sql"
+)
+
+// SigningKeyRepo defines persistence operations for JWT signing keys.
+//
+// Keys are hard-deleted once pruned so expired private keys do not linger.
+type SigningKeyRepo interface {
+	Create(ctx context.Context, key *entity.SigningKey) (*entity.SigningKey, error)
+	ListUsable(ctx context.Context, retiredAfter time.Time) ([]*entity.SigningKey, error)
+	RetireOthers(ctx context.Context, keepID uint, at time.Time) error
+	DeleteRetiredBefore(ctx context.Context, before time.Time) (int, error)
+}
+
+type signingKeyRepo struct {
+	ds *datastore.DataStore
+}
+
+// NewSigningKeyRepo creates a SigningKeyRepo instance backed by datastore.
+func NewSigningKeyRepo(ds *datastore.DataStore) SigningKeyRepo {
+	return &signingKeyRepo{ds: ds}
+}
+
+// Create inserts a new signing key.
+func (r *signingKeyRepo) Create(ctx context.Context, k *entity.SigningKey) (*entity.SigningKey, error) {
+	created, err := r.ds.Client(ctx).SigningKey.
+		Create().
+		SetKid(k.KID).
+		SetAlgorithm(k.Algorithm).
+		SetPrivateKey(k.PrivateKey).
+		SetPublicKey(k.PublicKey).
+		SetNillableActivatesAt(k.ActivatesAt).
+		Save(ctx)
+	if err != nil {
+		return nil, errx.New(errx.CodeInternalError, err)
+	}
+
+	return mapper.ToSigningKey(created), nil
+}
+
+// ListUsable returns the active keys and the keys retired after the given time,
+// newest first.
+func (r *signingKeyRepo) ListUsable(ctx context.Context, retiredAfter time.Time) ([]*entity.SigningKey, error) {
+	keys, err := r.ds.Client(ctx).SigningKey.
+		Query().
+		Where(
+			signingkey.DeletedAtIsNil(),
+			signingkey.Or(
+				signingkey.RetiredAtIsNil(),
+				signingkey.RetiredAtGT(retiredAfter),
+			),
+		).
+		Order(signingkey.ByID(sql.OrderDesc())).
+		All(ctx)
+	if err != nil {
+		return nil, errx.New(errx.CodeInternalError, err)
+	}
+
+	return mapper.ToSigningKeys(keys), nil
+}
+
+// RetireOthers marks every active key except keepID as retired at the given
+// time, which may lie in the future.
+func (r *signingKeyRepo) RetireOthers(ctx context.Context, keepID uint, at time.Time) error {
+	_, err := r.ds.Client(ctx).SigningKey.
+		Update().
+		Where(
+			signingkey.IDNEQ(keepID),
+			signingkey.RetiredAtIsNil(),
+		).
+		SetRetiredAt(at).
+		SetUpdatedAt(at).
+		Save(ctx)
+	if err != nil {
+		return errx.New(errx.CodeInternalError, err)
+	}
+	return nil
+}
+
+// DeleteRetiredBefore removes keys retired before the given time and returns how many were removed.
+func (r *signingKeyRepo) DeleteRetiredBefore(ctx context.Context, before time.Time) (int, error) {
+	n, err := r.ds.Client(ctx).SigningKey.
+		Delete().
+		Where(signingkey.RetiredAtLT(before)).
+		Exec(ctx)
+	if err != nil {
+		return 0, errx.New(errx.CodeInternalError, err)
+	}
+	return n, nil
+}
@@ -0,0 +1,123 @@
+package repository
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"blog-server/datastore"
+	"blog-server/ent"
+	"blog-server/ent/useridentity"
+	"blog-server/entity"
+	"blog-server/mapper"
+	"blog-server/pkg/errx"
+
+	"entgo.io/ent/dialect/sql"
+)
+
+// UserIdentityRepo defines persistence operations for external login identities.
+//
+// Identities are hard-deleted when unlinked so the external account can be
+// linked to another user afterwards.
+type UserIdentityRepo interface {
+	Create(ctx context.Context, identity *entity.UserIdentity) (*entity.UserIdentity, error)
+	GetByProviderSubject(ctx context.Context, provider, subject string) (*entity.UserIdentity, error)
+	ListByUser(ctx context.Context, userID uint) ([]*entity.UserIdentity, error)
+	UpdateLastLogin(ctx context.Context, id uint, at time.Time) error
+	Delete(ctx context.Context, userID, id uint) error
+}
+
+type userIdentityRepo struct {
+	ds *datastore.DataStore
+}
+
+// NewUserIdentityRepo creates a UserIdentityRepo instance backed by datastore.
+func NewUserIdentityRepo(ds *datastore.DataStore) UserIdentityRepo {
+	return &userIdentityRepo{ds: ds}
+}
+
+// Create links a new identity.
+//
+// Returns CodeConflict if the external account is already linked.
+func (r *userIdentityRepo) Create(ctx context.Context, i *entity.UserIdentity) (*entity.UserIdentity, error) {
+	created, err := r.ds.Client(ctx).UserIdentity.
+		Create().
+		SetUserID(i.UserID).
+		SetProvider(i.Provider).
+		SetSubject(i.Subject).
+		SetEmail(i.Email).
+		SetNillableLastLoginAt(i.LastLoginAt).
+		Save(ctx)
+	if err != nil {
+		if ent.IsConstraintError(err) {
+			return nil, errx.New(errx.CodeConflict, err)
+		}
+		return nil, errx.New(errx.CodeInternalError, err)
+	}
+
+	return mapper.ToUserIdentity(created), nil
+}
+
+// GetByProviderSubject retrieves the identity of an external account.
+func (r *userIdentityRepo) GetByProviderSubject(ctx context.Context, provider, subject string) (*entity.UserIdentity, error) {
+	i, err := r.ds.Client(ctx).UserIdentity.
+		Query().
+		Where(
+			useridentity.ProviderEQ(provider),
+			useridentity.SubjectEQ(subject),
+			useridentity.DeletedAtIsNil(),
+		).
+		Only(ctx)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return nil, errx.New(errx.CodeNotFound, err)
+		}
+		return nil, errx.New(errx.CodeInternalError, err)
+	}
+
+	return mapper.ToUserIdentity(i), nil
+}
+
+// ListByUser returns the identities linked to a user, oldest first.
+func (r *userIdentityRepo) ListByUser(ctx context.Context, userID uint) ([]*entity.UserIdentity, error) {
+	is, err := r.ds.Client(ctx).UserIdentity.
+		Query().
+		Where(useridentity.UserIDEQ(userID), useridentity.DeletedAtIsNil()).
+		Order(useridentity.ByID(sql.OrderAsc())).
+		All(ctx)
+	if err != nil {
+		return nil, errx.New(errx.CodeInternalError, err)
+	}
+
+	return mapper.ToUserIdentities(is), nil
+}
+
+// UpdateLastLogin records a login through the identity.
+func (r *userIdentityRepo) UpdateLastLogin(ctx context.Context, id uint, at time.Time) error {
+	err := r.ds.Client(ctx).UserIdentity.
+		UpdateOneID(id).
+		SetLastLoginAt(at).
+		Exec(ctx)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return errx.New(errx.CodeNotFound, err)
+		}
+		return errx.New(errx.CodeInternalError, err)
+	}
+	return nil
+}
+
+// Delete unlinks an identity of the user.
+func (r *userIdentityRepo) Delete(ctx context.Context, userID, id uint) error {
+	n, err := r.ds.Client(ctx).UserIdentity.
+		Delete().
+		Where(useridentity.IDEQ(id), useridentity.UserIDEQ(userID)).
+		Exec(ctx)
+	if err != nil {
+		return errx.New(errx.CodeInternalError, err)
+	}
+	if n == 0 {
+		return errx.New(errx.CodeNotFound, fmt.Errorf("identity %d not found", id))
+	}
+	return nil
+}
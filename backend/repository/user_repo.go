@@ -2,6 +2,8 @@ package repository
 
 import (
 	"context"
+	"fmt"
+	"time"
 
 	"blog-server/datastore"
 	"blog-server/ent"
@@ -10,6 +12,8 @@ import (
 	"blog-server/mapper"
 	"blog-server/pkg/errx"
 
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqljson"
 	"github.com/google/uuid"
 )
 
@@ -20,13 +24,32 @@ import (
 type UserRepo interface {
 	Create(ctx context.Context, user *entity.User, hashPassword string) (*entity.User, error)
 	GetByEmail(ctx context.Context, email string) (*entity.User, error)
+	GetByID(ctx context.Context, id uint) (*entity.User, error)
+	GetByUsername(ctx context.Context, username string) (*entity.User, error)
+	ListByRole(ctx context.Context, role entity.UserRole) ([]*entity.User, error)
+	List(ctx context.Context, filter *entity.UserFilter, page, pageSize int) ([]*entity.User, error)
+	Count(ctx context.Context, filter *entity.UserFilter) (int, error)
+
+	UpdatePassword(ctx context.Context, id uint, hashPassword string) error
+	UpdateEmail(ctx context.Context, id uint, email string) (*entity.User, error)
+	UpdateProfile(ctx context.Context, id uint, update *entity.UserProfileUpdate) (*entity.User, error)
+	UpdateRole(ctx context.Context, id uint, role entity.UserRole) (*entity.User, error)
+	UpdateStatus(ctx context.Context, id uint, status entity.UserStatus) (*entity.User, error)
+	Delete(ctx context.Context, id uint) error
 
 	ExistsByEmail(ctx context.Context, email string) (bool, error)
+	ExistsByEmailFold(ctx context.Context, email string) (bool, error)
 	ExistsByUUID(ctx context.Context, uuidStr string) (bool, error)
 	ExistsByID(ctx context.Context, id uint) (bool, error)
 
 	GetAuthByEmail(ctx context.Context, email string) (*entity.UserAuth, error)
 	GetAuthByID(ctx context.Context, id uint) (*entity.UserAuth, error)
+
+	GetTwoFactor(ctx context.Context, id uint) (*entity.UserTwoFactor, error)
+	EnableTwoFactor(ctx context.Context, id uint, secret string, recoveryCodes []string) error
+	UpdateRecoveryCodes(ctx context.Context, id uint, recoveryCodes []string) error
+	UseRecoveryCode(ctx context.Context, id uint, hash string) (bool, error)
+	DisableTwoFactor(ctx context.Context, id uint) error
 }
 
 // userRepo implements UserRepo using ent ORM.
@@ -71,6 +94,135 @@ func (r *userRepo) Create(ctx context.Context, u *entity.User, hashPassword stri
 	return mapper.ToUser(created), nil
 }
 
+// UpdatePassword replaces the password hash of a user.
+func (r *userRepo) UpdatePassword(ctx context.Context, id uint, hashPassword string) error {
+	n, err := r.ds.Client(ctx).User.
+		Update().
+		Where(user.IDEQ(id), user.DeletedAtIsNil()).
+		SetPassword(hashPassword).
+		Save(ctx)
+	if err != nil {
+		return errx.New(errx.CodeInternalError, err)
+	}
+	if n == 0 {
+		return errx.New(errx.CodeNotFound, fmt.Errorf("user %d not found", id))
+	}
+	return nil
+}
+
+// UpdateEmail replaces the email of a user and returns the updated user.
+func (r *userRepo) UpdateEmail(ctx context.Context, id uint, email string) (*entity.User, error) {
+	u, err := r.ds.Client(ctx).User.
+		UpdateOneID(id).
+		Where(user.DeletedAtIsNil()).
+		SetEmail(email).
+		Save(ctx)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return nil, errx.New(errx.CodeNotFound, err)
+		}
+		if ent.IsConstraintError(err) {
+			return nil, errx.New(errx.CodeConflict, err)
+		}
+		return nil, errx.New(errx.CodeInternalError, err)
+	}
+
+	return mapper.ToUser(u), nil
+}
+
+// UpdateProfile applies a profile update and returns the updated user.
+//
+// Returns CodeConflict if the new username is taken.
+func (r *userRepo) UpdateProfile(ctx context.Context, id uint, update *entity.UserProfileUpdate) (*entity.User, error) {
+	m := r.ds.Client(ctx).User.
+		UpdateOneID(id).
+		Where(user.DeletedAtIsNil()).
+		SetUpdatedAt(time.Now())
+
+	if update.Username != nil {
+		m.SetUsername(*update.Username)
+	}
+	if update.Avatar != nil {
+		if *update.Avatar == "" {
+			m.ClearAvatar()
+		} else {
+			m.SetAvatar(*update.Avatar)
+		}
+	}
+	if update.Bio != nil {
+		if *update.Bio == "" {
+			m.ClearBio()
+		} else {
+			m.SetBio(*update.Bio)
+		}
+	}
+
+	u, err := m.Save(ctx)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return nil, errx.New(errx.CodeNotFound, err)
+		}
+		if ent.IsConstraintError(err) {
+			return nil, errx.New(errx.CodeConflict, err)
+		}
+		return nil, errx.New(errx.CodeInternalError, err)
+	}
+
+	return mapper.ToUser(u), nil
+}
+
+// UpdateRole changes the role of a user and returns the updated user.
+func (r *userRepo) UpdateRole(ctx context.Context, id uint, role entity.UserRole) (*entity.User, error) {
+	u, err := r.ds.Client(ctx).User.
+		UpdateOneID(id).
+		Where(user.DeletedAtIsNil()).
+		SetRole(role).
+		SetUpdatedAt(time.Now()).
+		Save(ctx)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return nil, errx.New(errx.CodeNotFound, err)
+		}
+		return nil, errx.New(errx.CodeInternalError, err)
+	}
+
+	return mapper.ToUser(u), nil
+}
+
+// UpdateStatus changes the status of a user and returns the updated user.
+func (r *userRepo) UpdateStatus(ctx context.Context, id uint, status entity.UserStatus) (*entity.User, error) {
+	u, err := r.ds.Client(ctx).User.
+		UpdateOneID(id).
+		Where(user.DeletedAtIsNil()).
+		SetStatus(status).
+		SetUpdatedAt(time.Now()).
+		Save(ctx)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return nil, errx.New(errx.CodeNotFound, err)
+		}
+		return nil, errx.New(errx.CodeInternalError, err)
+	}
+
+	return mapper.ToUser(u), nil
+}
+
+// Delete soft-deletes a user, after which all queries skip it.
+func (r *userRepo) Delete(ctx context.Context, id uint) error {
+	n, err := r.ds.Client(ctx).User.
+		Update().
+		Where(user.IDEQ(id), user.DeletedAtIsNil()).
+		SetDeletedAt(time.Now()).
+		Save(ctx)
+	if err != nil {
+		return errx.New(errx.CodeInternalError, err)
+	}
+	if n == 0 {
+		return errx.New(errx.CodeNotFound, fmt.Errorf("user %d not found", id))
+	}
+	return nil
+}
+
 // GetByEmail retrieves a user by email.
 //
 // Only non-soft-deleted users are returned.
@@ -89,6 +241,108 @@ func (r *userRepo) GetByEmail(ctx context.Context, email string) (*entity.User,
 	return mapper.ToUser(u), nil
 }
 
+// GetByID retrieves a user by ID.
+//
+// Only non-soft-deleted users are returned.
+// Returns ErrNotFound wrapped in domain error if no match exists.
+func (r *userRepo) GetByID(ctx context.Context, id uint) (*entity.User, error) {
+	u, err := r.baseQuery(ctx).
+		Where(user.IDEQ(id)).
+		Only(ctx)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return nil, errx.New(errx.CodeNotFound, err)
+		}
+		return nil, errx.New(errx.CodeInternalError, err)
+	}
+
+	return mapper.ToUser(u), nil
+}
+
+// GetByUsername retrieves a user by username.
+//
+// Only non-soft-deleted users are returned.
+func (r *userRepo) GetByUsername(ctx context.Context, username string) (*entity.User, error) {
+	u, err := r.baseQuery(ctx).
+		Where(user.UsernameEQ(username)).
+		Only(ctx)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return nil, errx.New(errx.CodeNotFound, err)
+		}
+		return nil, errx.New(errx.CodeInternalError, err)
+	}
+
+	return mapper.ToUser(u), nil
+}
+
+// ListByRole returns all users with the given role.
+func (r *userRepo) ListByRole(ctx context.Context, role entity.UserRole) ([]*entity.User, error) {
+	us, err := r.baseQuery(ctx).
+		Where(user.RoleEQ(role)).
+		All(ctx)
+	if err != nil {
+		return nil, errx.New(errx.CodeInternalError, err)
+	}
+
+	res := make([]*entity.User, len(us))
+	for i, u := range us {
+		res[i] = mapper.ToUser(u)
+	}
+	return res, nil
+}
+
+// List returns the users matching the filter, newest first.
+func (r *userRepo) List(ctx context.Context, filter *entity.UserFilter, page, pageSize int) ([]*entity.User, error) {
+	page, pageSize = normalizedPage(page, pageSize)
+
+	us, err := r.filteredQuery(ctx, filter).
+		Order(user.ByCreatedAt(sql.OrderDesc()), user.ByID(sql.OrderDesc())).
+		Offset((page - 1) * pageSize).
+		Limit(pageSize).
+		All(ctx)
+	if err != nil {
+		return nil, errx.New(errx.CodeInternalError, err)
+	}
+
+	res := make([]*entity.User, len(us))
+	for i, u := range us {
+		res[i] = mapper.ToUser(u)
+	}
+	return res, nil
+}
+
+// Count returns the number of users matching the filter.
+func (r *userRepo) Count(ctx context.Context, filter *entity.UserFilter) (int, error) {
+	n, err := r.filteredQuery(ctx, filter).Count(ctx)
+	if err != nil {
+		return 0, errx.New(errx.CodeInternalError, err)
+	}
+	return n, nil
+}
+
+// filteredQuery applies a user filter. The keyword matches email or username.
+func (r *userRepo) filteredQuery(ctx context.Context, filter *entity.UserFilter) *ent.UserQuery {
+	query := r.baseQuery(ctx)
+	if filter == nil {
+		return query
+	}
+
+	if filter.Role != nil {
+		query = query.Where(user.RoleEQ(*filter.Role))
+	}
+	if filter.Status != nil {
+		query = query.Where(user.StatusEQ(*filter.Status))
+	}
+	if filter.Keyword != nil {
+		query = query.Where(user.Or(
+			user.EmailContainsFold(*filter.Keyword),
+			user.UsernameContainsFold(*filter.Keyword),
+		))
+	}
+	return query
+}
+
 // ExistsByID checks whether a user exists by ID.
 //
 // Soft-deleted users are excluded.
@@ -134,9 +388,22 @@ func (r *userRepo) ExistsByEmail(ctx context.Context, email string) (bool, error
 	return exists, nil
 }
 
+// ExistsByEmailFold checks whether a user exists by email, ignoring case.
+//
+// Soft-deleted users are excluded.
+func (r *userRepo) ExistsByEmailFold(ctx context.Context, email string) (bool, error) {
+	exists, err := r.baseQuery(ctx).
+		Where(user.EmailEqualFold(email)).
+		Exist(ctx)
+	if err != nil {
+		return false, errx.New(errx.CodeInternalError, err)
+	}
+	return exists, nil
+}
+
 // GetAuthByEmail returns authentication projection for a user identified by email.
 //
-// Only minimal fields required for authentication are selected (ID, Password, Role).
+// Only minimal fields required for authentication are selected (ID, Password, Role, Status, TOTP flag).
 // Soft-deleted users are excluded.
 func (r *userRepo) GetAuthByEmail(ctx context.Context, email string) (*entity.UserAuth, error) {
 	u, err := r.baseQuery(ctx).
@@ -145,6 +412,8 @@ func (r *userRepo) GetAuthByEmail(ctx context.Context, email string) (*entity.Us
 			user.FieldID,
 			user.FieldPassword,
 			user.FieldRole,
+			user.FieldStatus,
+			user.FieldTotpEnabled,
 		).
 		Only(ctx)
 	if err != nil {
@@ -155,9 +424,11 @@ func (r *userRepo) GetAuthByEmail(ctx context.Context, email string) (*entity.Us
 	}
 
 	return &entity.UserAuth{
-		ID:       u.ID,
-		Password: u.Password,
-		Role:     u.Role,
+		ID:               u.ID,
+		Password:         u.Password,
+		Role:             u.Role,
+		Status:           u.Status,
+		TwoFactorEnabled: u.TotpEnabled,
 	}, nil
 }
 
@@ -172,6 +443,8 @@ func (r *userRepo) GetAuthByID(ctx context.Context, id uint) (*entity.UserAuth,
 			user.FieldID,
 			user.FieldPassword,
 			user.FieldRole,
+			user.FieldStatus,
+			user.FieldTotpEnabled,
 		).
 		Only(ctx)
 	if err != nil {
@@ -182,8 +455,110 @@ func (r *userRepo) GetAuthByID(ctx context.Context, id uint) (*entity.UserAuth,
 	}
 
 	return &entity.UserAuth{
-		ID:       u.ID,
-		Password: u.Password,
-		Role:     u.Role,
+		ID:               u.ID,
+		Password:         u.Password,
+		Role:             u.Role,
+		Status:           u.Status,
+		TwoFactorEnabled: u.TotpEnabled,
 	}, nil
 }
+
+// GetTwoFactor returns the TOTP settings of a user.
+func (r *userRepo) GetTwoFactor(ctx context.Context, id uint) (*entity.UserTwoFactor, error) {
+	u, err := r.baseQuery(ctx).
+		Where(user.IDEQ(id)).
+		Select(
+			user.FieldID,
+			user.FieldTotpEnabled,
+			user.FieldTotpSecret,
+			user.FieldTotpRecoveryCodes,
+		).
+		Only(ctx)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return nil, errx.New(errx.CodeNotFound, err)
+		}
+		return nil, errx.New(errx.CodeInternalError, err)
+	}
+
+	tf := &entity.UserTwoFactor{
+		Enabled:       u.TotpEnabled,
+		RecoveryCodes: u.TotpRecoveryCodes,
+	}
+	if u.TotpSecret != nil {
+		tf.Secret = *u.TotpSecret
+	}
+	return tf, nil
+}
+
+// EnableTwoFactor stores a confirmed TOTP secret and the hashed recovery codes.
+func (r *userRepo) EnableTwoFactor(ctx context.Context, id uint, secret string, recoveryCodes []string) error {
+	return r.updateTwoFactor(ctx, id, func(u *ent.UserUpdate) {
+		u.SetTotpEnabled(true).
+			SetTotpSecret(secret).
+			SetTotpRecoveryCodes(recoveryCodes)
+	})
+}
+
+// UpdateRecoveryCodes replaces the hashed recovery codes of a user.
+func (r *userRepo) UpdateRecoveryCodes(ctx context.Context, id uint, recoveryCodes []string) error {
+	return r.updateTwoFactor(ctx, id, func(u *ent.UserUpdate) {
+		u.SetTotpRecoveryCodes(recoveryCodes)
+	})
+}
+
+// UseRecoveryCode removes a hashed recovery code of a user and reports
+// whether the user had it.
+//
+// The check and the removal are one statement, so two requests using the
+// same code cannot both succeed, and using one code never writes back
+// codes another request removed meanwhile.
+func (r *userRepo) UseRecoveryCode(ctx context.Context, id uint, hash string) (bool, error) {
+	n, err := r.ds.Client(ctx).User.
+		Update().
+		Where(
+			user.IDEQ(id),
+			user.DeletedAtIsNil(),
+			user.TotpEnabled(true),
+			func(s *sql.Selector) {
+				s.Where(sqljson.ValueContains(s.C(user.FieldTotpRecoveryCodes), hash))
+			},
+		).
+		Modify(func(u *sql.UpdateBuilder) {
+			// jsonb - text removes the matching elements from the array.
+			u.Set(user.FieldTotpRecoveryCodes, sql.ExprFunc(func(b *sql.Builder) {
+				b.Ident(user.FieldTotpRecoveryCodes).WriteString(" - ").Arg(hash).WriteString("::text")
+			}))
+		}).
+		Save(ctx)
+	if err != nil {
+		return false, errx.New(errx.CodeInternalError, err)
+	}
+	return n > 0, nil
+}
+
+// DisableTwoFactor turns TOTP off and forgets the secret and recovery codes.
+func (r *userRepo) DisableTwoFactor(ctx context.Context, id uint) error {
+	return r.updateTwoFactor(ctx, id, func(u *ent.UserUpdate) {
+		u.SetTotpEnabled(false).
+			ClearTotpSecret().
+			ClearTotpRecoveryCodes()
+	})
+}
+
+// updateTwoFactor applies a TOTP update to a non-deleted user.
+func (r *userRepo) updateTwoFactor(ctx context.Context, id uint, apply func(u *ent.UserUpdate)) error {
+	u := r.ds.Client(ctx).User.
+		Update().
+		Where(user.IDEQ(id), user.DeletedAtIsNil())
+	apply(u)
+
+	n, err := u.Save(ctx)
+	if err != nil {
+		return errx.New(errx.CodeInternalError, err)
+	}
+	if n == 0 {
+		return errx.New(errx.CodeNotFound, fmt.Errorf("user %d not found", id))
+	}
+	return nil
+}
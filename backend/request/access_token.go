@@ -0,0 +1,7 @@
+package request
+
+type CreateAccessTokenReq struct {
+	Name          string   `json:"name" validate:"required,max=64"`
+	Scopes        []string `json:"scopes" validate:"required,min=1,max=32,dive,max=32"`
+	ExpiresInDays int      `json:"expiresInDays" validate:"required,min=1,max=365"`
+}
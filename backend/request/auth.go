@@ -16,3 +16,32 @@ type LoginReq struct {
 	Email    string `json:"email" validate:"required,email"`
 	Password string `json:"password" validate:"required,min=8,max=64"`
 }
+
+type ForgotPasswordReq struct {
+	Email string `json:"email" validate:"required,email"`
+}
+
+type ResetPasswordReq struct {
+	Email           string `json:"email" validate:"required,email"`
+	Captcha         string `json:"captcha" validate:"required"`
+	Password        string `json:"password" validate:"required,min=8,max=64"`
+	PasswordConfirm string `json:"passwordConfirm" validate:"required,min=8,max=64,eqfield=Password"`
+}
+
+type ChangeEmailCaptchaReq struct {
+	Email string `json:"email" validate:"required,email,max=100"`
+}
+
+type ChangeEmailReq struct {
+	Email   string `json:"email" validate:"required,email,max=100"`
+	Captcha string `json:"captcha" validate:"required"`
+}
+
+type TwoFactorLoginReq struct {
+	ChallengeToken string `json:"challengeToken" validate:"required,max=64"`
+	Code           string `json:"code" validate:"required,max=32"`
+}
+
+type TwoFactorCodeReq struct {
+	Code string `json:"code" validate:"required,max=32"`
+}
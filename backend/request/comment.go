@@ -0,0 +1,56 @@
+package request
+
+import "blog-server/entity"
+
+// CommentListReq is the request query for listing comment threads of a post.
+type CommentListReq struct {
+	Cursor uint `json:"cursor" query:"cursor"`
+	Limit  int  `json:"limit" query:"limit" validate:"omitempty,min=1,max=50"`
+}
+
+// CreateCommentReq is the request body for posting a comment.
+// Author fields are ignored for logged-in users and required for guests.
+type CreateCommentReq struct {
+	ParentID      *uint   `json:"parentId"`
+	Content       string  `json:"content" validate:"required,max=2000"`
+	AuthorName    *string `json:"authorName" validate:"omitempty,max=50"`
+	AuthorEmail   *string `json:"authorEmail" validate:"omitempty,email,max=100"`
+	AuthorWebsite *string `json:"authorWebsite" validate:"omitempty,url,max=255"`
+	// NotifyOnReply asks for an email when someone replies to this comment.
+	NotifyOnReply bool `json:"notifyOnReply"`
+
+	// Honeypot is bound to a form field hidden from humans and must stay empty.
+	Honeypot string `json:"hp"`
+	// RenderedAt is the Unix time in milliseconds when the comment form was rendered.
+	RenderedAt *int64 `json:"renderedAt"`
+}
+
+// AdminCommentListReq is the request query for the comment moderation queue.
+type AdminCommentListReq struct {
+	Page     int                   `json:"page" query:"page" validate:"omitempty,min=1"`
+	PageSize int                   `json:"pageSize" query:"pageSize" validate:"omitempty,min=1,max=100"`
+	Status   *entity.CommentStatus `json:"status" query:"status" validate:"omitempty,oneof=pending approved rejected spam deleted"`
+	PostID   *uint                 `json:"postId" query:"postId"`
+	Keyword  *string               `json:"keyword" query:"keyword" validate:"omitempty,max=100"`
+}
+
+// UpdateCommentReq is the request body for editing a comment.
+type UpdateCommentReq struct {
+	Content string `json:"content" validate:"required,max=2000"`
+}
+
+// BatchCommentReq is the request body for applying a moderation action to many comments.
+type BatchCommentReq struct {
+	IDs    []uint `json:"ids" validate:"required,min=1,max=100"`
+	Action string `json:"action" validate:"required,oneof=approve reject spam delete"`
+}
+
+// SubscribeReq is the request query of the link confirming reply notifications.
+type SubscribeReq struct {
+	Token string `json:"token" query:"token" validate:"required,max=512"`
+}
+
+// UnsubscribeReq is the request query of the unsubscribe link in notification emails.
+type UnsubscribeReq struct {
+	Token string `json:"token" query:"token" validate:"required,max=512"`
+}
@@ -0,0 +1,15 @@
+package request
+
+type OAuthStartReq struct {
+	Redirect string `query:"redirect" validate:"omitempty,max=512"`
+}
+
+type OAuthLinkReq struct {
+	Redirect string `json:"redirect" validate:"omitempty,max=512"`
+}
+
+type OAuthCallbackReq struct {
+	Code  string `query:"code" validate:"required_without=Error,max=2048"`
+	State string `query:"state" validate:"required,max=64"`
+	Error string `query:"error" validate:"max=128"`
+}
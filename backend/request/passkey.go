@@ -0,0 +1,13 @@
+package request
+
+import "blog-server/pkg/webauthn"
+
+type PasskeyRegisterReq struct {
+	Name       string                         `json:"name" validate:"max=64"`
+	Credential *webauthn.RegistrationResponse `json:"credential" validate:"required"`
+}
+
+type PasskeyLoginReq struct {
+	ChallengeID string                      `json:"challengeId" validate:"required,max=64"`
+	Credential  *webauthn.AssertionResponse `json:"credential" validate:"required"`
+}
@@ -1,16 +1,24 @@
 package request
 
-import "blog-server/entity"
+import (
+	"time"
+
+	"blog-server/entity"
+)
 
 // CreatePostReq is the request body for creating a post.
 // UserID is intentionally omitted — it is extracted from the JWT context
 // by the handler, never accepted from the client.
 type CreatePostReq struct {
 	Title   string            `json:"title" validate:"required,min=3,max=100"`
+	Slug    *string           `json:"slug" validate:"omitempty,max=255"`
 	Summary *string           `json:"summary"`
 	Cover   *string           `json:"cover"`
 	Content string            `json:"content" validate:"required"`
-	Status  entity.PostStatus `json:"status"`
+	Status  entity.PostStatus `json:"status" validate:"omitempty,oneof=draft published scheduled archived"`
+
+	// PublishAt is when a scheduled post is published.
+	PublishAt *time.Time `json:"publishAt"`
 
 	CategoryIDs []uint `json:"categoryIDs"`
 	Tags        []uint `json:"tags"`
@@ -19,10 +27,15 @@ type CreatePostReq struct {
 // UpdatePostReq is the request body for updating a post.
 type UpdatePostReq struct {
 	Title   *string            `json:"title" validate:"omitempty,min=3,max=100"`
+	Slug    *string            `json:"slug" validate:"omitempty,max=255"`
 	Summary *string            `json:"summary" validate:"omitempty"`
 	Cover   *string            `json:"cover" validate:"omitempty"`
 	Content *string            `json:"content" validate:"omitempty"`
-	Status  *entity.PostStatus `json:"status" validate:"omitempty,oneof=draft published archived"`
+	Status  *entity.PostStatus `json:"status" validate:"omitempty,oneof=draft published scheduled archived"`
+
+	// PublishAt is when a scheduled post is published. It is required to
+	// schedule or reschedule a post.
+	PublishAt *time.Time `json:"publishAt"`
 
 	CategoryIDs *[]uint `json:"categoryIDs"`
 	Tags        *[]uint `json:"tags"`
@@ -34,10 +47,19 @@ type PostPageReq struct {
 	PageSize int `json:"pageSize" query:"pageSize" validate:"omitempty,min=1,max=100"`
 }
 
+// PostSearchReq is the request query for searching published posts.
+type PostSearchReq struct {
+	Q          string `json:"q" query:"q" validate:"required,max=100"`
+	TagID      *uint  `json:"tagID" query:"tagID" validate:"omitempty,min=1"`
+	CategoryID *uint  `json:"categoryID" query:"categoryID" validate:"omitempty,min=1"`
+	Page       int    `json:"page" query:"page" validate:"omitempty,min=1"`
+	PageSize   int    `json:"pageSize" query:"pageSize" validate:"omitempty,min=1,max=50"`
+}
+
 // AdminPostListReq is the request query for admin post list.
 type AdminPostListReq struct {
 	Page     int                `json:"page" query:"page" validate:"omitempty,min=1"`
 	PageSize int                `json:"pageSize" query:"pageSize" validate:"omitempty,min=1,max=100"`
-	Status   *entity.PostStatus `json:"status" query:"status" validate:"omitempty,oneof=draft published archived"`
+	Status   *entity.PostStatus `json:"status" query:"status" validate:"omitempty,oneof=draft published scheduled archived"`
 	Keyword  *string            `json:"keyword" query:"keyword" validate:"omitempty,max=100"`
 }
@@ -0,0 +1,7 @@
+package request
+
+// PostRevisionDiffReq is the request query for diffing two revisions of a post.
+type PostRevisionDiffReq struct {
+	From uint `json:"from" query:"from" validate:"required,min=1"`
+	To   uint `json:"to" query:"to" validate:"required,min=1"`
+}
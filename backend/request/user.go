@@ -0,0 +1,38 @@
+package request
+
+import "blog-server/entity"
+
+// UpdateProfileReq is the request body for updating the current user's profile.
+// Omitted fields are kept; an empty avatar or bio clears it.
+type UpdateProfileReq struct {
+	Username *string `json:"username" validate:"omitempty,max=32"`
+	Avatar   *string `json:"avatar" validate:"omitempty,max=255"`
+	Bio      *string `json:"bio" validate:"omitempty,max=500"`
+}
+
+// ChangePasswordReq is the request body for changing the current user's password.
+type ChangePasswordReq struct {
+	CurrentPassword string `json:"currentPassword" validate:"required,max=64"`
+	Password        string `json:"password" validate:"required,min=8,max=64"`
+	PasswordConfirm string `json:"passwordConfirm" validate:"required,min=8,max=64,eqfield=Password"`
+}
+
+// AdminUserListReq is the request query for the admin user list.
+type AdminUserListReq struct {
+	Page     int                `json:"page" query:"page" validate:"omitempty,min=1"`
+	PageSize int                `json:"pageSize" query:"pageSize" validate:"omitempty,min=1,max=100"`
+	Role     *entity.UserRole   `json:"role" query:"role" validate:"omitempty,max=32"`
+	Status   *entity.UserStatus `json:"status" query:"status" validate:"omitempty,oneof=active disabled banned"`
+	Keyword  *string            `json:"keyword" query:"keyword" validate:"omitempty,max=100"`
+}
+
+// UpdateUserRoleReq is the request body for changing the role of a user.
+// The role is checked against entity.UserRole by the service.
+type UpdateUserRoleReq struct {
+	Role entity.UserRole `json:"role" validate:"required,max=32"`
+}
+
+// UpdateUserStatusReq is the request body for enabling, disabling or banning a user.
+type UpdateUserStatusReq struct {
+	Status entity.UserStatus `json:"status" validate:"required,oneof=active disabled banned"`
+}
@@ -0,0 +1,22 @@
+package response
+
+import "time"
+
+// AccessTokenRes represents a personal access token of the current user.
+type AccessTokenRes struct {
+	ID         uint       `json:"id"`
+	Name       string     `json:"name"`
+	Prefix     string     `json:"prefix"`
+	Scopes     []string   `json:"scopes"`
+	TwoFactor  bool       `json:"twoFactor"`
+	ExpiresAt  time.Time  `json:"expiresAt"`
+	LastUsedAt *time.Time `json:"lastUsedAt"`
+	RevokedAt  *time.Time `json:"revokedAt"`
+	CreatedAt  time.Time  `json:"createdAt"`
+}
+
+// CreatedAccessTokenRes carries a new token and its value, shown only once.
+type CreatedAccessTokenRes struct {
+	*AccessTokenRes
+	Token string `json:"token"`
+}
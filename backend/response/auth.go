@@ -10,6 +10,10 @@ type LoginRes struct {
 	Avatar       *string `json:"avatar"`
 	Username     string  `json:"username"`
 	Role         string  `json:"role"`
+
+	// Set instead of the fields above when the login needs a second factor.
+	TwoFactorRequired bool   `json:"twoFactorRequired,omitempty"`
+	ChallengeToken    string `json:"challengeToken,omitempty"`
 }
 
 type RefreshRes struct {
@@ -0,0 +1,42 @@
+package response
+
+import "time"
+
+// CommentAuthorRes is the public view of a comment author.
+// Email and network details are never exposed publicly.
+type CommentAuthorRes struct {
+	Name       string  `json:"name"`
+	Website    *string `json:"website"`
+	Avatar     *string `json:"avatar"`
+	Registered bool    `json:"registered"`
+}
+
+// CommentRes represents a comment with its nested replies.
+type CommentRes struct {
+	ID        uint             `json:"id"`
+	ParentID  *uint            `json:"parentId"`
+	Author    CommentAuthorRes `json:"author"`
+	Content   string           `json:"content"`
+	Status    string           `json:"status"`
+	CreatedAt time.Time        `json:"createdAt"`
+	Replies   []CommentRes     `json:"replies"`
+}
+
+// AdminCommentRes represents a comment in the admin moderation queue.
+type AdminCommentRes struct {
+	ID            uint      `json:"id"`
+	PostID        uint      `json:"postId"`
+	PostTitle     string    `json:"postTitle"`
+	ParentID      *uint     `json:"parentId"`
+	UserID        *uint     `json:"userId"`
+	AuthorName    string    `json:"authorName"`
+	AuthorEmail   *string   `json:"authorEmail"`
+	AuthorWebsite *string   `json:"authorWebsite"`
+	Content       string    `json:"content"`
+	Status        string    `json:"status"`
+	SpamReason    *string   `json:"spamReason"`
+	IP            string    `json:"ip"`
+	UserAgent     string    `json:"userAgent"`
+	CreatedAt     time.Time `json:"createdAt"`
+	UpdatedAt     time.Time `json:"updatedAt"`
+}
@@ -0,0 +1,18 @@
+package response
+
+import "time"
+
+// MailDeadLetterRes represents an email that exhausted its delivery attempts.
+//
+// The body is left out, as it may carry codes and links meant only for the
+// recipient.
+type MailDeadLetterRes struct {
+	ID        string     `json:"id"`
+	To        string     `json:"to"`
+	Subject   string     `json:"subject"`
+	Attempts  int        `json:"attempts"`
+	LastError string     `json:"lastError"`
+	CreatedAt time.Time  `json:"createdAt"`
+	FailedAt  time.Time  `json:"failedAt"`
+	ExpiresAt *time.Time `json:"expiresAt"`
+}
@@ -0,0 +1,22 @@
+package response
+
+import "time"
+
+// OAuthProvidersRes lists the external login providers.
+type OAuthProvidersRes struct {
+	Providers []string `json:"providers"`
+}
+
+// OAuthLinkRes carries the provider URL to send the browser to for linking.
+type OAuthLinkRes struct {
+	URL string `json:"url"`
+}
+
+// IdentityRes represents an external account linked to the current user.
+type IdentityRes struct {
+	ID          uint       `json:"id"`
+	Provider    string     `json:"provider"`
+	Email       string     `json:"email"`
+	CreatedAt   time.Time  `json:"createdAt"`
+	LastLoginAt *time.Time `json:"lastLoginAt"`
+}
@@ -0,0 +1,23 @@
+package response
+
+import (
+	"time"
+
+	"blog-server/pkg/webauthn"
+)
+
+// PasskeyRes represents a passkey of the current user.
+type PasskeyRes struct {
+	ID         uint       `json:"id"`
+	Name       string     `json:"name"`
+	Transports []string   `json:"transports"`
+	CreatedAt  time.Time  `json:"createdAt"`
+	LastUsedAt *time.Time `json:"lastUsedAt"`
+}
+
+// PasskeyLoginOptionsRes carries the options for navigator.credentials.get()
+// and the ID of the challenge they contain.
+type PasskeyLoginOptionsRes struct {
+	ChallengeID string                   `json:"challengeId"`
+	Options     *webauthn.RequestOptions `json:"options"`
+}
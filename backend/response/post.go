@@ -6,6 +6,7 @@ import "time"
 type PostListRes struct {
 	ID              uint              `json:"id"`
 	Title           string            `json:"title"`
+	Slug            string            `json:"slug"`
 	Cover           *string           `json:"cover"`
 	Summary         *string           `json:"summary"`
 	ReadTimeMinutes uint              `json:"readTimeMinutes"`
@@ -17,10 +18,27 @@ type PostListRes struct {
 	Categories      []PostCategoryRes `json:"categories"`
 }
 
+// PostSearchRes is a post matching a search. HighlightedTitle and Snippet
+// are HTML with the matches wrapped in <mark>.
+type PostSearchRes struct {
+	ID               uint              `json:"id"`
+	Title            string            `json:"title"`
+	HighlightedTitle string            `json:"highlightedTitle"`
+	Snippet          string            `json:"snippet"`
+	Slug             string            `json:"slug"`
+	Cover            *string           `json:"cover"`
+	ReadTimeMinutes  uint              `json:"readTimeMinutes"`
+	PublishedAt      *time.Time        `json:"publishedAt"`
+	Author           string            `json:"author"`
+	Tags             []PostTagRes      `json:"tags"`
+	Categories       []PostCategoryRes `json:"categories"`
+}
+
 // PostRes represents the response for a public post.
 type PostRes struct {
 	ID              uint              `json:"id"`
 	Title           string            `json:"title"`
+	Slug            string            `json:"slug"`
 	Summary         *string           `json:"summary"`
 	Content         string            `json:"content"`
 	Cover           *string           `json:"cover"`
@@ -36,6 +54,7 @@ type PostRes struct {
 // PostMetaRes represents post metadata used for sitemap generation.
 type PostMetaRes struct {
 	ID        uint      `json:"id"`
+	Slug      string    `json:"slug"`
 	UpdatedAt time.Time `json:"updatedAt"`
 }
 
@@ -43,6 +62,7 @@ type PostMetaRes struct {
 type AdminPostListRes struct {
 	ID              uint              `json:"id"`
 	Title           string            `json:"title"`
+	Slug            string            `json:"slug"`
 	Cover           *string           `json:"cover"`
 	Summary         *string           `json:"summary"`
 	Status          string            `json:"status"`
@@ -60,6 +80,7 @@ type AdminPostListRes struct {
 type AdminPostRes struct {
 	ID              uint              `json:"id"`
 	Title           string            `json:"title"`
+	Slug            string            `json:"slug"`
 	Summary         *string           `json:"summary"`
 	Content         string            `json:"content"`
 	Cover           *string           `json:"cover"`
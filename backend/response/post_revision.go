@@ -0,0 +1,32 @@
+package response
+
+import "time"
+
+// PostRevisionRes is a revision of a post. Content is left out of lists.
+type PostRevisionRes struct {
+	Version      uint      `json:"version"`
+	User         string    `json:"user"`
+	Title        string    `json:"title"`
+	Summary      *string   `json:"summary"`
+	Content      string    `json:"content,omitempty"`
+	RestoredFrom *uint     `json:"restoredFrom"`
+	CreatedAt    time.Time `json:"createdAt"`
+}
+
+// PostRevisionDiffRes is the line-level diff of the content of two revisions.
+type PostRevisionDiffRes struct {
+	From    PostRevisionRes `json:"from"`
+	To      PostRevisionRes `json:"to"`
+	Added   int             `json:"added"`
+	Removed int             `json:"removed"`
+	Lines   []DiffLineRes   `json:"lines"`
+}
+
+// DiffLineRes is one line of a diff. Line numbers are 0 where the line does
+// not appear.
+type DiffLineRes struct {
+	Op      string `json:"op"`
+	Text    string `json:"text"`
+	OldLine int    `json:"oldLine"`
+	NewLine int    `json:"newLine"`
+}
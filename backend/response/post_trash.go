@@ -0,0 +1,21 @@
+package response
+
+import "time"
+
+// TrashPostRes is a post in the trash.
+type TrashPostRes struct {
+	ID          uint       `json:"id"`
+	Title       string     `json:"title"`
+	Slug        string     `json:"slug"`
+	Summary     *string    `json:"summary"`
+	User        string     `json:"user"`
+	Status      string     `json:"status"`
+	PublishedAt *time.Time `json:"publishedAt"`
+	CreatedAt   time.Time  `json:"createdAt"`
+	DeletedAt   *time.Time `json:"deletedAt"`
+}
+
+// EmptyTrashRes is the result of emptying the trash.
+type EmptyTrashRes struct {
+	Purged int `json:"purged"`
+}
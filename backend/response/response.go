@@ -23,6 +23,13 @@ type Page[T any] struct {
 	List  []T `json:"list"`
 }
 
+// CursorPage is a page of results addressed by the ID of the last item.
+// NextCursor is nil when there are no more results.
+type CursorPage[T any] struct {
+	List       []T   `json:"list"`
+	NextCursor *uint `json:"nextCursor"`
+}
+
 const CodeSuccess = 0
 
 func OK(c *echo.Context, data any) error {
@@ -0,0 +1,19 @@
+package response
+
+// TwoFactorStatusRes describes the two-factor setup of the current user.
+type TwoFactorStatusRes struct {
+	Enabled           bool `json:"enabled"`
+	Required          bool `json:"required"`
+	RecoveryCodesLeft int  `json:"recoveryCodesLeft"`
+}
+
+// TwoFactorEnrollmentRes carries a pending TOTP secret and its provisioning URI.
+type TwoFactorEnrollmentRes struct {
+	Secret string `json:"secret"`
+	URI    string `json:"uri"`
+}
+
+// RecoveryCodesRes carries freshly generated recovery codes, shown only once.
+type RecoveryCodesRes struct {
+	RecoveryCodes []string `json:"recoveryCodes"`
+}
@@ -0,0 +1,37 @@
+package response
+
+import "time"
+
+// ProfileRes is the profile of the logged-in user.
+type ProfileRes struct {
+	UUID      string    `json:"uuid"`
+	Username  string    `json:"username"`
+	Email     string    `json:"email"`
+	Avatar    *string   `json:"avatar"`
+	Bio       *string   `json:"bio"`
+	Role      string    `json:"role"`
+	CreatedAt time.Time `json:"createdAt"`
+}
+
+// AuthorRes is the public profile of a post author.
+type AuthorRes struct {
+	Username  string    `json:"username"`
+	Avatar    *string   `json:"avatar"`
+	Bio       *string   `json:"bio"`
+	PostCount int       `json:"postCount"`
+	CreatedAt time.Time `json:"createdAt"`
+}
+
+// AdminUserRes is a user as shown in the admin user management.
+type AdminUserRes struct {
+	ID               uint      `json:"id"`
+	UUID             string    `json:"uuid"`
+	Username         string    `json:"username"`
+	Email            string    `json:"email"`
+	Avatar           *string   `json:"avatar"`
+	Role             string    `json:"role"`
+	Status           string    `json:"status"`
+	TwoFactorEnabled bool      `json:"twoFactorEnabled"`
+	CreatedAt        time.Time `json:"createdAt"`
+	UpdatedAt        time.Time `json:"updatedAt"`
+}
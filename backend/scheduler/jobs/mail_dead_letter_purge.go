@@ -0,0 +1,30 @@
+package jobs
+
+import (
+	"context"
+	"time"
+
+	"blog-server/logger"
+	"blog-server/service"
+)
+
+const mailDeadLetterPurgeInterval = time.Hour
+
+func StartMailDeadLetterPurgeJob(ctx context.Context, svc service.MailService, log logger.Logger) {
+	wait := time.Duration(0)
+	for {
+		select {
+		case <-time.After(wait):
+			if err := svc.PurgeDeadLetters(ctx); err != nil {
+				log.Error("purge mail dead letters failed",
+					logger.String("module", "scheduler"),
+					logger.String("job", "mail_dead_letter_purge"),
+					logger.Err(err),
+				)
+			}
+		case <-ctx.Done():
+			return
+		}
+		wait = mailDeadLetterPurgeInterval
+	}
+}
@@ -0,0 +1,28 @@
+package jobs
+
+import (
+	"context"
+	"time"
+
+	"blog-server/logger"
+	"blog-server/service"
+)
+
+const mailQueuePollInterval = 5 * time.Second
+
+func StartMailQueueJob(ctx context.Context, svc service.MailService, log logger.Logger) {
+	for {
+		select {
+		case <-time.After(mailQueuePollInterval):
+			if err := svc.ProcessQueue(ctx); err != nil {
+				log.Error("process mail queue failed",
+					logger.String("module", "scheduler"),
+					logger.String("job", "mail_queue"),
+					logger.Err(err),
+				)
+			}
+		case <-ctx.Done():
+			return
+		}
+	}
+}
@@ -0,0 +1,30 @@
+package jobs
+
+import (
+	"context"
+	"time"
+
+	"blog-server/logger"
+	"blog-server/service"
+)
+
+const revisionPruneInterval = 24 * time.Hour
+
+func StartRevisionPruneJob(ctx context.Context, svc service.PostRevisionService, log logger.Logger) {
+	wait := time.Duration(0)
+	for {
+		select {
+		case <-time.After(wait):
+			if err := svc.PruneRevisions(ctx); err != nil {
+				log.Error("prune post revisions failed",
+					logger.String("module", "scheduler"),
+					logger.String("job", "revision_prune"),
+					logger.Err(err),
+				)
+			}
+		case <-ctx.Done():
+			return
+		}
+		wait = revisionPruneInterval
+	}
+}
@@ -0,0 +1,32 @@
+package jobs
+
+import (
+	"context"
+	"time"
+
+	"blog-server/logger"
+	"blog-server/service"
+)
+
+// scheduledPublishInterval is how late a scheduled post is published at most.
+const scheduledPublishInterval = 30 * time.Second
+
+func StartScheduledPublishJob(ctx context.Context, svc service.PostService, log logger.Logger) {
+	// Publish posts that fell due while the server was down right away.
+	wait := time.Duration(0)
+	for {
+		select {
+		case <-time.After(wait):
+			if err := svc.PublishDuePosts(ctx); err != nil {
+				log.Error("publish scheduled posts failed",
+					logger.String("module", "scheduler"),
+					logger.String("job", "scheduled_publish"),
+					logger.Err(err),
+				)
+			}
+		case <-ctx.Done():
+			return
+		}
+		wait = scheduledPublishInterval
+	}
+}
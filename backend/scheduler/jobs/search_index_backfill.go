@@ -0,0 +1,20 @@
+package jobs
+
+import (
+	"context"
+
+	"blog-server/logger"
+	"blog-server/service"
+)
+
+// StartSearchIndexBackfillJob indexes posts created before search existed,
+// once on startup.
+func StartSearchIndexBackfillJob(ctx context.Context, svc service.PostService, log logger.Logger) {
+	if err := svc.BackfillSearchIndex(ctx); err != nil {
+		log.Error("backfill post search index failed",
+			logger.String("module", "scheduler"),
+			logger.String("job", "search_index_backfill"),
+			logger.Err(err),
+		)
+	}
+}
@@ -0,0 +1,28 @@
+package jobs
+
+import (
+	"context"
+	"time"
+
+	"blog-server/logger"
+	"blog-server/service"
+)
+
+const signingKeyCheckInterval = time.Hour
+
+func StartSigningKeyRotationJob(ctx context.Context, svc service.SigningKeyService, log logger.Logger) {
+	for {
+		select {
+		case <-time.After(signingKeyCheckInterval):
+			if err := svc.RotateIfDue(ctx); err != nil {
+				log.Error("rotate signing key failed",
+					logger.String("module", "scheduler"),
+					logger.String("job", "signing_key_rotation"),
+					logger.Err(err),
+				)
+			}
+		case <-ctx.Done():
+			return
+		}
+	}
+}
@@ -0,0 +1,20 @@
+package jobs
+
+import (
+	"context"
+
+	"blog-server/logger"
+	"blog-server/service"
+)
+
+// StartSlugBackfillJob gives posts created before slugs existed a slug, once
+// on startup.
+func StartSlugBackfillJob(ctx context.Context, svc service.PostService, log logger.Logger) {
+	if err := svc.BackfillSlugs(ctx); err != nil {
+		log.Error("backfill post slugs failed",
+			logger.String("module", "scheduler"),
+			logger.String("job", "slug_backfill"),
+			logger.Err(err),
+		)
+	}
+}
@@ -0,0 +1,30 @@
+package jobs
+
+import (
+	"context"
+	"time"
+
+	"blog-server/logger"
+	"blog-server/service"
+)
+
+const trashPurgeInterval = 24 * time.Hour
+
+func StartTrashPurgeJob(ctx context.Context, svc service.PostTrashService, log logger.Logger) {
+	wait := time.Duration(0)
+	for {
+		select {
+		case <-time.After(wait):
+			if err := svc.PurgeExpired(ctx); err != nil {
+				log.Error("purge expired posts failed",
+					logger.String("module", "scheduler"),
+					logger.String("job", "trash_purge"),
+					logger.Err(err),
+				)
+			}
+		case <-ctx.Done():
+			return
+		}
+		wait = trashPurgeInterval
+	}
+}
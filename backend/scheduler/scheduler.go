@@ -10,16 +10,36 @@ import (
 )
 
 type Scheduler struct {
-	postService service.PostService
-	linkService service.LinkService
-	log         logger.Logger
+	postService     service.PostService
+	revisionService service.PostRevisionService
+	trashService    service.PostTrashService
+	linkService     service.LinkService
+	mailService     service.MailService
+	keyService      service.SigningKeyService
+	log             logger.Logger
 }
 
-func NewScheduler(log logger.Logger, postService service.PostService, linkService service.LinkService) *Scheduler {
-	return &Scheduler{postService, linkService, log}
+func NewScheduler(
+	log logger.Logger,
+	postService service.PostService,
+	revisionService service.PostRevisionService,
+	trashService service.PostTrashService,
+	linkService service.LinkService,
+	mailService service.MailService,
+	keyService service.SigningKeyService,
+) *Scheduler {
+	return &Scheduler{postService, revisionService, trashService, linkService, mailService, keyService, log}
 }
 
 func (s *Scheduler) Start(ctx context.Context) {
 	go jobs.StartViewFlushJob(ctx, s.postService, s.log)
+	go jobs.StartSlugBackfillJob(ctx, s.postService, s.log)
+	go jobs.StartSearchIndexBackfillJob(ctx, s.postService, s.log)
+	go jobs.StartScheduledPublishJob(ctx, s.postService, s.log)
+	go jobs.StartRevisionPruneJob(ctx, s.revisionService, s.log)
+	go jobs.StartTrashPurgeJob(ctx, s.trashService, s.log)
 	go jobs.StartCheckLinkStatusJob(ctx, s.linkService, s.log)
+	go jobs.StartMailQueueJob(ctx, s.mailService, s.log)
+	go jobs.StartMailDeadLetterPurgeJob(ctx, s.mailService, s.log)
+	go jobs.StartSigningKeyRotationJob(ctx, s.keyService, s.log)
 }
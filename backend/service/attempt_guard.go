@@ -0,0 +1,242 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"blog-server/cache"
+	"blog-server/config"
+	"blog-server/logger"
+	"blog-server/pkg/errx"
+)
+
+// AttemptAction names a kind of attempt with its own counters and policy.
+type AttemptAction string
+
+const (
+	// LoginAttempt is a failed password login.
+	LoginAttempt AttemptAction = "login"
+	// CaptchaAttempt is a request for a captcha email.
+	CaptchaAttempt AttemptAction = "captcha"
+	// CaptchaVerifyAttempt is a wrong captcha entered to redeem an emailed code.
+	CaptchaVerifyAttempt AttemptAction = "captcha_verify"
+)
+
+// attemptDimension is what attempts are counted by.
+type attemptDimension string
+
+const (
+	dimensionIP      attemptDimension = "ip"
+	dimensionEmail   attemptDimension = "email"
+	dimensionIPEmail attemptDimension = "ip_email"
+)
+
+// AttemptGuard throttles repeated attempts such as password guesses, captcha
+// emails and captcha guesses.
+//
+// Attempts are counted in sliding windows per IP, per email and per IP and
+// email together. Repeated attempts by the same IP and email are delayed
+// progressively, and a counter reaching its limit locks its IP, email or
+// pair out for a while. Blocked callers get a CodeTooManyRequests error that
+// carries how long to wait.
+type AttemptGuard interface {
+	// Check fails while the IP or email is locked out or has to wait.
+	Check(ctx context.Context, action AttemptAction, ip, email string) error
+	// Record counts an attempt and applies delays and lockouts.
+	Record(ctx context.Context, action AttemptAction, ip, email string) error
+	// Reset forgets the attempts of the email, as after a successful login.
+	Reset(ctx context.Context, action AttemptAction, ip, email string) error
+}
+
+// attemptGuard implements AttemptGuard on top of Redis.
+type attemptGuard struct {
+	rc       cache.CacheClient
+	policies map[AttemptAction]config.AttemptPolicy
+	log      logger.Logger
+}
+
+// NewAttemptGuard creates and returns a new AttemptGuard instance.
+func NewAttemptGuard(cfg *config.Config, rc cache.CacheClient, log logger.Logger) AttemptGuard {
+	return &attemptGuard{
+		rc: rc,
+		policies: map[AttemptAction]config.AttemptPolicy{
+			LoginAttempt:         cfg.Auth.Throttle.Login,
+			CaptchaAttempt:       cfg.Auth.Throttle.Captcha,
+			CaptchaVerifyAttempt: cfg.Auth.Throttle.CaptchaVerify,
+		},
+		log: log.With(logger.String("module", "attempt_guard")),
+	}
+}
+
+// Check implements [AttemptGuard].
+func (g *attemptGuard) Check(ctx context.Context, action AttemptAction, ip, email string) error {
+	email = normalizeEmail(email)
+	now := time.Now()
+
+	var wait time.Duration
+	blocks := []string{attemptDelayKey(action, ip, email)}
+	for _, dim := range []attemptDimension{dimensionIP, dimensionEmail, dimensionIPEmail} {
+		if id := attemptID(dim, ip, email); id != "" {
+			blocks = append(blocks, attemptLockKey(action, dim, id))
+		}
+	}
+	for _, key := range blocks {
+		val, err := g.rc.Get(ctx, key)
+		if err != nil {
+			if errx.ToAppError(err).Code == errx.CodeNotFound {
+				continue
+			}
+			return errx.New(errx.CodeInternalError, err)
+		}
+		until, err := strconv.ParseInt(val, 10, 64)
+		if err != nil {
+			continue
+		}
+		if d := time.UnixMilli(until).Sub(now); d > wait {
+			wait = d
+		}
+	}
+
+	if wait > 0 {
+		return errx.TooManyRequests(wait, fmt.Errorf("too many %s attempts", action))
+	}
+	return nil
+}
+
+// Record implements [AttemptGuard].
+func (g *attemptGuard) Record(ctx context.Context, action AttemptAction, ip, email string) error {
+	policy := g.policies[action]
+	if policy.Window <= 0 {
+		return nil
+	}
+	email = normalizeEmail(email)
+
+	limits := map[attemptDimension]int{
+		dimensionIP:      policy.IPLimit,
+		dimensionEmail:   policy.EmailLimit,
+		dimensionIPEmail: policy.IPEmailLimit,
+	}
+	for dim, limit := range limits {
+		id := attemptID(dim, ip, email)
+		delays := dim == dimensionIPEmail && policy.BaseDelay > 0
+		if id == "" || (limit <= 0 && !delays) {
+			continue
+		}
+
+		count, err := g.rc.SlidingWindowIncr(ctx, attemptCountKey(action, dim, id), policy.Window)
+		if err != nil {
+			return errx.New(errx.CodeInternalError, err)
+		}
+
+		if limit > 0 && count >= int64(limit) && policy.Lockout > 0 {
+			if err := g.block(ctx, attemptLockKey(action, dim, id), policy.Lockout); err != nil {
+				return err
+			}
+			if count == int64(limit) {
+				g.log.Warn("attempts locked out",
+					logger.String("action", string(action)),
+					logger.String("dimension", string(dim)),
+					logger.String("ip", ip),
+					logger.String("email", email),
+					logger.Int("attempts", int(count)),
+					logger.Duration("lockout", policy.Lockout),
+				)
+			}
+		}
+		if delays {
+			if d := attemptDelay(policy, count); d > 0 {
+				if err := g.block(ctx, attemptDelayKey(action, ip, email), d); err != nil {
+					return err
+				}
+			}
+		}
+	}
+	return nil
+}
+
+// Reset implements [AttemptGuard].
+//
+// Only the counters of the email are reset, so one successful login does not
+// reset the budget of an IP guessing at other accounts.
+func (g *attemptGuard) Reset(ctx context.Context, action AttemptAction, ip, email string) error {
+	email = normalizeEmail(email)
+
+	keys := []string{attemptDelayKey(action, ip, email)}
+	for _, dim := range []attemptDimension{dimensionEmail, dimensionIPEmail} {
+		if id := attemptID(dim, ip, email); id != "" {
+			keys = append(keys, attemptCountKey(action, dim, id))
+		}
+	}
+	for _, key := range keys {
+		if err := g.rc.Delete(ctx, key); err != nil {
+			return errx.New(errx.CodeInternalError, err)
+		}
+	}
+	return nil
+}
+
+// block stores until when key blocks attempts.
+func (g *attemptGuard) block(ctx context.Context, key string, d time.Duration) error {
+	until := time.Now().Add(d).UnixMilli()
+	if err := g.rc.Set(ctx, key, strconv.FormatInt(until, 10), d); err != nil {
+		return errx.New(errx.CodeInternalError, err)
+	}
+	return nil
+}
+
+// attemptDelay returns how long to wait after the count-th attempt by the same
+// IP and email: nothing for the free attempts, then BaseDelay doubling up to MaxDelay.
+func attemptDelay(policy config.AttemptPolicy, count int64) time.Duration {
+	n := count - int64(policy.FreeAttempts)
+	if n <= 0 {
+		return 0
+	}
+
+	delay := policy.BaseDelay
+	for i := int64(1); i < n; i++ {
+		delay *= 2
+		if policy.MaxDelay > 0 && delay >= policy.MaxDelay {
+			break
+		}
+	}
+	if policy.MaxDelay > 0 && delay > policy.MaxDelay {
+		delay = policy.MaxDelay
+	}
+	return delay
+}
+
+// attemptID returns what the dimension counts for the IP and email, or "" if
+// the dimension does not apply.
+func attemptID(dim attemptDimension, ip, email string) string {
+	switch dim {
+	case dimensionIP:
+		return ip
+	case dimensionEmail:
+		return email
+	default:
+		if ip == "" || email == "" {
+			return ""
+		}
+		return ip + "|" + email
+	}
+}
+
+func attemptCountKey(action AttemptAction, dim attemptDimension, id string) string {
+	return fmt.Sprintf("blog:throttle:%s:%s:%s", action, dim, id)
+}
+
+func attemptLockKey(action AttemptAction, dim attemptDimension, id string) string {
+	return fmt.Sprintf("blog:throttle:%s:lock:%s:%s", action, dim, id)
+}
+
+func attemptDelayKey(action AttemptAction, ip, email string) string {
+	return fmt.Sprintf("blog:throttle:%s:delay:%s|%s", action, ip, email)
+}
+
+// normalizeEmail makes differently cased spellings of an email count together.
+func normalizeEmail(email string) string {
+	return strings.ToLower(strings.TrimSpace(email))
+}
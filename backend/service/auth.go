@@ -2,18 +2,22 @@ package service
 
 import (
 	"context"
-	"crypto/subtle"
 	"fmt"
 	"slices"
 	"strings"
+	"sync"
 	"time"
 
 	"blog-server/cache"
 	"blog-server/config"
+	"blog-server/contextx"
 	"blog-server/datastore"
 	"blog-server/entity"
+	"blog-server/logger"
 	"blog-server/pkg/errx"
 	"blog-server/pkg/jwt"
+	"blog-server/pkg/oidc"
+	"blog-server/pkg/webauthn"
 	"blog-server/repository"
 	"blog-server/utils"
 
@@ -32,6 +36,20 @@ const (
 	ChangeEmail CaptchaType = "ChangeEmail"
 )
 
+// captchaTTL is how long an emailed captcha is valid, and so how long its
+// email is worth delivering.
+const captchaTTL = 5 * time.Minute
+
+// dummyPasswordHash is compared against when there is no password to check,
+// so that failing takes as long as a wrong password.
+var dummyPasswordHash = sync.OnceValue(func() string {
+	hash, _ := utils.HashPassword("dummy password")
+	return hash
+})
+
+// maxCaptchaFailures is how many wrong guesses invalidate an emailed captcha.
+const maxCaptchaFailures = 5
+
 // AuthMailData represents the data required to send a captcha email.
 type AuthMailData struct {
 	Title   string
@@ -46,12 +64,56 @@ type RegisterInput struct {
 	Email    string
 	Password string
 	Captcha  string
+
+	Meta SessionMeta
+}
+
+// ResetPasswordInput groups all parameters for resetting a forgotten password.
+type ResetPasswordInput struct {
+	Email    string
+	Password string
+	Captcha  string
+
+	IP string
+}
+
+// LogoutInput groups the credentials presented on logout.
+type LogoutInput struct {
+	// User is the authenticated caller, nil when the access token was missing or expired.
+	User         *contextx.User
+	RefreshToken string
+}
+
+// ChangeEmailInput groups all parameters for changing a user's email.
+type ChangeEmailInput struct {
+	Email   string
+	Captcha string
+
+	Meta SessionMeta
+}
+
+// ChangePasswordInput groups all parameters for changing a user's password.
+type ChangePasswordInput struct {
+	CurrentPassword string
+	NewPassword     string
+
+	Meta SessionMeta
+}
+
+// EmailChangedMailData represents the data of the email_changed.html template.
+type EmailChangedMailData struct {
+	Title     string
+	OldEmail  string
+	NewEmail  string
+	ChangedAt string
 }
 
 // LoginInput groups all parameters for user login.
 type LoginInput struct {
 	Email    string
 	Password string
+
+	Meta SessionMeta
 }
 
 // AuthResult is the unified return type for Register / Login.
@@ -64,15 +126,53 @@ type AuthResult struct {
 	Avatar       *string
 	Username     string
 	Role         string
+
+	// TwoFactorRequired is set instead of the tokens when the password was
+	// correct but the login must be completed with LoginTwoFactor.
+	TwoFactorRequired bool
+	ChallengeToken    string
+}
+
+// TwoFactorLoginInput groups all parameters for the second step of a login.
+type TwoFactorLoginInput struct {
+	ChallengeToken string
+	// Code is a TOTP code or a recovery code.
+	Code string
+
+	Meta SessionMeta
+}
+
+// PasskeyLoginInput groups all parameters for a passwordless login.
+type PasskeyLoginInput struct {
+	ChallengeID string
+	Response    *webauthn.AssertionResponse
+
+	Meta SessionMeta
+}
+
+// OAuthLoginInput groups all parameters for a login with an external account.
+type OAuthLoginInput struct {
+	Provider string
+	Identity *oidc.Identity
+
+	Meta SessionMeta
 }
 
 // AuthService defines the interface for authentication services.
 type AuthService interface {
-	SendCaptchaMail(ctx context.Context, to string, captchaType CaptchaType) error
+	SendCaptchaMail(ctx context.Context, to string, captchaType CaptchaType, ip string) error
 	Register(ctx context.Context, input *RegisterInput) (*AuthResult, error)
 	Login(ctx context.Context, input *LoginInput) (*AuthResult, error)
+	LoginTwoFactor(ctx context.Context, input *TwoFactorLoginInput) (*AuthResult, error)
+	LoginPasskey(ctx context.Context, input *PasskeyLoginInput) (*AuthResult, error)
+	LoginOAuth(ctx context.Context, input *OAuthLoginInput) (*AuthResult, error)
 	HasRole(ctx context.Context, id uint, roles ...entity.UserRole) (bool, error)
-	RefreshAccessToken(ctx context.Context, token string) (string, string, error)
+	RefreshAccessToken(ctx context.Context, token string, meta SessionMeta) (string, string, error)
+	Logout(ctx context.Context, input *LogoutInput) error
+	ResetPassword(ctx context.Context, input *ResetPasswordInput) error
+	SendChangeEmailCaptcha(ctx context.Context, userID uint, newEmail, ip string) error
+	ChangeEmail(ctx context.Context, userID uint, input *ChangeEmailInput) (*AuthResult, error)
+	ChangePassword(ctx context.Context, userID uint, input *ChangePasswordInput) (*AuthResult, error)
 }
 
 // authService implements the AuthService interface.
@@ -82,6 +182,14 @@ type authService struct {
 	cfg         *config.Config
 	userRepo    repository.UserRepo
 	mailService MailService
+	sessions    SessionService
+	twoFactor   TwoFactorService
+	passkeys    PasskeyService
+	oauth       OAuthService
+	denylist    TokenDenylist
+	guard       AttemptGuard
+	jwt         jwt.Jwt
+	log         logger.Logger
 }
 
 // NewAuthService creates and returns a new AuthService instance.
@@ -90,6 +198,14 @@ func NewAuthService(
 	rc cache.CacheClient,
 	userRepo repository.UserRepo,
 	mailService MailService,
+	sessions SessionService,
+	twoFactor TwoFactorService,
+	passkeys PasskeyService,
+	oauth OAuthService,
+	denylist TokenDenylist,
+	guard AttemptGuard,
+	j jwt.Jwt,
+	log logger.Logger,
 ) AuthService {
 	return &authService{
 		ds:          ds,
@@ -97,20 +213,22 @@ func NewAuthService(
 		cfg:         config.Get(),
 		userRepo:    userRepo,
 		mailService: mailService,
+		sessions:    sessions,
+		twoFactor:   twoFactor,
+		passkeys:    passkeys,
+		oauth:       oauth,
+		denylist:    denylist,
+		guard:       guard,
+		jwt:         j,
+		log:         log,
 	}
 }
 
 // Register registers a new user and generates access/refresh tokens.
 func (s *authService) Register(ctx context.Context, input *RegisterInput) (*AuthResult, error) {
-	// Verify captcha
-	cachedCaptcha, err := s.rc.Get(ctx, fmt.Sprintf("Register:%s", input.Email))
-	if err != nil {
-		return nil, errx.New(errx.CodeInternalError, fmt.Errorf("failed to get cached captcha: %w", err))
-	}
-	if !strings.EqualFold(strings.TrimSpace(cachedCaptcha), strings.TrimSpace(input.Captcha)) {
-		return nil, errx.New(errx.CodeInvalidParam, fmt.Errorf("invalid captcha"))
+	if err := s.verifyCaptcha(ctx, fmt.Sprintf("%s:%s", Register, input.Email), input.Captcha, input.Meta.IP, input.Email); err != nil {
+		return nil, err
 	}
-	_ = s.rc.Delete(ctx, fmt.Sprintf("Register:%s", input.Email))
 
 	hashedPassword, err := utils.HashPassword(input.Password)
 	if err != nil {
@@ -136,19 +254,14 @@ func (s *authService) Register(ctx context.Context, input *RegisterInput) (*Auth
 		return nil, errx.New(errx.CodeInternalError, err)
 	}
 
-	j := jwt.New(s.cfg.JWT)
-	accessToken, refreshToken, err := j.GenerateAllTokens(created.ID, created.Role)
+	tokens, err := s.sessions.Create(ctx, created.ID, created.Role, input.Meta)
 	if err != nil {
 		return nil, err
 	}
 
-	if err := s.cacheRefreshToken(ctx, created.ID, refreshToken); err != nil {
-		return nil, errx.New(errx.CodeInternalError, err)
-	}
-
 	return &AuthResult{
-		AccessToken:  accessToken,
-		RefreshToken: refreshToken,
+		AccessToken:  tokens.AccessToken,
+		RefreshToken: tokens.RefreshToken,
 		UUID:         created.UUID.String(),
 		Avatar:       created.Avatar,
 		Username:     created.Username,
@@ -157,13 +270,47 @@ func (s *authService) Register(ctx context.Context, input *RegisterInput) (*Auth
 }
 
 // Login logs in a user and generates access/refresh tokens.
+//
+// Failed attempts are throttled per IP and email, see AttemptGuard.
 func (s *authService) Login(ctx context.Context, input *LoginInput) (*AuthResult, error) {
+	if err := s.guard.Check(ctx, LoginAttempt, input.Meta.IP, input.Email); err != nil {
+		return nil, err
+	}
+
+	// Unknown emails fail like wrong passwords, after as long a check, so
+	// the login cannot be used to probe for registered emails.
+	invalid := errx.New(errx.CodeInvalidParam, fmt.Errorf("invalid credentials"))
 	user, err := s.userRepo.GetAuthByEmail(ctx, input.Email)
 	if err != nil {
+		if errx.ToAppError(err).Code == errx.CodeNotFound {
+			utils.VerifyPassword(input.Password, dummyPasswordHash())
+			s.recordFailedLogin(ctx, input)
+			return nil, invalid
+		}
+		return nil, err
+	}
+	hash := user.Password
+	if hash == "" {
+		// Accounts created through a provider have no password.
+		hash = dummyPasswordHash()
+	}
+	if !utils.VerifyPassword(input.Password, hash) || user.Password == "" {
+		s.recordFailedLogin(ctx, input)
+		return nil, invalid
+	}
+	if err := s.guard.Reset(ctx, LoginAttempt, input.Meta.IP, input.Email); err != nil {
+		s.log.Warn("reset login attempts failed", logger.Err(err))
+	}
+	if err := checkActive(user); err != nil {
 		return nil, err
 	}
-	if !utils.VerifyPassword(input.Password, user.Password) {
-		return nil, errx.New(errx.CodeInvalidParam, fmt.Errorf("invalid credentials"))
+
+	if user.TwoFactorEnabled {
+		token, err := s.createLoginChallenge(ctx, user.ID)
+		if err != nil {
+			return nil, err
+		}
+		return &AuthResult{TwoFactorRequired: true, ChallengeToken: token}, nil
 	}
 
 	// Fetch full profile for the response.
@@ -172,19 +319,147 @@ func (s *authService) Login(ctx context.Context, input *LoginInput) (*AuthResult
 		return nil, err
 	}
 
-	j := jwt.New(s.cfg.JWT)
-	accessToken, refreshToken, err := j.GenerateAllTokens(user.ID, user.Role)
+	tokens, err := s.sessions.Create(ctx, user.ID, user.Role, input.Meta)
 	if err != nil {
 		return nil, err
 	}
 
-	if err := s.cacheRefreshToken(ctx, user.ID, refreshToken); err != nil {
+	return &AuthResult{
+		AccessToken:  tokens.AccessToken,
+		RefreshToken: tokens.RefreshToken,
+		UUID:         profile.UUID.String(),
+		Avatar:       profile.Avatar,
+		Username:     profile.Username,
+		Role:         string(profile.Role),
+	}, nil
+}
+
+// LoginTwoFactor completes a login started by Login with a TOTP or recovery code.
+//
+// A challenge allows a few attempts and is consumed by the first success.
+func (s *authService) LoginTwoFactor(ctx context.Context, input *TwoFactorLoginInput) (*AuthResult, error) {
+	userID, err := s.consumeLoginChallenge(ctx, input.ChallengeToken, func(userID uint) error {
+		return s.twoFactor.Verify(ctx, userID, input.Code)
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	user, err := s.userRepo.GetAuthByID(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+	if err := checkActive(user); err != nil {
+		return nil, err
+	}
+	profile, err := s.userRepo.GetByID(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	meta := input.Meta
+	meta.TwoFactor = true
+	tokens, err := s.sessions.Create(ctx, user.ID, user.Role, meta)
+	if err != nil {
+		return nil, err
+	}
+
+	return &AuthResult{
+		AccessToken:  tokens.AccessToken,
+		RefreshToken: tokens.RefreshToken,
+		UUID:         profile.UUID.String(),
+		Avatar:       profile.Avatar,
+		Username:     profile.Username,
+		Role:         string(profile.Role),
+	}, nil
+}
+
+// LoginPasskey logs in with a passkey instead of a password.
+//
+// A passkey that verified the user counts as two factors. Without user
+// verification it only proves possession, so users with TOTP enabled are
+// asked for their code as after a password login.
+func (s *authService) LoginPasskey(ctx context.Context, input *PasskeyLoginInput) (*AuthResult, error) {
+	userID, verified, err := s.passkeys.Authenticate(ctx, input.ChallengeID, input.Response)
+	if err != nil {
+		return nil, err
+	}
+
+	user, err := s.userRepo.GetAuthByID(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+	if err := checkActive(user); err != nil {
+		return nil, err
+	}
+
+	if !verified && user.TwoFactorEnabled {
+		token, err := s.createLoginChallenge(ctx, user.ID)
+		if err != nil {
+			return nil, err
+		}
+		return &AuthResult{TwoFactorRequired: true, ChallengeToken: token}, nil
+	}
+
+	profile, err := s.userRepo.GetByID(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	meta := input.Meta
+	meta.TwoFactor = verified
+	tokens, err := s.sessions.Create(ctx, user.ID, user.Role, meta)
+	if err != nil {
+		return nil, err
+	}
+
+	return &AuthResult{
+		AccessToken:  tokens.AccessToken,
+		RefreshToken: tokens.RefreshToken,
+		UUID:         profile.UUID.String(),
+		Avatar:       profile.Avatar,
+		Username:     profile.Username,
+		Role:         string(profile.Role),
+	}, nil
+}
+
+// LoginOAuth logs in with an account at an external provider, registering
+// the user on first login. Users with TOTP enabled still need their code.
+func (s *authService) LoginOAuth(ctx context.Context, input *OAuthLoginInput) (*AuthResult, error) {
+	userID, err := s.oauth.ResolveUser(ctx, input.Provider, input.Identity)
+	if err != nil {
+		return nil, err
+	}
+
+	user, err := s.userRepo.GetAuthByID(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+	if err := checkActive(user); err != nil {
+		return nil, err
+	}
+
+	if user.TwoFactorEnabled {
+		token, err := s.createLoginChallenge(ctx, user.ID)
+		if err != nil {
+			return nil, err
+		}
+		return &AuthResult{TwoFactorRequired: true, ChallengeToken: token}, nil
+	}
+
+	profile, err := s.userRepo.GetByID(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	tokens, err := s.sessions.Create(ctx, user.ID, user.Role, input.Meta)
+	if err != nil {
 		return nil, err
 	}
 
 	return &AuthResult{
-		AccessToken:  accessToken,
-		RefreshToken: refreshToken,
+		AccessToken:  tokens.AccessToken,
+		RefreshToken: tokens.RefreshToken,
 		UUID:         profile.UUID.String(),
 		Avatar:       profile.Avatar,
 		Username:     profile.Username,
@@ -193,77 +468,235 @@ func (s *authService) Login(ctx context.Context, input *LoginInput) (*AuthResult
 }
 
 // SendCaptchaMail generates a captcha, stores it in Redis, and sends an email.
-func (s *authService) SendCaptchaMail(ctx context.Context, to string, captchaType CaptchaType) error {
+//
+// Register and ChangeEmail require the address to be unused. PasswordReset
+// requires an existing account, but silently skips unknown addresses so the
+// endpoint cannot be used to probe for registered emails.
+func (s *authService) SendCaptchaMail(ctx context.Context, to string, captchaType CaptchaType, ip string) error {
+	if captchaType == "" {
+		captchaType = Register
+	}
+	if err := s.throttleCaptcha(ctx, ip, to); err != nil {
+		return err
+	}
+
 	exists, err := s.userRepo.ExistsByEmail(ctx, to)
 	if err != nil {
 		return err
 	}
+	switch captchaType {
+	case PasswordReset:
+		if !exists {
+			return nil
+		}
+	default:
+		if exists {
+			return errx.New(errx.CodeConflict, fmt.Errorf("sent captcha failed to %s: user exists", to))
+		}
+	}
+
+	return s.sendCaptcha(ctx, fmt.Sprintf("%s:%s", captchaType, to), to, captchaType)
+}
+
+// SendChangeEmailCaptcha sends a captcha to the address a user wants to switch to.
+//
+// The captcha is bound to the user, so it cannot be redeemed by another account.
+func (s *authService) SendChangeEmailCaptcha(ctx context.Context, userID uint, newEmail, ip string) error {
+	if err := s.throttleCaptcha(ctx, ip, newEmail); err != nil {
+		return err
+	}
+
+	exists, err := s.userRepo.ExistsByEmail(ctx, newEmail)
+	if err != nil {
+		return err
+	}
 	if exists {
-		return errx.New(errx.CodeConflict, fmt.Errorf("sent captcha failed to %s: user exists", to))
+		return errx.New(errx.CodeConflict, fmt.Errorf("sent captcha failed to %s: user exists", newEmail))
 	}
 
-	if captchaType == "" {
-		captchaType = Register
+	return s.sendCaptcha(ctx, changeEmailCaptchaKey(userID, newEmail), newEmail, ChangeEmail)
+}
+
+// ChangeEmail switches the email of a user after verifying the captcha sent
+// to the new address.
+//
+// The old address is notified and all sessions are replaced by a new one for
+// the current device, so other devices have to log in again.
+func (s *authService) ChangeEmail(ctx context.Context, userID uint, input *ChangeEmailInput) (*AuthResult, error) {
+	if err := s.verifyCaptcha(ctx, changeEmailCaptchaKey(userID, input.Email), input.Captcha, input.Meta.IP, input.Email); err != nil {
+		return nil, err
 	}
 
-	mailData, err := getCaptchaEmailMeta(captchaType)
+	var oldEmail string
+	var updated *entity.User
+	err := s.ds.WithTx(ctx, func(ctx context.Context) error {
+		current, err := s.userRepo.GetByID(ctx, userID)
+		if err != nil {
+			return err
+		}
+		oldEmail = current.Email
+
+		exists, err := s.userRepo.ExistsByEmail(ctx, input.Email)
+		if err != nil {
+			return err
+		}
+		if exists {
+			return errx.New(errx.CodeConflict, fmt.Errorf("email %s already in use", input.Email))
+		}
+
+		updated, err = s.userRepo.UpdateEmail(ctx, userID, input.Email)
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	mailData := &EmailChangedMailData{
+		Title:     "Your Email Address Was Changed",
+		OldEmail:  oldEmail,
+		NewEmail:  updated.Email,
+		ChangedAt: time.Now().UTC().Format("2006-01-02 15:04 MST"),
+	}
+	// The change is already committed; a lost notice must not fail the request.
+	// The notice stays relevant, so it does not expire.
+	if err := s.mailService.Send(ctx, &SendMailInput{
+		To:       oldEmail,
+		Subject:  "[Immortal's Blog] Email Address Changed",
+		Template: "email_changed.html",
+		Data:     mailData,
+	}); err != nil {
+		s.log.Error("queue email changed notice failed", logger.Int("user_id", int(userID)), logger.Err(err))
+	}
+
+	if err := s.sessions.RevokeAll(ctx, updated.ID); err != nil {
+		return nil, err
+	}
+	tokens, err := s.sessions.Create(ctx, updated.ID, updated.Role, input.Meta)
 	if err != nil {
+		return nil, err
+	}
+
+	return &AuthResult{
+		AccessToken:  tokens.AccessToken,
+		RefreshToken: tokens.RefreshToken,
+		UUID:         updated.UUID.String(),
+		Avatar:       updated.Avatar,
+		Username:     updated.Username,
+		Role:         string(updated.Role),
+	}, nil
+}
+
+// ResetPassword sets a new password after verifying the emailed captcha.
+//
+// All sessions of the user are revoked, so every device has to log in
+// again with the new password.
+func (s *authService) ResetPassword(ctx context.Context, input *ResetPasswordInput) error {
+	if err := s.verifyCaptcha(ctx, fmt.Sprintf("%s:%s", PasswordReset, input.Email), input.Captcha, input.IP, input.Email); err != nil {
 		return err
 	}
 
-	captcha := generateCaptcha()
-	mailData.Captcha = captcha
+	user, err := s.userRepo.GetByEmail(ctx, input.Email)
+	if err != nil {
+		return err
+	}
 
-	if err := s.rc.Set(ctx, fmt.Sprintf("%s:%s", captchaType, to), captcha, 5*time.Minute); err != nil {
+	hashedPassword, err := utils.HashPassword(input.Password)
+	if err != nil {
 		return errx.New(errx.CodeInternalError, err)
 	}
 
-	templateName := "captcha.html"
-	if err := s.mailService.Send(to, mailData.Subject, templateName, mailData); err != nil {
+	if err := s.userRepo.UpdatePassword(ctx, user.ID, hashedPassword); err != nil {
 		return err
 	}
 
-	return nil
+	return s.sessions.RevokeAll(ctx, user.ID)
 }
 
-// RefreshAccessToken refreshes the access token using a valid refresh token.
-func (s *authService) RefreshAccessToken(ctx context.Context, token string) (string, string, error) {
-	j := jwt.New(s.cfg.JWT)
-	claims, err := j.Parse(token)
+// ChangePassword sets a new password after checking the current one.
+//
+// All sessions are revoked, and the caller continues in a new one.
+func (s *authService) ChangePassword(ctx context.Context, userID uint, input *ChangePasswordInput) (*AuthResult, error) {
+	auth, err := s.userRepo.GetAuthByID(ctx, userID)
 	if err != nil {
-		return "", "", err
+		return nil, err
+	}
+	if !utils.VerifyPassword(input.CurrentPassword, auth.Password) {
+		return nil, errx.New(errx.CodeInvalidParam, fmt.Errorf("invalid current password"))
 	}
 
-	userID := claims.ID
-	role := claims.Role
-
-	existed, err := s.userRepo.ExistsByID(ctx, userID)
+	hashedPassword, err := utils.HashPassword(input.NewPassword)
 	if err != nil {
-		return "", "", err
+		return nil, errx.New(errx.CodeInternalError, err)
 	}
-	if !existed {
-		return "", "", errx.New(errx.CodeNotFound, fmt.Errorf("user %d not found", userID))
+	if err := s.userRepo.UpdatePassword(ctx, userID, hashedPassword); err != nil {
+		return nil, err
 	}
 
-	cachedRefreshToken, err := s.rc.Get(ctx, fmt.Sprintf("RefreshToken:%d", userID))
+	profile, err := s.userRepo.GetByID(ctx, userID)
 	if err != nil {
-		return "", "", errx.New(errx.CodeInternalError, err)
+		return nil, err
 	}
 
-	if subtle.ConstantTimeCompare([]byte(token), []byte(cachedRefreshToken)) == 0 {
-		return "", "", errx.New(errx.CodeUnauthorized, fmt.Errorf("invalid refresh token"))
+	if err := s.sessions.RevokeAll(ctx, userID); err != nil {
+		return nil, err
 	}
+	tokens, err := s.sessions.Create(ctx, userID, profile.Role, input.Meta)
+	if err != nil {
+		return nil, err
+	}
+
+	return &AuthResult{
+		AccessToken:  tokens.AccessToken,
+		RefreshToken: tokens.RefreshToken,
+		UUID:         profile.UUID.String(),
+		Avatar:       profile.Avatar,
+		Username:     profile.Username,
+		Role:         string(profile.Role),
+	}, nil
+}
 
-	accessToken, refreshToken, err := j.GenerateAllTokens(userID, role)
+// RefreshAccessToken refreshes the access token using a valid refresh token.
+func (s *authService) RefreshAccessToken(ctx context.Context, token string, meta SessionMeta) (string, string, error) {
+	tokens, err := s.sessions.Refresh(ctx, token, meta)
 	if err != nil {
 		return "", "", err
 	}
 
-	if err := s.cacheRefreshToken(ctx, userID, refreshToken); err != nil {
-		return "", "", err
+	return tokens.AccessToken, tokens.RefreshToken, nil
+}
+
+// Logout ends the session of the caller and revokes the access token it presented.
+//
+// The session is taken from the access token when there is one, and from the
+// refresh token otherwise, so logging out still works after the access token expired.
+func (s *authService) Logout(ctx context.Context, input *LogoutInput) error {
+	if u := input.User; u != nil {
+		if err := s.denylist.Deny(ctx, u.TokenID, u.TokenExpiresAt); err != nil {
+			return err
+		}
+		if u.SessionID != "" {
+			return s.revokeSession(ctx, u.ID, u.SessionID)
+		}
 	}
 
-	return accessToken, refreshToken, nil
+	if input.RefreshToken == "" {
+		return nil
+	}
+	claims, err := s.jwt.Parse(input.RefreshToken)
+	if err != nil || claims.Type != jwt.TokenTypeRefresh {
+		// An invalid refresh token has no session left to revoke.
+		return nil
+	}
+
+	return s.revokeSession(ctx, claims.ID, claims.SessionID)
+}
+
+// revokeSession revokes a session, treating an already ended session as success.
+func (s *authService) revokeSession(ctx context.Context, userID uint, sessionID string) error {
+	if err := s.sessions.Revoke(ctx, userID, sessionID); err != nil && errx.ToAppError(err).Code != errx.CodeNotFound {
+		return err
+	}
+	return nil
 }
 
 // HasRole checks if a user has any of the specified roles.
@@ -283,18 +716,129 @@ func (s *authService) HasRole(ctx context.Context, id uint, roles ...entity.User
 	return false, nil
 }
 
-// cacheRefreshToken stores the refresh token in Redis.
-func (s *authService) cacheRefreshToken(ctx context.Context, id uint, refreshToken string) error {
-	key := fmt.Sprintf("RefreshToken:%d", id)
-	if err := s.rc.Set(ctx, key, refreshToken, s.cfg.JWT.RefreshExpiration); err != nil {
-		return errx.New(
-			errx.CodeInternalError,
-			fmt.Errorf("failed to cache refresh token for user %d", id),
-		)
+// checkActive rejects users who were disabled or banned.
+func checkActive(user *entity.UserAuth) error {
+	if user.Status != entity.UserStatusActive {
+		return errx.New(errx.CodeForbidden, fmt.Errorf("user %d is %s", user.ID, user.Status))
+	}
+	return nil
+}
+
+// recordFailedLogin counts a failed password login.
+func (s *authService) recordFailedLogin(ctx context.Context, input *LoginInput) {
+	if err := s.guard.Record(ctx, LoginAttempt, input.Meta.IP, input.Email); err != nil {
+		s.log.Warn("record failed login failed", logger.Err(err))
+	}
+}
+
+// throttleCaptcha limits captcha emails per IP and address.
+//
+// Every request counts, whether or not an email is sent, so throttling does
+// not reveal which addresses are registered.
+func (s *authService) throttleCaptcha(ctx context.Context, ip, to string) error {
+	if err := s.guard.Check(ctx, CaptchaAttempt, ip, to); err != nil {
+		return err
+	}
+	return s.guard.Record(ctx, CaptchaAttempt, ip, to)
+}
+
+// sendCaptcha generates a captcha, stores it under key, and emails it to to.
+func (s *authService) sendCaptcha(ctx context.Context, key, to string, captchaType CaptchaType) error {
+	mailData, err := getCaptchaEmailMeta(captchaType)
+	if err != nil {
+		return err
 	}
+
+	captcha := generateCaptcha()
+	mailData.Captcha = captcha
+
+	if err := s.rc.Set(ctx, key, captcha, captchaTTL); err != nil {
+		return errx.New(errx.CodeInternalError, err)
+	}
+	// A new captcha gets a fresh budget of guesses.
+	if err := s.rc.Delete(ctx, captchaFailuresKey(key)); err != nil {
+		return errx.New(errx.CodeInternalError, err)
+	}
+
+	if err := s.mailService.Send(ctx, &SendMailInput{
+		To:       to,
+		Subject:  mailData.Subject,
+		Template: "captcha.html",
+		Data:     mailData,
+		ValidFor: captchaTTL,
+	}); err != nil {
+		return err
+	}
+
 	return nil
 }
 
+// verifyCaptcha checks the captcha stored under key and consumes it on success.
+//
+// Wrong guesses are throttled per IP and email, see AttemptGuard, and the
+// captcha is discarded after maxCaptchaFailures of them, so that it cannot
+// be guessed within its lifetime.
+func (s *authService) verifyCaptcha(ctx context.Context, key, captcha, ip, email string) error {
+	if err := s.guard.Check(ctx, CaptchaVerifyAttempt, ip, email); err != nil {
+		return err
+	}
+
+	invalid := errx.New(errx.CodeInvalidParam, fmt.Errorf("invalid captcha"))
+	cachedCaptcha, err := s.rc.Get(ctx, key)
+	if err != nil {
+		if errx.ToAppError(err).Code == errx.CodeNotFound {
+			s.recordFailedCaptcha(ctx, ip, email)
+			return invalid
+		}
+		return errx.New(errx.CodeInternalError, fmt.Errorf("failed to get cached captcha: %w", err))
+	}
+	if !strings.EqualFold(strings.TrimSpace(cachedCaptcha), strings.TrimSpace(captcha)) {
+		s.recordFailedCaptcha(ctx, ip, email)
+
+		failuresKey := captchaFailuresKey(key)
+		failures, err := s.rc.IncrWithTTL(ctx, failuresKey, captchaTTL)
+		if err != nil {
+			return errx.New(errx.CodeInternalError, err)
+		}
+		if failures >= maxCaptchaFailures {
+			if err := s.rc.Delete(ctx, key); err != nil {
+				return errx.New(errx.CodeInternalError, err)
+			}
+			_ = s.rc.Delete(ctx, failuresKey)
+			s.log.Warn("captcha discarded after too many wrong guesses",
+				logger.String("ip", ip),
+				logger.String("email", email),
+			)
+		}
+		return invalid
+	}
+	_ = s.rc.Delete(ctx, key)
+	_ = s.rc.Delete(ctx, captchaFailuresKey(key))
+	if err := s.guard.Reset(ctx, CaptchaVerifyAttempt, ip, email); err != nil {
+		s.log.Warn("reset captcha attempts failed", logger.Err(err))
+	}
+
+	return nil
+}
+
+// recordFailedCaptcha counts a wrong captcha towards the throttle.
+func (s *authService) recordFailedCaptcha(ctx context.Context, ip, email string) {
+	if err := s.guard.Record(ctx, CaptchaVerifyAttempt, ip, email); err != nil {
+		s.log.Warn("record failed captcha failed", logger.Err(err))
+	}
+}
+
+// captchaFailuresKey returns the cache key counting wrong guesses of the
+// captcha stored under key.
+func captchaFailuresKey(key string) string {
+	return key + ":failures"
+}
+
+// changeEmailCaptchaKey returns the cache key of a change-email captcha.
+func changeEmailCaptchaKey(userID uint, email string) string {
+	return fmt.Sprintf("%s:%d:%s", ChangeEmail, userID, strings.ToLower(email))
+}
+
 var captchaMetaMap = map[CaptchaType]*AuthMailData{
 	Register: {
 		Subject: "[Immortal's Blog] Email Verification",
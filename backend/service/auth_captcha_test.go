@@ -0,0 +1,56 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"blog-server/config"
+	"blog-server/logger"
+	"blog-server/pkg/errx"
+)
+
+func newCaptchaTest() (*authService, *memoryCache) {
+	rc := newMemoryCache()
+	return &authService{
+		rc:    rc,
+		guard: NewAttemptGuard(&config.Config{}, rc, logger.NewNop()),
+		log:   logger.NewNop(),
+	}, rc
+}
+
+func TestVerifyCaptchaConsumesCode(t *testing.T) {
+	ctx := context.Background()
+	svc, rc := newCaptchaTest()
+	key := "password_reset:reader@example.com"
+	_ = rc.Set(ctx, key, "482913", captchaTTL)
+
+	if err := svc.verifyCaptcha(ctx, key, "000000", "203.0.113.1", "reader@example.com"); errx.ToAppError(err).Code != errx.CodeInvalidParam {
+		t.Fatalf("wrong captcha: got %v", err)
+	}
+	if err := svc.verifyCaptcha(ctx, key, " 482913 ", "203.0.113.1", "reader@example.com"); err != nil {
+		t.Fatalf("right captcha: %v", err)
+	}
+	if err := svc.verifyCaptcha(ctx, key, "482913", "203.0.113.1", "reader@example.com"); err == nil {
+		t.Fatal("captcha redeemed twice")
+	}
+}
+
+func TestVerifyCaptchaDiscardsGuessedCode(t *testing.T) {
+	ctx := context.Background()
+	svc, rc := newCaptchaTest()
+	key := "password_reset:reader@example.com"
+	_ = rc.Set(ctx, key, "482913", captchaTTL)
+
+	for i := range maxCaptchaFailures {
+		if err := svc.verifyCaptcha(ctx, key, fmt.Sprintf("%06d", i), "203.0.113.1", "reader@example.com"); err == nil {
+			t.Fatalf("guess %d accepted", i)
+		}
+	}
+	if err := svc.verifyCaptcha(ctx, key, "482913", "203.0.113.1", "reader@example.com"); err == nil {
+		t.Fatal("captcha still valid after too many wrong guesses")
+	}
+	if _, err := rc.Get(ctx, key); errx.ToAppError(err).Code != errx.CodeNotFound {
+		t.Errorf("captcha kept after too many wrong guesses: %v", err)
+	}
+}
@@ -0,0 +1,56 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"blog-server/entity"
+	"blog-server/pkg/errx"
+	"blog-server/repository"
+	"blog-server/utils"
+)
+
+// loginUsers is an in-memory repository.UserRepo for password logins.
+type loginUsers struct {
+	repository.UserRepo
+	users map[string]*entity.UserAuth
+}
+
+func (r *loginUsers) GetAuthByEmail(_ context.Context, email string) (*entity.UserAuth, error) {
+	u, ok := r.users[email]
+	if !ok {
+		return nil, errx.New(errx.CodeNotFound, errors.New("user not found"))
+	}
+	return u, nil
+}
+
+func TestLoginDoesNotRevealRegisteredEmails(t *testing.T) {
+	hash, err := utils.HashPassword("correct horse")
+	if err != nil {
+		t.Fatal(err)
+	}
+	svc, _ := newCaptchaTest()
+	svc.userRepo = &loginUsers{users: map[string]*entity.UserAuth{
+		"reader@example.com":   {ID: 1, Password: hash},
+		"provider@example.com": {ID: 2},
+	}}
+
+	var errs []error
+	for _, input := range []*LoginInput{
+		{Email: "reader@example.com", Password: "wrong"},
+		{Email: "nobody@example.com", Password: "wrong"},
+		{Email: "provider@example.com", Password: ""},
+	} {
+		_, err := svc.Login(context.Background(), input)
+		if err == nil {
+			t.Fatalf("login as %s succeeded", input.Email)
+		}
+		errs = append(errs, err)
+	}
+	for _, err := range errs[1:] {
+		if err.Error() != errs[0].Error() || errx.ToAppError(err).Code != errx.ToAppError(errs[0]).Code {
+			t.Errorf("got %v, want the same error as for a wrong password: %v", err, errs[0])
+		}
+	}
+}
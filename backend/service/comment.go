@@ -0,0 +1,476 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"blog-server/authz"
+	"blog-server/config"
+	"blog-server/contextx"
+	"blog-server/entity"
+	"blog-server/logger"
+	"blog-server/pkg/errx"
+	"blog-server/repository"
+)
+
+const (
+	defaultCommentPageSize = 10
+	maxCommentPageSize     = 50
+	maxSpamReasonLen       = 512
+)
+
+// CommentService defines the interface for comment business logic operations.
+type CommentService interface {
+	ListComments(ctx context.Context, postID uint, cursor uint, limit int) ([]*entity.Comment, *uint, error)
+	CreateComment(ctx context.Context, input *CreateCommentInput) (*CreateCommentResult, error)
+
+	AdminGetComments(ctx context.Context, user contextx.User, filter *AdminCommentFilter, page, pageSize int) ([]*entity.Comment, int, error)
+	UpdateComment(ctx context.Context, user contextx.User, id uint, content string) (*entity.Comment, error)
+	ModerateComments(ctx context.Context, user contextx.User, ids []uint, action CommentAction) error
+
+	SubscriptionAddress(token string) (string, error)
+	ConfirmSubscription(ctx context.Context, token string) (string, error)
+	UnsubscribeAddress(token string) (string, error)
+	Unsubscribe(ctx context.Context, token string) (string, error)
+}
+
+// CommentAction represents a moderation action applied to comments.
+type CommentAction string
+
+const (
+	CommentActionApprove CommentAction = "approve"
+	CommentActionReject  CommentAction = "reject"
+	CommentActionSpam    CommentAction = "spam"
+	CommentActionDelete  CommentAction = "delete"
+)
+
+// AdminCommentFilter groups the optional filters of the moderation queue.
+type AdminCommentFilter struct {
+	Status  *entity.CommentStatus
+	PostID  *uint
+	Keyword *string
+}
+
+// CreateCommentInput groups all parameters for creating a comment.
+//
+// User is nil for guests, in which case AuthorName and AuthorEmail are required.
+type CreateCommentInput struct {
+	PostID   uint
+	ParentID *uint
+
+	User          *contextx.User
+	AuthorName    *string
+	AuthorEmail   *string
+	AuthorWebsite *string
+
+	Content string
+
+	IP        string
+	UserAgent string
+
+	// Honeypot and RenderedAt come from the comment form and feed the spam filters.
+	Honeypot   string
+	RenderedAt *time.Time
+
+	// NotifyOnReply asks for reply notifications. Guests only get them
+	// after confirming their address through the emailed link.
+	NotifyOnReply bool
+
+	// GuestToken is the token handed to the guest with an earlier comment.
+	GuestToken string
+}
+
+// CreateCommentResult is the created comment and, for guests, the token to
+// present with later comments. GuestToken is empty when the guest should
+// keep the token they have.
+type CreateCommentResult struct {
+	Comment    *entity.Comment
+	GuestToken string
+}
+
+// commentService implements the CommentService interface.
+type commentService struct {
+	log      logger.Logger
+	cr       repository.CommentRepo
+	pr       repository.PostRepo
+	userRepo repository.UserRepo
+	authz    *authz.Authorizer
+	spam     *SpamFilterChain
+	mail     MailService
+
+	notifyCfg   config.CommentNotifyConfig
+	guestSecret string
+	domain      string
+}
+
+// NewCommentService creates and returns a new CommentService instance.
+func NewCommentService(
+	cfg *config.Config,
+	log logger.Logger,
+	cr repository.CommentRepo,
+	pr repository.PostRepo,
+	userRepo repository.UserRepo,
+	authz *authz.Authorizer,
+	spam *SpamFilterChain,
+	mail MailService,
+) CommentService {
+	return &commentService{
+		log:      log,
+		cr:       cr,
+		pr:       pr,
+		userRepo: userRepo,
+		authz:    authz,
+		spam:     spam,
+		mail:     mail,
+
+		notifyCfg:   cfg.Comment.Notify,
+		guestSecret: cfg.Comment.GuestSecret,
+		domain:      cfg.App.Domain,
+	}
+}
+
+// ListComments returns a page of approved comment threads of a published post.
+//
+// Top-level comments are paginated newest first by cursor; every returned
+// thread carries all of its approved replies nested under their parents.
+// The returned cursor is nil when there are no more threads.
+func (s *commentService) ListComments(ctx context.Context, postID uint, cursor uint, limit int) ([]*entity.Comment, *uint, error) {
+	if limit <= 0 {
+		limit = defaultCommentPageSize
+	}
+	if limit > maxCommentPageSize {
+		limit = maxCommentPageSize
+	}
+
+	if err := s.ensurePostPublished(ctx, postID); err != nil {
+		return nil, nil, err
+	}
+
+	// Fetch one extra root to find out whether another page exists.
+	roots, err := s.cr.ListApprovedRoots(ctx, postID, cursor, limit+1)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var next *uint
+	if len(roots) > limit {
+		roots = roots[:limit]
+		last := roots[len(roots)-1].ID
+		next = &last
+	}
+
+	rootIDs := make([]uint, len(roots))
+	for i, root := range roots {
+		rootIDs[i] = root.ID
+	}
+
+	replies, err := s.cr.ListApprovedReplies(ctx, rootIDs)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return buildCommentThreads(roots, replies), next, nil
+}
+
+// CreateComment validates and stores a new comment or reply.
+func (s *commentService) CreateComment(ctx context.Context, input *CreateCommentInput) (*CreateCommentResult, error) {
+	if err := s.ensurePostPublished(ctx, input.PostID); err != nil {
+		return nil, err
+	}
+
+	content := strings.TrimSpace(input.Content)
+	if content == "" {
+		return nil, errx.New(errx.CodeInvalidParam, fmt.Errorf("comment content is empty"))
+	}
+
+	c := &entity.Comment{
+		PostID:        input.PostID,
+		Content:       content,
+		NotifyOnReply: input.NotifyOnReply && input.User != nil,
+		IP:            input.IP,
+		UserAgent:     input.UserAgent,
+	}
+
+	if err := s.fillAuthor(ctx, c, input); err != nil {
+		return nil, err
+	}
+
+	status, err := s.initialStatus(ctx, c, input.GuestToken)
+	if err != nil {
+		return nil, err
+	}
+	c.Status = status
+
+	if input.ParentID != nil {
+		parent, err := s.cr.GetByID(ctx, *input.ParentID)
+		if err != nil {
+			return nil, err
+		}
+		if parent.PostID != input.PostID || parent.Status != entity.CommentStatusApproved {
+			return nil, errx.New(errx.CodeInvalidParam, fmt.Errorf("invalid parent comment %d", parent.ID))
+		}
+
+		rootID := parent.ID
+		if parent.RootID != nil {
+			rootID = *parent.RootID
+		}
+		c.ParentID = &parent.ID
+		c.RootID = &rootID
+	}
+
+	// Spam filters run last, so that rejected requests do not count
+	// towards the duplicate filter.
+	result := s.spam.Evaluate(ctx, &SpamCheckInput{
+		PostID:      input.PostID,
+		Content:     content,
+		AuthorEmail: *c.AuthorEmail,
+		IP:          input.IP,
+		UserAgent:   input.UserAgent,
+		Honeypot:    input.Honeypot,
+		RenderedAt:  input.RenderedAt,
+	})
+	if result.Spam {
+		reason := strings.Join(result.Reasons(), "; ")
+		if len(reason) > maxSpamReasonLen {
+			reason = strings.ToValidUTF8(reason[:maxSpamReasonLen], "")
+		}
+		c.Status = entity.CommentStatusSpam
+		c.SpamReason = &reason
+		s.log.Info("comment classified as spam",
+			logger.Int("post_id", int(input.PostID)),
+			logger.Int("score", result.Score),
+			logger.String("reason", reason),
+		)
+	}
+
+	created, err := s.cr.Create(ctx, c)
+	if err != nil {
+		return nil, err
+	}
+
+	switch created.Status {
+	case entity.CommentStatusApproved:
+		s.notifyReply(ctx, created)
+	case entity.CommentStatusPending:
+		s.notifyPending(ctx, created)
+	}
+	if input.NotifyOnReply && input.User == nil && created.Status != entity.CommentStatusSpam {
+		s.requestReplyNotify(ctx, created)
+	}
+
+	out := &CreateCommentResult{Comment: created}
+	// A trusted guest keeps the token of their approved comment; any other
+	// guest gets one for this comment, which counts once it is approved.
+	if created.UserID == nil && status != entity.CommentStatusApproved {
+		out.GuestToken = s.guestToken(created)
+	}
+	return out, nil
+}
+
+// AdminGetComments returns the moderation queue filtered by status, post and keyword.
+func (s *commentService) AdminGetComments(ctx context.Context, user contextx.User, filter *AdminCommentFilter, page, pageSize int) ([]*entity.Comment, int, error) {
+	if err := s.authz.Authorize(ctx, user.ID, user.Role, authz.ResourceComment, authz.ActionRead, nil); err != nil {
+		return nil, 0, err
+	}
+
+	count, err := s.cr.CountAll(ctx, filter.Status, filter.PostID, filter.Keyword)
+	if err != nil {
+		return nil, 0, err
+	}
+	cs, err := s.cr.ListAll(ctx, filter.Status, filter.PostID, filter.Keyword, page, pageSize)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	return cs, count, nil
+}
+
+// UpdateComment replaces the content of a comment.
+func (s *commentService) UpdateComment(ctx context.Context, user contextx.User, id uint, content string) (*entity.Comment, error) {
+	if err := s.authz.Authorize(ctx, user.ID, user.Role, authz.ResourceComment, authz.ActionUpdate, &id); err != nil {
+		return nil, err
+	}
+
+	content = strings.TrimSpace(content)
+	if content == "" {
+		return nil, errx.New(errx.CodeInvalidParam, fmt.Errorf("comment content is empty"))
+	}
+
+	if err := s.cr.UpdateContent(ctx, id, content); err != nil {
+		return nil, err
+	}
+
+	return s.cr.GetByID(ctx, id)
+}
+
+// ModerateComments applies a moderation action to one or more comments.
+//
+// Every comment is authorized individually so ownership rules apply per item.
+func (s *commentService) ModerateComments(ctx context.Context, user contextx.User, ids []uint, action CommentAction) error {
+	if len(ids) == 0 {
+		return errx.New(errx.CodeInvalidParam, fmt.Errorf("no comments selected"))
+	}
+
+	permission := authz.ActionUpdate
+	if action == CommentActionDelete {
+		permission = authz.ActionDelete
+	}
+	for _, id := range ids {
+		if err := s.authz.Authorize(ctx, user.ID, user.Role, authz.ResourceComment, permission, &id); err != nil {
+			return err
+		}
+	}
+
+	switch action {
+	case CommentActionApprove:
+		return s.approveComments(ctx, ids)
+	case CommentActionReject:
+		return s.cr.UpdateStatusBatch(ctx, ids, entity.CommentStatusRejected)
+	case CommentActionSpam:
+		if err := s.cr.UpdateStatusBatch(ctx, ids, entity.CommentStatusSpam); err != nil {
+			return err
+		}
+		s.reportSpam(ctx, ids)
+		return nil
+	case CommentActionDelete:
+		return s.cr.DeleteBatch(ctx, ids)
+	default:
+		return errx.New(errx.CodeInvalidParam, fmt.Errorf("unknown comment action: %s", action))
+	}
+}
+
+// approveComments publishes comments and notifies the parent authors of
+// replies that were not visible before.
+func (s *commentService) approveComments(ctx context.Context, ids []uint) error {
+	cs, err := s.cr.ListByIDs(ctx, ids)
+	if err != nil {
+		return err
+	}
+
+	if err := s.cr.UpdateStatusBatch(ctx, ids, entity.CommentStatusApproved); err != nil {
+		return err
+	}
+
+	for _, c := range cs {
+		if c.Status != entity.CommentStatusApproved {
+			s.notifyReply(ctx, c)
+		}
+	}
+	return nil
+}
+
+// reportSpam feeds comments marked as spam by a moderator into the
+// reputation counters so later comments from the same source score higher.
+func (s *commentService) reportSpam(ctx context.Context, ids []uint) {
+	cs, err := s.cr.ListByIDs(ctx, ids)
+	if err != nil {
+		s.log.Warn("load spam comments failed", logger.Err(err))
+		return
+	}
+	for _, c := range cs {
+		email := ""
+		if c.AuthorEmail != nil {
+			email = *c.AuthorEmail
+		}
+		s.spam.ReportSpam(ctx, c.IP, email)
+	}
+}
+
+// initialStatus decides whether a new comment is published right away.
+//
+// Registered users and guests presenting the token of an approved comment
+// of theirs skip the moderation queue; all other guest comments start as
+// pending.
+func (s *commentService) initialStatus(ctx context.Context, c *entity.Comment, guestToken string) (entity.CommentStatus, error) {
+	if c.UserID != nil {
+		return entity.CommentStatusApproved, nil
+	}
+
+	trusted, err := s.guestTrusted(ctx, c, guestToken)
+	if err != nil {
+		return "", err
+	}
+	if trusted {
+		return entity.CommentStatusApproved, nil
+	}
+	return entity.CommentStatusPending, nil
+}
+
+// fillAuthor populates author fields from the logged-in user or the guest input.
+func (s *commentService) fillAuthor(ctx context.Context, c *entity.Comment, input *CreateCommentInput) error {
+	if input.User != nil {
+		u, err := s.userRepo.GetByID(ctx, input.User.ID)
+		if err != nil {
+			return err
+		}
+		c.UserID = &u.ID
+		c.AuthorName = u.Username
+		c.AuthorEmail = &u.Email
+		c.AuthorAvatar = u.Avatar
+		return nil
+	}
+
+	name := trimmedOrNil(input.AuthorName)
+	email := trimmedOrNil(input.AuthorEmail)
+	if name == nil || email == nil {
+		return errx.New(errx.CodeInvalidParam, fmt.Errorf("guest comments require name and email"))
+	}
+
+	lowered := strings.ToLower(*email)
+	c.AuthorName = *name
+	c.AuthorEmail = &lowered
+	c.AuthorWebsite = trimmedOrNil(input.AuthorWebsite)
+	return nil
+}
+
+// ensurePostPublished returns NotFound unless the post exists and is published.
+func (s *commentService) ensurePostPublished(ctx context.Context, postID uint) error {
+	exists, err := s.pr.ExistsPublished(ctx, postID)
+	if err != nil {
+		return err
+	}
+	if !exists {
+		return errx.New(errx.CodeNotFound, fmt.Errorf("post %d not found", postID))
+	}
+	return nil
+}
+
+// buildCommentThreads nests replies under their parents.
+//
+// Replies must be ordered oldest first so parents are indexed before their
+// children. A reply whose parent is not visible is attached to its root.
+func buildCommentThreads(roots, replies []*entity.Comment) []*entity.Comment {
+	index := make(map[uint]*entity.Comment, len(roots)+len(replies))
+	for _, root := range roots {
+		index[root.ID] = root
+	}
+
+	for _, reply := range replies {
+		index[reply.ID] = reply
+
+		parent, ok := index[*reply.ParentID]
+		if !ok {
+			parent, ok = index[*reply.RootID]
+		}
+		if !ok {
+			continue
+		}
+		parent.Replies = append(parent.Replies, reply)
+	}
+
+	return roots
+}
+
+// trimmedOrNil returns nil for nil or blank strings, otherwise the trimmed value.
+func trimmedOrNil(s *string) *string {
+	if s == nil {
+		return nil
+	}
+	v := strings.TrimSpace(*s)
+	if v == "" {
+		return nil
+	}
+	return &v
+}
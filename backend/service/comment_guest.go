@@ -0,0 +1,104 @@
+package service
+
+import (
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/base64"
+	"strconv"
+	"strings"
+
+	"blog-server/entity"
+	"blog-server/logger"
+	"blog-server/pkg/errx"
+)
+
+// A guest token proves that the browser presenting it wrote a particular
+// guest comment. It is handed out with every guest comment and lets later
+// comments skip moderation once that comment is approved, as long as they
+// are left under the same name and email. Knowing the email of someone whose
+// comments were approved is therefore not enough to post as them.
+
+// guestTrusted reports whether a guest comment skips moderation.
+//
+// Emails of registered users are never trusted from guests: their owners
+// sign in to comment.
+func (s *commentService) guestTrusted(ctx context.Context, c *entity.Comment, token string) (bool, error) {
+	if s.guestSecret == "" || token == "" {
+		return false, nil
+	}
+
+	id, ok := verifyGuestToken(s.guestSecret, token, c.AuthorName, *c.AuthorEmail)
+	if !ok {
+		return false, nil
+	}
+
+	registered, err := s.userRepo.ExistsByEmailFold(ctx, *c.AuthorEmail)
+	if err != nil {
+		return false, err
+	}
+	if registered {
+		return false, nil
+	}
+
+	earlier, err := s.cr.GetByID(ctx, id)
+	if err != nil {
+		if errx.ToAppError(err).Code == errx.CodeNotFound {
+			return false, nil
+		}
+		return false, err
+	}
+	if earlier.UserID != nil || earlier.Status != entity.CommentStatusApproved ||
+		earlier.AuthorName != c.AuthorName || earlier.AuthorEmail == nil ||
+		!strings.EqualFold(*earlier.AuthorEmail, *c.AuthorEmail) {
+		return false, nil
+	}
+
+	s.log.Debug("guest comment trusted", logger.Int("earlier_comment_id", int(id)))
+	return true, nil
+}
+
+// guestToken returns the guest token of a created comment, or "" when
+// guest tokens are disabled or the comment is not a guest comment.
+func (s *commentService) guestToken(c *entity.Comment) string {
+	if s.guestSecret == "" || c.UserID != nil || c.AuthorEmail == nil {
+		return ""
+	}
+	return signGuestToken(s.guestSecret, c.ID, c.AuthorName, *c.AuthorEmail)
+}
+
+// signGuestToken returns a token of the form "<comment ID>.<signature>",
+// the signature base64url encoded. It binds the comment to the name and
+// email it was left under.
+func signGuestToken(secret string, commentID uint, name, email string) string {
+	id := strconv.FormatUint(uint64(commentID), 10)
+	return id + "." + base64.RawURLEncoding.EncodeToString(guestMAC(secret, id, name, email))
+}
+
+// verifyGuestToken checks the token against the name and email of a new
+// comment and returns the ID of the comment it was issued for.
+func verifyGuestToken(secret, token, name, email string) (uint, bool) {
+	id, sig, ok := strings.Cut(token, ".")
+	if !ok {
+		return 0, false
+	}
+	commentID, err := strconv.ParseUint(id, 10, 64)
+	if err != nil {
+		return 0, false
+	}
+	mac, err := base64.RawURLEncoding.DecodeString(sig)
+	if err != nil {
+		return 0, false
+	}
+	if !hmac.Equal(mac, guestMAC(secret, id, name, email)) {
+		return 0, false
+	}
+	return uint(commentID), true
+}
+
+// guestMAC signs a comment ID together with the name and email of its author.
+func guestMAC(secret, id, name, email string) []byte {
+	h := hmac.New(sha256.New, []byte(secret))
+	h.Write([]byte("comment-guest:" + id + "\x00" + name + "\x00" + strings.ToLower(email)))
+	return h.Sum(nil)
+}
@@ -0,0 +1,99 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"testing"
+
+	"blog-server/entity"
+	"blog-server/logger"
+	"blog-server/pkg/errx"
+	"blog-server/repository"
+)
+
+// guestComments is an in-memory repository.CommentRepo.
+type guestComments struct {
+	repository.CommentRepo
+	comments map[uint]*entity.Comment
+}
+
+func (r *guestComments) GetByID(_ context.Context, id uint) (*entity.Comment, error) {
+	c, ok := r.comments[id]
+	if !ok {
+		return nil, errx.New(errx.CodeNotFound, errors.New("comment not found"))
+	}
+	return c, nil
+}
+
+// guestUsers is an in-memory repository.UserRepo holding registered emails.
+type guestUsers struct {
+	repository.UserRepo
+	emails []string
+}
+
+func (r *guestUsers) ExistsByEmailFold(_ context.Context, email string) (bool, error) {
+	for _, e := range r.emails {
+		if strings.EqualFold(e, email) {
+			return true, nil
+		}
+	}
+	return false, nil
+}
+
+func TestGuestTrusted(t *testing.T) {
+	const secret = "guest-secret"
+	email := "guest@example.com"
+	adminEmail := "admin@blog.example"
+	userID := uint(1)
+
+	svc := &commentService{
+		log: logger.NewNop(),
+		cr: &guestComments{comments: map[uint]*entity.Comment{
+			1: {ID: 1, AuthorName: "Guest", AuthorEmail: &email, Status: entity.CommentStatusApproved},
+			2: {ID: 2, AuthorName: "Guest", AuthorEmail: &email, Status: entity.CommentStatusPending},
+			3: {ID: 3, AuthorName: "Admin", AuthorEmail: &adminEmail, Status: entity.CommentStatusApproved},
+			4: {ID: 4, UserID: &userID, AuthorName: "Guest", AuthorEmail: &email, Status: entity.CommentStatusApproved},
+		}},
+		userRepo:    &guestUsers{emails: []string{"Admin@blog.example"}},
+		guestSecret: secret,
+	}
+
+	tests := []struct {
+		name  string
+		token string
+		as    string
+		email string
+		want  bool
+	}{
+		{name: "approved comment", token: signGuestToken(secret, 1, "Guest", email), as: "Guest", email: "GUEST@example.com", want: true},
+		{name: "no token", token: "", as: "Guest", email: email},
+		{name: "other name", token: signGuestToken(secret, 1, "Guest", email), as: "Admin", email: email},
+		{name: "other email", token: signGuestToken(secret, 1, "Guest", email), as: "Guest", email: "other@example.com"},
+		{name: "forged", token: signGuestToken("other-secret", 1, "Guest", email), as: "Guest", email: email},
+		{name: "tampered id", token: "1" + signGuestToken(secret, 1, "Guest", email), as: "Guest", email: email},
+		{name: "pending comment", token: signGuestToken(secret, 2, "Guest", email), as: "Guest", email: email},
+		{name: "missing comment", token: signGuestToken(secret, 9, "Guest", email), as: "Guest", email: email},
+		{name: "registered email", token: signGuestToken(secret, 3, "Admin", adminEmail), as: "Admin", email: adminEmail},
+		{name: "registered author", token: signGuestToken(secret, 4, "Guest", email), as: "Guest", email: email},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			email := strings.ToLower(tt.email)
+			c := &entity.Comment{AuthorName: tt.as, AuthorEmail: &email}
+			got, err := svc.guestTrusted(context.Background(), c, tt.token)
+			if err != nil {
+				t.Fatalf("guestTrusted: %v", err)
+			}
+			if got != tt.want {
+				t.Errorf("guestTrusted = %v, want %v", got, tt.want)
+			}
+		})
+	}
+
+	svc.guestSecret = ""
+	c := &entity.Comment{AuthorName: "Guest", AuthorEmail: &email}
+	if got, _ := svc.guestTrusted(context.Background(), c, signGuestToken("", 1, "Guest", email)); got {
+		t.Error("guest trusted without a secret")
+	}
+}
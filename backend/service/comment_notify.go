@@ -0,0 +1,334 @@
+package service
+
+import (
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/base64"
+	"fmt"
+	"net/url"
+	"strconv"
+	"strings"
+	"time"
+
+	"blog-server/entity"
+	"blog-server/logger"
+	"blog-server/pkg/errx"
+)
+
+// commentMailTTL is how long comment notifications are worth delivering.
+const commentMailTTL = 3 * 24 * time.Hour
+
+// CommentReplyMailData is the data of the comment_reply.html template.
+type CommentReplyMailData struct {
+	Title          string
+	PostTitle      string
+	PostURL        string
+	RecipientName  string
+	ParentContent  string
+	ReplyAuthor    string
+	ReplyContent   string
+	UnsubscribeURL string
+}
+
+// CommentPendingMailData is the data of the comment_pending.html template.
+type CommentPendingMailData struct {
+	Title         string
+	PostTitle     string
+	PostURL       string
+	AuthorName    string
+	AuthorEmail   string
+	Content       string
+	ModerationURL string
+}
+
+// CommentSubscribeMailData is the data of the comment_subscribe.html template.
+type CommentSubscribeMailData struct {
+	Title         string
+	PostTitle     string
+	PostURL       string
+	RecipientName string
+	Content       string
+	ConfirmURL    string
+}
+
+// SubscriptionAddress returns the email address carried by a signed
+// subscription confirmation token.
+func (s *commentService) SubscriptionAddress(token string) (string, error) {
+	_, email, err := verifySubscribeToken(s.notifyCfg.UnsubscribeSecret, token)
+	return email, err
+}
+
+// ConfirmSubscription turns on reply notifications for the comment carried
+// by a signed token and returns the email address they go to.
+func (s *commentService) ConfirmSubscription(ctx context.Context, token string) (string, error) {
+	id, email, err := verifySubscribeToken(s.notifyCfg.UnsubscribeSecret, token)
+	if err != nil {
+		return "", err
+	}
+	if err := s.cr.EnableReplyNotify(ctx, id, email); err != nil {
+		if errx.ToAppError(err).Code == errx.CodeNotFound {
+			return "", errx.New(errx.CodeInvalidParam, fmt.Errorf("invalid subscription token"))
+		}
+		return "", err
+	}
+	return email, nil
+}
+
+// UnsubscribeAddress returns the email address carried by a signed unsubscribe token.
+func (s *commentService) UnsubscribeAddress(token string) (string, error) {
+	return verifyUnsubscribeToken(s.notifyCfg.UnsubscribeSecret, token)
+}
+
+// Unsubscribe turns off reply notifications for the email address carried by
+// a signed token and returns that address.
+func (s *commentService) Unsubscribe(ctx context.Context, token string) (string, error) {
+	email, err := verifyUnsubscribeToken(s.notifyCfg.UnsubscribeSecret, token)
+	if err != nil {
+		return "", err
+	}
+	if err := s.cr.DisableReplyNotify(ctx, email); err != nil {
+		return "", err
+	}
+	return email, nil
+}
+
+// notifyReply emails the author of the parent comment about an approved reply.
+//
+// Only authors who opted in are notified, and never about their own replies.
+func (s *commentService) notifyReply(ctx context.Context, reply *entity.Comment) {
+	if !s.notifyCfg.Enabled || s.notifyCfg.UnsubscribeSecret == "" || reply.ParentID == nil {
+		return
+	}
+
+	parent, err := s.cr.GetByID(ctx, *reply.ParentID)
+	if err != nil {
+		s.log.Warn("load parent comment for notification failed", logger.Err(err))
+		return
+	}
+	if !parent.NotifyOnReply || parent.AuthorEmail == nil {
+		return
+	}
+	if reply.AuthorEmail != nil && strings.EqualFold(*reply.AuthorEmail, *parent.AuthorEmail) {
+		return
+	}
+
+	post, err := s.pr.GetByID(ctx, reply.PostID)
+	if err != nil {
+		s.log.Warn("load post for notification failed", logger.Err(err))
+		return
+	}
+
+	token := signUnsubscribeToken(s.notifyCfg.UnsubscribeSecret, *parent.AuthorEmail)
+	unsubscribeURL := s.domain + "/api/v1/comments/unsubscribe?token=" + url.QueryEscape(token)
+	data := &CommentReplyMailData{
+		Title:          "New Reply to Your Comment",
+		PostTitle:      post.Title,
+		PostURL:        s.postURL(post),
+		RecipientName:  parent.AuthorName,
+		ParentContent:  parent.Content,
+		ReplyAuthor:    reply.AuthorName,
+		ReplyContent:   reply.Content,
+		UnsubscribeURL: unsubscribeURL,
+	}
+
+	subject := fmt.Sprintf("[Immortal's Blog] %s replied to your comment", reply.AuthorName)
+	if err := s.mail.Send(ctx, &SendMailInput{
+		To:       *parent.AuthorEmail,
+		Subject:  subject,
+		Template: "comment_reply.html",
+		Data:     data,
+		// One-click unsubscribe (RFC 8058): mail clients POST to the link.
+		Headers: map[string]string{
+			"List-Unsubscribe":      "<" + unsubscribeURL + ">",
+			"List-Unsubscribe-Post": "List-Unsubscribe=One-Click",
+		},
+		ValidFor: commentMailTTL,
+	}); err != nil {
+		s.log.Warn("queue reply notification failed", logger.Err(err))
+	}
+}
+
+// requestReplyNotify asks a guest to confirm reply notifications for their
+// comment. Guest emails are not verified, so notifications only start once
+// the owner of the address follows the link.
+func (s *commentService) requestReplyNotify(ctx context.Context, c *entity.Comment) {
+	if !s.notifyCfg.Enabled || s.notifyCfg.UnsubscribeSecret == "" || c.AuthorEmail == nil {
+		return
+	}
+
+	post, err := s.pr.GetByID(ctx, c.PostID)
+	if err != nil {
+		s.log.Warn("load post for notification failed", logger.Err(err))
+		return
+	}
+
+	token := signSubscribeToken(s.notifyCfg.UnsubscribeSecret, c.ID, *c.AuthorEmail)
+	data := &CommentSubscribeMailData{
+		Title:         "Confirm Reply Notifications",
+		PostTitle:     post.Title,
+		PostURL:       s.postURL(post),
+		RecipientName: c.AuthorName,
+		Content:       c.Content,
+		ConfirmURL:    s.domain + "/api/v1/comments/subscribe?token=" + url.QueryEscape(token),
+	}
+
+	if err := s.mail.Send(ctx, &SendMailInput{
+		To:       *c.AuthorEmail,
+		Subject:  "[Immortal's Blog] Confirm reply notifications",
+		Template: "comment_subscribe.html",
+		Data:     data,
+		ValidFor: commentMailTTL,
+	}); err != nil {
+		s.log.Warn("queue subscription confirmation failed", logger.Err(err))
+	}
+}
+
+// notifyPending emails the post author and all admins about a comment awaiting moderation.
+func (s *commentService) notifyPending(ctx context.Context, c *entity.Comment) {
+	if !s.notifyCfg.Enabled {
+		return
+	}
+
+	post, err := s.pr.GetByID(ctx, c.PostID)
+	if err != nil {
+		s.log.Warn("load post for notification failed", logger.Err(err))
+		return
+	}
+
+	recipients := make(map[string]struct{})
+	if author, err := s.userRepo.GetByID(ctx, post.UserID); err == nil {
+		recipients[strings.ToLower(author.Email)] = struct{}{}
+	} else {
+		s.log.Warn("load post author for notification failed", logger.Err(err))
+	}
+
+	admins, err := s.userRepo.ListByRole(ctx, entity.UserRoleAdmin)
+	if err != nil {
+		s.log.Warn("load admins for notification failed", logger.Err(err))
+	}
+	for _, admin := range admins {
+		recipients[strings.ToLower(admin.Email)] = struct{}{}
+	}
+
+	data := &CommentPendingMailData{
+		Title:         "New Comment Awaiting Moderation",
+		PostTitle:     post.Title,
+		PostURL:       s.postURL(post),
+		AuthorName:    c.AuthorName,
+		Content:       c.Content,
+		ModerationURL: s.domain + "/admin/comments",
+	}
+	if c.AuthorEmail != nil {
+		data.AuthorEmail = *c.AuthorEmail
+	}
+
+	subject := fmt.Sprintf("[Immortal's Blog] New comment on %q awaits moderation", post.Title)
+	for to := range recipients {
+		if err := s.mail.Send(ctx, &SendMailInput{
+			To:       to,
+			Subject:  subject,
+			Template: "comment_pending.html",
+			Data:     data,
+			ValidFor: commentMailTTL,
+		}); err != nil {
+			s.log.Warn("queue pending notification failed", logger.Err(err))
+		}
+	}
+}
+
+// postURL returns the public URL of a post.
+func (s *commentService) postURL(post *entity.Post) string {
+	return s.domain + postPath(post)
+}
+
+// signUnsubscribeToken returns a token of the form "<email>.<signature>",
+// both parts base64url encoded.
+func signUnsubscribeToken(secret, email string) string {
+	email = strings.ToLower(email)
+	payload := base64.RawURLEncoding.EncodeToString([]byte(email))
+	return payload + "." + base64.RawURLEncoding.EncodeToString(unsubscribeMAC(secret, email))
+}
+
+// verifyUnsubscribeToken checks the token signature and returns the email it carries.
+func verifyUnsubscribeToken(secret, token string) (string, error) {
+	invalid := errx.New(errx.CodeInvalidParam, fmt.Errorf("invalid unsubscribe token"))
+	if secret == "" {
+		return "", invalid
+	}
+
+	payload, sig, ok := strings.Cut(token, ".")
+	if !ok {
+		return "", invalid
+	}
+	email, err := base64.RawURLEncoding.DecodeString(payload)
+	if err != nil {
+		return "", invalid
+	}
+	mac, err := base64.RawURLEncoding.DecodeString(sig)
+	if err != nil {
+		return "", invalid
+	}
+	if !hmac.Equal(mac, unsubscribeMAC(secret, string(email))) {
+		return "", invalid
+	}
+
+	return string(email), nil
+}
+
+// unsubscribeMAC signs an email address for unsubscribe links.
+func unsubscribeMAC(secret, email string) []byte {
+	h := hmac.New(sha256.New, []byte(secret))
+	h.Write([]byte("comment-unsubscribe:" + email))
+	return h.Sum(nil)
+}
+
+// signSubscribeToken returns a token of the form "<comment ID>:<email>.<signature>",
+// both parts base64url encoded.
+func signSubscribeToken(secret string, commentID uint, email string) string {
+	payload := strconv.FormatUint(uint64(commentID), 10) + ":" + strings.ToLower(email)
+	return base64.RawURLEncoding.EncodeToString([]byte(payload)) + "." +
+		base64.RawURLEncoding.EncodeToString(subscribeMAC(secret, payload))
+}
+
+// verifySubscribeToken checks the token signature and returns the comment ID
+// and email it carries.
+func verifySubscribeToken(secret, token string) (uint, string, error) {
+	invalid := errx.New(errx.CodeInvalidParam, fmt.Errorf("invalid subscription token"))
+	if secret == "" {
+		return 0, "", invalid
+	}
+
+	encoded, sig, ok := strings.Cut(token, ".")
+	if !ok {
+		return 0, "", invalid
+	}
+	payload, err := base64.RawURLEncoding.DecodeString(encoded)
+	if err != nil {
+		return 0, "", invalid
+	}
+	mac, err := base64.RawURLEncoding.DecodeString(sig)
+	if err != nil {
+		return 0, "", invalid
+	}
+	if !hmac.Equal(mac, subscribeMAC(secret, string(payload))) {
+		return 0, "", invalid
+	}
+
+	id, email, ok := strings.Cut(string(payload), ":")
+	if !ok {
+		return 0, "", invalid
+	}
+	commentID, err := strconv.ParseUint(id, 10, 64)
+	if err != nil {
+		return 0, "", invalid
+	}
+	return uint(commentID), email, nil
+}
+
+// subscribeMAC signs a comment ID and email for subscription confirmation links.
+func subscribeMAC(secret, payload string) []byte {
+	h := hmac.New(sha256.New, []byte(secret))
+	h.Write([]byte("comment-subscribe:" + payload))
+	return h.Sum(nil)
+}
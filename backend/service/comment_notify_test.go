@@ -0,0 +1,35 @@
+package service
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestSubscribeToken(t *testing.T) {
+	const secret = "notify-secret"
+	token := signSubscribeToken(secret, 42, "Guest@Example.com")
+
+	id, email, err := verifySubscribeToken(secret, token)
+	if err != nil {
+		t.Fatalf("verifySubscribeToken: %v", err)
+	}
+	if id != 42 || email != "guest@example.com" {
+		t.Errorf("got %d, %q, want 42, %q", id, email, "guest@example.com")
+	}
+
+	payload, sig, _ := strings.Cut(token, ".")
+	for name, bad := range map[string]string{
+		"other secret":      signSubscribeToken("other", 42, "guest@example.com"),
+		"no secret":         signSubscribeToken("", 42, "guest@example.com"),
+		"unsubscribe token": signUnsubscribeToken(secret, "guest@example.com"),
+		"tampered payload":  signSubscribeToken(secret, 43, "guest@example.com")[:len(payload)] + "." + sig,
+		"malformed":         payload,
+	} {
+		if _, _, err := verifySubscribeToken(secret, bad); err == nil {
+			t.Errorf("%s: token accepted", name)
+		}
+	}
+	if _, _, err := verifySubscribeToken("", signSubscribeToken("", 42, "guest@example.com")); err == nil {
+		t.Error("token accepted without a secret")
+	}
+}
@@ -0,0 +1,183 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"blog-server/cache"
+	"blog-server/pkg/errx"
+)
+
+// memoryCache is an in-memory cache.CacheClient for the operations the
+// tested services use. Other operations panic.
+type memoryCache struct {
+	cache.CacheClient
+
+	mu     sync.Mutex
+	data   map[string]string
+	hashes map[string]map[string]string
+	zsets  map[string]map[string]float64
+}
+
+func newMemoryCache() *memoryCache {
+	return &memoryCache{
+		data:   make(map[string]string),
+		hashes: make(map[string]map[string]string),
+		zsets:  make(map[string]map[string]float64),
+	}
+}
+
+func (c *memoryCache) Get(_ context.Context, key string) (string, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	v, ok := c.data[key]
+	if !ok {
+		return "", errx.New(errx.CodeNotFound, errors.New("cache miss"))
+	}
+	return v, nil
+}
+
+func (c *memoryCache) Set(_ context.Context, key, value string, _ time.Duration) error {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.data[key] = value
+	return nil
+}
+
+func (c *memoryCache) SetNX(_ context.Context, key, value string, _ time.Duration) (bool, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if _, ok := c.data[key]; ok {
+		return false, nil
+	}
+	c.data[key] = value
+	return true, nil
+}
+
+func (c *memoryCache) IncrWithTTL(_ context.Context, key string, _ time.Duration) (int64, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	n, _ := strconv.ParseInt(c.data[key], 10, 64)
+	n++
+	c.data[key] = strconv.FormatInt(n, 10)
+	return n, nil
+}
+
+func (c *memoryCache) Delete(_ context.Context, key string) error {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	delete(c.data, key)
+	return nil
+}
+
+func (c *memoryCache) DeleteIfEqual(_ context.Context, key, value string) (bool, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if v, ok := c.data[key]; !ok || v != value {
+		return false, nil
+	}
+	delete(c.data, key)
+	return true, nil
+}
+
+func (c *memoryCache) HSet(_ context.Context, key, field, value string) error {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if c.hashes[key] == nil {
+		c.hashes[key] = make(map[string]string)
+	}
+	c.hashes[key][field] = value
+	return nil
+}
+
+func (c *memoryCache) HGet(_ context.Context, key, field string) (string, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	v, ok := c.hashes[key][field]
+	if !ok {
+		return "", errx.New(errx.CodeNotFound, errors.New("cache miss"))
+	}
+	return v, nil
+}
+
+func (c *memoryCache) HGetAll(_ context.Context, key string) (map[string]string, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	all := make(map[string]string, len(c.hashes[key]))
+	for k, v := range c.hashes[key] {
+		all[k] = v
+	}
+	return all, nil
+}
+
+func (c *memoryCache) HDel(_ context.Context, key string, fields ...string) error {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	for _, f := range fields {
+		delete(c.hashes[key], f)
+	}
+	return nil
+}
+
+func (c *memoryCache) ZAdd(_ context.Context, key string, score float64, member string) error {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if c.zsets[key] == nil {
+		c.zsets[key] = make(map[string]float64)
+	}
+	c.zsets[key][member] = score
+	return nil
+}
+
+func (c *memoryCache) ZRangeByScore(_ context.Context, key string, min, max float64, count int64) ([]string, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	zset := c.zsets[key]
+	var members []string
+	for m, score := range zset {
+		if score >= min && score <= max {
+			members = append(members, m)
+		}
+	}
+	sort.Slice(members, func(i, j int) bool {
+		return zset[members[i]] < zset[members[j]]
+	})
+	if count > 0 && int64(len(members)) > count {
+		members = members[:count]
+	}
+	return members, nil
+}
+
+func (c *memoryCache) ZRem(_ context.Context, key string, members ...string) error {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	for _, m := range members {
+		delete(c.zsets[key], m)
+	}
+	return nil
+}
+
+// keys returns the keys starting with prefix.
+func (c *memoryCache) keys(prefix string) []string {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	var keys []string
+	for k := range c.data {
+		if strings.HasPrefix(k, prefix) {
+			keys = append(keys, k)
+		}
+	}
+	return keys
+}
+
+// directTx runs transactions without a database.
+type directTx struct{}
+
+func (directTx) WithTx(ctx context.Context, fn func(ctx context.Context) error) error {
+	return fn(ctx)
+}
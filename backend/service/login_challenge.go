@@ -0,0 +1,65 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"time"
+
+	"blog-server/pkg/errx"
+	"blog-server/utils"
+)
+
+const (
+	loginChallengeKeyPrefix  = "blog:2fa:challenge:"
+	loginChallengeTTL        = 5 * time.Minute
+	loginChallengeMaxAttempt = 5
+)
+
+// createLoginChallenge stores a short-lived challenge for a user who passed
+// the password check and returns its token.
+func (s *authService) createLoginChallenge(ctx context.Context, userID uint) (string, error) {
+	token := utils.RandomString(43, "ABCDEFGHIJKLMNOPQRSTUVWXYZabcdefghijklmnopqrstuvwxyz0123456789")
+	if err := s.rc.Set(ctx, loginChallengeKeyPrefix+token, strconv.FormatUint(uint64(userID), 10), loginChallengeTTL); err != nil {
+		return "", errx.New(errx.CodeInternalError, err)
+	}
+	return token, nil
+}
+
+// consumeLoginChallenge resolves a challenge and runs verify for its user.
+//
+// The challenge is deleted on success and after too many failed attempts,
+// so a stolen password alone cannot be used to brute force the second factor.
+func (s *authService) consumeLoginChallenge(ctx context.Context, token string, verify func(userID uint) error) (uint, error) {
+	key := loginChallengeKeyPrefix + token
+	invalid := errx.New(errx.CodeUnauthorized, fmt.Errorf("login challenge expired or invalid"))
+
+	raw, err := s.rc.Get(ctx, key)
+	if err != nil {
+		if errx.ToAppError(err).Code == errx.CodeNotFound {
+			return 0, invalid
+		}
+		return 0, errx.New(errx.CodeInternalError, err)
+	}
+	userID, err := strconv.ParseUint(raw, 10, 64)
+	if err != nil {
+		return 0, invalid
+	}
+
+	attempts, err := s.rc.IncrWithTTL(ctx, key+":attempts", loginChallengeTTL)
+	if err != nil {
+		return 0, errx.New(errx.CodeInternalError, err)
+	}
+	if attempts > loginChallengeMaxAttempt {
+		_ = s.rc.Delete(ctx, key)
+		return 0, invalid
+	}
+
+	if err := verify(uint(userID)); err != nil {
+		return 0, err
+	}
+
+	_ = s.rc.Delete(ctx, key)
+	_ = s.rc.Delete(ctx, key+":attempts")
+	return uint(userID), nil
+}
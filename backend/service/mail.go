@@ -2,61 +2,416 @@ package service
 
 import (
 	"bytes"
+	"context"
+	"encoding/json"
 	"fmt"
 	"html/template"
+	"math"
+	"sort"
+	"time"
 
+	"blog-server/authz"
+	"blog-server/cache"
 	"blog-server/config"
+	"blog-server/contextx"
+	"blog-server/entity"
+	"blog-server/logger"
+	"blog-server/mailer"
 	"blog-server/pkg/errx"
 	"blog-server/templates"
 
-	"gopkg.in/gomail.v2"
+	"github.com/google/uuid"
 )
 
+const (
+	// mailQueueKey orders pending message IDs by their next attempt time (unix ms).
+	mailQueueKey = "blog:mail:queue"
+	// mailMessagesKey holds the pending messages by ID.
+	mailMessagesKey = "blog:mail:messages"
+	// mailDeadKey holds messages that exhausted their attempts by ID.
+	mailDeadKey = "blog:mail:dead"
+	// mailLockPrefix guards a message against concurrent delivery by several workers.
+	mailLockPrefix = "blog:mail:lock:"
+
+	mailBatchSize = 50
+	mailLockTTL   = 2 * time.Minute
+
+	defaultMailMaxAttempts         = 6
+	defaultMailBaseBackoff         = 30 * time.Second
+	defaultMailMaxBackoff          = time.Hour
+	defaultMailDeadLetterRetention = 7 * 24 * time.Hour
+)
+
+// SendMailInput groups all parameters for queueing an email.
+type SendMailInput struct {
+	To       string
+	Subject  string
+	Template string
+	Data     any
+	// Headers are extra MIME headers, e.g. List-Unsubscribe.
+	Headers map[string]string
+	// ValidFor limits how long delivery is attempted, e.g. to the lifetime of
+	// a code the email carries. Zero retries until the attempts run out.
+	ValidFor time.Duration
+}
+
 // MailService defines the interface for email sending operations.
+//
+// Emails are rendered immediately but delivered by a background worker, so
+// callers never wait on SMTP and transient failures are retried.
 type MailService interface {
-	Send(to, subject, templateName string, data any) error
+	Send(ctx context.Context, input *SendMailInput) error
+	ProcessQueue(ctx context.Context) error
+	PurgeDeadLetters(ctx context.Context) error
+
+	ListDeadLetters(ctx context.Context, user contextx.User) ([]*entity.MailMessage, error)
+	RetryDeadLetter(ctx context.Context, user contextx.User, id string) error
+	DeleteDeadLetter(ctx context.Context, user contextx.User, id string) error
 }
 
 // mailService implements the MailService interface.
 type mailService struct {
-	dialer   *gomail.Dialer
-	template *template.Template
-	from     string
+	transport mailer.Transport
+	template  *template.Template
+	from      string
+	queueCfg  config.MailQueueConfig
+
+	rc    cache.CacheClient
+	log   logger.Logger
+	authz *authz.Authorizer
 }
 
 // NewEmailService creates and returns a new MailService instance.
-func NewEmailService() (MailService, error) {
-	mailCfg := config.Get().Email
-	dialer := gomail.NewDialer(mailCfg.Host, mailCfg.Port, mailCfg.Username, mailCfg.Password)
+func NewEmailService(
+	cfg *config.Config,
+	log logger.Logger,
+	transport mailer.Transport,
+	rc cache.CacheClient,
+	authz *authz.Authorizer,
+) (MailService, error) {
+	mailCfg := cfg.Email
 
 	t, err := template.ParseFS(templates.FS, "*.html")
 	if err != nil {
 		return nil, errx.New(errx.CodeInternalError, err)
 	}
 
+	queueCfg := mailCfg.Queue
+	if queueCfg.MaxAttempts <= 0 {
+		queueCfg.MaxAttempts = defaultMailMaxAttempts
+	}
+	if queueCfg.BaseBackoff <= 0 {
+		queueCfg.BaseBackoff = defaultMailBaseBackoff
+	}
+	if queueCfg.MaxBackoff <= 0 {
+		queueCfg.MaxBackoff = defaultMailMaxBackoff
+	}
+	if queueCfg.DeadLetterRetention <= 0 {
+		queueCfg.DeadLetterRetention = defaultMailDeadLetterRetention
+	}
+
 	return &mailService{
-		dialer:   dialer,
-		template: t,
-		from:     mailCfg.From,
+		transport: transport,
+		template:  t,
+		from:      mailCfg.From,
+		queueCfg:  queueCfg,
+		rc:        rc,
+		log:       log.With(logger.String("module", "mail")),
+		authz:     authz,
 	}, nil
 }
 
-// Send sends an email with the given template and data.
-func (s *mailService) Send(to, subject, templateName string, data any) error {
+// Send renders the template and queues the email for delivery.
+func (s *mailService) Send(ctx context.Context, input *SendMailInput) error {
 	var body bytes.Buffer
-	if err := s.template.ExecuteTemplate(&body, templateName, data); err != nil {
-		return errx.New(errx.CodeInternalError, fmt.Errorf("failed to execute email template %s: %w", templateName, err))
+	if err := s.template.ExecuteTemplate(&body, input.Template, input.Data); err != nil {
+		return errx.New(errx.CodeInternalError, fmt.Errorf("failed to execute email template %s: %w", input.Template, err))
 	}
 
-	m := gomail.NewMessage()
-	m.SetHeader("From", s.from)
-	m.SetHeader("To", to)
-	m.SetHeader("Subject", subject)
-	m.SetBody("text/html", body.String())
+	now := time.Now()
+	msg := &entity.MailMessage{
+		ID:            uuid.NewString(),
+		To:            input.To,
+		Subject:       input.Subject,
+		Body:          body.String(),
+		Headers:       input.Headers,
+		CreatedAt:     now,
+		NextAttemptAt: now,
+	}
+	if input.ValidFor > 0 {
+		expiresAt := now.Add(input.ValidFor)
+		msg.ExpiresAt = &expiresAt
+	}
 
-	if err := s.dialer.DialAndSend(m); err != nil {
-		return errx.New(errx.CodeExternalError, fmt.Errorf("failed to send email to %s: %w", to, err))
+	if err := s.enqueue(ctx, msg); err != nil {
+		return errx.New(errx.CodeInternalError, fmt.Errorf("failed to queue email to %s: %w", input.To, err))
 	}
 
 	return nil
 }
+
+// ProcessQueue delivers the messages whose next attempt is due.
+//
+// Failed messages are rescheduled with exponential backoff and moved to the
+// dead-letter list once they run out of attempts.
+func (s *mailService) ProcessQueue(ctx context.Context) error {
+	ids, err := s.rc.ZRangeByScore(ctx, mailQueueKey, 0, float64(time.Now().UnixMilli()), mailBatchSize)
+	if err != nil {
+		return errx.New(errx.CodeInternalError, err)
+	}
+
+	for _, id := range ids {
+		if err := ctx.Err(); err != nil {
+			return err
+		}
+		if err := s.deliver(ctx, id); err != nil {
+			s.log.Error("process queued email failed", logger.String("id", id), logger.Err(err))
+		}
+	}
+
+	return nil
+}
+
+// PurgeDeadLetters deletes the dead letters older than the retention.
+func (s *mailService) PurgeDeadLetters(ctx context.Context) error {
+	raw, err := s.rc.HGetAll(ctx, mailDeadKey)
+	if err != nil {
+		return errx.New(errx.CodeInternalError, err)
+	}
+
+	before := time.Now().Add(-s.queueCfg.DeadLetterRetention)
+	var ids []string
+	for id, v := range raw {
+		msg := new(entity.MailMessage)
+		if err := json.Unmarshal([]byte(v), msg); err != nil {
+			s.log.Warn("decode dead letter failed", logger.String("id", id), logger.Err(err))
+			continue
+		}
+		if msg.DeadSince().Before(before) {
+			ids = append(ids, id)
+		}
+	}
+	if len(ids) == 0 {
+		return nil
+	}
+
+	if err := s.rc.HDel(ctx, mailDeadKey, ids...); err != nil {
+		return errx.New(errx.CodeInternalError, err)
+	}
+	s.log.Info("expired dead letters purged", logger.Int("count", len(ids)))
+	return nil
+}
+
+// ListDeadLetters returns all messages that exhausted their delivery attempts.
+func (s *mailService) ListDeadLetters(ctx context.Context, user contextx.User) ([]*entity.MailMessage, error) {
+	if err := s.authz.Authorize(ctx, user.ID, user.Role, authz.ResourceMail, authz.ActionRead, nil); err != nil {
+		return nil, err
+	}
+
+	raw, err := s.rc.HGetAll(ctx, mailDeadKey)
+	if err != nil {
+		return nil, errx.New(errx.CodeInternalError, err)
+	}
+
+	msgs := make([]*entity.MailMessage, 0, len(raw))
+	for _, v := range raw {
+		msg := new(entity.MailMessage)
+		if err := json.Unmarshal([]byte(v), msg); err != nil {
+			s.log.Warn("decode dead letter failed", logger.Err(err))
+			continue
+		}
+		msgs = append(msgs, msg)
+	}
+
+	sort.Slice(msgs, func(i, j int) bool {
+		return msgs[i].CreatedAt.After(msgs[j].CreatedAt)
+	})
+
+	return msgs, nil
+}
+
+// RetryDeadLetter moves a dead letter back into the queue with a fresh attempt budget.
+func (s *mailService) RetryDeadLetter(ctx context.Context, user contextx.User, id string) error {
+	if err := s.authz.Authorize(ctx, user.ID, user.Role, authz.ResourceMail, authz.ActionUpdate, nil); err != nil {
+		return err
+	}
+
+	msg, err := s.getMessage(ctx, mailDeadKey, id)
+	if err != nil {
+		return err
+	}
+	now := time.Now()
+	if msg.Expired(now) {
+		return errx.New(errx.CodeInvalidParam, fmt.Errorf("email %s expired and cannot be retried", id))
+	}
+
+	msg.Attempts = 0
+	msg.FailedAt = nil
+	msg.NextAttemptAt = now
+	if err := s.enqueue(ctx, msg); err != nil {
+		return errx.New(errx.CodeInternalError, err)
+	}
+	if err := s.rc.HDel(ctx, mailDeadKey, id); err != nil {
+		return errx.New(errx.CodeInternalError, err)
+	}
+
+	return nil
+}
+
+// DeleteDeadLetter discards a dead letter for good.
+func (s *mailService) DeleteDeadLetter(ctx context.Context, user contextx.User, id string) error {
+	if err := s.authz.Authorize(ctx, user.ID, user.Role, authz.ResourceMail, authz.ActionDelete, nil); err != nil {
+		return err
+	}
+
+	if _, err := s.getMessage(ctx, mailDeadKey, id); err != nil {
+		return err
+	}
+	if err := s.rc.HDel(ctx, mailDeadKey, id); err != nil {
+		return errx.New(errx.CodeInternalError, err)
+	}
+
+	return nil
+}
+
+// deliver sends a single queued message and records the outcome.
+//
+// The message is stored before its ID is scheduled and unscheduled before it
+// is removed, so a crash at any point leads to a retry rather than a loss.
+func (s *mailService) deliver(ctx context.Context, id string) error {
+	// The lock holds a token of this attempt, so that a delivery outliving
+	// the lock does not release the lock of the next worker.
+	lockKey := mailLockPrefix + id
+	token := uuid.NewString()
+	locked, err := s.rc.SetNX(ctx, lockKey, token, mailLockTTL)
+	if err != nil {
+		return err
+	}
+	if !locked {
+		// Another worker is delivering this message.
+		return nil
+	}
+	defer func() {
+		if _, err := s.rc.DeleteIfEqual(ctx, lockKey, token); err != nil {
+			s.log.Warn("release email lock failed", logger.String("id", id), logger.Err(err))
+		}
+	}()
+
+	msg, err := s.getMessage(ctx, mailMessagesKey, id)
+	if err != nil {
+		if errx.ToAppError(err).Code == errx.CodeNotFound {
+			// Stale ID without a message; drop it from the schedule.
+			return s.rc.ZRem(ctx, mailQueueKey, id)
+		}
+		return err
+	}
+	now := time.Now()
+	if msg.NextAttemptAt.After(now) {
+		// Rescheduled by another worker after this batch was read.
+		return nil
+	}
+	if msg.Expired(now) {
+		s.log.Warn("email expired before delivery",
+			logger.String("id", id),
+			logger.Int("attempts", msg.Attempts),
+		)
+		return s.dequeue(ctx, id)
+	}
+
+	sendErr := s.transport.Send(ctx, &mailer.Message{
+		From:    s.from,
+		To:      msg.To,
+		Subject: msg.Subject,
+		HTML:    msg.Body,
+		Headers: msg.Headers,
+	})
+	if sendErr == nil {
+		return s.dequeue(ctx, id)
+	}
+
+	msg.Attempts++
+	msg.LastError = sendErr.Error()
+	now = time.Now()
+
+	if msg.Attempts >= s.queueCfg.MaxAttempts {
+		s.log.Error("email moved to dead letters",
+			logger.String("id", id),
+			logger.String("to", msg.To),
+			logger.Int("attempts", msg.Attempts),
+			logger.Err(sendErr),
+		)
+		msg.FailedAt = &now
+		data, err := json.Marshal(msg)
+		if err != nil {
+			return err
+		}
+		if err := s.rc.HSet(ctx, mailDeadKey, id, string(data)); err != nil {
+			return err
+		}
+		return s.dequeue(ctx, id)
+	}
+
+	msg.NextAttemptAt = now.Add(s.backoff(msg.Attempts))
+	if msg.Expired(msg.NextAttemptAt) {
+		s.log.Warn("email delivery failed, expires before the next attempt",
+			logger.String("id", id),
+			logger.Int("attempts", msg.Attempts),
+			logger.Err(sendErr),
+		)
+		return s.dequeue(ctx, id)
+	}
+
+	s.log.Warn("email delivery failed, will retry",
+		logger.String("id", id),
+		logger.String("to", msg.To),
+		logger.Int("attempts", msg.Attempts),
+		logger.Err(sendErr),
+	)
+	return s.enqueue(ctx, msg)
+}
+
+// enqueue stores a message and schedules it for its next attempt.
+func (s *mailService) enqueue(ctx context.Context, msg *entity.MailMessage) error {
+	data, err := json.Marshal(msg)
+	if err != nil {
+		return err
+	}
+	if err := s.rc.HSet(ctx, mailMessagesKey, msg.ID, string(data)); err != nil {
+		return err
+	}
+	return s.rc.ZAdd(ctx, mailQueueKey, float64(msg.NextAttemptAt.UnixMilli()), msg.ID)
+}
+
+// dequeue unschedules a message and then removes it.
+func (s *mailService) dequeue(ctx context.Context, id string) error {
+	if err := s.rc.ZRem(ctx, mailQueueKey, id); err != nil {
+		return err
+	}
+	return s.rc.HDel(ctx, mailMessagesKey, id)
+}
+
+// getMessage loads a message from the given hash.
+func (s *mailService) getMessage(ctx context.Context, key, id string) (*entity.MailMessage, error) {
+	raw, err := s.rc.HGet(ctx, key, id)
+	if err != nil {
+		if appErr := errx.ToAppError(err); appErr.Code == errx.CodeNotFound {
+			return nil, errx.New(errx.CodeNotFound, fmt.Errorf("email %s not found", id))
+		}
+		return nil, errx.New(errx.CodeInternalError, err)
+	}
+
+	msg := new(entity.MailMessage)
+	if err := json.Unmarshal([]byte(raw), msg); err != nil {
+		return nil, errx.New(errx.CodeInternalError, err)
+	}
+	return msg, nil
+}
+
+// backoff returns the delay before the given retry attempt.
+func (s *mailService) backoff(attempts int) time.Duration {
+	d := float64(s.queueCfg.BaseBackoff) * math.Pow(2, float64(attempts-1))
+	if d > float64(s.queueCfg.MaxBackoff) {
+		return s.queueCfg.MaxBackoff
+	}
+	return time.Duration(d)
+}
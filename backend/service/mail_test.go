@@ -0,0 +1,130 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"testing"
+
+	"blog-server/config"
+	"blog-server/logger"
+	"blog-server/mailer"
+)
+
+func newMailTest(t *testing.T, transport mailer.Transport) (*mailService, *memoryCache) {
+	t.Helper()
+
+	cfg := &config.Config{}
+	cfg.Email.From = "blog@blog.example"
+
+	rc := newMemoryCache()
+	svc, err := NewEmailService(cfg, logger.NewNop(), transport, rc, nil)
+	if err != nil {
+		t.Fatalf("NewEmailService: %v", err)
+	}
+	return svc.(*mailService), rc
+}
+
+func TestMailDeliversQueuedMessage(t *testing.T) {
+	ctx := context.Background()
+	transport := mailer.NewMemoryTransport()
+	svc, rc := newMailTest(t, transport)
+
+	err := svc.Send(ctx, &SendMailInput{
+		To:       "reader@example.com",
+		Subject:  "Your verification code",
+		Template: "captcha.html",
+		Data: &AuthMailData{
+			Title:   "Verification Code",
+			Type:    "sign up",
+			Subject: "Your verification code",
+			Captcha: "482913",
+		},
+		Headers: map[string]string{"List-Unsubscribe": "<https://blog.example/unsubscribe>"},
+	})
+	if err != nil {
+		t.Fatalf("Send: %v", err)
+	}
+	if len(transport.Messages()) != 0 {
+		t.Fatal("message delivered before the queue was processed")
+	}
+
+	if err := svc.ProcessQueue(ctx); err != nil {
+		t.Fatalf("ProcessQueue: %v", err)
+	}
+
+	msgs := transport.Messages()
+	if len(msgs) != 1 {
+		t.Fatalf("delivered %d messages, want 1", len(msgs))
+	}
+	msg := msgs[0]
+	if msg.From != "blog@blog.example" {
+		t.Errorf("From = %q", msg.From)
+	}
+	if msg.To != "reader@example.com" {
+		t.Errorf("To = %q", msg.To)
+	}
+	if msg.Subject != "Your verification code" {
+		t.Errorf("Subject = %q", msg.Subject)
+	}
+	for _, want := range []string{"482913", "<strong>sign up</strong>", "</html>"} {
+		if !strings.Contains(msg.HTML, want) {
+			t.Errorf("HTML does not contain %q", want)
+		}
+	}
+	if got := msg.Headers["List-Unsubscribe"]; got != "<https://blog.example/unsubscribe>" {
+		t.Errorf("List-Unsubscribe = %q", got)
+	}
+
+	// A delivered message leaves the queue.
+	if err := svc.ProcessQueue(ctx); err != nil {
+		t.Fatalf("ProcessQueue: %v", err)
+	}
+	if n := len(transport.Messages()); n != 1 {
+		t.Errorf("delivered %d messages after a second run, want 1", n)
+	}
+	if pending, _ := rc.HGetAll(ctx, mailMessagesKey); len(pending) != 0 {
+		t.Errorf("%d messages left in the queue", len(pending))
+	}
+}
+
+// failingTransport fails every delivery.
+type failingTransport struct{}
+
+func (failingTransport) Send(context.Context, *mailer.Message) error {
+	return errors.New("connection refused")
+}
+
+func TestMailReschedulesFailedDelivery(t *testing.T) {
+	ctx := context.Background()
+	svc, rc := newMailTest(t, failingTransport{})
+
+	if err := svc.Send(ctx, &SendMailInput{
+		To:       "reader@example.com",
+		Subject:  "Your verification code",
+		Template: "captcha.html",
+		Data:     &AuthMailData{Captcha: "482913"},
+	}); err != nil {
+		t.Fatalf("Send: %v", err)
+	}
+	if err := svc.ProcessQueue(ctx); err != nil {
+		t.Fatalf("ProcessQueue: %v", err)
+	}
+
+	pending, _ := rc.HGetAll(ctx, mailMessagesKey)
+	if len(pending) != 1 {
+		t.Fatalf("%d messages in the queue, want 1", len(pending))
+	}
+	for id := range pending {
+		msg, err := svc.getMessage(ctx, mailMessagesKey, id)
+		if err != nil {
+			t.Fatalf("getMessage: %v", err)
+		}
+		if msg.Attempts != 1 || msg.LastError != "connection refused" {
+			t.Errorf("attempts = %d, last error = %q", msg.Attempts, msg.LastError)
+		}
+		if due, _ := rc.ZRangeByScore(ctx, mailQueueKey, 0, float64(msg.NextAttemptAt.UnixMilli()-1), 0); len(due) != 0 {
+			t.Error("failed message is due again before its backoff")
+		}
+	}
+}
@@ -7,11 +7,27 @@ func Module() fx.Option {
 		"service",
 		fx.Provide(
 			NewPostService,
+			NewPostRevisionService,
+			NewPostTrashService,
+			NewPostEvents,
 			NewRssService,
 			NewLinkService,
 			NewAuthService,
+			NewUserService,
+			NewSessionService,
+			NewTwoFactorService,
+			NewPasskeyService,
+			NewOAuthService,
+			NewAccessTokenService,
+			NewSigningKeyService,
+			NewJwt,
+			NewTokenDenylist,
+			NewAttemptGuard,
 			NewEmailService,
 			NewModelService,
+			NewCommentService,
+			NewStatsService,
+			NewSpamFilterChain,
 		),
 	)
 }
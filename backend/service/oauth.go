@@ -0,0 +1,419 @@
+package service
+
+import (
+	"context"
+	"crypto/subtle"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strings"
+	"sync"
+	"time"
+
+	"blog-server/cache"
+	"blog-server/config"
+	"blog-server/entity"
+	"blog-server/logger"
+	"blog-server/pkg/errx"
+	"blog-server/pkg/oidc"
+	"blog-server/pkg/txmgr"
+	"blog-server/repository"
+	"blog-server/utils"
+
+	"github.com/google/uuid"
+)
+
+const (
+	oauthStateKeyPrefix = "blog:oauth:state:"
+	oauthStateTTL       = 10 * time.Minute
+	oauthHTTPTimeout    = 10 * time.Second
+	oauthCallbackPath   = "/api/v1/auth/oauth/%s/callback"
+)
+
+// OAuthStartInput groups all parameters for sending a user to a provider.
+type OAuthStartInput struct {
+	Provider string
+	// Redirect is the path of this site to return to afterwards.
+	Redirect string
+	// LinkUserID links the external account to this user instead of logging in.
+	LinkUserID uint
+}
+
+// OAuthAuthorization is an authorization started at a provider.
+type OAuthAuthorization struct {
+	// URL is the provider URL to send the browser to.
+	URL string
+	// Binding must be kept by the browser that starts the authorization and
+	// passed back to Callback, so that no other browser can complete it.
+	Binding   string
+	ExpiresAt time.Time
+}
+
+// OAuthCallback is a completed authorization at a provider.
+type OAuthCallback struct {
+	Provider   string
+	Identity   *oidc.Identity
+	Redirect   string
+	LinkUserID uint
+}
+
+// oauthState is the pending authorization kept in the cache under its state parameter.
+type oauthState struct {
+	Provider     string `json:"provider"`
+	CodeVerifier string `json:"codeVerifier"`
+	Nonce        string `json:"nonce"`
+	Redirect     string `json:"redirect"`
+	LinkUserID   uint   `json:"linkUserId,omitempty"`
+	// BindingHash is the hash of the binding handed to the browser.
+	BindingHash string `json:"bindingHash"`
+}
+
+// OAuthService defines the interface for logging in with external accounts.
+//
+// The authorization code flow is protected by a one-time state, a PKCE code
+// verifier and, for OpenID Connect, a nonce, all kept server side. The state
+// is bound to the browser that started the flow.
+type OAuthService interface {
+	Providers() []string
+	Authorize(ctx context.Context, input *OAuthStartInput) (*OAuthAuthorization, error)
+	Callback(ctx context.Context, provider, code, state, binding string) (*OAuthCallback, error)
+
+	// ResolveUser returns the user an external account logs in as. Unknown
+	// accounts are linked to the user with the same verified email, or get a
+	// new user.
+	ResolveUser(ctx context.Context, provider string, identity *oidc.Identity) (uint, error)
+	Link(ctx context.Context, userID uint, provider string, identity *oidc.Identity) (*entity.UserIdentity, error)
+	ListIdentities(ctx context.Context, userID uint) ([]*entity.UserIdentity, error)
+	Unlink(ctx context.Context, userID, id uint) error
+}
+
+// oauthProvider is a configured provider, discovered on first use.
+type oauthProvider struct {
+	cfg      config.OAuthProviderConfig
+	provider oidc.Provider
+}
+
+// oauthService implements the OAuthService interface.
+type oauthService struct {
+	tx           txmgr.TxManager
+	rc           cache.CacheClient
+	userRepo     repository.UserRepo
+	identityRepo repository.UserIdentityRepo
+	domain       string
+	client       *http.Client
+	log          logger.Logger
+
+	mu        sync.Mutex
+	providers map[string]*oauthProvider
+	names     []string
+}
+
+// NewOAuthService creates and returns a new OAuthService instance.
+func NewOAuthService(
+	cfg *config.Config,
+	tx txmgr.TxManager,
+	rc cache.CacheClient,
+	userRepo repository.UserRepo,
+	identityRepo repository.UserIdentityRepo,
+	log logger.Logger,
+) OAuthService {
+	s := &oauthService{
+		tx:           tx,
+		rc:           rc,
+		userRepo:     userRepo,
+		identityRepo: identityRepo,
+		domain:       strings.TrimSuffix(cfg.App.Domain, "/"),
+		client:       &http.Client{Timeout: oauthHTTPTimeout},
+		log:          log.With(logger.String("module", "oauth")),
+		providers:    make(map[string]*oauthProvider),
+	}
+	for _, p := range cfg.Auth.OAuth.Providers {
+		if !p.Enabled() {
+			continue
+		}
+		s.providers[p.Name] = &oauthProvider{cfg: p}
+		s.names = append(s.names, p.Name)
+	}
+	return s
+}
+
+// Providers returns the names of the enabled providers.
+func (s *oauthService) Providers() []string {
+	return s.names
+}
+
+// Authorize starts an authorization at a provider.
+func (s *oauthService) Authorize(ctx context.Context, input *OAuthStartInput) (*OAuthAuthorization, error) {
+	provider, err := s.provider(ctx, input.Provider)
+	if err != nil {
+		return nil, err
+	}
+
+	binding := oidc.RandomToken()
+	verifier, challenge := oidc.NewPKCE()
+	state := &oauthState{
+		Provider:     input.Provider,
+		CodeVerifier: verifier,
+		Nonce:        oidc.RandomToken(),
+		Redirect:     safeRedirect(input.Redirect),
+		LinkUserID:   input.LinkUserID,
+		BindingHash:  hashToken(binding),
+	}
+	data, err := json.Marshal(state)
+	if err != nil {
+		return nil, errx.New(errx.CodeInternalError, err)
+	}
+
+	token := oidc.RandomToken()
+	if err := s.rc.Set(ctx, oauthStateKeyPrefix+token, string(data), oauthStateTTL); err != nil {
+		return nil, errx.New(errx.CodeInternalError, err)
+	}
+
+	return &OAuthAuthorization{
+		URL:       provider.AuthCodeURL(token, state.Nonce, challenge),
+		Binding:   binding,
+		ExpiresAt: time.Now().Add(oauthStateTTL),
+	}, nil
+}
+
+// Callback consumes the state of an authorization and redeems its code.
+//
+// The binding must match the one handed out with the authorization. Without
+// it, a victim could be made to complete an authorization started by someone
+// else, linking the victim's external account to their user.
+func (s *oauthService) Callback(ctx context.Context, provider, code, state, binding string) (*OAuthCallback, error) {
+	invalid := errx.New(errx.CodeUnauthorized, fmt.Errorf("oauth state expired or invalid"))
+
+	key := oauthStateKeyPrefix + state
+	raw, err := s.rc.Get(ctx, key)
+	if err != nil {
+		if errx.ToAppError(err).Code == errx.CodeNotFound {
+			return nil, invalid
+		}
+		return nil, errx.New(errx.CodeInternalError, err)
+	}
+	if err := s.rc.Delete(ctx, key); err != nil {
+		return nil, errx.New(errx.CodeInternalError, err)
+	}
+
+	pending := new(oauthState)
+	if err := json.Unmarshal([]byte(raw), pending); err != nil {
+		return nil, errx.New(errx.CodeInternalError, err)
+	}
+	if pending.Provider != provider {
+		return nil, invalid
+	}
+	if subtle.ConstantTimeCompare([]byte(hashToken(binding)), []byte(pending.BindingHash)) != 1 {
+		return nil, invalid
+	}
+
+	p, err := s.provider(ctx, provider)
+	if err != nil {
+		return nil, err
+	}
+	identity, err := p.Exchange(ctx, code, pending.CodeVerifier, pending.Nonce)
+	if err != nil {
+		s.log.Warn("oauth code exchange failed", logger.String("provider", provider), logger.Err(err))
+		return nil, errx.New(errx.CodeUnauthorized, fmt.Errorf("login with %s failed", provider))
+	}
+
+	return &OAuthCallback{
+		Provider:   provider,
+		Identity:   identity,
+		Redirect:   pending.Redirect,
+		LinkUserID: pending.LinkUserID,
+	}, nil
+}
+
+// ResolveUser finds, links or creates the user of an external account.
+//
+// Only a verified email links to an existing user, otherwise anyone able to
+// register the address at the provider could take the account over.
+func (s *oauthService) ResolveUser(ctx context.Context, provider string, identity *oidc.Identity) (uint, error) {
+	now := time.Now()
+
+	existing, err := s.identityRepo.GetByProviderSubject(ctx, provider, identity.Subject)
+	if err == nil {
+		exists, err := s.userRepo.ExistsByID(ctx, existing.UserID)
+		if err != nil {
+			return 0, err
+		}
+		if !exists {
+			return 0, errx.New(errx.CodeUnauthorized, fmt.Errorf("account no longer exists"))
+		}
+		if err := s.identityRepo.UpdateLastLogin(ctx, existing.ID, now); err != nil {
+			s.log.Warn("record identity login failed", logger.Err(err))
+		}
+		return existing.UserID, nil
+	}
+	if errx.ToAppError(err).Code != errx.CodeNotFound {
+		return 0, err
+	}
+
+	if identity.Email == "" || !identity.EmailVerified {
+		return 0, errx.New(errx.CodeInvalidParam, fmt.Errorf("%s did not provide a verified email", provider))
+	}
+
+	var userID uint
+	err = s.tx.WithTx(ctx, func(ctx context.Context) error {
+		user, err := s.userRepo.GetByEmail(ctx, identity.Email)
+		switch {
+		case err == nil:
+			userID = user.ID
+		case errx.ToAppError(err).Code == errx.CodeNotFound:
+			created, err := s.createUser(ctx, identity)
+			if err != nil {
+				return err
+			}
+			userID = created.ID
+		default:
+			return err
+		}
+
+		_, err = s.identityRepo.Create(ctx, &entity.UserIdentity{
+			UserID:      userID,
+			Provider:    provider,
+			Subject:     identity.Subject,
+			Email:       identity.Email,
+			LastLoginAt: &now,
+		})
+		return err
+	})
+	if err != nil {
+		return 0, err
+	}
+
+	s.log.Info("identity linked",
+		logger.String("provider", provider),
+		logger.Int("user_id", int(userID)),
+	)
+	return userID, nil
+}
+
+// Link links an external account to a logged-in user.
+func (s *oauthService) Link(ctx context.Context, userID uint, provider string, identity *oidc.Identity) (*entity.UserIdentity, error) {
+	existing, err := s.identityRepo.GetByProviderSubject(ctx, provider, identity.Subject)
+	if err == nil {
+		if existing.UserID != userID {
+			return nil, errx.New(errx.CodeConflict, fmt.Errorf("this %s account is linked to another user", provider))
+		}
+		return existing, nil
+	}
+	if errx.ToAppError(err).Code != errx.CodeNotFound {
+		return nil, err
+	}
+
+	linked, err := s.identityRepo.Create(ctx, &entity.UserIdentity{
+		UserID:   userID,
+		Provider: provider,
+		Subject:  identity.Subject,
+		Email:    identity.Email,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	s.log.Info("identity linked",
+		logger.String("provider", provider),
+		logger.Int("user_id", int(userID)),
+	)
+	return linked, nil
+}
+
+// ListIdentities returns the external accounts linked to the user.
+func (s *oauthService) ListIdentities(ctx context.Context, userID uint) ([]*entity.UserIdentity, error) {
+	return s.identityRepo.ListByUser(ctx, userID)
+}
+
+// Unlink removes an external account of the user.
+func (s *oauthService) Unlink(ctx context.Context, userID, id uint) error {
+	return s.identityRepo.Delete(ctx, userID, id)
+}
+
+// provider returns the named provider, running OpenID Connect discovery on first use.
+func (s *oauthService) provider(ctx context.Context, name string) (oidc.Provider, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	p, ok := s.providers[name]
+	if !ok {
+		return nil, errx.New(errx.CodeNotFound, fmt.Errorf("unknown login provider %q", name))
+	}
+	if p.provider != nil {
+		return p.provider, nil
+	}
+
+	oc := oidc.Config{
+		ClientID:     p.cfg.ClientID,
+		ClientSecret: p.cfg.ClientSecret,
+		RedirectURL:  s.domain + fmt.Sprintf(oauthCallbackPath, name),
+		Scopes:       p.cfg.Scopes,
+	}
+	switch p.cfg.Type {
+	case "github":
+		p.provider = oidc.NewGitHub(s.client, oc)
+	default:
+		discovered, err := oidc.Discover(ctx, s.client, p.cfg.Issuer, oc)
+		if err != nil {
+			s.log.Error("oidc discovery failed", logger.String("provider", name), logger.Err(err))
+			return nil, errx.New(errx.CodeInternalError, fmt.Errorf("login provider %q is unavailable", name))
+		}
+		p.provider = discovered
+	}
+	return p.provider, nil
+}
+
+// createUser registers a user for an external account.
+//
+// The password is random; the user can set one with the password reset flow.
+func (s *oauthService) createUser(ctx context.Context, identity *oidc.Identity) (*entity.User, error) {
+	hashedPassword, err := utils.HashPassword(utils.RandomString(32, "ABCDEFGHIJKLMNOPQRSTUVWXYZabcdefghijklmnopqrstuvwxyz0123456789"))
+	if err != nil {
+		return nil, errx.New(errx.CodeInternalError, err)
+	}
+
+	username, err := s.username(ctx, identity.Name)
+	if err != nil {
+		return nil, err
+	}
+
+	user := &entity.User{
+		UUID:     uuid.New(),
+		Email:    identity.Email,
+		Username: username,
+		Role:     entity.UserRoleReader,
+	}
+	if identity.Picture != "" && len(identity.Picture) <= 255 {
+		user.Avatar = &identity.Picture
+	}
+
+	return s.userRepo.Create(ctx, user, hashedPassword)
+}
+
+// username returns the name of an external account as username when it is a
+// valid username no one has taken, and a generated one otherwise.
+func (s *oauthService) username(ctx context.Context, name string) (string, error) {
+	name = strings.TrimSpace(name)
+	if !usernamePattern.MatchString(name) {
+		return entity.GenerateUsername(), nil
+	}
+
+	_, err := s.userRepo.GetByUsername(ctx, name)
+	switch {
+	case err == nil:
+		return entity.GenerateUsername(), nil
+	case errx.ToAppError(err).Code == errx.CodeNotFound:
+		return name, nil
+	default:
+		return "", err
+	}
+}
+
+// safeRedirect keeps a redirect on this site, so the login flow cannot be
+// used to forward users to other sites.
+func safeRedirect(path string) string {
+	if !strings.HasPrefix(path, "/") || strings.HasPrefix(path, "//") || strings.HasPrefix(path, "/\\") {
+		return "/"
+	}
+	return path
+}
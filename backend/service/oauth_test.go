@@ -0,0 +1,333 @@
+package service
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"strings"
+	"testing"
+	"time"
+
+	"blog-server/config"
+	"blog-server/entity"
+	"blog-server/logger"
+	"blog-server/pkg/errx"
+	"blog-server/pkg/oidc"
+	"blog-server/pkg/oidc/oidctest"
+	"blog-server/repository"
+)
+
+// oauthUsers is an in-memory repository.UserRepo.
+type oauthUsers struct {
+	repository.UserRepo
+	users []*entity.User
+}
+
+func (r *oauthUsers) Create(_ context.Context, u *entity.User, _ string) (*entity.User, error) {
+	created := *u
+	created.ID = uint(len(r.users) + 1)
+	r.users = append(r.users, &created)
+	return &created, nil
+}
+
+func (r *oauthUsers) find(match func(u *entity.User) bool) (*entity.User, error) {
+	for _, u := range r.users {
+		if match(u) {
+			return u, nil
+		}
+	}
+	return nil, errx.New(errx.CodeNotFound, errors.New("user not found"))
+}
+
+func (r *oauthUsers) GetByEmail(_ context.Context, email string) (*entity.User, error) {
+	return r.find(func(u *entity.User) bool { return u.Email == email })
+}
+
+func (r *oauthUsers) GetByUsername(_ context.Context, username string) (*entity.User, error) {
+	return r.find(func(u *entity.User) bool { return u.Username == username })
+}
+
+func (r *oauthUsers) ExistsByID(_ context.Context, id uint) (bool, error) {
+	_, err := r.find(func(u *entity.User) bool { return u.ID == id })
+	return err == nil, nil
+}
+
+// oauthIdentities is an in-memory repository.UserIdentityRepo.
+type oauthIdentities struct {
+	repository.UserIdentityRepo
+	identities []*entity.UserIdentity
+}
+
+func (r *oauthIdentities) Create(_ context.Context, i *entity.UserIdentity) (*entity.UserIdentity, error) {
+	created := *i
+	created.ID = uint(len(r.identities) + 1)
+	r.identities = append(r.identities, &created)
+	return &created, nil
+}
+
+func (r *oauthIdentities) GetByProviderSubject(_ context.Context, provider, subject string) (*entity.UserIdentity, error) {
+	for _, i := range r.identities {
+		if i.Provider == provider && i.Subject == subject {
+			return i, nil
+		}
+	}
+	return nil, errx.New(errx.CodeNotFound, errors.New("identity not found"))
+}
+
+func (r *oauthIdentities) UpdateLastLogin(context.Context, uint, time.Time) error {
+	return nil
+}
+
+type oauthTest struct {
+	svc        *oauthService
+	idp        *oidctest.Server
+	rc         *memoryCache
+	users      *oauthUsers
+	identities *oauthIdentities
+}
+
+func newOAuthTest(t *testing.T, user oidctest.User) *oauthTest {
+	t.Helper()
+
+	idp := oidctest.NewServer("blog", "secret", user)
+	t.Cleanup(idp.Close)
+
+	cfg := &config.Config{}
+	cfg.App.Domain = "https://blog.example"
+	cfg.Auth.OAuth.Providers = []config.OAuthProviderConfig{{
+		Name:         "idp",
+		Issuer:       idp.URL,
+		ClientID:     idp.ClientID,
+		ClientSecret: idp.ClientSecret,
+	}}
+
+	ot := &oauthTest{
+		idp:        idp,
+		rc:         newMemoryCache(),
+		users:      &oauthUsers{},
+		identities: &oauthIdentities{},
+	}
+	ot.svc = NewOAuthService(cfg, directTx{}, ot.rc, ot.users, ot.identities, logger.NewNop()).(*oauthService)
+	return ot
+}
+
+// authorize starts an authorization and lets the provider approve it,
+// returning the authorization with the code and state of the callback.
+func (ot *oauthTest) authorize(t *testing.T, input *OAuthStartInput) (auth *OAuthAuthorization, code, state string) {
+	t.Helper()
+
+	auth, err := ot.svc.Authorize(context.Background(), input)
+	if err != nil {
+		t.Fatalf("Authorize: %v", err)
+	}
+	back, err := ot.idp.Authorize(auth.URL)
+	if err != nil {
+		t.Fatalf("provider authorize: %v", err)
+	}
+	return auth, back.Query().Get("code"), back.Query().Get("state")
+}
+
+func errCode(err error) int {
+	if err == nil {
+		return errx.CodeOK
+	}
+	return errx.ToAppError(err).Code
+}
+
+func TestOAuthLoginCreatesUser(t *testing.T) {
+	ot := newOAuthTest(t, oidctest.User{
+		Subject:       "sub-1",
+		Email:         "alice@example.com",
+		EmailVerified: true,
+		Name:          "alice_w",
+	})
+	ctx := context.Background()
+
+	auth, code, state := ot.authorize(t, &OAuthStartInput{Provider: "idp", Redirect: "/posts"})
+	callback, err := ot.svc.Callback(ctx, "idp", code, state, auth.Binding)
+	if err != nil {
+		t.Fatalf("Callback: %v", err)
+	}
+	if callback.Identity.Subject != "sub-1" || callback.Redirect != "/posts" || callback.LinkUserID != 0 {
+		t.Fatalf("callback = %+v, identity %+v", callback, callback.Identity)
+	}
+
+	userID, err := ot.svc.ResolveUser(ctx, "idp", callback.Identity)
+	if err != nil {
+		t.Fatalf("ResolveUser: %v", err)
+	}
+	if len(ot.users.users) != 1 {
+		t.Fatalf("created %d users, want 1", len(ot.users.users))
+	}
+	u := ot.users.users[0]
+	if u.ID != userID || u.Email != "alice@example.com" || u.Username != "alice_w" || u.Role != entity.UserRoleReader {
+		t.Errorf("created user = %+v", u)
+	}
+	if len(ot.identities.identities) != 1 || ot.identities.identities[0].UserID != userID {
+		t.Errorf("identities = %+v", ot.identities.identities)
+	}
+
+	// Logging in again resolves the linked identity.
+	again, err := ot.svc.ResolveUser(ctx, "idp", callback.Identity)
+	if err != nil || again != userID {
+		t.Errorf("second ResolveUser = %d, %v; want %d", again, err, userID)
+	}
+	if len(ot.users.users) != 1 {
+		t.Errorf("second login created a user")
+	}
+}
+
+func TestOAuthLoginLinksVerifiedEmail(t *testing.T) {
+	ot := newOAuthTest(t, oidctest.User{})
+	ctx := context.Background()
+	ot.users.users = []*entity.User{{ID: 1, Email: "bob@example.com", Username: "bob"}}
+
+	_, err := ot.svc.ResolveUser(ctx, "idp", &oidc.Identity{Subject: "sub-1", Email: "bob@example.com"})
+	if errCode(err) != errx.CodeInvalidParam {
+		t.Fatalf("unverified email: err = %v, want invalid param", err)
+	}
+
+	userID, err := ot.svc.ResolveUser(ctx, "idp", &oidc.Identity{Subject: "sub-1", Email: "bob@example.com", EmailVerified: true})
+	if err != nil {
+		t.Fatalf("ResolveUser: %v", err)
+	}
+	if userID != 1 || len(ot.users.users) != 1 {
+		t.Errorf("verified email resolved to user %d with %d users, want the existing user", userID, len(ot.users.users))
+	}
+}
+
+func TestOAuthUsername(t *testing.T) {
+	ot := newOAuthTest(t, oidctest.User{})
+	ot.users.users = []*entity.User{{ID: 1, Username: "taken"}}
+
+	tests := []struct {
+		name string
+		want string
+	}{
+		{name: "carol", want: "carol"},
+		{name: "  dave-2  ", want: "dave-2"},
+		{name: "taken", want: ""},
+		{name: "Carol Smith", want: ""},
+		{name: "李雷", want: ""},
+		{name: "ab", want: ""},
+		{name: strings.Repeat("a", 33), want: ""},
+		{name: "", want: ""},
+	}
+	for _, tt := range tests {
+		got, err := ot.svc.username(context.Background(), tt.name)
+		if err != nil {
+			t.Fatalf("username(%q): %v", tt.name, err)
+		}
+		if tt.want == "" {
+			if !strings.HasPrefix(got, "user_") {
+				t.Errorf("username(%q) = %q, want a generated username", tt.name, got)
+			}
+			continue
+		}
+		if got != tt.want {
+			t.Errorf("username(%q) = %q, want %q", tt.name, got, tt.want)
+		}
+	}
+}
+
+func TestOAuthUsesPKCE(t *testing.T) {
+	ot := newOAuthTest(t, oidctest.User{Subject: "sub-1"})
+	ctx := context.Background()
+
+	auth, code, state := ot.authorize(t, &OAuthStartInput{Provider: "idp"})
+	if !strings.Contains(auth.URL, "code_challenge_method=S256") {
+		t.Fatalf("authorization URL %q lacks a PKCE challenge", auth.URL)
+	}
+
+	// A code redeemed with another verifier is refused by the provider.
+	key := oauthStateKeyPrefix + state
+	raw, err := ot.rc.Get(ctx, key)
+	if err != nil {
+		t.Fatalf("pending state: %v", err)
+	}
+	pending := new(oauthState)
+	if err := json.Unmarshal([]byte(raw), pending); err != nil {
+		t.Fatal(err)
+	}
+	pending.CodeVerifier = "another-verifier"
+	data, _ := json.Marshal(pending)
+	_ = ot.rc.Set(ctx, key, string(data), 0)
+
+	_, err = ot.svc.Callback(ctx, "idp", code, state, auth.Binding)
+	if errCode(err) != errx.CodeUnauthorized {
+		t.Errorf("wrong verifier: err = %v, want unauthorized", err)
+	}
+}
+
+func TestOAuthStateIsSingleUse(t *testing.T) {
+	ot := newOAuthTest(t, oidctest.User{Subject: "sub-1"})
+	ctx := context.Background()
+
+	auth, code, state := ot.authorize(t, &OAuthStartInput{Provider: "idp"})
+	if _, err := ot.svc.Callback(ctx, "idp", code, state, auth.Binding); err != nil {
+		t.Fatalf("first Callback: %v", err)
+	}
+	if _, err := ot.svc.Callback(ctx, "idp", code, state, auth.Binding); errCode(err) != errx.CodeUnauthorized {
+		t.Errorf("reused state: err = %v, want unauthorized", err)
+	}
+	if keys := ot.rc.keys(oauthStateKeyPrefix); len(keys) != 0 {
+		t.Errorf("states left in cache: %v", keys)
+	}
+}
+
+func TestOAuthStateIsBoundToBrowser(t *testing.T) {
+	ot := newOAuthTest(t, oidctest.User{Subject: "sub-1"})
+	ctx := context.Background()
+
+	tests := []struct {
+		name     string
+		provider string
+		binding  func(auth *OAuthAuthorization) string
+	}{
+		{name: "missing binding", provider: "idp", binding: func(*OAuthAuthorization) string { return "" }},
+		{name: "other binding", provider: "idp", binding: func(*OAuthAuthorization) string { return "someone-else" }},
+		{name: "other provider", provider: "github", binding: func(a *OAuthAuthorization) string { return a.Binding }},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			auth, code, state := ot.authorize(t, &OAuthStartInput{Provider: "idp"})
+			_, err := ot.svc.Callback(ctx, tt.provider, code, state, tt.binding(auth))
+			if errCode(err) != errx.CodeUnauthorized {
+				t.Errorf("err = %v, want unauthorized", err)
+			}
+		})
+	}
+}
+
+func TestOAuthLink(t *testing.T) {
+	ot := newOAuthTest(t, oidctest.User{Subject: "sub-1", Email: "erin@example.com"})
+	ctx := context.Background()
+
+	auth, code, state := ot.authorize(t, &OAuthStartInput{Provider: "idp", Redirect: "/settings", LinkUserID: 7})
+	callback, err := ot.svc.Callback(ctx, "idp", code, state, auth.Binding)
+	if err != nil {
+		t.Fatalf("Callback: %v", err)
+	}
+	if callback.LinkUserID != 7 || callback.Redirect != "/settings" {
+		t.Fatalf("callback = %+v", callback)
+	}
+
+	linked, err := ot.svc.Link(ctx, callback.LinkUserID, "idp", callback.Identity)
+	if err != nil {
+		t.Fatalf("Link: %v", err)
+	}
+	if linked.UserID != 7 || linked.Subject != "sub-1" || linked.Email != "erin@example.com" {
+		t.Errorf("linked = %+v", linked)
+	}
+
+	again, err := ot.svc.Link(ctx, 7, "idp", callback.Identity)
+	if err != nil || again.ID != linked.ID {
+		t.Errorf("linking again = %+v, %v; want the existing identity", again, err)
+	}
+	if _, err := ot.svc.Link(ctx, 8, "idp", callback.Identity); errCode(err) != errx.CodeConflict {
+		t.Errorf("linking to another user: err = %v, want conflict", err)
+	}
+	if len(ot.users.users) != 0 {
+		t.Errorf("linking created users: %+v", ot.users.users)
+	}
+}
@@ -0,0 +1,302 @@
+package service
+
+import (
+	"context"
+	"encoding/base64"
+	"fmt"
+	"net/url"
+	"time"
+
+	"blog-server/cache"
+	"blog-server/config"
+	"blog-server/entity"
+	"blog-server/logger"
+	"blog-server/pkg/errx"
+	"blog-server/pkg/webauthn"
+	"blog-server/repository"
+	"blog-server/utils"
+
+	"github.com/google/uuid"
+)
+
+const (
+	passkeyRegisterKeyPrefix = "blog:webauthn:reg:"
+	passkeyLoginKeyPrefix    = "blog:webauthn:login:"
+
+	passkeyChallengeTTL = 5 * time.Minute
+	defaultPasskeyName  = "Passkey"
+)
+
+// PasskeyLoginChallenge is a pending passwordless login.
+type PasskeyLoginChallenge struct {
+	ID      string
+	Options *webauthn.RequestOptions
+}
+
+// PasskeyService defines the interface for WebAuthn passkeys.
+//
+// Both ceremonies are two-step: the options and their challenge are issued
+// first, and the challenge is consumed when the signed response comes back.
+type PasskeyService interface {
+	List(ctx context.Context, userID uint) ([]*entity.Passkey, error)
+	BeginRegistration(ctx context.Context, userID uint) (*webauthn.CreationOptions, error)
+	FinishRegistration(ctx context.Context, userID uint, name string, resp *webauthn.RegistrationResponse) (*entity.Passkey, error)
+	Delete(ctx context.Context, userID, id uint) error
+
+	BeginLogin(ctx context.Context) (*PasskeyLoginChallenge, error)
+	// Authenticate verifies a login response and returns the user it belongs to
+	// and whether the authenticator verified the user, e.g. with a PIN or biometrics.
+	Authenticate(ctx context.Context, challengeID string, resp *webauthn.AssertionResponse) (uint, bool, error)
+}
+
+// passkeyService implements the PasskeyService interface.
+type passkeyService struct {
+	rc          cache.CacheClient
+	passkeyRepo repository.PasskeyRepo
+	userRepo    repository.UserRepo
+	rp          *webauthn.RelyingParty
+	log         logger.Logger
+}
+
+// NewPasskeyService creates and returns a new PasskeyService instance.
+func NewPasskeyService(
+	cfg *config.Config,
+	rc cache.CacheClient,
+	passkeyRepo repository.PasskeyRepo,
+	userRepo repository.UserRepo,
+	log logger.Logger,
+) (PasskeyService, error) {
+	rp, err := relyingParty(cfg)
+	if err != nil {
+		return nil, err
+	}
+
+	return &passkeyService{
+		rc:          rc,
+		passkeyRepo: passkeyRepo,
+		userRepo:    userRepo,
+		rp:          rp,
+		log:         log.With(logger.String("module", "passkey")),
+	}, nil
+}
+
+// List returns the passkeys of the user.
+func (s *passkeyService) List(ctx context.Context, userID uint) ([]*entity.Passkey, error) {
+	return s.passkeyRepo.ListByUser(ctx, userID)
+}
+
+// BeginRegistration issues the options for creating a passkey. Passkeys the
+// user already has are excluded so an authenticator is not registered twice.
+func (s *passkeyService) BeginRegistration(ctx context.Context, userID uint) (*webauthn.CreationOptions, error) {
+	user, err := s.userRepo.GetByID(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+	existing, err := s.passkeyRepo.ListByUser(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	exclude := make([]webauthn.CredentialDescriptor, 0, len(existing))
+	for _, p := range existing {
+		id, err := base64.RawURLEncoding.DecodeString(p.CredentialID)
+		if err != nil {
+			continue
+		}
+		exclude = append(exclude, webauthn.CredentialDescriptor{
+			Type:       "public-key",
+			ID:         id,
+			Transports: p.Transports,
+		})
+	}
+
+	challenge, err := s.issueChallenge(ctx, registerChallengeKey(userID))
+	if err != nil {
+		return nil, err
+	}
+
+	return s.rp.CreationOptions(challenge, webauthn.UserEntity{
+		ID:          user.UUID[:],
+		Name:        user.Email,
+		DisplayName: user.Username,
+	}, exclude, passkeyChallengeTTL), nil
+}
+
+// FinishRegistration verifies the new credential against the pending challenge and stores it.
+func (s *passkeyService) FinishRegistration(ctx context.Context, userID uint, name string, resp *webauthn.RegistrationResponse) (*entity.Passkey, error) {
+	challenge, err := s.consumeChallenge(ctx, registerChallengeKey(userID))
+	if err != nil {
+		return nil, err
+	}
+
+	cred, err := s.rp.VerifyRegistration(challenge, resp)
+	if err != nil {
+		return nil, errx.New(errx.CodeInvalidParam, fmt.Errorf("passkey registration failed: %w", err))
+	}
+
+	if name == "" {
+		name = defaultPasskeyName
+	}
+	var aaguid string
+	if id, err := uuid.FromBytes(cred.AAGUID); err == nil && id != uuid.Nil {
+		aaguid = id.String()
+	}
+
+	created, err := s.passkeyRepo.Create(ctx, &entity.Passkey{
+		UserID:       userID,
+		CredentialID: base64.RawURLEncoding.EncodeToString(cred.ID),
+		PublicKey:    cred.PublicKey,
+		Algorithm:    int(cred.Algorithm),
+		SignCount:    cred.SignCount,
+		Name:         name,
+		AAGUID:       aaguid,
+		Transports:   cred.Transports,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	s.log.Info("passkey registered",
+		logger.Int("user_id", int(userID)),
+		logger.Int("passkey_id", int(created.ID)),
+	)
+	return created, nil
+}
+
+// Delete removes a passkey of the user.
+func (s *passkeyService) Delete(ctx context.Context, userID, id uint) error {
+	if err := s.passkeyRepo.Delete(ctx, userID, id); err != nil {
+		return err
+	}
+
+	s.log.Info("passkey removed",
+		logger.Int("user_id", int(userID)),
+		logger.Int("passkey_id", int(id)),
+	)
+	return nil
+}
+
+// BeginLogin issues the options for a passwordless login. No account is
+// named, the authenticator offers the passkeys it holds for this site.
+func (s *passkeyService) BeginLogin(ctx context.Context) (*PasskeyLoginChallenge, error) {
+	id := utils.RandomString(43, "ABCDEFGHIJKLMNOPQRSTUVWXYZabcdefghijklmnopqrstuvwxyz0123456789")
+	challenge, err := s.issueChallenge(ctx, passkeyLoginKeyPrefix+id)
+	if err != nil {
+		return nil, err
+	}
+
+	return &PasskeyLoginChallenge{
+		ID:      id,
+		Options: s.rp.RequestOptions(challenge, passkeyChallengeTTL),
+	}, nil
+}
+
+// Authenticate verifies a login response and records the new signature counter.
+//
+// Every failure is reported the same way so responses do not reveal which
+// credentials exist.
+func (s *passkeyService) Authenticate(ctx context.Context, challengeID string, resp *webauthn.AssertionResponse) (uint, bool, error) {
+	challenge, err := s.consumeChallenge(ctx, passkeyLoginKeyPrefix+challengeID)
+	if err != nil {
+		return 0, false, err
+	}
+
+	invalid := errx.New(errx.CodeUnauthorized, fmt.Errorf("passkey login failed"))
+
+	p, err := s.passkeyRepo.GetByCredentialID(ctx, base64.RawURLEncoding.EncodeToString(resp.RawID))
+	if err != nil {
+		if errx.ToAppError(err).Code == errx.CodeNotFound {
+			return 0, false, invalid
+		}
+		return 0, false, err
+	}
+	user, err := s.userRepo.GetByID(ctx, p.UserID)
+	if err != nil {
+		if errx.ToAppError(err).Code == errx.CodeNotFound {
+			return 0, false, invalid
+		}
+		return 0, false, err
+	}
+	if len(resp.Response.UserHandle) > 0 && string(resp.Response.UserHandle) != string(user.UUID[:]) {
+		return 0, false, invalid
+	}
+
+	assertion, err := s.rp.VerifyAssertion(challenge, resp, p.PublicKey, p.SignCount)
+	if err != nil {
+		s.log.Warn("passkey assertion rejected",
+			logger.Int("user_id", int(p.UserID)),
+			logger.Int("passkey_id", int(p.ID)),
+			logger.Err(err),
+		)
+		return 0, false, invalid
+	}
+
+	if err := s.passkeyRepo.UpdateUsage(ctx, p.ID, assertion.SignCount, time.Now()); err != nil {
+		return 0, false, err
+	}
+
+	return p.UserID, assertion.UserVerified, nil
+}
+
+// issueChallenge stores a new challenge under key and returns it.
+func (s *passkeyService) issueChallenge(ctx context.Context, key string) ([]byte, error) {
+	challenge, err := webauthn.NewChallenge()
+	if err != nil {
+		return nil, errx.New(errx.CodeInternalError, err)
+	}
+	if err := s.rc.Set(ctx, key, base64.RawURLEncoding.EncodeToString(challenge), passkeyChallengeTTL); err != nil {
+		return nil, errx.New(errx.CodeInternalError, err)
+	}
+	return challenge, nil
+}
+
+// consumeChallenge loads and deletes the challenge under key, so every
+// challenge can be answered only once.
+func (s *passkeyService) consumeChallenge(ctx context.Context, key string) ([]byte, error) {
+	raw, err := s.rc.Get(ctx, key)
+	if err != nil {
+		if errx.ToAppError(err).Code == errx.CodeNotFound {
+			return nil, errx.New(errx.CodeUnauthorized, fmt.Errorf("passkey challenge expired or invalid"))
+		}
+		return nil, errx.New(errx.CodeInternalError, err)
+	}
+	if err := s.rc.Delete(ctx, key); err != nil {
+		return nil, errx.New(errx.CodeInternalError, err)
+	}
+
+	challenge, err := base64.RawURLEncoding.DecodeString(raw)
+	if err != nil {
+		return nil, errx.New(errx.CodeInternalError, err)
+	}
+	return challenge, nil
+}
+
+// registerChallengeKey returns the cache key of a pending registration.
+func registerChallengeKey(userID uint) string {
+	return fmt.Sprintf("%s%d", passkeyRegisterKeyPrefix, userID)
+}
+
+// relyingParty builds the relying party from the configuration, defaulting
+// to the application domain.
+func relyingParty(cfg *config.Config) (*webauthn.RelyingParty, error) {
+	waCfg := cfg.Auth.WebAuthn
+	rp := &webauthn.RelyingParty{
+		ID:      waCfg.RPID,
+		Name:    waCfg.RPName,
+		Origins: waCfg.Origins,
+	}
+	if rp.Name == "" {
+		rp.Name = cfg.App.Name
+	}
+	if len(rp.Origins) == 0 && cfg.App.Domain != "" {
+		rp.Origins = []string{cfg.App.Domain}
+	}
+	if rp.ID == "" && cfg.App.Domain != "" {
+		u, err := url.Parse(cfg.App.Domain)
+		if err != nil {
+			return nil, errx.New(errx.CodeInternalError, fmt.Errorf("invalid app domain %q: %w", cfg.App.Domain, err))
+		}
+		rp.ID = u.Hostname()
+	}
+	return rp, nil
+}
@@ -13,8 +13,10 @@ import (
 	"blog-server/contextx"
 	"blog-server/entity"
 	"blog-server/logger"
+	"blog-server/pkg/errx"
 	"blog-server/pkg/txmgr"
 	"blog-server/repository"
+	"blog-server/utils"
 )
 
 // PostService defines the interface for post business logic operations.
@@ -23,11 +25,16 @@ type PostService interface {
 	GetPostsWithContent(ctx context.Context) ([]*entity.Post, error)
 	GetPostsMeta(ctx context.Context) []*entity.Post
 	GetPostByID(ctx context.Context, id uint) (*entity.Post, error)
+	GetPostBySlug(ctx context.Context, slug string) (*entity.Post, string, error)
 	CreatePost(ctx context.Context, user contextx.User, input *CreatePostInput) (*entity.Post, error)
 	FlushViewCountToDB(ctx context.Context) error
+	BackfillSlugs(ctx context.Context) error
+	BackfillSearchIndex(ctx context.Context) error
+	SearchPosts(ctx context.Context, input *SearchPostsInput) ([]*PostSearchResult, int, error)
+	PublishDuePosts(ctx context.Context) error
 
-	AdminGetPosts(ctx context.Context, status *entity.PostStatus, keyword *string, page, pageSize int) ([]*entity.Post, int, error)
-	AdminGetPostByID(ctx context.Context, id uint) (*entity.Post, error)
+	AdminGetPosts(ctx context.Context, user contextx.User, status *entity.PostStatus, keyword *string, page, pageSize int) ([]*entity.Post, int, error)
+	AdminGetPostByID(ctx context.Context, user contextx.User, id uint) (*entity.Post, error)
 	UpdatePost(ctx context.Context, user contextx.User, input *UpdatePostInput) (*entity.Post, error)
 	DeletePost(ctx context.Context, user contextx.User, id uint) error
 }
@@ -35,11 +42,15 @@ type PostService interface {
 // CreatePostInput groups all parameters for creating a post.
 type CreatePostInput struct {
 	Title   string
+	Slug    *string
 	Summary *string
 	Content string
 	Cover   *string
 	Status  entity.PostStatus
 
+	// PublishAt is when a scheduled post is published.
+	PublishAt *time.Time
+
 	UserID uint
 
 	CategoryIDs []uint
@@ -51,21 +62,42 @@ type UpdatePostInput struct {
 	ID uint
 
 	Title       *string
+	Slug        *string
 	Summary     *string
 	Cover       *string
 	Content     *string
 	Status      *entity.PostStatus
+	PublishAt   *time.Time
 	CategoryIDs *[]uint
 	Tags        *[]uint
 }
 
+// SearchPostsInput groups all parameters for searching published posts.
+type SearchPostsInput struct {
+	Query      string
+	TagID      *uint
+	CategoryID *uint
+	Page       int
+	PageSize   int
+}
+
+// PostSearchResult is a post matching a search. Title and Snippet are HTML
+// with the matches wrapped in <mark>.
+type PostSearchResult struct {
+	Post    *entity.Post
+	Title   string
+	Snippet string
+}
+
 // postService implements the PostService interface.
 type postService struct {
-	tx    txmgr.TxManager
-	log   logger.Logger
-	rc    cache.CacheClient
-	pr    repository.PostRepo
-	authz *authz.Authorizer
+	tx        txmgr.TxManager
+	log       logger.Logger
+	rc        cache.CacheClient
+	pr        repository.PostRepo
+	revisions PostRevisionService
+	events    PostEvents
+	authz     *authz.Authorizer
 }
 
 // NewPostService creates and returns a new PostService instance.
@@ -74,14 +106,18 @@ func NewPostService(
 	log logger.Logger,
 	pr repository.PostRepo,
 	rc cache.CacheClient,
+	revisions PostRevisionService,
+	events PostEvents,
 	authz *authz.Authorizer,
 ) PostService {
 	return &postService{
-		log:   log,
-		rc:    rc,
-		tx:    tx,
-		pr:    pr,
-		authz: authz,
+		log:       log,
+		rc:        rc,
+		tx:        tx,
+		pr:        pr,
+		revisions: revisions,
+		events:    events,
+		authz:     authz,
 	}
 }
 
@@ -121,15 +157,40 @@ func (s *postService) GetPostByID(ctx context.Context, id uint) (*entity.Post, e
 		return nil, err
 	}
 
-	go func(postID uint) {
+	s.countView(post.ID)
+
+	return post, nil
+}
+
+// GetPostBySlug retrieves a single published post by slug and asynchronously
+// increments view count. For a former slug of a post it returns no post but
+// the current slug, to redirect to.
+func (s *postService) GetPostBySlug(ctx context.Context, slug string) (*entity.Post, string, error) {
+	post, err := s.pr.GetPublishedBySlug(ctx, slug)
+	if err == nil {
+		s.countView(post.ID)
+		return post, "", nil
+	}
+	if errx.ToAppError(err).Code != errx.CodeNotFound {
+		return nil, "", err
+	}
+
+	current, err := s.pr.GetSlugFromHistory(ctx, slug)
+	if err != nil {
+		return nil, "", err
+	}
+	return nil, current, nil
+}
+
+// countView increments the view count of a post in the background.
+func (s *postService) countView(postID uint) {
+	go func() {
 		bgCtx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
 		defer cancel()
 		if _, err := s.rc.Incr(bgCtx, fmt.Sprintf("blog:post:view_count:%d", postID)); err != nil {
 			s.log.Error("incr post view count failed", logger.Err(err))
 		}
-	}(post.ID)
-
-	return post, nil
+	}()
 }
 
 // CreatePost creates a new post with tags and categories.
@@ -137,20 +198,25 @@ func (s *postService) CreatePost(ctx context.Context, user contextx.User, input
 	if err := s.authz.Authorize(ctx, user.ID, user.Role, authz.ResourcePost, authz.ActionCreate, nil); err != nil {
 		return nil, err
 	}
-	contentLength := utf8.RuneCountInString(input.Content)
-
-	readTime := uint(max(1, (contentLength+199)/200))
+	if err := checkSchedule(&input.Status, input.PublishAt); err != nil {
+		return nil, err
+	}
+	slug, err := s.resolveSlug(ctx, input.Slug, input.Title, 0)
+	if err != nil {
+		return nil, err
+	}
 
 	post := &entity.Post{
 		Title:           input.Title,
+		Slug:            slug,
 		Summary:         input.Summary,
 		Cover:           input.Cover,
 		Content:         input.Content,
-		ReadTimeMinutes: readTime,
+		ReadTimeMinutes: readTimeMinutes(input.Content),
 		UserID:          input.UserID,
 		Status:          input.Status,
+		PublishedAt:     input.PublishAt,
 	}
-	var err error
 
 	err = s.tx.WithTx(ctx, func(ctx context.Context) error {
 		post, err = s.pr.Create(ctx, post)
@@ -165,6 +231,14 @@ func (s *postService) CreatePost(ctx context.Context, user contextx.User, input
 			return err
 		}
 
+		if err = indexPost(ctx, s.pr, post.ID); err != nil {
+			return err
+		}
+
+		if err = s.revisions.Record(ctx, post.ID, &user.ID, nil); err != nil {
+			return err
+		}
+
 		post, err = s.pr.GetAdminListItemByID(ctx, post.ID)
 		if err != nil {
 			return err
@@ -175,16 +249,26 @@ func (s *postService) CreatePost(ctx context.Context, user contextx.User, input
 		return nil, err
 	}
 
+	if post.Status == entity.PostStatusPublish {
+		s.events.Emit(ctx, PostEvent{Type: PostEventPublished, Post: post, At: time.Now()})
+	}
+
 	return post, nil
 }
 
-// AdminGetPosts retrieves all posts inclueing drafts for admin.
-func (s *postService) AdminGetPosts(ctx context.Context, status *entity.PostStatus, keyword *string, page, pageSize int) ([]*entity.Post, int, error) {
-	count, err := s.pr.CountAll(ctx, status, keyword)
+// AdminGetPosts retrieves all posts inclueing drafts for admin. Authors only
+// see their own posts.
+func (s *postService) AdminGetPosts(ctx context.Context, user contextx.User, status *entity.PostStatus, keyword *string, page, pageSize int) ([]*entity.Post, int, error) {
+	authorID, err := s.authz.OwnerScope(ctx, user.ID, user.Role, authz.ResourcePost, authz.ActionUpdate)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	count, err := s.pr.CountAll(ctx, authorID, status, keyword)
 	if err != nil {
 		return nil, 0, err
 	}
-	ps, err := s.pr.ListAll(ctx, status, keyword, page, pageSize)
+	ps, err := s.pr.ListAll(ctx, authorID, status, keyword, page, pageSize)
 	if err != nil {
 		return nil, 0, err
 	}
@@ -193,7 +277,10 @@ func (s *postService) AdminGetPosts(ctx context.Context, status *entity.PostStat
 }
 
 // AdminGetPostByID retrieves a single post by ID for admin.
-func (s *postService) AdminGetPostByID(ctx context.Context, id uint) (*entity.Post, error) {
+func (s *postService) AdminGetPostByID(ctx context.Context, user contextx.User, id uint) (*entity.Post, error) {
+	if err := s.authz.Authorize(ctx, user.ID, user.Role, authz.ResourcePost, authz.ActionUpdate, &id); err != nil {
+		return nil, err
+	}
 	return s.pr.GetByID(ctx, id)
 }
 
@@ -202,9 +289,13 @@ func (s *postService) UpdatePost(ctx context.Context, user contextx.User, input
 	if err := s.authz.Authorize(ctx, user.ID, user.Role, authz.ResourcePost, authz.ActionUpdate, &input.ID); err != nil {
 		return nil, err
 	}
+	if err := checkSchedule(input.Status, input.PublishAt); err != nil {
+		return nil, err
+	}
 
 	post := &entity.Post{
-		ID: input.ID,
+		ID:          input.ID,
+		PublishedAt: input.PublishAt,
 	}
 
 	if input.Title != nil {
@@ -218,19 +309,57 @@ func (s *postService) UpdatePost(ctx context.Context, user contextx.User, input
 	}
 	if input.Content != nil {
 		post.Content = *input.Content
-		contentLength := utf8.RuneCountInString(post.Content)
-		post.ReadTimeMinutes = uint(max(1, (contentLength+199)/200))
+		post.ReadTimeMinutes = readTimeMinutes(post.Content)
 	}
 	if input.Status != nil {
 		post.Status = *input.Status
 	}
 
+	var slug string
+	if input.Slug != nil {
+		var err error
+		if slug, err = s.resolveSlug(ctx, input.Slug, "", input.ID); err != nil {
+			return nil, err
+		}
+	}
+
+	published := false
 	err := s.tx.WithTx(ctx, func(ctx context.Context) error {
+		if post.Status == entity.PostStatusPublish {
+			before, err := s.pr.GetByID(ctx, input.ID)
+			if err != nil {
+				return err
+			}
+			// published_at records the first publication, or the last
+			// publication after being unpublished, not every save.
+			published = before.Status != entity.PostStatusPublish
+			if published {
+				now := time.Now()
+				post.PublishedAt = &now
+			}
+		}
+
+		// Keep the version being overwritten if it has no revision yet, as
+		// for posts written before revisions existed.
+		if err := s.revisions.Record(ctx, input.ID, nil, nil); err != nil {
+			return err
+		}
+
 		var err error
 		if err = s.pr.Update(ctx, post); err != nil {
 			return err
 		}
 
+		if err = indexPost(ctx, s.pr, input.ID); err != nil {
+			return err
+		}
+
+		if slug != "" {
+			if err = s.pr.SetSlug(ctx, input.ID, slug); err != nil {
+				return err
+			}
+		}
+
 		if input.Tags != nil {
 			if err = s.pr.SetTags(ctx, input.ID, *input.Tags); err != nil {
 				return err
@@ -243,6 +372,10 @@ func (s *postService) UpdatePost(ctx context.Context, user contextx.User, input
 			}
 		}
 
+		if err = s.revisions.Record(ctx, input.ID, &user.ID, nil); err != nil {
+			return err
+		}
+
 		post, err = s.pr.GetAdminListItemByID(ctx, input.ID)
 		if err != nil {
 			return err
@@ -253,6 +386,10 @@ func (s *postService) UpdatePost(ctx context.Context, user contextx.User, input
 		return nil, err
 	}
 
+	if published {
+		s.events.Emit(ctx, PostEvent{Type: PostEventPublished, Post: post, At: time.Now()})
+	}
+
 	return post, nil
 }
 
@@ -323,3 +460,223 @@ func (s *postService) FlushViewCountToDB(ctx context.Context) error {
 	}
 	return nil
 }
+
+// BackfillSlugs gives every post without a slug one generated from its title.
+func (s *postService) BackfillSlugs(ctx context.Context) error {
+	posts, err := s.pr.ListWithoutSlug(ctx)
+	if err != nil {
+		return err
+	}
+
+	for _, post := range posts {
+		err := s.tx.WithTx(ctx, func(ctx context.Context) error {
+			slug, err := s.resolveSlug(ctx, nil, post.Title, post.ID)
+			if err != nil {
+				return err
+			}
+			return s.pr.SetSlug(ctx, post.ID, slug)
+		})
+		if err != nil {
+			return err
+		}
+	}
+
+	if len(posts) > 0 {
+		s.log.Info("post slugs backfilled", logger.Int("count", len(posts)))
+	}
+	return nil
+}
+
+// snippetLength is the number of characters of content in a search result.
+const snippetLength = 160
+
+// SearchPosts runs a full-text search of published posts, most relevant
+// first, and highlights the matches in the title and an excerpt of the
+// summary or content.
+func (s *postService) SearchPosts(ctx context.Context, input *SearchPostsInput) ([]*PostSearchResult, int, error) {
+	terms := utils.SearchTerms(input.Query)
+	if len(terms) == 0 {
+		return nil, 0, errx.New(errx.CodeInvalidParam, fmt.Errorf("search query must contain letters or digits"))
+	}
+	filter := &entity.PostSearchFilter{
+		Terms:      terms,
+		TagID:      input.TagID,
+		CategoryID: input.CategoryID,
+	}
+
+	count, err := s.pr.CountSearch(ctx, filter)
+	if err != nil {
+		return nil, 0, err
+	}
+	posts, err := s.pr.Search(ctx, filter, input.Page, input.PageSize)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	results := make([]*PostSearchResult, len(posts))
+	for i, post := range posts {
+		title, _ := utils.Highlight(post.Title, terms, utf8.RuneCountInString(post.Title))
+		snippet, ok := utils.Highlight(utils.StripMarkdown(stringValue(post.Summary)), terms, snippetLength)
+		if !ok {
+			if content, ok := utils.Highlight(utils.StripMarkdown(post.Content), terms, snippetLength); ok || snippet == "" {
+				snippet = content
+			}
+		}
+		results[i] = &PostSearchResult{Post: post, Title: title, Snippet: snippet}
+	}
+	return results, count, nil
+}
+
+// BackfillSearchIndex indexes posts created before search existed.
+func (s *postService) BackfillSearchIndex(ctx context.Context) error {
+	ids, err := s.pr.ListUnindexed(ctx)
+	if err != nil {
+		return err
+	}
+
+	for _, id := range ids {
+		if err := indexPost(ctx, s.pr, id); err != nil {
+			return err
+		}
+	}
+
+	if len(ids) > 0 {
+		s.log.Info("post search index backfilled", logger.Int("count", len(ids)))
+	}
+	return nil
+}
+
+// indexPost writes the search index of a post from its current title,
+// summary and content.
+func indexPost(ctx context.Context, pr repository.PostRepo, id uint) error {
+	post, err := pr.GetByID(ctx, id)
+	if err != nil {
+		return err
+	}
+	return pr.SetSearchIndex(ctx, id,
+		utils.SearchDocument(post.Title),
+		utils.SearchDocument(stringValue(post.Summary)),
+		utils.SearchDocument(post.Content),
+	)
+}
+
+// publishBatchSize is how many due posts PublishDuePosts publishes at most in
+// one run. The rest are left to the next run.
+const publishBatchSize = 100
+
+// PublishDuePosts publishes the scheduled posts that are due.
+//
+// Whether a post is due is kept in the database only, so posts that fell due
+// while the server was down are published on the next run. Each post is
+// published by a conditional update, so with several servers running the
+// job only one of them publishes it and emits the event.
+func (s *postService) PublishDuePosts(ctx context.Context) error {
+	now := time.Now()
+	ids, err := s.pr.ListDueScheduled(ctx, now, publishBatchSize)
+	if err != nil {
+		return err
+	}
+
+	for _, id := range ids {
+		ok, err := s.pr.PublishScheduled(ctx, id, now)
+		if err != nil {
+			return err
+		}
+		if !ok {
+			continue
+		}
+
+		post, err := s.pr.GetByID(ctx, id)
+		if err != nil {
+			return err
+		}
+		s.log.Info("scheduled post published", logger.Int("post_id", int(id)))
+		s.events.Emit(ctx, PostEvent{Type: PostEventPublished, Post: post, At: now})
+	}
+	return nil
+}
+
+// checkSchedule checks that a publish time is given exactly for scheduled
+// posts, and is in the future.
+func checkSchedule(status *entity.PostStatus, publishAt *time.Time) error {
+	scheduled := status != nil && *status == entity.PostStatusScheduled
+	switch {
+	case scheduled && publishAt == nil:
+		return errx.New(errx.CodeInvalidParam, fmt.Errorf("publishAt is required for scheduled posts"))
+	case !scheduled && publishAt != nil:
+		return errx.New(errx.CodeInvalidParam, fmt.Errorf("publishAt is only allowed for scheduled posts"))
+	case scheduled && !publishAt.After(time.Now()):
+		return errx.New(errx.CodeInvalidParam, fmt.Errorf("publishAt must be in the future"))
+	}
+	return nil
+}
+
+// readTimeMinutes estimates the reading time of post content at 200
+// characters per minute.
+func readTimeMinutes(content string) uint {
+	return uint(max(1, (utf8.RuneCountInString(content)+199)/200))
+}
+
+// slugAlphabet is used for the random part of fallback slugs.
+const slugAlphabet = "abcdefghijklmnopqrstuvwxyz0123456789"
+
+// maxSlugSuffix is how far numbered slugs such as "title-2" are tried before
+// a random suffix is used.
+const maxSlugSuffix = 20
+
+// resolveSlug returns the slug to store for a post.
+//
+// A requested slug is normalized and must not belong to another post.
+// Otherwise the slug is generated from the title, falling back to a random
+// one for titles without letters or digits, and numbered if already taken.
+// Slugs of digits only would be taken for post IDs in URLs, so requested ones
+// are rejected and generated ones are prefixed.
+func (s *postService) resolveSlug(ctx context.Context, requested *string, title string, postID uint) (string, error) {
+	if requested != nil {
+		slug := utils.Slugify(*requested)
+		if slug == "" {
+			return "", errx.New(errx.CodeInvalidParam, fmt.Errorf("slug must contain letters or digits"))
+		}
+		if numericSlug(slug) {
+			return "", errx.New(errx.CodeInvalidParam, fmt.Errorf("slug %q must not consist of digits only", slug))
+		}
+		taken, err := s.pr.SlugTaken(ctx, slug, postID)
+		if err != nil {
+			return "", err
+		}
+		if taken {
+			return "", errx.New(errx.CodeConflict, fmt.Errorf("slug %q is already in use", slug))
+		}
+		return slug, nil
+	}
+
+	base := utils.Slugify(title)
+	switch {
+	case base == "":
+		base = "post-" + utils.RandomString(8, slugAlphabet)
+	case numericSlug(base):
+		base = "post-" + base
+	}
+
+	slug := base
+	for i := 2; ; i++ {
+		taken, err := s.pr.SlugTaken(ctx, slug, postID)
+		if err != nil {
+			return "", err
+		}
+		if !taken {
+			return slug, nil
+		}
+		if i > maxSlugSuffix {
+			slug = base + "-" + utils.RandomString(6, slugAlphabet)
+			continue
+		}
+		slug = fmt.Sprintf("%s-%d", base, i)
+	}
+}
+
+// numericSlug reports whether a slug consists of ASCII digits only, which
+// the frontend routes as a post ID.
+func numericSlug(slug string) bool {
+	return strings.Trim(slug, "0123456789") == ""
+}
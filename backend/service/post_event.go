@@ -0,0 +1,83 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"time"
+
+	"blog-server/entity"
+	"blog-server/logger"
+)
+
+// PostEventType is what happened to a post.
+type PostEventType string
+
+const (
+	// PostEventPublished is emitted when a post becomes public, either by
+	// an editor or by the scheduled publishing job.
+	PostEventPublished PostEventType = "post.published"
+)
+
+// PostEvent is emitted after a change to a post has been committed.
+type PostEvent struct {
+	Type PostEventType
+	Post *entity.Post
+	At   time.Time
+}
+
+// PostEventHandler handles a post event.
+type PostEventHandler func(ctx context.Context, event PostEvent)
+
+// PostEvents dispatches post events to in-process subscribers, such as
+// cache invalidation and feed pings.
+type PostEvents interface {
+	Subscribe(handler PostEventHandler)
+	Emit(ctx context.Context, event PostEvent)
+}
+
+// postEvents implements the PostEvents interface.
+type postEvents struct {
+	mu       sync.RWMutex
+	handlers []PostEventHandler
+	log      logger.Logger
+}
+
+// NewPostEvents creates and returns a new PostEvents instance.
+func NewPostEvents(log logger.Logger) PostEvents {
+	return &postEvents{log: log.With(logger.String("module", "post_events"))}
+}
+
+// Subscribe implements [PostEvents].
+func (e *postEvents) Subscribe(handler PostEventHandler) {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+	e.handlers = append(e.handlers, handler)
+}
+
+// Emit calls the subscribers in the order they subscribed. A panicking
+// subscriber is logged and does not keep the event from the others.
+func (e *postEvents) Emit(ctx context.Context, event PostEvent) {
+	e.mu.RLock()
+	handlers := e.handlers
+	e.mu.RUnlock()
+
+	e.log.Info("post event",
+		logger.String("type", string(event.Type)),
+		logger.Int("post_id", int(event.Post.ID)),
+	)
+
+	for _, handler := range handlers {
+		func() {
+			defer func() {
+				if r := recover(); r != nil {
+					e.log.Error("post event handler panicked",
+						logger.String("type", string(event.Type)),
+						logger.Err(fmt.Errorf("%v", r)),
+					)
+				}
+			}()
+			handler(ctx, event)
+		}()
+	}
+}
@@ -0,0 +1,244 @@
+package service
+
+import (
+	"context"
+	"time"
+
+	"blog-server/authz"
+	"blog-server/config"
+	"blog-server/contextx"
+	"blog-server/entity"
+	"blog-server/logger"
+	"blog-server/pkg/errx"
+	"blog-server/pkg/txmgr"
+	"blog-server/repository"
+	"blog-server/utils"
+)
+
+// PostRevisionService defines the interface for the revision history of posts.
+//
+// A revision snapshots the title, summary and content of a post. One is
+// recorded in the transaction of every change, so the latest revision always
+// matches the post. Old revisions are pruned by config.RevisionConfig.
+type PostRevisionService interface {
+	// Record snapshots the post as a new revision, unless its latest revision
+	// already matches. It must run in the transaction of the change.
+	Record(ctx context.Context, postID uint, userID *uint, restoredFrom *uint) error
+
+	ListRevisions(ctx context.Context, user contextx.User, postID uint, page, pageSize int) ([]*entity.PostRevision, int, error)
+	GetRevision(ctx context.Context, user contextx.User, postID, version uint) (*entity.PostRevision, error)
+	DiffRevisions(ctx context.Context, user contextx.User, postID, from, to uint) (*RevisionDiff, error)
+	RestoreRevision(ctx context.Context, user contextx.User, postID, version uint) (*entity.Post, error)
+
+	PruneRevisions(ctx context.Context) error
+}
+
+// RevisionDiff is the line-level diff of the content of two revisions.
+type RevisionDiff struct {
+	From  *entity.PostRevision
+	To    *entity.PostRevision
+	Lines []utils.DiffLine
+}
+
+// postRevisionService implements the PostRevisionService interface.
+type postRevisionService struct {
+	tx        txmgr.TxManager
+	pr        repository.PostRepo
+	rr        repository.PostRevisionRepo
+	authz     *authz.Authorizer
+	retention config.RevisionConfig
+	log       logger.Logger
+}
+
+// NewPostRevisionService creates and returns a new PostRevisionService instance.
+func NewPostRevisionService(
+	cfg *config.Config,
+	tx txmgr.TxManager,
+	pr repository.PostRepo,
+	rr repository.PostRevisionRepo,
+	authz *authz.Authorizer,
+	log logger.Logger,
+) PostRevisionService {
+	return &postRevisionService{
+		tx:        tx,
+		pr:        pr,
+		rr:        rr,
+		authz:     authz,
+		retention: cfg.Post.Revisions,
+		log:       log.With(logger.String("module", "post_revision")),
+	}
+}
+
+// Record implements [PostRevisionService].
+func (s *postRevisionService) Record(ctx context.Context, postID uint, userID *uint, restoredFrom *uint) error {
+	post, err := s.pr.GetByID(ctx, postID)
+	if err != nil {
+		return err
+	}
+
+	latest, err := s.rr.GetLatest(ctx, postID)
+	if err != nil && errx.ToAppError(err).Code != errx.CodeNotFound {
+		return err
+	}
+	if latest != nil && latest.Title == post.Title && latest.Content == post.Content &&
+		stringValue(latest.Summary) == stringValue(post.Summary) {
+		return nil
+	}
+
+	_, err = s.rr.Create(ctx, &entity.PostRevision{
+		PostID:       postID,
+		UserID:       userID,
+		Title:        post.Title,
+		Summary:      post.Summary,
+		Content:      post.Content,
+		RestoredFrom: restoredFrom,
+	})
+	if err != nil {
+		return err
+	}
+
+	if s.retention.MaxPerPost > 0 {
+		if _, err := s.rr.DeleteBeyond(ctx, postID, s.retention.MaxPerPost); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// ListRevisions returns the revisions of a post without content, newest first.
+func (s *postRevisionService) ListRevisions(ctx context.Context, user contextx.User, postID uint, page, pageSize int) ([]*entity.PostRevision, int, error) {
+	if err := s.authorize(ctx, user, postID); err != nil {
+		return nil, 0, err
+	}
+
+	count, err := s.rr.CountByPost(ctx, postID)
+	if err != nil {
+		return nil, 0, err
+	}
+	revs, err := s.rr.ListByPost(ctx, postID, page, pageSize)
+	if err != nil {
+		return nil, 0, err
+	}
+	return revs, count, nil
+}
+
+// GetRevision returns one revision of a post with its content.
+func (s *postRevisionService) GetRevision(ctx context.Context, user contextx.User, postID, version uint) (*entity.PostRevision, error) {
+	if err := s.authorize(ctx, user, postID); err != nil {
+		return nil, err
+	}
+	return s.rr.GetByVersion(ctx, postID, version)
+}
+
+// DiffRevisions returns the line-level diff of the content from one revision
+// of a post to another.
+func (s *postRevisionService) DiffRevisions(ctx context.Context, user contextx.User, postID, from, to uint) (*RevisionDiff, error) {
+	if err := s.authorize(ctx, user, postID); err != nil {
+		return nil, err
+	}
+
+	fromRev, err := s.rr.GetByVersion(ctx, postID, from)
+	if err != nil {
+		return nil, err
+	}
+	toRev, err := s.rr.GetByVersion(ctx, postID, to)
+	if err != nil {
+		return nil, err
+	}
+
+	return &RevisionDiff{
+		From:  fromRev,
+		To:    toRev,
+		Lines: utils.DiffLines(fromRev.Content, toRev.Content),
+	}, nil
+}
+
+// RestoreRevision puts the title, summary and content of a revision back
+// into the post. The restore is recorded as a new revision, so it can be
+// undone in turn.
+func (s *postRevisionService) RestoreRevision(ctx context.Context, user contextx.User, postID, version uint) (*entity.Post, error) {
+	if err := s.authorize(ctx, user, postID); err != nil {
+		return nil, err
+	}
+
+	var post *entity.Post
+	err := s.tx.WithTx(ctx, func(ctx context.Context) error {
+		rev, err := s.rr.GetByVersion(ctx, postID, version)
+		if err != nil {
+			return err
+		}
+
+		if err := s.Record(ctx, postID, nil, nil); err != nil {
+			return err
+		}
+
+		// Update keeps fields it is given empty, so a missing summary is
+		// restored as an empty one.
+		summary := stringValue(rev.Summary)
+		err = s.pr.Update(ctx, &entity.Post{
+			ID:              postID,
+			Title:           rev.Title,
+			Summary:         &summary,
+			Content:         rev.Content,
+			ReadTimeMinutes: readTimeMinutes(rev.Content),
+		})
+		if err != nil {
+			return err
+		}
+
+		if err := indexPost(ctx, s.pr, postID); err != nil {
+			return err
+		}
+
+		if err := s.Record(ctx, postID, &user.ID, &version); err != nil {
+			return err
+		}
+
+		post, err = s.pr.GetByID(ctx, postID)
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	s.log.Info("post revision restored",
+		logger.Int("post_id", int(postID)),
+		logger.Int("version", int(version)),
+		logger.Int("user_id", int(user.ID)),
+	)
+	return post, nil
+}
+
+// PruneRevisions deletes revisions older than the retention allows.
+func (s *postRevisionService) PruneRevisions(ctx context.Context) error {
+	if s.retention.MaxAge <= 0 {
+		return nil
+	}
+
+	n, err := s.rr.DeleteOlderThan(ctx, time.Now().Add(-s.retention.MaxAge))
+	if err != nil {
+		return err
+	}
+	if n > 0 {
+		s.log.Info("post revisions pruned", logger.Int("count", n))
+	}
+	return nil
+}
+
+// authorize checks that the post exists and the user may edit it, which the
+// revision history is part of.
+func (s *postRevisionService) authorize(ctx context.Context, user contextx.User, postID uint) error {
+	if err := s.authz.Authorize(ctx, user.ID, user.Role, authz.ResourcePost, authz.ActionUpdate, &postID); err != nil {
+		return err
+	}
+	_, err := s.pr.GetByID(ctx, postID)
+	return err
+}
+
+// stringValue returns the string s points to, or "" for nil.
+func stringValue(s *string) string {
+	if s == nil {
+		return ""
+	}
+	return *s
+}
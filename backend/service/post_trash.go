@@ -0,0 +1,178 @@
+package service
+
+import (
+	"context"
+	"time"
+
+	"blog-server/authz"
+	"blog-server/config"
+	"blog-server/contextx"
+	"blog-server/entity"
+	"blog-server/logger"
+	"blog-server/pkg/errx"
+	"blog-server/pkg/txmgr"
+	"blog-server/repository"
+)
+
+// PostTrashService defines the interface for the trash of deleted posts.
+//
+// Deleted posts stay in the trash until they are restored or purged. Purging
+// deletes a post for good, with its comments, relations, slug history and
+// revisions. Posts older than config.TrashConfig are purged daily.
+type PostTrashService interface {
+	ListTrash(ctx context.Context, user contextx.User, page, pageSize int) ([]*entity.Post, int, error)
+	RestorePost(ctx context.Context, user contextx.User, id uint) (*entity.Post, error)
+	PurgePost(ctx context.Context, user contextx.User, id uint) error
+	EmptyTrash(ctx context.Context, user contextx.User) (int, error)
+
+	PurgeExpired(ctx context.Context) error
+}
+
+// postTrashService implements the PostTrashService interface.
+type postTrashService struct {
+	tx        txmgr.TxManager
+	pr        repository.PostRepo
+	authz     *authz.Authorizer
+	retention time.Duration
+	log       logger.Logger
+}
+
+// NewPostTrashService creates and returns a new PostTrashService instance.
+func NewPostTrashService(
+	cfg *config.Config,
+	tx txmgr.TxManager,
+	pr repository.PostRepo,
+	authz *authz.Authorizer,
+	log logger.Logger,
+) PostTrashService {
+	return &postTrashService{
+		tx:        tx,
+		pr:        pr,
+		authz:     authz,
+		retention: cfg.Post.Trash.Retention,
+		log:       log.With(logger.String("module", "post_trash")),
+	}
+}
+
+// ListTrash returns the deleted posts, most recently deleted first. Authors
+// only see their own.
+func (s *postTrashService) ListTrash(ctx context.Context, user contextx.User, page, pageSize int) ([]*entity.Post, int, error) {
+	authorID, err := s.authz.OwnerScope(ctx, user.ID, user.Role, authz.ResourcePost, authz.ActionDelete)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	count, err := s.pr.CountDeleted(ctx, authorID)
+	if err != nil {
+		return nil, 0, err
+	}
+	ps, err := s.pr.ListDeleted(ctx, authorID, page, pageSize)
+	if err != nil {
+		return nil, 0, err
+	}
+	return ps, count, nil
+}
+
+// RestorePost takes a post out of the trash with the status it was deleted
+// with.
+func (s *postTrashService) RestorePost(ctx context.Context, user contextx.User, id uint) (*entity.Post, error) {
+	if err := s.authz.Authorize(ctx, user.ID, user.Role, authz.ResourcePost, authz.ActionDelete, &id); err != nil {
+		return nil, err
+	}
+
+	if err := s.pr.Restore(ctx, id); err != nil {
+		return nil, err
+	}
+	// Posts deleted before search existed have no index yet.
+	if err := indexPost(ctx, s.pr, id); err != nil {
+		return nil, err
+	}
+
+	s.log.Info("post restored",
+		logger.Int("post_id", int(id)),
+		logger.Int("user_id", int(user.ID)),
+	)
+	return s.pr.GetAdminListItemByID(ctx, id)
+}
+
+// PurgePost permanently deletes a post in the trash. Only admins may purge
+// posts; authors and editors can only restore them.
+func (s *postTrashService) PurgePost(ctx context.Context, user contextx.User, id uint) error {
+	if err := s.authz.Authorize(ctx, user.ID, user.Role, authz.ResourcePost, authz.ActionPurge, &id); err != nil {
+		return err
+	}
+
+	if err := s.purge(ctx, id); err != nil {
+		return err
+	}
+
+	s.log.Info("post purged",
+		logger.Int("post_id", int(id)),
+		logger.Int("user_id", int(user.ID)),
+	)
+	return nil
+}
+
+// EmptyTrash permanently deletes every post in the trash, and returns how
+// many were deleted. Only admins may empty the trash.
+func (s *postTrashService) EmptyTrash(ctx context.Context, user contextx.User) (int, error) {
+	if err := s.authz.Authorize(ctx, user.ID, user.Role, authz.ResourcePost, authz.ActionPurge, nil); err != nil {
+		return 0, err
+	}
+
+	ids, err := s.pr.ListDeletedIDs(ctx, nil, nil)
+	if err != nil {
+		return 0, err
+	}
+	n, err := s.purgeAll(ctx, ids)
+
+	s.log.Info("trash emptied",
+		logger.Int("count", n),
+		logger.Int("user_id", int(user.ID)),
+	)
+	return n, err
+}
+
+// PurgeExpired permanently deletes the posts that have been in the trash
+// longer than the retention allows.
+func (s *postTrashService) PurgeExpired(ctx context.Context) error {
+	if s.retention <= 0 {
+		return nil
+	}
+
+	before := time.Now().Add(-s.retention)
+	ids, err := s.pr.ListDeletedIDs(ctx, nil, &before)
+	if err != nil {
+		return err
+	}
+	n, err := s.purgeAll(ctx, ids)
+	if n > 0 {
+		s.log.Info("expired posts purged", logger.Int("count", n))
+	}
+	return err
+}
+
+// purgeAll purges the posts one by one, so that a failure keeps the posts
+// purged so far purged. Posts restored in the meantime are skipped. It
+// returns how many were purged.
+func (s *postTrashService) purgeAll(ctx context.Context, ids []uint) (int, error) {
+	n := 0
+	for _, id := range ids {
+		err := s.purge(ctx, id)
+		if err != nil && errx.ToAppError(err).Code == errx.CodeNotFound {
+			continue
+		}
+		if err != nil {
+			return n, err
+		}
+		n++
+	}
+	return n, nil
+}
+
+// purge permanently deletes a post in the trash in a transaction.
+func (s *postTrashService) purge(ctx context.Context, id uint) error {
+	return s.tx.WithTx(ctx, func(ctx context.Context) error {
+		return s.pr.Purge(ctx, id)
+	})
+}
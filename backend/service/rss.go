@@ -3,6 +3,7 @@ package service
 import (
 	"context"
 	"fmt"
+	"net/url"
 	"strconv"
 	"time"
 
@@ -162,7 +163,7 @@ func (s *rssService) getBuildTime(ctx context.Context) string {
 func (s *rssService) convertPostsToItems(posts []*entity.Post) []entity.RssItem {
 	items := make([]entity.RssItem, len(posts))
 	for i, post := range posts {
-		link := s.cfg.Domain + "/blog/" + strconv.Itoa(int(post.ID))
+		link := s.cfg.Domain + postPath(post)
 		items[i] = entity.RssItem{
 			Title:       post.Title,
 			Link:        link,
@@ -173,3 +174,12 @@ func (s *rssService) convertPostsToItems(posts []*entity.Post) []entity.RssItem
 	}
 	return items
 }
+
+// postPath returns the canonical frontend path of a post, by slug or by ID
+// for posts that have none yet.
+func postPath(post *entity.Post) string {
+	if post.Slug == "" {
+		return "/blog/" + strconv.Itoa(int(post.ID))
+	}
+	return "/blog/" + url.PathEscape(post.Slug)
+}
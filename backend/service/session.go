@@ -0,0 +1,343 @@
+package service
+
+import (
+	"context"
+	"crypto/sha256"
+	"crypto/subtle"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"sort"
+	"time"
+
+	"blog-server/cache"
+	"blog-server/config"
+	"blog-server/entity"
+	"blog-server/logger"
+	"blog-server/pkg/errx"
+	"blog-server/pkg/jwt"
+	"blog-server/repository"
+
+	"github.com/google/uuid"
+)
+
+const (
+	sessionKeyPrefix      = "blog:session:"
+	userSessionsKeyPrefix = "blog:user:sessions:"
+)
+
+// SessionMeta describes the client a session belongs to.
+type SessionMeta struct {
+	IP        string
+	UserAgent string
+	// TwoFactor records that the login passed a second factor. It is fixed
+	// when the session is created and ignored on refresh.
+	TwoFactor bool
+}
+
+// SessionTokens is the token pair issued for a session.
+type SessionTokens struct {
+	SessionID    string
+	AccessToken  string
+	RefreshToken string
+}
+
+// SessionService defines the interface for login session operations.
+//
+// Each login creates a session with its own refresh token, so a user can be
+// logged in on several devices and revoke them one by one. Revoking a session
+// also revokes the access tokens issued for it.
+type SessionService interface {
+	Create(ctx context.Context, userID uint, role entity.UserRole, meta SessionMeta) (*SessionTokens, error)
+	Refresh(ctx context.Context, refreshToken string, meta SessionMeta) (*SessionTokens, error)
+
+	List(ctx context.Context, userID uint) ([]*entity.Session, error)
+	Revoke(ctx context.Context, userID uint, sessionID string) error
+	RevokeAll(ctx context.Context, userID uint) error
+}
+
+// sessionService implements the SessionService interface.
+type sessionService struct {
+	rc       cache.CacheClient
+	jwt      jwt.Jwt
+	userRepo repository.UserRepo
+	denylist TokenDenylist
+	cfg      config.JWTConfig
+	log      logger.Logger
+}
+
+// NewSessionService creates and returns a new SessionService instance.
+func NewSessionService(
+	cfg *config.Config,
+	rc cache.CacheClient,
+	j jwt.Jwt,
+	userRepo repository.UserRepo,
+	denylist TokenDenylist,
+	log logger.Logger,
+) SessionService {
+	return &sessionService{
+		rc:       rc,
+		jwt:      j,
+		userRepo: userRepo,
+		denylist: denylist,
+		cfg:      cfg.JWT,
+		log:      log.With(logger.String("module", "session")),
+	}
+}
+
+// Create starts a new session and issues its first token pair.
+func (s *sessionService) Create(ctx context.Context, userID uint, role entity.UserRole, meta SessionMeta) (*SessionTokens, error) {
+	now := time.Now()
+	session := &entity.Session{
+		ID:         uuid.NewString(),
+		UserID:     userID,
+		Role:       role,
+		UserAgent:  meta.UserAgent,
+		IP:         meta.IP,
+		TwoFactor:  meta.TwoFactor,
+		CreatedAt:  now,
+		LastUsedAt: now,
+	}
+
+	tokens, err := s.issue(session)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := s.save(ctx, session); err != nil {
+		return nil, err
+	}
+	if err := s.rc.SAdd(ctx, userSessionsKey(userID), session.ID); err != nil {
+		return nil, errx.New(errx.CodeInternalError, fmt.Errorf("failed to index session for user %d: %w", userID, err))
+	}
+
+	return tokens, nil
+}
+
+// Refresh rotates the refresh token of a session and issues a new token pair.
+//
+// A refresh token is valid exactly once. Presenting an already rotated token
+// means it was copied, so the whole session is revoked. The role and status
+// of the user are read again, so role changes apply and disabled users are
+// logged out at the latest on the next refresh.
+func (s *sessionService) Refresh(ctx context.Context, refreshToken string, meta SessionMeta) (*SessionTokens, error) {
+	claims, err := s.jwt.Parse(refreshToken)
+	if err != nil {
+		return nil, err
+	}
+	if claims.Type != jwt.TokenTypeRefresh || claims.SessionID == "" {
+		return nil, errx.New(errx.CodeUnauthorized, fmt.Errorf("invalid refresh token"))
+	}
+
+	session, raw, err := s.load(ctx, claims.SessionID)
+	if err != nil {
+		return nil, err
+	}
+	if session.UserID != claims.ID {
+		return nil, errx.New(errx.CodeUnauthorized, fmt.Errorf("invalid refresh token"))
+	}
+
+	if subtle.ConstantTimeCompare([]byte(hashToken(refreshToken)), []byte(session.RefreshTokenHash)) == 0 {
+		return nil, s.revokeReused(ctx, session, meta)
+	}
+
+	user, err := s.userRepo.GetAuthByID(ctx, session.UserID)
+	if err != nil {
+		if errx.ToAppError(err).Code == errx.CodeNotFound {
+			return nil, errx.New(errx.CodeUnauthorized, fmt.Errorf("user %d of session %s no longer exists", session.UserID, session.ID))
+		}
+		return nil, err
+	}
+	if err := checkActive(user); err != nil {
+		if revokeErr := s.revoke(ctx, session.UserID, session.ID); revokeErr != nil {
+			return nil, revokeErr
+		}
+		return nil, err
+	}
+
+	session.Role = user.Role
+	session.LastUsedAt = time.Now()
+	session.IP = meta.IP
+	session.UserAgent = meta.UserAgent
+
+	tokens, err := s.issue(session)
+	if err != nil {
+		return nil, err
+	}
+
+	// Only one refresh of the session may store its rotated token. A
+	// concurrent refresh with the same token lost the race and counts as reuse.
+	data, err := json.Marshal(session)
+	if err != nil {
+		return nil, errx.New(errx.CodeInternalError, err)
+	}
+	swapped, err := s.rc.SetIfEqual(ctx, sessionKeyPrefix+session.ID, raw, string(data), time.Until(session.ExpiresAt))
+	if err != nil {
+		return nil, errx.New(errx.CodeInternalError, fmt.Errorf("failed to store session %s: %w", session.ID, err))
+	}
+	if !swapped {
+		return nil, s.revokeReused(ctx, session, meta)
+	}
+
+	return tokens, nil
+}
+
+// revokeReused revokes a session whose refresh token was presented twice.
+func (s *sessionService) revokeReused(ctx context.Context, session *entity.Session, meta SessionMeta) error {
+	s.log.Warn("refresh token reuse detected, revoking session",
+		logger.Int("user_id", int(session.UserID)),
+		logger.String("session_id", session.ID),
+		logger.String("ip", meta.IP),
+	)
+	if err := s.revoke(ctx, session.UserID, session.ID); err != nil {
+		return err
+	}
+	return errx.New(errx.CodeUnauthorized, fmt.Errorf("refresh token reuse detected"))
+}
+
+// List returns the active sessions of a user, most recently used first.
+//
+// Expired sessions found along the way are dropped from the user's index.
+func (s *sessionService) List(ctx context.Context, userID uint) ([]*entity.Session, error) {
+	ids, err := s.rc.SMembers(ctx, userSessionsKey(userID))
+	if err != nil {
+		return nil, errx.New(errx.CodeInternalError, err)
+	}
+
+	sessions := make([]*entity.Session, 0, len(ids))
+	var expired []string
+	for _, id := range ids {
+		session, err := s.get(ctx, id)
+		if err != nil {
+			if errx.ToAppError(err).Code == errx.CodeUnauthorized {
+				expired = append(expired, id)
+				continue
+			}
+			return nil, err
+		}
+		sessions = append(sessions, session)
+	}
+
+	if err := s.rc.SRem(ctx, userSessionsKey(userID), expired...); err != nil {
+		s.log.Warn("prune expired sessions failed", logger.Err(err))
+	}
+
+	sort.Slice(sessions, func(i, j int) bool {
+		return sessions[i].LastUsedAt.After(sessions[j].LastUsedAt)
+	})
+
+	return sessions, nil
+}
+
+// Revoke ends a single session of a user.
+func (s *sessionService) Revoke(ctx context.Context, userID uint, sessionID string) error {
+	session, err := s.get(ctx, sessionID)
+	if err != nil || session.UserID != userID {
+		return errx.New(errx.CodeNotFound, fmt.Errorf("session %s not found", sessionID))
+	}
+
+	return s.revoke(ctx, userID, sessionID)
+}
+
+// RevokeAll ends every session of a user.
+func (s *sessionService) RevokeAll(ctx context.Context, userID uint) error {
+	ids, err := s.rc.SMembers(ctx, userSessionsKey(userID))
+	if err != nil {
+		return errx.New(errx.CodeInternalError, err)
+	}
+
+	for _, id := range ids {
+		if err := s.revoke(ctx, userID, id); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// issue generates a token pair for the session and records the refresh token hash.
+func (s *sessionService) issue(session *entity.Session) (*SessionTokens, error) {
+	accessToken, refreshToken, err := s.jwt.GenerateAllTokens(jwt.Identity{
+		UserID:    session.UserID,
+		Role:      session.Role,
+		SessionID: session.ID,
+		TwoFactor: session.TwoFactor,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	session.RefreshTokenHash = hashToken(refreshToken)
+	session.ExpiresAt = time.Now().Add(s.cfg.RefreshExpiration)
+
+	return &SessionTokens{
+		SessionID:    session.ID,
+		AccessToken:  accessToken,
+		RefreshToken: refreshToken,
+	}, nil
+}
+
+// get loads a session; missing or expired sessions are reported as unauthorized.
+func (s *sessionService) get(ctx context.Context, sessionID string) (*entity.Session, error) {
+	session, _, err := s.load(ctx, sessionID)
+	return session, err
+}
+
+// load is get that also returns the stored value, for compare-and-set updates.
+func (s *sessionService) load(ctx context.Context, sessionID string) (*entity.Session, string, error) {
+	raw, err := s.rc.Get(ctx, sessionKeyPrefix+sessionID)
+	if err != nil {
+		if errx.ToAppError(err).Code == errx.CodeNotFound {
+			return nil, "", errx.New(errx.CodeUnauthorized, fmt.Errorf("session %s expired or revoked", sessionID))
+		}
+		return nil, "", errx.New(errx.CodeInternalError, err)
+	}
+
+	session := new(entity.Session)
+	if err := json.Unmarshal([]byte(raw), session); err != nil {
+		return nil, "", errx.New(errx.CodeInternalError, err)
+	}
+	return session, raw, nil
+}
+
+// save stores the session until its refresh token expires.
+func (s *sessionService) save(ctx context.Context, session *entity.Session) error {
+	data, err := json.Marshal(session)
+	if err != nil {
+		return errx.New(errx.CodeInternalError, err)
+	}
+	if err := s.rc.Set(ctx, sessionKeyPrefix+session.ID, string(data), time.Until(session.ExpiresAt)); err != nil {
+		return errx.New(errx.CodeInternalError, fmt.Errorf("failed to store session %s: %w", session.ID, err))
+	}
+	return nil
+}
+
+// revoke deletes a session and denies the access tokens issued for it.
+func (s *sessionService) revoke(ctx context.Context, userID uint, sessionID string) error {
+	if err := s.delete(ctx, userID, sessionID); err != nil {
+		return err
+	}
+	return s.denylist.DenySession(ctx, sessionID)
+}
+
+// delete removes a session and its index entry.
+func (s *sessionService) delete(ctx context.Context, userID uint, sessionID string) error {
+	if err := s.rc.Delete(ctx, sessionKeyPrefix+sessionID); err != nil {
+		return errx.New(errx.CodeInternalError, err)
+	}
+	if err := s.rc.SRem(ctx, userSessionsKey(userID), sessionID); err != nil {
+		return errx.New(errx.CodeInternalError, err)
+	}
+	return nil
+}
+
+// userSessionsKey returns the key of the set indexing a user's sessions.
+func userSessionsKey(userID uint) string {
+	return fmt.Sprintf("%s%d", userSessionsKeyPrefix, userID)
+}
+
+// hashToken returns the hex-encoded SHA-256 of a token.
+func hashToken(token string) string {
+	sum := sha256.Sum256([]byte(token))
+	return hex.EncodeToString(sum[:])
+}
@@ -0,0 +1,432 @@
+package service
+
+import (
+	"context"
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/rand"
+	"encoding/base64"
+	"fmt"
+	"strings"
+	"sync"
+	"time"
+
+	"blog-server/cache"
+	"blog-server/config"
+	"blog-server/datastore"
+	"blog-server/entity"
+	"blog-server/logger"
+	"blog-server/pkg/errx"
+	"blog-server/pkg/jwt"
+	"blog-server/repository"
+
+	"github.com/google/uuid"
+)
+
+const (
+	defaultKeyRotation = 30 * 24 * time.Hour
+	// signingKeyReloadInterval limits how often an unknown kid triggers a
+	// reload, so forged kids cannot hammer the database.
+	signingKeyReloadInterval = 30 * time.Second
+	signingKeyLoadTimeout    = 5 * time.Second
+
+	// signingKeyLockKey guards rotation against several instances rotating at once.
+	signingKeyLockKey = "blog:signing_key:rotation_lock"
+	signingKeyLockTTL = time.Minute
+
+	// sealedKeyPrefix marks private keys encrypted with the key encryption key.
+	sealedKeyPrefix = "aes256gcm:"
+
+	// JWKSMaxAge is how long clients may cache the JWKS. New keys are
+	// published this long before they start signing.
+	JWKSMaxAge = 5 * time.Minute
+)
+
+// SigningKeyService manages the key pairs tokens are signed with.
+//
+// The newest active key signs new tokens. A rotated-in key is published in
+// the JWKS for JWKSMaxAge before it activates, so that clients caching the
+// JWKS know it before the first token it signs. Rotated-out keys stay
+// available for verification, and in the JWKS, until every token they signed
+// has expired. With the shared secret algorithm there are no keys and every
+// operation is a no-op.
+type SigningKeyService interface {
+	jwt.KeySet
+
+	JWKS(ctx context.Context) (*jwt.JWKSet, error)
+	Rotate(ctx context.Context) error
+	RotateIfDue(ctx context.Context) error
+}
+
+// signingKeyService implements the SigningKeyService interface.
+type signingKeyService struct {
+	ds   *datastore.DataStore
+	repo repository.SigningKeyRepo
+	rc   cache.CacheClient
+	cfg  config.JWTConfig
+	log  logger.Logger
+	// kek encrypts private keys at rest; nil stores them in plain text.
+	kek cipher.AEAD
+
+	mu        sync.RWMutex
+	current   *jwt.Key
+	currentAt time.Time
+	// next is the published key that takes over from current at nextAt.
+	next     *jwt.Key
+	nextAt   time.Time
+	keys     map[string]*jwt.Key
+	loadedAt time.Time
+}
+
+// NewSigningKeyService creates a SigningKeyService and loads the stored keys,
+// generating the first key when there is none yet.
+func NewSigningKeyService(
+	cfg *config.Config,
+	ds *datastore.DataStore,
+	repo repository.SigningKeyRepo,
+	rc cache.CacheClient,
+	log logger.Logger,
+) (SigningKeyService, error) {
+	s := &signingKeyService{
+		ds:   ds,
+		repo: repo,
+		rc:   rc,
+		cfg:  cfg.JWT,
+		log:  log.With(logger.String("module", "signing_key")),
+		keys: make(map[string]*jwt.Key),
+	}
+	if s.cfg.KeyRotation <= 0 {
+		s.cfg.KeyRotation = defaultKeyRotation
+	}
+	if s.cfg.UsesSharedSecret() {
+		return s, nil
+	}
+
+	if s.cfg.KeyEncryptionKey != "" {
+		kek, err := newKeyEncryption(s.cfg.KeyEncryptionKey)
+		if err != nil {
+			return nil, errx.New(errx.CodeInternalError, err)
+		}
+		s.kek = kek
+	} else {
+		s.log.Warn("no jwt.key_encryption_key configured, private signing keys are stored unencrypted")
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), signingKeyLoadTimeout)
+	defer cancel()
+
+	if err := s.RotateIfDue(ctx); err != nil {
+		return nil, err
+	}
+	return s, nil
+}
+
+// NewJwt creates the token signer shared by the services and middleware.
+func NewJwt(cfg *config.Config, keys SigningKeyService) jwt.Jwt {
+	return jwt.New(cfg.JWT, keys)
+}
+
+// SigningKey returns the key new tokens are signed with.
+//
+// Without any key, e.g. while another instance generates the first one, the
+// keys are reloaded.
+func (s *signingKeyService) SigningKey() (*jwt.Key, error) {
+	s.mu.RLock()
+	key := s.signingKey()
+	stale := time.Since(s.loadedAt) > signingKeyReloadInterval
+	s.mu.RUnlock()
+
+	if key != nil {
+		return key, nil
+	}
+	if stale {
+		ctx, cancel := context.WithTimeout(context.Background(), signingKeyLoadTimeout)
+		defer cancel()
+		if err := s.load(ctx); err != nil {
+			return nil, err
+		}
+
+		s.mu.RLock()
+		key = s.signingKey()
+		s.mu.RUnlock()
+		if key != nil {
+			return key, nil
+		}
+	}
+	return nil, fmt.Errorf("no active %s signing key", s.cfg.Algorithm)
+}
+
+// signingKey returns the key that signs now. s.mu must be held.
+func (s *signingKeyService) signingKey() *jwt.Key {
+	if s.next != nil && !time.Now().Before(s.nextAt) {
+		return s.next
+	}
+	return s.current
+}
+
+// VerificationKey returns the key with the given ID.
+//
+// Unknown IDs trigger a reload, since another instance may have rotated.
+func (s *signingKeyService) VerificationKey(kid string) (*jwt.Key, error) {
+	s.mu.RLock()
+	key, ok := s.keys[kid]
+	stale := time.Since(s.loadedAt) > signingKeyReloadInterval
+	s.mu.RUnlock()
+
+	if ok {
+		return key, nil
+	}
+	if !stale || s.cfg.UsesSharedSecret() {
+		return nil, fmt.Errorf("unknown signing key %s", kid)
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), signingKeyLoadTimeout)
+	defer cancel()
+	if err := s.load(ctx); err != nil {
+		return nil, err
+	}
+
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	if key, ok := s.keys[kid]; ok {
+		return key, nil
+	}
+	return nil, fmt.Errorf("unknown signing key %s", kid)
+}
+
+// JWKS returns the public keys of all keys that may have signed a valid token.
+func (s *signingKeyService) JWKS(_ context.Context) (*jwt.JWKSet, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	set := &jwt.JWKSet{Keys: make([]jwt.JWK, 0, len(s.keys))}
+	for _, key := range s.keys {
+		jwk, err := jwt.PublicJWK(key)
+		if err != nil {
+			return nil, errx.New(errx.CodeInternalError, err)
+		}
+		set.Keys = append(set.Keys, jwk)
+	}
+	return set, nil
+}
+
+// Rotate generates a new signing key and retires the previous ones.
+//
+// The new key activates after JWKSMaxAge, when the previous keys retire. The
+// first key activates immediately, since no client can have cached the JWKS.
+func (s *signingKeyService) Rotate(ctx context.Context) error {
+	if s.cfg.UsesSharedSecret() {
+		return nil
+	}
+
+	key, err := jwt.GenerateKey(s.cfg.Algorithm)
+	if err != nil {
+		return errx.New(errx.CodeInternalError, err)
+	}
+	privatePEM, publicPEM, err := jwt.MarshalKey(key)
+	if err != nil {
+		return errx.New(errx.CodeInternalError, err)
+	}
+	privateKey, err := s.seal(key.ID, privatePEM)
+	if err != nil {
+		return errx.New(errx.CodeInternalError, err)
+	}
+
+	s.mu.RLock()
+	activatesAt := time.Now()
+	if s.current != nil {
+		activatesAt = activatesAt.Add(JWKSMaxAge)
+	}
+	s.mu.RUnlock()
+
+	err = s.ds.WithTx(ctx, func(ctx context.Context) error {
+		created, err := s.repo.Create(ctx, &entity.SigningKey{
+			KID:         key.ID,
+			Algorithm:   key.Algorithm,
+			PrivateKey:  privateKey,
+			PublicKey:   publicPEM,
+			ActivatesAt: &activatesAt,
+		})
+		if err != nil {
+			return err
+		}
+		return s.repo.RetireOthers(ctx, created.ID, activatesAt)
+	})
+	if err != nil {
+		return err
+	}
+
+	s.log.Info("signing key rotated",
+		logger.String("kid", key.ID),
+		logger.String("algorithm", key.Algorithm),
+		logger.Time("activates_at", activatesAt),
+	)
+
+	return s.load(ctx)
+}
+
+// RotateIfDue reloads the keys, rotates when the signing key is older than the
+// rotation interval and deletes keys no token can depend on anymore.
+//
+// Only one instance rotates at a time; the others just reload.
+func (s *signingKeyService) RotateIfDue(ctx context.Context) error {
+	if s.cfg.UsesSharedSecret() {
+		return nil
+	}
+
+	token := uuid.NewString()
+	locked, err := s.rc.SetNX(ctx, signingKeyLockKey, token, signingKeyLockTTL)
+	if err != nil {
+		return errx.New(errx.CodeInternalError, err)
+	}
+	if !locked {
+		return s.load(ctx)
+	}
+	defer func() {
+		if _, err := s.rc.DeleteIfEqual(ctx, signingKeyLockKey, token); err != nil {
+			s.log.Warn("release signing key lock failed", logger.Err(err))
+		}
+	}()
+
+	if err := s.load(ctx); err != nil {
+		return err
+	}
+
+	// A published key that is yet to activate counts as the rotation.
+	s.mu.RLock()
+	due := s.next == nil && (s.current == nil || time.Since(s.currentAt) >= s.cfg.KeyRotation)
+	s.mu.RUnlock()
+
+	if due {
+		if err := s.Rotate(ctx); err != nil {
+			return err
+		}
+	}
+
+	deleted, err := s.repo.DeleteRetiredBefore(ctx, time.Now().Add(-s.retention()))
+	if err != nil {
+		return err
+	}
+	if deleted > 0 {
+		s.log.Info("expired signing keys deleted", logger.Int("count", deleted))
+	}
+
+	return nil
+}
+
+// load replaces the in-memory keys with the usable keys from the database.
+func (s *signingKeyService) load(ctx context.Context) error {
+	stored, err := s.repo.ListUsable(ctx, time.Now().Add(-s.retention()))
+	if err != nil {
+		return err
+	}
+
+	now := time.Now()
+	keys := make(map[string]*jwt.Key, len(stored))
+	var current, next *jwt.Key
+	var currentAt, nextAt time.Time
+	for _, sk := range stored {
+		privatePEM, err := s.open(sk.KID, sk.PrivateKey)
+		if err != nil {
+			s.log.Error("decrypt signing key failed", logger.String("kid", sk.KID), logger.Err(err))
+			continue
+		}
+		key, err := jwt.ParseKey(sk.KID, sk.Algorithm, privatePEM)
+		if err != nil {
+			s.log.Error("parse signing key failed", logger.String("kid", sk.KID), logger.Err(err))
+			continue
+		}
+		keys[key.ID] = key
+
+		// Keys are listed newest first; a key of another algorithm is left
+		// over from a configuration change and only verifies.
+		if sk.Algorithm != s.cfg.Algorithm || (sk.RetiredAt != nil && !sk.RetiredAt.After(now)) {
+			continue
+		}
+		activatesAt := sk.CreatedAt
+		if sk.ActivatesAt != nil {
+			activatesAt = *sk.ActivatesAt
+		}
+		switch {
+		case activatesAt.After(now):
+			if next == nil {
+				next, nextAt = key, activatesAt
+			}
+		case current == nil:
+			current, currentAt = key, activatesAt
+		}
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.keys = keys
+	s.current = current
+	s.currentAt = currentAt
+	s.next = next
+	s.nextAt = nextAt
+	s.loadedAt = now
+
+	return nil
+}
+
+// retention is how long a retired key is kept: long enough for every token it
+// signed to expire.
+func (s *signingKeyService) retention() time.Duration {
+	return max(s.cfg.AccessExpiration, s.cfg.RefreshExpiration)
+}
+
+// seal encrypts a private key with the key encryption key, bound to its key ID.
+func (s *signingKeyService) seal(kid, privatePEM string) (string, error) {
+	if s.kek == nil {
+		return privatePEM, nil
+	}
+
+	nonce := make([]byte, s.kek.NonceSize())
+	if _, err := rand.Read(nonce); err != nil {
+		return "", err
+	}
+	sealed := s.kek.Seal(nonce, nonce, []byte(privatePEM), []byte(kid))
+	return sealedKeyPrefix + base64.StdEncoding.EncodeToString(sealed), nil
+}
+
+// open decrypts a private key stored by seal. Keys stored before a key
+// encryption key was configured are returned as they are.
+func (s *signingKeyService) open(kid, stored string) (string, error) {
+	encoded, ok := strings.CutPrefix(stored, sealedKeyPrefix)
+	if !ok {
+		return stored, nil
+	}
+	if s.kek == nil {
+		return "", fmt.Errorf("signing key is encrypted but no key encryption key is configured")
+	}
+
+	sealed, err := base64.StdEncoding.DecodeString(encoded)
+	if err != nil {
+		return "", err
+	}
+	if len(sealed) < s.kek.NonceSize() {
+		return "", fmt.Errorf("encrypted signing key too short")
+	}
+	nonce, ciphertext := sealed[:s.kek.NonceSize()], sealed[s.kek.NonceSize():]
+	plain, err := s.kek.Open(nil, nonce, ciphertext, []byte(kid))
+	if err != nil {
+		return "", err
+	}
+	return string(plain), nil
+}
+
+// newKeyEncryption creates the AES-256-GCM cipher of a base64 encoded key encryption key.
+func newKeyEncryption(encoded string) (cipher.AEAD, error) {
+	key, err := base64.StdEncoding.DecodeString(encoded)
+	if err != nil {
+		return nil, fmt.Errorf("invalid key encryption key: %w", err)
+	}
+	if len(key) != 32 {
+		return nil, fmt.Errorf("key encryption key must be 32 bytes, got %d", len(key))
+	}
+	block, err := aes.NewCipher(key)
+	if err != nil {
+		return nil, err
+	}
+	return cipher.NewGCM(block)
+}
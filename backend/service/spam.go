@@ -0,0 +1,316 @@
+package service
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"regexp"
+	"strconv"
+	"strings"
+	"time"
+
+	"blog-server/cache"
+	"blog-server/config"
+	"blog-server/logger"
+	"blog-server/pkg/errx"
+)
+
+const (
+	defaultSpamThreshold = 100
+
+	// Per-filter scores. Certain signals reach the default threshold on their
+	// own; weaker signals only push a comment into spam when combined.
+	honeypotScore   = 100
+	tooFastScore    = 100
+	bannedWordScore = 100
+	linkCountScore  = 60
+	duplicateScore  = 60
+	reputationScore = 60
+)
+
+var linkPattern = regexp.MustCompile(`(?i)https?://|www\.|\[url`)
+
+// SpamCheckInput carries everything the spam filters may inspect.
+type SpamCheckInput struct {
+	PostID      uint
+	Content     string
+	AuthorEmail string
+	IP          string
+	UserAgent   string
+
+	// Honeypot is a form field hidden from humans; bots tend to fill it in.
+	Honeypot string
+	// RenderedAt is when the comment form was rendered on the client.
+	RenderedAt *time.Time
+}
+
+// SpamVerdict is the outcome of a single filter. A zero score means the
+// filter found nothing suspicious.
+type SpamVerdict struct {
+	Filter string
+	Score  int
+	Reason string
+}
+
+// SpamResult aggregates the verdicts of every filter in the chain.
+type SpamResult struct {
+	Score    int
+	Spam     bool
+	Verdicts []SpamVerdict
+}
+
+// Reasons returns the reasons of all filters that scored the comment.
+func (r *SpamResult) Reasons() []string {
+	reasons := make([]string, 0, len(r.Verdicts))
+	for _, v := range r.Verdicts {
+		if v.Score > 0 {
+			reasons = append(reasons, fmt.Sprintf("%s: %s", v.Filter, v.Reason))
+		}
+	}
+	return reasons
+}
+
+// SpamFilter scores a comment. Filters must not block on external services;
+// everything runs locally or against the shared cache.
+type SpamFilter interface {
+	Name() string
+	Check(ctx context.Context, input *SpamCheckInput) (SpamVerdict, error)
+}
+
+// SpamFilterChain runs all configured filters and sums their scores.
+type SpamFilterChain struct {
+	filters    []SpamFilter
+	reputation *reputationFilter
+	threshold  int
+	log        logger.Logger
+}
+
+// NewSpamFilterChain builds the filter chain from the comment spam configuration.
+//
+// When spam filtering is disabled the chain is empty and accepts everything.
+func NewSpamFilterChain(cfg *config.Config, log logger.Logger, rc cache.CacheClient) *SpamFilterChain {
+	spamCfg := cfg.Comment.Spam
+	chain := &SpamFilterChain{
+		threshold: spamCfg.Threshold,
+		log:       log.With(logger.String("module", "spam")),
+	}
+	if chain.threshold <= 0 {
+		chain.threshold = defaultSpamThreshold
+	}
+	if !spamCfg.Enabled {
+		return chain
+	}
+
+	chain.filters = append(chain.filters, honeypotFilter{})
+	if spamCfg.MinSubmitDelay > 0 {
+		chain.filters = append(chain.filters, submitDelayFilter{minDelay: spamCfg.MinSubmitDelay})
+	}
+	if spamCfg.MaxLinks > 0 {
+		chain.filters = append(chain.filters, linkCountFilter{maxLinks: spamCfg.MaxLinks})
+	}
+	if len(spamCfg.BannedWords) > 0 {
+		chain.filters = append(chain.filters, newBannedWordFilter(spamCfg.BannedWords))
+	}
+	if spamCfg.DuplicateWindow > 0 {
+		chain.filters = append(chain.filters, duplicateFilter{rc: rc, window: spamCfg.DuplicateWindow})
+	}
+	if spamCfg.ReputationLimit > 0 {
+		chain.reputation = &reputationFilter{rc: rc, limit: spamCfg.ReputationLimit, ttl: spamCfg.ReputationTTL}
+		chain.filters = append(chain.filters, chain.reputation)
+	}
+
+	return chain
+}
+
+// Evaluate runs every filter and reports whether the comment is spam.
+//
+// Filters fail open: an error is logged and the filter is skipped so an
+// unavailable cache never blocks commenting.
+func (c *SpamFilterChain) Evaluate(ctx context.Context, input *SpamCheckInput) *SpamResult {
+	result := &SpamResult{}
+
+	for _, f := range c.filters {
+		verdict, err := f.Check(ctx, input)
+		if err != nil {
+			c.log.Warn("spam filter failed",
+				logger.String("filter", f.Name()),
+				logger.Err(err),
+			)
+			continue
+		}
+		verdict.Filter = f.Name()
+		result.Verdicts = append(result.Verdicts, verdict)
+		result.Score += verdict.Score
+
+		c.log.Debug("spam filter verdict",
+			logger.String("filter", verdict.Filter),
+			logger.Int("score", verdict.Score),
+			logger.Int("post_id", int(input.PostID)),
+		)
+	}
+
+	result.Spam = result.Score >= c.threshold
+	return result
+}
+
+// ReportSpam lowers the reputation of the IP and email behind a spam comment.
+func (c *SpamFilterChain) ReportSpam(ctx context.Context, ip, email string) {
+	if c.reputation == nil {
+		return
+	}
+	if err := c.reputation.record(ctx, ip, email); err != nil {
+		c.log.Warn("record spam reputation failed", logger.Err(err))
+	}
+}
+
+// honeypotFilter flags comments that filled in the hidden form field.
+type honeypotFilter struct{}
+
+func (honeypotFilter) Name() string { return "honeypot" }
+
+func (honeypotFilter) Check(_ context.Context, input *SpamCheckInput) (SpamVerdict, error) {
+	if strings.TrimSpace(input.Honeypot) != "" {
+		return SpamVerdict{Score: honeypotScore, Reason: "honeypot field filled"}, nil
+	}
+	return SpamVerdict{Reason: "honeypot empty"}, nil
+}
+
+// submitDelayFilter flags comments submitted faster than a human could type them.
+type submitDelayFilter struct {
+	minDelay time.Duration
+}
+
+func (submitDelayFilter) Name() string { return "submit_delay" }
+
+func (f submitDelayFilter) Check(_ context.Context, input *SpamCheckInput) (SpamVerdict, error) {
+	// Clients that do not send the render time, such as the API or older
+	// forms, are not penalized for it.
+	if input.RenderedAt == nil {
+		return SpamVerdict{Reason: "no form render time"}, nil
+	}
+	elapsed := time.Since(*input.RenderedAt)
+	if elapsed < f.minDelay {
+		return SpamVerdict{Score: tooFastScore, Reason: fmt.Sprintf("submitted %s after render", elapsed.Round(time.Millisecond))}, nil
+	}
+	return SpamVerdict{Reason: "submit delay ok"}, nil
+}
+
+// linkCountFilter flags comments carrying more links than allowed.
+type linkCountFilter struct {
+	maxLinks int
+}
+
+func (linkCountFilter) Name() string { return "link_count" }
+
+func (f linkCountFilter) Check(_ context.Context, input *SpamCheckInput) (SpamVerdict, error) {
+	links := len(linkPattern.FindAllStringIndex(input.Content, -1))
+	if links > f.maxLinks {
+		return SpamVerdict{Score: linkCountScore, Reason: fmt.Sprintf("%d links exceed limit of %d", links, f.maxLinks)}, nil
+	}
+	return SpamVerdict{Reason: strconv.Itoa(links) + " links"}, nil
+}
+
+// bannedWordFilter flags comments containing any configured word, case-insensitively.
+type bannedWordFilter struct {
+	words []string
+}
+
+func newBannedWordFilter(words []string) bannedWordFilter {
+	lowered := make([]string, 0, len(words))
+	for _, w := range words {
+		if w = strings.ToLower(strings.TrimSpace(w)); w != "" {
+			lowered = append(lowered, w)
+		}
+	}
+	return bannedWordFilter{words: lowered}
+}
+
+func (bannedWordFilter) Name() string { return "banned_word" }
+
+func (f bannedWordFilter) Check(_ context.Context, input *SpamCheckInput) (SpamVerdict, error) {
+	content := strings.ToLower(input.Content)
+	for _, w := range f.words {
+		if strings.Contains(content, w) {
+			return SpamVerdict{Score: bannedWordScore, Reason: fmt.Sprintf("contains banned word %q", w)}, nil
+		}
+	}
+	return SpamVerdict{Reason: "no banned words"}, nil
+}
+
+// duplicateFilter flags bodies already posted within the configured window.
+type duplicateFilter struct {
+	rc     cache.CacheClient
+	window time.Duration
+}
+
+func (duplicateFilter) Name() string { return "duplicate" }
+
+func (f duplicateFilter) Check(ctx context.Context, input *SpamCheckInput) (SpamVerdict, error) {
+	normalized := strings.Join(strings.Fields(strings.ToLower(input.Content)), " ")
+	sum := sha256.Sum256([]byte(normalized))
+
+	count, err := f.rc.IncrWithTTL(ctx, "blog:comment:dup:"+hex.EncodeToString(sum[:]), f.window)
+	if err != nil {
+		return SpamVerdict{}, err
+	}
+	if count > 1 {
+		return SpamVerdict{Score: duplicateScore, Reason: fmt.Sprintf("body seen %d times", count)}, nil
+	}
+	return SpamVerdict{Reason: "body is unique"}, nil
+}
+
+// reputationFilter flags IPs and emails that recently produced spam.
+type reputationFilter struct {
+	rc    cache.CacheClient
+	limit int
+	ttl   time.Duration
+}
+
+func (*reputationFilter) Name() string { return "reputation" }
+
+func (f *reputationFilter) Check(ctx context.Context, input *SpamCheckInput) (SpamVerdict, error) {
+	for _, key := range f.keys(input.IP, input.AuthorEmail) {
+		val, err := f.rc.Get(ctx, key.key)
+		if err != nil && errx.ToAppError(err).Code == errx.CodeNotFound {
+			continue
+		}
+		if err != nil {
+			return SpamVerdict{}, err
+		}
+		count, _ := strconv.Atoi(val)
+		if count >= f.limit {
+			return SpamVerdict{Score: reputationScore, Reason: fmt.Sprintf("%s has %d spam reports", key.kind, count)}, nil
+		}
+	}
+	return SpamVerdict{Reason: "reputation ok"}, nil
+}
+
+// record increments the spam counters of the given IP and email.
+func (f *reputationFilter) record(ctx context.Context, ip, email string) error {
+	for _, key := range f.keys(ip, email) {
+		if _, err := f.rc.IncrWithTTL(ctx, key.key, f.ttl); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// reputationKey is the counter key of an identifier, with the kind of
+// identifier it counts for use in reasons that end up in logs.
+type reputationKey struct {
+	kind string
+	key  string
+}
+
+// keys returns the reputation counter keys for the non-empty identifiers.
+func (f *reputationFilter) keys(ip, email string) []reputationKey {
+	keys := make([]reputationKey, 0, 2)
+	if ip != "" {
+		keys = append(keys, reputationKey{kind: "ip", key: "blog:comment:rep:ip:" + ip})
+	}
+	if email != "" {
+		keys = append(keys, reputationKey{kind: "email", key: "blog:comment:rep:email:" + strings.ToLower(email)})
+	}
+	return keys
+}
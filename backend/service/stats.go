@@ -0,0 +1,55 @@
+package service
+
+import (
+	"context"
+
+	"blog-server/authz"
+	"blog-server/contextx"
+	"blog-server/entity"
+	"blog-server/repository"
+)
+
+// StatsService defines the interface for site statistics.
+type StatsService interface {
+	GetDashboardStats(ctx context.Context, user contextx.User) (*entity.DashboardStats, error)
+}
+
+// statsService implements the StatsService interface.
+type statsService struct {
+	pr    repository.PostRepo
+	cr    repository.CommentRepo
+	authz *authz.Authorizer
+}
+
+// NewStatsService creates and returns a new StatsService instance.
+func NewStatsService(pr repository.PostRepo, cr repository.CommentRepo, authz *authz.Authorizer) StatsService {
+	return &statsService{pr: pr, cr: cr, authz: authz}
+}
+
+// GetDashboardStats returns the dashboard summary to users who moderate
+// comments.
+func (s *statsService) GetDashboardStats(ctx context.Context, user contextx.User) (*entity.DashboardStats, error) {
+	if err := s.authz.Authorize(ctx, user.ID, user.Role, authz.ResourceComment, authz.ActionRead, nil); err != nil {
+		return nil, err
+	}
+
+	posts, err := s.pr.CountPublished(ctx)
+	if err != nil {
+		return nil, err
+	}
+	approved := entity.CommentStatusApproved
+	comments, err := s.cr.CountAll(ctx, &approved, nil, nil)
+	if err != nil {
+		return nil, err
+	}
+	views, err := s.pr.SumViewCount(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return &entity.DashboardStats{
+		TotalPV:       views,
+		TotalComments: int64(comments),
+		TotalPosts:    int64(posts),
+	}, nil
+}
@@ -0,0 +1,125 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"time"
+
+	"blog-server/cache"
+	"blog-server/config"
+	"blog-server/pkg/errx"
+)
+
+const (
+	tokenDenylistKeyPrefix   = "blog:token:denied:"
+	userDenylistKeyPrefix    = "blog:token:user_denied:"
+	sessionDenylistKeyPrefix = "blog:token:session_denied:"
+)
+
+// TokenDenylist keeps revoked access tokens until they would have expired anyway.
+//
+// Single tokens are denied by ID. DenySession denies every access token of a
+// session, e.g. when it is logged out from another device. DenyUser denies
+// every access token of a user issued so far at once, e.g. when the user is
+// disabled.
+type TokenDenylist interface {
+	Deny(ctx context.Context, tokenID string, expiresAt time.Time) error
+	IsDenied(ctx context.Context, tokenID string) (bool, error)
+
+	DenySession(ctx context.Context, sessionID string) error
+	IsSessionDenied(ctx context.Context, sessionID string) (bool, error)
+
+	DenyUser(ctx context.Context, userID uint) error
+	IsUserDenied(ctx context.Context, userID uint, issuedAt time.Time) (bool, error)
+}
+
+// tokenDenylist implements the TokenDenylist interface on top of the cache.
+type tokenDenylist struct {
+	rc  cache.CacheClient
+	ttl time.Duration
+}
+
+// NewTokenDenylist creates and returns a new TokenDenylist instance.
+func NewTokenDenylist(cfg *config.Config, rc cache.CacheClient) TokenDenylist {
+	return &tokenDenylist{rc: rc, ttl: cfg.JWT.AccessExpiration}
+}
+
+// Deny revokes the token with the given ID. Tokens that already expired are skipped.
+func (d *tokenDenylist) Deny(ctx context.Context, tokenID string, expiresAt time.Time) error {
+	ttl := time.Until(expiresAt)
+	if tokenID == "" || ttl <= 0 {
+		return nil
+	}
+	if err := d.rc.Set(ctx, tokenDenylistKeyPrefix+tokenID, "1", ttl); err != nil {
+		return errx.New(errx.CodeInternalError, fmt.Errorf("failed to deny token %s: %w", tokenID, err))
+	}
+	return nil
+}
+
+// IsDenied reports whether the token with the given ID was revoked.
+func (d *tokenDenylist) IsDenied(ctx context.Context, tokenID string) (bool, error) {
+	if tokenID == "" {
+		return false, nil
+	}
+	if _, err := d.rc.Get(ctx, tokenDenylistKeyPrefix+tokenID); err != nil {
+		if errx.ToAppError(err).Code == errx.CodeNotFound {
+			return false, nil
+		}
+		return false, errx.New(errx.CodeInternalError, err)
+	}
+	return true, nil
+}
+
+// DenySession revokes all access tokens issued for a session. The mark is
+// kept as long as an access token lives.
+func (d *tokenDenylist) DenySession(ctx context.Context, sessionID string) error {
+	if err := d.rc.Set(ctx, sessionDenylistKeyPrefix+sessionID, "1", d.ttl); err != nil {
+		return errx.New(errx.CodeInternalError, fmt.Errorf("failed to deny tokens of session %s: %w", sessionID, err))
+	}
+	return nil
+}
+
+// IsSessionDenied reports whether the tokens of a session were revoked.
+func (d *tokenDenylist) IsSessionDenied(ctx context.Context, sessionID string) (bool, error) {
+	if sessionID == "" {
+		return false, nil
+	}
+	if _, err := d.rc.Get(ctx, sessionDenylistKeyPrefix+sessionID); err != nil {
+		if errx.ToAppError(err).Code == errx.CodeNotFound {
+			return false, nil
+		}
+		return false, errx.New(errx.CodeInternalError, err)
+	}
+	return true, nil
+}
+
+// DenyUser revokes all access tokens of the user issued up to now. The mark
+// is kept as long as an access token lives.
+func (d *tokenDenylist) DenyUser(ctx context.Context, userID uint) error {
+	key := userDenylistKeyPrefix + strconv.FormatUint(uint64(userID), 10)
+	if err := d.rc.Set(ctx, key, strconv.FormatInt(time.Now().Unix(), 10), d.ttl); err != nil {
+		return errx.New(errx.CodeInternalError, fmt.Errorf("failed to deny tokens of user %d: %w", userID, err))
+	}
+	return nil
+}
+
+// IsUserDenied reports whether an access token of the user issued at issuedAt
+// was revoked by DenyUser.
+//
+// Tokens carry whole seconds, so tokens issued in the same second as the
+// revocation count as revoked too.
+func (d *tokenDenylist) IsUserDenied(ctx context.Context, userID uint, issuedAt time.Time) (bool, error) {
+	val, err := d.rc.Get(ctx, userDenylistKeyPrefix+strconv.FormatUint(uint64(userID), 10))
+	if err != nil {
+		if errx.ToAppError(err).Code == errx.CodeNotFound {
+			return false, nil
+		}
+		return false, errx.New(errx.CodeInternalError, err)
+	}
+	deniedAt, err := strconv.ParseInt(val, 10, 64)
+	if err != nil {
+		return false, nil
+	}
+	return issuedAt.Unix() <= deniedAt, nil
+}
@@ -0,0 +1,265 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+
+	"blog-server/cache"
+	"blog-server/config"
+	"blog-server/entity"
+	"blog-server/logger"
+	"blog-server/pkg/errx"
+	"blog-server/pkg/totp"
+	"blog-server/repository"
+	"blog-server/utils"
+)
+
+const (
+	twoFactorEnrollKeyPrefix = "blog:2fa:enroll:"
+	twoFactorUsedKeyPrefix   = "blog:2fa:used:"
+
+	twoFactorEnrollTTL = 10 * time.Minute
+	// twoFactorUsedTTL covers every step Validate may accept around now.
+	twoFactorUsedTTL = (2*totp.Skew + 1) * totp.Period
+
+	recoveryCodeCount    = 10
+	recoveryCodeAlphabet = "abcdefghjkmnpqrstuvwxyz23456789"
+)
+
+// TwoFactorStatus describes the two-factor setup of a user.
+type TwoFactorStatus struct {
+	Enabled           bool
+	Required          bool
+	RecoveryCodesLeft int
+}
+
+// TwoFactorEnrollment is a pending TOTP secret waiting for confirmation.
+type TwoFactorEnrollment struct {
+	Secret string
+	// URI is the otpauth:// provisioning URI, to be shown as a QR code.
+	URI string
+}
+
+// TwoFactorService defines the interface for TOTP two-factor authentication.
+//
+// Enrollment is two-step: a secret is generated and kept in the cache until
+// the user proves their authenticator works by submitting a code from it.
+type TwoFactorService interface {
+	Status(ctx context.Context, userID uint) (*TwoFactorStatus, error)
+	BeginEnrollment(ctx context.Context, userID uint) (*TwoFactorEnrollment, error)
+	ConfirmEnrollment(ctx context.Context, userID uint, code string) ([]string, error)
+	RegenerateRecoveryCodes(ctx context.Context, userID uint, code string) ([]string, error)
+	Disable(ctx context.Context, userID uint, code string) error
+
+	// Verify accepts a current TOTP code or an unused recovery code, which is consumed.
+	Verify(ctx context.Context, userID uint, code string) error
+}
+
+// twoFactorService implements the TwoFactorService interface.
+type twoFactorService struct {
+	rc       cache.CacheClient
+	userRepo repository.UserRepo
+	cfg      config.TwoFactorConfig
+	log      logger.Logger
+}
+
+// NewTwoFactorService creates and returns a new TwoFactorService instance.
+func NewTwoFactorService(
+	cfg *config.Config,
+	rc cache.CacheClient,
+	userRepo repository.UserRepo,
+	log logger.Logger,
+) TwoFactorService {
+	tfCfg := cfg.Auth.TwoFactor
+	if tfCfg.Issuer == "" {
+		tfCfg.Issuer = cfg.App.Name
+	}
+
+	return &twoFactorService{
+		rc:       rc,
+		userRepo: userRepo,
+		cfg:      tfCfg,
+		log:      log.With(logger.String("module", "two_factor")),
+	}
+}
+
+// Status reports whether two-factor authentication is enabled for the user.
+func (s *twoFactorService) Status(ctx context.Context, userID uint) (*TwoFactorStatus, error) {
+	tf, err := s.userRepo.GetTwoFactor(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+	auth, err := s.userRepo.GetAuthByID(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	return &TwoFactorStatus{
+		Enabled:           tf.Enabled,
+		Required:          s.required(auth.Role),
+		RecoveryCodesLeft: len(tf.RecoveryCodes),
+	}, nil
+}
+
+// BeginEnrollment generates a new secret for the user. It only takes effect
+// once confirmed with ConfirmEnrollment.
+func (s *twoFactorService) BeginEnrollment(ctx context.Context, userID uint) (*TwoFactorEnrollment, error) {
+	tf, err := s.userRepo.GetTwoFactor(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+	if tf.Enabled {
+		return nil, errx.New(errx.CodeConflict, fmt.Errorf("two-factor authentication is already enabled"))
+	}
+
+	user, err := s.userRepo.GetByID(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	secret, err := totp.GenerateSecret()
+	if err != nil {
+		return nil, errx.New(errx.CodeInternalError, err)
+	}
+	if err := s.rc.Set(ctx, enrollKey(userID), secret, twoFactorEnrollTTL); err != nil {
+		return nil, errx.New(errx.CodeInternalError, err)
+	}
+
+	return &TwoFactorEnrollment{
+		Secret: secret,
+		URI:    totp.ProvisioningURI(s.cfg.Issuer, user.Email, secret),
+	}, nil
+}
+
+// ConfirmEnrollment enables two-factor authentication with the pending secret
+// and returns the recovery codes. They are shown only this once.
+func (s *twoFactorService) ConfirmEnrollment(ctx context.Context, userID uint, code string) ([]string, error) {
+	secret, err := s.rc.Get(ctx, enrollKey(userID))
+	if err != nil {
+		if errx.ToAppError(err).Code == errx.CodeNotFound {
+			return nil, errx.New(errx.CodeInvalidParam, fmt.Errorf("no pending two-factor enrollment"))
+		}
+		return nil, errx.New(errx.CodeInternalError, err)
+	}
+
+	if err := s.verifyTOTP(ctx, userID, secret, code); err != nil {
+		return nil, err
+	}
+
+	codes, hashes := generateRecoveryCodes()
+	if err := s.userRepo.EnableTwoFactor(ctx, userID, secret, hashes); err != nil {
+		return nil, err
+	}
+	if err := s.rc.Delete(ctx, enrollKey(userID)); err != nil {
+		s.log.Warn("delete pending enrollment failed", logger.Err(err))
+	}
+
+	s.log.Info("two-factor authentication enabled", logger.Int("user_id", int(userID)))
+	return codes, nil
+}
+
+// RegenerateRecoveryCodes replaces all recovery codes after checking a current code.
+func (s *twoFactorService) RegenerateRecoveryCodes(ctx context.Context, userID uint, code string) ([]string, error) {
+	if err := s.Verify(ctx, userID, code); err != nil {
+		return nil, err
+	}
+
+	codes, hashes := generateRecoveryCodes()
+	if err := s.userRepo.UpdateRecoveryCodes(ctx, userID, hashes); err != nil {
+		return nil, err
+	}
+	return codes, nil
+}
+
+// Disable turns two-factor authentication off after checking a current code.
+func (s *twoFactorService) Disable(ctx context.Context, userID uint, code string) error {
+	if err := s.Verify(ctx, userID, code); err != nil {
+		return err
+	}
+
+	if err := s.userRepo.DisableTwoFactor(ctx, userID); err != nil {
+		return err
+	}
+
+	s.log.Info("two-factor authentication disabled", logger.Int("user_id", int(userID)))
+	return nil
+}
+
+// Verify checks a TOTP code, or consumes a recovery code.
+func (s *twoFactorService) Verify(ctx context.Context, userID uint, code string) error {
+	tf, err := s.userRepo.GetTwoFactor(ctx, userID)
+	if err != nil {
+		return err
+	}
+	if !tf.Enabled {
+		return errx.New(errx.CodeInvalidParam, fmt.Errorf("two-factor authentication is not enabled"))
+	}
+
+	code = strings.TrimSpace(code)
+	if len(code) == totp.Digits {
+		return s.verifyTOTP(ctx, userID, tf.Secret, code)
+	}
+
+	// The code is looked up and removed in one statement, so concurrent
+	// requests cannot both use it.
+	used, err := s.userRepo.UseRecoveryCode(ctx, userID, hashToken(normalizeRecoveryCode(code)))
+	if err != nil {
+		return err
+	}
+	if !used {
+		return errx.New(errx.CodeInvalidParam, fmt.Errorf("invalid two-factor code"))
+	}
+
+	s.log.Info("recovery code used", logger.Int("user_id", int(userID)))
+	return nil
+}
+
+// verifyTOTP checks a code against the secret and rejects codes already used,
+// so a code seen over someone's shoulder cannot be replayed.
+func (s *twoFactorService) verifyTOTP(ctx context.Context, userID uint, secret, code string) error {
+	step, ok := totp.Validate(secret, code, time.Now())
+	if !ok {
+		return errx.New(errx.CodeInvalidParam, fmt.Errorf("invalid two-factor code"))
+	}
+
+	usedKey := twoFactorUsedKeyPrefix + strconv.FormatUint(uint64(userID), 10) + ":" + strconv.FormatInt(step, 10)
+	fresh, err := s.rc.SetNX(ctx, usedKey, "1", twoFactorUsedTTL)
+	if err != nil {
+		return errx.New(errx.CodeInternalError, err)
+	}
+	if !fresh {
+		return errx.New(errx.CodeInvalidParam, fmt.Errorf("two-factor code already used"))
+	}
+	return nil
+}
+
+// required reports whether the configuration requires two-factor authentication for the role.
+func (s *twoFactorService) required(role entity.UserRole) bool {
+	return slices.Contains(s.cfg.RequiredRoles, string(role))
+}
+
+// enrollKey returns the cache key of a pending enrollment.
+func enrollKey(userID uint) string {
+	return fmt.Sprintf("%s%d", twoFactorEnrollKeyPrefix, userID)
+}
+
+// generateRecoveryCodes returns new recovery codes and their hashes.
+func generateRecoveryCodes() (codes, hashes []string) {
+	codes = make([]string, recoveryCodeCount)
+	hashes = make([]string, recoveryCodeCount)
+	for i := range codes {
+		raw := utils.RandomString(10, recoveryCodeAlphabet)
+		codes[i] = raw[:5] + "-" + raw[5:]
+		hashes[i] = hashToken(raw)
+	}
+	return codes, hashes
+}
+
+// normalizeRecoveryCode strips the separator and case so codes can be typed loosely.
+func normalizeRecoveryCode(code string) string {
+	return strings.ToLower(strings.ReplaceAll(strings.TrimSpace(code), "-", ""))
+}
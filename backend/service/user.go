@@ -0,0 +1,278 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+	"regexp"
+	"slices"
+	"strings"
+	"time"
+
+	"blog-server/authz"
+	"blog-server/contextx"
+	"blog-server/entity"
+	"blog-server/logger"
+	"blog-server/pkg/errx"
+	"blog-server/repository"
+)
+
+// usernamePattern restricts usernames to what fits in author profile URLs.
+var usernamePattern = regexp.MustCompile(`^[A-Za-z0-9_-]{3,32}$`)
+
+// UpdateProfileInput groups the profile fields to change. Nil fields are kept,
+// and an empty Avatar or Bio clears it.
+type UpdateProfileInput struct {
+	Username *string
+	Avatar   *string
+	Bio      *string
+}
+
+// UserService defines the interface for user profiles and user management.
+//
+// The Admin methods act on other users and are authorized against
+// authz.ResourceUser. Admins cannot change, disable or delete themselves, so
+// there is always an admin left.
+type UserService interface {
+	GetProfile(ctx context.Context, userID uint) (*entity.User, error)
+	UpdateProfile(ctx context.Context, userID uint, input *UpdateProfileInput) (*entity.User, error)
+
+	GetAuthor(ctx context.Context, username string) (*entity.User, int, error)
+	GetAuthorPosts(ctx context.Context, username string, page, pageSize int) ([]*entity.Post, int, error)
+
+	AdminGetUsers(ctx context.Context, actor contextx.User, filter *entity.UserFilter, page, pageSize int) ([]*entity.User, int, error)
+	AdminGetUser(ctx context.Context, actor contextx.User, id uint) (*entity.User, error)
+	AdminUpdateRole(ctx context.Context, actor contextx.User, id uint, role entity.UserRole) (*entity.User, error)
+	AdminUpdateStatus(ctx context.Context, actor contextx.User, id uint, status entity.UserStatus) (*entity.User, error)
+	AdminDeleteUser(ctx context.Context, actor contextx.User, id uint) error
+}
+
+// userService implements the UserService interface.
+type userService struct {
+	userRepo     repository.UserRepo
+	postRepo     repository.PostRepo
+	accessTokens repository.PersonalAccessTokenRepo
+	sessions     SessionService
+	denylist     TokenDenylist
+	authz        *authz.Authorizer
+	log          logger.Logger
+}
+
+// NewUserService creates and returns a new UserService instance.
+func NewUserService(
+	userRepo repository.UserRepo,
+	postRepo repository.PostRepo,
+	accessTokens repository.PersonalAccessTokenRepo,
+	sessions SessionService,
+	denylist TokenDenylist,
+	authz *authz.Authorizer,
+	log logger.Logger,
+) UserService {
+	return &userService{
+		userRepo:     userRepo,
+		postRepo:     postRepo,
+		accessTokens: accessTokens,
+		sessions:     sessions,
+		denylist:     denylist,
+		authz:        authz,
+		log:          log.With(logger.String("module", "user")),
+	}
+}
+
+// GetProfile returns the user with the given ID.
+func (s *userService) GetProfile(ctx context.Context, userID uint) (*entity.User, error) {
+	return s.userRepo.GetByID(ctx, userID)
+}
+
+// UpdateProfile changes the username, avatar or bio of a user.
+func (s *userService) UpdateProfile(ctx context.Context, userID uint, input *UpdateProfileInput) (*entity.User, error) {
+	update := &entity.UserProfileUpdate{}
+
+	if input.Username != nil {
+		username := strings.TrimSpace(*input.Username)
+		if !usernamePattern.MatchString(username) {
+			return nil, errx.New(errx.CodeInvalidParam, fmt.Errorf("username must be 3 to 32 letters, digits, '_' or '-'"))
+		}
+		update.Username = &username
+	}
+	if input.Avatar != nil {
+		avatar := strings.TrimSpace(*input.Avatar)
+		if avatar != "" {
+			u, err := url.Parse(avatar)
+			if err != nil || (u.Scheme != "http" && u.Scheme != "https") || u.Host == "" {
+				return nil, errx.New(errx.CodeInvalidParam, fmt.Errorf("avatar must be an http(s) URL"))
+			}
+		}
+		update.Avatar = &avatar
+	}
+	if input.Bio != nil {
+		bio := strings.TrimSpace(*input.Bio)
+		update.Bio = &bio
+	}
+
+	return s.userRepo.UpdateProfile(ctx, userID, update)
+}
+
+// GetAuthor returns the public profile of a user and the number of posts
+// they have published.
+func (s *userService) GetAuthor(ctx context.Context, username string) (*entity.User, int, error) {
+	u, err := s.userRepo.GetByUsername(ctx, username)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	count, err := s.postRepo.CountPublishedByAuthor(ctx, u.ID)
+	if err != nil {
+		return nil, 0, err
+	}
+	return u, count, nil
+}
+
+// GetAuthorPosts returns the published posts of a user with pagination.
+func (s *userService) GetAuthorPosts(ctx context.Context, username string, page, pageSize int) ([]*entity.Post, int, error) {
+	u, err := s.userRepo.GetByUsername(ctx, username)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	count, err := s.postRepo.CountPublishedByAuthor(ctx, u.ID)
+	if err != nil {
+		return nil, 0, err
+	}
+	posts, err := s.postRepo.ListPublishedByAuthor(ctx, u.ID, page, pageSize)
+	if err != nil {
+		return nil, 0, err
+	}
+	return posts, count, nil
+}
+
+// AdminGetUsers returns the users matching the filter with pagination.
+func (s *userService) AdminGetUsers(ctx context.Context, actor contextx.User, filter *entity.UserFilter, page, pageSize int) ([]*entity.User, int, error) {
+	if err := s.authz.Authorize(ctx, actor.ID, actor.Role, authz.ResourceUser, authz.ActionRead, nil); err != nil {
+		return nil, 0, err
+	}
+
+	count, err := s.userRepo.Count(ctx, filter)
+	if err != nil {
+		return nil, 0, err
+	}
+	users, err := s.userRepo.List(ctx, filter, page, pageSize)
+	if err != nil {
+		return nil, 0, err
+	}
+	return users, count, nil
+}
+
+// AdminGetUser returns a user by ID.
+func (s *userService) AdminGetUser(ctx context.Context, actor contextx.User, id uint) (*entity.User, error) {
+	if err := s.authz.Authorize(ctx, actor.ID, actor.Role, authz.ResourceUser, authz.ActionRead, &id); err != nil {
+		return nil, err
+	}
+	return s.userRepo.GetByID(ctx, id)
+}
+
+// AdminUpdateRole changes the role of a user.
+//
+// Tokens and sessions carry the role, so the user is logged out everywhere
+// and picks up the new role on the next login.
+func (s *userService) AdminUpdateRole(ctx context.Context, actor contextx.User, id uint, role entity.UserRole) (*entity.User, error) {
+	if err := s.authorizeChange(ctx, actor, id, authz.ActionUpdate); err != nil {
+		return nil, err
+	}
+	if !slices.Contains(role.Values(), string(role)) {
+		return nil, errx.New(errx.CodeInvalidParam, fmt.Errorf("unknown role %q", role))
+	}
+
+	updated, err := s.userRepo.UpdateRole(ctx, id, role)
+	if err != nil {
+		return nil, err
+	}
+	if err := s.logout(ctx, id, false); err != nil {
+		return nil, err
+	}
+
+	s.log.Info("user role changed",
+		logger.Int("actor_id", int(actor.ID)),
+		logger.Int("user_id", int(id)),
+		logger.String("role", string(role)),
+	)
+	return updated, nil
+}
+
+// AdminUpdateStatus enables, disables or bans a user.
+//
+// A user who is no longer active loses all sessions, access tokens and
+// personal access tokens at once.
+func (s *userService) AdminUpdateStatus(ctx context.Context, actor contextx.User, id uint, status entity.UserStatus) (*entity.User, error) {
+	if err := s.authorizeChange(ctx, actor, id, authz.ActionUpdate); err != nil {
+		return nil, err
+	}
+	if !slices.Contains(status.Values(), string(status)) {
+		return nil, errx.New(errx.CodeInvalidParam, fmt.Errorf("unknown status %q", status))
+	}
+
+	updated, err := s.userRepo.UpdateStatus(ctx, id, status)
+	if err != nil {
+		return nil, err
+	}
+	if status != entity.UserStatusActive {
+		if err := s.logout(ctx, id, true); err != nil {
+			return nil, err
+		}
+	}
+
+	s.log.Warn("user status changed",
+		logger.Int("actor_id", int(actor.ID)),
+		logger.Int("user_id", int(id)),
+		logger.String("status", string(status)),
+	)
+	return updated, nil
+}
+
+// AdminDeleteUser soft-deletes a user and revokes everything they could log in with.
+func (s *userService) AdminDeleteUser(ctx context.Context, actor contextx.User, id uint) error {
+	if err := s.authorizeChange(ctx, actor, id, authz.ActionDelete); err != nil {
+		return err
+	}
+
+	if err := s.userRepo.Delete(ctx, id); err != nil {
+		return err
+	}
+	if err := s.logout(ctx, id, true); err != nil {
+		return err
+	}
+
+	s.log.Warn("user deleted",
+		logger.Int("actor_id", int(actor.ID)),
+		logger.Int("user_id", int(id)),
+	)
+	return nil
+}
+
+// authorizeChange checks that the actor may change the user, who must not be the actor.
+func (s *userService) authorizeChange(ctx context.Context, actor contextx.User, id uint, action authz.Action) error {
+	if err := s.authz.Authorize(ctx, actor.ID, actor.Role, authz.ResourceUser, action, &id); err != nil {
+		return err
+	}
+	if actor.ID == id {
+		return errx.New(errx.CodeForbidden, fmt.Errorf("admins cannot change their own account here"))
+	}
+	return nil
+}
+
+// logout revokes all sessions and issued access tokens of a user, and with
+// accessTokens also their personal access tokens.
+func (s *userService) logout(ctx context.Context, userID uint, accessTokens bool) error {
+	if err := s.sessions.RevokeAll(ctx, userID); err != nil {
+		return err
+	}
+	if err := s.denylist.DenyUser(ctx, userID); err != nil {
+		return err
+	}
+	if accessTokens {
+		if err := s.accessTokens.RevokeAll(ctx, userID, time.Now()); err != nil {
+			return err
+		}
+	}
+	return nil
+}
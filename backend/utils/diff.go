@@ -0,0 +1,169 @@
+package utils
+
+import (
+	"slices"
+	"strings"
+)
+
+// DiffOp is what a diff does with a line.
+type DiffOp string
+
+const (
+	DiffEqual  DiffOp = "equal"
+	DiffInsert DiffOp = "insert"
+	DiffDelete DiffOp = "delete"
+)
+
+// DiffLine is one line of a line-level diff. OldLine and NewLine are the
+// 1-based line numbers in the old and new text, or 0 where the line does not
+// appear.
+type DiffLine struct {
+	Op      DiffOp
+	Text    string
+	OldLine int
+	NewLine int
+}
+
+// maxDiffEdits bounds the work of DiffLines. Texts that differ in more lines
+// are diffed as the old lines deleted and the new lines inserted.
+const maxDiffEdits = 2000
+
+// DiffLines returns a shortest line-level diff from oldText to newText, using
+// Myers' algorithm.
+func DiffLines(oldText, newText string) []DiffLine {
+	a := splitLines(oldText)
+	b := splitLines(newText)
+
+	// Common leading and trailing lines need no search.
+	prefix := 0
+	for prefix < len(a) && prefix < len(b) && a[prefix] == b[prefix] {
+		prefix++
+	}
+	suffix := 0
+	for suffix < len(a)-prefix && suffix < len(b)-prefix && a[len(a)-1-suffix] == b[len(b)-1-suffix] {
+		suffix++
+	}
+
+	lines := make([]DiffLine, 0, max(len(a), len(b)))
+	for i := range prefix {
+		lines = append(lines, DiffLine{Op: DiffEqual, Text: a[i], OldLine: i + 1, NewLine: i + 1})
+	}
+
+	ops := myersDiff(a[prefix:len(a)-suffix], b[prefix:len(b)-suffix])
+	x, y := prefix, prefix
+	for _, op := range ops {
+		switch op {
+		case DiffEqual:
+			lines = append(lines, DiffLine{Op: op, Text: a[x], OldLine: x + 1, NewLine: y + 1})
+			x++
+			y++
+		case DiffDelete:
+			lines = append(lines, DiffLine{Op: op, Text: a[x], OldLine: x + 1})
+			x++
+		case DiffInsert:
+			lines = append(lines, DiffLine{Op: op, Text: b[y], NewLine: y + 1})
+			y++
+		}
+	}
+
+	for i := range suffix {
+		lines = append(lines, DiffLine{Op: DiffEqual, Text: a[x+i], OldLine: x + i + 1, NewLine: y + i + 1})
+	}
+	return lines
+}
+
+// myersDiff returns the operations that turn a into b.
+//
+// The search keeps the frontier of every step to backtrack, which takes
+// memory quadratic in the number of edits; beyond maxDiffEdits it gives up
+// and replaces all lines.
+func myersDiff(a, b []string) []DiffOp {
+	n, m := len(a), len(b)
+	if n == 0 && m == 0 {
+		return nil
+	}
+
+	limit := min(n+m, maxDiffEdits)
+	offset := limit + 1
+	v := make([]int, 2*limit+3)
+	var trace [][]int
+
+	found := false
+	for d := 0; d <= limit && !found; d++ {
+		trace = append(trace, slices.Clone(v[offset-d:offset+d+1]))
+		for k := -d; k <= d; k += 2 {
+			var x int
+			if k == -d || (k != d && v[offset+k-1] < v[offset+k+1]) {
+				x = v[offset+k+1]
+			} else {
+				x = v[offset+k-1] + 1
+			}
+			y := x - k
+			for x < n && y < m && a[x] == b[y] {
+				x++
+				y++
+			}
+			v[offset+k] = x
+			if x >= n && y >= m {
+				found = true
+				break
+			}
+		}
+	}
+
+	if !found {
+		ops := make([]DiffOp, 0, n+m)
+		for range n {
+			ops = append(ops, DiffDelete)
+		}
+		for range m {
+			ops = append(ops, DiffInsert)
+		}
+		return ops
+	}
+
+	var ops []DiffOp
+	x, y := n, m
+	for d := len(trace) - 1; d > 0; d-- {
+		// trace[d] holds the frontier of step d-1, indexed by k+d.
+		prev := trace[d]
+		k := x - y
+		var prevK int
+		if k == -d || (k != d && prev[k-1+d] < prev[k+1+d]) {
+			prevK = k + 1
+		} else {
+			prevK = k - 1
+		}
+		prevX := prev[prevK+d]
+		prevY := prevX - prevK
+
+		for x > prevX && y > prevY {
+			ops = append(ops, DiffEqual)
+			x--
+			y--
+		}
+		if x == prevX {
+			ops = append(ops, DiffInsert)
+		} else {
+			ops = append(ops, DiffDelete)
+		}
+		x, y = prevX, prevY
+	}
+	for x > 0 && y > 0 {
+		ops = append(ops, DiffEqual)
+		x--
+		y--
+	}
+
+	slices.Reverse(ops)
+	return ops
+}
+
+// splitLines splits text into lines, treating CRLF like LF.
+func splitLines(text string) []string {
+	if text == "" {
+		return nil
+	}
+	text = strings.ReplaceAll(text, "\r\n", "\n")
+	return strings.Split(strings.TrimSuffix(text, "\n"), "\n")
+}
@@ -0,0 +1,72 @@
+package utils
+
+import (
+	"regexp"
+	"strings"
+)
+
+var (
+	mdFence          = regexp.MustCompile("^\\s{0,3}(```|~~~)")
+	mdLinkDefinition = regexp.MustCompile(`^\s{0,3}\[[^\]]+\]:\s*\S+`)
+	mdRule           = regexp.MustCompile(`^\s{0,3}([-*_]\s*){3,}$`)
+	mdTableDelimiter = regexp.MustCompile(`^\s*\|?\s*:?-+:?\s*(\|\s*:?-+:?\s*)*\|?\s*$`)
+	mdHeading        = regexp.MustCompile(`^\s{0,3}#{1,6}\s+|\s+#+\s*$`)
+	mdBlockPrefix    = regexp.MustCompile(`^\s*((>\s?)+|[-*+]\s+(\[[ xX]\]\s+)?|\d+[.)]\s+)`)
+
+	// Inline replacements, applied in order. Images and links keep their
+	// text, code keeps its content and emphasis markers are dropped.
+	mdInline = []struct {
+		pattern *regexp.Regexp
+		repl    string
+	}{
+		{regexp.MustCompile(`!\[([^\]]*)\]\([^)]*\)`), "$1"},
+		{regexp.MustCompile(`\[([^\]]+)\]\([^)]*\)`), "$1"},
+		{regexp.MustCompile(`\[([^\]]+)\]\[[^\]]*\]`), "$1"},
+		{regexp.MustCompile(`<((?:https?|mailto):[^>\s]+)>`), "$1"},
+		{regexp.MustCompile(`</?[a-zA-Z][^>]*>`), ""},
+		{regexp.MustCompile("`+([^`]+)`+"), "$1"},
+		{regexp.MustCompile(`\*\*([^*]+)\*\*`), "$1"},
+		{regexp.MustCompile(`__([^_]+)__`), "$1"},
+		{regexp.MustCompile(`~~([^~]+)~~`), "$1"},
+		{regexp.MustCompile(`\*([^*\s](?:[^*]*[^*\s])?)\*`), "$1"},
+		// Underscores inside words, as in snake_case, are not emphasis.
+		{regexp.MustCompile(`(^|[^\w])_([^_\s](?:[^_]*[^_\s])?)_([^\w]|$)`), "$1$2$3"},
+	}
+)
+
+// StripMarkdown returns the text of Markdown source without its markup, for
+// excerpts. Code blocks keep their content; link and image targets, HTML
+// tags, rules and table delimiters are dropped.
+//
+// It is a line-based approximation rather than a full parser, which is
+// enough for excerpts.
+func StripMarkdown(source string) string {
+	lines := strings.Split(strings.ReplaceAll(source, "\r\n", "\n"), "\n")
+	out := make([]string, 0, len(lines))
+	inFence := false
+	for _, line := range lines {
+		if mdFence.MatchString(line) {
+			inFence = !inFence
+			continue
+		}
+		if inFence {
+			out = append(out, line)
+			continue
+		}
+		if mdLinkDefinition.MatchString(line) || mdRule.MatchString(line) ||
+			(strings.Contains(line, "-") && mdTableDelimiter.MatchString(line)) {
+			continue
+		}
+
+		line = mdHeading.ReplaceAllString(line, "")
+		line = mdBlockPrefix.ReplaceAllString(line, "")
+		for _, r := range mdInline {
+			line = r.pattern.ReplaceAllString(line, r.repl)
+		}
+		if trimmed := strings.TrimSpace(line); strings.HasPrefix(trimmed, "|") {
+			line = strings.ReplaceAll(strings.Trim(trimmed, "|"), "|", " ")
+		}
+		out = append(out, line)
+	}
+	return strings.Join(out, "\n")
+}
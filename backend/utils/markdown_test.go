@@ -0,0 +1,50 @@
+package utils
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestStripMarkdown(t *testing.T) {
+	tests := []struct {
+		name   string
+		source string
+		want   string
+	}{
+		{name: "plain", source: "just text", want: "just text"},
+		{name: "heading", source: "## Getting started ##", want: "Getting started"},
+		{name: "emphasis", source: "**bold**, *italic*, __strong__ and ~~gone~~", want: "bold, italic, strong and gone"},
+		{name: "snake case kept", source: "call snake_case_name and _this_", want: "call snake_case_name and this"},
+		{name: "inline code", source: "run `go test ./...` now", want: "run go test ./... now"},
+		{name: "link", source: "see [the docs](https://example.com/docs \"Docs\")", want: "see the docs"},
+		{name: "reference link", source: "see [the docs][docs]\n\n[docs]: https://example.com", want: "see the docs\n"},
+		{name: "image", source: "![a cat](cat.png) here", want: "a cat here"},
+		{name: "autolink", source: "<https://example.com>", want: "https://example.com"},
+		{name: "html", source: "<div class=\"note\">Note</div>", want: "Note"},
+		{name: "lists", source: "- one\n* two\n1. three\n- [x] done", want: "one\ntwo\nthree\ndone"},
+		{name: "blockquote", source: "> quoted\n> > nested", want: "quoted\nnested"},
+		{name: "rule", source: "above\n\n---\n\nbelow", want: "above\n\n\nbelow"},
+		{name: "fenced code keeps content", source: "```go\nfmt.Println(\"*hi*\")\n```", want: "fmt.Println(\"*hi*\")"},
+		{name: "table", source: "| a | b |\n|---|:-:|\n| 1 | 2 |", want: " a   b \n 1   2 "},
+		{name: "cjk", source: "## 标题\n**加粗**的[链接](https://example.com)", want: "标题\n加粗的链接"},
+		{name: "crlf", source: "# Title\r\ntext", want: "Title\ntext"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := StripMarkdown(tt.source); got != tt.want {
+				t.Errorf("StripMarkdown(%q) = %q, want %q", tt.source, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestHighlightStrippedMarkdown(t *testing.T) {
+	source := "# Intro\n\nRead [the **Go** guide](https://go.dev/doc) first."
+	got, hit := Highlight(StripMarkdown(source), []string{"go"}, 80)
+	if want := "Intro Read the <mark>Go</mark> guide first."; got != want || !hit {
+		t.Errorf("got %q, %v, want %q", got, hit, want)
+	}
+	if strings.Contains(got, "go.dev") {
+		t.Errorf("excerpt contains the link target: %q", got)
+	}
+}
@@ -0,0 +1,187 @@
+package utils
+
+import (
+	"html"
+	"slices"
+	"strings"
+	"unicode"
+)
+
+// maxSearchWordLength is the length beyond which a word is left out of the
+// search index, as it is unlikely to be searched for.
+const maxSearchWordLength = 64
+
+// searchRun is a run of letters and digits in a text.
+type searchRun struct {
+	runes []rune
+	cjk   bool
+}
+
+// isCJK reports whether r belongs to a script written without spaces
+// between words.
+func isCJK(r rune) bool {
+	return unicode.In(r, unicode.Han, unicode.Hiragana, unicode.Katakana, unicode.Hangul)
+}
+
+// isWordRune reports whether r continues a word that is not CJK.
+func isWordRune(r rune) bool {
+	return (unicode.IsLetter(r) || unicode.IsDigit(r)) && !isCJK(r)
+}
+
+// searchRuns splits text into lowercase runs of letters and digits, keeping
+// CJK runs apart from others.
+func searchRuns(text string) []searchRun {
+	var runs []searchRun
+	var cur searchRun
+	flush := func() {
+		if len(cur.runes) > 0 {
+			runs = append(runs, cur)
+		}
+		cur = searchRun{}
+	}
+
+	for _, r := range text {
+		if !unicode.IsLetter(r) && !unicode.IsDigit(r) {
+			flush()
+			continue
+		}
+		cjk := isCJK(r)
+		if len(cur.runes) > 0 && cur.cjk != cjk {
+			flush()
+		}
+		cur.cjk = cjk
+		cur.runes = append(cur.runes, unicode.ToLower(r))
+	}
+	flush()
+	return runs
+}
+
+// SearchDocument turns text into space-separated tokens for a full-text
+// index with the Postgres "simple" configuration.
+//
+// Words are kept whole. CJK text has no word boundaries, so it is indexed as
+// every single character and every pair of adjacent characters, which lets
+// SearchTerms match any part of it.
+func SearchDocument(text string) string {
+	var b strings.Builder
+	write := func(token []rune) {
+		if b.Len() > 0 {
+			b.WriteByte(' ')
+		}
+		b.WriteString(string(token))
+	}
+
+	for _, run := range searchRuns(text) {
+		if !run.cjk {
+			if len(run.runes) <= maxSearchWordLength {
+				write(run.runes)
+			}
+			continue
+		}
+		for i := range run.runes {
+			write(run.runes[i : i+1])
+			if i+1 < len(run.runes) {
+				write(run.runes[i : i+2])
+			}
+		}
+	}
+	return b.String()
+}
+
+// SearchTerms returns the tokens of a search query, all of which a document
+// must contain to match. CJK text is split into pairs of adjacent
+// characters, as indexed by SearchDocument.
+func SearchTerms(query string) []string {
+	var terms []string
+	seen := make(map[string]bool)
+	add := func(term string) {
+		if !seen[term] {
+			seen[term] = true
+			terms = append(terms, term)
+		}
+	}
+
+	for _, run := range searchRuns(query) {
+		if !run.cjk || len(run.runes) == 1 {
+			add(string(run.runes))
+			continue
+		}
+		for i := 0; i+1 < len(run.runes); i++ {
+			add(string(run.runes[i : i+2]))
+		}
+	}
+	return terms
+}
+
+// Highlight returns an HTML excerpt of text of at most maxRunes characters
+// around the first occurrence of any of the terms, with every occurrence
+// wrapped in <mark>, and whether there was one. The rest of the text is
+// escaped. Without an occurrence the excerpt is the start of text.
+//
+// Like the search index, other terms only match whole words, while CJK terms
+// match anywhere.
+func Highlight(text string, terms []string, maxRunes int) (string, bool) {
+	runes := []rune(strings.Join(strings.Fields(text), " "))
+	lower := make([]rune, len(runes))
+	for i, r := range runes {
+		lower[i] = unicode.ToLower(r)
+	}
+
+	// marked[i] is true for characters within an occurrence of a term.
+	marked := make([]bool, len(runes))
+	first := -1
+	for _, term := range terms {
+		t := []rune(term)
+		if len(t) == 0 {
+			continue
+		}
+		cjk := isCJK(t[0])
+		for i := 0; i+len(t) <= len(lower); i++ {
+			if !slices.Equal(lower[i:i+len(t)], t) {
+				continue
+			}
+			wordBefore := i > 0 && isWordRune(lower[i-1])
+			wordAfter := i+len(t) < len(lower) && isWordRune(lower[i+len(t)])
+			if !cjk && (wordBefore || wordAfter) {
+				continue
+			}
+			for j := i; j < i+len(t); j++ {
+				marked[j] = true
+			}
+			if first < 0 || i < first {
+				first = i
+			}
+		}
+	}
+
+	start := 0
+	if first > maxRunes/4 {
+		start = first - maxRunes/4
+	}
+	end := min(len(runes), start+maxRunes)
+	if end-start < maxRunes {
+		start = max(0, end-maxRunes)
+	}
+
+	var b strings.Builder
+	if start > 0 {
+		b.WriteString("…")
+	}
+	for i := start; i < end; {
+		j := i
+		for j < end && marked[j] == marked[i] {
+			j++
+		}
+		chunk := html.EscapeString(string(runes[i:j]))
+		if marked[i] {
+			b.WriteString("<mark>" + chunk + "</mark>")
+		} else {
+			b.WriteString(chunk)
+		}
+		i = j
+	}
+	if end < len(runes) {
+		b.WriteString("…")
+	}
+	return b.String(), first >= 0
+}
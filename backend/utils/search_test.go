@@ -0,0 +1,181 @@
+package utils
+
+import (
+	"slices"
+	"testing"
+)
+
+func TestSearchDocument(t *testing.T) {
+	tests := []struct {
+		name string
+		text string
+		want string
+	}{
+		{name: "empty", text: "", want: ""},
+		{name: "words", text: "Hello, World! Go 1.24", want: "hello world go 1 24"},
+		{name: "cjk", text: "搜索引擎", want: "搜 搜索 索 索引 引 引擎 擎"},
+		{name: "single cjk", text: "书", want: "书"},
+		{name: "mixed", text: "Go语言入门", want: "go 语 语言 言 言入 入 入门 门"},
+		{name: "mixed with spaces", text: "用 Postgres 搜索", want: "用 postgres 搜 搜索 索"},
+		{name: "kana and hangul", text: "カナ 한글", want: "カ カナ ナ 한 한글 글"},
+		{name: "accents kept", text: "Crème brûlée", want: "crème brûlée"},
+		{name: "html is text", text: "<b>bold</b> &amp;", want: "b bold b amp"},
+		{name: "long word dropped", text: "a " + longWord(65) + " b", want: "a b"},
+		{name: "word at limit kept", text: longWord(64), want: longWord(64)},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := SearchDocument(tt.text); got != tt.want {
+				t.Errorf("SearchDocument(%q) = %q, want %q", tt.text, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestSearchTerms(t *testing.T) {
+	tests := []struct {
+		name  string
+		query string
+		want  []string
+	}{
+		{name: "empty", query: "", want: nil},
+		{name: "punctuation only", query: "?!. -", want: nil},
+		{name: "words", query: "Go  Search", want: []string{"go", "search"}},
+		{name: "duplicates", query: "go GO Go", want: []string{"go"}},
+		{name: "single cjk", query: "书", want: []string{"书"}},
+		{name: "cjk pairs", query: "搜索引擎", want: []string{"搜索", "索引", "引擎"}},
+		{name: "mixed", query: "Go语言", want: []string{"go", "语言"}},
+		{name: "mixed with spaces", query: "postgres 全文 搜索", want: []string{"postgres", "全文", "搜索"}},
+		{name: "repeated cjk pair", query: "哈哈哈", want: []string{"哈哈"}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := SearchTerms(tt.query); !slices.Equal(got, tt.want) {
+				t.Errorf("SearchTerms(%q) = %q, want %q", tt.query, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestHighlight(t *testing.T) {
+	tests := []struct {
+		name     string
+		text     string
+		terms    []string
+		maxRunes int
+		want     string
+		wantHit  bool
+	}{
+		{
+			name:     "no match is start of text",
+			text:     "nothing to see here",
+			terms:    []string{"go"},
+			maxRunes: 7,
+			want:     "nothing…",
+		},
+		{
+			name:     "case insensitive",
+			text:     "Learn Go today",
+			terms:    []string{"go"},
+			maxRunes: 50,
+			want:     "Learn <mark>Go</mark> today",
+			wantHit:  true,
+		},
+		{
+			name:     "latin terms match whole words only",
+			text:     "going to Go, not gopher or ago",
+			terms:    []string{"go"},
+			maxRunes: 50,
+			want:     "going to <mark>Go</mark>, not gopher or ago",
+			wantHit:  true,
+		},
+		{
+			name:     "latin term inside a word does not count",
+			text:     "a gopher",
+			terms:    []string{"go"},
+			maxRunes: 50,
+			want:     "a gopher",
+		},
+		{
+			name:     "latin term next to cjk",
+			text:     "学习Go语言",
+			terms:    []string{"go"},
+			maxRunes: 50,
+			want:     "学习<mark>Go</mark>语言",
+			wantHit:  true,
+		},
+		{
+			name:     "cjk terms match inside runs",
+			text:     "全文搜索引擎",
+			terms:    []string{"搜索", "索引"},
+			maxRunes: 50,
+			want:     "全文<mark>搜索引</mark>擎",
+			wantHit:  true,
+		},
+		{
+			name:     "mixed terms",
+			text:     "用 Postgres 做全文搜索",
+			terms:    []string{"postgres", "搜索"},
+			maxRunes: 50,
+			want:     "用 <mark>Postgres</mark> 做全文<mark>搜索</mark>",
+			wantHit:  true,
+		},
+		{
+			name:     "html is escaped",
+			text:     `<script>alert("go")</script> & go`,
+			terms:    []string{"go"},
+			maxRunes: 50,
+			want:     `&lt;script&gt;alert(&#34;<mark>go</mark>&#34;)&lt;/script&gt; &amp; <mark>go</mark>`,
+			wantHit:  true,
+		},
+		{
+			name:     "term in html is escaped inside mark",
+			text:     "a <b> tag",
+			terms:    []string{"b"},
+			maxRunes: 50,
+			want:     "a &lt;<mark>b</mark>&gt; tag",
+			wantHit:  true,
+		},
+		{
+			name:     "whitespace collapsed",
+			text:     "one\n\n  two\tthree",
+			terms:    []string{"two"},
+			maxRunes: 50,
+			want:     "one <mark>two</mark> three",
+			wantHit:  true,
+		},
+		{
+			name:     "excerpt around match",
+			text:     "aaaa bbbb cccc dddd match eeee ffff gggg",
+			terms:    []string{"match"},
+			maxRunes: 16,
+			want:     "…ddd <mark>match</mark> eeee f…",
+			wantHit:  true,
+		},
+		{
+			name:     "excerpt at end keeps length",
+			text:     "aaaa bbbb cccc match",
+			terms:    []string{"match"},
+			maxRunes: 10,
+			want:     "…cccc <mark>match</mark>",
+			wantHit:  true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, hit := Highlight(tt.text, tt.terms, tt.maxRunes)
+			if got != tt.want || hit != tt.wantHit {
+				t.Errorf("Highlight(%q, %q, %d) = %q, %v, want %q, %v", tt.text, tt.terms, tt.maxRunes, got, hit, tt.want, tt.wantHit)
+			}
+		})
+	}
+}
+
+// longWord returns a word of n letters.
+func longWord(n int) string {
+	b := make([]byte, n)
+	for i := range b {
+		b[i] = 'a'
+	}
+	return string(b)
+}
@@ -0,0 +1,64 @@
+package utils
+
+import (
+	"strings"
+	"unicode"
+
+	"golang.org/x/text/runes"
+	"golang.org/x/text/transform"
+	"golang.org/x/text/unicode/norm"
+)
+
+// MaxSlugLength is the maximum number of characters Slugify returns.
+const MaxSlugLength = 80
+
+// latinSpecials are Latin letters that do not decompose into a base letter
+// and a mark.
+var latinSpecials = map[rune]string{
+	'ß': "ss", 'æ': "ae", 'œ': "oe", 'ø': "o", 'đ': "d", 'ð': "d", 'ł': "l", 'þ': "th", 'ı': "i",
+}
+
+// Slugify turns a title into a URL slug of lowercase letters, digits and
+// hyphens.
+//
+// Latin letters are transliterated to ASCII ("Crème Brûlée" becomes
+// "creme-brulee"). Letters of other scripts, such as CJK characters, have no
+// ASCII spelling without a dictionary and are kept as they are, which is
+// valid in IRIs. The result is empty if the title has no letters or digits.
+func Slugify(title string) string {
+	stripMarks := transform.Chain(norm.NFD, runes.Remove(runes.In(unicode.Mn)), norm.NFC)
+	folded, _, err := transform.String(stripMarks, title)
+	if err != nil {
+		folded = title
+	}
+
+	var b strings.Builder
+	n := 0
+	hyphen := false
+	for _, r := range strings.ToLower(folded) {
+		if n >= MaxSlugLength {
+			break
+		}
+		switch {
+		case r < unicode.MaxASCII && (unicode.IsLetter(r) || unicode.IsDigit(r)):
+			b.WriteRune(r)
+			n++
+		case latinSpecials[r] != "":
+			b.WriteString(latinSpecials[r])
+			n += len(latinSpecials[r])
+		case unicode.IsLetter(r) || unicode.IsDigit(r):
+			b.WriteRune(r)
+			n++
+		default:
+			if b.Len() > 0 && !hyphen {
+				b.WriteByte('-')
+				n++
+				hyphen = true
+			}
+			continue
+		}
+		hyphen = false
+	}
+
+	return strings.Trim(b.String(), "-")
+}
@@ -173,7 +173,7 @@ fi
 
 mkdir -p deploy/runtime/backend deploy/runtime/nginx
 # shellcheck disable=SC2016
-BACKEND_VARS='$APP_NAME $APP_DOMAIN $DB_HOST $DB_PORT $DB_USER $DB_PASSWORD $JWT_SECRET $EMAIL_HOST $EMAIL_PORT $EMAIL_USERNAME $EMAIL_PASSWORD $EMAIL_FROM $MODEL_API_KEY $RUSTFS_ACCESS_KEY_ID $RUSTFS_SECRET_ACCESS_KEY $RUSTFS_ENDPOINT'
+BACKEND_VARS='$APP_NAME $APP_DOMAIN $DB_HOST $DB_PORT $DB_USER $DB_PASSWORD $JWT_SECRET $JWT_KEY_ENCRYPTION_KEY $COMMENT_UNSUBSCRIBE_SECRET $COMMENT_GUEST_SECRET $EMAIL_HOST $EMAIL_PORT $EMAIL_USERNAME $EMAIL_PASSWORD $EMAIL_FROM $MODEL_API_KEY $RUSTFS_ACCESS_KEY_ID $RUSTFS_SECRET_ACCESS_KEY $RUSTFS_ENDPOINT $GITHUB_CLIENT_ID $GITHUB_CLIENT_SECRET $OIDC_ISSUER $OIDC_CLIENT_ID $OIDC_CLIENT_SECRET'
 echo "渲染后端配置 → deploy/runtime/backend/config.yaml"
 envsubst "$BACKEND_VARS" < config/backend_config.yml > deploy/runtime/backend/config.yaml
 
@@ -1,7 +1,8 @@
-export type PostStatus = "draft" | "published" | "archived";
+export type PostStatus = "draft" | "published" | "scheduled" | "archived";
 
 export interface PostMeta {
   id: number;
+  slug: string;
   cover: string;
   title: string;
   summary: string;
@@ -21,9 +22,11 @@ export type PostInput = {
   cover: string;
   summary: string;
   title: string;
+  slug?: string;
   content: string;
   tagIds: number[];
   status: PostStatus;
+  publishAt?: string;
 };
 
 export interface PostAdminMeta extends PostMeta {
@@ -34,3 +37,15 @@ export interface PostAdminDetail extends Post {
   createdAt: string;
   status: PostStatus;
 }
+
+export interface PostTrashItem {
+  id: number;
+  title: string;
+  slug: string;
+  summary: string;
+  user: string;
+  status: PostStatus;
+  publishedAt: string;
+  createdAt: string;
+  deletedAt: string;
+}
@@ -1,13 +1,13 @@
 export default defineSitemapEventHandler(async () => {
   try {
     // 调用后端 API 获取 posts 的 meta
-    const { data } = await $fetch<{ data: { id: number; updatedAt: Date }[] }>(
+    const { data } = await $fetch<{ data: { id: number; slug: string; updatedAt: Date }[] }>(
       "/api/v1/posts/meta",
     );
     const metas = data ?? [];
 
     const routeList = metas.map((meta) => ({
-      loc: `/blog/${meta.id}`,
+      loc: `/blog/${meta.slug ? encodeURIComponent(meta.slug) : meta.id}`,
       _i18nTransform: true,
       lastmod: new Date(meta.updatedAt).toISOString(),
     }));